@@ -79,6 +79,9 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrTraceTransaction signals an error happening when trying to trace a transaction
+var ErrTraceTransaction = errors.New("tracing transaction failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -151,6 +154,10 @@ var ErrFetchingLatestNonceCannotIncludeFields = errors.New("fetching latest nonc
 // ErrFetchingNonceGapsCannotIncludeFields signals that an error happened when trying to fetch nonce gaps
 var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps cannot include fields")
 
+// ErrFetchingNonceCannotIncludeFilters signals that the receiver or data prefix filters were provided when fetching the
+// latest nonce or the nonce gaps
+var ErrFetchingNonceCannotIncludeFilters = errors.New("fetching latest nonce or nonce gaps cannot include the receiver or data prefix filters")
+
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
 
@@ -163,6 +170,21 @@ var ErrRegisteredNFTTokenIDs = errors.New("getting registered nft token ids erro
 // ErrInvalidRole signals that an invalid role was provided
 var ErrInvalidRole = errors.New("invalid role")
 
+// ErrGetStateDiff signals an error in getting the accounts that differ between the states of two blocks
+var ErrGetStateDiff = errors.New("get state diff error")
+
+// ErrGetStorageReport signals an error in computing the storage report of a state
+var ErrGetStorageReport = errors.New("get storage report error")
+
+// ErrGetAddressTransactions signals an error in getting the indexed transactions of an address
+var ErrGetAddressTransactions = errors.New("get address transactions error")
+
+// ErrEventsSubscription signals an error in creating an events subscription
+var ErrEventsSubscription = errors.New("events subscription error")
+
+// ErrTransactionsPoolSubscription signals an error in creating a transactions pool subscription
+var ErrTransactionsPoolSubscription = errors.New("transactions pool subscription error")
+
 // ErrIsDataTrieMigrated signals that an error occurred while trying to verify the migration status of the data trie
 var ErrIsDataTrieMigrated = errors.New("could not verify the migration status of the data trie")
 
@@ -171,3 +193,46 @@ var ErrGetEligibleManagedKeys = errors.New("error getting the eligible managed k
 
 // ErrGetWaitingManagedKeys signals that an error occurred while getting the waiting managed keys
 var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed keys")
+
+// ErrInvalidSourceLimiterResetInterval signals that an invalid source limiter reset interval has been provided
+var ErrInvalidSourceLimiterResetInterval = errors.New("invalid source limiter reset interval")
+
+// ErrReloadConfig signals that an error occurred while reloading the config values
+var ErrReloadConfig = errors.New("error reloading the config values")
+
+// ErrGetRedundancyLeaseStatus signals that an error occurred while getting the redundancy lease status
+var ErrGetRedundancyLeaseStatus = errors.New("error getting the redundancy lease status")
+
+// ErrPromoteRedundancyLease signals that an error occurred while promoting the node as redundancy lease holder
+var ErrPromoteRedundancyLease = errors.New("error promoting the node as redundancy lease holder")
+
+// ErrDemoteRedundancyLease signals that an error occurred while demoting the node from redundancy lease holder
+var ErrDemoteRedundancyLease = errors.New("error demoting the node from redundancy lease holder")
+
+// ErrGetPeersReputation signals that an error occurred while getting the peers reputation
+var ErrGetPeersReputation = errors.New("error getting the peers reputation")
+
+// ErrBanPeer signals that an error occurred while banning a peer ID or an IP address
+var ErrBanPeer = errors.New("error banning the peer")
+
+// ErrUnbanPeer signals that an error occurred while unbanning a peer ID or an IP address
+var ErrUnbanPeer = errors.New("error unbanning the peer")
+
+// ErrGetOutportDriversCursors signals that an error occurred while fetching the cursors of the outport drivers
+var ErrGetOutportDriversCursors = errors.New("error getting the outport drivers cursors")
+
+// ErrReplayOutport signals that an error occurred while replaying the outport journal
+var ErrReplayOutport = errors.New("error replaying the outport journal")
+
+// ErrEmptyOutportDriverID signals that the outport driver ID was not provided
+var ErrEmptyOutportDriverID = errors.New("empty outport driver ID")
+
+// ErrInvalidPeerBanRequest signals that exactly one of the peer ID and the IP address should be provided
+var ErrInvalidPeerBanRequest = errors.New("exactly one of the peer ID and the IP address should be provided")
+
+// ErrClientCertificatesWithoutMutualTLS signals that client certificates were configured without enabling the TLS
+// client certificates verification
+var ErrClientCertificatesWithoutMutualTLS = errors.New("client certificates require TLS with a client CA file")
+
+// ErrInvalidClientCAFile signals that the provided client CA file does not contain any valid certificate
+var ErrInvalidClientCAFile = errors.New("invalid client CA file")
@@ -1,8 +1,11 @@
 package gin
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"os"
 	"reflect"
 
 	"github.com/gin-gonic/gin"
@@ -12,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/logs"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/config"
 	"gopkg.in/go-playground/validator.v8"
 )
@@ -39,9 +43,100 @@ func checkArgs(args ArgsNewWebServer) error {
 		return fmt.Errorf("%w: %s", apiErrors.ErrCannotCreateGinWebServer, apiErrors.ErrNilFacadeHandler.Error())
 	}
 
+	authenticationConfig := args.ApiConfig.Authentication
+	isMutualTLSEnabled := authenticationConfig.TLS.Enabled && len(authenticationConfig.TLS.ClientCAFile) > 0
+	if len(authenticationConfig.ClientCertificates) > 0 && !isMutualTLSEnabled {
+		return fmt.Errorf("%w: %s", apiErrors.ErrCannotCreateGinWebServer, apiErrors.ErrClientCertificatesWithoutMutualTLS.Error())
+	}
+
 	return nil
 }
 
+// createRouteRoles returns the roles required by the routes, keyed by their full path. The routes not found in the
+// returned map are public
+func createRouteRoles(routesConfig config.ApiRoutesConfig) (map[string]middleware.Role, error) {
+	routeRoles := make(map[string]middleware.Role)
+	for packageName, packageConfig := range routesConfig.APIPackages {
+		for _, routeConfig := range packageConfig.Routes {
+			role, err := middleware.ParseRole(routeConfig.Role)
+			if err != nil {
+				return nil, fmt.Errorf("%w for route %s of package %s", err, routeConfig.Name, packageName)
+			}
+			if role == middleware.RolePublic {
+				continue
+			}
+
+			// the log route is registered directly on the engine, not under its package group
+			fullPath := fmt.Sprintf("/%s%s", packageName, routeConfig.Name)
+			if packageName == "log" && routeConfig.Name == "/log" {
+				fullPath = routeConfig.Name
+			}
+
+			routeRoles[fullPath] = role
+		}
+	}
+
+	return routeRoles, nil
+}
+
+// closeRestrictedRoutes returns a copy of the provided config in which the routes having a role assigned are closed,
+// together with the number of closed routes. It is used while the authentication is disabled, so the restricted routes
+// do not become public
+func closeRestrictedRoutes(routesConfig config.ApiRoutesConfig) (config.ApiRoutesConfig, int) {
+	numClosedRoutes := 0
+	apiPackages := make(map[string]config.APIPackageConfig, len(routesConfig.APIPackages))
+	for packageName, packageConfig := range routesConfig.APIPackages {
+		routes := make([]config.RouteConfig, 0, len(packageConfig.Routes))
+		for _, routeConfig := range packageConfig.Routes {
+			role, err := middleware.ParseRole(routeConfig.Role)
+			isRestricted := err != nil || role != middleware.RolePublic
+			if isRestricted && routeConfig.Open {
+				routeConfig.Open = false
+				numClosedRoutes++
+			}
+
+			routes = append(routes, routeConfig)
+		}
+
+		apiPackages[packageName] = config.APIPackageConfig{Routes: routes}
+	}
+
+	routesConfig.APIPackages = apiPackages
+
+	return routesConfig, numClosedRoutes
+}
+
+func createTLSConfig(tlsConfig config.ApiTLSConfig) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(tlsConfig.CertificateFile, tlsConfig.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	serverTLSConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if len(tlsConfig.ClientCAFile) == 0 {
+		return serverTLSConfig, nil
+	}
+
+	clientCAs, err := os.ReadFile(tlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAsPool := x509.NewCertPool()
+	if !clientCAsPool.AppendCertsFromPEM(clientCAs) {
+		return nil, fmt.Errorf("%w: %s", apiErrors.ErrInvalidClientCAFile, tlsConfig.ClientCAFile)
+	}
+
+	// the client certificates are optional, so the callers relying on bearer tokens or on the public routes can still connect
+	serverTLSConfig.ClientCAs = clientCAsPool
+	serverTLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+
+	return serverTLSConfig, nil
+}
+
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	logConfig, ok := routesConfig.APIPackages["log"]
 	if !ok {
@@ -1,10 +1,22 @@
 package gin
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade/initial"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -26,6 +38,174 @@ func TestCommon_checkArgs(t *testing.T) {
 	require.NoError(t, err)
 	err = checkArgs(args)
 	require.NoError(t, err)
+
+	args.ApiConfig.Authentication.ClientCertificates = []config.ApiClientCertificateConfig{{CommonName: "operator", Role: "operator"}}
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+	require.Contains(t, err.Error(), apiErrors.ErrClientCertificatesWithoutMutualTLS.Error())
+
+	args.ApiConfig.Authentication.TLS = config.ApiTLSConfig{
+		Enabled:      true,
+		ClientCAFile: "ca.pem",
+	}
+	err = checkArgs(args)
+	require.NoError(t, err)
+}
+
+func TestCommon_createRouteRoles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid role should error", func(t *testing.T) {
+		t.Parallel()
+
+		routesConfig := config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"node": {Routes: []config.RouteConfig{{Name: "/debug", Open: true, Role: "root"}}},
+			},
+		}
+		routeRoles, err := createRouteRoles(routesConfig)
+		require.True(t, errors.Is(err, middleware.ErrInvalidRole))
+		require.Nil(t, routeRoles)
+	})
+	t.Run("should only contain the restricted routes", func(t *testing.T) {
+		t.Parallel()
+
+		routesConfig := config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"node": {Routes: []config.RouteConfig{
+					{Name: "/status", Open: true},
+					{Name: "/debug", Open: true, Role: "operator"},
+				}},
+				"hardfork": {Routes: []config.RouteConfig{{Name: "/trigger", Open: true, Role: "Admin"}}},
+				"log":      {Routes: []config.RouteConfig{{Name: "/log", Open: true, Role: "operator"}}},
+				"address":  {Routes: []config.RouteConfig{{Name: "/:address", Open: true, Role: "public"}}},
+			},
+		}
+		routeRoles, err := createRouteRoles(routesConfig)
+		require.Nil(t, err)
+		expectedRouteRoles := map[string]middleware.Role{
+			"/node/debug":       middleware.RoleOperator,
+			"/hardfork/trigger": middleware.RoleAdmin,
+			"/log":              middleware.RoleOperator,
+		}
+		require.Equal(t, expectedRouteRoles, routeRoles)
+	})
+}
+
+func TestCommon_createTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: "missing.pem",
+			KeyFile:         "missing.key",
+		})
+		require.NotNil(t, err)
+		require.Nil(t, tlsConfig)
+	})
+	t.Run("invalid client CA file should error", func(t *testing.T) {
+		t.Parallel()
+
+		certificateFile, keyFile := writeTestCertificate(t)
+		clientCAFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.Nil(t, os.WriteFile(clientCAFile, []byte("not a certificate"), 0600))
+
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: certificateFile,
+			KeyFile:         keyFile,
+			ClientCAFile:    clientCAFile,
+		})
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidClientCAFile))
+		require.Nil(t, tlsConfig)
+	})
+	t.Run("without client CA file should not verify the client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		certificateFile, keyFile := writeTestCertificate(t)
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: certificateFile,
+			KeyFile:         keyFile,
+		})
+		require.Nil(t, err)
+		require.Len(t, tlsConfig.Certificates, 1)
+		require.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+	})
+	t.Run("with client CA file should verify the provided client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		certificateFile, keyFile := writeTestCertificate(t)
+		tlsConfig, err := createTLSConfig(config.ApiTLSConfig{
+			Enabled:         true,
+			CertificateFile: certificateFile,
+			KeyFile:         keyFile,
+			ClientCAFile:    certificateFile,
+		})
+		require.Nil(t, err)
+		require.NotNil(t, tlsConfig.ClientCAs)
+		require.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+	})
+}
+
+func writeTestCertificate(t *testing.T) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	dir := t.TempDir()
+	certificateFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.Nil(t, os.WriteFile(certificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}), 0600))
+	require.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+
+	return certificateFile, keyFile
+}
+
+func TestCommon_closeRestrictedRoutes(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"node": {Routes: []config.RouteConfig{
+				{Name: "/status", Open: true},
+				{Name: "/peers", Open: true, Role: "public"},
+				{Name: "/debug", Open: true, Role: "operator"},
+				{Name: "/config/reload", Open: false, Role: "admin"},
+			}},
+			"log": {Routes: []config.RouteConfig{{Name: "/log", Open: true, Role: "operator"}}},
+		},
+	}
+
+	closedRoutesConfig, numClosedRoutes := closeRestrictedRoutes(routesConfig)
+	require.Equal(t, 2, numClosedRoutes)
+	require.Equal(t, []config.RouteConfig{
+		{Name: "/status", Open: true},
+		{Name: "/peers", Open: true, Role: "public"},
+		{Name: "/debug", Open: false, Role: "operator"},
+		{Name: "/config/reload", Open: false, Role: "admin"},
+	}, closedRoutesConfig.APIPackages["node"].Routes)
+	require.False(t, isLogRouteEnabled(closedRoutesConfig))
+
+	// the provided config is not changed
+	require.True(t, routesConfig.APIPackages["node"].Routes[2].Open)
+	require.True(t, isLogRouteEnabled(routesConfig))
 }
 
 func TestCommon_isLogRouteEnabled(t *testing.T) {
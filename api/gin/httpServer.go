@@ -9,7 +9,8 @@ import (
 )
 
 type httpServer struct {
-	server server
+	server       server
+	isTLSEnabled bool
 }
 
 // NewHttpServer returns a new instance of httpServer
@@ -23,10 +24,28 @@ func NewHttpServer(server server) (*httpServer, error) {
 	}, nil
 }
 
+// NewHttpsServer returns a new instance of httpServer that serves over TLS. The certificates are expected to be
+// already set in the server's TLS config
+func NewHttpsServer(server server) (*httpServer, error) {
+	h, err := NewHttpServer(server)
+	if err != nil {
+		return nil, err
+	}
+
+	h.isTLSEnabled = true
+
+	return h, nil
+}
+
 // Start will handle the starting of the gin web server. This call is blocking, and it should be
 // called on a go routine (different from the main one)
 func (h *httpServer) Start() {
-	err := h.server.ListenAndServe()
+	var err error
+	if h.isTLSEnabled {
+		err = h.server.ListenAndServeTLS("", "")
+	} else {
+		err = h.server.ListenAndServe()
+	}
 	if err == nil {
 		return
 	}
@@ -30,6 +30,30 @@ func TestNewHttpServer(t *testing.T) {
 	})
 }
 
+func TestNewHttpsServer(t *testing.T) {
+	t.Parallel()
+
+	hs, err := NewHttpsServer(nil)
+	require.Equal(t, apiErrors.ErrNilHttpServer, err)
+	require.Nil(t, hs)
+
+	listenAndServeTLSCalled := false
+	hs, err = NewHttpsServer(&api.ServerStub{
+		ListenAndServeCalled: func() error {
+			require.Fail(t, "should have not been called")
+			return nil
+		},
+		ListenAndServeTLSCalled: func(certFile string, keyFile string) error {
+			listenAndServeTLSCalled = true
+			return http.ErrServerClosed
+		},
+	})
+	require.NoError(t, err)
+
+	hs.Start()
+	require.True(t, listenAndServeTLSCalled)
+}
+
 func TestHttpServer_Start(t *testing.T) {
 	t.Parallel()
 
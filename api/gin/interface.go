@@ -1,13 +1,39 @@
 package gin
 
-import "context"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
 
 type resetHandler interface {
 	Reset()
 	IsInterfaceNil() bool
 }
 
+type requestsLimiterHandler interface {
+	shared.MiddlewareProcessor
+	SetMaxNumRequests(maxNumRequests uint32) error
+}
+
+type sourceLimiterHandler interface {
+	requestsLimiterHandler
+	Reset()
+}
+
+type apiKeyLimiterHandler interface {
+	shared.MiddlewareProcessor
+	SetConfig(apiKeysConfig config.WebServerAPIKeysConfig) error
+}
+
+type streamsLimiterHandler interface {
+	shared.MiddlewareProcessor
+	SetLimits(maxStreams uint32, streamingRoutes map[string]struct{}) error
+}
+
 type server interface {
 	ListenAndServe() error
+	ListenAndServeTLS(certFile string, keyFile string) error
 	Shutdown(ctx context.Context) error
 }
@@ -0,0 +1,27 @@
+package gin
+
+import (
+	"net/http"
+	"sync"
+)
+
+// swappableHandler is an http.Handler whose inner handler can be replaced while the http server is running
+type swappableHandler struct {
+	mut     sync.RWMutex
+	handler http.Handler
+}
+
+// ServeHTTP forwards the request to the current inner handler
+func (sh *swappableHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	sh.mut.RLock()
+	handler := sh.handler
+	sh.mut.RUnlock()
+
+	handler.ServeHTTP(writer, request)
+}
+
+func (sh *swappableHandler) setHandler(handler http.Handler) {
+	sh.mut.Lock()
+	sh.handler = handler
+	sh.mut.Unlock()
+}
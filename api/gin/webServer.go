@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"sync"
 	"time"
 
@@ -37,6 +38,12 @@ type webServer struct {
 	antiFloodConfig config.WebServerAntifloodConfig
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
+	handler         *swappableHandler
+	apiKeyLimiter   apiKeyLimiterHandler
+	sourceLimiter   sourceLimiterHandler
+	globalLimiter   requestsLimiterHandler
+	streamsLimiter  streamsLimiterHandler
+	resetInterval   time.Duration
 	cancelFunc      func()
 }
 
@@ -87,45 +94,43 @@ func (ws *webServer) StartHttpServer() error {
 		return nil
 	}
 
-	var engine *gin.Engine
 	if !ws.facade.RestAPIServerDebugMode() {
 		gin.DefaultWriter = &ginWriter{}
 		gin.DefaultErrorWriter = &ginErrorWriter{}
 		gin.DisableConsoleColor()
 		gin.SetMode(gin.ReleaseMode)
 	}
-	engine = gin.Default()
-	engine.Use(cors.Default())
 
-	processors, err := ws.createMiddlewareLimiters()
+	err := registerValidators()
 	if err != nil {
 		return err
 	}
 
-	for idx, proc := range processors {
-		if check.IfNil(proc) {
-			log.Error("got nil middleware processor, skipping it...", "index", idx)
-			continue
-		}
-
-		engine.Use(proc.MiddlewareHandlerFunc())
-	}
-
-	err = registerValidators()
+	err = ws.createGroups()
 	if err != nil {
 		return err
 	}
 
-	err = ws.createGroups()
+	engine, err := ws.createEngine()
 	if err != nil {
 		return err
 	}
+	ws.handler = &swappableHandler{handler: engine}
 
-	ws.registerRoutes(engine)
+	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: ws.handler}
+	tlsConfig := ws.apiConfig.Authentication.TLS
+	if tlsConfig.Enabled {
+		server.TLSConfig, err = createTLSConfig(tlsConfig)
+		if err != nil {
+			return err
+		}
 
-	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
-	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
-	ws.httpServer, err = NewHttpServer(server)
+		log.Debug("creating gin web sever over TLS", "interface", ws.facade.RestApiInterface(), "client CA file", tlsConfig.ClientCAFile)
+		ws.httpServer, err = NewHttpsServer(server)
+	} else {
+		log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
+		ws.httpServer, err = NewHttpServer(server)
+	}
 	if err != nil {
 		return err
 	}
@@ -137,6 +142,9 @@ func (ws *webServer) StartHttpServer() error {
 			"SimultaneousRequests", ws.antiFloodConfig.SimultaneousRequests,
 			"SameSourceRequests", ws.antiFloodConfig.SameSourceRequests,
 			"SameSourceResetIntervalInSec", ws.antiFloodConfig.SameSourceResetIntervalInSec,
+			"SimultaneousStreams", ws.antiFloodConfig.SimultaneousStreams,
+			"APIKeys", ws.antiFloodConfig.APIKeys.Enabled,
+			"num API keys", len(ws.antiFloodConfig.APIKeys.Keys),
 		)
 	}
 
@@ -159,6 +167,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	eventsGroup, err := groups.NewEventsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["events"] = eventsGroup
+
 	internalBlockGroup, err := groups.NewInternalBlockGroup(ws.facade)
 	if err != nil {
 		return err
@@ -213,13 +227,14 @@ func (ws *webServer) createGroups() error {
 }
 
 func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
+	routesConfig := ws.getRoutesConfig()
 	for groupName, groupHandler := range ws.groups {
 		log.Debug("registering gin API group", "group name", groupName)
 		ginGroup := ginRouter.Group(fmt.Sprintf("/%s", groupName))
-		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
+		groupHandler.RegisterRoutes(ginGroup, routesConfig)
 	}
 
-	if isLogRouteEnabled(ws.apiConfig) {
+	if isLogRouteEnabled(routesConfig) {
 		marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
@@ -229,40 +244,260 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	}
 }
 
+// getRoutesConfig returns the routes config used when registering the routes. While the authentication is disabled,
+// the routes having a role assigned are closed
+func (ws *webServer) getRoutesConfig() config.ApiRoutesConfig {
+	if ws.apiConfig.Authentication.Enabled {
+		return ws.apiConfig
+	}
+
+	routesConfig, _ := closeRestrictedRoutes(ws.apiConfig)
+
+	return routesConfig
+}
+
+// createEngine creates a gin engine holding the middlewares and the routes built from the current configs. The limiters
+// are created only once and then updated in place, so their counters are kept when the engine is rebuilt
+func (ws *webServer) createEngine() (*gin.Engine, error) {
+	engine := gin.Default()
+	engine.Use(cors.Default())
+
+	processors, err := ws.createMiddlewareLimiters()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, proc := range processors {
+		if check.IfNil(proc) {
+			log.Error("got nil middleware processor, skipping it...", "index", idx)
+			continue
+		}
+
+		engine.Use(proc.MiddlewareHandlerFunc())
+	}
+
+	ws.registerRoutes(engine)
+
+	return engine, nil
+}
+
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
 
+	// the streams stay open for as long as the clients are subscribed, so they are not traced as a single request
+	streamingRoutes := ws.getStreamingRoutes()
+	tracingMiddleware, err := middleware.NewSkippedRoutesMiddleware(middleware.NewTracingMiddleware(), streamingRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	middlewares = append(middlewares, tracingMiddleware)
+
 	if ws.apiConfig.Logging.LoggingEnabled {
 		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(ws.apiConfig.Logging.ThresholdInMicroSeconds) * time.Microsecond)
-		middlewares = append(middlewares, responseLoggerMiddleware)
+		skippingResponseLogger, errCreate := middleware.NewSkippedRoutesMiddleware(responseLoggerMiddleware, streamingRoutes)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		middlewares = append(middlewares, skippingResponseLogger)
 	}
 
 	if ws.antiFloodConfig.WebServerAntifloodEnabled {
-		sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
+		err = ws.updateLimiters(streamingRoutes)
 		if err != nil {
 			return nil, err
 		}
 
-		var ctx context.Context
-		ctx, ws.cancelFunc = context.WithCancel(context.Background())
-
-		go ws.sourceLimiterReset(ctx, sourceLimiter)
+		// the API keys are checked first, so the requests carrying an unknown key are rejected at the cost of a hash
+		// and the ones carrying a known key are only limited by its quotas, not by the limit of their source, which can
+		// be shared by several clients. The rest are throttled by source before the authentication, so the floods with
+		// invalid credentials are rejected cheaply
+		if !check.IfNil(ws.apiKeyLimiter) {
+			middlewares = append(middlewares, ws.apiKeyLimiter)
+		}
+		middlewares = append(middlewares, ws.sourceLimiter)
+	}
 
-		middlewares = append(middlewares, sourceLimiter)
+	authenticationMiddleware, err := ws.createAuthenticationMiddleware()
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(authenticationMiddleware) {
+		middlewares = append(middlewares, authenticationMiddleware)
+	}
 
-		globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
-		if err != nil {
-			return nil, err
-		}
+	if !ws.antiFloodConfig.WebServerAntifloodEnabled {
+		return middlewares, nil
+	}
 
-		middlewares = append(middlewares, globalLimiter)
+	skippingGlobalLimiter, err := middleware.NewSkippedRoutesMiddleware(ws.globalLimiter, streamingRoutes)
+	if err != nil {
+		return nil, err
 	}
 
+	middlewares = append(middlewares, skippingGlobalLimiter, ws.streamsLimiter)
+
+	ws.startSourceLimiterReset()
+
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
+// updateLimiters creates the limiters on the first call and afterwards only sets their new limits, so the requests
+// in progress and the counters accumulated until the reload are not lost
+func (ws *webServer) updateLimiters(streamingRoutes map[string]struct{}) error {
+	if check.IfNil(ws.sourceLimiter) {
+		return ws.createLimiters(streamingRoutes)
+	}
+
+	err := ws.sourceLimiter.SetMaxNumRequests(ws.antiFloodConfig.SameSourceRequests)
+	if err != nil {
+		return err
+	}
+
+	err = ws.globalLimiter.SetMaxNumRequests(ws.antiFloodConfig.SimultaneousRequests)
+	if err != nil {
+		return err
+	}
+
+	err = ws.streamsLimiter.SetLimits(ws.antiFloodConfig.SimultaneousStreams, streamingRoutes)
+	if err != nil {
+		return err
+	}
+
+	return ws.updateAPIKeyLimiter()
+}
+
+// updateAPIKeyLimiter creates the API key limiter when the keys get enabled and afterwards only sets the new keys and
+// quotas, so the buckets of the unchanged quotas keep their tokens
+func (ws *webServer) updateAPIKeyLimiter() error {
+	if !ws.antiFloodConfig.APIKeys.Enabled {
+		ws.apiKeyLimiter = nil
+		return nil
+	}
+
+	if !check.IfNil(ws.apiKeyLimiter) {
+		return ws.apiKeyLimiter.SetConfig(ws.antiFloodConfig.APIKeys)
+	}
+
+	apiKeyLimiter, err := middleware.NewAPIKeyThrottler(ws.antiFloodConfig.APIKeys)
+	if err != nil {
+		return err
+	}
+
+	ws.apiKeyLimiter = apiKeyLimiter
+
+	return nil
+}
+
+func (ws *webServer) createLimiters(streamingRoutes map[string]struct{}) error {
+	sourceLimiter, err := middleware.NewSourceThrottler(ws.antiFloodConfig.SameSourceRequests)
+	if err != nil {
+		return err
+	}
+
+	globalLimiter, err := middleware.NewGlobalThrottler(ws.antiFloodConfig.SimultaneousRequests)
+	if err != nil {
+		return err
+	}
+
+	streamsLimiter, err := middleware.NewStreamsThrottler(ws.antiFloodConfig.SimultaneousStreams, streamingRoutes)
+	if err != nil {
+		return err
+	}
+
+	ws.sourceLimiter = sourceLimiter
+	ws.globalLimiter = globalLimiter
+	ws.streamsLimiter = streamsLimiter
+
+	return ws.updateAPIKeyLimiter()
+}
+
+// restoreLimiters sets back the limits of the current config, after the engine could not be created with the new ones
+func (ws *webServer) restoreLimiters() {
+	if !ws.antiFloodConfig.WebServerAntifloodEnabled || check.IfNil(ws.sourceLimiter) {
+		return
+	}
+
+	err := ws.updateLimiters(ws.getStreamingRoutes())
+	if err != nil {
+		log.Error("could not restore the web server limiters", "error", err)
+	}
+}
+
+// startSourceLimiterReset starts the go routine resetting the source limiter, restarting it only if the reset interval
+// changed
+func (ws *webServer) startSourceLimiterReset() {
 	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+	if ws.cancelFunc != nil {
+		if ws.resetInterval == betweenResetDuration {
+			return
+		}
+
+		ws.cancelFunc()
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	ws.cancelFunc = cancelFunc
+	ws.resetInterval = betweenResetDuration
+	go ws.sourceLimiterReset(ctx, ws.sourceLimiter, betweenResetDuration)
+}
+
+// getStreamingRoutes returns the full paths of the routes keeping the connection open in order to push notifications
+func (ws *webServer) getStreamingRoutes() map[string]struct{} {
+	streamingRoutes := make(map[string]struct{})
+	for groupName, groupHandler := range ws.groups {
+		for _, endpoint := range groupHandler.GetEndpoints() {
+			if endpoint.IsStreaming {
+				streamingRoutes[fmt.Sprintf("/%s%s", groupName, endpoint.Path)] = struct{}{}
+			}
+		}
+	}
+
+	if isLogRouteEnabled(ws.getRoutesConfig()) {
+		// the log route is registered directly on the engine, not under its package group
+		streamingRoutes["/log"] = struct{}{}
+	}
+
+	return streamingRoutes
+}
+
+func (ws *webServer) createAuthenticationMiddleware() (shared.MiddlewareProcessor, error) {
+	routeRoles, err := createRouteRoles(ws.apiConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticationConfig := ws.apiConfig.Authentication
+	if !authenticationConfig.Enabled {
+		_, numClosedRoutes := closeRestrictedRoutes(ws.apiConfig)
+		if numClosedRoutes > 0 {
+			log.Warn("API routes have roles assigned but the authentication is disabled, these routes are closed",
+				"num closed routes", numClosedRoutes)
+		}
+
+		return nil, nil
+	}
+
+	if len(authenticationConfig.Tokens) > 0 && !authenticationConfig.TLS.Enabled {
+		log.Warn("API bearer tokens are accepted without TLS, the tokens are sent in clear text",
+			"num tokens", len(authenticationConfig.Tokens))
+	}
+
+	log.Debug("starting web server with authentication middleware",
+		"num tokens", len(authenticationConfig.Tokens),
+		"num client certificates", len(authenticationConfig.ClientCertificates),
+		"num restricted routes", len(routeRoles),
+	)
+
+	return middleware.NewAuthenticationMiddleware(middleware.ArgsAuthenticationMiddleware{
+		Tokens:             authenticationConfig.Tokens,
+		ClientCertificates: authenticationConfig.ClientCertificates,
+		RouteRoles:         routeRoles,
+	})
+}
+
+func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler, betweenResetDuration time.Duration) {
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
@@ -275,14 +510,108 @@ func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler)
 	}
 }
 
+// ValidateConfig checks that the reloaded web server antiflood limits and route roles can be used
+func (ws *webServer) ValidateConfig(configs *config.Configs) error {
+	_, err := createRouteRoles(*configs.ApiRoutesConfig)
+	if err != nil {
+		return err
+	}
+
+	return checkAntiFloodConfig(configs.GeneralConfig.WebServerAntiflood)
+}
+
+func checkAntiFloodConfig(antiFloodConfig config.WebServerAntifloodConfig) error {
+	if !antiFloodConfig.WebServerAntifloodEnabled {
+		return nil
+	}
+
+	if antiFloodConfig.SimultaneousRequests == 0 {
+		return fmt.Errorf("%w for SimultaneousRequests", middleware.ErrInvalidMaxNumRequests)
+	}
+	if antiFloodConfig.SameSourceRequests == 0 {
+		return fmt.Errorf("%w for SameSourceRequests", middleware.ErrInvalidMaxNumRequests)
+	}
+	if antiFloodConfig.SameSourceResetIntervalInSec == 0 {
+		return fmt.Errorf("%w, SameSourceResetIntervalInSec should be greater than 0", errors.ErrInvalidSourceLimiterResetInterval)
+	}
+	if antiFloodConfig.SimultaneousStreams == 0 {
+		return fmt.Errorf("%w for SimultaneousStreams", middleware.ErrInvalidMaxNumStreams)
+	}
+	if antiFloodConfig.APIKeys.Enabled {
+		return middleware.CheckAPIKeysConfig(antiFloodConfig.APIKeys)
+	}
+
+	return nil
+}
+
+// ApplyConfig sets the reloaded web server antiflood limits and API routes. If they changed, a new engine is built and
+// swapped in place of the running one, without restarting the http server
+func (ws *webServer) ApplyConfig(configs *config.Configs) error {
+	ws.Lock()
+	defer ws.Unlock()
+
+	antiFloodConfig := ws.antiFloodConfig
+	antiFloodConfig.SimultaneousRequests = configs.GeneralConfig.WebServerAntiflood.SimultaneousRequests
+	antiFloodConfig.SameSourceRequests = configs.GeneralConfig.WebServerAntiflood.SameSourceRequests
+	antiFloodConfig.SameSourceResetIntervalInSec = configs.GeneralConfig.WebServerAntiflood.SameSourceResetIntervalInSec
+	antiFloodConfig.SimultaneousStreams = configs.GeneralConfig.WebServerAntiflood.SimultaneousStreams
+	antiFloodConfig.APIKeys = configs.GeneralConfig.WebServerAntiflood.APIKeys
+
+	apiConfig := ws.apiConfig
+	apiConfig.APIPackages = configs.ApiRoutesConfig.APIPackages
+
+	isSameConfig := reflect.DeepEqual(antiFloodConfig, ws.antiFloodConfig) && reflect.DeepEqual(apiConfig.APIPackages, ws.apiConfig.APIPackages)
+	if isSameConfig {
+		return nil
+	}
+
+	// the limiters are updated in place, so the new limits and roles are checked before any of them is changed
+	err := checkAntiFloodConfig(antiFloodConfig)
+	if err != nil {
+		return err
+	}
+	_, err = createRouteRoles(apiConfig)
+	if err != nil {
+		return err
+	}
+
+	oldAntiFloodConfig, oldApiConfig := ws.antiFloodConfig, ws.apiConfig
+	ws.antiFloodConfig, ws.apiConfig = antiFloodConfig, apiConfig
+	if ws.handler == nil {
+		return nil
+	}
+
+	oldAPIKeyLimiter := ws.apiKeyLimiter
+	engine, err := ws.createEngine()
+	if err != nil {
+		ws.antiFloodConfig, ws.apiConfig = oldAntiFloodConfig, oldApiConfig
+		ws.apiKeyLimiter = oldAPIKeyLimiter
+		ws.restoreLimiters()
+
+		return err
+	}
+
+	ws.handler.setHandler(engine)
+
+	log.Info("web server reloaded",
+		"SimultaneousRequests", antiFloodConfig.SimultaneousRequests,
+		"SameSourceRequests", antiFloodConfig.SameSourceRequests,
+		"SameSourceResetIntervalInSec", antiFloodConfig.SameSourceResetIntervalInSec,
+		"SimultaneousStreams", antiFloodConfig.SimultaneousStreams,
+		"APIKeys", antiFloodConfig.APIKeys.Enabled,
+		"num API keys", len(antiFloodConfig.APIKeys.Keys),
+	)
+
+	return nil
+}
+
 // Close will handle the closing of inner components
 func (ws *webServer) Close() error {
+	var err error
+	ws.Lock()
 	if ws.cancelFunc != nil {
 		ws.cancelFunc()
 	}
-
-	var err error
-	ws.Lock()
 	if !check.IfNil(ws.httpServer) {
 		err = ws.httpServer.Close()
 	}
@@ -1,13 +1,17 @@
 package gin
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/mock"
@@ -19,6 +23,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func createMockAPIKeysConfig(name string, apiKey string, burst uint32) config.WebServerAPIKeysConfig {
+	apiKeyHash := sha256.Sum256([]byte(apiKey))
+	quotas := make([]config.WebServerAPIKeyQuotaConfig, 0)
+	for _, routeClass := range []string{middleware.RouteClassRead, middleware.RouteClassSendTx, middleware.RouteClassVmQuery, middleware.RouteClassSimulate} {
+		quotas = append(quotas, config.WebServerAPIKeyQuotaConfig{KeyName: name, RouteClass: routeClass, RequestsPerSecond: 1, Burst: burst})
+	}
+
+	return config.WebServerAPIKeysConfig{
+		Enabled:    true,
+		HeaderName: "X-Api-Key",
+		Keys:       []config.WebServerAPIKeyConfig{{Name: name, KeySHA256: hex.EncodeToString(apiKeyHash[:])}},
+		Quotas:     quotas,
+	}
+}
+
 func createMockArgsNewWebServer() ArgsNewWebServer {
 	return ArgsNewWebServer{
 		Facade: &mock.FacadeStub{
@@ -45,6 +64,7 @@ func createMockArgsNewWebServer() ArgsNewWebServer {
 			SimultaneousRequests:         1,
 			SameSourceRequests:           1,
 			SameSourceResetIntervalInSec: 1,
+			SimultaneousStreams:          1,
 		},
 	}
 }
@@ -96,6 +116,18 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		require.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
+	t.Run("createMiddlewareLimiters returns error due to middleware.NewAPIKeyThrottler error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.APIKeys = config.WebServerAPIKeysConfig{
+			Enabled:    true,
+			HeaderName: "",
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.True(t, errors.Is(err, middleware.ErrInvalidAPIKey))
+	})
 	t.Run("createMiddlewareLimiters returns error due to middleware.NewGlobalThrottler error", func(t *testing.T) {
 		args := createMockArgsNewWebServer()
 		args.AntiFloodConfig.SimultaneousRequests = 0
@@ -105,6 +137,15 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		require.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
+	t.Run("createMiddlewareLimiters returns error due to middleware.NewStreamsThrottler error", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.SimultaneousStreams = 0
+		ws, _ := NewGinWebServerHandler(args)
+		require.NotNil(t, ws)
+
+		err := ws.StartHttpServer()
+		require.Equal(t, middleware.ErrInvalidMaxNumStreams, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		ws, _ := NewGinWebServerHandler(createMockArgsNewWebServer())
 		require.NotNil(t, ws)
@@ -203,3 +244,500 @@ func TestWebServer_CloseWithDisabledServerShouldNotPanic(t *testing.T) {
 	err = ws.Close()
 	assert.Nil(t, err)
 }
+
+func createReloadedConfigs(args ArgsNewWebServer) *config.Configs {
+	return &config.Configs{
+		GeneralConfig: &config.Config{
+			WebServerAntiflood: args.AntiFloodConfig,
+		},
+		ApiRoutesConfig: &args.ApiConfig,
+	}
+}
+
+func TestWebServer_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewWebServer()
+	ws, _ := NewGinWebServerHandler(args)
+
+	require.Nil(t, ws.ValidateConfig(createReloadedConfigs(args)))
+
+	configs := createReloadedConfigs(args)
+	configs.GeneralConfig.WebServerAntiflood.SimultaneousRequests = 0
+	require.True(t, errors.Is(ws.ValidateConfig(configs), middleware.ErrInvalidMaxNumRequests))
+
+	configs = createReloadedConfigs(args)
+	configs.GeneralConfig.WebServerAntiflood.SameSourceRequests = 0
+	require.True(t, errors.Is(ws.ValidateConfig(configs), middleware.ErrInvalidMaxNumRequests))
+
+	configs = createReloadedConfigs(args)
+	configs.GeneralConfig.WebServerAntiflood.SameSourceResetIntervalInSec = 0
+	require.True(t, errors.Is(ws.ValidateConfig(configs), apiErrors.ErrInvalidSourceLimiterResetInterval))
+
+	configs = createReloadedConfigs(args)
+	configs.GeneralConfig.WebServerAntiflood.SimultaneousStreams = 0
+	require.True(t, errors.Is(ws.ValidateConfig(configs), middleware.ErrInvalidMaxNumStreams))
+
+	configs = createReloadedConfigs(args)
+	configs.GeneralConfig.WebServerAntiflood.APIKeys = createMockAPIKeysConfig("partner", "key", 1)
+	require.Nil(t, ws.ValidateConfig(configs))
+	configs.GeneralConfig.WebServerAntiflood.APIKeys.Quotas = configs.GeneralConfig.WebServerAntiflood.APIKeys.Quotas[1:]
+	require.True(t, errors.Is(ws.ValidateConfig(configs), middleware.ErrInvalidAPIKeyQuota))
+
+	configs.GeneralConfig.WebServerAntiflood.WebServerAntifloodEnabled = false
+	require.Nil(t, ws.ValidateConfig(configs))
+
+	configs = createReloadedConfigs(args)
+	configs.ApiRoutesConfig = &config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"node": {Routes: []config.RouteConfig{{Name: "/debug", Open: true, Role: "root"}}},
+		},
+	}
+	require.True(t, errors.Is(ws.ValidateConfig(configs), middleware.ErrInvalidRole))
+}
+
+func TestWebServer_GetStreamingRoutes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewWebServer()
+	ws, _ := NewGinWebServerHandler(args)
+	ws.groups = map[string]shared.GroupHandler{
+		"events": &api.GroupHandlerStub{
+			GetEndpointsCalled: func() []*shared.EndpointHandlerData {
+				return []*shared.EndpointHandlerData{
+					{Path: "/ws", IsStreaming: true},
+					{Path: "/sse", IsStreaming: true},
+				}
+			},
+		},
+		"node": &api.GroupHandlerStub{
+			GetEndpointsCalled: func() []*shared.EndpointHandlerData {
+				return []*shared.EndpointHandlerData{{Path: "/status"}}
+			},
+		},
+	}
+
+	expectedRoutes := map[string]struct{}{
+		"/events/ws":  {},
+		"/events/sse": {},
+		"/log":        {},
+	}
+	require.Equal(t, expectedRoutes, ws.getStreamingRoutes())
+}
+
+func TestWebServer_Authentication(t *testing.T) {
+	t.Parallel()
+
+	adminToken := "admin token"
+	adminTokenHash := sha256.Sum256([]byte(adminToken))
+
+	args := createMockArgsNewWebServer()
+	args.AntiFloodConfig.WebServerAntifloodEnabled = false
+	args.ApiConfig.Authentication = config.ApiAuthenticationConfig{
+		Enabled: true,
+		Tokens: []config.ApiTokenConfig{
+			{Name: "admin", Role: "admin", TokenSHA256: hex.EncodeToString(adminTokenHash[:])},
+		},
+	}
+	args.ApiConfig.APIPackages = map[string]config.APIPackageConfig{
+		"group": {Routes: []config.RouteConfig{
+			{Name: "/public", Open: true},
+			{Name: "/restricted", Open: true, Role: "admin"},
+		}},
+	}
+	ws, _ := NewGinWebServerHandler(args)
+	ws.groups = map[string]shared.GroupHandler{
+		"group": &api.GroupHandlerStub{
+			RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+				for _, route := range apiConfig.APIPackages["group"].Routes {
+					ws.GET(route.Name, func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				}
+			},
+		},
+	}
+	engine, err := ws.createEngine()
+	require.Nil(t, err)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/public", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/restricted", nil))
+	require.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/group/restricted", nil)
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	engine.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestWebServer_AuthenticationDisabledShouldCloseRestrictedRoutes(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsNewWebServer()
+	args.AntiFloodConfig.WebServerAntifloodEnabled = false
+	args.ApiConfig.APIPackages = map[string]config.APIPackageConfig{
+		"group": {Routes: []config.RouteConfig{
+			{Name: "/public", Open: true},
+			{Name: "/restricted", Open: true, Role: "admin"},
+		}},
+		"log": {Routes: []config.RouteConfig{{Name: "/log", Open: true, Role: "operator"}}},
+	}
+	ws, _ := NewGinWebServerHandler(args)
+	ws.groups = map[string]shared.GroupHandler{
+		"group": &api.GroupHandlerStub{
+			RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+				for _, route := range apiConfig.APIPackages["group"].Routes {
+					if route.Open {
+						ws.GET(route.Name, func(c *gin.Context) {
+							c.Status(http.StatusOK)
+						})
+					}
+				}
+			},
+		},
+	}
+	engine, err := ws.createEngine()
+	require.Nil(t, err)
+	require.Empty(t, ws.getStreamingRoutes())
+
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/public", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	engine.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/restricted", nil))
+	require.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestWebServer_SourceThrottlingShouldApplyBeforeAuthentication(t *testing.T) {
+	t.Parallel()
+
+	adminTokenHash := sha256.Sum256([]byte("admin token"))
+	apiKey := "partner key"
+
+	args := createMockArgsNewWebServer()
+	args.AntiFloodConfig.SimultaneousRequests = 10
+	args.AntiFloodConfig.SameSourceRequests = 2
+	args.AntiFloodConfig.SameSourceResetIntervalInSec = 100
+	args.AntiFloodConfig.APIKeys = createMockAPIKeysConfig("partner", apiKey, 10)
+	args.ApiConfig.Authentication = config.ApiAuthenticationConfig{
+		Enabled: true,
+		Tokens:  []config.ApiTokenConfig{{Name: "admin", Role: "admin", TokenSHA256: hex.EncodeToString(adminTokenHash[:])}},
+	}
+	args.ApiConfig.APIPackages = map[string]config.APIPackageConfig{
+		"group": {Routes: []config.RouteConfig{
+			{Name: "/public", Open: true},
+			{Name: "/restricted", Open: true, Role: "admin"},
+		}},
+	}
+	ws, _ := NewGinWebServerHandler(args)
+	ws.groups = map[string]shared.GroupHandler{
+		"group": &api.GroupHandlerStub{
+			RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+				for _, route := range apiConfig.APIPackages["group"].Routes {
+					ws.GET(route.Name, func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				}
+			},
+		},
+	}
+	engine, err := ws.createEngine()
+	require.Nil(t, err)
+	defer func() {
+		_ = ws.Close()
+	}()
+
+	serve := func(path string, header string, value string) int {
+		resp := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if len(header) > 0 {
+			req.Header.Set(header, value)
+		}
+		engine.ServeHTTP(resp, req)
+
+		return resp.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, serve("/group/restricted", "Authorization", "Bearer invalid token"))
+	// the unknown keys are rejected before the source limiter, while the known ones are only limited by their quotas
+	require.Equal(t, http.StatusUnauthorized, serve("/group/public", "X-Api-Key", "unknown key"))
+	require.Equal(t, http.StatusOK, serve("/group/public", "X-Api-Key", apiKey))
+	require.Equal(t, http.StatusOK, serve("/group/public", "X-Api-Key", apiKey))
+	require.Equal(t, http.StatusUnauthorized, serve("/group/restricted", "Authorization", "Bearer invalid token"))
+	require.Equal(t, http.StatusTooManyRequests, serve("/group/restricted", "Authorization", "Bearer invalid token"))
+	require.Equal(t, http.StatusOK, serve("/group/public", "X-Api-Key", apiKey))
+}
+
+func TestWebServer_ApplyConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("server not started should only store the values", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		ws, _ := NewGinWebServerHandler(args)
+
+		configs := createReloadedConfigs(args)
+		configs.GeneralConfig.WebServerAntiflood.SimultaneousRequests = 37
+		configs.GeneralConfig.WebServerAntiflood.WebServerAntifloodEnabled = false
+		err := ws.ApplyConfig(configs)
+		require.Nil(t, err)
+		require.Equal(t, uint32(37), ws.antiFloodConfig.SimultaneousRequests)
+		require.True(t, ws.antiFloodConfig.WebServerAntifloodEnabled)
+	})
+	t.Run("should swap the running engine", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.WebServerAntifloodEnabled = false
+		args.ApiConfig.APIPackages = map[string]config.APIPackageConfig{
+			"group": {Routes: []config.RouteConfig{{Name: "/route", Open: false}}},
+		}
+		ws, _ := NewGinWebServerHandler(args)
+		ws.groups = map[string]shared.GroupHandler{
+			"group": &api.GroupHandlerStub{
+				RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+					if apiConfig.APIPackages["group"].Routes[0].Open {
+						ws.GET("/route", func(c *gin.Context) {
+							c.Status(http.StatusOK)
+						})
+					}
+				},
+			},
+		}
+		engine, err := ws.createEngine()
+		require.Nil(t, err)
+		ws.handler = &swappableHandler{handler: engine}
+
+		resp := httptest.NewRecorder()
+		ws.handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/route", nil))
+		require.Equal(t, http.StatusNotFound, resp.Code)
+
+		configs := createReloadedConfigs(args)
+		configs.ApiRoutesConfig = &config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"group": {Routes: []config.RouteConfig{{Name: "/route", Open: true}}},
+			},
+		}
+		err = ws.ApplyConfig(configs)
+		require.Nil(t, err)
+
+		resp = httptest.NewRecorder()
+		ws.handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/route", nil))
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("engine creation error should keep the previous values", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		ws, _ := NewGinWebServerHandler(args)
+		ws.groups = make(map[string]shared.GroupHandler)
+		ws.handler = &swappableHandler{}
+
+		configs := createReloadedConfigs(args)
+		configs.GeneralConfig.WebServerAntiflood.SameSourceRequests = 0
+		err := ws.ApplyConfig(configs)
+		require.True(t, errors.Is(err, middleware.ErrInvalidMaxNumRequests))
+		require.Equal(t, args.AntiFloodConfig.SameSourceRequests, ws.antiFloodConfig.SameSourceRequests)
+	})
+	t.Run("invalid role should error before changing the limiters", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		ws, _ := NewGinWebServerHandler(args)
+		ws.groups = make(map[string]shared.GroupHandler)
+		engine, err := ws.createEngine()
+		require.Nil(t, err)
+		defer func() {
+			_ = ws.Close()
+		}()
+		ws.handler = &swappableHandler{handler: engine}
+		ws.streamsLimiter = &mock.StreamsLimiterStub{
+			SetLimitsCalled: func(maxStreams uint32, streamingRoutes map[string]struct{}) error {
+				require.Fail(t, "should have not changed the limiters")
+				return nil
+			},
+		}
+
+		configs := createReloadedConfigs(args)
+		configs.GeneralConfig.WebServerAntiflood.SimultaneousStreams = 5
+		configs.ApiRoutesConfig = &config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"node": {Routes: []config.RouteConfig{{Name: "/debug", Open: true, Role: "root"}}},
+			},
+		}
+		err = ws.ApplyConfig(configs)
+		require.True(t, errors.Is(err, middleware.ErrInvalidRole))
+	})
+	t.Run("limiter update error should restore the previous limits", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.SimultaneousRequests = 10
+		args.AntiFloodConfig.SameSourceRequests = 1
+		args.AntiFloodConfig.SameSourceResetIntervalInSec = 100
+		ws, _ := NewGinWebServerHandler(args)
+		ws.groups = map[string]shared.GroupHandler{
+			"group": &api.GroupHandlerStub{
+				RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+					ws.GET("/route", func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				},
+			},
+		}
+		engine, err := ws.createEngine()
+		require.Nil(t, err)
+		defer func() {
+			_ = ws.Close()
+		}()
+		ws.handler = &swappableHandler{handler: engine}
+		expectedErr := errors.New("expected error")
+		ws.streamsLimiter = &mock.StreamsLimiterStub{
+			SetLimitsCalled: func(maxStreams uint32, streamingRoutes map[string]struct{}) error {
+				if maxStreams == 5 {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+
+		configs := createReloadedConfigs(args)
+		configs.GeneralConfig.WebServerAntiflood.SameSourceRequests = 4
+		configs.GeneralConfig.WebServerAntiflood.SimultaneousStreams = 5
+		err = ws.ApplyConfig(configs)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, args.AntiFloodConfig, ws.antiFloodConfig)
+
+		// the running engine still applies the previous source limit
+		serve := func() int {
+			resp := httptest.NewRecorder()
+			ws.handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/route", nil))
+
+			return resp.Code
+		}
+		require.Equal(t, http.StatusOK, serve())
+		require.Equal(t, http.StatusTooManyRequests, serve())
+	})
+	t.Run("should keep the limiters state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.SimultaneousRequests = 10
+		args.AntiFloodConfig.SameSourceRequests = 2
+		args.AntiFloodConfig.SameSourceResetIntervalInSec = 100
+		ws, _ := NewGinWebServerHandler(args)
+		ws.groups = map[string]shared.GroupHandler{
+			"group": &api.GroupHandlerStub{
+				RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+					ws.GET("/route", func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				},
+			},
+		}
+		engine, err := ws.createEngine()
+		require.Nil(t, err)
+		defer func() {
+			_ = ws.Close()
+		}()
+		ws.handler = &swappableHandler{handler: engine}
+		sourceLimiter := ws.sourceLimiter
+
+		serve := func() int {
+			resp := httptest.NewRecorder()
+			ws.handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/group/route", nil))
+
+			return resp.Code
+		}
+		require.Equal(t, http.StatusOK, serve())
+		require.Equal(t, http.StatusOK, serve())
+		require.Equal(t, http.StatusTooManyRequests, serve())
+
+		configs := createReloadedConfigs(args)
+		configs.GeneralConfig.WebServerAntiflood.SameSourceRequests = 4
+		err = ws.ApplyConfig(configs)
+		require.Nil(t, err)
+		require.True(t, sourceLimiter == ws.sourceLimiter)
+		require.Equal(t, 100*time.Second, ws.resetInterval)
+
+		// the 3 requests counted before the reload still count against the new limit
+		require.Equal(t, http.StatusOK, serve())
+		require.Equal(t, http.StatusTooManyRequests, serve())
+
+		configs.GeneralConfig.WebServerAntiflood.SameSourceResetIntervalInSec = 200
+		err = ws.ApplyConfig(configs)
+		require.Nil(t, err)
+		require.True(t, sourceLimiter == ws.sourceLimiter)
+		require.Equal(t, 200*time.Second, ws.resetInterval)
+	})
+	t.Run("should apply the changed API keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.AntiFloodConfig.SimultaneousRequests = 10
+		args.AntiFloodConfig.SameSourceRequests = 10
+		args.AntiFloodConfig.SameSourceResetIntervalInSec = 100
+		args.AntiFloodConfig.APIKeys = createMockAPIKeysConfig("partner-a", "key a", 1)
+		ws, _ := NewGinWebServerHandler(args)
+		ws.groups = map[string]shared.GroupHandler{
+			"group": &api.GroupHandlerStub{
+				RegisterRoutesCalled: func(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+					ws.GET("/route", func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				},
+			},
+		}
+		engine, err := ws.createEngine()
+		require.Nil(t, err)
+		defer func() {
+			_ = ws.Close()
+		}()
+		ws.handler = &swappableHandler{handler: engine}
+
+		serve := func(apiKey string) int {
+			resp := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/group/route", nil)
+			req.Header.Set("X-Api-Key", apiKey)
+			ws.handler.ServeHTTP(resp, req)
+
+			return resp.Code
+		}
+		require.Equal(t, http.StatusOK, serve("key a"))
+		require.Equal(t, http.StatusTooManyRequests, serve("key a"))
+		require.Equal(t, http.StatusUnauthorized, serve("key b"))
+
+		configs := createReloadedConfigs(args)
+		apiKeysConfig := createMockAPIKeysConfig("partner-b", "key b", 1)
+		configs.GeneralConfig.WebServerAntiflood.APIKeys.Keys = append(configs.GeneralConfig.WebServerAntiflood.APIKeys.Keys, apiKeysConfig.Keys...)
+		configs.GeneralConfig.WebServerAntiflood.APIKeys.Quotas = append(configs.GeneralConfig.WebServerAntiflood.APIKeys.Quotas, apiKeysConfig.Quotas...)
+		err = ws.ApplyConfig(configs)
+		require.Nil(t, err)
+
+		// the bucket of the unchanged key keeps its state
+		require.Equal(t, http.StatusTooManyRequests, serve("key a"))
+		require.Equal(t, http.StatusOK, serve("key b"))
+
+		configs.GeneralConfig.WebServerAntiflood.APIKeys.Quotas = configs.GeneralConfig.WebServerAntiflood.APIKeys.Quotas[1:]
+		err = ws.ApplyConfig(configs)
+		require.True(t, errors.Is(err, middleware.ErrInvalidAPIKeyQuota))
+
+		configs = createReloadedConfigs(args)
+		configs.GeneralConfig.WebServerAntiflood.APIKeys.Enabled = false
+		err = ws.ApplyConfig(configs)
+		require.Nil(t, err)
+		require.Nil(t, ws.apiKeyLimiter)
+		require.Equal(t, http.StatusOK, serve("key b"))
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -32,12 +33,17 @@ const (
 	getRegisteredNFTsPath          = "/:address/registered-nfts"
 	getESDTNFTDataPath             = "/:address/nft/:tokenIdentifier/nonce/:nonce"
 	getGuardianData                = "/:address/guardian-data"
+	getTransactionsPath            = "/:address/transactions"
 	urlParamOnFinalBlock           = "onFinalBlock"
 	urlParamOnStartOfEpoch         = "onStartOfEpoch"
 	urlParamBlockNonce             = "blockNonce"
 	urlParamBlockHash              = "blockHash"
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
+	urlParamFrom                   = "from"
+	urlParamSize                   = "size"
+	defaultAddressTransactionsSize = 25
+	maxAddressTransactionsSize     = 100
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -56,6 +62,7 @@ type addressFacadeHandler interface {
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -171,6 +178,11 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.isDataTrieMigrated,
 		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+		},
 	}
 	ag.endpoints = endpoints
 
@@ -472,6 +484,29 @@ func (ag *addressGroup) isDataTrieMigrated(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"isMigrated": isMigrated})
 }
 
+// getTransactions returns the indexed transactions in which the given address was involved, newest first
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, errors.ErrEmptyAddress)
+		return
+	}
+
+	from, size, err := extractPagination(c, defaultAddressTransactionsSize, maxAddressTransactionsSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	response, err := ag.getFacade().GetTransactionsForAddress(addr, from, size)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAddressTransactions, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"transactions": response.Transactions, "total": response.Total})
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,6 +100,17 @@ type guardianDataResponse struct {
 	Code  string                   `json:"code"`
 }
 
+type addressTransactionsResponseData struct {
+	Transactions []common.AddressTransactionAPIResponse `json:"transactions"`
+	Total        uint64                                 `json:"total"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
 type esdtNFTResponse struct {
 	Data  esdtNFTResponseData `json:"data"`
 	Error string              `json:"error"`
@@ -609,6 +621,105 @@ func TestAddressGroup_getGuardianData(t *testing.T) {
 	})
 }
 
+func TestAddressGroup_getTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid from should error",
+		testErrorScenario("/address/erd1alice/transactions?from=not-uint64", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid size should error",
+		testErrorScenario("/address/erd1alice/transactions?size=not-uint64", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("zero size should error",
+		testErrorScenario("/address/erd1alice/transactions?size=0", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("size too large should error",
+		testErrorScenario("/address/erd1alice/transactions?size=101", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, apiErrors.ErrBadUrlParams)))
+	t.Run("with node fail should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/erd1alice/transactions",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAddressTransactions, expectedErr),
+		)
+	})
+	t.Run("should use default pagination", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, uint64(0), from)
+				assert.Equal(t, uint64(25), size)
+
+				return &common.AddressTransactionsAPIResponse{Address: address}, nil
+			},
+		}
+
+		response := &addressTransactionsResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/erd1alice/transactions",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Data.Transactions)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTransactions := []common.AddressTransactionAPIResponse{
+			{
+				Hash:          "aa",
+				MiniBlockType: "TxBlock",
+				IsSender:      true,
+				Epoch:         1,
+				Round:         12,
+				BlockNonce:    10,
+				BlockHash:     "bb",
+				Timestamp:     1234,
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsForAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+				assert.Equal(t, uint64(5), from)
+				assert.Equal(t, uint64(10), size)
+
+				return &common.AddressTransactionsAPIResponse{
+					Address:      address,
+					Total:        7,
+					Transactions: expectedTransactions,
+				}, nil
+			},
+		}
+
+		response := &addressTransactionsResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/erd1alice/transactions?from=5&size=10",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedTransactions, response.Data.Transactions)
+		assert.Equal(t, uint64(7), response.Data.Total)
+	})
+}
+
 func TestAddressGroup_getKeyValuePairs(t *testing.T) {
 	t.Parallel()
 
@@ -1151,6 +1262,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
 					{Name: "/:address/is-data-trie-migrated", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -23,9 +24,19 @@ const (
 	getBlockByRoundPath       = "/by-round/:round"
 	getAlteredAccountsByNonce = "/altered-accounts/by-nonce/:nonce"
 	getAlteredAccountsByHash  = "/altered-accounts/by-hash/:hash"
+	getStateDiffPath          = "/state-diff"
 	urlParamTokensFilter      = "tokens"
 	urlParamWithTxs           = "withTxs"
 	urlParamWithLogs          = "withLogs"
+	urlParamOldBlockHash      = "oldBlockHash"
+	urlParamNewBlockHash      = "newBlockHash"
+	urlParamWithDataTries     = "withDataTries"
+	urlParamCursor            = "cursor"
+	urlParamMaxKeysPerAccount = "maxKeysPerAccount"
+	defaultStateDiffSize      = 25
+	maxStateDiffSize          = 100
+	defaultMaxKeysPerAccount  = 100
+	maxMaxKeysPerAccount      = 1000
 )
 
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
@@ -34,6 +45,7 @@ type blockFacadeHandler interface {
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetAlteredAccountsForBlock(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -80,6 +92,11 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByHash,
 		},
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: bg.getStateDiff,
+		},
 	}
 	bg.endpoints = endpoints
 
@@ -103,7 +120,7 @@ func (bg *blockGroup) getBlockByNonce(c *gin.Context) {
 	block, err := bg.getFacade().GetBlockByNonce(nonce, options)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetBlockByNonce")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -127,7 +144,7 @@ func (bg *blockGroup) getBlockByHash(c *gin.Context) {
 	block, err := bg.getFacade().GetBlockByHash(hash, options)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetBlockByHash")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -151,7 +168,7 @@ func (bg *blockGroup) getBlockByRound(c *gin.Context) {
 	block, err := bg.getFacade().GetBlockByRound(round, options)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetBlockByRound")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -208,6 +225,62 @@ func (bg *blockGroup) getAlteredAccountsByHash(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"accounts": alteredAccountsResponse})
 }
 
+// getStateDiff returns a page of the accounts added, removed or modified between the states committed by two blocks
+func (bg *blockGroup) getStateDiff(c *gin.Context) {
+	request, err := parseStateDiffRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	start := time.Now()
+	stateDiff, err := bg.getFacade().GetStateDiff(request)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetStateDiff")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"stateDiff": stateDiff})
+}
+
+func parseStateDiffRequest(c *gin.Context) (common.StateDiffRequest, error) {
+	oldBlockHash := c.Request.URL.Query().Get(urlParamOldBlockHash)
+	if oldBlockHash == "" {
+		return common.StateDiffRequest{}, errors.ErrValidationEmptyBlockHash
+	}
+
+	withDataTries, err := parseBoolUrlParam(c, urlParamWithDataTries)
+	if err != nil {
+		return common.StateDiffRequest{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	cursor := c.Request.URL.Query().Get(urlParamCursor)
+	_, err = parseHexBytesUrlParam(c, urlParamCursor)
+	if err != nil {
+		return common.StateDiffRequest{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	size, err := extractBoundedUint64UrlParam(c, urlParamSize, defaultStateDiffSize, maxStateDiffSize)
+	if err != nil {
+		return common.StateDiffRequest{}, err
+	}
+
+	maxKeysPerAccount, err := extractBoundedUint64UrlParam(c, urlParamMaxKeysPerAccount, defaultMaxKeysPerAccount, maxMaxKeysPerAccount)
+	if err != nil {
+		return common.StateDiffRequest{}, err
+	}
+
+	return common.StateDiffRequest{
+		OldBlockHash:      oldBlockHash,
+		NewBlockHash:      c.Request.URL.Query().Get(urlParamNewBlockHash),
+		Cursor:            cursor,
+		Size:              size,
+		WithDataTries:     withDataTries,
+		MaxKeysPerAccount: maxKeysPerAccount,
+	}, nil
+}
+
 func parseBlockQueryOptions(c *gin.Context) (api.BlockQueryOptions, error) {
 	withTxs, err := parseBoolUrlParam(c, urlParamWithTxs)
 	if err != nil {
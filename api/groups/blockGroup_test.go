@@ -16,7 +16,9 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,6 +47,14 @@ type alteredAccountsForBlockResponse struct {
 	Code  string `json:"code"`
 }
 
+type stateDiffResponse struct {
+	Data struct {
+		StateDiff *common.StateDiffAPIResponse `json:"stateDiff"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type blockResponseData struct {
 	Block api.Block `json:"block"`
 }
@@ -86,6 +96,30 @@ func TestBlockGroup_getBlockByNonce(t *testing.T) {
 			formatExpectedErr(apiErrors.ErrGetBlock, expectedErr),
 		)
 	})
+	t.Run("pruned block should error with dedicated code", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetBlockByNonceCalled: func(_ uint64, _ api.BlockQueryOptions) (*api.Block, error) {
+				return nil, fmt.Errorf("%w: epoch 2 in MiniBlocks", storage.ErrDataPruned)
+			},
+		}
+
+		blockGroup, err := groups.NewBlockGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/block/by-nonce/10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := blockResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusGone, resp.Code)
+		assert.Equal(t, string(shared.ReturnCodeDataPruned), response.Code)
+		assert.Contains(t, response.Error, storage.ErrDataPruned.Error())
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -374,6 +408,112 @@ func TestBlockGroup_getAlteredAccountsByHash(t *testing.T) {
 	})
 }
 
+func TestBlockGroup_getStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty old block hash should error",
+		testBlockGroupErrorScenario("/block/state-diff?newBlockHash=abcd", nil,
+			formatExpectedErr(apiErrors.ErrGetStateDiff, apiErrors.ErrValidationEmptyBlockHash)))
+	t.Run("invalid data tries option should error",
+		testBlockGroupErrorScenario("/block/state-diff?oldBlockHash=abcd&withDataTries=not-bool", nil,
+			apiErrors.ErrBadUrlParams.Error()))
+	t.Run("invalid cursor should error",
+		testBlockGroupErrorScenario("/block/state-diff?oldBlockHash=abcd&cursor=not-hex", nil,
+			apiErrors.ErrBadUrlParams.Error()))
+	t.Run("too big size should error",
+		testBlockGroupErrorScenario("/block/state-diff?oldBlockHash=abcd&size=101", nil,
+			apiErrors.ErrBadUrlParams.Error()))
+	t.Run("zero max keys per account should error",
+		testBlockGroupErrorScenario("/block/state-diff?oldBlockHash=abcd&maxKeysPerAccount=0", nil,
+			apiErrors.ErrBadUrlParams.Error()))
+	t.Run("too big max keys per account should error",
+		testBlockGroupErrorScenario("/block/state-diff?oldBlockHash=abcd&maxKeysPerAccount=1001", nil,
+			apiErrors.ErrBadUrlParams.Error()))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testBlockGroup(
+			t,
+			facade,
+			"/block/state-diff?oldBlockHash=abcd",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetStateDiff, expectedErr),
+		)
+	})
+	t.Run("should use the default page size and max keys per account", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+				require.Equal(t, common.StateDiffRequest{OldBlockHash: "abcd", Size: 25, MaxKeysPerAccount: 100}, request)
+				return &common.StateDiffAPIResponse{}, nil
+			},
+		}
+
+		response := &stateDiffResponse{}
+		loadBlockGroupResponse(t, facade, "/block/state-diff?oldBlockHash=abcd", "GET", nil, response)
+		require.Empty(t, response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRequest := common.StateDiffRequest{
+			OldBlockHash:      "abcd",
+			NewBlockHash:      "ef01",
+			Cursor:            "0a0b",
+			Size:              5,
+			WithDataTries:     true,
+			MaxKeysPerAccount: 20,
+		}
+		expectedResponse := &common.StateDiffAPIResponse{
+			OldBlockHash: "abcd",
+			OldRootHash:  "aa",
+			NewBlockHash: "ef01",
+			NewRootHash:  "bb",
+			Accounts: []*common.StateDiffAccountAPIResponse{
+				{
+					Address: "erd1alice",
+					Status:  "modified",
+					Old:     &common.StateDiffAccountStateAPIResponse{Nonce: 1, Balance: "10"},
+					New:     &common.StateDiffAccountStateAPIResponse{Nonce: 2, Balance: "5"},
+					Keys: []*common.StateDiffKeyAPIResponse{
+						{Key: "6b6579", Status: "added", NewValue: "76616c7565"},
+					},
+					HasMoreKeys: true,
+				},
+			},
+			HasMore:    true,
+			NextCursor: "0c0d",
+		}
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+				require.Equal(t, expectedRequest, request)
+				return expectedResponse, nil
+			},
+		}
+
+		response := &stateDiffResponse{}
+		loadBlockGroupResponse(
+			t,
+			facade,
+			"/block/state-diff?oldBlockHash=abcd&newBlockHash=ef01&withDataTries=true&cursor=0a0b&size=5&maxKeysPerAccount=20",
+			"GET",
+			nil,
+			response,
+		)
+		require.Equal(t, expectedResponse, response.Data.StateDiff)
+		require.Empty(t, response.Error)
+		require.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	})
+}
+
 func TestBlockGroup_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -521,6 +661,7 @@ func getBlockRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/by-round/:round", Open: true},
 					{Name: "/altered-accounts/by-nonce/:nonce", Open: true},
 					{Name: "/altered-accounts/by-hash/:hash", Open: true},
+					{Name: "/state-diff", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,233 @@
+package groups
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	subscribeWebSocketPath        = "/ws"
+	subscribeServerSentEventsPath = "/sse"
+
+	urlParamEventsAddress    = "address"
+	urlParamEventsIdentifier = "identifier"
+	urlParamEventsTopic      = "topic"
+	urlParamEventsToken      = "token"
+
+	eventsWriteTimeout = 10 * time.Second
+	sseCloseEvent      = "close"
+)
+
+// eventsFacadeHandler defines the methods to be implemented by a facade for handling events subscriptions
+type eventsFacadeHandler interface {
+	SubscribeEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	UnsubscribeEvents(subscriptionID uint64)
+	IsInterfaceNil() bool
+}
+
+type eventsGroup struct {
+	*baseGroup
+	facade            eventsFacadeHandler
+	mutFacade         sync.RWMutex
+	upgrader          websocket.Upgrader
+	mutAllowedOrigins sync.RWMutex
+	allowedOrigins    map[string]struct{}
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facade eventsFacadeHandler) (*eventsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for events group", errors.ErrNilFacadeHandler)
+	}
+
+	eg := &eventsGroup{
+		facade:         facade,
+		baseGroup:      &baseGroup{},
+		allowedOrigins: make(map[string]struct{}),
+	}
+	eg.upgrader = websocket.Upgrader{
+		CheckOrigin: eg.checkOrigin,
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:        subscribeWebSocketPath,
+			Method:      http.MethodGet,
+			Handler:     eg.subscribeWebSocket,
+			IsStreaming: true,
+		},
+		{
+			Path:        subscribeServerSentEventsPath,
+			Method:      http.MethodGet,
+			Handler:     eg.subscribeServerSentEvents,
+			IsStreaming: true,
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+// RegisterRoutes registers the endpoints of the group and sets the origins allowed to open a websocket connection
+func (eg *eventsGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	allowedOrigins := make(map[string]struct{}, len(apiConfig.WebSocket.AllowedOrigins))
+	for _, origin := range apiConfig.WebSocket.AllowedOrigins {
+		allowedOrigins[normalizeOrigin(origin)] = struct{}{}
+	}
+
+	eg.mutAllowedOrigins.Lock()
+	eg.allowedOrigins = allowedOrigins
+	eg.mutAllowedOrigins.Unlock()
+
+	eg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+// checkOrigin accepts the websocket connections opened by the pages served from the same host as the API or from one
+// of the allowed origins. The clients not sending an Origin header are not browsers, so they are accepted
+func (eg *eventsGroup) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if len(origin) == 0 {
+		return true
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(originURL.Host, r.Host) {
+		return true
+	}
+
+	eg.mutAllowedOrigins.RLock()
+	_, isAllowed := eg.allowedOrigins[normalizeOrigin(origin)]
+	eg.mutAllowedOrigins.RUnlock()
+
+	return isAllowed
+}
+
+func normalizeOrigin(origin string) string {
+	return strings.ToLower(strings.TrimSuffix(origin, "/"))
+}
+
+// subscribeWebSocket upgrades the connection to a websocket and pushes the matching events as JSON messages
+func (eg *eventsGroup) subscribeWebSocket(c *gin.Context) {
+	subscription, err := eg.getFacade().SubscribeEvents(parseEventsFilter(c))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrEventsSubscription, err)
+		return
+	}
+	defer eg.getFacade().UnsubscribeEvents(subscription.ID())
+
+	conn, err := eg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("eventsGroup.subscribeWebSocket: cannot upgrade connection", "error", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	chanClientGone := make(chan struct{})
+	go func() {
+		// the client is not expected to send data, reading is required only to process the control messages
+		defer close(chanClientGone)
+		for {
+			_, _, errRead := conn.ReadMessage()
+			if errRead != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case notification := <-subscription.Notifications():
+			_ = conn.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+			err = conn.WriteJSON(notification)
+			if err != nil {
+				log.Debug("eventsGroup.subscribeWebSocket: cannot write notification", "subscription", subscription.ID(), "error", err)
+				return
+			}
+		case <-subscription.Done():
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, subscription.Err().Error())
+			_ = conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(eventsWriteTimeout))
+			return
+		case <-chanClientGone:
+			return
+		}
+	}
+}
+
+// subscribeServerSentEvents streams the matching events as server-sent events, the event name being the notification type
+func (eg *eventsGroup) subscribeServerSentEvents(c *gin.Context) {
+	subscription, err := eg.getFacade().SubscribeEvents(parseEventsFilter(c))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrEventsSubscription, err)
+		return
+	}
+	defer eg.getFacade().UnsubscribeEvents(subscription.ID())
+
+	c.Stream(func(_ io.Writer) bool {
+		select {
+		case notification := <-subscription.Notifications():
+			c.SSEvent(notification.Type, notification)
+			return true
+		case <-subscription.Done():
+			c.SSEvent(sseCloseEvent, subscription.Err().Error())
+			return false
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func parseEventsFilter(c *gin.Context) common.EventsSubscriptionFilter {
+	return common.EventsSubscriptionFilter{
+		Addresses:   c.QueryArray(urlParamEventsAddress),
+		Identifiers: c.QueryArray(urlParamEventsIdentifier),
+		Topics:      c.QueryArray(urlParamEventsTopic),
+		Tokens:      c.QueryArray(urlParamEventsToken),
+	}
+}
+
+func (eg *eventsGroup) getFacade() eventsFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *eventsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(eventsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *eventsGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
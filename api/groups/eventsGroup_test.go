@@ -0,0 +1,313 @@
+package groups_test
+
+import (
+	"bufio"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEventsSubscription struct {
+	chanNotifications chan *common.EventsNotification
+	chanDone          chan struct{}
+	closeOnce         sync.Once
+	err               error
+}
+
+func newTestEventsSubscription() *testEventsSubscription {
+	return &testEventsSubscription{
+		chanNotifications: make(chan *common.EventsNotification, 10),
+		chanDone:          make(chan struct{}),
+	}
+}
+
+func (sub *testEventsSubscription) terminate(err error) {
+	sub.closeOnce.Do(func() {
+		sub.err = err
+		close(sub.chanDone)
+	})
+}
+
+func (sub *testEventsSubscription) ID() uint64 {
+	return 37
+}
+
+func (sub *testEventsSubscription) Notifications() <-chan *common.EventsNotification {
+	return sub.chanNotifications
+}
+
+func (sub *testEventsSubscription) Done() <-chan struct{} {
+	return sub.chanDone
+}
+
+func (sub *testEventsSubscription) Err() error {
+	return sub.err
+}
+
+func createEventsFacade(subscription *testEventsSubscription, chanUnsubscribed chan uint64) *mock.FacadeStub {
+	return &mock.FacadeStub{
+		SubscribeEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+			return subscription, nil
+		},
+		UnsubscribeEventsCalled: func(subscriptionID uint64) {
+			chanUnsubscribed <- subscriptionID
+		},
+	}
+}
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, eg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, eg)
+	})
+}
+
+func TestEventsGroup_SubscribeShouldParseFilterAndForwardErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("too many subscriptions")
+	facade := &mock.FacadeStub{
+		SubscribeEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+			assert.Equal(t, common.EventsSubscriptionFilter{
+				Addresses:   []string{"erd1alice", "erd1bob"},
+				Identifiers: []string{"ESDTTransfer"},
+				Topics:      []string{"aabb"},
+				Tokens:      []string{"TKN-abcdef"},
+			}, filter)
+			return nil, expectedErr
+		},
+	}
+
+	eventsGroup, err := groups.NewEventsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+	for _, path := range []string{"/events/ws", "/events/sse"} {
+		req, _ := http.NewRequest("GET", path+"?address=erd1alice&address=erd1bob&identifier=ESDTTransfer&topic=aabb&token=TKN-abcdef", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEventsSubscription.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+}
+
+func TestEventsGroup_SubscribeWebSocket(t *testing.T) {
+	t.Parallel()
+
+	subscription := newTestEventsSubscription()
+	chanUnsubscribed := make(chan uint64, 1)
+	eventsGroup, _ := groups.NewEventsGroup(createEventsFacade(subscription, chanUnsubscribed))
+
+	server := httptest.NewServer(startWebServer(eventsGroup, "events", getEventsRoutesConfig()))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/events/ws", nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	subscription.chanNotifications <- &common.EventsNotification{
+		Type: "push",
+		Hash: "hash",
+		Events: []*common.EventNotificationData{
+			{TxHash: "txHash", Identifier: "ESDTTransfer"},
+		},
+	}
+
+	received := &common.EventsNotification{}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	err = conn.ReadJSON(received)
+	require.NoError(t, err)
+	require.Equal(t, "push", received.Type)
+	require.Equal(t, "txHash", received.Events[0].TxHash)
+
+	subscription.terminate(errors.New("slow subscriber"))
+	_, _, err = conn.ReadMessage()
+	closeErr := &websocket.CloseError{}
+	require.True(t, errors.As(err, &closeErr))
+	require.Equal(t, websocket.CloseTryAgainLater, closeErr.Code)
+	require.Equal(t, "slow subscriber", closeErr.Text)
+
+	select {
+	case id := <-chanUnsubscribed:
+		require.Equal(t, uint64(37), id)
+	case <-time.After(time.Second):
+		require.Fail(t, "should have unsubscribed")
+	}
+}
+
+func TestEventsGroup_SubscribeWebSocketOrigin(t *testing.T) {
+	t.Parallel()
+
+	subscription := newTestEventsSubscription()
+	eventsGroup, _ := groups.NewEventsGroup(createEventsFacade(subscription, make(chan uint64, 10)))
+
+	apiConfig := getEventsRoutesConfig()
+	apiConfig.WebSocket.AllowedOrigins = []string{"https://Explorer.example.com/"}
+	server := httptest.NewServer(startWebServer(eventsGroup, "events", apiConfig))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/ws"
+	dial := func(origin string) (*http.Response, error) {
+		header := http.Header{}
+		if len(origin) > 0 {
+			header.Set("Origin", origin)
+		}
+		conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+		if err == nil {
+			_ = conn.Close()
+		}
+
+		return resp, err
+	}
+
+	_, err := dial("")
+	require.NoError(t, err)
+
+	_, err = dial(server.URL)
+	require.NoError(t, err)
+
+	_, err = dial("https://explorer.example.com")
+	require.NoError(t, err)
+
+	resp, err := dial("https://attacker.example.com")
+	require.Error(t, err)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestEventsGroup_SubscribeServerSentEvents(t *testing.T) {
+	t.Parallel()
+
+	subscription := newTestEventsSubscription()
+	chanUnsubscribed := make(chan uint64, 1)
+	eventsGroup, _ := groups.NewEventsGroup(createEventsFacade(subscription, chanUnsubscribed))
+
+	server := httptest.NewServer(startWebServer(eventsGroup, "events", getEventsRoutesConfig()))
+	defer server.Close()
+
+	subscription.chanNotifications <- &common.EventsNotification{Type: "finalized", Hash: "hash"}
+
+	resp, err := http.Get(server.URL + "/events/sse")
+	require.NoError(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	require.Equal(t, "event:finalized\n", readLine(t, reader))
+	require.Equal(t, `data:{"type":"finalized","hash":"hash","shardId":0}`+"\n", readLine(t, reader))
+	require.Equal(t, "\n", readLine(t, reader))
+
+	subscription.terminate(errors.New("events hub closed"))
+	require.Equal(t, "event:close\n", readLine(t, reader))
+	require.Equal(t, "data:events hub closed\n", readLine(t, reader))
+
+	select {
+	case id := <-chanUnsubscribed:
+		require.Equal(t, uint64(37), id)
+	case <-time.After(time.Second):
+		require.Fail(t, "should have unsubscribed")
+	}
+}
+
+func readLine(t *testing.T, reader *bufio.Reader) string {
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+
+	return line
+}
+
+func TestEventsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		eventsGroup, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		err := eventsGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		eventsGroup, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+		err := eventsGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		eventsGroup, _ := groups.NewEventsGroup(&mock.FacadeStub{})
+
+		expectedErr := errors.New("expected error")
+		newFacade := &mock.FacadeStub{
+			SubscribeEventsCalled: func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+				return nil, expectedErr
+			},
+		}
+		err := eventsGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+		req, _ := http.NewRequest("GET", "/events/sse", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+}
+
+func TestEventsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	eventsGroup, _ := groups.NewEventsGroup(nil)
+	require.True(t, eventsGroup.IsInterfaceNil())
+
+	eventsGroup, _ = groups.NewEventsGroup(&mock.FacadeStub{})
+	require.False(t, eventsGroup.IsInterfaceNil())
+}
+
+func getEventsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+					{Name: "/sse", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -170,7 +170,7 @@ func (ib *internalBlockGroup) getRawMetaBlockByNonce(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalMetaBlockByNonce(common.ApiOutputFormatProto, nonce)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMetaBlockByNonce with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 	}
 
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": rawBlock}, "", shared.ReturnCodeSuccess)
@@ -187,7 +187,7 @@ func (ib *internalBlockGroup) getRawMetaBlockByHash(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalMetaBlockByHash(common.ApiOutputFormatProto, hash)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMetaBlockByHash with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -205,7 +205,7 @@ func (ib *internalBlockGroup) getRawMetaBlockByRound(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalMetaBlockByRound(common.ApiOutputFormatProto, round)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMetaBlockByRound with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -223,7 +223,7 @@ func (ib *internalBlockGroup) getRawStartOfEpochMetaBlock(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalStartOfEpochMetaBlock(common.ApiOutputFormatProto, epoch)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalStartOfEpochMetaBlock with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -241,7 +241,7 @@ func (ib *internalBlockGroup) getRawShardBlockByNonce(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalShardBlockByNonce(common.ApiOutputFormatProto, nonce)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalShardBlockByNonce with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -259,7 +259,7 @@ func (ib *internalBlockGroup) getRawShardBlockByHash(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalShardBlockByHash(common.ApiOutputFormatProto, hash)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalShardBlockByHash with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -277,7 +277,7 @@ func (ib *internalBlockGroup) getRawShardBlockByRound(c *gin.Context) {
 	rawBlock, err := ib.getFacade().GetInternalShardBlockByRound(common.ApiOutputFormatProto, round)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalShardBlockByRound with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -295,7 +295,7 @@ func (ib *internalBlockGroup) getJSONMetaBlockByNonce(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalMetaBlockByNonce(common.ApiOutputFormatJSON, nonce)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMetaBlockByNonce with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -313,7 +313,7 @@ func (ib *internalBlockGroup) getJSONMetaBlockByHash(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalMetaBlockByHash(common.ApiOutputFormatJSON, hash)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMetaBlockByHash with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -331,7 +331,7 @@ func (ib *internalBlockGroup) getJSONMetaBlockByRound(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalMetaBlockByRound(common.ApiOutputFormatJSON, round)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMetaBlockByRound with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -349,7 +349,7 @@ func (ib *internalBlockGroup) getJSONStartOfEpochMetaBlock(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalStartOfEpochMetaBlock(common.ApiOutputFormatJSON, epoch)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalStartOfEpochMetaBlock with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -367,7 +367,7 @@ func (ib *internalBlockGroup) getJSONShardBlockByNonce(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalShardBlockByNonce(common.ApiOutputFormatJSON, nonce)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalShardBlockByNonce with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -385,7 +385,7 @@ func (ib *internalBlockGroup) getJSONShardBlockByHash(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalShardBlockByHash(common.ApiOutputFormatJSON, hash)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalShardBlockByHash with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -403,7 +403,7 @@ func (ib *internalBlockGroup) getJSONShardBlockByRound(c *gin.Context) {
 	block, err := ib.getFacade().GetInternalShardBlockByRound(common.ApiOutputFormatJSON, round)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalShardBlockByRound with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -427,7 +427,7 @@ func (ib *internalBlockGroup) getRawMiniBlockByHash(c *gin.Context) {
 	miniBlock, err := ib.getFacade().GetInternalMiniBlockByHash(common.ApiOutputFormatProto, hash, epoch)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMiniBlockByHash with proto")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -451,7 +451,7 @@ func (ib *internalBlockGroup) getJSONMiniBlockByHash(c *gin.Context) {
 	miniBlock, err := ib.getFacade().GetInternalMiniBlockByHash(common.ApiOutputFormatJSON, hash, epoch)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalMiniBlockByHash with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetBlock, err)
+		respondWithStorageReadError(c, errors.ErrGetBlock, err)
 		return
 	}
 
@@ -469,7 +469,7 @@ func (ib *internalBlockGroup) getJSONStartOfEpochValidatorsInfo(c *gin.Context)
 	validatorsInfo, err := ib.getFacade().GetInternalStartOfEpochValidatorsInfo(epoch)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetInternalStartOfEpochValidatorsInfo with JSON")
 	if err != nil {
-		shared.RespondWithInternalError(c, errors.ErrGetValidatorsInfo, err)
+		respondWithStorageReadError(c, errors.ErrGetValidatorsInfo, err)
 		return
 	}
 
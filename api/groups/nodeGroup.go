@@ -1,15 +1,18 @@
 package groups
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/shared/logging"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -21,6 +24,7 @@ const (
 	debugPath                 = "/debug"
 	heartbeatStatusPath       = "/heartbeatstatus"
 	metricsPath               = "/metrics"
+	openMetricsPath           = "/openmetrics"
 	p2pStatusPath             = "/p2pstatus"
 	peerInfoPath              = "/peerinfo"
 	statusPath                = "/status"
@@ -31,6 +35,23 @@ const (
 	managedKeysCount          = "/managed-keys/count"
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
+	configReloadPath          = "/config/reload"
+	redundancyStatusPath      = "/redundancy/status"
+	redundancyPromotePath     = "/redundancy/promote"
+	redundancyDemotePath      = "/redundancy/demote"
+	peersReputationPath       = "/peers-reputation"
+	peersReputationBanPath    = "/peers-reputation/ban"
+	peersReputationUnbanPath  = "/peers-reputation/unban"
+	storageReportPath         = "/storage-report"
+	outportCursorsPath        = "/outport/cursors"
+	outportReplayPath         = "/outport/replay"
+	urlParamRootHash          = "rootHash"
+	urlParamTop               = "top"
+
+	defaultStorageReportNumTop = 10
+	maxStorageReportNumTop     = 1000
+
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -45,6 +66,18 @@ type nodeFacadeHandler interface {
 	GetManagedKeys() []string
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
+	ReloadConfig() ([]common.ConfigChange, error)
+	GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error)
+	PromoteRedundancyLease() (common.RedundancyLeaseStatus, error)
+	DemoteRedundancyLease() (common.RedundancyLeaseStatus, error)
+	GetPeersReputation() ([]*common.PeerReputation, error)
+	BanPeer(pid string, duration time.Duration, reason string) error
+	UnbanPeer(pid string) error
+	BanIP(ip string, duration time.Duration, reason string) error
+	UnbanIP(ip string) error
+	GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error)
+	GetOutportDriversCursors() (map[string]uint64, error)
+	ReplayOutport(driverID string, sequence uint64) error
 	IsInterfaceNil() bool
 }
 
@@ -54,6 +87,22 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// PeerBanRequest represents the structure on which user input for banning or unbanning a peer ID or an IP address will
+// validate against. Exactly one of the peer ID and the IP address should be provided
+type PeerBanRequest struct {
+	PeerID            string `json:"peerID"`
+	IP                string `json:"ip"`
+	DurationInSeconds uint64 `json:"durationInSeconds"`
+	Reason            string `json:"reason"`
+}
+
+// OutportReplayRequest represents the structure on which user input for replaying the outport journal to a driver will
+// validate against. The driver IDs are the ones returned by the outport cursors endpoint
+type OutportReplayRequest struct {
+	DriverID string `json:"driverID"`
+	Sequence uint64 `json:"sequence"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -92,6 +141,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
 		},
+		{
+			Path:    openMetricsPath,
+			Method:  http.MethodGet,
+			Handler: ng.openMetrics,
+		},
 		{
 			Path:    debugPath,
 			Method:  http.MethodPost,
@@ -137,6 +191,56 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.managedKeysWaiting,
 		},
+		{
+			Path:    configReloadPath,
+			Method:  http.MethodPost,
+			Handler: ng.configReload,
+		},
+		{
+			Path:    redundancyStatusPath,
+			Method:  http.MethodGet,
+			Handler: ng.redundancyStatus,
+		},
+		{
+			Path:    redundancyPromotePath,
+			Method:  http.MethodPost,
+			Handler: ng.redundancyPromote,
+		},
+		{
+			Path:    redundancyDemotePath,
+			Method:  http.MethodPost,
+			Handler: ng.redundancyDemote,
+		},
+		{
+			Path:    peersReputationPath,
+			Method:  http.MethodGet,
+			Handler: ng.peersReputation,
+		},
+		{
+			Path:    peersReputationBanPath,
+			Method:  http.MethodPost,
+			Handler: ng.peersReputationBan,
+		},
+		{
+			Path:    peersReputationUnbanPath,
+			Method:  http.MethodPost,
+			Handler: ng.peersReputationUnban,
+		},
+		{
+			Path:    storageReportPath,
+			Method:  http.MethodGet,
+			Handler: ng.storageReport,
+		},
+		{
+			Path:    outportCursorsPath,
+			Method:  http.MethodGet,
+			Handler: ng.outportCursors,
+		},
+		{
+			Path:    outportReplayPath,
+			Method:  http.MethodPost,
+			Handler: ng.outportReplay,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -328,6 +432,28 @@ func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	)
 }
 
+// openMetrics is the endpoint which will return the metrics, histograms included, in the OpenMetrics text format
+func (ng *nodeGroup) openMetrics(c *gin.Context) {
+	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2POpenMetricsString()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.Data(
+		http.StatusOK,
+		openMetricsContentType,
+		[]byte(metrics),
+	)
+}
+
 // bootstrapMetrics returns the node's bootstrap statistics exported by a StatusMetricsHandler
 func (ng *nodeGroup) bootstrapMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().BootstrapMetrics()
@@ -440,6 +566,214 @@ func (ng *nodeGroup) managedKeysWaiting(c *gin.Context) {
 	)
 }
 
+// configReload reloads the hot-reloadable config values and returns the applied changes
+func (ng *nodeGroup) configReload(c *gin.Context) {
+	changes, err := ng.getFacade().ReloadConfig()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrReloadConfig, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"changes": changes},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// redundancyStatus returns the state of the node in the redundancy leader lease protocol
+func (ng *nodeGroup) redundancyStatus(c *gin.Context) {
+	status, err := ng.getFacade().GetRedundancyLeaseStatus()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetRedundancyLeaseStatus, err)
+		return
+	}
+
+	respondWithRedundancyLeaseStatus(c, status)
+}
+
+// redundancyPromote forces the node to take over the redundancy lease
+func (ng *nodeGroup) redundancyPromote(c *gin.Context) {
+	status, err := ng.getFacade().PromoteRedundancyLease()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrPromoteRedundancyLease, err)
+		return
+	}
+
+	respondWithRedundancyLeaseStatus(c, status)
+}
+
+// redundancyDemote forces the node to release the redundancy lease
+func (ng *nodeGroup) redundancyDemote(c *gin.Context) {
+	status, err := ng.getFacade().DemoteRedundancyLease()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrDemoteRedundancyLease, err)
+		return
+	}
+
+	respondWithRedundancyLeaseStatus(c, status)
+}
+
+func respondWithRedundancyLeaseStatus(c *gin.Context, status common.RedundancyLeaseStatus) {
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"status": status},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// peersReputation returns the persisted reputation of the known peer IDs, IP addresses and public keys
+func (ng *nodeGroup) peersReputation(c *gin.Context) {
+	reputations, err := ng.getFacade().GetPeersReputation()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetPeersReputation, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"peers": reputations})
+}
+
+// peersReputationBan manually bans a peer ID or an IP address
+func (ng *nodeGroup) peersReputationBan(c *gin.Context) {
+	request, err := getPeerBanRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	duration := time.Duration(request.DurationInSeconds) * time.Second
+	if len(request.PeerID) > 0 {
+		err = ng.getFacade().BanPeer(request.PeerID, duration, request.Reason)
+	} else {
+		err = ng.getFacade().BanIP(request.IP, duration, request.Reason)
+	}
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrBanPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
+// peersReputationUnban removes the ban of a peer ID or of an IP address
+func (ng *nodeGroup) peersReputationUnban(c *gin.Context) {
+	request, err := getPeerBanRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	if len(request.PeerID) > 0 {
+		err = ng.getFacade().UnbanPeer(request.PeerID)
+	} else {
+		err = ng.getFacade().UnbanIP(request.IP)
+	}
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrUnbanPeer, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
+func getPeerBanRequest(c *gin.Context) (*PeerBanRequest, error) {
+	request := &PeerBanRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPeerID := len(request.PeerID) > 0
+	hasIP := len(request.IP) > 0
+	if hasPeerID == hasIP {
+		return nil, errors.ErrInvalidPeerBanRequest
+	}
+
+	return request, nil
+}
+
+// storageReport returns the storage used by the accounts, the contracts, the codes and the tokens of a state
+func (ng *nodeGroup) storageReport(c *gin.Context) {
+	request, err := parseStorageReportRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStorageReport, err)
+		return
+	}
+
+	start := time.Now()
+	report, err := ng.getFacade().GetStorageReport(request)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetStorageReport")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStorageReport, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"storageReport": report})
+}
+
+func parseStorageReportRequest(c *gin.Context) (common.StorageReportRequest, error) {
+	rootHash, err := parseHexBytesUrlParam(c, urlParamRootHash)
+	if err != nil {
+		return common.StorageReportRequest{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	numTop, err := parseUint32UrlParam(c, urlParamTop)
+	if err != nil {
+		return common.StorageReportRequest{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !numTop.HasValue {
+		numTop.Value = defaultStorageReportNumTop
+	}
+	if numTop.Value == 0 || numTop.Value > maxStorageReportNumTop {
+		return common.StorageReportRequest{}, fmt.Errorf("%w: %s %d, maximum allowed is %d",
+			errors.ErrBadUrlParams, urlParamTop, numTop.Value, maxStorageReportNumTop)
+	}
+
+	return common.StorageReportRequest{
+		RootHash: hex.EncodeToString(rootHash),
+		NumTop:   int(numTop.Value),
+	}, nil
+}
+
+// outportCursors returns the last sequence number acknowledged by each driver of the journaled outport
+func (ng *nodeGroup) outportCursors(c *gin.Context) {
+	cursors, err := ng.getFacade().GetOutportDriversCursors()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetOutportDriversCursors, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"cursors": cursors})
+}
+
+// outportReplay delivers again to a reconnected driver the outport journal entries starting with the requested sequence
+func (ng *nodeGroup) outportReplay(c *gin.Context) {
+	request := &OutportReplayRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.DriverID) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrEmptyOutportDriverID)
+		return
+	}
+
+	err = ng.getFacade().ReplayOutport(request.DriverID, request.Sequence)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrReplayOutport, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
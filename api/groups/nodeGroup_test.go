@@ -95,6 +95,41 @@ type managedWaitingKeysResponse struct {
 	generalResponse
 }
 
+type configReloadResponse struct {
+	Data struct {
+		Changes []common.ConfigChange `json:"changes"`
+	} `json:"data"`
+	generalResponse
+}
+
+type redundancyLeaseStatusResponse struct {
+	Data struct {
+		Status common.RedundancyLeaseStatus `json:"status"`
+	} `json:"data"`
+	generalResponse
+}
+
+type peersReputationResponse struct {
+	Data struct {
+		Peers []*common.PeerReputation `json:"peers"`
+	} `json:"data"`
+	generalResponse
+}
+
+type outportCursorsResponse struct {
+	Data struct {
+		Cursors map[string]uint64 `json:"cursors"`
+	} `json:"data"`
+	generalResponse
+}
+
+type storageReportResponse struct {
+	Data struct {
+		StorageReport *common.StorageReport `json:"storageReport"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -676,6 +711,63 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestOpenMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
+	facade := mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				StatusMetricsWithoutP2POpenMetricsStringCalled: func() (string, error) {
+					return "", expectedErr
+				},
+			}
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/openmetrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestOpenMetrics_ShouldWork(t *testing.T) {
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	key := "test-key"
+	val := uint64(37)
+	statusMetricsProvider.SetUInt64Value(key, val)
+
+	facade := mock.FacadeStub{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusMetricsProvider
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/openmetrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := io.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.HasPrefix(resp.Header().Get("Content-Type"), "application/openmetrics-text"))
+	assert.True(t, strings.Contains(respStr, fmt.Sprintf("# TYPE %s gauge", key)))
+	assert.True(t, strings.Contains(respStr, fmt.Sprintf("%d", val)))
+	assert.True(t, strings.HasSuffix(respStr, "# EOF\n"))
+}
+
 func TestNodeGroup_ManagedKeysCount(t *testing.T) {
 	t.Parallel()
 
@@ -851,6 +943,419 @@ func TestNodeGroup_ManagedKeysWaiting(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_ConfigReload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			ReloadConfigCalled: func() ([]common.ConfigChange, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/config/reload", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrReloadConfig.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedChanges := []common.ConfigChange{
+			{
+				Path:     "GeneralConfig.Logs.LogLevel",
+				OldValue: "*:INFO",
+				NewValue: "*:DEBUG",
+			},
+		}
+		facade := mock.FacadeStub{
+			ReloadConfigCalled: func() ([]common.ConfigChange, error) {
+				return providedChanges, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/config/reload", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &configReloadResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedChanges, response.Data.Changes)
+	})
+}
+
+func TestNodeGroup_RedundancyLease(t *testing.T) {
+	t.Parallel()
+
+	providedStatus := common.RedundancyLeaseStatus{
+		State:           "holder",
+		LeaseEpoch:      4,
+		RedundancyLevel: 1,
+		LastHolder:      "peer",
+	}
+	leaseHandler := func() (common.RedundancyLeaseStatus, error) {
+		return providedStatus, nil
+	}
+	failingLeaseHandler := func() (common.RedundancyLeaseStatus, error) {
+		return common.RedundancyLeaseStatus{}, expectedErr
+	}
+
+	testRoute := func(t *testing.T, method string, path string, facade *mock.FacadeStub, expectedErr error) {
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest(method, path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &redundancyLeaseStatusResponse{}
+		loadResponse(resp.Body, response)
+
+		if expectedErr != nil {
+			assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+			return
+		}
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedStatus, response.Data.Status)
+	}
+
+	t.Run("status facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{GetRedundancyLeaseStatusCalled: failingLeaseHandler}
+		testRoute(t, "GET", "/node/redundancy/status", facade, apiErrors.ErrGetRedundancyLeaseStatus)
+	})
+	t.Run("status should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{GetRedundancyLeaseStatusCalled: leaseHandler}
+		testRoute(t, "GET", "/node/redundancy/status", facade, nil)
+	})
+	t.Run("promote facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{PromoteRedundancyLeaseCalled: failingLeaseHandler}
+		testRoute(t, "POST", "/node/redundancy/promote", facade, apiErrors.ErrPromoteRedundancyLease)
+	})
+	t.Run("promote should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{PromoteRedundancyLeaseCalled: leaseHandler}
+		testRoute(t, "POST", "/node/redundancy/promote", facade, nil)
+	})
+	t.Run("demote facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{DemoteRedundancyLeaseCalled: failingLeaseHandler}
+		testRoute(t, "POST", "/node/redundancy/demote", facade, apiErrors.ErrDemoteRedundancyLease)
+	})
+	t.Run("demote should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{DemoteRedundancyLeaseCalled: leaseHandler}
+		testRoute(t, "POST", "/node/redundancy/demote", facade, nil)
+	})
+}
+
+func TestNodeGroup_PeersReputation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetPeersReputationCalled: func() ([]*common.PeerReputation, error) {
+				return nil, expectedErr
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/peers-reputation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &peersReputationResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetPeersReputation.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReputations := []*common.PeerReputation{
+			{
+				Type:  "peer",
+				Key:   "pid",
+				Score: -10,
+				ScoreHistory: []common.PeerScoreChange{
+					{Timestamp: 1, Score: -10, Event: "blacklist"},
+				},
+				IsBanned:        true,
+				BanExpiry:       100,
+				BlacklistReason: "unmarshalable data got on topic headers",
+				OffendingTopic:  "headers",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetPeersReputationCalled: func() ([]*common.PeerReputation, error) {
+				return providedReputations, nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/peers-reputation", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &peersReputationResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedReputations, response.Data.Peers)
+	})
+}
+
+func TestNodeGroup_PeersReputationBanUnban(t *testing.T) {
+	t.Parallel()
+
+	testRoute := func(t *testing.T, path string, body string, facade *mock.FacadeStub, expectedErr error) {
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", path, bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &generalResponse{}
+		loadResponse(resp.Body, response)
+
+		if expectedErr != nil {
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+			return
+		}
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+	}
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		testRoute(t, "/node/peers-reputation/ban", "invalid", &mock.FacadeStub{}, apiErrors.ErrValidation)
+		testRoute(t, "/node/peers-reputation/unban", "invalid", &mock.FacadeStub{}, apiErrors.ErrValidation)
+	})
+	t.Run("both peer ID and IP should error", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"peerID":"pid","ip":"10.0.0.1"}`
+		testRoute(t, "/node/peers-reputation/ban", body, &mock.FacadeStub{}, apiErrors.ErrInvalidPeerBanRequest)
+		testRoute(t, "/node/peers-reputation/unban", `{}`, &mock.FacadeStub{}, apiErrors.ErrInvalidPeerBanRequest)
+	})
+	t.Run("ban peer should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			BanPeerCalled: func(pid string, duration time.Duration, reason string) error {
+				assert.Equal(t, "pid", pid)
+				assert.Equal(t, time.Minute, duration)
+				assert.Equal(t, "spammer", reason)
+				return nil
+			},
+			BanIPCalled: func(ip string, duration time.Duration, reason string) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		body := `{"peerID":"pid","durationInSeconds":60,"reason":"spammer"}`
+		testRoute(t, "/node/peers-reputation/ban", body, facade, nil)
+	})
+	t.Run("ban IP facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			BanIPCalled: func(ip string, duration time.Duration, reason string) error {
+				assert.Equal(t, "10.0.0.1", ip)
+				return expectedErr
+			},
+		}
+		body := `{"ip":"10.0.0.1","durationInSeconds":60}`
+		testRoute(t, "/node/peers-reputation/ban", body, facade, apiErrors.ErrBanPeer)
+	})
+	t.Run("unban peer facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			UnbanPeerCalled: func(pid string) error {
+				return expectedErr
+			},
+		}
+		testRoute(t, "/node/peers-reputation/unban", `{"peerID":"pid"}`, facade, apiErrors.ErrUnbanPeer)
+	})
+	t.Run("unban IP should work", func(t *testing.T) {
+		t.Parallel()
+
+		unbanCalled := false
+		facade := &mock.FacadeStub{
+			UnbanIPCalled: func(ip string) error {
+				unbanCalled = true
+				assert.Equal(t, "10.0.0.1", ip)
+				return nil
+			},
+		}
+		testRoute(t, "/node/peers-reputation/unban", `{"ip":"10.0.0.1"}`, facade, nil)
+		assert.True(t, unbanCalled)
+	})
+}
+
+func TestNodeGroup_OutportCursors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetOutportDriversCursorsCalled: func() (map[string]uint64, error) {
+				return nil, expectedErr
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/outport/cursors", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &outportCursorsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetOutportDriversCursors.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedCursors := map[string]uint64{"WebSocketDriver_0": 1200}
+		facade := &mock.FacadeStub{
+			GetOutportDriversCursorsCalled: func() (map[string]uint64, error) {
+				return providedCursors, nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/outport/cursors", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &outportCursorsResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedCursors, response.Data.Cursors)
+	})
+}
+
+func TestNodeGroup_OutportReplay(t *testing.T) {
+	t.Parallel()
+
+	testRoute := func(t *testing.T, body string, facade *mock.FacadeStub, expectedErr error) {
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/outport/replay", bytes.NewBuffer([]byte(body)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &generalResponse{}
+		loadResponse(resp.Body, response)
+
+		if expectedErr != nil {
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+			return
+		}
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+	}
+
+	t.Run("invalid body should error", func(t *testing.T) {
+		t.Parallel()
+
+		testRoute(t, "invalid", &mock.FacadeStub{}, apiErrors.ErrValidation)
+	})
+	t.Run("empty driver ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		testRoute(t, `{"sequence":10}`, &mock.FacadeStub{}, apiErrors.ErrEmptyOutportDriverID)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReplayOutportCalled: func(driverID string, sequence uint64) error {
+				return expectedErr
+			},
+		}
+		testRoute(t, `{"driverID":"WebSocketDriver_0","sequence":10}`, facade, apiErrors.ErrReplayOutport)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayCalled := false
+		facade := &mock.FacadeStub{
+			ReplayOutportCalled: func(driverID string, sequence uint64) error {
+				replayCalled = true
+				assert.Equal(t, "WebSocketDriver_0", driverID)
+				assert.Equal(t, uint64(10), sequence)
+				return nil
+			},
+		}
+		testRoute(t, `{"driverID":"WebSocketDriver_0","sequence":10}`, facade, nil)
+		assert.True(t, replayCalled)
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -944,6 +1449,84 @@ func loadResponseAsString(rsp io.Reader, response *statusResponse) {
 	response.Message = string(buff)
 }
 
+func TestNodeGroup_StorageReport(t *testing.T) {
+	t.Parallel()
+
+	testRoute := func(t *testing.T, path string, facade *mock.FacadeStub, expectedCode int) *storageReportResponse {
+		nodeGroup, err := groups.NewNodeGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &storageReportResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, expectedCode, resp.Code)
+
+		return response
+	}
+
+	t.Run("invalid url params should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStorageReportCalled: func(request common.StorageReportRequest) (*common.StorageReport, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		for _, path := range []string{
+			"/node/storage-report?rootHash=not-hex",
+			"/node/storage-report?top=invalid",
+			"/node/storage-report?top=0",
+			"/node/storage-report?top=1001",
+		} {
+			response := testRoute(t, path, facade, http.StatusBadRequest)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStorageReport.Error()), path)
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetStorageReportCalled: func(request common.StorageReportRequest) (*common.StorageReport, error) {
+				return nil, expectedErr
+			},
+		}
+		response := testRoute(t, "/node/storage-report", facade, http.StatusInternalServerError)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStorageReport.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReport := &common.StorageReport{
+			RootHash:    "abcd",
+			NumAccounts: 2,
+			TopContracts: []*common.ContractStorageReport{
+				{Address: "erd1contract", StorageSize: 100, CodeSize: 60, DataTrieSize: 40},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetStorageReportCalled: func(request common.StorageReportRequest) (*common.StorageReport, error) {
+				assert.Equal(t, common.StorageReportRequest{RootHash: "abcd", NumTop: 10}, request)
+				return providedReport, nil
+			},
+		}
+		response := testRoute(t, "/node/storage-report?rootHash=abcd", facade, http.StatusOK)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedReport, response.Data.StorageReport)
+
+		facade.GetStorageReportCalled = func(request common.StorageReportRequest) (*common.StorageReport, error) {
+			assert.Equal(t, common.StorageReportRequest{NumTop: 50}, request)
+			return providedReport, nil
+		}
+		_ = testRoute(t, "/node/storage-report?top=50", facade, http.StatusOK)
+	})
+}
+
 func getNodeRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -951,6 +1534,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/status", Open: true},
 					{Name: "/metrics", Open: true},
+					{Name: "/openmetrics", Open: true},
 					{Name: "/heartbeatstatus", Open: true},
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
@@ -962,6 +1546,16 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys", Open: true},
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
+					{Name: "/config/reload", Open: true},
+					{Name: "/redundancy/status", Open: true},
+					{Name: "/redundancy/promote", Open: true},
+					{Name: "/redundancy/demote", Open: true},
+					{Name: "/peers-reputation", Open: true},
+					{Name: "/peers-reputation/ban", Open: true},
+					{Name: "/peers-reputation/unban", Open: true},
+					{Name: "/storage-report", Open: true},
+					{Name: "/outport/cursors", Open: true},
+					{Name: "/outport/replay", Open: true},
 				},
 			},
 		},
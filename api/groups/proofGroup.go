@@ -20,10 +20,14 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/multi"
+	verifyMultiProofEndpoint        = "/proof/verify-multi"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/multi"
+	verifyMultiProofPath            = "/verify-multi"
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +36,8 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error)
+	VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +104,28 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +139,13 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// VerifyMultiProofRequest represents the parameters needed to verify a Merkle multi-proof
+type VerifyMultiProofRequest struct {
+	RootHash string                            `json:"rootHash"`
+	Nodes    []string                          `json:"nodes"`
+	Accounts []common.MultiProofAccountRequest `json:"accounts"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -238,6 +273,87 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
 }
 
+// getMultiProof will receive a list of accounts, each with its data trie keys and ESDT tokens, and an optional block hash.
+// It will return a single Merkle proof for all of them, together with the block header committing to the root hash
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	request := common.MultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.Accounts) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(request)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	accounts := make([]gin.H, 0, len(response.Accounts))
+	for _, account := range response.Accounts {
+		keys := make([]gin.H, 0, len(account.Keys))
+		for _, key := range account.Keys {
+			keys = append(keys, gin.H{
+				"key":   hex.EncodeToString(key.Key),
+				"value": hex.EncodeToString(key.Value),
+			})
+		}
+
+		accounts = append(accounts, gin.H{
+			"address":          account.Address,
+			"value":            hex.EncodeToString(account.Value),
+			"dataTrieRootHash": hex.EncodeToString(account.DataTrieRootHash),
+			"keys":             keys,
+		})
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"headerHash": hex.EncodeToString(response.HeaderHash),
+		"header":     hex.EncodeToString(response.Header),
+		"rootHash":   hex.EncodeToString(response.RootHash),
+		"nodes":      bytesToHex(response.Nodes),
+		"accounts":   accounts,
+	})
+}
+
+// verifyMultiProof will receive a root hash, a Merkle multi-proof and the accounts with their data trie keys and
+// ESDT tokens, and it will verify that all of them are proven
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	request := &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	nodes := make([][]byte, 0, len(request.Nodes))
+	for _, hexNode := range request.Nodes {
+		node, errDecode := hex.DecodeString(hexNode)
+		if errDecode != nil {
+			shared.RespondWithValidationError(c, errors.ErrValidation, errDecode)
+			return
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	proofOk, err := pg.getFacade().VerifyMultiProof(common.VerifyMultiProofRequest{
+		RootHash: request.RootHash,
+		Nodes:    nodes,
+		Accounts: request.Accounts,
+	})
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
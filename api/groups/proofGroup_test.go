@@ -379,6 +379,214 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	request := common.MultiProofRequest{
+		BlockHash: "aabb",
+		Accounts: []common.MultiProofAccountRequest{
+			{
+				Address: "erd1alice",
+				Keys:    []string{"0102"},
+				Tokens:  []string{"TKN-abcdef"},
+			},
+		},
+	}
+	requestBytes, _ := json.Marshal(request)
+
+	t.Run("bad request should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("no accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer([]byte(`{"blockHash":"aabb"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyAddress.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+				return nil, fmt.Errorf("GetMultiProof err")
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(providedRequest common.MultiProofRequest) (*common.MultiProofResponse, error) {
+				assert.Equal(t, request, providedRequest)
+
+				return &common.MultiProofResponse{
+					HeaderHash: []byte("header hash"),
+					Header:     []byte("header"),
+					RootHash:   []byte("root hash"),
+					Nodes:      [][]byte{[]byte("node1"), []byte("node2")},
+					Accounts: []*common.MultiProofAccountResponse{
+						{
+							Address:          "erd1alice",
+							Value:            []byte("account"),
+							DataTrieRootHash: []byte("data root hash"),
+							Keys: []*common.MultiProofKeyResponse{
+								{Key: []byte("key"), Value: []byte("value")},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		assert.Equal(t, hex.EncodeToString([]byte("header hash")), responseMap["headerHash"])
+		assert.Equal(t, hex.EncodeToString([]byte("header")), responseMap["header"])
+		assert.Equal(t, hex.EncodeToString([]byte("root hash")), responseMap["rootHash"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("node1")), hex.EncodeToString([]byte("node2"))}, responseMap["nodes"])
+
+		accounts, _ := responseMap["accounts"].([]interface{})
+		require.Len(t, accounts, 1)
+		account, _ := accounts[0].(map[string]interface{})
+		assert.Equal(t, "erd1alice", account["address"])
+		assert.Equal(t, hex.EncodeToString([]byte("account")), account["value"])
+		assert.Equal(t, hex.EncodeToString([]byte("data root hash")), account["dataTrieRootHash"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"key":   hex.EncodeToString([]byte("key")),
+				"value": hex.EncodeToString([]byte("value")),
+			},
+		}, account["keys"])
+	})
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	accounts := []common.MultiProofAccountRequest{
+		{
+			Address: "erd1alice",
+			Tokens:  []string{"TKN-abcdef"},
+		},
+	}
+
+	t.Run("invalid node should error", func(t *testing.T) {
+		t.Parallel()
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "aabb",
+			Nodes:    []string{"invalid hex"},
+			Accounts: accounts,
+		})
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{RootHash: "aabb", Accounts: accounts})
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(request common.VerifyMultiProofRequest) (bool, error) {
+				return false, fmt.Errorf("VerifyMultiProof err")
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "aabb",
+			Nodes:    []string{hex.EncodeToString([]byte("node"))},
+			Accounts: accounts,
+		})
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(request common.VerifyMultiProofRequest) (bool, error) {
+				assert.Equal(t, common.VerifyMultiProofRequest{
+					RootHash: "aabb",
+					Nodes:    [][]byte{[]byte("node")},
+					Accounts: accounts,
+				}, request)
+
+				return true, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		assert.Equal(t, true, responseMap["ok"])
+	})
+}
+
 func TestProofGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +684,8 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/multi", Open: true},
+					{Name: "/verify-multi", Open: true},
 				},
 			},
 		},
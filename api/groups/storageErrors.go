@@ -0,0 +1,29 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// respondWithStorageReadError should be called by the routes reading the storers that can have a retention policy.
+// If the inner error signals pruned data, the response will have the dedicated status and code, otherwise it is an
+// internal error
+func respondWithStorageReadError(c *gin.Context, err error, innerErr error) {
+	if !errors.Is(innerErr, storage.ErrDataPruned) {
+		shared.RespondWithInternalError(c, err, innerErr)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusGone,
+		nil,
+		fmt.Sprintf("%s: %s", err.Error(), innerErr.Error()),
+		shared.ReturnCodeDataPruned,
+	)
+}
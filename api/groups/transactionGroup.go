@@ -3,6 +3,7 @@ package groups
 import (
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
 	"net/http"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/api/shared/logging"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/node/external"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
@@ -27,16 +29,22 @@ const (
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	traceTransactionEndpoint         = "/transaction/:hash/trace"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	traceTransactionPath             = "/:txhash/trace"
 	getTransactionsPool              = "/pool"
+	getTransactionsPoolPolicy        = "/pool/policy"
+	streamTransactionsPool           = "/pool/stream"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
 	queryParamSender         = "by-sender"
+	queryParamReceiver       = "by-receiver"
+	queryParamDataPrefix     = "data-prefix"
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
@@ -49,11 +57,16 @@ type transactionFacadeHandler interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransaction(hash string) (*txSimData.TransactionTrace, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPool(subscriptionID uint64)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -116,6 +129,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionsPoolPolicy,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolPolicyCounters,
+		},
+		{
+			Path:        streamTransactionsPool,
+			Method:      http.MethodGet,
+			Handler:     tg.streamTransactionsPool,
+			IsStreaming: true,
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
@@ -138,6 +162,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    traceTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.traceTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -249,6 +284,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		)
 		return
 	}
+	tracing.TraceTx(c.Request.Context(), txHash)
 
 	start = time.Now()
 	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
@@ -338,6 +374,7 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 		)
 		return
 	}
+	tracing.TraceTx(c.Request.Context(), txHash)
 
 	start = time.Now()
 	err = tg.getFacade().ValidateTransaction(tx)
@@ -354,6 +391,21 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
+	start = time.Now()
+	replacedTxsHashes, err := tg.getFacade().GetTransactionsReplacedInPool(tx, txHash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsReplacedInPool")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start = time.Now()
 	_, err = tg.getFacade().SendBulkTransactions([]*transaction.Transaction{tx})
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SendBulkTransactions")
@@ -370,10 +422,16 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	}
 
 	txHexHash := hex.EncodeToString(txHash)
+	responseData := gin.H{"txHash": txHexHash}
+	if len(replacedTxsHashes) > 0 {
+		// computed before sending, on the pending transactions known at that time: the actual replacement happens when the
+		// transaction is received by the pool and can differ
+		responseData["predictedReplacedTxsHashes"] = replacedTxsHashes
+	}
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"txHash": txHexHash},
+			Data:  responseData,
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -404,6 +462,7 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 
 	var start time.Time
 	txsHashes := make(map[int]string)
+	txsReplacedHashes := make(map[int][]string)
 	for idx, receivedTx := range gtx {
 		txArgs := &external.ArgsCreateTransaction{
 			Nonce:            receivedTx.Nonce,
@@ -427,14 +486,24 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		if err != nil {
 			continue
 		}
+		tracing.AddEvent(c.Request.Context(), "transaction created", tracing.TxHash(txHash))
+		tracing.RecordTxSpan(c.Request.Context(), txHash)
 
 		err = tg.getFacade().ValidateTransaction(tx)
 		if err != nil {
 			continue
 		}
 
+		replacedTxsHashes, err := tg.getFacade().GetTransactionsReplacedInPool(tx, txHash)
+		if err != nil {
+			continue
+		}
+
 		txs = append(txs, tx)
 		txsHashes[idx] = hex.EncodeToString(txHash)
+		if len(replacedTxsHashes) > 0 {
+			txsReplacedHashes[idx] = replacedTxsHashes
+		}
 	}
 
 	start = time.Now()
@@ -456,8 +525,9 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		http.StatusOK,
 		shared.GenericAPIResponse{
 			Data: gin.H{
-				"txsSent":   numOfSentTxs,
-				"txsHashes": txsHashes,
+				"txsSent":                    numOfSentTxs,
+				"txsHashes":                  txsHashes,
+				"predictedReplacedTxsHashes": txsReplacedHashes,
 			},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
@@ -496,12 +566,45 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	start := time.Now()
 	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransaction")
+	if err != nil {
+		respondWithStorageReadError(c, errors.ErrGetTransaction, err)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transaction": tx},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// traceTransaction replays an already executed transaction and returns its execution trace
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().TraceTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: TraceTransaction")
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
 				Code:  shared.ReturnCodeInternalError,
 			},
 		)
@@ -511,7 +614,7 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"transaction": tx},
+			Data:  gin.H{"trace": trace},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -607,7 +710,15 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
+	filter := common.TransactionsPoolFilter{
+		Sender:     sender,
+		Receiver:   getQueryParameterReceiver(c),
+		DataPrefix: getQueryParameterDataPrefix(c),
+	}
 	err = validateQuery(sender, fields, lastNonce, nonceGaps)
+	if err == nil {
+		err = validatePoolFilterQuery(filter, lastNonce, nonceGaps)
+	}
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -620,9 +731,11 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	// if no sender was provided, the fields for all transactions from pool should be returned in response
-	if sender == "" {
-		tg.getTxPool(fields, c)
+	// if no sender was provided or the transactions are filtered by receiver or data prefix, the fields for all the
+	// matching transactions from pool should be returned in response
+	isFilteredByReceiverOrData := filter.Receiver != "" || filter.DataPrefix != ""
+	if sender == "" || isFilteredByReceiverOrData {
+		tg.getTxPool(fields, filter, c)
 		return
 	}
 
@@ -655,10 +768,10 @@ func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (string, stri
 	return senderAddress, fields, lastNonce, nonceGaps, nil
 }
 
-// getTxPool returns the fields for all txs in pool
-func (tg *transactionGroup) getTxPool(fields string, c *gin.Context) {
+// getTxPool returns the fields for all txs in pool matching the filter
+func (tg *transactionGroup) getTxPool(fields string, filter common.TransactionsPoolFilter, c *gin.Context) {
 	start := time.Now()
-	txPool, err := tg.getFacade().GetTransactionsPool(fields)
+	txPool, err := tg.getFacade().GetTransactionsPool(fields, filter)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPool")
 	if err != nil {
 		c.JSON(
@@ -763,6 +876,71 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
+// getTransactionsPoolPolicyCounters returns the number of transactions rejected or evicted by each rule of the pool policy
+func (tg *transactionGroup) getTransactionsPoolPolicyCounters(c *gin.Context) {
+	start := time.Now()
+	counters, err := tg.getFacade().GetTransactionsPoolPolicyCounters()
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolPolicyCounters")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"policy": counters},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// streamTransactionsPool streams as server-sent events the changes of the transactions pool matching the filter, the
+// event name being the change type
+func (tg *transactionGroup) streamTransactionsPool(c *gin.Context) {
+	filter := common.TransactionsPoolFilter{
+		Sender:     getQueryParameterSender(c),
+		Receiver:   getQueryParameterReceiver(c),
+		DataPrefix: getQueryParameterDataPrefix(c),
+	}
+	subscription, err := tg.getFacade().SubscribeTransactionsPool(filter)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrTransactionsPoolSubscription, err)
+		return
+	}
+	defer tg.getFacade().UnsubscribeTransactionsPool(subscription.ID())
+
+	c.Stream(func(_ io.Writer) bool {
+		select {
+		case notification := <-subscription.Notifications():
+			c.SSEvent(notification.Type, notification)
+			return true
+		case <-subscription.Done():
+			c.SSEvent(sseCloseEvent, subscription.Err().Error())
+			return false
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func validatePoolFilterQuery(filter common.TransactionsPoolFilter, lastNonce, nonceGaps bool) error {
+	isFilteredByReceiverOrData := filter.Receiver != "" || filter.DataPrefix != ""
+	if isFilteredByReceiverOrData && (lastNonce || nonceGaps) {
+		return errors.ErrFetchingNonceCannotIncludeFilters
+	}
+
+	return nil
+}
+
 func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
 	if fields != "" && lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
@@ -826,6 +1004,14 @@ func getQueryParameterSender(c *gin.Context) string {
 	return senderAddress
 }
 
+func getQueryParameterReceiver(c *gin.Context) string {
+	return c.Request.URL.Query().Get(queryParamReceiver)
+}
+
+func getQueryParameterDataPrefix(c *gin.Context) string {
+	return c.Request.URL.Query().Get(queryParamDataPrefix)
+}
+
 func getQueryParameterFields(c *gin.Context) string {
 	fieldsStr := c.Request.URL.Query().Get(queryParamFields)
 	return fieldsStr
@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
@@ -11,6 +12,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	dataTx "github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -22,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/node/external"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -42,6 +45,20 @@ func TestNewTransactionGroup(t *testing.T) {
 	})
 }
 
+func TestTransactionGroup_GetEndpointsShouldMarkThePoolStreamAsStreaming(t *testing.T) {
+	t.Parallel()
+
+	tg, _ := groups.NewTransactionGroup(&mock.FacadeStub{})
+
+	streamingPaths := make([]string, 0)
+	for _, endpoint := range tg.GetEndpoints() {
+		if endpoint.IsStreaming {
+			streamingPaths = append(streamingPaths, endpoint.Path)
+		}
+	}
+	require.Equal(t, []string{"/pool/stream"}, streamingPaths)
+}
+
 type transactionResponseData struct {
 	TxResp *groups.TxResponse `json:"transaction,omitempty"`
 }
@@ -70,7 +87,8 @@ type simulateTxResponse struct {
 }
 
 type sendSingleTxResponseData struct {
-	TxHash string `json:"txHash"`
+	TxHash                     string   `json:"txHash"`
+	PredictedReplacedTxsHashes []string `json:"predictedReplacedTxsHashes"`
 }
 
 type sendSingleTxResponse struct {
@@ -79,6 +97,16 @@ type sendSingleTxResponse struct {
 	Code  string                   `json:"code"`
 }
 
+type traceTxResponseData struct {
+	Trace *txSimData.TransactionTrace `json:"trace"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
 type transactionCostResponseData struct {
 	Cost uint64 `json:"txGasUnits"`
 }
@@ -129,6 +157,16 @@ type txPoolNonceGapsForSenderResponse struct {
 	Code  string                               `json:"code"`
 }
 
+type txPoolPolicyCountersResponseData struct {
+	Policy common.TransactionsPoolPolicyCountersApiResponse `json:"policy"`
+}
+
+type txPoolPolicyCountersResponse struct {
+	Data  txPoolPolicyCountersResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
 var (
 	sender      = "sender"
 	receiver    = "receiver"
@@ -203,6 +241,32 @@ func TestTransactionsGroup_getTransaction(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.Code)
 		assert.Empty(t, txResp.Data)
 	})
+	t.Run("pruned transaction should error with dedicated code", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				return nil, fmt.Errorf("%w: epoch 2 in Transactions", storage.ErrDataPruned)
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/hash", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := transactionResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusGone, resp.Code)
+		assert.Equal(t, string(shared.ReturnCodeDataPruned), txResp.Code)
+		assert.Contains(t, txResp.Error, storage.ErrDataPruned.Error())
+		assert.Empty(t, txResp.Data)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -236,6 +300,68 @@ func TestTransactionsGroup_getTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionsGroup_traceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/eeee/trace", nil))
+	t.Run("facade returns error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TraceTransactionCalled: func(hash string) (*txSimData.TransactionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/trace",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			apiErrors.ErrTraceTransaction,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedTrace := &txSimData.TransactionTrace{
+			Hash:        hexTxHash,
+			Status:      dataTx.TxStatusSuccess,
+			GasConsumed: 50000,
+			Steps: []*txSimData.TraceStep{
+				{
+					Hash:     hexTxHash,
+					Kind:     "scCall",
+					Function: "add",
+					Executed: true,
+					StorageWrites: []*txSimData.StorageAccessTrace{
+						{Address: receiver, Key: "aa", Value: "bb"},
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			TraceTransactionCalled: func(hash string) (*txSimData.TransactionTrace, error) {
+				require.Equal(t, hexTxHash, hash)
+				return providedTrace, nil
+			},
+		}
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/trace",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, providedTrace, response.Data.Trace)
+	})
+}
+
 func TestTransactionGroup_sendTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -288,6 +414,34 @@ func TestTransactionGroup_sendTransaction(t *testing.T) {
 			expectedErr,
 		)
 	})
+	t.Run("GetTransactionsReplacedInPool error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			GetTransactionsReplacedInPoolCalled: func(tx *dataTx.Transaction, txHash []byte) ([]string, error) {
+				return nil, expectedErr
+			},
+			SendBulkTransactionsHandler: func(txs []*dataTx.Transaction) (u uint64, err error) {
+				require.Fail(t, "should have not been called")
+				return 0, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/send",
+			"POST",
+			&groups.SendTxRequest{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
 	t.Run("SendBulkTransactions error should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -339,6 +493,41 @@ func TestTransactionGroup_sendTransaction(t *testing.T) {
 		)
 		assert.Empty(t, response.Error)
 		assert.Equal(t, hexTxHash, response.Data.TxHash)
+		assert.Empty(t, response.Data.PredictedReplacedTxsHashes)
+	})
+	t.Run("should work with replaced transactions", func(t *testing.T) {
+		t.Parallel()
+
+		replacedTxsHashes := []string{"aabb"}
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				txHash, _ := hex.DecodeString(hexTxHash)
+				return nil, txHash, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			GetTransactionsReplacedInPoolCalled: func(tx *dataTx.Transaction, txHash []byte) ([]string, error) {
+				require.Equal(t, hexTxHash, hex.EncodeToString(txHash))
+				return replacedTxsHashes, nil
+			},
+			SendBulkTransactionsHandler: func(txs []*dataTx.Transaction) (u uint64, err error) {
+				return 1, nil
+			},
+		}
+
+		response := &sendSingleTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/send",
+			"POST",
+			bytes.NewBuffer([]byte(jsonTxStr)),
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, hexTxHash, response.Data.TxHash)
+		assert.Equal(t, replacedTxsHashes, response.Data.PredictedReplacedTxsHashes)
 	})
 }
 
@@ -398,6 +587,34 @@ func TestTransactionGroup_sendMultipleTransactions(t *testing.T) {
 			expectedErr,
 		)
 	})
+	t.Run("GetTransactionsReplacedInPool error should continue, error on SendBulkTransactions", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			GetTransactionsReplacedInPoolCalled: func(tx *dataTx.Transaction, txHash []byte) ([]string, error) {
+				return nil, expectedErr
+			},
+			SendBulkTransactionsHandler: func(txs []*dataTx.Transaction) (uint64, error) {
+				require.Zero(t, len(txs))
+				return 0, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/send-multiple",
+			"POST",
+			[]*groups.SendTxRequest{{}},
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
 	t.Run("SendBulkTransactions error error", func(t *testing.T) {
 		t.Parallel()
 
@@ -704,11 +921,44 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 	t.Run("fields + nonce gaps", testTxPoolWithInvalidQuery("?fields=sender,receiver&nonce-gaps=true", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
 	t.Run("fields has spaces", testTxPoolWithInvalidQuery("?fields=sender ,receiver", apiErrors.ErrInvalidFields))
 	t.Run("fields has numbers", testTxPoolWithInvalidQuery("?fields=sender1", apiErrors.ErrInvalidFields))
+	t.Run("receiver + latest nonce", testTxPoolWithInvalidQuery("?by-sender=alice&by-receiver=bob&last-nonce=true", apiErrors.ErrFetchingNonceCannotIncludeFilters))
+	t.Run("data prefix + nonce gaps", testTxPoolWithInvalidQuery("?by-sender=alice&data-prefix=claim&nonce-gaps=true", apiErrors.ErrFetchingNonceCannotIncludeFilters))
+	t.Run("receiver and data prefix filters should call GetTransactionsPool", func(t *testing.T) {
+		t.Parallel()
+
+		expectedFilter := common.TransactionsPoolFilter{
+			Sender:     "alice",
+			Receiver:   "bob",
+			DataPrefix: "claim@",
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+				assert.Equal(t, "sender,receiver", fields)
+				assert.Equal(t, expectedFilter, filter)
+				return &common.TransactionsPoolAPIResponse{}, nil
+			},
+			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		response := &txsPoolResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool?by-sender=alice&by-receiver=bob&data-prefix=claim@&fields=sender,receiver",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+	})
 	t.Run("GetTransactionsPool error should error", func(t *testing.T) {
 		t.Parallel()
 
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
@@ -795,7 +1045,7 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 			},
 		}
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxPool, nil
 			},
 		}
@@ -935,6 +1185,151 @@ func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing
 	}
 }
 
+func TestTransactionGroup_getTransactionsPoolPolicyCounters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolPolicyCountersCalled: func() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/policy",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedCounters := &common.TransactionsPoolPolicyCountersApiResponse{
+			Counters: map[string]uint64{
+				"min-gas-price":       3,
+				"evict-highest-nonce": 1,
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolPolicyCountersCalled: func() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+				return expectedCounters, nil
+			},
+		}
+
+		response := &txPoolPolicyCountersResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool/policy",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedCounters, response.Data.Policy)
+	})
+}
+
+type testTxPoolSubscription struct {
+	chanNotifications chan *common.TransactionsPoolChangeNotification
+	chanDone          chan struct{}
+	err               error
+}
+
+func (sub *testTxPoolSubscription) ID() uint64 {
+	return 41
+}
+
+func (sub *testTxPoolSubscription) Notifications() <-chan *common.TransactionsPoolChangeNotification {
+	return sub.chanNotifications
+}
+
+func (sub *testTxPoolSubscription) Done() <-chan struct{} {
+	return sub.chanDone
+}
+
+func (sub *testTxPoolSubscription) Err() error {
+	return sub.err
+}
+
+func TestTransactionGroup_streamTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("subscribe error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			SubscribeTransactionsPoolCalled: func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionGroup, _ := groups.NewTransactionGroup(facade)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/pool/stream", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTransactionsPoolSubscription.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should stream the changes", func(t *testing.T) {
+		t.Parallel()
+
+		subscription := &testTxPoolSubscription{
+			chanNotifications: make(chan *common.TransactionsPoolChangeNotification, 10),
+			chanDone:          make(chan struct{}),
+		}
+		chanUnsubscribed := make(chan uint64, 1)
+		facade := &mock.FacadeStub{
+			SubscribeTransactionsPoolCalled: func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+				assert.Equal(t, common.TransactionsPoolFilter{Receiver: "bob", DataPrefix: "claim"}, filter)
+				return subscription, nil
+			},
+			UnsubscribeTransactionsPoolCalled: func(subscriptionID uint64) {
+				chanUnsubscribed <- subscriptionID
+			},
+		}
+		transactionGroup, _ := groups.NewTransactionGroup(facade)
+		server := httptest.NewServer(startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig()))
+		defer server.Close()
+
+		subscription.chanNotifications <- &common.TransactionsPoolChangeNotification{Type: "added", Hash: "aa", Nonce: 1}
+
+		resp, err := http.Get(server.URL + "/transaction/pool/stream?by-receiver=bob&data-prefix=claim")
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(resp.Body)
+		require.Equal(t, "event:added\n", readLine(t, reader))
+		require.Equal(t, `data:{"type":"added","hash":"aa","sender":"","receiver":"","nonce":1,"gasPrice":0,"senderShardId":0,"receiverShardId":0}`+"\n", readLine(t, reader))
+		require.Equal(t, "\n", readLine(t, reader))
+
+		subscription.err = errors.New("subscriber too slow")
+		close(subscription.chanDone)
+		require.Equal(t, "event:close\n", readLine(t, reader))
+		require.Equal(t, "data:subscriber too slow\n", readLine(t, reader))
+
+		select {
+		case id := <-chanUnsubscribed:
+			require.Equal(t, uint64(41), id)
+		case <-time.After(time.Second):
+			require.Fail(t, "should have unsubscribed")
+		}
+	})
+}
+
 func TestTransactionsGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -969,7 +1364,7 @@ func TestTransactionsGroup_UpdateFacade(t *testing.T) {
 			},
 		}
 		facade := mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxPool, nil
 			},
 		}
@@ -990,7 +1385,7 @@ func TestTransactionsGroup_UpdateFacade(t *testing.T) {
 		assert.Equal(t, *expectedTxPool, txsPoolResp.Data.TxPool)
 
 		newFacade := mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
@@ -1119,8 +1514,11 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/pool", Open: true},
+					{Name: "/pool/policy", Open: true},
+					{Name: "/pool/stream", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/:txhash/trace", Open: true},
 					{Name: "/simulate", Open: true},
 				},
 			},
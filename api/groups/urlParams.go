@@ -2,10 +2,12 @@ package groups
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/api/errors"
 )
 
 func parseBoolUrlParam(c *gin.Context, name string) (bool, error) {
@@ -58,3 +60,35 @@ func parseHexBytesUrlParam(c *gin.Context, name string) ([]byte, error) {
 
 	return decoded, nil
 }
+
+// extractPagination returns the from and size url params, with the given default size if the size is not provided
+func extractPagination(c *gin.Context, defaultSize uint64, maxSize uint64) (uint64, uint64, error) {
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	size, err := extractBoundedUint64UrlParam(c, urlParamSize, defaultSize, maxSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return from.Value, size, nil
+}
+
+// extractBoundedUint64UrlParam returns the value of a positive url param not greater than the given maximum, with the
+// given default value if the param is not provided
+func extractBoundedUint64UrlParam(c *gin.Context, name string, defaultValue uint64, maxValue uint64) (uint64, error) {
+	param, err := parseUint64UrlParam(c, name)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !param.HasValue {
+		return defaultValue, nil
+	}
+	if param.Value == 0 || param.Value > maxValue {
+		return 0, fmt.Errorf("%w: %s %d, maximum allowed is %d", errors.ErrBadUrlParams, name, param.Value, maxValue)
+	}
+
+	return param.Value, nil
+}
@@ -8,26 +8,28 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	apiData "github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 const (
-	hexPath    = "/hex"
-	stringPath = "/string"
-	intPath    = "/int"
-	queryPath  = "/query"
+	hexPath        = "/hex"
+	stringPath     = "/string"
+	intPath        = "/int"
+	queryPath      = "/query"
+	multiQueryPath = "/multi-query"
 )
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, apiData.BlockInfo, error)
+	ExecuteSCQueries([]*process.SCQuery) ([]*common.SCQueryResultApi, apiData.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -70,6 +72,11 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
 		},
+		{
+			Path:    multiQueryPath,
+			Method:  http.MethodPost,
+			Handler: vvg.executeMultiQuery,
+		},
 	}
 	vvg.endpoints = endpoints
 
@@ -87,6 +94,11 @@ type VMValueRequest struct {
 	ShouldBeSynced bool     `json:"shouldBeSynced"`
 }
 
+// VMValuesMultiRequest represents the structure of a multi-query request. All the queries are executed on the same block
+type VMValuesMultiRequest struct {
+	Queries []VMValueRequest `json:"queries"`
+}
+
 // getHex returns the data as bytes, hex-encoded
 func (vvg *vmValuesGroup) getHex(context *gin.Context) {
 	vvg.doGetVMValue(context, vm.AsHex)
@@ -129,6 +141,50 @@ func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
 	vvg.returnOkResponse(context, vmOutput, execErrMsg, blockInfo)
 }
 
+// executeMultiQuery executes all the provided queries on the same block and returns the result of each of them
+func (vvg *vmValuesGroup) executeMultiQuery(context *gin.Context) {
+	request := VMValuesMultiRequest{}
+	err := context.ShouldBindJSON(&request)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultiQuery", errors.ErrInvalidJSONRequest)
+		return
+	}
+
+	commands := make([]*process.SCQuery, 0, len(request.Queries))
+	for i := range request.Queries {
+		command, errCreate := vvg.createSCQuery(&request.Queries[i])
+		if errCreate != nil {
+			vvg.returnBadRequest(context, "executeMultiQuery", fmt.Errorf("query %d: %w", i, errCreate))
+			return
+		}
+
+		err = extractBlockCoordinates(context, command)
+		if err != nil {
+			vvg.returnBadRequest(context, "executeMultiQuery", err)
+			return
+		}
+
+		commands = append(commands, command)
+	}
+
+	results, blockInfo, err := vvg.getFacade().ExecuteSCQueries(commands)
+	if err != nil {
+		vvg.returnBadRequest(context, "executeMultiQuery", err)
+		return
+	}
+
+	for _, result := range results {
+		if result.Data == nil || len(result.Error) > 0 {
+			continue
+		}
+		if len(result.Data.ReturnCode) > 0 && result.Data.ReturnCode != vmcommon.Ok.String() {
+			result.Error = result.Data.ReturnCode + ":" + result.Data.ReturnMessage
+		}
+	}
+
+	vvg.returnOkResponse(context, results, "", blockInfo)
+}
+
 func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, apiData.BlockInfo, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
@@ -141,7 +197,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		return nil, "", apiData.BlockInfo{}, err
 	}
 
-	command.BlockNonce, command.BlockHash, err = extractBlockCoordinates(context)
+	err = extractBlockCoordinates(context, command)
 	if err != nil {
 		return nil, "", apiData.BlockInfo{}, err
 	}
@@ -159,18 +215,43 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 	return vmOutputApi, vmExecErrMsg, blockInfo, nil
 }
 
-func extractBlockCoordinates(context *gin.Context) (core.OptionalUint64, []byte, error) {
+func extractBlockCoordinates(context *gin.Context, command *process.SCQuery) error {
 	blockNonce, err := parseUint64UrlParam(context, urlParamBlockNonce)
 	if err != nil {
-		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block nonce", err)
+		return fmt.Errorf("%w for block nonce", err)
 	}
 
 	blockHash, err := parseHexBytesUrlParam(context, urlParamBlockHash)
 	if err != nil {
-		return core.OptionalUint64{}, nil, fmt.Errorf("%w for block hash", err)
+		return fmt.Errorf("%w for block hash", err)
 	}
 
-	return blockNonce, blockHash, nil
+	blockRootHash, err := parseHexBytesUrlParam(context, urlParamBlockRootHash)
+	if err != nil {
+		return fmt.Errorf("%w for block root hash", err)
+	}
+
+	hintEpoch, err := parseUint32UrlParam(context, urlParamHintEpoch)
+	if err != nil {
+		return fmt.Errorf("%w for hint epoch", err)
+	}
+
+	err = checkAccountQueryOptions(apiData.AccountQueryOptions{
+		BlockNonce:    blockNonce,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+		HintEpoch:     hintEpoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	command.BlockNonce = blockNonce
+	command.BlockHash = blockHash
+	command.BlockRootHash = blockRootHash
+	command.HintEpoch = hintEpoch
+
+	return nil
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
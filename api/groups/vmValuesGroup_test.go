@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -46,6 +47,12 @@ type simpleResponse struct {
 	Error string `json:"error"`
 }
 
+type multiQueryResponse struct {
+	Data      []*common.SCQueryResultApi `json:"data"`
+	BlockInfo api.BlockInfo              `json:"blockInfo"`
+	Error     string                     `json:"error"`
+}
+
 type vmOutputResponse struct {
 	Data      *vmcommon.VMOutput `json:"data"`
 	BlockInfo api.BlockInfo      `json:"blockInfo"`
@@ -146,6 +153,10 @@ func TestQuery(t *testing.T) {
 
 	t.Run("invalid block nonce should error", testQueryShouldError("/vm-values/query?blockNonce=invalid_nonce"))
 	t.Run("invalid block hash should error", testQueryShouldError("/vm-values/query?blockHash=invalid_nonce"))
+	t.Run("invalid block root hash should error", testQueryShouldError("/vm-values/query?blockRootHash=invalid_hash"))
+	t.Run("invalid hint epoch should error", testQueryShouldError("/vm-values/query?blockRootHash=aabb&hintEpoch=invalid_epoch"))
+	t.Run("multiple block coordinates should error", testQueryShouldError("/vm-values/query?blockNonce=10&blockRootHash=aabb"))
+	t.Run("hint epoch without block root hash should error", testQueryShouldError("/vm-values/query?blockNonce=10&hintEpoch=2"))
 	t.Run("should work - block nonce", func(t *testing.T) {
 		t.Parallel()
 
@@ -179,6 +190,26 @@ func TestQuery(t *testing.T) {
 		url := fmt.Sprintf("/vm-values/query?blockHash=%s", hex.EncodeToString(providedBlockHash))
 		testQueryShouldWork(t, url, &facade)
 	})
+	t.Run("should work - block root hash", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("provided root hash")
+		providedHintEpoch := core.OptionalUint32{
+			Value:    5,
+			HasValue: true,
+		}
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error) {
+				require.Equal(t, providedRootHash, query.BlockRootHash)
+				require.Equal(t, providedHintEpoch, query.HintEpoch)
+				return &vm.VMOutputApi{
+					ReturnData: [][]byte{big.NewInt(42).Bytes()},
+				}, api.BlockInfo{}, nil
+			},
+		}
+		url := fmt.Sprintf("/vm-values/query?blockRootHash=%s&hintEpoch=%d", hex.EncodeToString(providedRootHash), providedHintEpoch.Value)
+		testQueryShouldWork(t, url, &facade)
+	})
 	t.Run("should work - no block coordinates", func(t *testing.T) {
 		t.Parallel()
 
@@ -211,6 +242,102 @@ func TestQuery(t *testing.T) {
 	})
 }
 
+func TestMultiQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValuesMultiRequest{
+			Queries: []groups.VMValueRequest{{ScAddress: dummyScAddress, FuncName: "function"}},
+		}
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/multi-query?blockNonce=10&blockRootHash=aabb", request, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.NotEmpty(t, response.Error)
+	})
+	t.Run("invalid query should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValuesMultiRequest{
+			Queries: []groups.VMValueRequest{
+				{ScAddress: dummyScAddress, FuncName: "function"},
+				{ScAddress: dummyScAddress, FuncName: "function", Args: []string{"bad arg"}},
+			},
+		}
+		response := simpleResponse{}
+		statusCode := doPost(t, &mock.FacadeStub{}, "/vm-values/multi-query", request, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, "query 1")
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+		request := groups.VMValuesMultiRequest{
+			Queries: []groups.VMValueRequest{{ScAddress: dummyScAddress, FuncName: "function"}},
+		}
+		response := simpleResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/multi-query", request, &response)
+		require.Equal(t, http.StatusBadRequest, statusCode)
+		require.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedBlockNonce := core.OptionalUint64{
+			Value:    123,
+			HasValue: true,
+		}
+		providedBlockInfo := api.BlockInfo{
+			Nonce:    123,
+			Hash:     "provided hash",
+			RootHash: "provided root hash",
+		}
+		facade := mock.FacadeStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+				require.Len(t, queries, 3)
+				for _, query := range queries {
+					require.Equal(t, providedBlockNonce, query.BlockNonce)
+				}
+				require.Equal(t, "first", queries[0].FuncName)
+				require.Equal(t, "second", queries[1].FuncName)
+				require.Equal(t, "third", queries[2].FuncName)
+
+				return []*common.SCQueryResultApi{
+					{Data: &vm.VMOutputApi{ReturnCode: vmcommon.Ok.String(), ReturnData: [][]byte{big.NewInt(42).Bytes()}}},
+					{Data: &vm.VMOutputApi{ReturnCode: vmcommon.UserError.String(), ReturnMessage: "not allowed"}},
+					{Error: "execution failed"},
+				}, providedBlockInfo, nil
+			},
+		}
+		request := groups.VMValuesMultiRequest{
+			Queries: []groups.VMValueRequest{
+				{ScAddress: dummyScAddress, FuncName: "first"},
+				{ScAddress: dummyScAddress, FuncName: "second"},
+				{ScAddress: dummyScAddress, FuncName: "third"},
+			},
+		}
+
+		response := multiQueryResponse{}
+		statusCode := doPost(t, &facade, fmt.Sprintf("/vm-values/multi-query?blockNonce=%d", providedBlockNonce.Value), request, &response)
+
+		require.Equal(t, http.StatusOK, statusCode)
+		require.Equal(t, providedBlockInfo, response.BlockInfo)
+		require.Len(t, response.Data, 3)
+		require.Empty(t, response.Data[0].Error)
+		require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data[0].Data.ReturnData[0]).Int64())
+		require.Equal(t, vmcommon.UserError.String()+":not allowed", response.Data[1].Error)
+		require.Equal(t, "execution failed", response.Data[2].Error)
+		require.Nil(t, response.Data[2].Data)
+	})
+}
+
 func testQueryShouldWork(t *testing.T, url string, facade shared.FacadeHandler) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -581,6 +708,7 @@ func getVmValuesRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/string", Open: true},
 					{Name: "/int", Open: true},
 					{Name: "/query", Open: true},
+					{Name: "/multi-query", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,272 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	// APIKeyContextKey is the gin context key holding the name of the API key that identified the request
+	APIKeyContextKey = "apiKeyName"
+
+	// RouteClassRead is the class of the routes that only read data
+	RouteClassRead = "read"
+	// RouteClassSendTx is the class of the routes that send transactions
+	RouteClassSendTx = "send-tx"
+	// RouteClassVmQuery is the class of the routes that execute VM queries
+	RouteClassVmQuery = "vm-query"
+	// RouteClassSimulate is the class of the routes that simulate transactions or estimate their cost
+	RouteClassSimulate = "simulate"
+
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+var allRouteClasses = []string{RouteClassRead, RouteClassSendTx, RouteClassVmQuery, RouteClassSimulate}
+
+var routeClasses = map[string]string{
+	"/transaction/send":          RouteClassSendTx,
+	"/transaction/send-multiple": RouteClassSendTx,
+	"/transaction/simulate":      RouteClassSimulate,
+	"/transaction/cost":          RouteClassSimulate,
+}
+
+type apiKeyClient struct {
+	name    string
+	buckets map[string]*tokenBucket
+}
+
+// apiKeyThrottler is a middleware limiter that applies token bucket quotas, per route class, to the requests carrying
+// an API key. Each key has a quota for every route class
+type apiKeyThrottler struct {
+	mut        sync.Mutex
+	headerName string
+	clients    map[string]*apiKeyClient
+	timeNow    func() time.Time
+}
+
+// NewAPIKeyThrottler creates a new instance of apiKeyThrottler
+func NewAPIKeyThrottler(apiKeysConfig config.WebServerAPIKeysConfig) (*apiKeyThrottler, error) {
+	err := CheckAPIKeysConfig(apiKeysConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, _ := createAPIKeyClients(apiKeysConfig, nil, time.Now())
+
+	return &apiKeyThrottler{
+		headerName: apiKeysConfig.HeaderName,
+		clients:    clients,
+		timeNow:    time.Now,
+	}, nil
+}
+
+// CheckAPIKeysConfig returns an error if the provided API keys config can not be used
+func CheckAPIKeysConfig(apiKeysConfig config.WebServerAPIKeysConfig) error {
+	if len(apiKeysConfig.HeaderName) == 0 {
+		return fmt.Errorf("%w: empty header name", ErrInvalidAPIKey)
+	}
+
+	_, err := createAPIKeyClients(apiKeysConfig, nil, time.Now())
+
+	return err
+}
+
+// SetConfig replaces the API keys and their quotas. The buckets of the quotas left unchanged keep their tokens
+func (akt *apiKeyThrottler) SetConfig(apiKeysConfig config.WebServerAPIKeysConfig) error {
+	err := CheckAPIKeysConfig(apiKeysConfig)
+	if err != nil {
+		return err
+	}
+
+	akt.mut.Lock()
+	defer akt.mut.Unlock()
+
+	akt.clients, _ = createAPIKeyClients(apiKeysConfig, akt.clients, akt.timeNow())
+	akt.headerName = apiKeysConfig.HeaderName
+
+	return nil
+}
+
+// createAPIKeyClients creates the clients of the provided config, reusing the buckets of the old clients having the same
+// name, route class and quota
+func createAPIKeyClients(apiKeysConfig config.WebServerAPIKeysConfig, oldClients map[string]*apiKeyClient, now time.Time) (map[string]*apiKeyClient, error) {
+	oldBuckets := make(map[string]map[string]*tokenBucket, len(oldClients))
+	for _, oldClient := range oldClients {
+		oldBuckets[oldClient.name] = oldClient.buckets
+	}
+
+	clients := make(map[string]*apiKeyClient, len(apiKeysConfig.Keys))
+	clientsByName := make(map[string]*apiKeyClient, len(apiKeysConfig.Keys))
+	for idx, keyConfig := range apiKeysConfig.Keys {
+		if len(keyConfig.Name) == 0 {
+			return nil, fmt.Errorf("%w: empty name for key at index %d", ErrInvalidAPIKey, idx)
+		}
+		_, exists := clientsByName[keyConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated name %s", ErrInvalidAPIKey, keyConfig.Name)
+		}
+
+		keyHash, err := hex.DecodeString(keyConfig.KeySHA256)
+		if err != nil || len(keyHash) != sha256.Size {
+			return nil, fmt.Errorf("%w: KeySHA256 of key %s should be a hex encoded sha256 hash", ErrInvalidAPIKey, keyConfig.Name)
+		}
+		normalizedKeyHash := hex.EncodeToString(keyHash)
+		_, exists = clients[normalizedKeyHash]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated hash for key %s", ErrInvalidAPIKey, keyConfig.Name)
+		}
+
+		client := &apiKeyClient{
+			name:    keyConfig.Name,
+			buckets: make(map[string]*tokenBucket),
+		}
+		clients[normalizedKeyHash] = client
+		clientsByName[keyConfig.Name] = client
+	}
+
+	for _, quotaConfig := range apiKeysConfig.Quotas {
+		client, ok := clientsByName[quotaConfig.KeyName]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown key %s", ErrInvalidAPIKeyQuota, quotaConfig.KeyName)
+		}
+
+		err := checkRouteClass(quotaConfig.RouteClass)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %s", err, quotaConfig.KeyName)
+		}
+		_, exists := client.buckets[quotaConfig.RouteClass]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated route class %s for key %s", ErrInvalidAPIKeyQuota, quotaConfig.RouteClass, quotaConfig.KeyName)
+		}
+		if quotaConfig.RequestsPerSecond == 0 || quotaConfig.Burst == 0 {
+			return nil, fmt.Errorf("%w: RequestsPerSecond and Burst should be greater than 0 for key %s, route class %s",
+				ErrInvalidAPIKeyQuota, quotaConfig.KeyName, quotaConfig.RouteClass)
+		}
+
+		bucket := newTokenBucket(quotaConfig.RequestsPerSecond, quotaConfig.Burst, now)
+		oldBucket, exists := oldBuckets[quotaConfig.KeyName][quotaConfig.RouteClass]
+		if exists && oldBucket.hasSameQuota(bucket) {
+			bucket = oldBucket
+		}
+		client.buckets[quotaConfig.RouteClass] = bucket
+	}
+
+	for _, client := range clientsByName {
+		for _, routeClass := range allRouteClasses {
+			_, exists := client.buckets[routeClass]
+			if !exists {
+				return nil, fmt.Errorf("%w: missing quota for route class %s of key %s", ErrInvalidAPIKeyQuota, routeClass, client.name)
+			}
+		}
+	}
+
+	return clients, nil
+}
+
+func checkRouteClass(routeClass string) error {
+	switch routeClass {
+	case RouteClassRead, RouteClassSendTx, RouteClassVmQuery, RouteClassSimulate:
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown route class %s", ErrInvalidAPIKeyQuota, routeClass)
+	}
+}
+
+// getRouteClass returns the class of the provided route, as returned by gin.Context.FullPath
+func getRouteClass(route string) string {
+	routeClass, ok := routeClasses[route]
+	if ok {
+		return routeClass
+	}
+	if strings.HasPrefix(route, "/vm-values/") {
+		return RouteClassVmQuery
+	}
+
+	return RouteClassRead
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests. The requests carrying
+// an unknown key are rejected right away, at the cost of hashing the key, while the ones carrying a known key are marked
+// with APIKeyContextKey, so the source limiter does not apply to them
+func (akt *apiKeyThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		akt.mut.Lock()
+		headerName := akt.headerName
+		akt.mut.Unlock()
+
+		apiKey := c.GetHeader(headerName)
+		if len(apiKey) == 0 {
+			c.Next()
+			return
+		}
+
+		keyHash := sha256.Sum256([]byte(apiKey))
+		routeClass := getRouteClass(c.FullPath())
+
+		akt.mut.Lock()
+		client, ok := akt.clients[hex.EncodeToString(keyHash[:])]
+		var state tokenBucketState
+		var retryAfter uint64
+		if ok {
+			bucket := client.buckets[routeClass]
+			state = bucket.take(akt.timeNow())
+			retryAfter = bucket.secondsUntilNextToken()
+		}
+		akt.mut.Unlock()
+
+		if !ok {
+			log.Debug("API request with unknown API key", "path", c.Request.URL.Path, "remote address", c.Request.RemoteAddr)
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: ErrInvalidAPIKey.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Set(APIKeyContextKey, client.name)
+
+		c.Header(headerRateLimitLimit, strconv.FormatUint(state.limit, 10))
+		c.Header(headerRateLimitRemaining, strconv.FormatUint(state.remaining, 10))
+		c.Header(headerRateLimitReset, strconv.FormatUint(state.resetSeconds, 10))
+
+		if !state.isAllowed {
+			metricsRegistry.APIKeyRequests.WithLabelValues(client.name, routeClass, metricsRegistry.OutcomeThrottled).Inc()
+			c.Header(headerRetryAfter, strconv.FormatUint(retryAfter, 10))
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for key %s, route class %s", ErrAPIKeyQuotaExceeded.Error(), client.name, routeClass),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		metricsRegistry.APIKeyRequests.WithLabelValues(client.name, routeClass, metricsRegistry.OutcomeAllowed).Inc()
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akt *apiKeyThrottler) IsInterfaceNil() bool {
+	return akt == nil
+}
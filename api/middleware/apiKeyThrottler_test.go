@@ -0,0 +1,277 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKeyHeader = "X-Api-Key"
+
+func createMockAPIKeysConfig() config.WebServerAPIKeysConfig {
+	return config.WebServerAPIKeysConfig{
+		Enabled:    true,
+		HeaderName: apiKeyHeader,
+		Keys: []config.WebServerAPIKeyConfig{
+			{Name: "partner-a", KeySHA256: hashToken("key a")},
+			{Name: "partner-b", KeySHA256: hashToken("key b")},
+		},
+		Quotas: []config.WebServerAPIKeyQuotaConfig{
+			{KeyName: "partner-a", RouteClass: RouteClassRead, RequestsPerSecond: 1, Burst: 2},
+			{KeyName: "partner-a", RouteClass: RouteClassSendTx, RequestsPerSecond: 1, Burst: 1},
+			{KeyName: "partner-a", RouteClass: RouteClassVmQuery, RequestsPerSecond: 1, Burst: 1},
+			{KeyName: "partner-a", RouteClass: RouteClassSimulate, RequestsPerSecond: 1, Burst: 1},
+			{KeyName: "partner-b", RouteClass: RouteClassRead, RequestsPerSecond: 1, Burst: 1},
+			{KeyName: "partner-b", RouteClass: RouteClassSendTx, RequestsPerSecond: 1, Burst: 1},
+			{KeyName: "partner-b", RouteClass: RouteClassVmQuery, RequestsPerSecond: 1, Burst: 1},
+			{KeyName: "partner-b", RouteClass: RouteClassSimulate, RequestsPerSecond: 1, Burst: 1},
+		},
+	}
+}
+
+func startNodeServerAPIKeyThrottler(akt *apiKeyThrottler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(akt.MiddlewareHandlerFunc())
+
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, c.GetString(APIKeyContextKey))
+	}
+	ws.GET("/node/status", handler)
+	ws.POST("/transaction/send", handler)
+	ws.POST("/vm-values/query", handler)
+
+	return ws
+}
+
+func TestNewAPIKeyThrottler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty header name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAPIKeysConfig()
+		cfg.HeaderName = ""
+		akt, err := NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKey))
+		require.True(t, check.IfNil(akt))
+	})
+	t.Run("invalid keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAPIKeysConfig()
+		cfg.Keys[0].Name = ""
+		_, err := NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKey))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Keys[1].Name = cfg.Keys[0].Name
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKey))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Keys[0].KeySHA256 = "key a"
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKey))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Keys[1].KeySHA256 = strings.ToUpper(cfg.Keys[0].KeySHA256)
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKey))
+	})
+	t.Run("invalid quotas should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAPIKeysConfig()
+		cfg.Quotas[0].KeyName = "partner-c"
+		_, err := NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Quotas[0].RouteClass = "write"
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Quotas[1].RouteClass = RouteClassRead
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Quotas[0].RequestsPerSecond = 0
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Quotas[0].Burst = 0
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+
+		cfg = createMockAPIKeysConfig()
+		cfg.Quotas = cfg.Quotas[:len(cfg.Quotas)-1]
+		_, err = NewAPIKeyThrottler(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+		require.Contains(t, err.Error(), "missing quota for route class simulate of key partner-b")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		akt, err := NewAPIKeyThrottler(createMockAPIKeysConfig())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(akt))
+	})
+}
+
+func TestGetRouteClass(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, RouteClassSendTx, getRouteClass("/transaction/send"))
+	require.Equal(t, RouteClassSendTx, getRouteClass("/transaction/send-multiple"))
+	require.Equal(t, RouteClassSimulate, getRouteClass("/transaction/simulate"))
+	require.Equal(t, RouteClassSimulate, getRouteClass("/transaction/cost"))
+	require.Equal(t, RouteClassVmQuery, getRouteClass("/vm-values/query"))
+	require.Equal(t, RouteClassRead, getRouteClass("/transaction/:txhash"))
+	require.Equal(t, RouteClassRead, getRouteClass(""))
+}
+
+func TestAPIKeyThrottler_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request without key should pass", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := NewAPIKeyThrottler(createMockAPIKeysConfig())
+		ws := startNodeServerAPIKeyThrottler(akt)
+
+		for i := 0; i < 5; i++ {
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/node/status", nil))
+			require.Equal(t, http.StatusOK, resp.Code)
+			require.Empty(t, resp.Header().Get(headerRateLimitLimit))
+		}
+	})
+	t.Run("request with unknown key should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := NewAPIKeyThrottler(createMockAPIKeysConfig())
+		ws := startNodeServerAPIKeyThrottler(akt)
+
+		req := httptest.NewRequest(http.MethodGet, "/node/status", nil)
+		req.Header.Set(apiKeyHeader, "key c")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+		require.Contains(t, resp.Body.String(), ErrInvalidAPIKey.Error())
+	})
+	t.Run("quotas should be applied per key and route class", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := NewAPIKeyThrottler(createMockAPIKeysConfig())
+		now := time.Unix(1000, 0)
+		akt.timeNow = func() time.Time {
+			return now
+		}
+		for _, client := range akt.clients {
+			for _, bucket := range client.buckets {
+				bucket.lastRefill = now
+			}
+		}
+		ws := startNodeServerAPIKeyThrottler(akt)
+
+		serve := func(method string, path string, key string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, nil)
+			req.Header.Set(apiKeyHeader, key)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			return resp
+		}
+
+		resp := serve(http.MethodGet, "/node/status", "key a")
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, `"partner-a"`, resp.Body.String())
+		require.Equal(t, "2", resp.Header().Get(headerRateLimitLimit))
+		require.Equal(t, "1", resp.Header().Get(headerRateLimitRemaining))
+		require.Equal(t, "1", resp.Header().Get(headerRateLimitReset))
+
+		resp = serve(http.MethodGet, "/node/status", "key a")
+		require.Equal(t, http.StatusOK, resp.Code)
+		require.Equal(t, "0", resp.Header().Get(headerRateLimitRemaining))
+
+		resp = serve(http.MethodGet, "/node/status", "key a")
+		require.Equal(t, http.StatusTooManyRequests, resp.Code)
+		require.Equal(t, "1", resp.Header().Get(headerRetryAfter))
+		require.Contains(t, resp.Body.String(), ErrAPIKeyQuotaExceeded.Error())
+
+		// the other route classes and the other keys have their own buckets
+		resp = serve(http.MethodPost, "/transaction/send", "key a")
+		require.Equal(t, http.StatusOK, resp.Code)
+		resp = serve(http.MethodGet, "/node/status", "key b")
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		resp = serve(http.MethodPost, "/vm-values/query", "key a")
+		require.Equal(t, http.StatusOK, resp.Code)
+		resp = serve(http.MethodPost, "/vm-values/query", "key a")
+		require.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+		now = now.Add(time.Second)
+		resp = serve(http.MethodGet, "/node/status", "key a")
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		metrics, err := metricsRegistry.OpenMetricsString()
+		require.Nil(t, err)
+		require.Contains(t, metrics, `erd_api_key_requests_total{key="partner-a",outcome="throttled",routeClass="read"}`)
+		require.Contains(t, metrics, `erd_api_key_requests_total{key="partner-a",outcome="throttled",routeClass="vm-query"}`)
+	})
+}
+
+func TestAPIKeyThrottler_SetConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error and keep the old keys", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := NewAPIKeyThrottler(createMockAPIKeysConfig())
+		cfg := createMockAPIKeysConfig()
+		cfg.Quotas = cfg.Quotas[1:]
+		err := akt.SetConfig(cfg)
+		require.True(t, errors.Is(err, ErrInvalidAPIKeyQuota))
+		require.Len(t, akt.clients, 2)
+	})
+	t.Run("should replace the keys and keep the buckets of the unchanged quotas", func(t *testing.T) {
+		t.Parallel()
+
+		akt, _ := NewAPIKeyThrottler(createMockAPIKeysConfig())
+		ws := startNodeServerAPIKeyThrottler(akt)
+		serve := func(method string, path string, key string) int {
+			req := httptest.NewRequest(method, path, nil)
+			req.Header.Set(apiKeyHeader, key)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			return resp.Code
+		}
+		require.Equal(t, http.StatusOK, serve(http.MethodPost, "/transaction/send", "key a"))
+		require.Equal(t, http.StatusOK, serve(http.MethodPost, "/vm-values/query", "key a"))
+
+		cfg := createMockAPIKeysConfig()
+		cfg.Keys = cfg.Keys[:1]
+		cfg.Quotas = cfg.Quotas[:4]
+		cfg.Quotas[2].Burst = 2
+		err := akt.SetConfig(cfg)
+		require.Nil(t, err)
+
+		// the send-tx bucket kept its state while the vm-query one was created again with the new burst
+		require.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "/transaction/send", "key a"))
+		require.Equal(t, http.StatusOK, serve(http.MethodPost, "/vm-values/query", "key a"))
+		require.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/node/status", "key b"))
+	})
+}
@@ -0,0 +1,246 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+	anonymousIdentity   = "anonymous"
+)
+
+// ArgsAuthenticationMiddleware holds the arguments needed to create a new instance of authenticationMiddleware
+type ArgsAuthenticationMiddleware struct {
+	Tokens             []config.ApiTokenConfig
+	ClientCertificates []config.ApiClientCertificateConfig
+	RouteRoles         map[string]Role
+}
+
+type tokenIdentity struct {
+	name      string
+	tokenHash []byte
+	role      Role
+}
+
+type callerIdentity struct {
+	name string
+	role Role
+}
+
+// authenticationMiddleware is a middleware that only lets through the requests whose credentials grant the role
+// required by the requested route. The credentials can be a bearer token and/or a verified client certificate
+type authenticationMiddleware struct {
+	tokens             []tokenIdentity
+	clientCertificates map[string]Role
+	routeRoles         map[string]Role
+}
+
+// NewAuthenticationMiddleware creates a new instance of authenticationMiddleware
+func NewAuthenticationMiddleware(args ArgsAuthenticationMiddleware) (*authenticationMiddleware, error) {
+	tokens, err := createTokenIdentities(args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCertificates, err := createClientCertificateRoles(args.ClientCertificates)
+	if err != nil {
+		return nil, err
+	}
+
+	routeRoles := make(map[string]Role, len(args.RouteRoles))
+	for route, role := range args.RouteRoles {
+		routeRoles[route] = role
+	}
+
+	return &authenticationMiddleware{
+		tokens:             tokens,
+		clientCertificates: clientCertificates,
+		routeRoles:         routeRoles,
+	}, nil
+}
+
+func createTokenIdentities(tokensConfig []config.ApiTokenConfig) ([]tokenIdentity, error) {
+	tokens := make([]tokenIdentity, 0, len(tokensConfig))
+	for idx, tokenConfig := range tokensConfig {
+		if len(tokenConfig.Name) == 0 {
+			return nil, fmt.Errorf("%w: empty name for token at index %d", ErrInvalidToken, idx)
+		}
+
+		tokenHash, err := hex.DecodeString(tokenConfig.TokenSHA256)
+		if err != nil || len(tokenHash) != sha256.Size {
+			return nil, fmt.Errorf("%w: TokenSHA256 of token %s should be a hex encoded sha256 hash", ErrInvalidToken, tokenConfig.Name)
+		}
+
+		role, err := ParseRole(tokenConfig.Role)
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s", err, tokenConfig.Name)
+		}
+
+		for _, token := range tokens {
+			if subtle.ConstantTimeCompare(token.tokenHash, tokenHash) == 1 {
+				return nil, fmt.Errorf("%w: tokens %s and %s", ErrDuplicatedToken, token.name, tokenConfig.Name)
+			}
+		}
+
+		tokens = append(tokens, tokenIdentity{
+			name:      tokenConfig.Name,
+			tokenHash: tokenHash,
+			role:      role,
+		})
+	}
+
+	return tokens, nil
+}
+
+func createClientCertificateRoles(certificatesConfig []config.ApiClientCertificateConfig) (map[string]Role, error) {
+	clientCertificates := make(map[string]Role, len(certificatesConfig))
+	for idx, certificateConfig := range certificatesConfig {
+		if len(certificateConfig.CommonName) == 0 {
+			return nil, fmt.Errorf("%w: empty common name at index %d", ErrInvalidClientCertificate, idx)
+		}
+
+		_, exists := clientCertificates[certificateConfig.CommonName]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated common name %s", ErrInvalidClientCertificate, certificateConfig.CommonName)
+		}
+
+		role, err := ParseRole(certificateConfig.Role)
+		if err != nil {
+			return nil, fmt.Errorf("%w for client certificate %s", err, certificateConfig.CommonName)
+		}
+
+		clientCertificates[certificateConfig.CommonName] = role
+	}
+
+	return clientCertificates, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (am *authenticationMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requiredRole := am.routeRoles[c.FullPath()]
+		if requiredRole == RolePublic {
+			c.Next()
+			return
+		}
+
+		identity, err := am.identifyCaller(c.Request)
+		if err != nil {
+			am.deny(c, http.StatusUnauthorized, err, requiredRole, identity)
+			return
+		}
+
+		if identity.role >= requiredRole {
+			c.Next()
+			return
+		}
+
+		if identity.name == anonymousIdentity {
+			am.deny(c, http.StatusUnauthorized, ErrUnauthenticatedRequest, requiredRole, identity)
+			return
+		}
+
+		am.deny(c, http.StatusForbidden, ErrForbiddenRequest, requiredRole, identity)
+	}
+}
+
+// identifyCaller returns the highest role granted by the credentials of the request. A request without credentials is
+// anonymous and has the public role, while a request with an unknown bearer token is rejected
+func (am *authenticationMiddleware) identifyCaller(request *http.Request) (callerIdentity, error) {
+	identity := callerIdentity{
+		name: anonymousIdentity,
+		role: RolePublic,
+	}
+
+	commonName, hasCertificate := getVerifiedCommonName(request)
+	if hasCertificate {
+		role, ok := am.clientCertificates[commonName]
+		if ok {
+			identity = callerIdentity{
+				name: "certificate " + commonName,
+				role: role,
+			}
+		}
+	}
+
+	authorization := request.Header.Get(authorizationHeader)
+	if len(authorization) == 0 {
+		return identity, nil
+	}
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return identity, fmt.Errorf("%w: unsupported authorization scheme", ErrUnauthenticatedRequest)
+	}
+
+	token, ok := am.findToken(strings.TrimPrefix(authorization, bearerPrefix))
+	if !ok {
+		return identity, fmt.Errorf("%w: invalid bearer token", ErrUnauthenticatedRequest)
+	}
+
+	if token.role >= identity.role {
+		identity = callerIdentity{
+			name: "token " + token.name,
+			role: token.role,
+		}
+	}
+
+	return identity, nil
+}
+
+func (am *authenticationMiddleware) findToken(token string) (tokenIdentity, bool) {
+	tokenHash := sha256.Sum256([]byte(token))
+
+	found := tokenIdentity{}
+	isFound := false
+	for _, identity := range am.tokens {
+		if subtle.ConstantTimeCompare(identity.tokenHash, tokenHash[:]) == 1 {
+			found = identity
+			isFound = true
+		}
+	}
+
+	return found, isFound
+}
+
+func getVerifiedCommonName(request *http.Request) (string, bool) {
+	if request.TLS == nil || len(request.TLS.VerifiedChains) == 0 || len(request.TLS.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return request.TLS.VerifiedChains[0][0].Subject.CommonName, true
+}
+
+func (am *authenticationMiddleware) deny(c *gin.Context, status int, err error, requiredRole Role, identity callerIdentity) {
+	log.Warn("denied API request",
+		"path", c.Request.URL.Path,
+		"method", c.Request.Method,
+		"remote address", c.Request.RemoteAddr,
+		"required role", requiredRole.String(),
+		"identity", identity.name,
+		"identity role", identity.role.String(),
+		"error", err.Error(),
+	)
+
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (am *authenticationMiddleware) IsInterfaceNil() bool {
+	return am == nil
+}
@@ -0,0 +1,232 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operatorToken = "operator token"
+	adminToken    = "admin token"
+)
+
+func hashToken(token string) string {
+	tokenHash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(tokenHash[:])
+}
+
+func createMockArgsAuthenticationMiddleware() ArgsAuthenticationMiddleware {
+	return ArgsAuthenticationMiddleware{
+		Tokens: []config.ApiTokenConfig{
+			{Name: "operator", Role: "operator", TokenSHA256: hashToken(operatorToken)},
+			{Name: "admin", Role: "admin", TokenSHA256: hashToken(adminToken)},
+		},
+		ClientCertificates: []config.ApiClientCertificateConfig{
+			{CommonName: "operator.local", Role: "operator"},
+		},
+		RouteRoles: map[string]Role{
+			"/node/debug":       RoleOperator,
+			"/hardfork/trigger": RoleAdmin,
+		},
+	}
+}
+
+func startNodeServerAuthentication(am *authenticationMiddleware) *gin.Engine {
+	ws := gin.New()
+	ws.Use(am.MiddlewareHandlerFunc())
+
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	}
+	ws.GET("/node/status", handler)
+	ws.GET("/node/debug", handler)
+	ws.POST("/hardfork/trigger", handler)
+
+	return ws
+}
+
+func createRequestWithClientCertificate(method string, path string, commonName string) *http.Request {
+	req := httptest.NewRequest(method, path, nil)
+	req.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{
+			{{Subject: pkix.Name{CommonName: commonName}}},
+		},
+	}
+
+	return req
+}
+
+func TestNewAuthenticationMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("token without name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.Tokens[0].Name = ""
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidToken))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("token with invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.Tokens[0].TokenSHA256 = operatorToken
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidToken))
+		require.True(t, check.IfNil(am))
+
+		args.Tokens[0].TokenSHA256 = "aabb"
+		am, err = NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidToken))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("token with invalid role should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.Tokens[0].Role = "root"
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidRole))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("duplicated token should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.Tokens[1].TokenSHA256 = args.Tokens[0].TokenSHA256
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrDuplicatedToken))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("client certificate without common name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.ClientCertificates[0].CommonName = ""
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidClientCertificate))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("duplicated client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.ClientCertificates = append(args.ClientCertificates, args.ClientCertificates[0])
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidClientCertificate))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("client certificate with invalid role should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuthenticationMiddleware()
+		args.ClientCertificates[0].Role = "root"
+		am, err := NewAuthenticationMiddleware(args)
+		require.True(t, errors.Is(err, ErrInvalidRole))
+		require.True(t, check.IfNil(am))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		am, err := NewAuthenticationMiddleware(createMockArgsAuthenticationMiddleware())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(am))
+	})
+}
+
+func TestAuthenticationMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	am, _ := NewAuthenticationMiddleware(createMockArgsAuthenticationMiddleware())
+	ws := startNodeServerAuthentication(am)
+
+	serve := func(req *http.Request, token string) *httptest.ResponseRecorder {
+		if len(token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	t.Run("public route should not require credentials", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(httptest.NewRequest(http.MethodGet, "/node/status", nil), "")
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		resp = serve(httptest.NewRequest(http.MethodGet, "/node/status", nil), "invalid token")
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("restricted route without credentials should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(httptest.NewRequest(http.MethodGet, "/node/debug", nil), "")
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+		require.True(t, strings.Contains(resp.Body.String(), ErrUnauthenticatedRequest.Error()))
+	})
+	t.Run("invalid token should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(httptest.NewRequest(http.MethodGet, "/node/debug", nil), "invalid token")
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+
+		req := httptest.NewRequest(http.MethodGet, "/node/debug", nil)
+		req.Header.Set("Authorization", "Basic "+operatorToken)
+		resp = serve(req, "")
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("lower role should be forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(httptest.NewRequest(http.MethodPost, "/hardfork/trigger", nil), operatorToken)
+		require.Equal(t, http.StatusForbidden, resp.Code)
+		require.True(t, strings.Contains(resp.Body.String(), ErrForbiddenRequest.Error()))
+	})
+	t.Run("higher role should grant access to the lower roles routes", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(httptest.NewRequest(http.MethodGet, "/node/debug", nil), operatorToken)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		resp = serve(httptest.NewRequest(http.MethodGet, "/node/debug", nil), adminToken)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		resp = serve(httptest.NewRequest(http.MethodPost, "/hardfork/trigger", nil), adminToken)
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("verified client certificate should grant its role", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(createRequestWithClientCertificate(http.MethodGet, "/node/debug", "operator.local"), "")
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		resp = serve(createRequestWithClientCertificate(http.MethodPost, "/hardfork/trigger", "operator.local"), "")
+		require.Equal(t, http.StatusForbidden, resp.Code)
+
+		resp = serve(createRequestWithClientCertificate(http.MethodGet, "/node/debug", "unknown.local"), "")
+		require.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("the highest role of the provided credentials should be used", func(t *testing.T) {
+		t.Parallel()
+
+		resp := serve(createRequestWithClientCertificate(http.MethodPost, "/hardfork/trigger", "operator.local"), adminToken)
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+}
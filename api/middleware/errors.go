@@ -7,3 +7,39 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrInvalidRole signals that an unknown role name was provided
+var ErrInvalidRole = errors.New("invalid role")
+
+// ErrInvalidToken signals that an invalid bearer token configuration was provided
+var ErrInvalidToken = errors.New("invalid token")
+
+// ErrDuplicatedToken signals that the same bearer token was configured more than once
+var ErrDuplicatedToken = errors.New("duplicated token")
+
+// ErrInvalidClientCertificate signals that an invalid client certificate configuration was provided
+var ErrInvalidClientCertificate = errors.New("invalid client certificate")
+
+// ErrUnauthenticatedRequest signals that the request did not provide valid credentials for the requested route
+var ErrUnauthenticatedRequest = errors.New("unauthenticated request")
+
+// ErrForbiddenRequest signals that the credentials provided by the request do not grant access to the requested route
+var ErrForbiddenRequest = errors.New("the provided credentials do not grant access to this route")
+
+// ErrInvalidAPIKey signals that an invalid API key configuration was provided or that a request carried an unknown API key
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// ErrInvalidAPIKeyQuota signals that an invalid API key quota configuration was provided
+var ErrInvalidAPIKeyQuota = errors.New("invalid API key quota")
+
+// ErrAPIKeyQuotaExceeded signals that the quota of an API key was exhausted
+var ErrAPIKeyQuotaExceeded = errors.New("API key quota exceeded")
+
+// ErrInvalidMaxNumStreams signals that a provided number of simultaneous streams is invalid
+var ErrInvalidMaxNumStreams = errors.New("max number of streams value is invalid")
+
+// ErrTooManyStreams signals that too many streams are simultaneously open
+var ErrTooManyStreams = errors.New("too many streams")
+
+// ErrNilMiddleware signals that a nil middleware was provided
+var ErrNilMiddleware = errors.New("nil middleware")
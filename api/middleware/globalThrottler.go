@@ -15,9 +15,10 @@ var log = logger.GetOrCreate("api/middleware")
 
 // globalThrottler is a middleware global limiter used to limit total number of simultaneous requests
 type globalThrottler struct {
-	queue            chan struct{}
-	mutDebugRequests sync.Mutex
-	debugRequests    map[string]int
+	mutRequests    sync.Mutex
+	numRequests    uint32
+	maxNumRequests uint32
+	debugRequests  map[string]int
 }
 
 // NewGlobalThrottler creates a new instance of a globalThrottler
@@ -27,8 +28,8 @@ func NewGlobalThrottler(maxConnections uint32) (*globalThrottler, error) {
 	}
 
 	return &globalThrottler{
-		queue:         make(chan struct{}, maxConnections),
-		debugRequests: make(map[string]int),
+		maxNumRequests: maxConnections,
+		debugRequests:  make(map[string]int),
 	}, nil
 }
 
@@ -37,12 +38,15 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		path := c.Request.URL.Path
 
-		select {
-		case gt.queue <- struct{}{}:
-			gt.mutDebugRequests.Lock()
+		gt.mutRequests.Lock()
+		isQuotaReached := gt.numRequests >= gt.maxNumRequests
+		if !isQuotaReached {
+			gt.numRequests++
 			gt.debugRequests[path]++
-			gt.mutDebugRequests.Unlock()
-		default:
+		}
+		gt.mutRequests.Unlock()
+
+		if isQuotaReached {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
@@ -64,23 +68,36 @@ func (gt *globalThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 }
 
 func (gt *globalThrottler) finish(path string) {
-	gt.mutDebugRequests.Lock()
+	gt.mutRequests.Lock()
+	gt.numRequests--
 	gt.debugRequests[path]--
 	if gt.debugRequests[path] < 1 {
 		delete(gt.debugRequests, path)
 	}
-	gt.mutDebugRequests.Unlock()
+	gt.mutRequests.Unlock()
+}
+
+// SetMaxNumRequests changes the maximum number of simultaneous requests, keeping the requests already in progress. If
+// the new limit is lower than the number of requests in progress, the new requests are rejected until enough of them finish
+func (gt *globalThrottler) SetMaxNumRequests(maxConnections uint32) error {
+	if maxConnections == 0 {
+		return ErrInvalidMaxNumRequests
+	}
+
+	gt.mutRequests.Lock()
+	gt.maxNumRequests = maxConnections
+	gt.mutRequests.Unlock()
 
-	<-gt.queue
+	return nil
 }
 
 func (gt *globalThrottler) printDebugInfo() {
-	gt.mutDebugRequests.Lock()
+	gt.mutRequests.Lock()
 	infoLines := make([]string, 0, len(gt.debugRequests))
 	for requestPath, counter := range gt.debugRequests {
 		infoLines = append(infoLines, fmt.Sprintf("%s: %d", requestPath, counter))
 	}
-	gt.mutDebugRequests.Unlock()
+	gt.mutRequests.Unlock()
 
 	log.Debug(fmt.Sprintf("API engine stuck: \n%s", strings.Join(infoLines, "\n")))
 }
@@ -112,3 +112,48 @@ func makeRequestGlobalThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestGlobalThrottler_SetMaxNumRequestsShouldKeepTheRequestsInProgress(t *testing.T) {
+	t.Parallel()
+
+	gt, _ := middleware.NewGlobalThrottler(1)
+	err := gt.SetMaxNumRequests(0)
+	assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
+
+	chanRequestStarted := make(chan struct{})
+	chanFinishRequest := make(chan struct{})
+	ws := gin.New()
+	ws.Use(gt.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/balance", func(c *gin.Context) {
+		chanRequestStarted <- struct{}{}
+		<-chanFinishRequest
+	})
+
+	mutResponses := sync.Mutex{}
+	responses := make(map[int]int)
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		makeRequestGlobalThrottler(ws, &mutResponses, responses)
+		wg.Done()
+	}()
+	<-chanRequestStarted
+
+	// the request in progress still counts against the new limit
+	err = gt.SetMaxNumRequests(2)
+	assert.Nil(t, err)
+	go func() {
+		makeRequestGlobalThrottler(ws, &mutResponses, responses)
+		wg.Done()
+	}()
+	<-chanRequestStarted
+	makeRequestGlobalThrottler(ws, &mutResponses, responses)
+
+	close(chanFinishRequest)
+	wg.Wait()
+
+	mutResponses.Lock()
+	assert.Equal(t, 2, responses[http.StatusOK])
+	assert.Equal(t, 1, responses[http.StatusTooManyRequests])
+	mutResponses.Unlock()
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Role defines the access level granted to an API caller. A higher role also grants access to the routes of the
+// lower roles
+type Role uint8
+
+const (
+	// RolePublic is the role of the callers that did not provide any credentials
+	RolePublic Role = iota
+	// RoleOperator is the role of the callers allowed to access the node's operational routes
+	RoleOperator
+	// RoleAdmin is the role of the callers allowed to access all the routes
+	RoleAdmin
+)
+
+const (
+	rolePublicName   = "public"
+	roleOperatorName = "operator"
+	roleAdminName    = "admin"
+)
+
+// ParseRole returns the role having the provided name. An empty name stands for the public role
+func ParseRole(name string) (Role, error) {
+	switch strings.ToLower(name) {
+	case "", rolePublicName:
+		return RolePublic, nil
+	case roleOperatorName:
+		return RoleOperator, nil
+	case roleAdminName:
+		return RoleAdmin, nil
+	default:
+		return RolePublic, fmt.Errorf("%w: %s", ErrInvalidRole, name)
+	}
+}
+
+// String returns the name of the role
+func (r Role) String() string {
+	switch r {
+	case RolePublic:
+		return rolePublicName
+	case RoleOperator:
+		return roleOperatorName
+	case RoleAdmin:
+		return roleAdminName
+	default:
+		return fmt.Sprintf("unknown role %d", r)
+	}
+}
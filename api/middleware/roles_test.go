@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := ParseRole("")
+	require.Nil(t, err)
+	require.Equal(t, RolePublic, role)
+
+	role, err = ParseRole("public")
+	require.Nil(t, err)
+	require.Equal(t, RolePublic, role)
+
+	role, err = ParseRole("Operator")
+	require.Nil(t, err)
+	require.Equal(t, RoleOperator, role)
+
+	role, err = ParseRole("admin")
+	require.Nil(t, err)
+	require.Equal(t, RoleAdmin, role)
+
+	_, err = ParseRole("root")
+	require.True(t, errors.Is(err, ErrInvalidRole))
+}
+
+func TestRole_String(t *testing.T) {
+	t.Parallel()
+
+	for _, role := range []Role{RolePublic, RoleOperator, RoleAdmin} {
+		parsedRole, err := ParseRole(role.String())
+		require.Nil(t, err)
+		require.Equal(t, role, parsedRole)
+	}
+	require.Equal(t, "unknown role 5", Role(5).String())
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// skippedRoutesMiddleware applies the wrapped middleware to all the routes but the skipped ones, e.g. the global
+// throttler and the response logger are not applied to the streaming routes
+type skippedRoutesMiddleware struct {
+	middleware    shared.MiddlewareProcessor
+	skippedRoutes map[string]struct{}
+}
+
+// NewSkippedRoutesMiddleware creates a new instance of skippedRoutesMiddleware
+func NewSkippedRoutesMiddleware(middleware shared.MiddlewareProcessor, skippedRoutes map[string]struct{}) (*skippedRoutesMiddleware, error) {
+	if check.IfNil(middleware) {
+		return nil, ErrNilMiddleware
+	}
+
+	return &skippedRoutesMiddleware{
+		middleware:    middleware,
+		skippedRoutes: skippedRoutes,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (srm *skippedRoutesMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	handler := srm.middleware.MiddlewareHandlerFunc()
+
+	return func(c *gin.Context) {
+		_, isSkipped := srm.skippedRoutes[c.FullPath()]
+		if isSkipped {
+			c.Next()
+			return
+		}
+
+		handler(c)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srm *skippedRoutesMiddleware) IsInterfaceNil() bool {
+	return srm == nil
+}
@@ -0,0 +1,71 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+type middlewareStub struct {
+	handler gin.HandlerFunc
+}
+
+func (stub *middlewareStub) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return stub.handler
+}
+
+func (stub *middlewareStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestNewSkippedRoutesMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil middleware should error", func(t *testing.T) {
+		t.Parallel()
+
+		srm, err := middleware.NewSkippedRoutesMiddleware(nil, nil)
+		assert.True(t, check.IfNil(srm))
+		assert.Equal(t, middleware.ErrNilMiddleware, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gt, _ := middleware.NewGlobalThrottler(1)
+		srm, err := middleware.NewSkippedRoutesMiddleware(gt, nil)
+		assert.False(t, check.IfNil(srm))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSkippedRoutesMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	calledPaths := make([]string, 0)
+	wrapped := &middlewareStub{
+		handler: func(c *gin.Context) {
+			calledPaths = append(calledPaths, c.FullPath())
+			c.Next()
+		},
+	}
+	srm, _ := middleware.NewSkippedRoutesMiddleware(wrapped, map[string]struct{}{"/events/sse": {}})
+
+	ws := gin.New()
+	ws.Use(srm.MiddlewareHandlerFunc())
+	ws.GET("/events/sse", func(c *gin.Context) {})
+	ws.GET("/node/status", func(c *gin.Context) {})
+
+	for _, path := range []string{"/events/sse", "/node/status"} {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	}
+
+	assert.Equal(t, []string{"/node/status"}, calledPaths)
+}
@@ -30,9 +30,16 @@ func NewSourceThrottler(maxNumRequests uint32) (*sourceThrottler, error) {
 	}, nil
 }
 
-// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests. The requests identified
+// by a known API key are not limited, as several clients having their own quotas can share the same source address
 func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		_, isIdentifiedByAPIKey := c.Get(APIKeyContextKey)
+		if isIdentifiedByAPIKey {
+			c.Next()
+			return
+		}
+
 		remoteAddr, _, err := net.SplitHostPort(c.Request.RemoteAddr)
 		if err != nil {
 			c.AbortWithStatusJSON(
@@ -68,6 +75,20 @@ func (st *sourceThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
 	}
 }
 
+// SetMaxNumRequests changes the maximum number of requests accepted from the same source, keeping the counters
+// accumulated since the last reset
+func (st *sourceThrottler) SetMaxNumRequests(maxNumRequests uint32) error {
+	if maxNumRequests == 0 {
+		return ErrInvalidMaxNumRequests
+	}
+
+	st.mutRequests.Lock()
+	st.maxNumRequests = maxNumRequests
+	st.mutRequests.Unlock()
+
+	return nil
+}
+
 // Reset resets all accumulated counters
 func (st *sourceThrottler) Reset() {
 	st.mutRequests.Lock()
@@ -121,6 +121,33 @@ func TestSourceThrottler_LimitResetShouldWork(t *testing.T) {
 	mutResponses.Unlock()
 }
 
+func TestSourceThrottler_SetMaxNumRequestsShouldKeepTheCounters(t *testing.T) {
+	t.Parallel()
+
+	ws := gin.New()
+	sourceThrottler, _ := middleware.NewSourceThrottler(2)
+	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+	ws.GET("/address/:address/balance", func(c *gin.Context) {})
+
+	err := sourceThrottler.SetMaxNumRequests(0)
+	assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
+
+	mutResponses := sync.Mutex{}
+	responses := make(map[int]int)
+	makeRequestSourceThrottler(ws, &mutResponses, responses)
+	makeRequestSourceThrottler(ws, &mutResponses, responses)
+
+	err = sourceThrottler.SetMaxNumRequests(3)
+	assert.Nil(t, err)
+	makeRequestSourceThrottler(ws, &mutResponses, responses)
+	makeRequestSourceThrottler(ws, &mutResponses, responses)
+
+	mutResponses.Lock()
+	assert.Equal(t, 3, responses[http.StatusOK])
+	assert.Equal(t, 1, responses[http.StatusTooManyRequests])
+	mutResponses.Unlock()
+}
+
 func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, responses map[int]int) {
 	addr := "testAddress"
 	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/balance", addr), nil)
@@ -132,3 +159,25 @@ func makeRequestSourceThrottler(ws *gin.Engine, mutResponses *sync.Mutex, respon
 	responses[resp.Code]++
 	mutResponses.Unlock()
 }
+
+func TestSourceThrottler_RequestIdentifiedByAPIKeyShouldNotBeLimited(t *testing.T) {
+	t.Parallel()
+
+	ws := gin.New()
+	ws.Use(func(c *gin.Context) {
+		c.Set(middleware.APIKeyContextKey, "partner")
+	})
+	sourceThrottler, _ := middleware.NewSourceThrottler(1)
+	ws.Use(sourceThrottler.MiddlewareHandlerFunc())
+	ws.GET("/node/status", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/node/status", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/node/status", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+// streamsThrottler is a middleware limiting the number of simultaneously open streams. The streaming routes keep the
+// connection open for as long as the client stays subscribed, so they are not subject to the global throttler, which
+// would otherwise be exhausted by the subscribers
+type streamsThrottler struct {
+	mutStreams      sync.Mutex
+	numStreams      uint32
+	maxStreams      uint32
+	streamingRoutes map[string]struct{}
+}
+
+// NewStreamsThrottler creates a new instance of a streamsThrottler, applied only to the provided streaming routes
+func NewStreamsThrottler(maxStreams uint32, streamingRoutes map[string]struct{}) (*streamsThrottler, error) {
+	if maxStreams == 0 {
+		return nil, ErrInvalidMaxNumStreams
+	}
+
+	return &streamsThrottler{
+		maxStreams:      maxStreams,
+		streamingRoutes: streamingRoutes,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (st *streamsThrottler) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		st.mutStreams.Lock()
+		_, isStreamingRoute := st.streamingRoutes[c.FullPath()]
+		isQuotaReached := isStreamingRoute && st.numStreams >= st.maxStreams
+		if isStreamingRoute && !isQuotaReached {
+			st.numStreams++
+		}
+		st.mutStreams.Unlock()
+
+		if !isStreamingRoute {
+			c.Next()
+			return
+		}
+
+		if isQuotaReached {
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: ErrTooManyStreams.Error(),
+					Code:  shared.ReturnCodeSystemBusy,
+				},
+			)
+			return
+		}
+
+		defer st.finish()
+
+		c.Next()
+	}
+}
+
+func (st *streamsThrottler) finish() {
+	st.mutStreams.Lock()
+	st.numStreams--
+	st.mutStreams.Unlock()
+}
+
+// SetLimits changes the maximum number of simultaneously open streams and the streaming routes, keeping the streams
+// already open
+func (st *streamsThrottler) SetLimits(maxStreams uint32, streamingRoutes map[string]struct{}) error {
+	if maxStreams == 0 {
+		return ErrInvalidMaxNumStreams
+	}
+
+	st.mutStreams.Lock()
+	st.maxStreams = maxStreams
+	st.streamingRoutes = streamingRoutes
+	st.mutStreams.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (st *streamsThrottler) IsInterfaceNil() bool {
+	return st == nil
+}
@@ -0,0 +1,131 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStreamsThrottler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max streams should error", func(t *testing.T) {
+		t.Parallel()
+
+		st, err := middleware.NewStreamsThrottler(0, nil)
+		assert.True(t, check.IfNil(st))
+		assert.Equal(t, middleware.ErrInvalidMaxNumStreams, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		st, err := middleware.NewStreamsThrottler(1, nil)
+		assert.False(t, check.IfNil(st))
+		assert.Nil(t, err)
+	})
+}
+
+func TestStreamsThrottler_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	st, _ := middleware.NewStreamsThrottler(1, map[string]struct{}{"/events/ws": {}})
+
+	chanStreamOpen := make(chan struct{})
+	chanCloseStream := make(chan struct{})
+	ws := gin.New()
+	ws.Use(st.MiddlewareHandlerFunc())
+	ws.GET("/events/ws", func(c *gin.Context) {
+		chanStreamOpen <- struct{}{}
+		<-chanCloseStream
+	})
+	ws.GET("/node/status", func(c *gin.Context) {})
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	chanStreamDone := make(chan *httptest.ResponseRecorder)
+	go func() {
+		chanStreamDone <- serve("/events/ws")
+	}()
+	<-chanStreamOpen
+
+	resp := serve("/events/ws")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	response := shared.GenericAPIResponse{}
+	require.Nil(t, json.Unmarshal(resp.Body.Bytes(), &response))
+	assert.Equal(t, middleware.ErrTooManyStreams.Error(), response.Error)
+	assert.Equal(t, shared.ReturnCodeSystemBusy, response.Code)
+
+	resp = serve("/node/status")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	close(chanCloseStream)
+	require.Equal(t, http.StatusOK, (<-chanStreamDone).Code)
+
+	go func() {
+		chanStreamDone <- serve("/events/ws")
+	}()
+	<-chanStreamOpen
+	require.Equal(t, http.StatusOK, (<-chanStreamDone).Code)
+}
+
+func TestStreamsThrottler_SetLimits(t *testing.T) {
+	t.Parallel()
+
+	st, _ := middleware.NewStreamsThrottler(1, map[string]struct{}{"/events/ws": {}})
+	err := st.SetLimits(0, nil)
+	assert.Equal(t, middleware.ErrInvalidMaxNumStreams, err)
+
+	chanStreamOpen := make(chan struct{})
+	chanCloseStream := make(chan struct{})
+	ws := gin.New()
+	ws.Use(st.MiddlewareHandlerFunc())
+	handler := func(c *gin.Context) {
+		chanStreamOpen <- struct{}{}
+		<-chanCloseStream
+	}
+	ws.GET("/events/ws", handler)
+	ws.GET("/log", handler)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", path, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	chanStreamDone := make(chan *httptest.ResponseRecorder, 2)
+	go func() {
+		chanStreamDone <- serve("/events/ws")
+	}()
+	<-chanStreamOpen
+
+	// the stream opened before the change still counts against the new limit
+	err = st.SetLimits(2, map[string]struct{}{"/events/ws": {}, "/log": {}})
+	require.Nil(t, err)
+	go func() {
+		chanStreamDone <- serve("/log")
+	}()
+	<-chanStreamOpen
+
+	resp := serve("/events/ws")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	close(chanCloseStream)
+	require.Equal(t, http.StatusOK, (<-chanStreamDone).Code)
+	require.Equal(t, http.StatusOK, (<-chanStreamDone).Code)
+}
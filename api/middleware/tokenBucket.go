@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"math"
+	"time"
+)
+
+// tokenBucket is a non-concurrent safe token bucket, refilled with ratePerSecond tokens each second, up to its capacity
+type tokenBucket struct {
+	capacity      float64
+	ratePerSecond float64
+	tokens        float64
+	lastRefill    time.Time
+}
+
+type tokenBucketState struct {
+	isAllowed    bool
+	limit        uint64
+	remaining    uint64
+	resetSeconds uint64
+}
+
+func newTokenBucket(ratePerSecond uint32, capacity uint32, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity:      float64(capacity),
+		ratePerSecond: float64(ratePerSecond),
+		tokens:        float64(capacity),
+		lastRefill:    now,
+	}
+}
+
+// take consumes one token, if available, and returns the state of the bucket after the attempt
+func (tb *tokenBucket) take(now time.Time) tokenBucketState {
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed > 0 {
+		tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.ratePerSecond)
+		tb.lastRefill = now
+	}
+
+	isAllowed := tb.tokens >= 1
+	if isAllowed {
+		tb.tokens--
+	}
+
+	return tokenBucketState{
+		isAllowed:    isAllowed,
+		limit:        uint64(tb.capacity),
+		remaining:    uint64(math.Floor(tb.tokens)),
+		resetSeconds: uint64(math.Ceil((tb.capacity - tb.tokens) / tb.ratePerSecond)),
+	}
+}
+
+// hasSameQuota returns true if the provided bucket has the same rate and capacity
+func (tb *tokenBucket) hasSameQuota(other *tokenBucket) bool {
+	return tb.ratePerSecond == other.ratePerSecond && tb.capacity == other.capacity
+}
+
+// secondsUntilNextToken returns the number of seconds after which the next token will be available
+func (tb *tokenBucket) secondsUntilNextToken() uint64 {
+	if tb.tokens >= 1 {
+		return 0
+	}
+
+	return uint64(math.Ceil((1 - tb.tokens) / tb.ratePerSecond))
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket_Take(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	bucket := newTokenBucket(2, 3, now)
+
+	for i := 2; i >= 0; i-- {
+		state := bucket.take(now)
+		require.True(t, state.isAllowed)
+		require.Equal(t, uint64(3), state.limit)
+		require.Equal(t, uint64(i), state.remaining)
+	}
+
+	state := bucket.take(now)
+	require.False(t, state.isAllowed)
+	require.Equal(t, uint64(0), state.remaining)
+	require.Equal(t, uint64(2), state.resetSeconds)
+	require.Equal(t, uint64(1), bucket.secondsUntilNextToken())
+
+	// half a second later, one token was added
+	now = now.Add(500 * time.Millisecond)
+	state = bucket.take(now)
+	require.True(t, state.isAllowed)
+	require.Equal(t, uint64(0), state.remaining)
+
+	// the bucket does not exceed its capacity
+	now = now.Add(time.Hour)
+	state = bucket.take(now)
+	require.True(t, state.isAllowed)
+	require.Equal(t, uint64(2), state.remaining)
+	require.Equal(t, uint64(1), state.resetSeconds)
+	require.Equal(t, uint64(0), bucket.secondsUntilNextToken())
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/common/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+const unmatchedRoute = "unmatched route"
+
+type tracingMiddleware struct {
+}
+
+// NewTracingMiddleware returns a new instance of tracingMiddleware
+func NewTracingMiddleware() *tracingMiddleware {
+	return &tracingMiddleware{}
+}
+
+// MiddlewareHandlerFunc starts a span for each request and makes it available to the handlers through the request's context
+func (tm *tracingMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = unmatchedRoute
+		}
+
+		ctx, span := tracing.StartSpan(
+			c.Request.Context(),
+			fmt.Sprintf("API %s %s", c.Request.Method, route),
+			semconv.HTTPMethodKey.String(c.Request.Method),
+			semconv.HTTPRouteKey.String(route),
+		)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(status))
+
+		var err error
+		if status >= http.StatusInternalServerError {
+			err = errors.New(http.StatusText(status))
+		}
+		tracing.EndSpan(span, err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tm *tracingMiddleware) IsInterfaceNil() bool {
+	return tm == nil
+}
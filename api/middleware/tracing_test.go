@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNewTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tm := NewTracingMiddleware()
+
+	assert.False(t, check.IfNil(tm))
+}
+
+func TestTracingMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	spanRecorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+
+	txHash := []byte("tx hash")
+	ws := gin.New()
+	ws.Use(NewTracingMiddleware().MiddlewareHandlerFunc())
+	ws.Handle(http.MethodPost, "/transaction/send", func(c *gin.Context) {
+		tracing.SetAttributes(c.Request.Context(), tracing.TxHash(txHash))
+		c.JSON(http.StatusOK, "ok")
+	})
+	ws.Handle(http.MethodGet, "/node/status", func(c *gin.Context) {
+		c.JSON(http.StatusInternalServerError, "not ok")
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/transaction/send", nil)
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+	req, _ = http.NewRequest(http.MethodGet, "/node/status", nil)
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := spanRecorder.Ended()
+	require.Equal(t, 2, len(spans))
+
+	assert.Equal(t, "API POST /transaction/send", spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), tracing.TxHash(txHash))
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("http.status_code", http.StatusOK))
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	assert.Equal(t, "API GET /node/status", spans[1].Name())
+	assert.Contains(t, spans[1].Attributes(), attribute.Int("http.status_code", http.StatusInternalServerError))
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -3,6 +3,7 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -35,6 +36,7 @@ type FacadeStub struct {
 	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*accounts.ValidatorApiResponse, error)
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
@@ -50,6 +52,7 @@ type FacadeStub struct {
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionCalled                      func(hash string) (*txSimData.TransactionTrace, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -76,13 +79,24 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                         func(request common.MultiProofRequest) (*common.MultiProofResponse, error)
+	VerifyMultiProofCalled                      func(request common.VerifyMultiProofRequest) (bool, error)
+	GetStateDiffCalled                          func(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error)
+	GetStorageReportCalled                      func(request common.StorageReportRequest) (*common.StorageReport, error)
 	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolCalled                   func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPoolCalled             func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPoolCalled           func(subscriptionID uint64)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCountersCalled     func() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPoolCalled         func(tx *transaction.Transaction, txHash []byte) ([]string, error)
+	GetTransactionsForAddressCalled             func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	SubscribeEventsCalled                       func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	UnsubscribeEventsCalled                     func(subscriptionID uint64)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
 	RestApiInterfaceCalled                      func() string
 	RestAPIServerDebugModeCalled                func() bool
@@ -93,6 +107,17 @@ type FacadeStub struct {
 	GetManagedKeysCalled                        func() []string
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
+	ReloadConfigCalled                          func() ([]common.ConfigChange, error)
+	GetRedundancyLeaseStatusCalled              func() (common.RedundancyLeaseStatus, error)
+	PromoteRedundancyLeaseCalled                func() (common.RedundancyLeaseStatus, error)
+	DemoteRedundancyLeaseCalled                 func() (common.RedundancyLeaseStatus, error)
+	GetPeersReputationCalled                    func() ([]*common.PeerReputation, error)
+	BanPeerCalled                               func(pid string, duration time.Duration, reason string) error
+	UnbanPeerCalled                             func(pid string) error
+	BanIPCalled                                 func(ip string, duration time.Duration, reason string) error
+	UnbanIPCalled                               func(ip string) error
+	GetOutportDriversCursorsCalled              func() (map[string]uint64, error)
+	ReplayOutportCalled                         func(driverID string, sequence uint64) error
 }
 
 // GetTokenSupply -
@@ -140,6 +165,42 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(request)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(request)
+	}
+
+	return false, nil
+}
+
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetStorageReport -
+func (f *FacadeStub) GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error) {
+	if f.GetStorageReportCalled != nil {
+		return f.GetStorageReportCalled(request)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (f *FacadeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if f.GetUsernameCalled != nil {
@@ -310,6 +371,15 @@ func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (
 	return f.SimulateTransactionExecutionHandler(tx)
 }
 
+// TraceTransaction -
+func (f *FacadeStub) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	if f.TraceTransactionCalled != nil {
+		return f.TraceTransactionCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
 func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return f.SendBulkTransactionsHandler(txs)
@@ -335,6 +405,15 @@ func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, ap
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries is a mock implementation.
+func (f *FacadeStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+	if f.ExecuteSCQueriesHandler != nil {
+		return f.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // StatusMetrics is the mock implementation for the StatusMetrics
 func (f *FacadeStub) StatusMetrics() external.StatusMetricsHandler {
 	return f.StatusMetricsHandler()
@@ -506,14 +585,30 @@ func (f *FacadeStub) GetGenesisBalances() ([]*common.InitialAccountAPI, error) {
 }
 
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (f *FacadeStub) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	if f.GetTransactionsPoolCalled != nil {
-		return f.GetTransactionsPoolCalled(fields)
+		return f.GetTransactionsPoolCalled(fields, filter)
+	}
+
+	return nil, nil
+}
+
+// SubscribeTransactionsPool -
+func (f *FacadeStub) SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	if f.SubscribeTransactionsPoolCalled != nil {
+		return f.SubscribeTransactionsPoolCalled(filter)
 	}
 
 	return nil, nil
 }
 
+// UnsubscribeTransactionsPool -
+func (f *FacadeStub) UnsubscribeTransactionsPool(subscriptionID uint64) {
+	if f.UnsubscribeTransactionsPoolCalled != nil {
+		f.UnsubscribeTransactionsPoolCalled(subscriptionID)
+	}
+}
+
 // GetTransactionsPoolForSender -
 func (f *FacadeStub) GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	if f.GetTransactionsPoolForSenderCalled != nil {
@@ -541,6 +636,49 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionsReplacedInPool -
+func (f *FacadeStub) GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+	if f.GetTransactionsReplacedInPoolCalled != nil {
+		return f.GetTransactionsReplacedInPoolCalled(tx, txHash)
+	}
+
+	return make([]string, 0), nil
+}
+
+// GetTransactionsPoolPolicyCounters -
+func (f *FacadeStub) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	if f.GetTransactionsPoolPolicyCountersCalled != nil {
+		return f.GetTransactionsPoolPolicyCountersCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (f *FacadeStub) GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	if f.GetTransactionsForAddressCalled != nil {
+		return f.GetTransactionsForAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
+// SubscribeEvents -
+func (f *FacadeStub) SubscribeEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	if f.SubscribeEventsCalled != nil {
+		return f.SubscribeEventsCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// UnsubscribeEvents -
+func (f *FacadeStub) UnsubscribeEvents(subscriptionID uint64) {
+	if f.UnsubscribeEventsCalled != nil {
+		f.UnsubscribeEventsCalled(subscriptionID)
+	}
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -610,6 +748,105 @@ func (f *FacadeStub) GetWaitingManagedKeys() ([]string, error) {
 	return make([]string, 0), nil
 }
 
+// ReloadConfig -
+func (f *FacadeStub) ReloadConfig() ([]common.ConfigChange, error) {
+	if f.ReloadConfigCalled != nil {
+		return f.ReloadConfigCalled()
+	}
+
+	return make([]common.ConfigChange, 0), nil
+}
+
+// GetRedundancyLeaseStatus -
+func (f *FacadeStub) GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error) {
+	if f.GetRedundancyLeaseStatusCalled != nil {
+		return f.GetRedundancyLeaseStatusCalled()
+	}
+
+	return common.RedundancyLeaseStatus{}, nil
+}
+
+// PromoteRedundancyLease -
+func (f *FacadeStub) PromoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	if f.PromoteRedundancyLeaseCalled != nil {
+		return f.PromoteRedundancyLeaseCalled()
+	}
+
+	return common.RedundancyLeaseStatus{}, nil
+}
+
+// DemoteRedundancyLease -
+func (f *FacadeStub) DemoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	if f.DemoteRedundancyLeaseCalled != nil {
+		return f.DemoteRedundancyLeaseCalled()
+	}
+
+	return common.RedundancyLeaseStatus{}, nil
+}
+
+// GetPeersReputation -
+func (f *FacadeStub) GetPeersReputation() ([]*common.PeerReputation, error) {
+	if f.GetPeersReputationCalled != nil {
+		return f.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputation, 0), nil
+}
+
+// BanPeer -
+func (f *FacadeStub) BanPeer(pid string, duration time.Duration, reason string) error {
+	if f.BanPeerCalled != nil {
+		return f.BanPeerCalled(pid, duration, reason)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (f *FacadeStub) UnbanPeer(pid string) error {
+	if f.UnbanPeerCalled != nil {
+		return f.UnbanPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// BanIP -
+func (f *FacadeStub) BanIP(ip string, duration time.Duration, reason string) error {
+	if f.BanIPCalled != nil {
+		return f.BanIPCalled(ip, duration, reason)
+	}
+
+	return nil
+}
+
+// UnbanIP -
+func (f *FacadeStub) UnbanIP(ip string) error {
+	if f.UnbanIPCalled != nil {
+		return f.UnbanIPCalled(ip)
+	}
+
+	return nil
+}
+
+// GetOutportDriversCursors -
+func (f *FacadeStub) GetOutportDriversCursors() (map[string]uint64, error) {
+	if f.GetOutportDriversCursorsCalled != nil {
+		return f.GetOutportDriversCursorsCalled()
+	}
+
+	return make(map[string]uint64), nil
+}
+
+// ReplayOutport -
+func (f *FacadeStub) ReplayOutport(driverID string, sequence uint64) error {
+	if f.ReplayOutportCalled != nil {
+		return f.ReplayOutportCalled(driverID, sequence)
+	}
+
+	return nil
+}
+
 // Close -
 func (f *FacadeStub) Close() error {
 	return nil
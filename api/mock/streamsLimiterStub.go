@@ -0,0 +1,29 @@
+package mock
+
+import "github.com/gin-gonic/gin"
+
+// StreamsLimiterStub -
+type StreamsLimiterStub struct {
+	SetLimitsCalled func(maxStreams uint32, streamingRoutes map[string]struct{}) error
+}
+
+// MiddlewareHandlerFunc -
+func (stub *StreamsLimiterStub) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+	}
+}
+
+// SetLimits -
+func (stub *StreamsLimiterStub) SetLimits(maxStreams uint32, streamingRoutes map[string]struct{}) error {
+	if stub.SetLimitsCalled != nil {
+		return stub.SetLimitsCalled(maxStreams, streamingRoutes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *StreamsLimiterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -24,12 +24,14 @@ type AdditionalMiddleware struct {
 	Position   MiddlewarePosition
 }
 
-// EndpointHandlerData holds the items needed for creating a new gin HTTP endpoint
+// EndpointHandlerData holds the items needed for creating a new gin HTTP endpoint. A streaming endpoint keeps the
+// connection open in order to push notifications, so it is throttled separately from the other endpoints
 type EndpointHandlerData struct {
 	Path                  string
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	IsStreaming           bool
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
@@ -54,6 +56,10 @@ const ReturnCodeRequestError ReturnCode = "bad_request"
 // ReturnCodeSystemBusy defines a request which hasn't been executed successfully due to too many requests
 const ReturnCodeSystemBusy ReturnCode = "system_busy"
 
+// ReturnCodeDataPruned defines a request which hasn't been executed successfully because the requested data is outside
+// the retention window of the node's storage
+const ReturnCodeDataPruned ReturnCode = "data_pruned"
+
 // RespondWith will respond with the generic API response
 func RespondWith(c *gin.Context, status int, dataField interface{}, errMessage string, code ReturnCode) {
 	c.JSON(
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/multiversx/mx-chain-go/cmd/antifloodreplay/simulator"
+	antifloodDebug "github.com/multiversx/mx-chain-go/debug/antiflood"
+)
+
+type traceSimulator interface {
+	ProcessEntry(entry *antifloodDebug.TraceEntry) error
+	Report() *simulator.Report
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/display"
+	"github.com/multiversx/mx-chain-go/cmd/antifloodreplay/simulator"
+	"github.com/multiversx/mx-chain-go/common"
+	antifloodDebug "github.com/multiversx/mx-chain-go/debug/antiflood"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const reportFilePermissions = 0644
+
+type cfg struct {
+	traceFile     string
+	configFile    string
+	consensusSize int
+	selfPeer      string
+	outputFile    string
+}
+
+var (
+	fileGenHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// traceFile defines the flag for the recorded antiflood trace file
+	traceFile = cli.StringFlag{
+		Name:        "trace",
+		Usage:       "The antiflood trace file recorded by a node with the Debug.Antiflood.Trace option enabled",
+		Destination: &argsConfig.traceFile,
+	}
+	// configFile defines the flag for the candidate config file
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The node's config.toml file containing the candidate [Antiflood] section",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// consensusSize defines the flag for the consensus size applied on the flood preventers
+	consensusSize = cli.IntFlag{
+		Name: "consensus-size",
+		Usage: "The consensus group size of the shard the trace was recorded on. The node uses it to increase the " +
+			"flood preventers limits. 0 means no increase",
+		Value:       0,
+		Destination: &argsConfig.consensusSize,
+	}
+	// selfPeer defines the flag for the peer ID of the node that recorded the trace
+	selfPeer = cli.StringFlag{
+		Name:        "self-peer",
+		Usage:       "The peer ID of the node that recorded the trace. This peer will never be blacklisted",
+		Destination: &argsConfig.selfPeer,
+	}
+	// outputFile defines the flag for the JSON report file
+	outputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "If provided, the full report will be also written, in JSON format, in this file",
+		Destination: &argsConfig.outputFile,
+	}
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("antifloodreplay")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = fileGenHelpTemplate
+	app.Name = "Antiflood replay Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will replay a recorded antiflood trace against a candidate [Antiflood] config and will " +
+		"report the peers that would have been throttled or blacklisted"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		traceFile,
+		configFile,
+		consensusSize,
+		selfPeer,
+		outputFile,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error replaying the antiflood trace", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	if len(argsConfig.traceFile) == 0 {
+		return cli.NewExitError("the trace flag should be provided", 1)
+	}
+
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	traceSim, err := simulator.NewSimulator(simulator.ArgsSimulator{
+		AntifloodConfig: mainConfig.Antiflood,
+		ConsensusSize:   argsConfig.consensusSize,
+		SelfPeer:        argsConfig.selfPeer,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = replayTrace(traceSim)
+	if err != nil {
+		return err
+	}
+
+	report := traceSim.Report()
+	displayReport(report)
+
+	if len(argsConfig.outputFile) == 0 {
+		return nil
+	}
+
+	return writeReport(report)
+}
+
+func replayTrace(traceSim traceSimulator) error {
+	file, err := os.Open(argsConfig.traceFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return antifloodDebug.ReadTrace(file, traceSim.ProcessEntry)
+}
+
+func displayReport(report *simulator.Report) {
+	log.Info("antiflood trace replayed",
+		"num entries", report.NumEntries,
+		"first entry", time.Unix(0, report.FirstTimestamp).UTC().Format(time.RFC3339),
+		"last entry", time.Unix(0, report.LastTimestamp).UTC().Format(time.RFC3339),
+		"num peers", report.NumPeers,
+		"num affected peers", len(report.AffectedPeers),
+	)
+	if report.NumDroppedEntries > 0 {
+		log.Warn("the antiflood trace is incomplete, the recording node dropped entries, so the replay underestimates the traffic",
+			"num dropped entries", report.NumDroppedEntries)
+	}
+	if len(report.AffectedPeers) == 0 {
+		return
+	}
+
+	header := []string{"Peer", "Messages", "Size", "Throttled", "Topic throttled", "Denied", "Blacklisted", "Reasons"}
+	lines := make([]*display.LineData, 0, len(report.AffectedPeers))
+	for _, peerReport := range report.AffectedPeers {
+		lines = append(lines, display.NewLineData(false, []string{
+			peerReport.Peer,
+			fmt.Sprintf("%d", peerReport.NumMessages),
+			core.ConvertBytes(peerReport.TotalSize),
+			fmt.Sprintf("%d", peerReport.NumThrottled),
+			formatThrottledTopics(peerReport),
+			fmt.Sprintf("%d", peerReport.NumDenied),
+			fmt.Sprintf("%d", len(peerReport.Blacklistings)),
+			formatReasons(peerReport.Blacklistings),
+		}))
+	}
+
+	tab, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Error("error creating the report table", "error", err)
+		return
+	}
+
+	log.Info("peers that would have been throttled or blacklisted\n" + tab)
+}
+
+func formatThrottledTopics(peerReport *simulator.PeerReport) string {
+	if len(peerReport.ThrottledTopics) == 0 {
+		return fmt.Sprintf("%d", peerReport.NumTopicThrottled)
+	}
+
+	topics := make([]string, 0, len(peerReport.ThrottledTopics))
+	for topic, numThrottled := range peerReport.ThrottledTopics {
+		topics = append(topics, fmt.Sprintf("%s: %d", topic, numThrottled))
+	}
+	sort.Strings(topics)
+
+	return fmt.Sprintf("%d (%s)", peerReport.NumTopicThrottled, strings.Join(topics, ", "))
+}
+
+func formatReasons(blacklistings []simulator.BlacklistEvent) string {
+	uniqueReasons := make(map[string]struct{})
+	reasons := make([]string, 0)
+	for _, event := range blacklistings {
+		_, found := uniqueReasons[event.Reason]
+		if found {
+			continue
+		}
+
+		uniqueReasons[event.Reason] = struct{}{}
+		reasons = append(reasons, event.Reason)
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+func writeReport(report *simulator.Report) error {
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(argsConfig.outputFile, buff, reportFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Info("report written", "file", argsConfig.outputFile)
+
+	return nil
+}
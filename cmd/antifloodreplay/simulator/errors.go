@@ -0,0 +1,18 @@
+package simulator
+
+import "errors"
+
+// ErrInvalidConsensusSize signals that an invalid consensus size was provided
+var ErrInvalidConsensusSize = errors.New("invalid consensus size")
+
+// ErrInvalidInterval signals that an invalid flood preventer interval was provided
+var ErrInvalidInterval = errors.New("invalid interval")
+
+// ErrNilTraceEntry signals that a nil trace entry was provided
+var ErrNilTraceEntry = errors.New("nil trace entry")
+
+// ErrUnknownTraceEntryType signals that the trace entry has an unknown type
+var ErrUnknownTraceEntryType = errors.New("unknown trace entry type")
+
+// ErrEmptyPeer signals that the trace entry does not contain the peer
+var ErrEmptyPeer = errors.New("empty peer")
@@ -0,0 +1,47 @@
+package simulator
+
+import "sort"
+
+// PeerReport holds the replay results of a single peer
+type PeerReport struct {
+	Peer              string            `json:"peer"`
+	NumMessages       uint64            `json:"numMessages"`
+	TotalSize         uint64            `json:"totalSize"`
+	NumThrottled      uint64            `json:"numThrottled"`
+	NumTopicThrottled uint64            `json:"numTopicThrottled"`
+	ThrottledTopics   map[string]uint64 `json:"throttledTopics,omitempty"`
+	NumDenied         uint64            `json:"numDenied"`
+	Blacklistings     []BlacklistEvent  `json:"blacklistings,omitempty"`
+}
+
+// IsAffected returns true if at least one message of the peer was rejected or if the peer was blacklisted
+func (pr *PeerReport) IsAffected() bool {
+	return pr.NumThrottled > 0 || pr.NumTopicThrottled > 0 || pr.NumDenied > 0 || len(pr.Blacklistings) > 0
+}
+
+// Report holds the results of a trace replay
+type Report struct {
+	NumEntries        uint64        `json:"numEntries"`
+	NumDroppedEntries uint64        `json:"numDroppedEntries"`
+	FirstTimestamp    int64         `json:"firstTimestamp"`
+	LastTimestamp     int64         `json:"lastTimestamp"`
+	NumPeers          int           `json:"numPeers"`
+	AffectedPeers     []*PeerReport `json:"affectedPeers"`
+}
+
+func sortPeerReports(reports []*PeerReport) {
+	sort.Slice(reports, func(i, j int) bool {
+		ri, rj := reports[i], reports[j]
+		if len(ri.Blacklistings) != len(rj.Blacklistings) {
+			return len(ri.Blacklistings) > len(rj.Blacklistings)
+		}
+
+		throttledI := ri.NumThrottled + ri.NumTopicThrottled
+		throttledJ := rj.NumThrottled + rj.NumTopicThrottled
+		if throttledI != throttledJ {
+			return throttledI > throttledJ
+		}
+
+		return ri.Peer < rj.Peer
+	})
+}
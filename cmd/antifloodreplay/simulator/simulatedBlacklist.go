@@ -0,0 +1,74 @@
+package simulator
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// BlacklistEvent holds the details of a simulated peer blacklisting
+type BlacklistEvent struct {
+	Timestamp         int64   `json:"timestamp"`
+	DurationInSeconds float64 `json:"durationInSeconds"`
+	Reason            string  `json:"reason"`
+}
+
+// simulatedBlacklist is a peer blacklist cacher that uses the simulated time instead of the wall clock
+type simulatedBlacklist struct {
+	expiries         map[core.PeerID]time.Time
+	getTimeHandler   func() time.Time
+	onBlacklistEvent func(pid core.PeerID, event BlacklistEvent)
+}
+
+func newSimulatedBlacklist(getTimeHandler func() time.Time, onBlacklistEvent func(pid core.PeerID, event BlacklistEvent)) *simulatedBlacklist {
+	return &simulatedBlacklist{
+		expiries:         make(map[core.PeerID]time.Time),
+		getTimeHandler:   getTimeHandler,
+		onBlacklistEvent: onBlacklistEvent,
+	}
+}
+
+// Upsert will add or extend the blacklisting of the provided peer ID
+func (sb *simulatedBlacklist) Upsert(pid core.PeerID, span time.Duration) error {
+	return sb.UpsertWithReason(pid, span, "")
+}
+
+// UpsertWithReason will add or extend the blacklisting of the provided peer ID, recording the reason for a new blacklisting
+func (sb *simulatedBlacklist) UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error {
+	now := sb.getTimeHandler()
+	if !sb.Has(pid) {
+		sb.onBlacklistEvent(pid, BlacklistEvent{
+			Timestamp:         now.UnixNano(),
+			DurationInSeconds: span.Seconds(),
+			Reason:            reason,
+		})
+	}
+
+	expiry := now.Add(span)
+	if expiry.After(sb.expiries[pid]) {
+		sb.expiries[pid] = expiry
+	}
+
+	return nil
+}
+
+// Has returns true if the provided peer ID is blacklisted at the current simulated time
+func (sb *simulatedBlacklist) Has(pid core.PeerID) bool {
+	expiry, found := sb.expiries[pid]
+
+	return found && sb.getTimeHandler().Before(expiry)
+}
+
+// Sweep removes the expired blacklistings
+func (sb *simulatedBlacklist) Sweep() {
+	for pid := range sb.expiries {
+		if !sb.Has(pid) {
+			delete(sb.expiries, pid)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sb *simulatedBlacklist) IsInterfaceNil() bool {
+	return sb == nil
+}
@@ -0,0 +1,381 @@
+package simulator
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-communication-go/p2p/message"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	antifloodDebug "github.com/multiversx/mx-chain-go/debug/antiflood"
+	bootstrapDisabled "github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+const fastReactingIdentifier = "fast_reacting"
+const slowReactingIdentifier = "slow_reacting"
+const outOfSpecsIdentifier = "out_of_specs"
+const topicResetInterval = time.Second
+const minIntervalInSeconds = 1
+
+// ArgsSimulator holds the arguments needed to create a new antiflood simulator
+type ArgsSimulator struct {
+	// AntifloodConfig is the candidate config that will be used when replaying the trace
+	AntifloodConfig config.AntifloodConfig
+	// ConsensusSize, if positive, is applied on the flood preventers the same way the node does on each epoch start
+	ConsensusSize int
+	// SelfPeer is the peer ID, as recorded in the trace, of the node that recorded the trace. It will never be blacklisted
+	SelfPeer string
+}
+
+type scheduledReset struct {
+	next     time.Time
+	interval time.Duration
+	reset    func()
+}
+
+type simulator struct {
+	antifloodHandler  process.P2PAntifloodHandler
+	topicPreventer    process.TopicFloodPreventer
+	topicMaxMessages  []config.TopicMaxMessagesConfig
+	denialEvaluator   p2p.PeerDenialEvaluator
+	blacklist         *simulatedBlacklist
+	scheduledResets   []*scheduledReset
+	currentTime       time.Time
+	isStarted         bool
+	payload           []byte
+	peers             map[string]*PeerReport
+	numEntries        uint64
+	numDroppedEntries uint64
+	firstTimestamp    int64
+	lastTimestamp     int64
+}
+
+// NewSimulator creates a new simulator able to replay antiflood traces through the flood preventers, topic flood
+// preventer and peer denial evaluator built from the provided candidate config. The simulator uses the recorded
+// timestamps instead of the wall clock, so it does not start any go routine
+func NewSimulator(args ArgsSimulator) (*simulator, error) {
+	if args.ConsensusSize < 0 {
+		return nil, fmt.Errorf("%w, should not be negative", ErrInvalidConsensusSize)
+	}
+
+	s := &simulator{
+		topicMaxMessages: args.AntifloodConfig.Topic.MaxMessages,
+		peers:            make(map[string]*PeerReport),
+		scheduledResets:  make([]*scheduledReset, 0),
+	}
+	s.blacklist = newSimulatedBlacklist(s.getCurrentTime, s.addBlacklistEvent)
+
+	err := s.createComponents(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *simulator) createComponents(args ArgsSimulator) error {
+	selfPid := core.PeerID(args.SelfPeer)
+	cfg := args.AntifloodConfig
+
+	preventers := make([]process.FloodPreventer, 0, 3)
+	preventersConfigs := []struct {
+		identifier string
+		config     config.FloodPreventerConfig
+	}{
+		{identifier: fastReactingIdentifier, config: cfg.FastReacting},
+		{identifier: slowReactingIdentifier, config: cfg.SlowReacting},
+		{identifier: outOfSpecsIdentifier, config: cfg.OutOfSpecs},
+	}
+	for _, pc := range preventersConfigs {
+		preventer, err := s.createFloodPreventer(pc.config, cfg.Cache, pc.identifier, selfPid)
+		if err != nil {
+			return fmt.Errorf("%w when creating %s flood preventer", err, pc.identifier)
+		}
+
+		preventers = append(preventers, preventer)
+	}
+
+	topicPreventer, err := floodPreventers.NewTopicFloodPreventer(cfg.Topic.DefaultMaxMessagesPerSec)
+	if err != nil {
+		return err
+	}
+	for _, topicMaxMsg := range cfg.Topic.MaxMessages {
+		topicPreventer.SetMaxMessagesForTopic(topicMaxMsg.Topic, topicMaxMsg.NumMessagesPerSec)
+	}
+	s.topicPreventer = topicPreventer
+	s.scheduledResets = append(s.scheduledResets, &scheduledReset{
+		interval: topicResetInterval,
+		reset:    s.resetTopicPreventer,
+	})
+
+	p2pAntiflood, err := antiflood.NewP2PAntiflood(s.blacklist, topicPreventer, preventers...)
+	if err != nil {
+		return err
+	}
+	if args.ConsensusSize > 0 {
+		p2pAntiflood.ApplyConsensusSize(args.ConsensusSize)
+	}
+	s.antifloodHandler = p2pAntiflood
+
+	s.denialEvaluator, err = blackList.NewPeerDenialEvaluator(
+		s.blacklist,
+		&disabled.TimeCache{},
+		bootstrapDisabled.NewPeerShardMapper(),
+	)
+
+	return err
+}
+
+func (s *simulator) createFloodPreventer(
+	floodPreventerConfig config.FloodPreventerConfig,
+	antifloodCacheConfig config.CacheConfig,
+	quotaIdentifier string,
+	selfPid core.PeerID,
+) (process.FloodPreventer, error) {
+	if floodPreventerConfig.IntervalInSeconds < minIntervalInSeconds {
+		return nil, fmt.Errorf("%w for IntervalInSeconds, minimum is %d", ErrInvalidInterval, minIntervalInSeconds)
+	}
+
+	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
+	blackListCache, err := storageunit.NewCache(cacheConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	blackListProcessor, err := blackList.NewP2PBlackListProcessor(
+		blackListCache,
+		s.blacklist,
+		floodPreventerConfig.BlackList.ThresholdNumMessagesPerInterval,
+		floodPreventerConfig.BlackList.ThresholdSizePerInterval,
+		floodPreventerConfig.BlackList.NumFloodingRounds,
+		time.Duration(floodPreventerConfig.BlackList.PeerBanDurationInSeconds)*time.Second,
+		quotaIdentifier,
+		selfPid,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	antifloodCache, err := storageunit.NewCache(cacheConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	floodPreventer, err := floodPreventers.NewQuotaFloodPreventer(floodPreventers.ArgQuotaFloodPreventer{
+		Name:                      quotaIdentifier,
+		Cacher:                    antifloodCache,
+		StatusHandlers:            []floodPreventers.QuotaStatusHandler{blackListProcessor},
+		BaseMaxNumMessagesPerPeer: floodPreventerConfig.PeerMaxInput.BaseMessagesPerInterval,
+		MaxTotalSizePerPeer:       floodPreventerConfig.PeerMaxInput.TotalSizePerInterval,
+		PercentReserved:           floodPreventerConfig.ReservedPercent,
+		IncreaseThreshold:         floodPreventerConfig.PeerMaxInput.IncreaseFactor.Threshold,
+		IncreaseFactor:            floodPreventerConfig.PeerMaxInput.IncreaseFactor.Factor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.scheduledResets = append(s.scheduledResets, &scheduledReset{
+		interval: time.Duration(floodPreventerConfig.IntervalInSeconds) * time.Second,
+		reset:    floodPreventer.Reset,
+	})
+
+	return floodPreventer, nil
+}
+
+// ProcessEntry replays the provided trace entry
+func (s *simulator) ProcessEntry(entry *antifloodDebug.TraceEntry) error {
+	if entry == nil {
+		return ErrNilTraceEntry
+	}
+	if entry.Type == antifloodDebug.DroppedTraceType {
+		s.numDroppedEntries += entry.NumDropped
+		return nil
+	}
+	if len(entry.Peer) == 0 {
+		return ErrEmptyPeer
+	}
+
+	switch entry.Type {
+	case antifloodDebug.MessageTraceType:
+		s.advanceTime(entry.Timestamp)
+		s.processMessage(entry)
+	case antifloodDebug.TopicTraceType:
+		s.advanceTime(entry.Timestamp)
+		s.processMessagesOnTopic(entry)
+	default:
+		return fmt.Errorf("%w %s", ErrUnknownTraceEntryType, entry.Type)
+	}
+
+	s.numEntries++
+
+	return nil
+}
+
+func (s *simulator) processMessage(entry *antifloodDebug.TraceEntry) {
+	pid := core.PeerID(entry.Peer)
+	peerReport := s.getPeerReport(pid)
+	peerReport.NumMessages++
+	peerReport.TotalSize += entry.Size
+
+	if s.denialEvaluator.IsDenied(pid) {
+		peerReport.NumDenied++
+		return
+	}
+
+	originator := pid
+	if len(entry.Originator) > 0 {
+		originator = core.PeerID(entry.Originator)
+	}
+
+	msg := &message.Message{
+		DataField:  s.getPayload(entry.Size),
+		TopicField: entry.Topic,
+		PeerField:  originator,
+	}
+	err := s.antifloodHandler.CanProcessMessage(msg, pid)
+	if err == nil {
+		return
+	}
+
+	if errors.Is(err, process.ErrOriginatorIsBlacklisted) {
+		s.getPeerReport(originator).NumDenied++
+		return
+	}
+
+	peerReport.NumThrottled++
+}
+
+func (s *simulator) processMessagesOnTopic(entry *antifloodDebug.TraceEntry) {
+	pid := core.PeerID(entry.Peer)
+	if s.denialEvaluator.IsDenied(pid) {
+		return
+	}
+
+	err := s.antifloodHandler.CanProcessMessagesOnTopic(pid, entry.Topic, entry.NumMessages, entry.Size, nil)
+	if err == nil {
+		return
+	}
+
+	peerReport := s.getPeerReport(pid)
+	peerReport.NumTopicThrottled += uint64(entry.NumMessages)
+	if peerReport.ThrottledTopics == nil {
+		peerReport.ThrottledTopics = make(map[string]uint64)
+	}
+	peerReport.ThrottledTopics[entry.Topic] += uint64(entry.NumMessages)
+}
+
+// advanceTime will move the simulated time to the provided timestamp, calling, in order, all the resets that the
+// node would have done in the meantime. Entries recorded out of order are considered at the current simulated time
+func (s *simulator) advanceTime(timestamp int64) {
+	entryTime := time.Unix(0, timestamp)
+	if !s.isStarted {
+		s.isStarted = true
+		s.firstTimestamp = timestamp
+		s.currentTime = entryTime
+		for _, sr := range s.scheduledResets {
+			sr.next = entryTime.Add(sr.interval)
+		}
+	}
+	if timestamp > s.lastTimestamp {
+		s.lastTimestamp = timestamp
+	}
+	if !entryTime.After(s.currentTime) {
+		return
+	}
+
+	for {
+		nextReset := s.getNextScheduledReset()
+		if nextReset.next.After(entryTime) {
+			break
+		}
+
+		s.currentTime = nextReset.next
+		nextReset.reset()
+		nextReset.next = nextReset.next.Add(nextReset.interval)
+	}
+
+	s.currentTime = entryTime
+	s.blacklist.Sweep()
+}
+
+func (s *simulator) getNextScheduledReset() *scheduledReset {
+	next := s.scheduledResets[0]
+	for _, sr := range s.scheduledResets[1:] {
+		if sr.next.Before(next.next) {
+			next = sr
+		}
+	}
+
+	return next
+}
+
+func (s *simulator) resetTopicPreventer() {
+	for _, topicMaxMsg := range s.topicMaxMessages {
+		s.topicPreventer.ResetForTopic(topicMaxMsg.Topic)
+	}
+	s.topicPreventer.ResetForNotRegisteredTopics()
+}
+
+func (s *simulator) getPayload(size uint64) []byte {
+	if uint64(len(s.payload)) < size {
+		s.payload = make([]byte, size)
+	}
+
+	return s.payload[:size]
+}
+
+func (s *simulator) getCurrentTime() time.Time {
+	return s.currentTime
+}
+
+func (s *simulator) addBlacklistEvent(pid core.PeerID, event BlacklistEvent) {
+	peerReport := s.getPeerReport(pid)
+	peerReport.Blacklistings = append(peerReport.Blacklistings, event)
+}
+
+func (s *simulator) getPeerReport(pid core.PeerID) *PeerReport {
+	peerReport, found := s.peers[string(pid)]
+	if !found {
+		peerReport = &PeerReport{
+			Peer: string(pid),
+		}
+		s.peers[string(pid)] = peerReport
+	}
+
+	return peerReport
+}
+
+// Report returns the replay results, containing only the peers that would have been throttled or blacklisted
+func (s *simulator) Report() *Report {
+	affectedPeers := make([]*PeerReport, 0)
+	for _, peerReport := range s.peers {
+		if peerReport.IsAffected() {
+			affectedPeers = append(affectedPeers, peerReport)
+		}
+	}
+	sortPeerReports(affectedPeers)
+
+	return &Report{
+		NumEntries:        s.numEntries,
+		NumDroppedEntries: s.numDroppedEntries,
+		FirstTimestamp:    s.firstTimestamp,
+		LastTimestamp:     s.lastTimestamp,
+		NumPeers:          len(s.peers),
+		AffectedPeers:     affectedPeers,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *simulator) IsInterfaceNil() bool {
+	return s == nil
+}
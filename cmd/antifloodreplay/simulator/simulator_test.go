@@ -0,0 +1,242 @@
+package simulator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	antifloodDebug "github.com/multiversx/mx-chain-go/debug/antiflood"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const startTimestamp = int64(1_000_000_000_000_000_000)
+
+func createFloodPreventerConfig() config.FloodPreventerConfig {
+	return config.FloodPreventerConfig{
+		IntervalInSeconds: 1,
+		ReservedPercent:   0,
+		PeerMaxInput: config.AntifloodLimitsConfig{
+			BaseMessagesPerInterval: 10,
+			TotalSizePerInterval:    10000,
+		},
+		BlackList: config.BlackListConfig{
+			ThresholdNumMessagesPerInterval: 20,
+			ThresholdSizePerInterval:        20000,
+			NumFloodingRounds:               2,
+			PeerBanDurationInSeconds:        10,
+		},
+	}
+}
+
+func createMockArgsSimulator() ArgsSimulator {
+	return ArgsSimulator{
+		AntifloodConfig: config.AntifloodConfig{
+			Enabled:      true,
+			FastReacting: createFloodPreventerConfig(),
+			SlowReacting: createFloodPreventerConfig(),
+			OutOfSpecs:   createFloodPreventerConfig(),
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 1000,
+			},
+			Topic: config.TopicAntifloodConfig{
+				DefaultMaxMessagesPerSec: 5,
+			},
+		},
+		SelfPeer: "self",
+	}
+}
+
+func createMessageEntry(peer string, timestamp int64) *antifloodDebug.TraceEntry {
+	return &antifloodDebug.TraceEntry{
+		Type:        antifloodDebug.MessageTraceType,
+		Timestamp:   timestamp,
+		Peer:        peer,
+		Originator:  peer,
+		Topic:       "topic",
+		NumMessages: 1,
+		Size:        10,
+	}
+}
+
+func TestNewSimulator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid consensus size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.ConsensusSize = -1
+		s, err := NewSimulator(args)
+		assert.True(t, check.IfNil(s))
+		assert.True(t, errors.Is(err, ErrInvalidConsensusSize))
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.AntifloodConfig.SlowReacting.IntervalInSeconds = 0
+		s, err := NewSimulator(args)
+		assert.Nil(t, s)
+		assert.True(t, errors.Is(err, ErrInvalidInterval))
+	})
+	t.Run("invalid black list config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.AntifloodConfig.OutOfSpecs.BlackList.NumFloodingRounds = 0
+		s, err := NewSimulator(args)
+		assert.Nil(t, s)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSimulator()
+		args.ConsensusSize = 63
+		s, err := NewSimulator(args)
+		assert.NotNil(t, s)
+		assert.Nil(t, err)
+	})
+}
+
+func TestSimulator_ProcessEntryInvalidEntryShouldError(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewSimulator(createMockArgsSimulator())
+
+	err := s.ProcessEntry(nil)
+	assert.Equal(t, ErrNilTraceEntry, err)
+
+	err = s.ProcessEntry(&antifloodDebug.TraceEntry{Type: antifloodDebug.MessageTraceType})
+	assert.Equal(t, ErrEmptyPeer, err)
+
+	err = s.ProcessEntry(&antifloodDebug.TraceEntry{Type: "unknown", Peer: "peer"})
+	assert.True(t, errors.Is(err, ErrUnknownTraceEntryType))
+
+	assert.Equal(t, uint64(0), s.Report().NumEntries)
+}
+
+func TestSimulator_ProcessEntryWithinQuotaShouldNotAffectPeers(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewSimulator(createMockArgsSimulator())
+
+	for i := 0; i < 100; i++ {
+		timestamp := startTimestamp + int64(i)*int64(200*time.Millisecond)
+		require.Nil(t, s.ProcessEntry(createMessageEntry("peer", timestamp)))
+	}
+
+	report := s.Report()
+	assert.Equal(t, uint64(100), report.NumEntries)
+	assert.Equal(t, 1, report.NumPeers)
+	assert.Equal(t, startTimestamp, report.FirstTimestamp)
+	assert.Equal(t, startTimestamp+99*int64(200*time.Millisecond), report.LastTimestamp)
+	assert.Equal(t, 0, len(report.AffectedPeers))
+}
+
+func TestSimulator_ProcessEntryDroppedEntriesShouldBeReported(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewSimulator(createMockArgsSimulator())
+
+	require.Nil(t, s.ProcessEntry(createMessageEntry("peer", startTimestamp)))
+	require.Nil(t, s.ProcessEntry(&antifloodDebug.TraceEntry{Type: antifloodDebug.DroppedTraceType, Timestamp: startTimestamp, NumDropped: 7}))
+	require.Nil(t, s.ProcessEntry(&antifloodDebug.TraceEntry{Type: antifloodDebug.DroppedTraceType, Timestamp: startTimestamp, NumDropped: 3}))
+
+	report := s.Report()
+	assert.Equal(t, uint64(1), report.NumEntries)
+	assert.Equal(t, uint64(10), report.NumDroppedEntries)
+	assert.Equal(t, 1, report.NumPeers)
+}
+
+func TestSimulator_ProcessEntryFloodingPeerShouldBeThrottledAndBlacklisted(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewSimulator(createMockArgsSimulator())
+
+	// 3 consecutive intervals with 25 messages each, above the black list threshold. The peer gets blacklisted
+	// after the second interval so all the messages from the third interval are denied
+	for second := 0; second < 3; second++ {
+		for i := 0; i < 25; i++ {
+			timestamp := startTimestamp + int64(second)*int64(time.Second) + int64(i)*int64(time.Millisecond)
+			require.Nil(t, s.ProcessEntry(createMessageEntry("flooder", timestamp)))
+			if i%5 == 0 {
+				require.Nil(t, s.ProcessEntry(createMessageEntry("honest", timestamp)))
+			}
+		}
+	}
+	// the flooder is still denied while banned
+	require.Nil(t, s.ProcessEntry(createMessageEntry("flooder", startTimestamp+int64(5*time.Second))))
+	// the ban expired
+	require.Nil(t, s.ProcessEntry(createMessageEntry("flooder", startTimestamp+int64(20*time.Second))))
+
+	report := s.Report()
+	assert.Equal(t, 2, report.NumPeers)
+	require.Equal(t, 1, len(report.AffectedPeers))
+
+	flooderReport := report.AffectedPeers[0]
+	assert.Equal(t, "flooder", flooderReport.Peer)
+	assert.Equal(t, uint64(77), flooderReport.NumMessages)
+	assert.True(t, flooderReport.NumThrottled > 0)
+	assert.Equal(t, uint64(26), flooderReport.NumDenied)
+	require.Equal(t, 1, len(flooderReport.Blacklistings))
+	assert.Equal(t, startTimestamp+int64(2*time.Second), flooderReport.Blacklistings[0].Timestamp)
+	assert.Equal(t, float64(10), flooderReport.Blacklistings[0].DurationInSeconds)
+	assert.Equal(t, "flooding detected by the fast_reacting flood preventer", flooderReport.Blacklistings[0].Reason)
+}
+
+func TestSimulator_ProcessEntryTopicLimitsShouldThrottle(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSimulator()
+	args.AntifloodConfig.Topic.MaxMessages = []config.TopicMaxMessagesConfig{
+		{Topic: "limited", NumMessagesPerSec: 2},
+	}
+	s, _ := NewSimulator(args)
+
+	for i := 0; i < 4; i++ {
+		timestamp := startTimestamp + int64(i)*int64(time.Millisecond)
+		require.Nil(t, s.ProcessEntry(&antifloodDebug.TraceEntry{
+			Type:        antifloodDebug.TopicTraceType,
+			Timestamp:   timestamp,
+			Peer:        "peer",
+			Topic:       "limited",
+			NumMessages: 1,
+			Size:        10,
+		}))
+	}
+	// the topic limits are reset each second
+	require.Nil(t, s.ProcessEntry(&antifloodDebug.TraceEntry{
+		Type:        antifloodDebug.TopicTraceType,
+		Timestamp:   startTimestamp + int64(time.Second),
+		Peer:        "peer",
+		Topic:       "limited",
+		NumMessages: 1,
+	}))
+
+	report := s.Report()
+	require.Equal(t, 1, len(report.AffectedPeers))
+	assert.Equal(t, uint64(2), report.AffectedPeers[0].NumTopicThrottled)
+	assert.Equal(t, map[string]uint64{"limited": 2}, report.AffectedPeers[0].ThrottledTopics)
+	assert.Equal(t, 0, len(report.AffectedPeers[0].Blacklistings))
+}
+
+func TestSimulator_SelfPeerShouldNotBeBlacklisted(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewSimulator(createMockArgsSimulator())
+
+	for i := 0; i < 200; i++ {
+		timestamp := startTimestamp + int64(i)*int64(20*time.Millisecond)
+		require.Nil(t, s.ProcessEntry(createMessageEntry("self", timestamp)))
+	}
+
+	report := s.Report()
+	require.Equal(t, 1, len(report.AffectedPeers))
+	assert.True(t, report.AffectedPeers[0].NumThrottled > 0)
+	assert.Equal(t, 0, len(report.AffectedPeers[0].Blacklistings))
+}
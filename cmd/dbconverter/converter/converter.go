@@ -0,0 +1,270 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// dbConfigFileName is the file written by the persister factory inside each storer directory
+	dbConfigFileName = "config.toml"
+	// levelDBCurrentFileName is the file present in each LevelDB directory
+	levelDBCurrentFileName = "CURRENT"
+	// read + write + execute for owner only
+	rwxOwner = 0700
+)
+
+var log = logger.GetOrCreate("dbconverter")
+
+// ArgsConverter holds the arguments needed to create a new LevelDB to Pebble converter
+type ArgsConverter struct {
+	// DefaultDBConfig is used for the storers that do not have a config.toml file in their directory
+	DefaultDBConfig config.DBConfig
+	// Verify enables reading back each converted key
+	Verify bool
+}
+
+type converter struct {
+	defaultDBConfig config.DBConfig
+	verify          bool
+}
+
+// NewConverter creates a new converter that migrates LevelDB storers to the Pebble backend
+func NewConverter(args ArgsConverter) (*converter, error) {
+	cfg := args.DefaultDBConfig
+	if cfg.BatchDelaySeconds <= 0 || cfg.MaxBatchSize <= 0 || cfg.MaxOpenFiles <= 0 {
+		return nil, fmt.Errorf("%w, BatchDelaySeconds, MaxBatchSize and MaxOpenFiles should be positive", ErrInvalidDefaultDBConfig)
+	}
+
+	return &converter{
+		defaultDBConfig: cfg,
+		verify:          args.Verify,
+	}, nil
+}
+
+// ConvertStorer copies all the key-value pairs of the LevelDB storer found in sourcePath into a new Pebble storer
+// created in destinationPath. The source storer is left untouched. Returns the number of converted keys
+func (c *converter) ConvertStorer(sourcePath string, destinationPath string) (uint64, error) {
+	sourceConfig, err := factory.NewDBConfigHandler(c.defaultDBConfig).GetDBConfig(sourcePath)
+	if err != nil {
+		return 0, err
+	}
+	if !isLevelDB(sourceConfig.Type) {
+		return 0, fmt.Errorf("%w: %s has type %s", ErrSourceNotLevelDB, sourcePath, sourceConfig.Type)
+	}
+
+	err = checkDestinationIsEmpty(destinationPath)
+	if err != nil {
+		return 0, err
+	}
+
+	destinationConfig := *sourceConfig
+	destinationConfig.Type = string(storageunit.Pebble)
+
+	source, err := createPersister(*sourceConfig, sourcePath)
+	if err != nil {
+		return 0, fmt.Errorf("%w while opening the source storer %s", err, sourcePath)
+	}
+	defer closePersister(source, sourcePath)
+
+	destination, err := createPersister(destinationConfig, destinationPath)
+	if err != nil {
+		return 0, fmt.Errorf("%w while creating the destination storer %s", err, destinationPath)
+	}
+	defer closePersister(destination, destinationPath)
+
+	numKeys, err := copyKeys(source, destination)
+	if err != nil {
+		return numKeys, err
+	}
+
+	if c.verify {
+		err = verifyKeys(source, destination)
+		if err != nil {
+			return numKeys, fmt.Errorf("%w for %s", err, destinationPath)
+		}
+	}
+
+	log.Info("storer converted", "source", sourcePath, "destination", destinationPath, "num keys", numKeys)
+
+	return numKeys, nil
+}
+
+// ConvertTree walks the sourceRoot directory and converts each LevelDB storer found in it into a Pebble storer,
+// keeping the same relative path inside destinationRoot. All the other files are copied as they are, so that the
+// destination directory can replace the source one. Returns the number of converted storers
+func (c *converter) ConvertTree(sourceRoot string, destinationRoot string) (int, error) {
+	err := checkDestinationIsEmpty(destinationRoot)
+	if err != nil {
+		return 0, err
+	}
+
+	numConvertedStorers := 0
+	err = filepath.WalkDir(sourceRoot, func(path string, entry fs.DirEntry, errWalk error) error {
+		if errWalk != nil {
+			return errWalk
+		}
+
+		relativePath, errRel := filepath.Rel(sourceRoot, path)
+		if errRel != nil {
+			return errRel
+		}
+		destinationPath := filepath.Join(destinationRoot, relativePath)
+
+		if !entry.IsDir() {
+			return copyFile(path, destinationPath)
+		}
+		if !c.isLevelDBStorer(path) {
+			return os.MkdirAll(destinationPath, rwxOwner)
+		}
+
+		_, errConvert := c.ConvertStorer(path, destinationPath)
+		if errConvert != nil {
+			return errConvert
+		}
+
+		numConvertedStorers++
+
+		return filepath.SkipDir
+	})
+
+	return numConvertedStorers, err
+}
+
+func (c *converter) isLevelDBStorer(path string) bool {
+	if fileExists(filepath.Join(path, dbConfigFileName)) {
+		dbConfig, err := factory.NewDBConfigHandler(c.defaultDBConfig).GetDBConfig(path)
+		return err == nil && isLevelDB(dbConfig.Type)
+	}
+
+	// LevelDB storers created before the db config file was introduced
+	return fileExists(filepath.Join(path, levelDBCurrentFileName))
+}
+
+func isLevelDB(dbType string) bool {
+	switch storageunit.DBType(dbType) {
+	case storageunit.LvlDB, storageunit.LvlDBSerial:
+		return true
+	default:
+		return false
+	}
+}
+
+func createPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	persisterFactory, err := factory.NewPersisterFactory(factory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+func closePersister(persister storage.Persister, path string) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close storer", "path", path, "error", err)
+	}
+}
+
+func copyKeys(source storage.Persister, destination storage.Persister) (uint64, error) {
+	numKeys := uint64(0)
+	var errPut error
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destination.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numKeys++
+		return true
+	})
+
+	return numKeys, errPut
+}
+
+func verifyKeys(source storage.Persister, destination storage.Persister) error {
+	var errVerify error
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		convertedValue, err := destination.Get(key)
+		if err != nil {
+			errVerify = fmt.Errorf("%w: key %x, %v", ErrVerificationFailed, key, err)
+			return false
+		}
+		if !bytes.Equal(value, convertedValue) {
+			errVerify = fmt.Errorf("%w: value mismatch for key %x", ErrVerificationFailed, key)
+			return false
+		}
+
+		return true
+	})
+
+	return errVerify
+}
+
+func checkDestinationIsEmpty(path string) error {
+	entries, err := os.ReadDir(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, path)
+	}
+
+	return nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func copyFile(sourcePath string, destinationPath string) error {
+	err := os.MkdirAll(filepath.Dir(destinationPath), rwxOwner)
+	if err != nil {
+		return err
+	}
+
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	info, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	destination, err := os.OpenFile(destinationPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destination, source)
+	if err != nil {
+		_ = destination.Close()
+		return err
+	}
+
+	return destination.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *converter) IsInterfaceNil() bool {
+	return c == nil
+}
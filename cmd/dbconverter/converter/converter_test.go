@@ -0,0 +1,163 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/require"
+)
+
+const numTestKeys = 100
+
+func createDefaultDBConfig() config.DBConfig {
+	return config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 2,
+		MaxBatchSize:      100,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createLevelDBStorer(t *testing.T, path string) {
+	persisterFactory, err := factory.NewPersisterFactory(factory.NewDBConfigHandler(createDefaultDBConfig()))
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+
+	for i := 0; i < numTestKeys; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, persister.Close())
+}
+
+func checkPebbleStorer(t *testing.T, path string) {
+	dbConfig, err := factory.NewDBConfigHandler(createDefaultDBConfig()).GetDBConfig(path)
+	require.Nil(t, err)
+	require.Equal(t, string(storageunit.Pebble), dbConfig.Type)
+
+	persister, err := createPersister(*dbConfig, path)
+	require.Nil(t, err)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	for i := 0; i < numTestKeys; i++ {
+		value, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+		require.Nil(t, errGet)
+		require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value)
+	}
+}
+
+func TestNewConverter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid default db config should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultDBConfig()
+		dbConfig.MaxOpenFiles = 0
+		c, err := NewConverter(ArgsConverter{DefaultDBConfig: dbConfig})
+		require.True(t, errors.Is(err, ErrInvalidDefaultDBConfig))
+		require.True(t, check.IfNil(c))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewConverter(ArgsConverter{DefaultDBConfig: createDefaultDBConfig()})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(c))
+	})
+}
+
+func TestConverter_ConvertStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("non LevelDB source should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		sourcePath := filepath.Join(dir, "source")
+		dbConfig := createDefaultDBConfig()
+		dbConfig.Type = string(storageunit.Pebble)
+		persister, _ := createPersister(dbConfig, sourcePath)
+		_ = persister.Close()
+
+		c, _ := NewConverter(ArgsConverter{DefaultDBConfig: createDefaultDBConfig()})
+		_, err := c.ConvertStorer(sourcePath, filepath.Join(dir, "destination"))
+		require.True(t, errors.Is(err, ErrSourceNotLevelDB))
+	})
+	t.Run("non empty destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		sourcePath := filepath.Join(dir, "source")
+		createLevelDBStorer(t, sourcePath)
+		destinationPath := filepath.Join(dir, "destination")
+		require.Nil(t, os.MkdirAll(destinationPath, rwxOwner))
+		require.Nil(t, os.WriteFile(filepath.Join(destinationPath, "file"), []byte("data"), 0600))
+
+		c, _ := NewConverter(ArgsConverter{DefaultDBConfig: createDefaultDBConfig()})
+		_, err := c.ConvertStorer(sourcePath, destinationPath)
+		require.True(t, errors.Is(err, ErrDestinationNotEmpty))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		sourcePath := filepath.Join(dir, "source")
+		createLevelDBStorer(t, sourcePath)
+		destinationPath := filepath.Join(dir, "destination")
+
+		c, _ := NewConverter(ArgsConverter{DefaultDBConfig: createDefaultDBConfig(), Verify: true})
+		numKeys, err := c.ConvertStorer(sourcePath, destinationPath)
+		require.Nil(t, err)
+		require.Equal(t, uint64(numTestKeys), numKeys)
+
+		checkPebbleStorer(t, destinationPath)
+	})
+}
+
+func TestConverter_ConvertTree(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	sourceRoot := filepath.Join(dir, "db")
+	createLevelDBStorer(t, filepath.Join(sourceRoot, "1", "Epoch_0", "Shard_0", "MiniBlocks"))
+	createLevelDBStorer(t, filepath.Join(sourceRoot, "1", "Static", "Shard_0", "BootstrapData"))
+
+	// storer created before the db config file was introduced
+	legacyPath := filepath.Join(sourceRoot, "1", "Static", "Shard_0", "Legacy")
+	createLevelDBStorer(t, legacyPath)
+	require.Nil(t, os.Remove(filepath.Join(legacyPath, dbConfigFileName)))
+
+	require.Nil(t, os.WriteFile(filepath.Join(sourceRoot, "1", "info.txt"), []byte("info"), 0600))
+
+	destinationRoot := filepath.Join(dir, "db-pebble")
+	c, _ := NewConverter(ArgsConverter{DefaultDBConfig: createDefaultDBConfig(), Verify: true})
+	numStorers, err := c.ConvertTree(sourceRoot, destinationRoot)
+	require.Nil(t, err)
+	require.Equal(t, 3, numStorers)
+
+	checkPebbleStorer(t, filepath.Join(destinationRoot, "1", "Epoch_0", "Shard_0", "MiniBlocks"))
+	checkPebbleStorer(t, filepath.Join(destinationRoot, "1", "Static", "Shard_0", "BootstrapData"))
+	checkPebbleStorer(t, filepath.Join(destinationRoot, "1", "Static", "Shard_0", "Legacy"))
+
+	content, err := os.ReadFile(filepath.Join(destinationRoot, "1", "info.txt"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("info"), content)
+
+	// the source storers are not altered
+	dbConfig, err := factory.NewDBConfigHandler(createDefaultDBConfig()).GetDBConfig(filepath.Join(sourceRoot, "1", "Epoch_0", "Shard_0", "MiniBlocks"))
+	require.Nil(t, err)
+	require.Equal(t, string(storageunit.LvlDBSerial), dbConfig.Type)
+}
@@ -0,0 +1,15 @@
+package converter
+
+import "errors"
+
+// ErrInvalidDefaultDBConfig signals that an invalid default db config was provided
+var ErrInvalidDefaultDBConfig = errors.New("invalid default db config")
+
+// ErrSourceNotLevelDB signals that the source storer is not a LevelDB storer
+var ErrSourceNotLevelDB = errors.New("source storer is not a LevelDB storer")
+
+// ErrDestinationNotEmpty signals that the destination directory already contains data
+var ErrDestinationNotEmpty = errors.New("destination directory is not empty")
+
+// ErrVerificationFailed signals that the converted storer does not contain the same data as the source storer
+var ErrVerificationFailed = errors.New("verification failed")
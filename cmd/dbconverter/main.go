@@ -0,0 +1,156 @@
+package main
+
+import (
+	"os"
+
+	"github.com/multiversx/mx-chain-go/cmd/dbconverter/converter"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	source            string
+	destination       string
+	recursive         bool
+	verify            bool
+	batchDelaySeconds int
+	maxBatchSize      int
+	maxOpenFiles      int
+}
+
+var (
+	fileGenHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// source defines the flag for the LevelDB directory to be converted
+	source = cli.StringFlag{
+		Name:        "source",
+		Usage:       "The path of the LevelDB storer directory to be converted. The directory is not altered",
+		Destination: &argsConfig.source,
+	}
+	// destination defines the flag for the directory where the Pebble storer will be written
+	destination = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The path where the converted Pebble storer will be written. It must be empty or missing",
+		Destination: &argsConfig.destination,
+	}
+	// recursive is the flag that, if active, will convert all the LevelDB storers found in the source directory
+	recursive = cli.BoolFlag{
+		Name: "recursive",
+		Usage: "Boolean option that will convert all the LevelDB storers found under the source directory " +
+			"(for example a whole node db directory), copying all the other files as they are",
+		Destination: &argsConfig.recursive,
+	}
+	// verify is the flag that, if active, will read back each converted key
+	verify = cli.BoolFlag{
+		Name:        "verify",
+		Usage:       "Boolean option that will check that each key of the source storer was written in the destination storer",
+		Destination: &argsConfig.verify,
+	}
+	// batchDelaySeconds defines the flag for the batch delay used when the storer directory has no db config file
+	batchDelaySeconds = cli.IntFlag{
+		Name:        "batch-delay-seconds",
+		Usage:       "The batch delay used for the storers that do not contain a config.toml file",
+		Value:       2,
+		Destination: &argsConfig.batchDelaySeconds,
+	}
+	// maxBatchSize defines the flag for the batch size used when the storer directory has no db config file
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The maximum batch size used for the storers that do not contain a config.toml file",
+		Value:       100,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// maxOpenFiles defines the flag for the open files limit used when the storer directory has no db config file
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of open files used for the storers that do not contain a config.toml file",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("dbconverter")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = fileGenHelpTemplate
+	app.Name = "DB conversion Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will convert offline LevelDB storers to the Pebble storage backend"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		source,
+		destination,
+		recursive,
+		verify,
+		batchDelaySeconds,
+		maxBatchSize,
+		maxOpenFiles,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error converting storers", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	if len(argsConfig.source) == 0 || len(argsConfig.destination) == 0 {
+		return cli.NewExitError("both the source and the destination flags should be provided", 1)
+	}
+
+	dbConverter, err := converter.NewConverter(converter.ArgsConverter{
+		DefaultDBConfig: config.DBConfig{
+			Type:              string(storageunit.LvlDBSerial),
+			BatchDelaySeconds: argsConfig.batchDelaySeconds,
+			MaxBatchSize:      argsConfig.maxBatchSize,
+			MaxOpenFiles:      argsConfig.maxOpenFiles,
+		},
+		Verify: argsConfig.verify,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !argsConfig.recursive {
+		_, err = dbConverter.ConvertStorer(argsConfig.source, argsConfig.destination)
+		return err
+	}
+
+	numStorers, err := dbConverter.ConvertTree(argsConfig.source, argsConfig.destination)
+	if err != nil {
+		return err
+	}
+
+	log.Info("conversion finished", "num converted storers", numStorers)
+
+	return nil
+}
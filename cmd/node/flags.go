@@ -407,6 +407,57 @@ var (
 		Name:  "repopulate-tokens-supplies",
 		Usage: "Boolean flag for repopulating the tokens supplies database. It will delete the current data, iterate over the entire trie and add he new obtained supplies",
 	}
+
+	// snapshotOutputFile defines the flag for the file in which the snapshot subcommand writes the state snapshot
+	snapshotOutputFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `filepath` of the state snapshot file to be written",
+		Value: "",
+	}
+
+	// snapshotLeavesPerChunk defines the flag for the maximum number of trie leaves in a state snapshot chunk
+	snapshotLeavesPerChunk = cli.IntFlag{
+		Name:  "leaves-per-chunk",
+		Usage: "The maximum number of trie leaves written in a state snapshot chunk",
+		Value: 10000,
+	}
+
+	// importStateSnapshot defines a flag for the state snapshot file to be imported when the node starts in epoch
+	importStateSnapshot = cli.StringFlag{
+		Name: "import-state-snapshot",
+		Usage: "The `filepath` of a state snapshot file, created with the snapshot subcommand, from which the accounts " +
+			"and peer tries are rebuilt instead of being synced from the network. The node will start in epoch and the " +
+			"snapshot is used only if it was created for the latest epoch start meta block.",
+		Value: "",
+	}
+
+	// storageReportOutputFile defines the flag for the file in which the storage-report subcommand writes the report
+	storageReportOutputFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `filepath` of the storage report file to be written",
+		Value: "",
+	}
+
+	// storageReportFormat defines the flag for the format of the storage report
+	storageReportFormat = cli.StringFlag{
+		Name:  "format",
+		Usage: "The `format` of the storage report. Possible values are: json or csv",
+		Value: "json",
+	}
+
+	// storageReportRootHash defines the flag for the accounts trie root hash the storage report is computed for
+	storageReportRootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded accounts trie `root hash` to compute the report for. If not set, the root hash committed at the latest epoch start is used",
+		Value: "",
+	}
+
+	// storageReportNumTop defines the flag for the number of entries in each top list of the storage report
+	storageReportNumTop = cli.IntFlag{
+		Name:  "top",
+		Usage: "The number of entries kept in each top list of the storage report",
+		Value: 100,
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -469,6 +520,23 @@ func getFlags() []cli.Flag {
 		logsDirectory,
 		operationMode,
 		repopulateTokensSupplies,
+		importStateSnapshot,
+	}
+}
+
+func getSnapshotFlags() []cli.Flag {
+	return []cli.Flag{
+		snapshotOutputFile,
+		snapshotLeavesPerChunk,
+	}
+}
+
+func getStorageReportFlags() []cli.Flag {
+	return []cli.Flag{
+		storageReportOutputFile,
+		storageReportFormat,
+		storageReportRootHash,
+		storageReportNumTop,
 	}
 }
 
@@ -497,6 +565,7 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.SerializeSnapshots = ctx.GlobalBool(serializeSnapshots.Name)
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
+	flagsConfig.StateSnapshotFile = ctx.GlobalString(importStateSnapshot.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"runtime"
@@ -11,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/cmd/node/factory"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/config/overridableConfig"
 	"github.com/multiversx/mx-chain-go/node"
@@ -78,6 +80,27 @@ func main() {
 	app.Action = func(c *cli.Context) error {
 		return startNodeRunner(c, log, baseVersion, app.Version)
 	}
+	app.Commands = []cli.Command{
+		{
+			Name: "snapshot",
+			Usage: "Writes a portable state snapshot of the accounts tries committed at the latest epoch start found in " +
+				"the node's database. The node must be stopped. The global options have to be provided before the command name",
+			Flags: getSnapshotFlags(),
+			Action: func(c *cli.Context) error {
+				return exportStateSnapshot(c, log)
+			},
+		},
+		{
+			Name: "storage-report",
+			Usage: "Writes a storage footprint report of the accounts trie committed at the latest epoch start found in " +
+				"the node's database, or at the provided root hash. The node must be stopped. The global options have to " +
+				"be provided before the command name",
+			Flags: getStorageReportFlags(),
+			Action: func(c *cli.Context) error {
+				return exportStorageReport(c, log)
+			},
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -135,6 +158,11 @@ func startNodeRunner(c *cli.Context, log logger.Logger, baseVersion string, vers
 		return fmt.Errorf("Hardware Requirements checks failed: %s", err.Error())
 	}
 
+	tracerProvider, err := tracing.NewTracerProvider(cfgs.GeneralConfig.Tracing, flagsConfig.WorkingDir)
+	if err != nil {
+		return err
+	}
+
 	nodeRunner, errRunner := node.NewNodeRunner(cfgs)
 	if errRunner != nil {
 		return errRunner
@@ -145,6 +173,9 @@ func startNodeRunner(c *cli.Context, log logger.Logger, baseVersion string, vers
 		log.Error(err.Error())
 	}
 
+	errTracing := tracerProvider.Close()
+	log.LogIfError(errTracing)
+
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.Close()
 		log.LogIfError(err)
@@ -153,6 +184,84 @@ func startNodeRunner(c *cli.Context, log logger.Logger, baseVersion string, vers
 	return err
 }
 
+func exportStateSnapshot(c *cli.Context, log logger.Logger) error {
+	outputFile := c.String(snapshotOutputFile.Name)
+	if len(outputFile) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", snapshotOutputFile.Name)
+	}
+
+	cfgs, err := readSubcommandConfigs(c, log)
+	if err != nil {
+		return err
+	}
+
+	nodeRunner, err := node.NewNodeRunner(cfgs)
+	if err != nil {
+		return err
+	}
+
+	return nodeRunner.ExportStateSnapshot(node.ArgsExportStateSnapshot{
+		OutputFile:        outputFile,
+		MaxLeavesPerChunk: c.Int(snapshotLeavesPerChunk.Name),
+	})
+}
+
+func exportStorageReport(c *cli.Context, log logger.Logger) error {
+	outputFile := c.String(storageReportOutputFile.Name)
+	if len(outputFile) == 0 {
+		return fmt.Errorf("the %s flag is mandatory", storageReportOutputFile.Name)
+	}
+
+	rootHash, err := hex.DecodeString(c.String(storageReportRootHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the %s flag", err, storageReportRootHash.Name)
+	}
+
+	numTop := c.Int(storageReportNumTop.Name)
+	if numTop < 1 {
+		return fmt.Errorf("the %s flag should be a positive number", storageReportNumTop.Name)
+	}
+
+	cfgs, err := readSubcommandConfigs(c, log)
+	if err != nil {
+		return err
+	}
+
+	nodeRunner, err := node.NewNodeRunner(cfgs)
+	if err != nil {
+		return err
+	}
+
+	return nodeRunner.ExportStorageReport(node.ArgsExportStorageReport{
+		OutputFile: outputFile,
+		Format:     c.String(storageReportFormat.Name),
+		RootHash:   rootHash,
+		NumTop:     numTop,
+	})
+}
+
+// readSubcommandConfigs reads and adjusts the configs out of the global options provided before the subcommand name
+func readSubcommandConfigs(c *cli.Context, log logger.Logger) (*config.Configs, error) {
+	globalCtx := c.Parent()
+	flagsConfig := getFlagsConfig(globalCtx, log)
+	cfgs, err := readConfigs(globalCtx, log)
+	if err != nil {
+		return nil, err
+	}
+
+	err = overridableConfig.OverrideConfigValues(cfgs.PreferencesConfig.Preferences.OverridableConfigTomlValues, cfgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyFlags(globalCtx, cfgs, flagsConfig, log)
+	if err != nil {
+		return nil, err
+	}
+
+	return cfgs, nil
+}
+
 func readConfigs(ctx *cli.Context, log logger.Logger) (*config.Configs, error) {
 	log.Trace("reading Configs")
 
@@ -0,0 +1,9 @@
+package main
+
+import "github.com/multiversx/mx-chain-go/consensus/signingHistory"
+
+type signingHistoryHandler interface {
+	Export() (*signingHistory.Interchange, error)
+	Import(interchange *signingHistory.Interchange) (signingHistory.ImportStatistics, error)
+	Close() error
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/consensus/signingHistory"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const interchangeFilePermissions = 0644
+
+type cfg struct {
+	dbPath            string
+	dbType            string
+	exportFile        string
+	importFile        string
+	chainID           string
+	batchDelaySeconds int
+	maxOpenFiles      int
+}
+
+var (
+	fileGenHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines the flag for the signing history storer directory
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The path of the signing history storer directory, for example ./db/<chain ID>/SigningHistory",
+		Destination: &argsConfig.dbPath,
+	}
+	// dbType defines the flag for the signing history storer type
+	dbType = cli.StringFlag{
+		Name:        "db-type",
+		Usage:       "The type of the signing history storer, as set in the SigningHistory.Storage.DB section of the node's config.toml",
+		Value:       string(storageunit.LvlDBSerial),
+		Destination: &argsConfig.dbType,
+	}
+	// exportFile defines the flag for the file where the signing history will be exported
+	exportFile = cli.StringFlag{
+		Name:        "export",
+		Usage:       "The JSON file where the signing history will be exported in the interchange format",
+		Destination: &argsConfig.exportFile,
+	}
+	// importFile defines the flag for the file from which the signing history will be imported
+	importFile = cli.StringFlag{
+		Name: "import",
+		Usage: "The JSON file, in the interchange format, that will be merged into the signing history. Rounds signed " +
+			"with different headers in the two histories are marked as conflicting and will not be signed anymore",
+		Destination: &argsConfig.importFile,
+	}
+	// chainID defines the flag for the chain ID written on export and checked on import
+	chainID = cli.StringFlag{
+		Name:        "chain-id",
+		Usage:       "The chain ID. It is mandatory on export and, if provided on import, it must match the one in the file",
+		Destination: &argsConfig.chainID,
+	}
+	// batchDelaySeconds defines the flag for the batch delay of the opened storer
+	batchDelaySeconds = cli.IntFlag{
+		Name:        "batch-delay-seconds",
+		Usage:       "The batch delay used when opening the storer",
+		Value:       2,
+		Destination: &argsConfig.batchDelaySeconds,
+	}
+	// maxOpenFiles defines the flag for the open files limit of the opened storer
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of open files used when opening the storer",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("signinghistory")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = fileGenHelpTemplate
+	app.Name = "Signing history Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will export or import the offline signing history of a node, used when moving keys between machines"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		dbType,
+		exportFile,
+		importFile,
+		chainID,
+		batchDelaySeconds,
+		maxOpenFiles,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error processing the signing history", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	if len(argsConfig.dbPath) == 0 {
+		return cli.NewExitError("the db-path flag should be provided", 1)
+	}
+	shouldExport := len(argsConfig.exportFile) > 0
+	shouldImport := len(argsConfig.importFile) > 0
+	if shouldExport == shouldImport {
+		return cli.NewExitError("exactly one of the export and import flags should be provided", 1)
+	}
+	if shouldExport && len(argsConfig.chainID) == 0 {
+		return cli.NewExitError("the chain-id flag should be provided on export", 1)
+	}
+
+	history, err := createSigningHistory()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := history.Close()
+		if errClose != nil {
+			log.Error("error closing the signing history", "error", errClose)
+		}
+	}()
+
+	if shouldExport {
+		return exportHistory(history)
+	}
+
+	return importHistory(history)
+}
+
+func createSigningHistory() (signingHistoryHandler, error) {
+	dbConfig := config.DBConfig{
+		Type:              argsConfig.dbType,
+		BatchDelaySeconds: argsConfig.batchDelaySeconds,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      argsConfig.maxOpenFiles,
+	}
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	storerDBConfig := storageFactory.GetDBFromConfig(dbConfig)
+	storerDBConfig.FilePath = argsConfig.dbPath
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageunit.CacheConfig{
+			Type:     storageunit.LRUCache,
+			Capacity: 1000,
+		},
+		storerDBConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+		Storer:     storer,
+		Marshaller: &marshal.JsonMarshalizer{},
+		ChainID:    argsConfig.chainID,
+	})
+}
+
+func exportHistory(history signingHistoryHandler) error {
+	interchange, err := history.Export()
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(interchange, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(argsConfig.exportFile, buff, interchangeFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Info("signing history exported", "file", argsConfig.exportFile, "num keys", len(interchange.Data))
+
+	return nil
+}
+
+func importHistory(history signingHistoryHandler) error {
+	buff, err := os.ReadFile(argsConfig.importFile)
+	if err != nil {
+		return err
+	}
+
+	interchange := &signingHistory.Interchange{}
+	err = json.Unmarshal(buff, interchange)
+	if err != nil {
+		return err
+	}
+
+	_, err = history.Import(interchange)
+
+	return err
+}
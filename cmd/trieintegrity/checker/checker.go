@@ -0,0 +1,230 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const mainTrieName = "main"
+const dataTrieName = "data"
+
+// ArgsChecker holds the arguments needed to create a new accounts trie checker
+type ArgsChecker struct {
+	Storer           common.BaseStorer
+	Marshaller       marshal.Marshalizer
+	Hasher           hashing.Hasher
+	AddressConverter core.PubkeyConverter
+	// CheckDataTries enables walking the data tries of the accounts found in the main trie
+	CheckDataTries bool
+	// RepairSource is optional. If set, the missing or corrupt nodes are fetched from it and written in the storer
+	RepairSource common.BaseStorer
+}
+
+// Issue holds the details of a missing or corrupt trie node
+type Issue struct {
+	Trie     string `json:"trie"`
+	Owner    string `json:"owner,omitempty"`
+	RootHash string `json:"rootHash"`
+	NodeHash string `json:"nodeHash"`
+	Path     string `json:"path"`
+	Missing  bool   `json:"missing"`
+	Repaired bool   `json:"repaired"`
+	Error    string `json:"error"`
+}
+
+// Report holds the outcome of checking an accounts trie and its data tries
+type Report struct {
+	RootHash         string   `json:"rootHash"`
+	NumDataTries     uint64   `json:"numDataTries"`
+	NumNodes         uint64   `json:"numNodes"`
+	NumLeaves        uint64   `json:"numLeaves"`
+	NumRepairedNodes uint64   `json:"numRepairedNodes"`
+	Issues           []*Issue `json:"issues"`
+}
+
+// IsHealthy returns true if all the nodes were found and matched their hash, or were repaired
+func (r *Report) IsHealthy() bool {
+	return uint64(len(r.Issues)) == r.NumRepairedNodes
+}
+
+type dataTrie struct {
+	rootHash []byte
+	owner    []byte
+}
+
+type checker struct {
+	trieChecker      trieIntegrityChecker
+	marshaller       marshal.Marshalizer
+	addressConverter core.PubkeyConverter
+	checkDataTries   bool
+}
+
+type trieIntegrityChecker interface {
+	Check(rootHash []byte, handler trie.LeafHandler) (*trie.IntegrityCheckResult, error)
+	IsInterfaceNil() bool
+}
+
+// NewChecker creates a new checker that walks an accounts trie and all its data tries
+func NewChecker(args ArgsChecker) (*checker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+
+	argsIntegrityChecker := trie.ArgsIntegrityChecker{
+		Storer:     args.Storer,
+		Marshaller: args.Marshaller,
+		Hasher:     args.Hasher,
+	}
+	if !check.IfNil(args.RepairSource) {
+		argsIntegrityChecker.RepairSource = args.RepairSource
+		argsIntegrityChecker.RepairDestination = args.Storer
+	}
+	trieChecker, err := trie.NewIntegrityChecker(argsIntegrityChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checker{
+		trieChecker:      trieChecker,
+		marshaller:       args.Marshaller,
+		addressConverter: args.AddressConverter,
+		checkDataTries:   args.CheckDataTries,
+	}, nil
+}
+
+// Check walks the main trie from the given root hash and then each data trie referenced by its accounts
+func (c *checker) Check(rootHash []byte) (*Report, error) {
+	report := &Report{
+		RootHash: hex.EncodeToString(rootHash),
+		Issues:   make([]*Issue, 0),
+	}
+
+	dataTries := make([]*dataTrie, 0)
+	uniqueDataTries := make(map[string]struct{})
+	result, err := c.trieChecker.Check(rootHash, func(key []byte, value []byte) {
+		if !c.checkDataTries {
+			return
+		}
+
+		dataTrieRootHash := c.getDataTrieRootHash(key, value)
+		if len(dataTrieRootHash) == 0 {
+			return
+		}
+		_, found := uniqueDataTries[string(dataTrieRootHash)]
+		if found {
+			return
+		}
+
+		uniqueDataTries[string(dataTrieRootHash)] = struct{}{}
+		dataTries = append(dataTries, &dataTrie{
+			rootHash: dataTrieRootHash,
+			owner:    key,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.addResult(report, result, mainTrieName, rootHash, nil)
+	log.Info("checked the main trie",
+		"root hash", rootHash,
+		"num nodes", result.NumNodes,
+		"num leaves", result.NumLeaves,
+		"num issues", len(result.Issues),
+		"num data tries", len(dataTries),
+	)
+
+	for i, dt := range dataTries {
+		result, err = c.trieChecker.Check(dt.rootHash, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.addResult(report, result, dataTrieName, dt.rootHash, dt.owner)
+		report.NumDataTries++
+
+		if len(result.Issues) > 0 {
+			log.Warn("data trie has faulty nodes",
+				"owner", c.addressConverter.SilentEncode(dt.owner, log),
+				"root hash", dt.rootHash,
+				"num issues", len(result.Issues),
+			)
+		}
+		log.Debug("checked data trie", "index", i, "owner", dt.owner, "root hash", dt.rootHash)
+	}
+
+	return report, nil
+}
+
+// getDataTrieRootHash returns the data trie root hash if the leaf holds an account with a non-empty data trie
+func (c *checker) getDataTrieRootHash(key []byte, value []byte) []byte {
+	accountData := &accounts.UserAccountData{}
+	err := c.marshaller.Unmarshal(accountData, value)
+	if err != nil {
+		return nil
+	}
+	if !bytes.Equal(accountData.Address, key) {
+		return nil
+	}
+
+	return accountData.RootHash
+}
+
+func (c *checker) addResult(report *Report, result *trie.IntegrityCheckResult, trieName string, rootHash []byte, owner []byte) {
+	report.NumNodes += result.NumNodes
+	report.NumLeaves += result.NumLeaves
+
+	for _, integrityIssue := range result.Issues {
+		issue := &Issue{
+			Trie:     trieName,
+			RootHash: hex.EncodeToString(rootHash),
+			NodeHash: hex.EncodeToString(integrityIssue.Hash),
+			Path:     nibblesToString(integrityIssue.Path),
+			Missing:  integrityIssue.Missing,
+			Repaired: integrityIssue.Repaired,
+		}
+		if len(owner) > 0 {
+			issue.Owner = c.addressConverter.SilentEncode(owner, log)
+		}
+		if integrityIssue.Err != nil {
+			issue.Error = integrityIssue.Err.Error()
+		}
+		if issue.Repaired {
+			report.NumRepairedNodes++
+		}
+
+		report.Issues = append(report.Issues, issue)
+	}
+}
+
+// nibblesToString writes each nibble of the path from the root as a hex digit
+func nibblesToString(nibbles []byte) string {
+	builder := strings.Builder{}
+	for _, nibble := range nibbles {
+		builder.WriteString(strconv.FormatUint(uint64(nibble), 16))
+	}
+
+	return builder.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *checker) IsInterfaceNil() bool {
+	return c == nil
+}
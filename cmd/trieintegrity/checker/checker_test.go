@@ -0,0 +1,270 @@
+package checker
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddressLen = 32
+
+type accountsTestTries struct {
+	db             *testscommon.MemDbMock
+	mainTrie       common.Trie
+	mainRootHash   []byte
+	dataRootHash   []byte
+	dataTrieOwners []string
+	numLeaves      uint64
+}
+
+func createTestTrie(t *testing.T, db *testscommon.MemDbMock, leaves map[string][]byte) common.Trie {
+	args := storageMock.GetStorageManagerArgs()
+	args.MainStorer = db
+	args.Marshalizer = &marshallerMock.MarshalizerMock{}
+	args.Hasher = &hashingMocks.HasherMock{}
+	tsm, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(tsm, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+	for key, value := range leaves {
+		require.Nil(t, tr.Update([]byte(key), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	return tr
+}
+
+func createAccountsTestTries(t *testing.T) *accountsTestTries {
+	db := testscommon.NewMemDbMock()
+	marshaller := &marshallerMock.MarshalizerMock{}
+
+	dataLeaves := make(map[string][]byte)
+	for i := 0; i < 20; i++ {
+		dataLeaves[fmt.Sprintf("key%d", i)] = []byte(fmt.Sprintf("value%d", i))
+	}
+	dataTrie := createTestTrie(t, db, dataLeaves)
+	dataRootHash, err := dataTrie.RootHash()
+	require.Nil(t, err)
+
+	mainLeaves := make(map[string][]byte)
+	addressConverter := testscommon.NewPubkeyConverterMock(testAddressLen)
+	dataTrieOwners := make([]string, 0)
+	for i := 0; i < 10; i++ {
+		address := []byte(fmt.Sprintf("address%25d", i))
+		account := &accounts.UserAccountData{
+			Nonce:   uint64(i),
+			Address: address,
+		}
+		if i%3 == 0 {
+			// the same data trie is referenced by multiple accounts and should be checked only once
+			account.RootHash = dataRootHash
+			dataTrieOwners = append(dataTrieOwners, addressConverter.SilentEncode(address, log))
+		}
+
+		mainLeaves[string(address)], err = marshaller.Marshal(account)
+		require.Nil(t, err)
+	}
+	mainTrie := createTestTrie(t, db, mainLeaves)
+	mainRootHash, err := mainTrie.RootHash()
+	require.Nil(t, err)
+
+	return &accountsTestTries{
+		db:             db,
+		mainTrie:       mainTrie,
+		mainRootHash:   mainRootHash,
+		dataRootHash:   dataRootHash,
+		dataTrieOwners: dataTrieOwners,
+		numLeaves:      uint64(len(mainLeaves) + len(dataLeaves)),
+	}
+}
+
+func createMockArgsChecker(storer common.BaseStorer) ArgsChecker {
+	return ArgsChecker{
+		Storer:           storer,
+		Marshaller:       &marshallerMock.MarshalizerMock{},
+		Hasher:           &hashingMocks.HasherMock{},
+		AddressConverter: testscommon.NewPubkeyConverterMock(testAddressLen),
+		CheckDataTries:   true,
+	}
+}
+
+func copyDB(db *testscommon.MemDbMock) *testscommon.MemDbMock {
+	dbCopy := testscommon.NewMemDbMock()
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		_ = dbCopy.Put(key, value)
+		return true
+	})
+
+	return dbCopy
+}
+
+func TestNewChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChecker(nil)
+		c, err := NewChecker(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.Nil(t, c)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChecker(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		c, err := NewChecker(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, c)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChecker(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		c, err := NewChecker(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, c)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChecker(testscommon.NewMemDbMock())
+		args.AddressConverter = nil
+		c, err := NewChecker(args)
+		assert.Equal(t, ErrNilAddressConverter, err)
+		assert.Nil(t, c)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewChecker(createMockArgsChecker(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, c.IsInterfaceNil())
+	})
+}
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewChecker(createMockArgsChecker(testscommon.NewMemDbMock()))
+		report, err := c.Check(nil)
+		assert.True(t, errors.Is(err, trie.ErrEmptyRootHash))
+		assert.Nil(t, report)
+	})
+	t.Run("healthy tries should not report issues", func(t *testing.T) {
+		t.Parallel()
+
+		testTries := createAccountsTestTries(t)
+		c, _ := NewChecker(createMockArgsChecker(testTries.db))
+		report, err := c.Check(testTries.mainRootHash)
+		require.Nil(t, err)
+
+		assert.True(t, report.IsHealthy())
+		assert.Empty(t, report.Issues)
+		assert.Equal(t, uint64(1), report.NumDataTries)
+		assert.Equal(t, testTries.numLeaves, report.NumLeaves)
+		assert.Equal(t, hex.EncodeToString(testTries.mainRootHash), report.RootHash)
+	})
+	t.Run("data tries should not be checked if disabled", func(t *testing.T) {
+		t.Parallel()
+
+		testTries := createAccountsTestTries(t)
+		require.Nil(t, testTries.db.Remove(testTries.dataRootHash))
+		args := createMockArgsChecker(testTries.db)
+		args.CheckDataTries = false
+		c, _ := NewChecker(args)
+		report, err := c.Check(testTries.mainRootHash)
+		require.Nil(t, err)
+
+		assert.True(t, report.IsHealthy())
+		assert.Zero(t, report.NumDataTries)
+	})
+	t.Run("missing data trie node should be reported with its owner", func(t *testing.T) {
+		t.Parallel()
+
+		testTries := createAccountsTestTries(t)
+		require.Nil(t, testTries.db.Remove(testTries.dataRootHash))
+		c, _ := NewChecker(createMockArgsChecker(testTries.db))
+		report, err := c.Check(testTries.mainRootHash)
+		require.Nil(t, err)
+
+		assert.False(t, report.IsHealthy())
+		require.Equal(t, 1, len(report.Issues))
+		issue := report.Issues[0]
+		assert.Equal(t, dataTrieName, issue.Trie)
+		assert.Contains(t, testTries.dataTrieOwners, issue.Owner)
+		assert.Equal(t, hex.EncodeToString(testTries.dataRootHash), issue.NodeHash)
+		assert.Equal(t, hex.EncodeToString(testTries.dataRootHash), issue.RootHash)
+		assert.True(t, issue.Missing)
+		assert.False(t, issue.Repaired)
+		assert.NotEmpty(t, issue.Error)
+	})
+	t.Run("corrupt main trie node should be reported with its path", func(t *testing.T) {
+		t.Parallel()
+
+		testTries := createAccountsTestTries(t)
+		mainRoot, _ := testTries.db.Get(testTries.mainRootHash)
+		require.Nil(t, testTries.db.Put(testTries.mainRootHash, append(mainRoot, 0)))
+		c, _ := NewChecker(createMockArgsChecker(testTries.db))
+		report, err := c.Check(testTries.mainRootHash)
+		require.Nil(t, err)
+
+		require.Equal(t, 1, len(report.Issues))
+		issue := report.Issues[0]
+		assert.Equal(t, mainTrieName, issue.Trie)
+		assert.Empty(t, issue.Owner)
+		assert.Empty(t, issue.Path)
+		assert.False(t, issue.Missing)
+		assert.Contains(t, issue.Error, trie.ErrNodeHashMismatch.Error())
+		assert.Zero(t, report.NumLeaves)
+	})
+	t.Run("should repair the missing nodes from the repair source", func(t *testing.T) {
+		t.Parallel()
+
+		testTries := createAccountsTestTries(t)
+		repairSource := copyDB(testTries.db)
+		require.Nil(t, testTries.db.Remove(testTries.dataRootHash))
+		require.Nil(t, testTries.db.Remove(testTries.mainRootHash))
+
+		args := createMockArgsChecker(testTries.db)
+		args.RepairSource = repairSource
+		c, _ := NewChecker(args)
+		report, err := c.Check(testTries.mainRootHash)
+		require.Nil(t, err)
+
+		assert.True(t, report.IsHealthy())
+		assert.Equal(t, 2, len(report.Issues))
+		assert.Equal(t, uint64(2), report.NumRepairedNodes)
+		assert.Equal(t, testTries.numLeaves, report.NumLeaves)
+
+		c, _ = NewChecker(createMockArgsChecker(testTries.db))
+		report, err = c.Check(testTries.mainRootHash)
+		require.Nil(t, err)
+		assert.Empty(t, report.Issues)
+	})
+}
+
+func TestNibblesToString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", nibblesToString(nil))
+	assert.Equal(t, "0a1f", nibblesToString([]byte{0, 10, 1, 15}))
+}
@@ -0,0 +1,24 @@
+package checker
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAddressConverter signals that a nil address converter was provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNoTrieStorerFound signals that no trie storer was found in the provided db directory
+var ErrNoTrieStorerFound = errors.New("no trie storer found")
+
+// ErrReadOnlyStorer signals that a write operation was attempted on a storer set opened as read-only
+var ErrReadOnlyStorer = errors.New("the storer set was opened as read-only")
+
+// ErrTrieNotFoundInSnapshot signals that the state snapshot does not contain the requested trie
+var ErrTrieNotFoundInSnapshot = errors.New("trie not found in the state snapshot")
@@ -0,0 +1,260 @@
+package checker
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// readOnlyPersisterCreator opens the existing databases without ever writing in them: no missing database is created,
+// no corrupted database is recovered and no write-ahead log or compaction is run
+type readOnlyPersisterCreator struct {
+	dbType       storageunit.DBType
+	maxOpenFiles int
+}
+
+func createReadOnlyPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	creator := &readOnlyPersisterCreator{
+		dbType:       storageunit.DBType(dbConfig.Type),
+		maxOpenFiles: dbConfig.MaxOpenFiles,
+	}
+	if dbConfig.NumShards < 2 {
+		return creator.CreateBasePersister(path)
+	}
+
+	if storageunit.ShardIDProviderType(dbConfig.ShardIDProviderType) != storageunit.BinarySplit {
+		return nil, storage.ErrNotSupportedShardIDProviderType
+	}
+	shardIDProvider, err := database.NewShardIDProvider(dbConfig.NumShards)
+	if err != nil {
+		return nil, err
+	}
+
+	return database.NewShardedPersister(path, creator, shardIDProvider)
+}
+
+// CreateBasePersister opens the database found at the provided path as read-only
+func (creator *readOnlyPersisterCreator) CreateBasePersister(path string) (storage.Persister, error) {
+	if creator.maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	switch creator.dbType {
+	case storageunit.LvlDB, storageunit.LvlDBSerial:
+		return openReadOnlyLevelDB(path, creator.maxOpenFiles)
+	case storageunit.Pebble:
+		return openReadOnlyPebbleDB(path, creator.maxOpenFiles)
+	default:
+		return nil, storage.ErrNotSupportedDBType
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *readOnlyPersisterCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
+
+type readOnlyLevelDB struct {
+	db   *leveldb.DB
+	path string
+}
+
+func openReadOnlyLevelDB(path string, maxOpenFiles int) (*readOnlyLevelDB, error) {
+	options := &opt.Options{
+		ReadOnly:               true,
+		ErrorIfMissing:         true,
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+	}
+	db, err := leveldb.OpenFile(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &readOnlyLevelDB{
+		db:   db,
+		path: path,
+	}, nil
+}
+
+// Get returns the value associated to the key
+func (rodb *readOnlyLevelDB) Get(key []byte) ([]byte, error) {
+	value, err := rodb.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, err
+}
+
+// Has returns nil if the given key is present in the database
+func (rodb *readOnlyLevelDB) Has(key []byte) error {
+	has, err := rodb.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return storage.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// RangeKeys iterates over all the (key, value) pairs until the handler returns false
+func (rodb *readOnlyLevelDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	iterator := rodb.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		value := append([]byte{}, iterator.Value()...)
+		if !handler(key, value) {
+			return
+		}
+	}
+}
+
+// Put returns ErrReadOnlyStorer
+func (rodb *readOnlyLevelDB) Put(_ []byte, _ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Remove returns ErrReadOnlyStorer
+func (rodb *readOnlyLevelDB) Remove(_ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Destroy returns ErrReadOnlyStorer
+func (rodb *readOnlyLevelDB) Destroy() error {
+	return ErrReadOnlyStorer
+}
+
+// DestroyClosed returns ErrReadOnlyStorer
+func (rodb *readOnlyLevelDB) DestroyClosed() error {
+	return ErrReadOnlyStorer
+}
+
+// Close closes the database
+func (rodb *readOnlyLevelDB) Close() error {
+	return rodb.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rodb *readOnlyLevelDB) IsInterfaceNil() bool {
+	return rodb == nil
+}
+
+type readOnlyPebbleDB struct {
+	db   *pebble.DB
+	path string
+}
+
+func openReadOnlyPebbleDB(path string, maxOpenFiles int) (*readOnlyPebbleDB, error) {
+	options := &pebble.Options{
+		ReadOnly:         true,
+		ErrorIfNotExists: true,
+		MaxOpenFiles:     maxOpenFiles,
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &readOnlyPebbleDB{
+		db:   db,
+		path: path,
+	}, nil
+}
+
+// Get returns the value associated to the key
+func (rodb *readOnlyPebbleDB) Get(key []byte) ([]byte, error) {
+	value, closer, err := rodb.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	clonedValue := append([]byte{}, value...)
+	_ = closer.Close()
+
+	return clonedValue, nil
+}
+
+// Has returns nil if the given key is present in the database
+func (rodb *readOnlyPebbleDB) Has(key []byte) error {
+	_, closer, err := rodb.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return closer.Close()
+}
+
+// RangeKeys iterates over all the (key, value) pairs until the handler returns false
+func (rodb *readOnlyPebbleDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	iterator, err := rodb.db.NewIter(nil)
+	if err != nil {
+		log.Warn("cannot iterate the read-only storer", "path", rodb.path, "error", err)
+		return
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	for iterator.First(); iterator.Valid(); iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		value := append([]byte{}, iterator.Value()...)
+		if !handler(key, value) {
+			return
+		}
+	}
+}
+
+// Put returns ErrReadOnlyStorer
+func (rodb *readOnlyPebbleDB) Put(_ []byte, _ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Remove returns ErrReadOnlyStorer
+func (rodb *readOnlyPebbleDB) Remove(_ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Destroy returns ErrReadOnlyStorer
+func (rodb *readOnlyPebbleDB) Destroy() error {
+	return ErrReadOnlyStorer
+}
+
+// DestroyClosed returns ErrReadOnlyStorer
+func (rodb *readOnlyPebbleDB) DestroyClosed() error {
+	return ErrReadOnlyStorer
+}
+
+// Close closes the database
+func (rodb *readOnlyPebbleDB) Close() error {
+	return rodb.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rodb *readOnlyPebbleDB) IsInterfaceNil() bool {
+	return rodb == nil
+}
@@ -0,0 +1,109 @@
+package checker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readDirectoryFiles returns the data files found in the provided directory, the LOCK files being touched on each open
+func readDirectoryFiles(t *testing.T, path string) map[string]int64 {
+	files := make(map[string]int64)
+	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && info.Name() != "LOCK" {
+			files[filePath] = info.ModTime().UnixNano() + info.Size()
+		}
+
+		return nil
+	})
+	require.Nil(t, err)
+
+	return files
+}
+
+func TestCreateReadOnlyPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported db type should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createTestDBConfig()
+		dbConfig.Type = string(storageunit.MemoryDB)
+
+		persister, err := createReadOnlyPersister(dbConfig, t.TempDir())
+		assert.Equal(t, storage.ErrNotSupportedDBType, err)
+		assert.Nil(t, persister)
+	})
+	t.Run("missing db should error and not create it", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "missing")
+		persister, err := createReadOnlyPersister(createTestDBConfig(), path)
+		assert.NotNil(t, err)
+		assert.Nil(t, persister)
+		assert.NoDirExists(t, path)
+	})
+
+	testReadOnlyPersister := func(t *testing.T, dbConfig config.DBConfig) {
+		path := filepath.Join(t.TempDir(), "db")
+		persister, err := createPersister(dbConfig, path)
+		require.Nil(t, err)
+		require.Nil(t, persister.Put([]byte("key"), []byte("value")))
+		require.Nil(t, persister.Close())
+		filesBefore := readDirectoryFiles(t, path)
+
+		persister, err = createReadOnlyPersister(dbConfig, path)
+		require.Nil(t, err)
+
+		value, err := persister.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+		assert.Nil(t, persister.Has([]byte("key")))
+		_, err = persister.Get([]byte("missing key"))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Equal(t, storage.ErrKeyNotFound, persister.Has([]byte("missing key")))
+
+		numKeys := 0
+		persister.RangeKeys(func(key []byte, value []byte) bool {
+			numKeys++
+			return true
+		})
+		assert.Equal(t, 1, numKeys)
+
+		assert.Equal(t, ErrReadOnlyStorer, persister.Put([]byte("key2"), []byte("value2")))
+		assert.Equal(t, ErrReadOnlyStorer, persister.Remove([]byte("key")))
+		require.Nil(t, persister.Close())
+
+		assert.Equal(t, filesBefore, readDirectoryFiles(t, path))
+	}
+
+	t.Run("leveldb should not write in the db", func(t *testing.T) {
+		t.Parallel()
+
+		testReadOnlyPersister(t, createTestDBConfig())
+	})
+	t.Run("sharded leveldb should not write in the db", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createTestDBConfig()
+		dbConfig.NumShards = 4
+		dbConfig.ShardIDProviderType = string(storageunit.BinarySplit)
+		testReadOnlyPersister(t, dbConfig)
+	})
+	t.Run("pebble should not write in the db", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createTestDBConfig()
+		dbConfig.Type = string(storageunit.Pebble)
+		testReadOnlyPersister(t, dbConfig)
+	})
+}
@@ -0,0 +1,192 @@
+package checker
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/portableSnapshot"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/hashesHolder/disabled"
+	"github.com/multiversx/mx-chain-go/trie/storageMarker"
+)
+
+// ArgsSnapshotRepairSource holds the arguments needed to rebuild the trie nodes from a portable state snapshot
+type ArgsSnapshotRepairSource struct {
+	SnapshotFile string
+	// Identifier is the identifier of the trie from the snapshot, for example UserAccountsUnit
+	Identifier string
+	// TemporaryDBConfig is the config of the storer the trie is rebuilt in. Its directory is removed on close
+	TemporaryDBConfig    config.DBConfig
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	MaxTrieLevelInMemory uint
+}
+
+type snapshotRepairSource struct {
+	common.StorageManager
+	temporaryDirectory string
+}
+
+// NewSnapshotRepairSource rebuilds the trie and, for the user accounts trie, all the data tries from the state
+// snapshot into a temporary storer, so that their nodes can be used to repair the node's storers
+func NewSnapshotRepairSource(args ArgsSnapshotRepairSource) (*snapshotRepairSource, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	file, err := os.Open(args.SnapshotFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader, err := portableSnapshot.NewSnapshotReader(file, args.Hasher)
+	if err != nil {
+		return nil, err
+	}
+	expectedTrie, err := getExpectedTrieFromManifest(reader.Manifest(), args.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	temporaryDirectory, err := os.MkdirTemp("", "trieintegrity")
+	if err != nil {
+		return nil, err
+	}
+	srs := &snapshotRepairSource{
+		temporaryDirectory: temporaryDirectory,
+	}
+	srs.StorageManager, err = createTemporaryStorageManager(args, temporaryDirectory)
+	if err != nil {
+		_ = os.RemoveAll(temporaryDirectory)
+		return nil, err
+	}
+
+	err = importSnapshot(args, reader, expectedTrie, srs.StorageManager)
+	if err != nil {
+		_ = srs.Close()
+		return nil, fmt.Errorf("%w while importing the state snapshot %s", err, args.SnapshotFile)
+	}
+
+	log.Info("rebuilt the trie from the state snapshot",
+		"file", args.SnapshotFile,
+		"identifier", args.Identifier,
+		"root hash", expectedTrie.RootHash,
+		"epoch", reader.Manifest().Epoch,
+		"num leaves", reader.Trailer().NumLeaves,
+	)
+
+	return srs, nil
+}
+
+func getExpectedTrieFromManifest(manifest *portableSnapshot.Manifest, identifier string) (portableSnapshot.ExpectedTrie, error) {
+	for _, trieManifest := range manifest.Tries {
+		if trieManifest.Identifier != identifier {
+			continue
+		}
+
+		return portableSnapshot.ExpectedTrie{
+			RootHash:     trieManifest.RootHash,
+			HasDataTries: identifier == dataRetriever.UserAccountsUnit.String(),
+		}, nil
+	}
+
+	return portableSnapshot.ExpectedTrie{}, fmt.Errorf("%w: %s", ErrTrieNotFoundInSnapshot, identifier)
+}
+
+func createTemporaryStorageManager(args ArgsSnapshotRepairSource, temporaryDirectory string) (common.StorageManager, error) {
+	persister, err := createPersister(args.TemporaryDBConfig, temporaryDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	tsmArgs := trie.NewTrieStorageManagerArgs{
+		MainStorer:        persister,
+		CheckpointsStorer: database.NewMemDB(),
+		Marshalizer:       args.Marshaller,
+		Hasher:            args.Hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: disabled.NewDisabledCheckpointHashesHolder(),
+		IdleProvider:           commonDisabled.NewProcessStatusHandler(),
+		Identifier:             args.Identifier,
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:     false,
+		SnapshotsEnabled:   false,
+		CheckpointsEnabled: false,
+	}
+
+	storageManager, err := trie.CreateTrieStorageManager(tsmArgs, options)
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return storageManager, nil
+}
+
+func importSnapshot(
+	args ArgsSnapshotRepairSource,
+	reader portableSnapshot.SnapshotReader,
+	expectedTrie portableSnapshot.ExpectedTrie,
+	storageManager common.StorageManager,
+) error {
+	// the leaves keep the version they were exported with, so all the flags are enabled in order to accept any version
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(config.EnableEpochs{}, forking.NewGenericEpochNotifier())
+	if err != nil {
+		return err
+	}
+
+	snapshotImporter, err := portableSnapshot.NewImporter(portableSnapshot.ArgsImporter{
+		Marshaller:           args.Marshaller,
+		Hasher:               args.Hasher,
+		EnableEpochsHandler:  enableEpochsHandler,
+		StorageMarker:        storageMarker.NewDisabledStorageMarker(),
+		MaxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+	})
+	if err != nil {
+		return err
+	}
+
+	expectedTries := map[string]portableSnapshot.ExpectedTrie{
+		args.Identifier: expectedTrie,
+	}
+	trieStorageManagers := map[string]common.StorageManager{
+		args.Identifier: storageManager,
+	}
+
+	return snapshotImporter.Import(reader, expectedTries, trieStorageManagers)
+}
+
+// Close closes the temporary storer and removes its directory
+func (srs *snapshotRepairSource) Close() error {
+	err := srs.StorageManager.Close()
+	errRemove := os.RemoveAll(srs.temporaryDirectory)
+	if err != nil {
+		return err
+	}
+
+	return errRemove
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (srs *snapshotRepairSource) IsInterfaceNil() bool {
+	return srs == nil
+}
@@ -0,0 +1,149 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/portableSnapshot"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestSnapshot(t *testing.T, testTries *accountsTestTries) string {
+	exporter, err := portableSnapshot.NewExporter(portableSnapshot.ArgsExporter{
+		Marshaller:        &marshallerMock.MarshalizerMock{},
+		Hasher:            &hashingMocks.HasherMock{},
+		MaxLeavesPerChunk: 4,
+	})
+	require.Nil(t, err)
+
+	leavesProvider := &stateMock.AccountsStub{
+		GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			return testTries.mainTrie.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), trieLeafParser)
+		},
+	}
+
+	epochStartMeta := []byte("epoch start meta")
+	snapshotFile := filepath.Join(t.TempDir(), "state.snapshot")
+	file, err := os.Create(snapshotFile)
+	require.Nil(t, err)
+	_, err = exporter.Export(file, portableSnapshot.ArgsExport{
+		ChainID:            "chain",
+		EpochStartMetaHash: (&hashingMocks.HasherMock{}).Compute(string(epochStartMeta)),
+		EpochStartMeta:     epochStartMeta,
+		Tries: []*portableSnapshot.TrieToExport{
+			{
+				Identifier:     dataRetriever.UserAccountsUnit.String(),
+				RootHash:       testTries.mainRootHash,
+				LeavesProvider: leavesProvider,
+				HasDataTries:   true,
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return snapshotFile
+}
+
+func createMockArgsSnapshotRepairSource(snapshotFile string) ArgsSnapshotRepairSource {
+	return ArgsSnapshotRepairSource{
+		SnapshotFile:         snapshotFile,
+		Identifier:           dataRetriever.UserAccountsUnit.String(),
+		TemporaryDBConfig:    createTestDBConfig(),
+		Marshaller:           &marshallerMock.MarshalizerMock{},
+		Hasher:               &hashingMocks.HasherMock{},
+		MaxTrieLevelInMemory: 5,
+	}
+}
+
+func TestNewSnapshotRepairSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotRepairSource("")
+		args.Marshaller = nil
+		srs, err := NewSnapshotRepairSource(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, srs)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotRepairSource("")
+		args.Hasher = nil
+		srs, err := NewSnapshotRepairSource(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, srs)
+	})
+	t.Run("missing snapshot file should error", func(t *testing.T) {
+		t.Parallel()
+
+		srs, err := NewSnapshotRepairSource(createMockArgsSnapshotRepairSource(filepath.Join(t.TempDir(), "missing")))
+		assert.NotNil(t, err)
+		assert.Nil(t, srs)
+	})
+	t.Run("trie missing from the snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshotFile := writeTestSnapshot(t, createAccountsTestTries(t))
+		args := createMockArgsSnapshotRepairSource(snapshotFile)
+		args.Identifier = dataRetriever.PeerAccountsUnit.String()
+		srs, err := NewSnapshotRepairSource(args)
+		assert.True(t, errors.Is(err, ErrTrieNotFoundInSnapshot))
+		assert.Nil(t, srs)
+	})
+	t.Run("should rebuild the tries from the snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		testTries := createAccountsTestTries(t)
+		srs, err := NewSnapshotRepairSource(createMockArgsSnapshotRepairSource(writeTestSnapshot(t, testTries)))
+		require.Nil(t, err)
+		assert.False(t, srs.IsInterfaceNil())
+
+		_, err = srs.Get(testTries.mainRootHash)
+		assert.Nil(t, err)
+		_, err = srs.Get(testTries.dataRootHash)
+		assert.Nil(t, err)
+
+		temporaryDirectory := srs.temporaryDirectory
+		require.Nil(t, srs.Close())
+		_, err = os.Stat(temporaryDirectory)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestSnapshotRepairSource_RepairWithChecker(t *testing.T) {
+	t.Parallel()
+
+	testTries := createAccountsTestTries(t)
+	srs, err := NewSnapshotRepairSource(createMockArgsSnapshotRepairSource(writeTestSnapshot(t, testTries)))
+	require.Nil(t, err)
+	defer func() {
+		_ = srs.Close()
+	}()
+
+	require.Nil(t, testTries.db.Remove(testTries.mainRootHash))
+	require.Nil(t, testTries.db.Remove(testTries.dataRootHash))
+
+	args := createMockArgsChecker(testTries.db)
+	args.RepairSource = srs
+	c, _ := NewChecker(args)
+	report, err := c.Check(testTries.mainRootHash)
+	require.Nil(t, err)
+
+	assert.True(t, report.IsHealthy())
+	assert.Equal(t, uint64(2), report.NumRepairedNodes)
+	assert.Equal(t, testTries.numLeaves, report.NumLeaves)
+}
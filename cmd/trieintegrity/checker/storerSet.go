@@ -0,0 +1,187 @@
+package checker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("trieintegrity")
+
+// ArgsStorerSet holds the arguments needed to open all the epoch storers of a trie
+type ArgsStorerSet struct {
+	// DBPath is the node's database directory holding the Epoch_N directories, for example db/<chain ID>
+	DBPath string
+	// ShardID is the shard directory suffix, for example 0 or metachain
+	ShardID string
+	// DBConfig is used for the storers that do not have a config.toml file in their directory
+	DBConfig config.DBConfig
+	// AllowWrites has to be set in order to write the repaired nodes. Otherwise, the storers are opened as read-only
+	AllowWrites bool
+}
+
+type epochPersister struct {
+	epoch     uint32
+	path      string
+	persister storage.Persister
+}
+
+// storerSet reads the trie nodes from all the epoch storers of a trie, newest epoch first, in the same way the
+// trie storage manager searches the nodes in the old epochs
+type storerSet struct {
+	persisters  []*epochPersister
+	allowWrites bool
+}
+
+// NewStorerSet opens the trie storer of each epoch found in the db directory
+func NewStorerSet(args ArgsStorerSet) (*storerSet, error) {
+	epochs, err := getEpochs(args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &storerSet{
+		persisters:  make([]*epochPersister, 0, len(epochs)),
+		allowWrites: args.AllowWrites,
+	}
+	shardDirectory := fmt.Sprintf("%s_%s", storage.DefaultShardString, args.ShardID)
+	for _, epoch := range epochs {
+		epochDirectory := fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch)
+		path := filepath.Join(args.DBPath, epochDirectory, shardDirectory, args.DBConfig.FilePath)
+		if !directoryExists(path) {
+			continue
+		}
+
+		persister, errCreate := createEpochPersister(args.DBConfig, path, args.AllowWrites)
+		if errCreate != nil {
+			_ = ss.Close()
+			return nil, fmt.Errorf("%w while opening the storer %s", errCreate, path)
+		}
+
+		log.Debug("opened trie storer", "epoch", epoch, "path", path)
+		ss.persisters = append(ss.persisters, &epochPersister{
+			epoch:     epoch,
+			path:      path,
+			persister: persister,
+		})
+	}
+
+	if len(ss.persisters) == 0 {
+		return nil, fmt.Errorf("%w in %s for shard %s and file path %s",
+			ErrNoTrieStorerFound, args.DBPath, args.ShardID, args.DBConfig.FilePath)
+	}
+
+	return ss, nil
+}
+
+func getEpochs(dbPath string) ([]uint32, error) {
+	entries, err := os.ReadDir(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochPrefix := storage.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] > epochs[j]
+	})
+
+	return epochs, nil
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func createEpochPersister(dbConfig config.DBConfig, path string, allowWrites bool) (storage.Persister, error) {
+	if allowWrites {
+		return createPersister(dbConfig, path)
+	}
+
+	return createReadOnlyPersister(dbConfig, path)
+}
+
+func createPersister(dbConfig config.DBConfig, path string) (storage.Persister, error) {
+	persisterFactory, err := factory.NewPersisterFactory(factory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+// Get returns the value from the newest epoch storer that holds the key
+func (ss *storerSet) Get(key []byte) ([]byte, error) {
+	for _, ep := range ss.persisters {
+		value, err := ep.persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put writes the value in the newest epoch storer, if the storer set was opened with writes allowed
+func (ss *storerSet) Put(key []byte, value []byte) error {
+	if !ss.allowWrites {
+		return ErrReadOnlyStorer
+	}
+
+	return ss.persisters[0].persister.Put(key, value)
+}
+
+// Remove is not supported, the trie nodes are never removed by this tool
+func (ss *storerSet) Remove(_ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// LatestEpoch returns the epoch of the storer in which the repaired nodes are written
+func (ss *storerSet) LatestEpoch() uint32 {
+	return ss.persisters[0].epoch
+}
+
+// NumStorers returns the number of opened epoch storers
+func (ss *storerSet) NumStorers() int {
+	return len(ss.persisters)
+}
+
+// Close closes all the opened epoch storers
+func (ss *storerSet) Close() error {
+	var lastError error
+	for _, ep := range ss.persisters {
+		err := ep.persister.Close()
+		if err != nil {
+			log.Warn("cannot close storer", "path", ep.path, "error", err)
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ss *storerSet) IsInterfaceNil() bool {
+	return ss == nil
+}
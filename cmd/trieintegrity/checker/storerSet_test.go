@@ -0,0 +1,164 @@
+package checker
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDBConfig() config.DBConfig {
+	return config.DBConfig{
+		FilePath:          "AccountsTrie",
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func writeEpochStorer(t *testing.T, dbPath string, epochDirectory string, pairs map[string]string) {
+	path := filepath.Join(dbPath, epochDirectory, "Shard_0", "AccountsTrie")
+	persister, err := createPersister(createTestDBConfig(), path)
+	require.Nil(t, err)
+	for key, value := range pairs {
+		require.Nil(t, persister.Put([]byte(key), []byte(value)))
+	}
+	require.Nil(t, persister.Close())
+}
+
+func createTestDBPath(t *testing.T) string {
+	dbPath := t.TempDir()
+	writeEpochStorer(t, dbPath, "Epoch_2", map[string]string{"key": "new value", "key2": "value2"})
+	writeEpochStorer(t, dbPath, "Epoch_10", map[string]string{"key": "newest value"})
+	writeEpochStorer(t, dbPath, "Epoch_1", map[string]string{"key1": "value1"})
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_3", "Shard_1", "AccountsTrie"), 0700))
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Static", "Shard_0"), 0700))
+
+	return dbPath
+}
+
+func TestNewStorerSet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing db directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewStorerSet(ArgsStorerSet{
+			DBPath:   filepath.Join(t.TempDir(), "missing"),
+			ShardID:  "0",
+			DBConfig: createTestDBConfig(),
+		})
+		assert.NotNil(t, err)
+		assert.Nil(t, ss)
+	})
+	t.Run("no trie storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewStorerSet(ArgsStorerSet{
+			DBPath:   createTestDBPath(t),
+			ShardID:  "metachain",
+			DBConfig: createTestDBConfig(),
+		})
+		assert.True(t, errors.Is(err, ErrNoTrieStorerFound))
+		assert.Nil(t, ss)
+	})
+	t.Run("should open the storers of all the epochs", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewStorerSet(ArgsStorerSet{
+			DBPath:   createTestDBPath(t),
+			ShardID:  "0",
+			DBConfig: createTestDBConfig(),
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = ss.Close()
+		}()
+
+		assert.False(t, ss.IsInterfaceNil())
+		assert.Equal(t, 3, ss.NumStorers())
+		assert.Equal(t, uint32(10), ss.LatestEpoch())
+	})
+}
+
+func TestStorerSet_Get(t *testing.T) {
+	t.Parallel()
+
+	ss, err := NewStorerSet(ArgsStorerSet{
+		DBPath:   createTestDBPath(t),
+		ShardID:  "0",
+		DBConfig: createTestDBConfig(),
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = ss.Close()
+	}()
+
+	value, err := ss.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("newest value"), value)
+
+	value, err = ss.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	value, err = ss.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	value, err = ss.Get([]byte("missing key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Nil(t, value)
+}
+
+func TestStorerSet_Put(t *testing.T) {
+	t.Parallel()
+
+	t.Run("read-only storer set should error", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewStorerSet(ArgsStorerSet{
+			DBPath:   createTestDBPath(t),
+			ShardID:  "0",
+			DBConfig: createTestDBConfig(),
+		})
+		require.Nil(t, err)
+		defer func() {
+			_ = ss.Close()
+		}()
+
+		assert.Equal(t, ErrReadOnlyStorer, ss.Put([]byte("key3"), []byte("value3")))
+		assert.Equal(t, ErrReadOnlyStorer, ss.Remove([]byte("key")))
+	})
+	t.Run("should write in the latest epoch storer", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestDBPath(t)
+		ss, err := NewStorerSet(ArgsStorerSet{
+			DBPath:      dbPath,
+			ShardID:     "0",
+			DBConfig:    createTestDBConfig(),
+			AllowWrites: true,
+		})
+		require.Nil(t, err)
+		require.Nil(t, ss.Put([]byte("key3"), []byte("value3")))
+		require.Nil(t, ss.Close())
+
+		persister, err := createPersister(createTestDBConfig(), filepath.Join(dbPath, "Epoch_10", "Shard_0", "AccountsTrie"))
+		require.Nil(t, err)
+		defer func() {
+			_ = persister.Close()
+		}()
+
+		value, err := persister.Get([]byte("key3"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value3"), value)
+	})
+}
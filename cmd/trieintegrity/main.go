@@ -0,0 +1,362 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/display"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	marshalizerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/trieintegrity/checker"
+	"github.com/multiversx/mx-chain-go/common"
+	commonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	reportFilePermissions = 0644
+	userTrie              = "user"
+	peerTrie              = "peer"
+	maxDisplayedIssues    = 100
+)
+
+type cfg struct {
+	dbPath             string
+	shardID            string
+	trieType           string
+	rootHash           string
+	configFile         string
+	skipDataTries      bool
+	repairFromDB       string
+	repairFromSnapshot string
+	outputFile         string
+}
+
+var (
+	fileGenHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines the flag for the node's database directory
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The node's database directory holding the Epoch_N directories, for example ./db/1",
+		Destination: &argsConfig.dbPath,
+	}
+	// shardID defines the flag for the shard of the checked storers
+	shardID = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard of the checked storers: 0, 1, 2 or metachain",
+		Value:       "0",
+		Destination: &argsConfig.shardID,
+	}
+	// trieType defines the flag for the checked trie
+	trieType = cli.StringFlag{
+		Name:        "trie",
+		Usage:       "The checked trie: user (the accounts trie together with the data tries) or peer (the validators trie)",
+		Value:       userTrie,
+		Destination: &argsConfig.trieType,
+	}
+	// rootHash defines the flag for the root hash the trie is checked from
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash the trie is walked from, for example the root hash of the last committed block",
+		Destination: &argsConfig.rootHash,
+	}
+	// configFile defines the flag for the node's config file
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The node's config.toml file, used for the storers, the marshaller, the hasher and the address format",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// skipDataTries is the flag that, if active, will check only the main trie
+	skipDataTries = cli.BoolFlag{
+		Name:        "skip-data-tries",
+		Usage:       "Boolean option that will check only the main trie, without the data tries of the accounts",
+		Destination: &argsConfig.skipDataTries,
+	}
+	// repairFromDB defines the flag for the database directory the missing nodes are fetched from
+	repairFromDB = cli.StringFlag{
+		Name: "repair-from-db",
+		Usage: "If provided, the missing or corrupt nodes are fetched from this database directory, for example " +
+			"a copy of another node's ./db/1 directory, and written in the latest epoch storer of the checked node",
+		Destination: &argsConfig.repairFromDB,
+	}
+	// repairFromSnapshot defines the flag for the state snapshot file the missing nodes are rebuilt from
+	repairFromSnapshot = cli.StringFlag{
+		Name: "repair-from-snapshot",
+		Usage: "If provided, the trie is rebuilt from this state snapshot file, created with the node snapshot " +
+			"command, and the missing or corrupt nodes are written in the latest epoch storer of the checked node",
+		Destination: &argsConfig.repairFromSnapshot,
+	}
+	// outputFile defines the flag for the JSON report file
+	outputFile = cli.StringFlag{
+		Name:        "output",
+		Usage:       "If provided, the full report will be also written, in JSON format, in this file",
+		Destination: &argsConfig.outputFile,
+	}
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("trieintegrity")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = fileGenHelpTemplate
+	app.Name = "Trie integrity Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary will check, on a stopped node, that all the trie nodes reachable from a root hash are " +
+		"present in the storers and match their hash, and can repair the faulty ones"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		shardID,
+		trieType,
+		rootHash,
+		configFile,
+		skipDataTries,
+		repairFromDB,
+		repairFromSnapshot,
+		outputFile,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error checking the trie", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	if len(argsConfig.dbPath) == 0 || len(argsConfig.rootHash) == 0 {
+		return cli.NewExitError("both the db-path and the root-hash flags should be provided", 1)
+	}
+	if len(argsConfig.repairFromDB) > 0 && len(argsConfig.repairFromSnapshot) > 0 {
+		return cli.NewExitError("only one of the repair-from-db and repair-from-snapshot flags can be provided", 1)
+	}
+	checkedRootHash, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	mainConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+	dbConfig, identifier, err := getTrieStorageConfig(mainConfig)
+	if err != nil {
+		return err
+	}
+
+	marshaller, err := marshalizerFactory.NewMarshalizer(mainConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(mainConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	addressConverter, err := commonFactory.NewPubkeyConverter(mainConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	isRepairEnabled := len(argsConfig.repairFromDB) > 0 || len(argsConfig.repairFromSnapshot) > 0
+	storer, err := checker.NewStorerSet(checker.ArgsStorerSet{
+		DBPath:      argsConfig.dbPath,
+		ShardID:     argsConfig.shardID,
+		DBConfig:    dbConfig,
+		AllowWrites: isRepairEnabled,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	argsChecker := checker.ArgsChecker{
+		Storer:           storer,
+		Marshaller:       marshaller,
+		Hasher:           hasher,
+		AddressConverter: addressConverter,
+		CheckDataTries:   identifier == dataRetriever.UserAccountsUnit.String() && !argsConfig.skipDataTries,
+	}
+	if isRepairEnabled {
+		repairSource, errCreate := createRepairSource(dbConfig, identifier, mainConfig, marshaller, hasher)
+		if errCreate != nil {
+			return errCreate
+		}
+		defer func() {
+			_ = repairSource.Close()
+		}()
+
+		argsChecker.RepairSource = repairSource
+		log.Info("the repaired nodes will be written in the latest epoch storer", "epoch", storer.LatestEpoch())
+	}
+
+	trieChecker, err := checker.NewChecker(argsChecker)
+	if err != nil {
+		return err
+	}
+
+	log.Info("checking the trie", "trie", identifier, "root hash", checkedRootHash, "num epoch storers", storer.NumStorers())
+	report, err := trieChecker.Check(checkedRootHash)
+	if err != nil {
+		return err
+	}
+
+	displayReport(report)
+
+	if len(argsConfig.outputFile) > 0 {
+		err = writeReport(report)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !report.IsHealthy() {
+		return cli.NewExitError("the trie has missing or corrupt nodes", 2)
+	}
+
+	return nil
+}
+
+func getTrieStorageConfig(mainConfig *config.Config) (config.DBConfig, string, error) {
+	switch argsConfig.trieType {
+	case userTrie:
+		return mainConfig.AccountsTrieStorage.DB, dataRetriever.UserAccountsUnit.String(), nil
+	case peerTrie:
+		return mainConfig.PeerAccountsTrieStorage.DB, dataRetriever.PeerAccountsUnit.String(), nil
+	default:
+		return config.DBConfig{}, "", fmt.Errorf("invalid trie type %s, it should be %s or %s", argsConfig.trieType, userTrie, peerTrie)
+	}
+}
+
+func createRepairSource(
+	dbConfig config.DBConfig,
+	identifier string,
+	mainConfig *config.Config,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (common.BaseStorer, error) {
+	if len(argsConfig.repairFromDB) > 0 {
+		return checker.NewStorerSet(checker.ArgsStorerSet{
+			DBPath:   argsConfig.repairFromDB,
+			ShardID:  argsConfig.shardID,
+			DBConfig: dbConfig,
+		})
+	}
+
+	return checker.NewSnapshotRepairSource(checker.ArgsSnapshotRepairSource{
+		SnapshotFile:         argsConfig.repairFromSnapshot,
+		Identifier:           identifier,
+		TemporaryDBConfig:    dbConfig,
+		Marshaller:           marshaller,
+		Hasher:               hasher,
+		MaxTrieLevelInMemory: mainConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	})
+}
+
+func displayReport(report *checker.Report) {
+	log.Info("trie checked",
+		"root hash", report.RootHash,
+		"num data tries", report.NumDataTries,
+		"num nodes", report.NumNodes,
+		"num leaves", report.NumLeaves,
+		"num issues", len(report.Issues),
+		"num repaired nodes", report.NumRepairedNodes,
+	)
+	if len(report.Issues) == 0 {
+		return
+	}
+
+	header := []string{"Trie", "Owner", "Node hash", "Path", "Status", "Error"}
+	lines := make([]*display.LineData, 0, maxDisplayedIssues)
+	for i, issue := range report.Issues {
+		if i == maxDisplayedIssues {
+			break
+		}
+
+		lines = append(lines, display.NewLineData(false, []string{
+			issue.Trie,
+			issue.Owner,
+			issue.NodeHash,
+			issue.Path,
+			getIssueStatus(issue),
+			issue.Error,
+		}))
+	}
+
+	tab, err := display.CreateTableString(header, lines)
+	if err != nil {
+		log.Error("error creating the report table", "error", err)
+		return
+	}
+
+	message := "missing or corrupt trie nodes"
+	if len(report.Issues) > maxDisplayedIssues {
+		message = fmt.Sprintf("first %d out of %d missing or corrupt trie nodes, use the output flag for the full list",
+			maxDisplayedIssues, len(report.Issues))
+	}
+	log.Warn(message + "\n" + tab)
+}
+
+func getIssueStatus(issue *checker.Issue) string {
+	status := "corrupt"
+	if issue.Missing {
+		status = "missing"
+	}
+	if issue.Repaired {
+		status += ", repaired"
+	}
+
+	return status
+}
+
+func writeReport(report *checker.Report) error {
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(argsConfig.outputFile, buff, reportFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Info("report written", "file", argsConfig.outputFile, "size", core.ConvertBytes(uint64(len(buff))))
+
+	return nil
+}
@@ -84,6 +84,9 @@ const ConnectionTopic = "connection"
 // ValidatorInfoTopic is the topic used for validatorInfo signaling
 const ValidatorInfoTopic = "validatorInfo"
 
+// RedundancyLeaseTopic is the topic used by the machines sharing the same validator key to exchange the redundancy lease heartbeats
+const RedundancyLeaseTopic = "redundancyLease"
+
 // MetricCurrentRound is the metric for monitoring the current round of a node
 const MetricCurrentRound = "erd_current_round"
 
@@ -310,6 +313,18 @@ const MetricRedundancyIsMainActive = "erd_redundancy_is_main_active"
 // MetricRedundancyStepInReason is the metric that specifies why the back-up machine stepped in
 const MetricRedundancyStepInReason = "erd_redundancy_step_in_reason"
 
+// MetricRedundancyLeaseState is the metric that specifies the state of the current node in the redundancy leader lease protocol
+const MetricRedundancyLeaseState = "erd_redundancy_lease_state"
+
+// MetricRedundancyLeaseEpoch is the metric that specifies the highest redundancy lease epoch known by the current node
+const MetricRedundancyLeaseEpoch = "erd_redundancy_lease_epoch"
+
+// MetricRedundancyLeaseTransitions is the metric that specifies the number of redundancy lease state transitions of the current node
+const MetricRedundancyLeaseTransitions = "erd_redundancy_lease_transitions"
+
+// MetricRedundancyLeaseLastTransition is the metric that specifies the last redundancy lease state transition and its reason
+const MetricRedundancyLeaseLastTransition = "erd_redundancy_lease_last_transition"
+
 // MetricValueNA represents the value to be used when a metric is not available/applicable
 const MetricValueNA = "N/A"
 
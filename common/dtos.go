@@ -2,6 +2,7 @@ package common
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -18,6 +19,32 @@ type TransactionsPoolAPIResponse struct {
 	Rewards              []Transaction `json:"rewards"`
 }
 
+// TransactionsPoolFilter holds the criteria used to select the transactions from pool. The addresses are bech32 encoded,
+// the data prefix is compared against the raw data field. An empty criterion matches everything
+type TransactionsPoolFilter struct {
+	Sender     string
+	Receiver   string
+	DataPrefix string
+}
+
+// IsEmpty returns true if the filter has no criterion set
+func (filter TransactionsPoolFilter) IsEmpty() bool {
+	return len(filter.Sender) == 0 && len(filter.Receiver) == 0 && len(filter.DataPrefix) == 0
+}
+
+// TransactionsPoolChangeNotification is the structure pushed to the transactions pool changes subscribers
+type TransactionsPoolChangeNotification struct {
+	Type            string `json:"type"`
+	Hash            string `json:"hash"`
+	Sender          string `json:"sender"`
+	Receiver        string `json:"receiver"`
+	Nonce           uint64 `json:"nonce"`
+	GasPrice        uint64 `json:"gasPrice"`
+	Data            []byte `json:"data,omitempty"`
+	SenderShardID   uint32 `json:"senderShardId"`
+	ReceiverShardID uint32 `json:"receiverShardId"`
+}
+
 // Transaction is a struct that holds transaction fields to be returned when getting the transactions from pool
 type Transaction struct {
 	TxFields map[string]interface{} `json:"txFields"`
@@ -36,12 +63,38 @@ type NonceGapApiResponse struct {
 	To   uint64 `json:"to"`
 }
 
+// TransactionsPoolPolicyCountersApiResponse is a struct that holds, for each rule of the transactions pool policy, the
+// number of rejected or evicted transactions, to be returned on API calls
+type TransactionsPoolPolicyCountersApiResponse struct {
+	Counters map[string]uint64 `json:"counters"`
+}
+
 // TransactionsPoolNonceGapsForSenderApiResponse is a struct that holds the data to be returned when getting the nonce gaps from transactions pool for a sender from an API call
 type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Sender string                `json:"sender"`
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// AddressTransactionsAPIResponse is a struct that holds the data to be returned when getting the indexed transactions of an address from an API call
+type AddressTransactionsAPIResponse struct {
+	Address      string                          `json:"address"`
+	Total        uint64                          `json:"total"`
+	Transactions []AddressTransactionAPIResponse `json:"transactions"`
+}
+
+// AddressTransactionAPIResponse is a struct that holds the coordinates of a transaction in which an address was involved
+type AddressTransactionAPIResponse struct {
+	Hash          string `json:"hash"`
+	MiniBlockType string `json:"miniblockType"`
+	IsSender      bool   `json:"isSender"`
+	IsReceiver    bool   `json:"isReceiver"`
+	Epoch         uint32 `json:"epoch"`
+	Round         uint64 `json:"round"`
+	BlockNonce    uint64 `json:"blockNonce"`
+	BlockHash     string `json:"blockHash"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -75,3 +128,236 @@ type EpochStartDataAPI struct {
 type AlteredAccountsForBlockAPIResponse struct {
 	Accounts []*alteredAccount.AlteredAccount `json:"accounts"`
 }
+
+// EventsSubscriptionFilter holds the criteria used to select the events pushed to an API subscriber.
+// An empty criterion matches everything, a non-empty one matches if any of its values matches
+type EventsSubscriptionFilter struct {
+	Addresses   []string
+	Identifiers []string
+	Topics      []string
+	Tokens      []string
+}
+
+// EventsNotification is the structure pushed to the API events subscribers
+type EventsNotification struct {
+	Type    string                   `json:"type"`
+	Hash    string                   `json:"hash"`
+	ShardID uint32                   `json:"shardId"`
+	Events  []*EventNotificationData `json:"events,omitempty"`
+}
+
+// EventNotificationData holds a log event pushed to the API events subscribers
+type EventNotificationData struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// SCQueryResultApi holds the API representation of the outcome of one query executed in a multi-query
+type SCQueryResultApi struct {
+	Data  *vm.VMOutputApi `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// MultiProofAccountRequest holds an account and the data trie keys to be proven for it. The ESDT tokens are
+// converted to the keys holding the account's balances
+type MultiProofAccountRequest struct {
+	Address string   `json:"address"`
+	Keys    []string `json:"keys"`
+	Tokens  []string `json:"tokens"`
+}
+
+// MultiProofRequest holds the accounts to be proven against the state committed by the block with the given hash.
+// An empty block hash means the current block
+type MultiProofRequest struct {
+	BlockHash string                     `json:"blockHash"`
+	Accounts  []MultiProofAccountRequest `json:"accounts"`
+}
+
+// VerifyMultiProofRequest holds a multi-proof to be verified against the given root hash
+type VerifyMultiProofRequest struct {
+	RootHash string
+	Nodes    [][]byte
+	Accounts []MultiProofAccountRequest
+}
+
+// MultiProofResponse holds the deduplicated set of trie nodes proving all the requested accounts and data trie keys,
+// together with the block header committing to the root hash
+type MultiProofResponse struct {
+	HeaderHash []byte
+	Header     []byte
+	RootHash   []byte
+	Nodes      [][]byte
+	Accounts   []*MultiProofAccountResponse
+}
+
+// MultiProofAccountResponse holds the proven value of an account and of its data trie keys
+type MultiProofAccountResponse struct {
+	Address          string
+	Value            []byte
+	DataTrieRootHash []byte
+	Keys             []*MultiProofKeyResponse
+}
+
+// MultiProofKeyResponse holds the proven value of a data trie key
+type MultiProofKeyResponse struct {
+	Key   []byte
+	Value []byte
+}
+
+// StateDiffRequest holds the blocks whose states are compared and the requested page of changed accounts.
+// An empty new block hash means the current block, and an empty cursor means the first page. The cursor is the
+// hex encoded trie key of the last account of the previous page
+type StateDiffRequest struct {
+	OldBlockHash      string
+	NewBlockHash      string
+	Cursor            string
+	Size              uint64
+	WithDataTries     bool
+	MaxKeysPerAccount uint64
+}
+
+// StateDiffAPIResponse holds a page of the accounts that differ between the states committed by two blocks.
+// NextCursor is set only if there are more accounts and should be provided to get the next page
+type StateDiffAPIResponse struct {
+	OldBlockHash string                         `json:"oldBlockHash"`
+	OldRootHash  string                         `json:"oldRootHash"`
+	NewBlockHash string                         `json:"newBlockHash"`
+	NewRootHash  string                         `json:"newRootHash"`
+	Accounts     []*StateDiffAccountAPIResponse `json:"accounts"`
+	HasMore      bool                           `json:"hasMore"`
+	NextCursor   string                         `json:"nextCursor,omitempty"`
+}
+
+// StateDiffAccountAPIResponse holds an account that was added, removed or modified, with its old and new versions.
+// HasMoreKeys is set if the data trie keys were truncated to the maximum number of keys per account
+type StateDiffAccountAPIResponse struct {
+	Address     string                            `json:"address"`
+	Status      string                            `json:"status"`
+	Old         *StateDiffAccountStateAPIResponse `json:"old,omitempty"`
+	New         *StateDiffAccountStateAPIResponse `json:"new,omitempty"`
+	Keys        []*StateDiffKeyAPIResponse        `json:"keys,omitempty"`
+	HasMoreKeys bool                              `json:"hasMoreKeys,omitempty"`
+}
+
+// StateDiffAccountStateAPIResponse holds one version of an account from a state diff
+type StateDiffAccountStateAPIResponse struct {
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	DeveloperReward string `json:"developerReward"`
+	CodeHash        string `json:"codeHash"`
+	RootHash        string `json:"rootHash"`
+	CodeMetadata    string `json:"codeMetadata"`
+	OwnerAddress    string `json:"ownerAddress"`
+	Username        string `json:"username"`
+}
+
+// StateDiffKeyAPIResponse holds a data trie key that was added, removed or modified. The key and values are hex encoded
+type StateDiffKeyAPIResponse struct {
+	Key      string `json:"key"`
+	Status   string `json:"status"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
+
+// StorageReportRequest holds the root hash of the accounts trie to be reported and the length of the top lists.
+// An empty root hash means the root hash of the current block
+type StorageReportRequest struct {
+	RootHash string
+	NumTop   int
+}
+
+// StorageReport holds the storage footprint of an accounts trie and of the data tries and code of its accounts.
+// All the sizes are in bytes, the trie sizes being the sum of the serialized trie nodes
+type StorageReport struct {
+	RootHash            string                   `json:"rootHash"`
+	NumAccounts         uint64                   `json:"numAccounts"`
+	NumContracts        uint64                   `json:"numContracts"`
+	NumDataTries        uint64                   `json:"numDataTries"`
+	MainTrieSize        uint64                   `json:"mainTrieSize"`
+	MainTrieNumNodes    uint64                   `json:"mainTrieNumNodes"`
+	MainTrieMaxDepth    uint32                   `json:"mainTrieMaxDepth"`
+	DataTriesSize       uint64                   `json:"dataTriesSize"`
+	DataTriesNumNodes   uint64                   `json:"dataTriesNumNodes"`
+	CodeSize            uint64                   `json:"codeSize"`
+	NumCodes            uint64                   `json:"numCodes"`
+	TopDataTriesBySize  []*DataTrieStorageReport `json:"topDataTriesBySize"`
+	TopDataTriesByDepth []*DataTrieStorageReport `json:"topDataTriesByDepth"`
+	TopContracts        []*ContractStorageReport `json:"topContracts"`
+	CodeSizeHistogram   []*CodeSizeBucket        `json:"codeSizeHistogram"`
+	TopTokens           []*TokenStorageReport    `json:"topTokens"`
+}
+
+// DataTrieStorageReport holds the size and the depth of the data trie of an account
+type DataTrieStorageReport struct {
+	Address   string `json:"address"`
+	RootHash  string `json:"rootHash"`
+	Size      uint64 `json:"size"`
+	NumNodes  uint64 `json:"numNodes"`
+	NumLeaves uint64 `json:"numLeaves"`
+	MaxDepth  uint32 `json:"maxDepth"`
+}
+
+// ContractStorageReport holds the storage used by a smart contract. StorageSize is the sum of the code size and of
+// the data trie size, while KeysSize is the sum of the sizes of the keys and values saved by the contract
+type ContractStorageReport struct {
+	Address      string `json:"address"`
+	StorageSize  uint64 `json:"storageSize"`
+	CodeSize     uint64 `json:"codeSize"`
+	DataTrieSize uint64 `json:"dataTrieSize"`
+	NumKeys      uint64 `json:"numKeys"`
+	KeysSize     uint64 `json:"keysSize"`
+}
+
+// CodeSizeBucket holds the number of contracts with the code size in [MinSize, MaxSize). The last bucket has no upper bound
+type CodeSizeBucket struct {
+	MinSize      uint64 `json:"minSize"`
+	MaxSize      uint64 `json:"maxSize,omitempty"`
+	NumContracts uint64 `json:"numContracts"`
+}
+
+// TokenStorageReport holds the number of data trie keys saved for a token, or for all the nonces of a collection,
+// in all the accounts
+type TokenStorageReport struct {
+	Token       string `json:"token"`
+	NumKeys     uint64 `json:"numKeys"`
+	NumAccounts uint64 `json:"numAccounts"`
+	KeysSize    uint64 `json:"keysSize"`
+}
+
+// ConfigChange holds a hot-reloaded config value
+type ConfigChange struct {
+	Path     string      `json:"path"`
+	OldValue interface{} `json:"oldValue"`
+	NewValue interface{} `json:"newValue"`
+}
+
+// RedundancyLeaseStatus holds the state of the current node in the redundancy leader lease protocol
+type RedundancyLeaseStatus struct {
+	State           string `json:"state"`
+	LeaseEpoch      uint64 `json:"leaseEpoch"`
+	RedundancyLevel int64  `json:"redundancyLevel"`
+	LastHolder      string `json:"lastHolder"`
+}
+
+// PeerReputation holds the persisted reputation of a peer ID, an IP address or a validator public key
+type PeerReputation struct {
+	Type            string            `json:"type"`
+	Key             string            `json:"key"`
+	Score           float64           `json:"score"`
+	ScoreHistory    []PeerScoreChange `json:"scoreHistory"`
+	IsBanned        bool              `json:"isBanned"`
+	IsManualBan     bool              `json:"isManualBan"`
+	BanExpiry       int64             `json:"banExpiry"`
+	BlacklistReason string            `json:"blacklistReason"`
+	OffendingTopic  string            `json:"offendingTopic"`
+}
+
+// PeerScoreChange holds a change of the reputation score together with the event that caused it
+type PeerScoreChange struct {
+	Timestamp int64   `json:"timestamp"`
+	Score     float64 `json:"score"`
+	Event     string  `json:"event"`
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/config"
 )
 
 // TrieIteratorChannels defines the channels that are being used when iterating the trie nodes
@@ -471,3 +472,27 @@ type ExecutionOrderGetter interface {
 	Len() int
 	IsInterfaceNil() bool
 }
+
+// EventsSubscription defines a live subscription to the events pushed by the node
+type EventsSubscription interface {
+	ID() uint64
+	Notifications() <-chan *EventsNotification
+	Done() <-chan struct{}
+	Err() error
+}
+
+// ConfigReloadHandler defines a component able to hot-reload its values from the whitelisted config fields.
+// ApplyConfig is called only after all the registered handlers validated the new configs
+type ConfigReloadHandler interface {
+	ValidateConfig(configs *config.Configs) error
+	ApplyConfig(configs *config.Configs) error
+	IsInterfaceNil() bool
+}
+
+// TransactionsPoolSubscription defines a live subscription to the changes of the transactions pool
+type TransactionsPoolSubscription interface {
+	ID() uint64
+	Notifications() <-chan *TransactionsPoolChangeNotification
+	Done() <-chan struct{}
+	Err() error
+}
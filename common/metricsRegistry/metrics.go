@@ -0,0 +1,84 @@
+package metricsRegistry
+
+const (
+	// PhaseProcessBlock is the phase label value used when processing a block
+	PhaseProcessBlock = "processBlock"
+	// PhaseCommitBlock is the phase label value used when committing a block
+	PhaseCommitBlock = "commitBlock"
+
+	// OperationSCCall is the operation label value used when executing a smart contract call
+	OperationSCCall = "call"
+	// OperationSCDeploy is the operation label value used when deploying a smart contract
+	OperationSCDeploy = "deploy"
+
+	// TrieAccounts is the trie label value used for the user accounts trie
+	TrieAccounts = "accounts"
+	// TriePeerAccounts is the trie label value used for the peer accounts trie
+	TriePeerAccounts = "peerAccounts"
+
+	// OutcomeFinished is the outcome label value of a consensus subround that reached its goal
+	OutcomeFinished = "finished"
+	// OutcomeTimedOut is the outcome label value of a consensus subround that ran out of time
+	OutcomeTimedOut = "timedOut"
+
+	// OutcomeAllowed is the outcome label value of an API request that fit in its quota
+	OutcomeAllowed = "allowed"
+	// OutcomeThrottled is the outcome label value of an API request rejected because its quota was exhausted
+	OutcomeThrottled = "throttled"
+)
+
+var (
+	// BlockProcessingDuration measures the block processor phases, labeled by phase and outcome
+	BlockProcessingDuration = newHistogramVec(
+		"erd_block_processing_duration_seconds",
+		"Duration of the block processing phases",
+		[]float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 3, 4, 5, 6, 10},
+		"phase", "outcome",
+	)
+
+	// SCExecutionDuration measures the smart contract executions in the VM, labeled by operation and return code
+	SCExecutionDuration = newHistogramVec(
+		"erd_sc_execution_duration_seconds",
+		"Duration of the smart contract executions in the VM",
+		[]float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2},
+		"operation", "returnCode",
+	)
+
+	// TrieCommitDuration measures the accounts commits, labeled by trie and outcome
+	TrieCommitDuration = newHistogramVec(
+		"erd_trie_commit_duration_seconds",
+		"Duration of the accounts trie commits",
+		[]float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		"trie", "outcome",
+	)
+
+	// ConsensusSubroundDuration measures the consensus subrounds, labeled by subround and outcome
+	ConsensusSubroundDuration = newHistogramVec(
+		"erd_consensus_subround_duration_seconds",
+		"Duration of the consensus subrounds",
+		[]float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2, 3, 4, 5, 6},
+		"subround", "outcome",
+	)
+
+	// OutportDriverCallDuration measures the outport driver calls including their retries, labeled by function and driver
+	OutportDriverCallDuration = newHistogramVec(
+		"erd_outport_driver_call_duration_seconds",
+		"Duration of the outport driver calls, including the retries",
+		[]float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		"function", "driver",
+	)
+
+	// OutportDriverCallErrors counts the failed outport driver calls, labeled by function and driver
+	OutportDriverCallErrors = newCounterVec(
+		"erd_outport_driver_call_errors_total",
+		"Number of failed outport driver calls",
+		"function", "driver",
+	)
+
+	// APIKeyRequests counts the REST API requests of the clients identified by an API key, labeled by key, route class and outcome
+	APIKeyRequests = newCounterVec(
+		"erd_api_key_requests_total",
+		"Number of REST API requests made by the clients identified by an API key",
+		"key", "routeClass", "outcome",
+	)
+)
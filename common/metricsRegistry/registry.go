@@ -0,0 +1,81 @@
+package metricsRegistry
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// OutcomeSuccess is the outcome label value of an operation that ended without error
+	OutcomeSuccess = "success"
+	// OutcomeError is the outcome label value of an operation that ended with an error
+	OutcomeError = "error"
+)
+
+var registry = prometheus.NewRegistry()
+
+func newHistogramVec(name string, help string, buckets []float64, labels ...string) *prometheus.HistogramVec {
+	histogram := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    name,
+		Help:    help,
+		Buckets: buckets,
+	}, labels)
+	registry.MustRegister(histogram)
+
+	return histogram
+}
+
+func newCounterVec(name string, help string, labels ...string) *prometheus.CounterVec {
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: name,
+		Help: help,
+	}, labels)
+	registry.MustRegister(counter)
+
+	return counter
+}
+
+// ObserveDuration records on the provided histogram the seconds elapsed since the start time
+func ObserveDuration(observer prometheus.Observer, startTime time.Time) {
+	observer.Observe(time.Since(startTime).Seconds())
+}
+
+// Outcome returns the outcome label value of an operation that ended with the provided error
+func Outcome(err error) string {
+	if err != nil {
+		return OutcomeError
+	}
+
+	return OutcomeSuccess
+}
+
+// OpenMetricsString returns all the registered histograms and counters in the OpenMetrics text format,
+// including the terminating EOF marker
+func OpenMetricsString() (string, error) {
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		return "", err
+	}
+
+	buff := bytes.Buffer{}
+	encoder := expfmt.NewEncoder(&buff, expfmt.FmtOpenMetrics)
+	for _, metricFamily := range metricFamilies {
+		err = encoder.Encode(metricFamily)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	closer, ok := encoder.(expfmt.Closer)
+	if ok {
+		err = closer.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return buff.String(), nil
+}
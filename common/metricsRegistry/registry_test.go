@@ -0,0 +1,36 @@
+package metricsRegistry
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutcome(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, OutcomeSuccess, Outcome(nil))
+	assert.Equal(t, OutcomeError, Outcome(errors.New("expected error")))
+}
+
+func TestOpenMetricsString(t *testing.T) {
+	t.Parallel()
+
+	ObserveDuration(BlockProcessingDuration.WithLabelValues(PhaseProcessBlock, OutcomeSuccess), time.Now())
+	ObserveDuration(TrieCommitDuration.WithLabelValues(TrieAccounts, OutcomeError), time.Now())
+	OutportDriverCallErrors.WithLabelValues("SaveBlock", "driver").Inc()
+
+	metrics, err := OpenMetricsString()
+	require.Nil(t, err)
+
+	assert.Contains(t, metrics, "# TYPE erd_block_processing_duration_seconds histogram\n")
+	assert.Contains(t, metrics, `erd_block_processing_duration_seconds_count{outcome="success",phase="processBlock"} 1`)
+	assert.Contains(t, metrics, `erd_trie_commit_duration_seconds_count{outcome="error",trie="accounts"} 1`)
+	assert.Contains(t, metrics, "# TYPE erd_outport_driver_call_errors counter\n")
+	assert.Contains(t, metrics, `erd_outport_driver_call_errors_total{driver="driver",function="SaveBlock"} 1`)
+	assert.True(t, strings.HasSuffix(metrics, "# EOF\n"))
+}
@@ -0,0 +1,9 @@
+package tracing
+
+import "errors"
+
+// ErrInvalidExporter signals that an invalid tracing exporter has been provided
+var ErrInvalidExporter = errors.New("invalid tracing exporter")
+
+// ErrInvalidSamplingRatio signals that an invalid tracing sampling ratio has been provided
+var ErrInvalidSamplingRatio = errors.New("invalid tracing sampling ratio")
@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// the OTLP/JSON encoding represents the trace and span IDs as hex strings, while the protobuf JSON mapping uses base64
+var otlpIDFields = map[string]struct{}{
+	"traceId":      {},
+	"spanId":       {},
+	"parentSpanId": {},
+}
+
+// otlpJSONClient is the otlptrace client writing each exported batch of spans as an OTLP/JSON TracesData object, one per
+// line. This is the file format read by the OpenTelemetry collector's otlpjsonfile receiver, so the spans can be
+// written offline and imported later by any OTLP compatible backend
+type otlpJSONClient struct {
+	mutWriter sync.Mutex
+	writer    io.Writer
+	marshaler protojson.MarshalOptions
+}
+
+func newOTLPJSONClient(writer io.Writer) *otlpJSONClient {
+	return &otlpJSONClient{
+		writer: writer,
+		marshaler: protojson.MarshalOptions{
+			UseEnumNumbers: true,
+		},
+	}
+}
+
+// Start does nothing as the writer is already opened
+func (client *otlpJSONClient) Start(_ context.Context) error {
+	return nil
+}
+
+// Stop does nothing as the writer is closed by the tracer provider
+func (client *otlpJSONClient) Stop(_ context.Context) error {
+	return nil
+}
+
+// UploadTraces writes the provided spans as a single OTLP/JSON line
+func (client *otlpJSONClient) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	line, err := client.encode(&tracepb.TracesData{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+
+	client.mutWriter.Lock()
+	defer client.mutWriter.Unlock()
+
+	_, err = client.writer.Write(append(line, '\n'))
+
+	return err
+}
+
+func (client *otlpJSONClient) encode(tracesData *tracepb.TracesData) ([]byte, error) {
+	protoJSON, err := client.marshaler.Marshal(tracesData)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(protoJSON))
+	decoder.UseNumber()
+
+	var value interface{}
+	err = decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hexEncodeIDs(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
+
+func hexEncodeIDs(value interface{}) error {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typedValue {
+			_, isIDField := otlpIDFields[key]
+			encodedID, isString := field.(string)
+			if isIDField && isString {
+				id, err := base64.StdEncoding.DecodeString(encodedID)
+				if err != nil {
+					return err
+				}
+
+				typedValue[key] = hex.EncodeToString(id)
+				continue
+			}
+
+			err := hexEncodeIDs(field)
+			if err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, element := range typedValue {
+			err := hexEncodeIDs(element)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxTracedTxs bounds the number of transactions whose last span context is kept in order to link their next spans
+const maxTracedTxs = 100000
+
+// txSpanContexts keeps the span context of the last span of each traced transaction. The processing steps of a
+// transaction, e.g. the API send, the interception and the preprocessing, do not share a call stack, as they are
+// separated by the p2p broadcast and by the block creation, so each step links its span to the previous one
+type txSpanContexts struct {
+	mut      sync.Mutex
+	contexts map[string]trace.SpanContext
+	order    []string
+	next     int
+}
+
+func newTxSpanContexts(capacity int) *txSpanContexts {
+	return &txSpanContexts{
+		contexts: make(map[string]trace.SpanContext, capacity),
+		order:    make([]string, capacity),
+	}
+}
+
+func (tsc *txSpanContexts) put(txHash []byte, spanContext trace.SpanContext) {
+	tsc.mut.Lock()
+	defer tsc.mut.Unlock()
+
+	_, exists := tsc.contexts[string(txHash)]
+	if !exists {
+		delete(tsc.contexts, tsc.order[tsc.next])
+		tsc.order[tsc.next] = string(txHash)
+		tsc.next = (tsc.next + 1) % len(tsc.order)
+	}
+
+	tsc.contexts[string(txHash)] = spanContext
+}
+
+func (tsc *txSpanContexts) get(txHash []byte) (trace.SpanContext, bool) {
+	tsc.mut.Lock()
+	defer tsc.mut.Unlock()
+
+	spanContext, found := tsc.contexts[string(txHash)]
+
+	return spanContext, found
+}
+
+var txSpans = newTxSpanContexts(maxTracedTxs)
+
+// blockSpan holds the span context of the block being created, processed or committed. The preprocessors and the
+// accounts adapters are not called with the block context, so their spans use it as parent
+var blockSpan = struct {
+	sync.RWMutex
+	spanContext trace.SpanContext
+}{}
+
+// TraceTx sets the transaction hash on the span held by the provided context and records the span as the last step of
+// the transaction, so the next spans of the transaction will be linked to it
+func TraceTx(ctx context.Context, txHash []byte) {
+	SetAttributes(ctx, TxHash(txHash))
+	RecordTxSpan(ctx, txHash)
+}
+
+// RecordTxSpan records the span held by the provided context as the last step of the transaction, e.g. for a span
+// handling more transactions, so the next spans of the transaction will be linked to it
+func RecordTxSpan(ctx context.Context, txHash []byte) {
+	recordTxSpan(txHash, trace.SpanFromContext(ctx))
+}
+
+// StartTxSpan starts a new span of a transaction processing step, as a child of the span held by the provided context,
+// if any, and linked to the span of the previous step of the same transaction, if any
+func StartTxSpan(ctx context.Context, name string, txHash []byte, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	options := []trace.SpanStartOption{trace.WithAttributes(append(attributes, TxHash(txHash))...)}
+	previousStep, found := txSpans.get(txHash)
+	if found {
+		options = append(options, trace.WithLinks(trace.Link{SpanContext: previousStep}))
+	}
+
+	ctx, span := getTracer().Start(ctx, name, options...)
+	recordTxSpan(txHash, span)
+
+	return ctx, span
+}
+
+func recordTxSpan(txHash []byte, span trace.Span) {
+	spanContext := span.SpanContext()
+	if !spanContext.IsValid() {
+		// tracing is disabled or the span was not sampled
+		return
+	}
+
+	txSpans.put(txHash, spanContext)
+}
+
+// StartBlockSpan starts a new span of a block lifecycle step, which will be the parent of the spans started with the
+// BlockContext until it is ended with EndBlockSpan
+func StartBlockSpan(name string, attributes ...attribute.KeyValue) trace.Span {
+	_, span := StartSpan(context.Background(), name, attributes...)
+
+	blockSpan.Lock()
+	blockSpan.spanContext = span.SpanContext()
+	blockSpan.Unlock()
+
+	return span
+}
+
+// EndBlockSpan records the provided error, if any, and ends the block span
+func EndBlockSpan(span trace.Span, err error) {
+	blockSpan.Lock()
+	if blockSpan.spanContext.Equal(span.SpanContext()) {
+		blockSpan.spanContext = trace.SpanContext{}
+	}
+	blockSpan.Unlock()
+
+	EndSpan(span, err)
+}
+
+// BlockContext returns a context holding the span of the block being created, processed or committed, if any
+func BlockContext() context.Context {
+	blockSpan.RLock()
+	defer blockSpan.RUnlock()
+
+	if !blockSpan.spanContext.IsValid() {
+		return context.Background()
+	}
+
+	return trace.ContextWithSpanContext(context.Background(), blockSpan.spanContext)
+}
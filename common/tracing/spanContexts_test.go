@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTxSpanContexts_ShouldKeepTheLastTransactions(t *testing.T) {
+	t.Parallel()
+
+	tsc := newTxSpanContexts(2)
+	tsc.put([]byte("tx1"), trace.SpanContext{})
+	tsc.put([]byte("tx2"), trace.SpanContext{})
+	tsc.put([]byte("tx2"), trace.SpanContext{})
+	_, found := tsc.get([]byte("tx1"))
+	assert.True(t, found)
+
+	tsc.put([]byte("tx3"), trace.SpanContext{})
+	_, found = tsc.get([]byte("tx1"))
+	assert.False(t, found)
+	_, found = tsc.get([]byte("tx2"))
+	assert.True(t, found)
+	_, found = tsc.get([]byte("tx3"))
+	assert.True(t, found)
+	assert.Equal(t, 2, len(tsc.contexts))
+}
+
+func TestStartTxSpan_ShouldLinkTheTransactionSteps(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		_ = provider.Shutdown(context.Background())
+	}()
+
+	txHash := []byte("linked tx hash")
+	apiCtx, apiSpan := StartSpan(context.Background(), "api")
+	TraceTx(apiCtx, txHash)
+	EndSpan(apiSpan, nil)
+
+	_, interceptorSpan := StartTxSpan(context.Background(), "interceptor", txHash)
+	EndSpan(interceptorSpan, nil)
+
+	blockSpan := StartBlockSpan("block")
+	_, preprocessSpan := StartTxSpan(BlockContext(), "preprocess", txHash)
+	EndSpan(preprocessSpan, nil)
+	EndBlockSpan(blockSpan, nil)
+	assert.False(t, trace.SpanContextFromContext(BlockContext()).IsValid())
+
+	spans := recorder.Ended()
+	require.Equal(t, 4, len(spans))
+	interceptor, preprocess := spans[1], spans[2]
+
+	require.Equal(t, 1, len(interceptor.Links()))
+	assert.Equal(t, apiSpan.SpanContext(), interceptor.Links()[0].SpanContext)
+	assert.False(t, interceptor.Parent().IsValid())
+
+	require.Equal(t, 1, len(preprocess.Links()))
+	assert.Equal(t, interceptorSpan.SpanContext(), preprocess.Links()[0].SpanContext)
+	assert.Equal(t, blockSpan.SpanContext().SpanID(), preprocess.Parent().SpanID())
+	assert.Contains(t, preprocess.Attributes(), TxHash(txHash))
+}
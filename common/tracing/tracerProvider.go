@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+const (
+	// ExporterFile is the exporter writing the spans in the OTLP/JSON format, one batch per line, in a file
+	ExporterFile = "file"
+	// ExporterStdout is the exporter writing the spans in the OTLP/JSON format, one batch per line, on the standard output
+	ExporterStdout = "stdout"
+
+	serviceName     = "mx-chain-go"
+	shutdownTimeout = 5 * time.Second
+	filePermissions = 0644
+	dirPermissions  = 0750
+)
+
+var log = logger.GetOrCreate("common/tracing")
+
+type tracerProvider struct {
+	provider *sdktrace.TracerProvider
+	file     *os.File
+}
+
+// NewTracerProvider creates the tracer provider described by the config and sets it as the global one.
+// If the tracing is disabled, the no-op global provider is kept and the returned instance does nothing
+func NewTracerProvider(cfg config.TracingConfig, workingDir string) (*tracerProvider, error) {
+	if !cfg.Enabled {
+		return &tracerProvider{}, nil
+	}
+	if cfg.SamplingRatio <= 0 || cfg.SamplingRatio > 1 {
+		return nil, fmt.Errorf("%w: %v, it should be in (0, 1]", ErrInvalidSamplingRatio, cfg.SamplingRatio)
+	}
+
+	tp := &tracerProvider{}
+	writer, err := tp.createWriter(cfg, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlptrace.New(context.Background(), newOTLPJSONClient(writer))
+	if err != nil {
+		tp.closeFile()
+		return nil, err
+	}
+
+	tp.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(tp.provider)
+
+	log.Debug("tracing enabled", "exporter", cfg.Exporter, "sampling ratio", cfg.SamplingRatio)
+
+	return tp, nil
+}
+
+func (tp *tracerProvider) createWriter(cfg config.TracingConfig, workingDir string) (io.Writer, error) {
+	switch cfg.Exporter {
+	case ExporterStdout:
+		return os.Stdout, nil
+	case ExporterFile:
+		path := cfg.FilePath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(workingDir, path)
+		}
+
+		err := os.MkdirAll(filepath.Dir(path), dirPermissions)
+		if err != nil {
+			return nil, err
+		}
+
+		tp.file, err = os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermissions)
+		if err != nil {
+			return nil, err
+		}
+
+		return tp.file, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExporter, cfg.Exporter)
+	}
+}
+
+// Close flushes the pending spans and releases the exporter resources
+func (tp *tracerProvider) Close() error {
+	if tp.provider == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := tp.provider.Shutdown(ctx)
+	tp.closeFile()
+
+	return err
+}
+
+func (tp *tracerProvider) closeFile() {
+	if tp.file == nil {
+		return
+	}
+
+	err := tp.file.Close()
+	log.LogIfError(err)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *tracerProvider) IsInterfaceNil() bool {
+	return tp == nil
+}
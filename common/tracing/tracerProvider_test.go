@@ -0,0 +1,122 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type otlpTracesData struct {
+	ResourceSpans []struct {
+		ScopeSpans []struct {
+			Spans []struct {
+				TraceID      string `json:"traceId"`
+				SpanID       string `json:"spanId"`
+				ParentSpanID string `json:"parentSpanId"`
+				Name         string `json:"name"`
+				Attributes   []struct {
+					Key   string `json:"key"`
+					Value struct {
+						StringValue string `json:"stringValue"`
+					} `json:"value"`
+				} `json:"attributes"`
+				Status struct {
+					Message string `json:"message"`
+					Code    int    `json:"code"`
+				} `json:"status"`
+			} `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+func createTracingConfig() config.TracingConfig {
+	return config.TracingConfig{
+		Enabled:       true,
+		Exporter:      ExporterFile,
+		FilePath:      "tracing/spans.json",
+		SamplingRatio: 1,
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Run("disabled tracing should return a provider which does nothing", func(t *testing.T) {
+		cfg := createTracingConfig()
+		cfg.Enabled = false
+		cfg.Exporter = "invalid"
+
+		tp, err := NewTracerProvider(cfg, t.TempDir())
+		require.Nil(t, err)
+		assert.False(t, check.IfNil(tp))
+		assert.Nil(t, tp.Close())
+	})
+	t.Run("invalid sampling ratio should error", func(t *testing.T) {
+		cfg := createTracingConfig()
+		cfg.SamplingRatio = 0
+
+		tp, err := NewTracerProvider(cfg, t.TempDir())
+		assert.True(t, errors.Is(err, ErrInvalidSamplingRatio))
+		assert.True(t, check.IfNil(tp))
+
+		cfg.SamplingRatio = 1.1
+		tp, err = NewTracerProvider(cfg, t.TempDir())
+		assert.True(t, errors.Is(err, ErrInvalidSamplingRatio))
+		assert.True(t, check.IfNil(tp))
+	})
+	t.Run("invalid exporter should error", func(t *testing.T) {
+		cfg := createTracingConfig()
+		cfg.Exporter = "otlp"
+
+		tp, err := NewTracerProvider(cfg, t.TempDir())
+		assert.True(t, errors.Is(err, ErrInvalidExporter))
+		assert.True(t, check.IfNil(tp))
+	})
+	t.Run("file exporter should write the spans", func(t *testing.T) {
+		workingDir := t.TempDir()
+		tp, err := NewTracerProvider(createTracingConfig(), workingDir)
+		require.Nil(t, err)
+
+		ctx, parentSpan := StartSpan(context.Background(), "parent")
+		_, childSpan := StartSpan(ctx, "child", TxHash([]byte("tx hash")))
+		EndSpan(childSpan, errors.New("expected error"))
+		EndSpan(parentSpan, nil)
+
+		require.Nil(t, tp.Close())
+
+		content, err := os.ReadFile(filepath.Join(workingDir, "tracing", "spans.json"))
+		require.Nil(t, err)
+
+		// the spans are written as OTLP/JSON, the ended spans being exported in a single batch on close
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		require.Equal(t, 1, len(lines))
+
+		tracesData := &otlpTracesData{}
+		require.Nil(t, json.Unmarshal([]byte(lines[0]), tracesData))
+		require.Equal(t, 1, len(tracesData.ResourceSpans))
+		require.Equal(t, 1, len(tracesData.ResourceSpans[0].ScopeSpans))
+		spans := tracesData.ResourceSpans[0].ScopeSpans[0].Spans
+		require.Equal(t, 2, len(spans))
+
+		assert.Equal(t, "child", spans[0].Name)
+		assert.Equal(t, childSpan.SpanContext().TraceID().String(), spans[0].TraceID)
+		assert.Equal(t, childSpan.SpanContext().SpanID().String(), spans[0].SpanID)
+		assert.Equal(t, parentSpan.SpanContext().SpanID().String(), spans[0].ParentSpanID)
+		assert.Equal(t, "tx.hash", spans[0].Attributes[0].Key)
+		assert.Equal(t, hex.EncodeToString([]byte("tx hash")), spans[0].Attributes[0].Value.StringValue)
+		assert.Equal(t, "expected error", spans[0].Status.Message)
+		assert.Equal(t, 2, spans[0].Status.Code)
+
+		assert.Equal(t, "parent", spans[1].Name)
+		assert.Equal(t, parentSpan.SpanContext().SpanID().String(), spans[1].SpanID)
+		assert.Equal(t, childSpan.SpanContext().TraceID(), parentSpan.SpanContext().TraceID())
+	})
+}
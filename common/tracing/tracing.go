@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/multiversx/mx-chain-go"
+
+const (
+	// TxHashKey is the attribute key holding the hex encoded hash of a transaction
+	TxHashKey = attribute.Key("tx.hash")
+	// BlockNonceKey is the attribute key holding the nonce of a block
+	BlockNonceKey = attribute.Key("block.nonce")
+	// BlockRoundKey is the attribute key holding the round of a block
+	BlockRoundKey = attribute.Key("block.round")
+	// ShardIDKey is the attribute key holding a shard ID
+	ShardIDKey = attribute.Key("shard.id")
+	// SenderShardIDKey is the attribute key holding the shard ID of a transaction's sender
+	SenderShardIDKey = attribute.Key("shard.sender")
+	// ReceiverShardIDKey is the attribute key holding the shard ID of a transaction's receiver
+	ReceiverShardIDKey = attribute.Key("shard.receiver")
+	// TrieKey is the attribute key holding the committed trie
+	TrieKey = attribute.Key("trie")
+	// SubroundKey is the attribute key holding the name of a consensus subround
+	SubroundKey = attribute.Key("consensus.subround")
+	// OutcomeKey is the attribute key holding the outcome of an operation
+	OutcomeKey = attribute.Key("outcome")
+	// DataTypeKey is the attribute key holding the type of an intercepted data
+	DataTypeKey = attribute.Key("data.type")
+	// TopicKey is the attribute key holding the p2p topic of an intercepted message
+	TopicKey = attribute.Key("p2p.topic")
+)
+
+// getTracer returns the tracer of the provider set by NewTracerProvider, if any, otherwise a tracer which does nothing.
+// The global tracers obtained before a provider is set remain bound to the first one, so the tracer is not cached
+func getTracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartSpan starts a new span as a child of the span held by the provided context, if any
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return getTracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan records the provided error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// SetAttributes sets the provided attributes on the span held by the provided context, if any
+func SetAttributes(ctx context.Context, attributes ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attributes...)
+}
+
+// AddEvent adds an event with the provided attributes on the span held by the provided context, if any
+func AddEvent(ctx context.Context, name string, attributes ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).AddEvent(name, trace.WithAttributes(attributes...))
+}
+
+// TxHash returns the attribute holding the provided transaction hash
+func TxHash(txHash []byte) attribute.KeyValue {
+	return TxHashKey.String(hex.EncodeToString(txHash))
+}
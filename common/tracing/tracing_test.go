@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartSpan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil context should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			r := recover()
+			assert.Nil(t, r)
+		}()
+
+		ctx, span := StartSpan(nil, "span")
+		assert.NotNil(t, ctx)
+		EndSpan(span, nil)
+	})
+	t.Run("should set the span in the returned context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, span := StartSpan(context.Background(), "span")
+		SetAttributes(ctx, TxHash([]byte("tx hash")))
+		AddEvent(ctx, "event")
+		EndSpan(span, nil)
+	})
+}
+
+func TestTxHash(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	attribute := TxHash(txHash)
+	assert.Equal(t, TxHashKey, attribute.Key)
+	assert.Equal(t, hex.EncodeToString(txHash), attribute.Value.AsString())
+}
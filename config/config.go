@@ -166,6 +166,9 @@ type Config struct {
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolPolicy                TxPoolPolicyConfig
+	TxPoolChangesStream         TxPoolChangesStreamConfig
+	TxPoolReplacement           TxPoolReplacementConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
@@ -222,6 +225,9 @@ type Config struct {
 	PeersRatingConfig   PeersRatingConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	SigningHistory      SigningHistoryConfig
+	PeerReputation      PeerReputationConfig
+	Tracing             TracingConfig
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -230,10 +236,19 @@ type PeersRatingConfig struct {
 	BadRatedCacheCapacity int
 }
 
+// TracingConfig will hold settings related to the OpenTelemetry tracing sub-system
+type TracingConfig struct {
+	Enabled       bool
+	Exporter      string
+	FilePath      string
+	SamplingRatio float64
+}
+
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
 	LogFileLifeSpanInMB  int
+	LogLevel             string
 }
 
 // StoragePruningConfig will hold settings related to storage pruning
@@ -246,6 +261,14 @@ type StoragePruningConfig struct {
 	NumEpochsToKeep                      uint64
 	NumActivePersisters                  uint64
 	FullArchiveNumActivePersisters       uint32
+	RetentionPolicies                    []StorageRetentionPolicyConfig
+}
+
+// StorageRetentionPolicyConfig will hold the retention window of a storer, expressed either in epochs or in days
+type StorageRetentionPolicyConfig struct {
+	Storer    string
+	NumEpochs uint32
+	NumDays   uint32
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -328,10 +351,35 @@ type WebServerAntifloodConfig struct {
 	SimultaneousRequests               uint32
 	SameSourceRequests                 uint32
 	SameSourceResetIntervalInSec       uint32
+	SimultaneousStreams                uint32
 	TrieOperationsDeadlineMilliseconds uint32
 	GetAddressesBulkMaxSize            uint32
+	VmQueriesBulkMaxSize               uint32
 	VmQueryDelayAfterStartInSec        uint32
 	EndpointsThrottlers                []EndpointsThrottlersConfig
+	APIKeys                            WebServerAPIKeysConfig
+}
+
+// WebServerAPIKeysConfig holds the configuration of the clients identified by an API key and of their request quotas
+type WebServerAPIKeysConfig struct {
+	Enabled    bool
+	HeaderName string
+	Keys       []WebServerAPIKeyConfig
+	Quotas     []WebServerAPIKeyQuotaConfig
+}
+
+// WebServerAPIKeyConfig holds the configuration of a single API key
+type WebServerAPIKeyConfig struct {
+	Name      string
+	KeySHA256 string
+}
+
+// WebServerAPIKeyQuotaConfig holds the token bucket quota of an API key for a class of routes
+type WebServerAPIKeyQuotaConfig struct {
+	KeyName           string
+	RouteClass        string
+	RequestsPerSecond uint32
+	Burst             uint32
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -423,8 +471,10 @@ type QueryVirtualMachineConfig struct {
 
 // VirtualMachineGasConfig holds the configuration for the virtual machine(s) gas operations
 type VirtualMachineGasConfig struct {
-	ShardMaxGasPerVmQuery uint64
-	MetaMaxGasPerVmQuery  uint64
+	ShardMaxGasPerVmQuery      uint64
+	MetaMaxGasPerVmQuery       uint64
+	ShardMaxGasPerVmMultiQuery uint64
+	MetaMaxGasPerVmMultiQuery  uint64
 }
 
 // BuiltInFunctionsConfig holds the configuration for the built-in functions
@@ -471,6 +521,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AddressTransactionsIndexEnabled    bool
+	AddressTransactionsStorageConfig   StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -508,6 +560,15 @@ type AntifloodDebugConfig struct {
 	Enabled                    bool
 	CacheSize                  int
 	IntervalAutoPrintInSeconds int
+	Trace                      AntifloodTraceConfig
+}
+
+// AntifloodTraceConfig will hold the antiflood messages trace recorder configuration
+type AntifloodTraceConfig struct {
+	Enabled         bool
+	Directory       string
+	BufferSize      int
+	MaxFileSizeInMB uint32
 }
 
 // ShuffleOutDebugConfig will hold the shuffle out debug configuration
@@ -534,8 +595,10 @@ type ProcessDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging        ApiLoggingConfig
+	Authentication ApiAuthenticationConfig
+	WebSocket      ApiWebSocketConfig
+	APIPackages    map[string]APIPackageConfig
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -544,6 +607,40 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// ApiWebSocketConfig holds the configuration related to the websocket connections opened on the API routes
+type ApiWebSocketConfig struct {
+	AllowedOrigins []string
+}
+
+// ApiAuthenticationConfig holds the configuration related to the authentication of the API requests
+type ApiAuthenticationConfig struct {
+	Enabled            bool
+	TLS                ApiTLSConfig
+	Tokens             []ApiTokenConfig
+	ClientCertificates []ApiClientCertificateConfig
+}
+
+// ApiTLSConfig holds the configuration related to serving the API over TLS
+type ApiTLSConfig struct {
+	Enabled         bool
+	CertificateFile string
+	KeyFile         string
+	ClientCAFile    string
+}
+
+// ApiTokenConfig holds the configuration of a bearer token accepted by the API
+type ApiTokenConfig struct {
+	Name        string
+	Role        string
+	TokenSHA256 string
+}
+
+// ApiClientCertificateConfig holds the role granted to the client certificates having the provided common name
+type ApiClientCertificateConfig struct {
+	CommonName string
+	Role       string
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
@@ -553,6 +650,7 @@ type APIPackageConfig struct {
 type RouteConfig struct {
 	Name string
 	Open bool
+	Role string
 }
 
 // VersionByEpochs represents a version entry that will be applied between the provided epochs
@@ -632,4 +730,57 @@ type PoolsCleanersConfig struct {
 // RedundancyConfig represents the config options to be used when setting the redundancy configuration
 type RedundancyConfig struct {
 	MaxRoundsOfInactivityAccepted int
+	LeaderLease                   LeaderLeaseConfig
+}
+
+// LeaderLeaseConfig represents the config options of the lease based failover between the main and the backup machines
+type LeaderLeaseConfig struct {
+	Enabled               bool
+	LeaseDurationInRounds int64
+	Storage               StorageConfig
+}
+
+// TxPoolPolicyConfig represents the config options of the admission rules and per-sender eviction policy of the transactions pool
+type TxPoolPolicyConfig struct {
+	Enabled                bool
+	MinGasPrice            uint64
+	MaxPendingTxsPerSender uint32
+	SenderLimitAction      string
+	PinnedSenders          []string
+	DeniedSenders          []string
+	DeniedReceivers        []string
+	AllowedSenders         []string
+	AllowedReceivers       []string
+}
+
+// TxPoolChangesStreamConfig represents the config options of the stream of transactions pool changes exposed by the REST API
+type TxPoolChangesStreamConfig struct {
+	Enabled                 bool
+	MaxSubscriptions        uint32
+	NotificationsBufferSize uint32
+}
+
+// TxPoolReplacementConfig represents the config options of the replacement of a pending transaction by a new transaction
+// of the same sender, having the same nonce and a higher gas price
+type TxPoolReplacementConfig struct {
+	Enabled                   bool
+	MinGasPriceBumpPercentage uint32
+}
+
+// PeerReputationConfig represents the config options of the persistent registry holding the peers scores and bans
+type PeerReputationConfig struct {
+	Enabled                      bool
+	DecayCoefficient             float64
+	DecayUpdateIntervalInSeconds uint32
+	BlacklistPenalty             float64
+	MaxScoreHistoryEntries       uint32
+	Storage                      StorageConfig
+	PeerHonestyStorage           StorageConfig
+}
+
+// SigningHistoryConfig represents the config options of the local store of signed headers used against double signing
+type SigningHistoryConfig struct {
+	Enabled         bool
+	NumEpochsToKeep uint32
+	Storage         StorageConfig
 }
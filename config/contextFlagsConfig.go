@@ -27,6 +27,7 @@ type ContextFlagsConfig struct {
 	SerializeSnapshots           bool
 	OperationMode                string
 	RepopulateTokensSupplies     bool
+	StateSnapshotFile            string
 }
 
 // ImportDbConfig will hold the import-db parameters
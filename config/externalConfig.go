@@ -5,6 +5,8 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	HostDriversConfig      []HostDriversConfig
+	OutportJournal         OutportJournalConfig
+	EventsSubscriptions    EventsSubscriptionsConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -51,3 +53,18 @@ type HostDriversConfig struct {
 	AcknowledgeTimeoutInSec    int
 	Version                    uint32
 }
+
+// EventsSubscriptionsConfig will hold the configuration for the events subscriptions exposed by the REST API
+type EventsSubscriptionsConfig struct {
+	Enabled                 bool
+	MaxSubscriptions        uint32
+	NotificationsBufferSize uint32
+}
+
+// OutportJournalConfig will hold the configuration for the persistent outport journal
+type OutportJournalConfig struct {
+	Enabled            bool
+	MaxBacklog         uint64
+	NumRetainedEntries uint64
+	Storage            StorageConfig
+}
@@ -0,0 +1,271 @@
+package hotReload
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// reloadableFields holds the whitelisted paths, relative to config.Configs, of the values that can be changed without
+// restarting the node. A path also covers all the values nested under it. A "*" segment matches any map key and a "[*]"
+// segment matches any slice element
+var reloadableFields = []string{
+	"GeneralConfig.Logs.LogLevel",
+	"GeneralConfig.Antiflood.FastReacting.ReservedPercent",
+	"GeneralConfig.Antiflood.FastReacting.PeerMaxInput.BaseMessagesPerInterval",
+	"GeneralConfig.Antiflood.FastReacting.PeerMaxInput.TotalSizePerInterval",
+	"GeneralConfig.Antiflood.SlowReacting.ReservedPercent",
+	"GeneralConfig.Antiflood.SlowReacting.PeerMaxInput.BaseMessagesPerInterval",
+	"GeneralConfig.Antiflood.SlowReacting.PeerMaxInput.TotalSizePerInterval",
+	"GeneralConfig.Antiflood.OutOfSpecs.ReservedPercent",
+	"GeneralConfig.Antiflood.OutOfSpecs.PeerMaxInput.BaseMessagesPerInterval",
+	"GeneralConfig.Antiflood.OutOfSpecs.PeerMaxInput.TotalSizePerInterval",
+	"GeneralConfig.WebServerAntiflood.SimultaneousRequests",
+	"GeneralConfig.WebServerAntiflood.SameSourceRequests",
+	"GeneralConfig.WebServerAntiflood.SameSourceResetIntervalInSec",
+	"GeneralConfig.WebServerAntiflood.SimultaneousStreams",
+	"GeneralConfig.WebServerAntiflood.APIKeys",
+	"ApiRoutesConfig.APIPackages.*.Routes[*].Open",
+	"RatingsConfig.PeerHonesty",
+}
+
+const keyFieldName = "Name"
+
+var reloadableFieldsRegexps = compileReloadableFields(reloadableFields)
+
+func compileReloadableFields(fields []string) []*regexp.Regexp {
+	regexps := make([]*regexp.Regexp, 0, len(fields))
+	for _, field := range fields {
+		pattern := regexp.QuoteMeta(field)
+		pattern = strings.ReplaceAll(pattern, `\[\*\]`, `\[[^\]]+\]`)
+		pattern = strings.ReplaceAll(pattern, `\*`, `[^.\[]+`)
+		regexps = append(regexps, regexp.MustCompile("^"+pattern+`($|[.\[])`))
+	}
+
+	return regexps
+}
+
+// IsReloadable returns true if the value found at the provided path, relative to config.Configs, can be hot-reloaded
+func IsReloadable(path string) bool {
+	for _, fieldRegexp := range reloadableFieldsRegexps {
+		if fieldRegexp.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeReloadableValues returns a copy of the current value holding the new values found at the reloadable paths only,
+// all the other values being kept from the current value
+func mergeReloadableValues(path string, currentValue reflect.Value, newValue reflect.Value) reflect.Value {
+	if IsReloadable(path) && newValue.IsValid() {
+		return newValue
+	}
+	if !currentValue.IsValid() || !newValue.IsValid() || currentValue.Kind() != newValue.Kind() {
+		return currentValue
+	}
+
+	switch currentValue.Kind() {
+	case reflect.Ptr:
+		if currentValue.IsNil() || newValue.IsNil() {
+			return currentValue
+		}
+		merged := reflect.New(currentValue.Type().Elem())
+		merged.Elem().Set(mergeReloadableValues(path, currentValue.Elem(), newValue.Elem()))
+		return merged
+	case reflect.Struct:
+		merged := reflect.New(currentValue.Type()).Elem()
+		merged.Set(currentValue)
+		for i := 0; i < currentValue.NumField(); i++ {
+			field := currentValue.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			merged.Field(i).Set(mergeReloadableValues(path+"."+field.Name, currentValue.Field(i), newValue.Field(i)))
+		}
+		return merged
+	case reflect.Map:
+		if currentValue.IsNil() {
+			return currentValue
+		}
+		merged := reflect.MakeMapWithSize(currentValue.Type(), currentValue.Len())
+		for _, key := range sortedMapKeys(currentValue, newValue) {
+			elementPath := fmt.Sprintf("%s.%v", path, key.Interface())
+			mergedElement := currentValue.MapIndex(key)
+			if IsReloadable(elementPath) {
+				mergedElement = newValue.MapIndex(key)
+			} else if mergedElement.IsValid() {
+				mergedElement = mergeReloadableValues(elementPath, mergedElement, newValue.MapIndex(key))
+			}
+			if mergedElement.IsValid() {
+				merged.SetMapIndex(key, mergedElement)
+			}
+		}
+		return merged
+	case reflect.Slice:
+		if currentValue.IsNil() {
+			return currentValue
+		}
+		isKeyed := isKeyedSlice(currentValue) && isKeyedSlice(newValue)
+		newElements := make(map[string]reflect.Value)
+		for i := 0; i < newValue.Len(); i++ {
+			newElements[sliceElementPath(path, newValue, i, isKeyed)] = newValue.Index(i)
+		}
+		merged := reflect.MakeSlice(currentValue.Type(), currentValue.Len(), currentValue.Len())
+		for i := 0; i < currentValue.Len(); i++ {
+			elementPath := sliceElementPath(path, currentValue, i, isKeyed)
+			merged.Index(i).Set(mergeReloadableValues(elementPath, currentValue.Index(i), newElements[elementPath]))
+		}
+		return merged
+	default:
+		return currentValue
+	}
+}
+
+// computeChanges returns the leaf values that differ between the two provided values, each one prefixed with the path
+func computeChanges(path string, oldValue interface{}, newValue interface{}) []common.ConfigChange {
+	changes := make([]common.ConfigChange, 0)
+	collectChanges(path, reflect.ValueOf(oldValue), reflect.ValueOf(newValue), &changes)
+
+	return changes
+}
+
+func collectChanges(path string, oldValue reflect.Value, newValue reflect.Value, changes *[]common.ConfigChange) {
+	if !oldValue.IsValid() || !newValue.IsValid() || oldValue.Kind() != newValue.Kind() {
+		addChangeIfNeeded(path, oldValue, newValue, changes)
+		return
+	}
+
+	switch oldValue.Kind() {
+	case reflect.Ptr:
+		if oldValue.IsNil() || newValue.IsNil() {
+			addChangeIfNeeded(path, oldValue, newValue, changes)
+			return
+		}
+		collectChanges(path, oldValue.Elem(), newValue.Elem(), changes)
+	case reflect.Struct:
+		for i := 0; i < oldValue.NumField(); i++ {
+			field := oldValue.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			collectChanges(path+"."+field.Name, oldValue.Field(i), newValue.Field(i), changes)
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(oldValue, newValue) {
+			collectChanges(fmt.Sprintf("%s.%v", path, key.Interface()), oldValue.MapIndex(key), newValue.MapIndex(key), changes)
+		}
+	case reflect.Slice, reflect.Array:
+		isKeyed := isKeyedSlice(oldValue) && isKeyedSlice(newValue)
+		if !isKeyed && oldValue.Len() != newValue.Len() {
+			addChangeIfNeeded(path, oldValue, newValue, changes)
+			return
+		}
+		collectSliceChanges(path, oldValue, newValue, isKeyed, changes)
+	default:
+		addChangeIfNeeded(path, oldValue, newValue, changes)
+	}
+}
+
+func collectSliceChanges(path string, oldValue reflect.Value, newValue reflect.Value, isKeyed bool, changes *[]common.ConfigChange) {
+	elementsPaths := make([]string, 0, oldValue.Len()+newValue.Len())
+	oldElements := make(map[string]reflect.Value)
+	for i := 0; i < oldValue.Len(); i++ {
+		elementPath := sliceElementPath(path, oldValue, i, isKeyed)
+		oldElements[elementPath] = oldValue.Index(i)
+		elementsPaths = append(elementsPaths, elementPath)
+	}
+	newElements := make(map[string]reflect.Value)
+	for i := 0; i < newValue.Len(); i++ {
+		elementPath := sliceElementPath(path, newValue, i, isKeyed)
+		newElements[elementPath] = newValue.Index(i)
+		_, isOldElement := oldElements[elementPath]
+		if !isOldElement {
+			elementsPaths = append(elementsPaths, elementPath)
+		}
+	}
+
+	for _, elementPath := range elementsPaths {
+		collectChanges(elementPath, oldElements[elementPath], newElements[elementPath], changes)
+	}
+}
+
+// isKeyedSlice returns true if the provided value is a slice of structs identified by a unique, non-empty, Name field.
+// The elements of such a slice are matched by their name instead of their index, so reordering, adding or removing
+// elements does not move the values of the other elements
+func isKeyedSlice(value reflect.Value) bool {
+	if value.Kind() != reflect.Slice || value.Type().Elem().Kind() != reflect.Struct {
+		return false
+	}
+	keyField, found := value.Type().Elem().FieldByName(keyFieldName)
+	if !found || keyField.Type.Kind() != reflect.String {
+		return false
+	}
+
+	keys := make(map[string]struct{}, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		key := value.Index(i).FieldByIndex(keyField.Index).String()
+		_, isDuplicated := keys[key]
+		if len(key) == 0 || isDuplicated {
+			return false
+		}
+		keys[key] = struct{}{}
+	}
+
+	return true
+}
+
+func sliceElementPath(path string, value reflect.Value, index int, isKeyed bool) string {
+	if isKeyed {
+		return fmt.Sprintf("%s[%s]", path, value.Index(index).FieldByName(keyFieldName).String())
+	}
+
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+func sortedMapKeys(oldValue reflect.Value, newValue reflect.Value) []reflect.Value {
+	keysMap := make(map[string]reflect.Value)
+	for _, key := range append(oldValue.MapKeys(), newValue.MapKeys()...) {
+		keysMap[fmt.Sprintf("%v", key.Interface())] = key
+	}
+
+	sortedKeys := make([]string, 0, len(keysMap))
+	for key := range keysMap {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	keys := make([]reflect.Value, 0, len(sortedKeys))
+	for _, key := range sortedKeys {
+		keys = append(keys, keysMap[key])
+	}
+
+	return keys
+}
+
+func addChangeIfNeeded(path string, oldValue reflect.Value, newValue reflect.Value, changes *[]common.ConfigChange) {
+	oldInterface := valueToInterface(oldValue)
+	newInterface := valueToInterface(newValue)
+	if reflect.DeepEqual(oldInterface, newInterface) {
+		return
+	}
+
+	*changes = append(*changes, common.ConfigChange{
+		Path:     path,
+		OldValue: oldInterface,
+		NewValue: newInterface,
+	})
+}
+
+func valueToInterface(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	return value.Interface()
+}
@@ -0,0 +1,134 @@
+package hotReload
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReloadable(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsReloadable("GeneralConfig.Logs.LogLevel"))
+	require.True(t, IsReloadable("ApiRoutesConfig.APIPackages.node.Routes[/status].Open"))
+	require.True(t, IsReloadable("GeneralConfig.WebServerAntiflood.APIKeys.Keys[partner].KeySHA256"))
+	require.True(t, IsReloadable("RatingsConfig.PeerHonesty.MaxScore"))
+	require.False(t, IsReloadable("GeneralConfig.Logs"))
+	require.False(t, IsReloadable("GeneralConfig.Logs.LogLevelExtra"))
+	require.False(t, IsReloadable("GeneralConfig.StoragePruning.NumEpochsToKeep"))
+	require.False(t, IsReloadable("ApiRoutesConfig.APIPackages.node"))
+	require.False(t, IsReloadable("ApiRoutesConfig.APIPackages.node.Routes"))
+	require.False(t, IsReloadable("ApiRoutesConfig.APIPackages.node.Routes[/status]"))
+	require.False(t, IsReloadable("ApiRoutesConfig.APIPackages.node.Routes[/status].Role"))
+	require.False(t, IsReloadable("ApiRoutesConfig.APIPackages.node.Routes[/status].OpenExtra"))
+}
+
+func TestComputeChanges(t *testing.T) {
+	t.Parallel()
+
+	type inner struct {
+		Value  int
+		Values []string
+	}
+	type outer struct {
+		Name    string
+		Inner   inner
+		Pointer *inner
+		Map     map[string]inner
+	}
+
+	oldValue := &outer{
+		Name:    "name",
+		Inner:   inner{Value: 1, Values: []string{"a", "b"}},
+		Pointer: nil,
+		Map:     map[string]inner{"b": {Value: 1}, "a": {Value: 1}},
+	}
+	newValue := &outer{
+		Name:    "name",
+		Inner:   inner{Value: 2, Values: []string{"a", "c", "d"}},
+		Pointer: &inner{Value: 3},
+		Map:     map[string]inner{"b": {Value: 2}, "c": {Value: 1}},
+	}
+
+	changes := computeChanges("Root", oldValue, newValue)
+	require.Equal(t, []common.ConfigChange{
+		{Path: "Root.Inner.Value", OldValue: 1, NewValue: 2},
+		{Path: "Root.Inner.Values", OldValue: []string{"a", "b"}, NewValue: []string{"a", "c", "d"}},
+		{Path: "Root.Pointer", OldValue: (*inner)(nil), NewValue: &inner{Value: 3}},
+		{Path: "Root.Map.a", OldValue: inner{Value: 1}, NewValue: nil},
+		{Path: "Root.Map.b.Value", OldValue: 1, NewValue: 2},
+		{Path: "Root.Map.c", OldValue: nil, NewValue: inner{Value: 1}},
+	}, changes)
+
+	require.Empty(t, computeChanges("Root", oldValue, oldValue))
+}
+
+func TestComputeChanges_KeyedSlices(t *testing.T) {
+	t.Parallel()
+
+	type element struct {
+		Name  string
+		Value int
+	}
+
+	oldValue := []element{{Name: "a", Value: 1}, {Name: "b", Value: 1}}
+	newValue := []element{{Name: "c", Value: 1}, {Name: "b", Value: 2}}
+
+	changes := computeChanges("Root", oldValue, newValue)
+	require.Equal(t, []common.ConfigChange{
+		{Path: "Root[a]", OldValue: element{Name: "a", Value: 1}, NewValue: nil},
+		{Path: "Root[b].Value", OldValue: 1, NewValue: 2},
+		{Path: "Root[c]", OldValue: nil, NewValue: element{Name: "c", Value: 1}},
+	}, changes)
+
+	// duplicated names fall back to matching the elements by index
+	newValue = []element{{Name: "a", Value: 2}, {Name: "a", Value: 1}}
+	changes = computeChanges("Root", oldValue, newValue)
+	require.Equal(t, []common.ConfigChange{
+		{Path: "Root[0].Value", OldValue: 1, NewValue: 2},
+		{Path: "Root[1].Name", OldValue: "b", NewValue: "a"},
+	}, changes)
+}
+
+func TestMergeReloadableValues(t *testing.T) {
+	t.Parallel()
+
+	currentConfigs := &config.Configs{
+		GeneralConfig: &config.Config{
+			Logs:           config.LogsConfig{LogLevel: "*:INFO"},
+			StoragePruning: config.StoragePruningConfig{NumEpochsToKeep: 4},
+		},
+		ApiRoutesConfig: &config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"node": {Routes: []config.RouteConfig{{Name: "/status", Open: true}, {Name: "/debug", Open: false, Role: "admin"}}},
+			},
+		},
+		RatingsConfig: &config.RatingsConfig{},
+	}
+	newConfigs := &config.Configs{
+		GeneralConfig: &config.Config{
+			Logs:           config.LogsConfig{LogLevel: "*:DEBUG"},
+			StoragePruning: config.StoragePruningConfig{NumEpochsToKeep: 10},
+		},
+		ApiRoutesConfig: &config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"node": {Routes: []config.RouteConfig{{Name: "/debug", Open: true}, {Name: "/new", Open: true}}},
+				"new":  {Routes: []config.RouteConfig{{Name: "/new", Open: true}}},
+			},
+		},
+		RatingsConfig: &config.RatingsConfig{PeerHonesty: config.PeerHonestyConfig{MaxScore: 100}},
+	}
+
+	mergedConfigs := mergeReloadableConfigs(currentConfigs, newConfigs)
+	require.Equal(t, "*:DEBUG", mergedConfigs.GeneralConfig.Logs.LogLevel)
+	require.Equal(t, uint64(4), mergedConfigs.GeneralConfig.StoragePruning.NumEpochsToKeep)
+	require.Equal(t, map[string]config.APIPackageConfig{
+		"node": {Routes: []config.RouteConfig{{Name: "/status", Open: true}, {Name: "/debug", Open: true, Role: "admin"}}},
+	}, mergedConfigs.ApiRoutesConfig.APIPackages)
+	require.Equal(t, float64(100), mergedConfigs.RatingsConfig.PeerHonesty.MaxScore)
+
+	require.Equal(t, "*:INFO", currentConfigs.GeneralConfig.Logs.LogLevel)
+	require.False(t, currentConfigs.ApiRoutesConfig.APIPackages["node"].Routes[1].Open)
+}
@@ -0,0 +1,283 @@
+package hotReload
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/config/overridableConfig"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// SourceSIGHUP is the source reported in the audit log when the reload was triggered by the SIGHUP signal
+	SourceSIGHUP = "SIGHUP"
+	// SourceAPI is the source reported in the audit log when the reload was triggered by the admin route
+	SourceAPI = "api"
+
+	configTomlFile = "config.toml"
+)
+
+var (
+	log      = logger.GetOrCreate("config/hotreload")
+	auditLog = logger.GetOrCreate("config/audit")
+)
+
+type configReloader struct {
+	mutReload   sync.Mutex
+	configs     *config.Configs
+	loadConfigs func(currentConfigs *config.Configs) (*config.Configs, error)
+
+	mutHandlers sync.RWMutex
+	handlers    []common.ConfigReloadHandler
+
+	mutCancel  sync.Mutex
+	cancelFunc func()
+}
+
+// NewConfigReloader creates a component able to reload the whitelisted config values from the config files
+func NewConfigReloader(configs *config.Configs) (*configReloader, error) {
+	err := checkConfigs(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configReloader{
+		configs:     configs,
+		loadConfigs: loadConfigsFromFiles,
+		handlers:    make([]common.ConfigReloadHandler, 0),
+	}, nil
+}
+
+func checkConfigs(configs *config.Configs) error {
+	if configs == nil {
+		return ErrNilConfigs
+	}
+	if configs.GeneralConfig == nil {
+		return fmt.Errorf("%w for the general config", ErrNilConfigs)
+	}
+	if configs.ApiRoutesConfig == nil {
+		return fmt.Errorf("%w for the api routes config", ErrNilConfigs)
+	}
+	if configs.RatingsConfig == nil {
+		return fmt.Errorf("%w for the ratings config", ErrNilConfigs)
+	}
+	if configs.ConfigurationPathsHolder == nil {
+		return fmt.Errorf("%w for the configuration paths holder", ErrNilConfigs)
+	}
+
+	return nil
+}
+
+// loadConfigsFromFiles reads again the files holding reloadable values. The preferences overrides targeting
+// config.toml are applied again, as they were applied at startup
+func loadConfigsFromFiles(currentConfigs *config.Configs) (*config.Configs, error) {
+	paths := currentConfigs.ConfigurationPathsHolder
+	generalConfig, err := common.LoadMainConfig(paths.MainConfig)
+	if err != nil {
+		return nil, err
+	}
+	apiRoutesConfig, err := common.LoadApiConfig(paths.ApiRoutes)
+	if err != nil {
+		return nil, err
+	}
+	ratingsConfig, err := common.LoadRatingsConfig(paths.Ratings)
+	if err != nil {
+		return nil, err
+	}
+
+	newConfigs := *currentConfigs
+	newConfigs.GeneralConfig = generalConfig
+	newConfigs.ApiRoutesConfig = apiRoutesConfig
+	newConfigs.RatingsConfig = ratingsConfig
+
+	if currentConfigs.PreferencesConfig == nil {
+		return &newConfigs, nil
+	}
+
+	overrides := make([]config.OverridableConfig, 0)
+	for _, overridableValue := range currentConfigs.PreferencesConfig.Preferences.OverridableConfigTomlValues {
+		if overridableValue.File == configTomlFile {
+			overrides = append(overrides, overridableValue)
+		}
+	}
+
+	err = overridableConfig.OverrideConfigValues(overrides, &newConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newConfigs, nil
+}
+
+// RegisterHandler registers a handler that will be notified each time the reloaded config values change
+func (cr *configReloader) RegisterHandler(handler common.ConfigReloadHandler) error {
+	if check.IfNil(handler) {
+		return ErrNilConfigReloadHandler
+	}
+
+	cr.mutHandlers.Lock()
+	cr.handlers = append(cr.handlers, handler)
+	cr.mutHandlers.Unlock()
+
+	return nil
+}
+
+// Reload reads the config files again and hands the new reloadable values to all the registered handlers, together with
+// the current values of all the other fields. The new values are applied only if all the handlers validate them,
+// otherwise the current values are kept. Every applied change is written in the audit log together with the source that
+// triggered the reload
+func (cr *configReloader) Reload(source string) ([]common.ConfigChange, error) {
+	cr.mutReload.Lock()
+	defer cr.mutReload.Unlock()
+
+	newConfigs, err := cr.loadConfigs(cr.configs)
+	if err != nil {
+		auditLog.Warn("config reload failed", "source", source, "error", err)
+		return nil, err
+	}
+
+	changes := computeReloadableChanges(cr.configs, newConfigs)
+	if len(changes) == 0 {
+		auditLog.Info("config reload: no reloadable value changed", "source", source)
+		return changes, nil
+	}
+
+	newConfigs = mergeReloadableConfigs(cr.configs, newConfigs)
+
+	handlers := cr.getHandlers()
+	for _, handler := range handlers {
+		err = handler.ValidateConfig(newConfigs)
+		if err != nil {
+			auditLog.Warn("config reload rejected", "source", source, "error", err)
+			return nil, fmt.Errorf("%w: %s", ErrInvalidReloadedConfig, err.Error())
+		}
+	}
+
+	for idx, handler := range handlers {
+		err = handler.ApplyConfig(newConfigs)
+		if err != nil {
+			cr.rollback(handlers[:idx+1])
+			auditLog.Error("config reload failed, previous values restored", "source", source, "error", err)
+			return nil, err
+		}
+	}
+
+	cr.configs = newConfigs
+	for _, change := range changes {
+		auditLog.Info("config value reloaded",
+			"source", source,
+			"path", change.Path,
+			"old value", change.OldValue,
+			"new value", change.NewValue,
+		)
+	}
+
+	return changes, nil
+}
+
+func computeReloadableChanges(oldConfigs *config.Configs, newConfigs *config.Configs) []common.ConfigChange {
+	allChanges := make([]common.ConfigChange, 0)
+	allChanges = append(allChanges, computeChanges("GeneralConfig", oldConfigs.GeneralConfig, newConfigs.GeneralConfig)...)
+	allChanges = append(allChanges, computeChanges("ApiRoutesConfig", oldConfigs.ApiRoutesConfig, newConfigs.ApiRoutesConfig)...)
+	allChanges = append(allChanges, computeChanges("RatingsConfig", oldConfigs.RatingsConfig, newConfigs.RatingsConfig)...)
+
+	changes := make([]common.ConfigChange, 0, len(allChanges))
+	for _, change := range allChanges {
+		if IsReloadable(change.Path) {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// mergeReloadableConfigs returns a copy of the current configs holding only the reloadable values taken from the new
+// configs, so the values that require a restart are neither handed to the handlers nor stored as the current ones
+func mergeReloadableConfigs(currentConfigs *config.Configs, newConfigs *config.Configs) *config.Configs {
+	mergedConfigs := *currentConfigs
+	mergedConfigs.GeneralConfig = mergeReloadableValues("GeneralConfig",
+		reflect.ValueOf(currentConfigs.GeneralConfig), reflect.ValueOf(newConfigs.GeneralConfig)).Interface().(*config.Config)
+	mergedConfigs.ApiRoutesConfig = mergeReloadableValues("ApiRoutesConfig",
+		reflect.ValueOf(currentConfigs.ApiRoutesConfig), reflect.ValueOf(newConfigs.ApiRoutesConfig)).Interface().(*config.ApiRoutesConfig)
+	mergedConfigs.RatingsConfig = mergeReloadableValues("RatingsConfig",
+		reflect.ValueOf(currentConfigs.RatingsConfig), reflect.ValueOf(newConfigs.RatingsConfig)).Interface().(*config.RatingsConfig)
+
+	return &mergedConfigs
+}
+
+func (cr *configReloader) rollback(handlers []common.ConfigReloadHandler) {
+	for _, handler := range handlers {
+		err := handler.ApplyConfig(cr.configs)
+		if err != nil {
+			log.Error("could not restore the previous config values", "error", err)
+		}
+	}
+}
+
+func (cr *configReloader) getHandlers() []common.ConfigReloadHandler {
+	cr.mutHandlers.RLock()
+	defer cr.mutHandlers.RUnlock()
+
+	handlers := make([]common.ConfigReloadHandler, len(cr.handlers))
+	copy(handlers, cr.handlers)
+
+	return handlers
+}
+
+// StartListeningForSIGHUP starts a go routine that reloads the config values each time the process receives SIGHUP
+func (cr *configReloader) StartListeningForSIGHUP() {
+	cr.mutCancel.Lock()
+	defer cr.mutCancel.Unlock()
+
+	if cr.cancelFunc != nil {
+		return
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cr.cancelFunc = cancel
+
+	go func() {
+		defer signal.Stop(sigs)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sigs:
+				log.Info("SIGHUP received, reloading the config values")
+				_, err := cr.Reload(SourceSIGHUP)
+				if err != nil {
+					log.Warn("config reload on SIGHUP failed", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// Close stops listening for SIGHUP
+func (cr *configReloader) Close() error {
+	cr.mutCancel.Lock()
+	defer cr.mutCancel.Unlock()
+
+	if cr.cancelFunc != nil {
+		cr.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *configReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
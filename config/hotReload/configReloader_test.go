@@ -0,0 +1,296 @@
+package hotReload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createConfigs() *config.Configs {
+	return &config.Configs{
+		GeneralConfig: &config.Config{
+			WebServerAntiflood: config.WebServerAntifloodConfig{
+				SimultaneousRequests: 100,
+			},
+			StoragePruning: config.StoragePruningConfig{
+				NumEpochsToKeep: 4,
+			},
+		},
+		ApiRoutesConfig: &config.ApiRoutesConfig{
+			APIPackages: map[string]config.APIPackageConfig{
+				"node": {Routes: []config.RouteConfig{{Name: "/status", Open: true}}},
+			},
+		},
+		RatingsConfig:            &config.RatingsConfig{},
+		ConfigurationPathsHolder: &config.ConfigurationPathsHolder{},
+	}
+}
+
+func copyConfigs(configs *config.Configs) *config.Configs {
+	generalConfig := *configs.GeneralConfig
+	ratingsConfig := *configs.RatingsConfig
+	apiRoutesConfig := &config.ApiRoutesConfig{
+		APIPackages: make(map[string]config.APIPackageConfig),
+	}
+	for name, apiPackage := range configs.ApiRoutesConfig.APIPackages {
+		routes := make([]config.RouteConfig, len(apiPackage.Routes))
+		copy(routes, apiPackage.Routes)
+		apiRoutesConfig.APIPackages[name] = config.APIPackageConfig{Routes: routes}
+	}
+
+	newConfigs := *configs
+	newConfigs.GeneralConfig = &generalConfig
+	newConfigs.ApiRoutesConfig = apiRoutesConfig
+	newConfigs.RatingsConfig = &ratingsConfig
+
+	return &newConfigs
+}
+
+func TestNewConfigReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil configs should error", func(t *testing.T) {
+		t.Parallel()
+
+		reloader, err := NewConfigReloader(nil)
+		require.True(t, check.IfNil(reloader))
+		require.Equal(t, ErrNilConfigs, err)
+	})
+	t.Run("nil general config should error", func(t *testing.T) {
+		t.Parallel()
+
+		configs := createConfigs()
+		configs.GeneralConfig = nil
+		reloader, err := NewConfigReloader(configs)
+		require.True(t, check.IfNil(reloader))
+		require.True(t, errors.Is(err, ErrNilConfigs))
+	})
+	t.Run("nil api routes config should error", func(t *testing.T) {
+		t.Parallel()
+
+		configs := createConfigs()
+		configs.ApiRoutesConfig = nil
+		reloader, err := NewConfigReloader(configs)
+		require.True(t, check.IfNil(reloader))
+		require.True(t, errors.Is(err, ErrNilConfigs))
+	})
+	t.Run("nil ratings config should error", func(t *testing.T) {
+		t.Parallel()
+
+		configs := createConfigs()
+		configs.RatingsConfig = nil
+		reloader, err := NewConfigReloader(configs)
+		require.True(t, check.IfNil(reloader))
+		require.True(t, errors.Is(err, ErrNilConfigs))
+	})
+	t.Run("nil configuration paths holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		configs := createConfigs()
+		configs.ConfigurationPathsHolder = nil
+		reloader, err := NewConfigReloader(configs)
+		require.True(t, check.IfNil(reloader))
+		require.True(t, errors.Is(err, ErrNilConfigs))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reloader, err := NewConfigReloader(createConfigs())
+		require.False(t, check.IfNil(reloader))
+		require.Nil(t, err)
+	})
+}
+
+func TestConfigReloader_RegisterHandler(t *testing.T) {
+	t.Parallel()
+
+	reloader, _ := NewConfigReloader(createConfigs())
+	require.Equal(t, ErrNilConfigReloadHandler, reloader.RegisterHandler(nil))
+	require.Nil(t, reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{}))
+	require.Equal(t, 1, len(reloader.getHandlers()))
+}
+
+func TestConfigReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("load error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		reloader, _ := NewConfigReloader(createConfigs())
+		reloader.loadConfigs = func(_ *config.Configs) (*config.Configs, error) {
+			return nil, expectedErr
+		}
+
+		changes, err := reloader.Reload(SourceAPI)
+		require.Nil(t, changes)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("non reloadable changes should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		reloader, _ := NewConfigReloader(createConfigs())
+		reloader.loadConfigs = func(currentConfigs *config.Configs) (*config.Configs, error) {
+			newConfigs := copyConfigs(currentConfigs)
+			newConfigs.GeneralConfig.StoragePruning.NumEpochsToKeep = 10
+
+			return newConfigs, nil
+		}
+		_ = reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{
+			ApplyConfigCalled: func(_ *config.Configs) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		})
+
+		changes, err := reloader.Reload(SourceAPI)
+		require.Nil(t, err)
+		require.Empty(t, changes)
+	})
+	t.Run("validation error should not apply", func(t *testing.T) {
+		t.Parallel()
+
+		configs := createConfigs()
+		reloader, _ := NewConfigReloader(configs)
+		reloader.loadConfigs = func(currentConfigs *config.Configs) (*config.Configs, error) {
+			newConfigs := copyConfigs(currentConfigs)
+			newConfigs.GeneralConfig.WebServerAntiflood.SimultaneousRequests = 0
+
+			return newConfigs, nil
+		}
+		applyCalled := false
+		_ = reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{
+			ApplyConfigCalled: func(_ *config.Configs) error {
+				applyCalled = true
+				return nil
+			},
+		})
+		_ = reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{
+			ValidateConfigCalled: func(_ *config.Configs) error {
+				return errors.New("invalid value")
+			},
+		})
+
+		changes, err := reloader.Reload(SourceAPI)
+		require.Nil(t, changes)
+		require.True(t, errors.Is(err, ErrInvalidReloadedConfig))
+		require.False(t, applyCalled)
+		require.True(t, configs == reloader.configs)
+	})
+	t.Run("apply error should restore the previous values", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		configs := createConfigs()
+		reloader, _ := NewConfigReloader(configs)
+		reloader.loadConfigs = func(currentConfigs *config.Configs) (*config.Configs, error) {
+			newConfigs := copyConfigs(currentConfigs)
+			newConfigs.GeneralConfig.WebServerAntiflood.SimultaneousRequests = 200
+
+			return newConfigs, nil
+		}
+		appliedValues := make([]uint32, 0)
+		_ = reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{
+			ApplyConfigCalled: func(configs *config.Configs) error {
+				appliedValues = append(appliedValues, configs.GeneralConfig.WebServerAntiflood.SimultaneousRequests)
+				return nil
+			},
+		})
+		_ = reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{
+			ApplyConfigCalled: func(_ *config.Configs) error {
+				return expectedErr
+			},
+		})
+
+		changes, err := reloader.Reload(SourceAPI)
+		require.Nil(t, changes)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, []uint32{200, 100}, appliedValues)
+		require.True(t, configs == reloader.configs)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		configs := createConfigs()
+		reloader, _ := NewConfigReloader(configs)
+		reloader.loadConfigs = func(currentConfigs *config.Configs) (*config.Configs, error) {
+			newConfigs := copyConfigs(currentConfigs)
+			newConfigs.GeneralConfig.WebServerAntiflood.SimultaneousRequests = 200
+			newConfigs.GeneralConfig.StoragePruning.NumEpochsToKeep = 10
+			newConfigs.ApiRoutesConfig.APIPackages["node"].Routes[0].Open = false
+			newConfigs.ApiRoutesConfig.APIPackages["node"].Routes[0].Role = "admin"
+			newConfigs.ApiRoutesConfig.APIPackages["node"] = config.APIPackageConfig{
+				Routes: append([]config.RouteConfig{{Name: "/new", Open: true}}, newConfigs.ApiRoutesConfig.APIPackages["node"].Routes...),
+			}
+
+			return newConfigs, nil
+		}
+		var appliedConfigs *config.Configs
+		_ = reloader.RegisterHandler(&testscommon.ConfigReloadHandlerStub{
+			ApplyConfigCalled: func(configs *config.Configs) error {
+				appliedConfigs = configs
+				return nil
+			},
+		})
+
+		changes, err := reloader.Reload(SourceSIGHUP)
+		require.Nil(t, err)
+		require.Equal(t, []common.ConfigChange{
+			{Path: "GeneralConfig.WebServerAntiflood.SimultaneousRequests", OldValue: uint32(100), NewValue: uint32(200)},
+			{Path: "ApiRoutesConfig.APIPackages.node.Routes[/status].Open", OldValue: true, NewValue: false},
+		}, changes)
+		require.True(t, appliedConfigs == reloader.configs)
+		require.Equal(t, uint32(100), configs.GeneralConfig.WebServerAntiflood.SimultaneousRequests)
+		require.Equal(t, uint32(200), appliedConfigs.GeneralConfig.WebServerAntiflood.SimultaneousRequests)
+		require.Equal(t, uint64(4), appliedConfigs.GeneralConfig.StoragePruning.NumEpochsToKeep)
+		require.Equal(t, []config.RouteConfig{{Name: "/status", Open: false}}, appliedConfigs.ApiRoutesConfig.APIPackages["node"].Routes)
+		require.True(t, configs.ApiRoutesConfig.APIPackages["node"].Routes[0].Open)
+
+		// reloading the same values should not report changes
+		changes, err = reloader.Reload(SourceSIGHUP)
+		require.Nil(t, err)
+		require.Empty(t, changes)
+	})
+}
+
+func TestLoadConfigsFromFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFile := func(name string, content string) string {
+		path := filepath.Join(dir, name)
+		require.Nil(t, os.WriteFile(path, []byte(content), os.ModePerm))
+
+		return path
+	}
+
+	configs := createConfigs()
+	configs.ConfigurationPathsHolder = &config.ConfigurationPathsHolder{
+		MainConfig: writeFile("config.toml", "[Logs]\nLogLevel = \"*:DEBUG\"\n[WebServerAntiflood]\nSimultaneousRequests = 5\n"),
+		ApiRoutes:  writeFile("api.toml", "[APIPackages]\n[APIPackages.node]\nRoutes = [{ Name = \"/status\", Open = false }]\n"),
+		Ratings:    writeFile("ratings.toml", "[PeerHonesty]\nMaxScore = 100.0\n"),
+	}
+	configs.PreferencesConfig = &config.Preferences{
+		Preferences: config.PreferencesConfig{
+			OverridableConfigTomlValues: []config.OverridableConfig{
+				{File: "config.toml", Path: "WebServerAntiflood.SimultaneousRequests", Value: "7"},
+				{File: "p2p.toml", Path: "Node.Port", Value: "1"},
+			},
+		},
+	}
+
+	newConfigs, err := loadConfigsFromFiles(configs)
+	require.Nil(t, err)
+	require.Equal(t, "*:DEBUG", newConfigs.GeneralConfig.Logs.LogLevel)
+	require.Equal(t, uint32(7), newConfigs.GeneralConfig.WebServerAntiflood.SimultaneousRequests)
+	require.False(t, newConfigs.ApiRoutesConfig.APIPackages["node"].Routes[0].Open)
+	require.Equal(t, float64(100), newConfigs.RatingsConfig.PeerHonesty.MaxScore)
+	require.True(t, configs.ConfigurationPathsHolder == newConfigs.ConfigurationPathsHolder)
+}
@@ -0,0 +1,12 @@
+package hotReload
+
+import "errors"
+
+// ErrNilConfigs signals that nil configs have been provided
+var ErrNilConfigs = errors.New("nil configs")
+
+// ErrNilConfigReloadHandler signals that a nil config reload handler has been provided
+var ErrNilConfigReloadHandler = errors.New("nil config reload handler")
+
+// ErrInvalidReloadedConfig signals that the reloaded config values did not pass the validation
+var ErrInvalidReloadedConfig = errors.New("invalid reloaded config")
@@ -0,0 +1,56 @@
+package hotReload
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+type logLevelReloadHandler struct {
+	mut          sync.Mutex
+	lastLogLevel string
+}
+
+// NewLogLevelReloadHandler creates a handler that changes the log level pattern when Logs.LogLevel is reloaded.
+// An empty value keeps the current log level
+func NewLogLevelReloadHandler() *logLevelReloadHandler {
+	return &logLevelReloadHandler{}
+}
+
+// ValidateConfig checks that the reloaded log level pattern can be parsed
+func (handler *logLevelReloadHandler) ValidateConfig(configs *config.Configs) error {
+	logLevel := configs.GeneralConfig.Logs.LogLevel
+	if len(logLevel) == 0 {
+		return nil
+	}
+
+	_, _, err := logger.ParseLogLevelAndMatchingString(logLevel)
+
+	return err
+}
+
+// ApplyConfig sets the reloaded log level pattern, if it changed
+func (handler *logLevelReloadHandler) ApplyConfig(configs *config.Configs) error {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	logLevel := configs.GeneralConfig.Logs.LogLevel
+	if len(logLevel) == 0 || logLevel == handler.lastLogLevel {
+		return nil
+	}
+
+	err := logger.SetLogLevel(logLevel)
+	if err != nil {
+		return err
+	}
+
+	handler.lastLogLevel = logLevel
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *logLevelReloadHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
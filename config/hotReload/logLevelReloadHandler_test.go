@@ -0,0 +1,45 @@
+package hotReload
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func createConfigsWithLogLevel(logLevel string) *config.Configs {
+	return &config.Configs{
+		GeneralConfig: &config.Config{
+			Logs: config.LogsConfig{
+				LogLevel: logLevel,
+			},
+		},
+	}
+}
+
+func TestLogLevelReloadHandler_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	handler := NewLogLevelReloadHandler()
+	require.False(t, check.IfNil(handler))
+
+	require.Nil(t, handler.ValidateConfig(createConfigsWithLogLevel("")))
+	require.Nil(t, handler.ValidateConfig(createConfigsWithLogLevel("*:INFO,process:DEBUG")))
+	require.NotNil(t, handler.ValidateConfig(createConfigsWithLogLevel("*:NOT_A_LEVEL")))
+}
+
+func TestLogLevelReloadHandler_ApplyConfig(t *testing.T) {
+	t.Parallel()
+
+	handler := NewLogLevelReloadHandler()
+
+	require.Nil(t, handler.ApplyConfig(createConfigsWithLogLevel("")))
+	require.Empty(t, handler.lastLogLevel)
+
+	require.Nil(t, handler.ApplyConfig(createConfigsWithLogLevel("*:INFO")))
+	require.Equal(t, "*:INFO", handler.lastLogLevel)
+
+	require.NotNil(t, handler.ApplyConfig(createConfigsWithLogLevel("*:NOT_A_LEVEL")))
+	require.Equal(t, "*:INFO", handler.lastLogLevel)
+}
@@ -427,11 +427,26 @@ func TestAPIRoutesToml(t *testing.T) {
 			LoggingEnabled:          true,
 			ThresholdInMicroSeconds: loggingThreshold,
 		},
+		Authentication: ApiAuthenticationConfig{
+			Enabled: true,
+			TLS: ApiTLSConfig{
+				Enabled:         true,
+				CertificateFile: "cert.pem",
+				KeyFile:         "key.pem",
+				ClientCAFile:    "ca.pem",
+			},
+			Tokens: []ApiTokenConfig{
+				{Name: "ops", Role: "operator", TokenSHA256: "aabb"},
+			},
+			ClientCertificates: []ApiClientCertificateConfig{
+				{CommonName: "admin.local", Role: "admin"},
+			},
+		},
 		APIPackages: map[string]APIPackageConfig{
 			package0: {
 				Routes: []RouteConfig{
 					{Name: route0, Open: true},
-					{Name: route1, Open: true},
+					{Name: route1, Open: true, Role: "admin"},
 				},
 			},
 			package1: {
@@ -447,6 +462,21 @@ func TestAPIRoutesToml(t *testing.T) {
     LoggingEnabled = true
     ThresholdInMicroSeconds = 10
 
+[Authentication]
+    Enabled = true
+    Tokens = [
+        { Name = "ops", Role = "operator", TokenSHA256 = "aabb" },
+    ]
+    ClientCertificates = [
+        { CommonName = "admin.local", Role = "admin" },
+    ]
+
+    [Authentication.TLS]
+        Enabled = true
+        CertificateFile = "cert.pem"
+        KeyFile = "key.pem"
+        ClientCAFile = "ca.pem"
+
      # API routes configuration
 [APIPackages]
 
@@ -456,7 +486,7 @@ func TestAPIRoutesToml(t *testing.T) {
         { Name = "` + route0 + `", Open = true },
 
         # test comment
-        { Name = "` + route1 + `", Open = true },
+        { Name = "` + route1 + `", Open = true, Role = "admin" },
     ]
 
 [APIPackages.` + package1 + `]
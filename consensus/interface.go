@@ -136,6 +136,7 @@ type NodeRedundancyHandler interface {
 	IsRedundancyNode() bool
 	IsMainMachineActive() bool
 	AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+	AdjustInactivityWhileNotSynchronized(roundIndex int64)
 	ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
 	ObserverPrivateKey() crypto.PrivateKey
 	IsInterfaceNil() bool
@@ -165,6 +166,14 @@ type PeerBlacklistHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SigningHistoryHandler defines the behaviour of a component that records the headers signed by each key, refusing to
+// sign a different header in an already signed round
+type SigningHistoryHandler interface {
+	CheckAndRecordSignature(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // SigningHandler defines the behaviour of a component that handles multi and single signatures used in consensus operations
 type SigningHandler interface {
 	Reset(pubKeys []string) error
@@ -38,6 +38,7 @@ type ConsensusCoreMock struct {
 	messageSigningHandler   consensus.P2PSigningHandler
 	peerBlacklistHandler    consensus.PeerBlacklistHandler
 	signingHandler          consensus.SigningHandler
+	signingHistory          consensus.SigningHistoryHandler
 }
 
 // GetAntiFloodHandler -
@@ -244,3 +245,13 @@ func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler consensus.Signing
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
 }
+
+// SigningHistory -
+func (ccm *ConsensusCoreMock) SigningHistory() consensus.SigningHistoryHandler {
+	return ccm.signingHistory
+}
+
+// SetSigningHistory -
+func (ccm *ConsensusCoreMock) SetSigningHistory(signingHistory consensus.SigningHistoryHandler) {
+	ccm.signingHistory = signingHistory
+}
@@ -7,11 +7,12 @@ import (
 
 // NodeRedundancyHandlerStub -
 type NodeRedundancyHandlerStub struct {
-	IsRedundancyNodeCalled         func() bool
-	IsMainMachineActiveCalled      func() bool
-	AdjustInactivityIfNeededCalled func(selfPubKey string, consensusPubKeys []string, roundIndex int64)
-	ResetInactivityIfNeededCalled  func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
-	ObserverPrivateKeyCalled       func() crypto.PrivateKey
+	IsRedundancyNodeCalled                     func() bool
+	IsMainMachineActiveCalled                  func() bool
+	AdjustInactivityIfNeededCalled             func(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+	AdjustInactivityWhileNotSynchronizedCalled func(roundIndex int64)
+	ResetInactivityIfNeededCalled              func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
+	ObserverPrivateKeyCalled                   func() crypto.PrivateKey
 }
 
 // IsRedundancyNode -
@@ -37,6 +38,13 @@ func (nrhs *NodeRedundancyHandlerStub) AdjustInactivityIfNeeded(selfPubKey strin
 	}
 }
 
+// AdjustInactivityWhileNotSynchronized -
+func (nrhs *NodeRedundancyHandlerStub) AdjustInactivityWhileNotSynchronized(roundIndex int64) {
+	if nrhs.AdjustInactivityWhileNotSynchronizedCalled != nil {
+		nrhs.AdjustInactivityWhileNotSynchronizedCalled(roundIndex)
+	}
+}
+
 // ResetInactivityIfNeeded -
 func (nrhs *NodeRedundancyHandlerStub) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
 	if nrhs.ResetInactivityIfNeededCalled != nil {
@@ -0,0 +1,24 @@
+package disabled
+
+type disabledSigningHistory struct {
+}
+
+// NewDisabledSigningHistory returns a new instance of disabledSigningHistory
+func NewDisabledSigningHistory() *disabledSigningHistory {
+	return &disabledSigningHistory{}
+}
+
+// CheckAndRecordSignature returns nil as it is disabled
+func (dsh *disabledSigningHistory) CheckAndRecordSignature(_ []byte, _ uint32, _ uint64, _ []byte) error {
+	return nil
+}
+
+// Close returns nil as it is disabled
+func (dsh *disabledSigningHistory) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsh *disabledSigningHistory) IsInterfaceNil() bool {
+	return dsh == nil
+}
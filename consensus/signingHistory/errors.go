@@ -0,0 +1,21 @@
+package signingHistory
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilInterchange signals that a nil interchange has been provided
+var ErrNilInterchange = errors.New("nil interchange")
+
+// ErrDoubleSignAttempt signals that a signature conflicting with an already recorded one was refused
+var ErrDoubleSignAttempt = errors.New("double sign attempt refused")
+
+// ErrInvalidInterchangeFormatVersion signals that the interchange has an unsupported format version
+var ErrInvalidInterchangeFormatVersion = errors.New("invalid interchange format version")
+
+// ErrChainIDMismatch signals that the interchange was exported on another chain
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrInvalidInterchangeData signals that the interchange contains invalid data
+var ErrInvalidInterchangeData = errors.New("invalid interchange data")
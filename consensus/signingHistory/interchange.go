@@ -0,0 +1,37 @@
+package signingHistory
+
+// InterchangeFormatVersion is the version of the signing history interchange format produced by this implementation
+const InterchangeFormatVersion = "1"
+
+// Interchange is the JSON document used to move the signing history of one or more keys between machines
+type Interchange struct {
+	Metadata InterchangeMetadata     `json:"metadata"`
+	Data     []InterchangeKeyHistory `json:"data"`
+}
+
+// InterchangeMetadata holds the data describing an interchange document
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchangeFormatVersion"`
+	ChainID                  string `json:"chainID"`
+}
+
+// InterchangeKeyHistory holds the signed blocks of a BLS public key
+type InterchangeKeyHistory struct {
+	PublicKey    string                   `json:"publicKey"`
+	SignedBlocks []InterchangeSignedBlock `json:"signedBlocks"`
+}
+
+// InterchangeSignedBlock is a block signed by a key. An empty header hash marks a round in which conflicting histories
+// were merged, so nothing can be signed by that key in that round
+type InterchangeSignedBlock struct {
+	Epoch      uint32 `json:"epoch"`
+	Round      uint64 `json:"round"`
+	HeaderHash string `json:"headerHash"`
+}
+
+// ImportStatistics holds the outcome of an import
+type ImportStatistics struct {
+	NumImported     int
+	NumAlreadyKnown int
+	NumConflicting  int
+}
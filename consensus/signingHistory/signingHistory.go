@@ -0,0 +1,375 @@
+package signingHistory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/signinghistory")
+
+const (
+	roundSize      = 8
+	pruneChunkSize = 100
+)
+
+// ArgsSigningHistory holds the arguments needed to create a new signing history
+type ArgsSigningHistory struct {
+	Storer          storage.Storer
+	Marshaller      marshal.Marshalizer
+	ChainID         string
+	NumEpochsToKeep uint32
+}
+
+// signedBlock is the record persisted for each (public key, round) pair signed by the node
+type signedBlock struct {
+	PublicKey  []byte `json:"publicKey"`
+	Epoch      uint32 `json:"epoch"`
+	Round      uint64 `json:"round"`
+	HeaderHash []byte `json:"headerHash"`
+}
+
+// signingHistory is a persistent store of the headers signed by each BLS key, consulted before each signature so that
+// a key never signs two different headers in the same round. The storer should return storage.ErrKeyNotFound for
+// missing keys, any other read error causing the signature to be refused
+type signingHistory struct {
+	mut             sync.Mutex
+	mutPrune        sync.Mutex
+	storer          storage.Storer
+	marshaller      marshal.Marshalizer
+	chainID         string
+	numEpochsToKeep uint32
+}
+
+// NewSigningHistory creates a new signing history
+func NewSigningHistory(args ArgsSigningHistory) (*signingHistory, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	return &signingHistory{
+		storer:          args.Storer,
+		marshaller:      args.Marshaller,
+		chainID:         args.ChainID,
+		numEpochsToKeep: args.NumEpochsToKeep,
+	}, nil
+}
+
+// CheckAndRecordSignature records that the provided key is about to sign the provided header in the provided round.
+// It returns an error if the key already signed a different header in that round, case in which the signature must not
+// be created. Signing again the same header is allowed
+func (sh *signingHistory) CheckAndRecordSignature(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+	if len(headerHash) == 0 {
+		return fmt.Errorf("%w: empty header hash", ErrDoubleSignAttempt)
+	}
+
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	key := createKey(publicKey, round)
+	existing, found, err := sh.getSignedBlock(key)
+	if err != nil {
+		return fmt.Errorf("%w: could not read the signing history: %s", ErrDoubleSignAttempt, err.Error())
+	}
+	if found {
+		if bytes.Equal(existing.HeaderHash, headerHash) {
+			return nil
+		}
+
+		log.Error("refused to sign a conflicting header, the key already signed in this round",
+			"public key", publicKey,
+			"round", round,
+			"epoch", epoch,
+			"header hash", headerHash,
+			"signed header hash", existing.HeaderHash)
+
+		return fmt.Errorf("%w for key %s in round %d", ErrDoubleSignAttempt, hex.EncodeToString(publicKey), round)
+	}
+
+	err = sh.putSignedBlock(key, &signedBlock{
+		PublicKey:  publicKey,
+		Epoch:      epoch,
+		Round:      round,
+		HeaderHash: headerHash,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: could not write the signing history: %s", ErrDoubleSignAttempt, err.Error())
+	}
+
+	return nil
+}
+
+// Export returns the whole signing history in the interchange format, sorted by public key and round
+func (sh *signingHistory) Export() (*Interchange, error) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	var errUnmarshal error
+	histories := make(map[string][]InterchangeSignedBlock)
+	sh.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &signedBlock{}
+		errUnmarshal = sh.marshaller.Unmarshal(record, val)
+		if errUnmarshal != nil {
+			errUnmarshal = fmt.Errorf("%w for key %s", errUnmarshal, hex.EncodeToString(key))
+			return false
+		}
+
+		publicKey := hex.EncodeToString(record.PublicKey)
+		histories[publicKey] = append(histories[publicKey], InterchangeSignedBlock{
+			Epoch:      record.Epoch,
+			Round:      record.Round,
+			HeaderHash: hex.EncodeToString(record.HeaderHash),
+		})
+
+		return true
+	})
+	if errUnmarshal != nil {
+		return nil, errUnmarshal
+	}
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			ChainID:                  sh.chainID,
+		},
+		Data: make([]InterchangeKeyHistory, 0, len(histories)),
+	}
+	for publicKey, signedBlocks := range histories {
+		sort.Slice(signedBlocks, func(i, j int) bool {
+			return signedBlocks[i].Round < signedBlocks[j].Round
+		})
+		interchange.Data = append(interchange.Data, InterchangeKeyHistory{
+			PublicKey:    publicKey,
+			SignedBlocks: signedBlocks,
+		})
+	}
+	sort.Slice(interchange.Data, func(i, j int) bool {
+		return interchange.Data[i].PublicKey < interchange.Data[j].PublicKey
+	})
+
+	return interchange, nil
+}
+
+// Import merges the provided interchange into the signing history. When the imported history and the local one hold
+// different headers for the same key and round, the round is marked as conflicting and the key will not sign in it
+func (sh *signingHistory) Import(interchange *Interchange) (ImportStatistics, error) {
+	stats := ImportStatistics{}
+	err := sh.checkInterchange(interchange)
+	if err != nil {
+		return stats, err
+	}
+
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	for _, keyHistory := range interchange.Data {
+		publicKey, _ := hex.DecodeString(keyHistory.PublicKey)
+		for _, block := range keyHistory.SignedBlocks {
+			headerHash, _ := hex.DecodeString(block.HeaderHash)
+			err = sh.importSignedBlock(publicKey, block.Epoch, block.Round, headerHash, &stats)
+			if err != nil {
+				return stats, err
+			}
+		}
+	}
+
+	log.Info("imported signing history", "num imported", stats.NumImported,
+		"num already known", stats.NumAlreadyKnown, "num conflicting", stats.NumConflicting)
+
+	return stats, nil
+}
+
+func (sh *signingHistory) checkInterchange(interchange *Interchange) error {
+	if interchange == nil {
+		return ErrNilInterchange
+	}
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return fmt.Errorf("%w: expected %s, got %s", ErrInvalidInterchangeFormatVersion,
+			InterchangeFormatVersion, interchange.Metadata.InterchangeFormatVersion)
+	}
+	if len(sh.chainID) > 0 && interchange.Metadata.ChainID != sh.chainID {
+		return fmt.Errorf("%w: expected %s, got %s", ErrChainIDMismatch, sh.chainID, interchange.Metadata.ChainID)
+	}
+
+	for _, keyHistory := range interchange.Data {
+		publicKey, err := hex.DecodeString(keyHistory.PublicKey)
+		if err != nil || len(publicKey) == 0 {
+			return fmt.Errorf("%w: invalid public key %s", ErrInvalidInterchangeData, keyHistory.PublicKey)
+		}
+		for _, block := range keyHistory.SignedBlocks {
+			_, err = hex.DecodeString(block.HeaderHash)
+			if err != nil {
+				return fmt.Errorf("%w: invalid header hash %s for key %s in round %d", ErrInvalidInterchangeData,
+					block.HeaderHash, keyHistory.PublicKey, block.Round)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (sh *signingHistory) importSignedBlock(publicKey []byte, epoch uint32, round uint64, headerHash []byte, stats *ImportStatistics) error {
+	key := createKey(publicKey, round)
+	existing, found, err := sh.getSignedBlock(key)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		if bytes.Equal(existing.HeaderHash, headerHash) {
+			stats.NumAlreadyKnown++
+			return nil
+		}
+		if len(existing.HeaderHash) == 0 {
+			stats.NumConflicting++
+			return nil
+		}
+
+		log.Warn("conflicting signing history entries, the key will not sign in this round",
+			"public key", publicKey,
+			"round", round,
+			"local header hash", existing.HeaderHash,
+			"imported header hash", headerHash)
+		stats.NumConflicting++
+		headerHash = nil
+	} else {
+		stats.NumImported++
+	}
+
+	return sh.putSignedBlock(key, &signedBlock{
+		PublicKey:  publicKey,
+		Epoch:      epoch,
+		Round:      round,
+		HeaderHash: headerHash,
+	})
+}
+
+// PruneOldEpochs removes the records older than the last NumEpochsToKeep epochs, the current one included, as the
+// conflicting headers of those rounds can no longer be signed. Nothing is removed if NumEpochsToKeep is 0
+func (sh *signingHistory) PruneOldEpochs(currentEpoch uint32) error {
+	if sh.numEpochsToKeep == 0 || currentEpoch < sh.numEpochsToKeep {
+		return nil
+	}
+
+	sh.mutPrune.Lock()
+	defer sh.mutPrune.Unlock()
+
+	// the storer is iterated without blocking the signatures, the records being removed in bounded chunks under the
+	// same lock as the signatures and the imports, each one checked again before its removal
+	oldestEpochToKeep := currentEpoch - sh.numEpochsToKeep + 1
+	keysToRemove := make([][]byte, 0)
+	sh.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &signedBlock{}
+		err := sh.marshaller.Unmarshal(record, val)
+		if err != nil {
+			log.Warn("signing history: could not read record while pruning", "key", key, "error", err)
+			return true
+		}
+		if record.Epoch < oldestEpochToKeep {
+			keysToRemove = append(keysToRemove, key)
+		}
+
+		return true
+	})
+
+	numRemoved := 0
+	for start := 0; start < len(keysToRemove); start += pruneChunkSize {
+		end := start + pruneChunkSize
+		if end > len(keysToRemove) {
+			end = len(keysToRemove)
+		}
+
+		numRemovedInChunk, err := sh.pruneKeys(keysToRemove[start:end], oldestEpochToKeep)
+		numRemoved += numRemovedInChunk
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("pruned signing history", "current epoch", currentEpoch,
+		"oldest epoch kept", oldestEpochToKeep, "num removed records", numRemoved)
+
+	return nil
+}
+
+func (sh *signingHistory) pruneKeys(keys [][]byte, oldestEpochToKeep uint32) (int, error) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	numRemoved := 0
+	for _, key := range keys {
+		record, found, err := sh.getSignedBlock(key)
+		if err != nil {
+			return numRemoved, err
+		}
+		if !found || record.Epoch >= oldestEpochToKeep {
+			continue
+		}
+
+		err = sh.storer.Remove(key)
+		if err != nil {
+			return numRemoved, err
+		}
+		numRemoved++
+	}
+
+	return numRemoved, nil
+}
+
+func (sh *signingHistory) getSignedBlock(key []byte) (*signedBlock, bool, error) {
+	buff, err := sh.storer.Get(key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	record := &signedBlock{}
+	err = sh.marshaller.Unmarshal(record, buff)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return record, true, nil
+}
+
+func (sh *signingHistory) putSignedBlock(key []byte, record *signedBlock) error {
+	buff, err := sh.marshaller.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return sh.storer.Put(key, buff)
+}
+
+func createKey(publicKey []byte, round uint64) []byte {
+	key := make([]byte, len(publicKey)+roundSize)
+	copy(key, publicKey)
+	binary.BigEndian.PutUint64(key[len(publicKey):], round)
+
+	return key
+}
+
+// Close closes the underlying storer
+func (sh *signingHistory) Close() error {
+	return sh.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *signingHistory) IsInterfaceNil() bool {
+	return sh == nil
+}
@@ -0,0 +1,364 @@
+package signingHistory
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "T"
+
+var (
+	pkA = []byte("public key A")
+	pkB = []byte("public key B")
+)
+
+func createMockArgsSigningHistory() ArgsSigningHistory {
+	return ArgsSigningHistory{
+		Storer:     genericMocks.NewStorerMockWithErrKeyNotFound(0),
+		Marshaller: &marshal.JsonMarshalizer{},
+		ChainID:    testChainID,
+	}
+}
+
+func TestNewSigningHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		args.Storer = nil
+		sh, err := NewSigningHistory(args)
+		assert.True(t, check.IfNil(sh))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		args.Marshaller = nil
+		sh, err := NewSigningHistory(args)
+		assert.True(t, check.IfNil(sh))
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sh, err := NewSigningHistory(createMockArgsSigningHistory())
+		assert.False(t, check.IfNil(sh))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSigningHistory_CheckAndRecordSignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty header hash should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+		err := sh.CheckAndRecordSignature(pkA, 1, 10, nil)
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+	})
+	t.Run("same header in the same round should be allowed", func(t *testing.T) {
+		t.Parallel()
+
+		sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+		assert.Nil(t, sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")))
+		assert.Nil(t, sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")))
+	})
+	t.Run("different header in the same round should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+		assert.Nil(t, sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")))
+
+		err := sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 2"))
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+	})
+	t.Run("other rounds and other keys should be allowed", func(t *testing.T) {
+		t.Parallel()
+
+		sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+		assert.Nil(t, sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")))
+		assert.Nil(t, sh.CheckAndRecordSignature(pkA, 1, 11, []byte("hash 2")))
+		assert.Nil(t, sh.CheckAndRecordSignature(pkB, 1, 10, []byte("hash 3")))
+	})
+	t.Run("history should survive a restart", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		sh, _ := NewSigningHistory(args)
+		assert.Nil(t, sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")))
+
+		restarted, _ := NewSigningHistory(args)
+		err := restarted.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 2"))
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+	})
+	t.Run("storer read error should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		args.Storer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, errors.New("read error")
+			},
+		}
+		sh, _ := NewSigningHistory(args)
+		err := sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1"))
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+	})
+	t.Run("storer write error should be refused", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		args.Storer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, storage.ErrKeyNotFound
+			},
+			PutCalled: func(key, data []byte) error {
+				return errors.New("write error")
+			},
+		}
+		sh, _ := NewSigningHistory(args)
+		err := sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1"))
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+	})
+}
+
+func TestSigningHistory_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("export should return the sorted history", func(t *testing.T) {
+		t.Parallel()
+
+		sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+		_ = sh.CheckAndRecordSignature(pkB, 2, 21, []byte("hash 3"))
+		_ = sh.CheckAndRecordSignature(pkA, 2, 20, []byte("hash 2"))
+		_ = sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1"))
+
+		interchange, err := sh.Export()
+		require.Nil(t, err)
+
+		expected := &Interchange{
+			Metadata: InterchangeMetadata{
+				InterchangeFormatVersion: InterchangeFormatVersion,
+				ChainID:                  testChainID,
+			},
+			Data: []InterchangeKeyHistory{
+				{
+					PublicKey: hex.EncodeToString(pkA),
+					SignedBlocks: []InterchangeSignedBlock{
+						{Epoch: 1, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash 1"))},
+						{Epoch: 2, Round: 20, HeaderHash: hex.EncodeToString([]byte("hash 2"))},
+					},
+				},
+				{
+					PublicKey: hex.EncodeToString(pkB),
+					SignedBlocks: []InterchangeSignedBlock{
+						{Epoch: 2, Round: 21, HeaderHash: hex.EncodeToString([]byte("hash 3"))},
+					},
+				},
+			},
+		}
+		assert.Equal(t, expected, interchange)
+	})
+	t.Run("import should move the history to another machine", func(t *testing.T) {
+		t.Parallel()
+
+		source, _ := NewSigningHistory(createMockArgsSigningHistory())
+		_ = source.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1"))
+		_ = source.CheckAndRecordSignature(pkA, 1, 11, []byte("hash 2"))
+		interchange, _ := source.Export()
+
+		destination, _ := NewSigningHistory(createMockArgsSigningHistory())
+		_ = destination.CheckAndRecordSignature(pkA, 1, 11, []byte("hash 2"))
+		_ = destination.CheckAndRecordSignature(pkB, 1, 11, []byte("hash 3"))
+
+		stats, err := destination.Import(interchange)
+		require.Nil(t, err)
+		assert.Equal(t, ImportStatistics{NumImported: 1, NumAlreadyKnown: 1}, stats)
+
+		err = destination.CheckAndRecordSignature(pkA, 1, 10, []byte("other hash"))
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+		assert.Nil(t, destination.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")))
+	})
+	t.Run("conflicting entries should block the round", func(t *testing.T) {
+		t.Parallel()
+
+		source, _ := NewSigningHistory(createMockArgsSigningHistory())
+		_ = source.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1"))
+		interchange, _ := source.Export()
+
+		destination, _ := NewSigningHistory(createMockArgsSigningHistory())
+		_ = destination.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 2"))
+
+		stats, err := destination.Import(interchange)
+		require.Nil(t, err)
+		assert.Equal(t, ImportStatistics{NumConflicting: 1}, stats)
+
+		assert.True(t, errors.Is(destination.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")), ErrDoubleSignAttempt))
+		assert.True(t, errors.Is(destination.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 2")), ErrDoubleSignAttempt))
+
+		exported, _ := destination.Export()
+		assert.Equal(t, "", exported.Data[0].SignedBlocks[0].HeaderHash)
+
+		stats, err = source.Import(exported)
+		require.Nil(t, err)
+		assert.Equal(t, ImportStatistics{NumConflicting: 1}, stats)
+		assert.True(t, errors.Is(source.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1")), ErrDoubleSignAttempt))
+	})
+	t.Run("invalid interchange should error", func(t *testing.T) {
+		t.Parallel()
+
+		sh, _ := NewSigningHistory(createMockArgsSigningHistory())
+		_, err := sh.Import(nil)
+		assert.Equal(t, ErrNilInterchange, err)
+
+		interchange := &Interchange{
+			Metadata: InterchangeMetadata{
+				InterchangeFormatVersion: "0",
+				ChainID:                  testChainID,
+			},
+		}
+		_, err = sh.Import(interchange)
+		assert.True(t, errors.Is(err, ErrInvalidInterchangeFormatVersion))
+
+		interchange.Metadata.InterchangeFormatVersion = InterchangeFormatVersion
+		interchange.Metadata.ChainID = "other chain"
+		_, err = sh.Import(interchange)
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+
+		interchange.Metadata.ChainID = testChainID
+		interchange.Data = []InterchangeKeyHistory{{PublicKey: "not hex"}}
+		_, err = sh.Import(interchange)
+		assert.True(t, errors.Is(err, ErrInvalidInterchangeData))
+
+		interchange.Data = []InterchangeKeyHistory{
+			{
+				PublicKey:    hex.EncodeToString(pkA),
+				SignedBlocks: []InterchangeSignedBlock{{Round: 1, HeaderHash: "not hex"}},
+			},
+		}
+		_, err = sh.Import(interchange)
+		assert.True(t, errors.Is(err, ErrInvalidInterchangeData))
+	})
+}
+
+func TestSigningHistory_PruneOldEpochs(t *testing.T) {
+	t.Parallel()
+
+	createHistory := func(numEpochsToKeep uint32) *signingHistory {
+		args := createMockArgsSigningHistory()
+		args.Storer = testscommon.CreateMemUnit()
+		args.NumEpochsToKeep = numEpochsToKeep
+		sh, _ := NewSigningHistory(args)
+		_ = sh.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 1"))
+		_ = sh.CheckAndRecordSignature(pkA, 2, 20, []byte("hash 2"))
+		_ = sh.CheckAndRecordSignature(pkB, 3, 30, []byte("hash 3"))
+
+		return sh
+	}
+	numRecords := func(sh *signingHistory) int {
+		interchange, err := sh.Export()
+		require.Nil(t, err)
+
+		num := 0
+		for _, keyHistory := range interchange.Data {
+			num += len(keyHistory.SignedBlocks)
+		}
+
+		return num
+	}
+
+	t.Run("zero epochs to keep should not prune", func(t *testing.T) {
+		t.Parallel()
+
+		sh := createHistory(0)
+		require.Nil(t, sh.PruneOldEpochs(100))
+		assert.Equal(t, 3, numRecords(sh))
+	})
+	t.Run("should remove the records older than the kept epochs", func(t *testing.T) {
+		t.Parallel()
+
+		sh := createHistory(2)
+		require.Nil(t, sh.PruneOldEpochs(1))
+		assert.Equal(t, 3, numRecords(sh))
+
+		require.Nil(t, sh.PruneOldEpochs(3))
+		assert.Equal(t, 2, numRecords(sh))
+		// the kept rounds are still protected
+		err := sh.CheckAndRecordSignature(pkA, 2, 20, []byte("other hash"))
+		assert.True(t, errors.Is(err, ErrDoubleSignAttempt))
+
+		require.Nil(t, sh.PruneOldEpochs(5))
+		assert.Equal(t, 0, numRecords(sh))
+	})
+	t.Run("storer remove error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsSigningHistory()
+		args.NumEpochsToKeep = 1
+		args.Storer = &storageStubs.StorerStub{
+			RangeKeysCalled: func(handler func(key []byte, val []byte) bool) {
+				handler([]byte("key"), []byte(`{"epoch":1}`))
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				return []byte(`{"epoch":1}`), nil
+			},
+			RemoveCalled: func(key []byte) error {
+				return expectedErr
+			},
+		}
+		sh, _ := NewSigningHistory(args)
+		assert.Equal(t, expectedErr, sh.PruneOldEpochs(2))
+	})
+	t.Run("record changed after the iteration should not be removed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		args.NumEpochsToKeep = 1
+		args.Storer = &storageStubs.StorerStub{
+			RangeKeysCalled: func(handler func(key []byte, val []byte) bool) {
+				handler([]byte("key"), []byte(`{"epoch":1}`))
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				return []byte(`{"epoch":2}`), nil
+			},
+			RemoveCalled: func(key []byte) error {
+				assert.Fail(t, "should have not removed the record")
+				return nil
+			},
+		}
+		sh, _ := NewSigningHistory(args)
+		assert.Nil(t, sh.PruneOldEpochs(2))
+	})
+	t.Run("should remove the records in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHistory()
+		args.Storer = testscommon.CreateMemUnit()
+		args.NumEpochsToKeep = 1
+		sh, _ := NewSigningHistory(args)
+		numOldRecords := 2*pruneChunkSize + 1
+		for i := 0; i < numOldRecords; i++ {
+			_ = sh.CheckAndRecordSignature(pkA, 1, uint64(i), []byte("hash"))
+		}
+		_ = sh.CheckAndRecordSignature(pkB, 2, 1000, []byte("hash"))
+
+		require.Nil(t, sh.PruneOldEpochs(2))
+		assert.Equal(t, 1, numRecords(sh))
+	})
+}
@@ -141,6 +141,10 @@ func (sr *subroundBlock) sendBlock(header data.HeaderHandler, body data.BodyHand
 		return false
 	}
 
+	if !sr.recordProposedHeader(header, marshalizedHeader) {
+		return false
+	}
+
 	if sr.couldBeSentTogether(marshalizedBody, marshalizedHeader) {
 		return sr.sendHeaderAndBlockBody(header, body, marshalizedBody, marshalizedHeader)
 	}
@@ -152,6 +156,25 @@ func (sr *subroundBlock) sendBlock(header data.HeaderHandler, body data.BodyHand
 	return true
 }
 
+// recordProposedHeader records the proposed header in the signing history of the leader, refusing to propose a
+// different header in an already signed round
+func (sr *subroundBlock) recordProposedHeader(header data.HeaderHandler, marshalizedHeader []byte) bool {
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("recordProposedHeader.GetLeader", "error", err.Error())
+		return false
+	}
+
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+	err = sr.SigningHistory().CheckAndRecordSignature([]byte(leader), header.GetEpoch(), header.GetRound(), headerHash)
+	if err != nil {
+		log.Error("recordProposedHeader.CheckAndRecordSignature", "error", err.Error())
+		return false
+	}
+
+	return true
+}
+
 func (sr *subroundBlock) couldBeSentTogether(marshalizedBody []byte, marshalizedHeader []byte) bool {
 	bodyAndHeaderSize := uint32(len(marshalizedBody) + len(marshalizedHeader))
 	log.Debug("couldBeSentTogether",
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -347,6 +348,36 @@ func TestSubroundBlock_DoBlockJob(t *testing.T) {
 	assert.Equal(t, uint64(1), sr.Header.GetNonce())
 }
 
+func TestSubroundBlock_DoBlockJobRefusedBySigningHistory(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container, &statusHandler.AppStatusHandlerStub{})
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+
+	blockSent := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			blockSent = true
+			return nil
+		},
+	})
+	container.SetRoundHandler(&mock.RoundHandlerMock{
+		RoundIndex: 1,
+	})
+	container.SetSigningHistory(&consensusMocks.SigningHistoryStub{
+		CheckAndRecordSignatureCalled: func(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+			assert.Equal(t, []byte(sr.ConsensusGroup()[0]), publicKey)
+			assert.Equal(t, uint64(1), round)
+			return errors.New("double sign attempt refused")
+		},
+	})
+
+	r := sr.DoBlockJob()
+	assert.False(t, r)
+	assert.False(t, blockSent)
+}
+
 func TestSubroundBlock_ReceivedBlockBodyAndHeaderDataAlreadySet(t *testing.T) {
 	t.Parallel()
 
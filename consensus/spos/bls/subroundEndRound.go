@@ -803,6 +803,13 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, errGetLeader
 	}
 
+	// the leader signature covers the proposed header completed with the aggregated signature, so the history is checked
+	// against the proposed header hash, refusing to sign the final info of a header other than the one proposed
+	err = sr.SigningHistory().CheckAndRecordSignature([]byte(leader), sr.Header.GetEpoch(), sr.Header.GetRound(), sr.GetData())
+	if err != nil {
+		return nil, fmt.Errorf("%w while signing the block header as leader", err)
+	}
+
 	return sr.SigningHandler().CreateSignatureForPublicKey(marshalizedHdr, []byte(leader))
 }
 
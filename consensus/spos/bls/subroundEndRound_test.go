@@ -617,6 +617,36 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobRefusedBySigningHistoryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	leaderSignatureCreated := false
+	container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+		CreateSignatureForPublicKeyCalled: func(publicKeyBytes []byte, msg []byte) ([]byte, error) {
+			leaderSignatureCreated = true
+			return []byte("SIG"), nil
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container, &statusHandler.AppStatusHandlerStub{})
+	sr.SetSelfPubKey("A")
+	sr.Header = &block.Header{Epoch: 2, Round: 37}
+	sr.Data = []byte("X")
+	container.SetSigningHistory(&consensusMocks.SigningHistoryStub{
+		CheckAndRecordSignatureCalled: func(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+			assert.Equal(t, []byte("A"), publicKey)
+			assert.Equal(t, uint32(2), epoch)
+			assert.Equal(t, uint64(37), round)
+			assert.Equal(t, []byte("X"), headerHash)
+			return errors.New("double sign attempt refused")
+		},
+	})
+
+	r := sr.DoEndRoundJob()
+	assert.False(t, r)
+	assert.False(t, leaderSignatureCreated)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
@@ -89,6 +89,17 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 			return false
 		}
 
+		err = sr.SigningHistory().CheckAndRecordSignature(
+			[]byte(sr.SelfPubKey()),
+			sr.Header.GetEpoch(),
+			sr.Header.GetRound(),
+			sr.GetData(),
+		)
+		if err != nil {
+			log.Error("doSignatureJob.CheckAndRecordSignature", "error", err.Error())
+			return false
+		}
+
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			sr.GetData(),
 			uint16(selfIndex),
@@ -367,6 +378,12 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 			continue
 		}
 
+		err = sr.SigningHistory().CheckAndRecordSignature(pkBytes, sr.Header.GetEpoch(), sr.Header.GetRound(), sr.GetData())
+		if err != nil {
+			log.Error("doSignatureJobForManagedKeys.CheckAndRecordSignature", "pk", pkBytes, "error", err.Error())
+			continue
+		}
+
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			sr.GetData(),
 			uint16(selfIndex),
@@ -377,6 +377,36 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobRefusedBySigningHistory(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.Header = &block.Header{Epoch: 2, Round: 37}
+	sr.Data = []byte("X")
+
+	signatureCreated := false
+	container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+		CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+			signatureCreated = true
+			return []byte("SIG"), nil
+		},
+	})
+	container.SetSigningHistory(&consensusMocks.SigningHistoryStub{
+		CheckAndRecordSignatureCalled: func(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+			assert.Equal(t, []byte(sr.SelfPubKey()), publicKey)
+			assert.Equal(t, uint32(2), epoch)
+			assert.Equal(t, uint64(37), round)
+			assert.Equal(t, []byte("X"), headerHash)
+			return errors.New("double sign attempt refused")
+		},
+	})
+
+	r := sr.DoSignatureJob()
+	assert.False(t, r)
+	assert.False(t, signatureCreated)
+}
+
 func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 	t.Parallel()
 
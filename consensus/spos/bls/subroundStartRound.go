@@ -133,6 +133,10 @@ func (sr *subroundStartRound) doStartRoundConsensusCheck() bool {
 func (sr *subroundStartRound) initCurrentRound() bool {
 	nodeState := sr.BootStrapper().GetNodeState()
 	if nodeState != common.NsSynchronized { // if node is not synchronized yet, it has to continue the bootstrapping mechanism
+		if sr.NodeRedundancyHandler().IsRedundancyNode() {
+			// the redundancy lease should not be held, nor taken over, on the rounds the node can not take part in consensus
+			sr.NodeRedundancyHandler().AdjustInactivityWhileNotSynchronized(sr.RoundHandler().Index())
+		}
 		return false
 	}
 
@@ -411,6 +411,38 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenGetNodeStateNot
 	assert.False(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundNotSynchronizedShouldAdjustTheRedundancyInactivity(t *testing.T) {
+	t.Parallel()
+
+	adjustedRound := int64(-1)
+	nodeRedundancyMock := &mock.NodeRedundancyHandlerStub{
+		IsRedundancyNodeCalled: func() bool {
+			return true
+		},
+		AdjustInactivityIfNeededCalled: func(_ string, _ []string, _ int64) {
+			assert.Fail(t, "should not have been called while not synchronized")
+		},
+		AdjustInactivityWhileNotSynchronizedCalled: func(roundIndex int64) {
+			adjustedRound = roundIndex
+		},
+	}
+	bootstrapperMock := &mock.BootstrapperStub{GetNodeStateCalled: func() common.NodeState {
+		return common.NsNotSynchronized
+	}}
+	roundHandlerMock := initRoundHandlerMock()
+	roundHandlerMock.RoundIndex = 7
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(bootstrapperMock)
+	container.SetNodeRedundancyHandler(nodeRedundancyMock)
+	container.SetRoundHandler(roundHandlerMock)
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.False(t, r)
+	assert.Equal(t, int64(7), adjustedRound)
+}
+
 func TestSubroundStartRound_InitCurrentRoundShouldReturnFalseWhenGenerateNextConsensusGroupErr(t *testing.T) {
 	t.Parallel()
 
@@ -35,6 +35,7 @@ func initConsensusDataContainer() *ConsensusCore {
 	peerBlacklistHandler := &mock.PeerBlacklistHandlerStub{}
 	multiSignerContainer := cryptoMocks.NewMultiSignerContainerMock(multiSignerMock)
 	signingHandler := &consensusMocks.SigningHandlerStub{}
+	signingHistory := &consensusMocks.SigningHistoryStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -58,6 +59,7 @@ func initConsensusDataContainer() *ConsensusCore {
 		messageSigningHandler:   messageSigningHandler,
 		peerBlacklistHandler:    peerBlacklistHandler,
 		signingHandler:          signingHandler,
+		signingHistory:          signingHistory,
 	}
 }
 
@@ -259,6 +261,17 @@ func TestConsensusContainerValidator_ValidateNilSignatureHandlerShouldFail(t *te
 	assert.Equal(t, ErrNilSigningHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilSigningHistoryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.signingHistory = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSigningHistory, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
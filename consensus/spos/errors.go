@@ -235,6 +235,9 @@ var ErrNilSignatureOnP2PMessage = errors.New("nil signature on the p2p message")
 // ErrNilSigningHandler signals that provided signing handler is nil
 var ErrNilSigningHandler = errors.New("nil signing handler")
 
+// ErrNilSigningHistory signals that provided signing history is nil
+var ErrNilSigningHistory = errors.New("nil signing history")
+
 // ErrNilKeysHandler signals that a nil keys handler was provided
 var ErrNilKeysHandler = errors.New("nil keys handler")
 
@@ -6,6 +6,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/consensus"
 )
 
@@ -126,6 +128,25 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 		return false
 	}
 
+	_, span := tracing.StartSpan(ctx, "consensus.subround",
+		tracing.SubroundKey.String(sr.name),
+		tracing.BlockRoundKey.Int64(roundHandler.Index()),
+	)
+	startTime := time.Now()
+	finished := sr.doWork(ctx, roundHandler)
+
+	outcome := metricsRegistry.OutcomeTimedOut
+	if finished {
+		outcome = metricsRegistry.OutcomeFinished
+	}
+	metricsRegistry.ObserveDuration(metricsRegistry.ConsensusSubroundDuration.WithLabelValues(sr.name, outcome), startTime)
+	span.SetAttributes(tracing.OutcomeKey.String(outcome))
+	span.End()
+
+	return finished
+}
+
+func (sr *Subround) doWork(ctx context.Context, roundHandler consensus.RoundHandler) bool {
 	// execute stored messages which were received in this new round but before this initialisation
 	go sr.executeStoredMessages()
 
@@ -215,6 +215,12 @@ var ErrNilEconomicsData = errors.New("nil economics data provided")
 // ErrNilTxGasHandler signals that a nil tx gas handler was provided
 var ErrNilTxGasHandler = errors.New("nil tx gas handler provided")
 
+// ErrNilPubkeyConverter signals that a nil public key converter was provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilTxPoolPolicy signals that a nil transactions pool policy was provided
+var ErrNilTxPoolPolicy = errors.New("nil transactions pool policy provided")
+
 // ErrNilManualEpochStartNotifier signals that a nil manual epoch start notifier has been provided
 var ErrNilManualEpochStartNotifier = errors.New("nil manual epoch start notifier")
 
@@ -265,3 +271,6 @@ var ErrNilValidatorInfoStorage = errors.New("nil validator info storage")
 
 // ErrValidatorInfoNotFound signals that no validator info was found
 var ErrValidatorInfoNotFound = errors.New("validator info not found")
+
+// ErrInvalidTxReplacementGasPriceBump signals that an invalid gas price bump percentage was provided for the transactions replacement
+var ErrInvalidTxReplacementGasPriceBump = errors.New("invalid gas price bump percentage for the transactions replacement")
@@ -14,6 +14,8 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
 	"github.com/multiversx/mx-chain-go/dataRetriever/shardedData"
 	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/policy"
+	txPoolPolicyDisabled "github.com/multiversx/mx-chain-go/dataRetriever/txpool/policy/disabled"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -34,11 +36,12 @@ var log = logger.GetOrCreate("dataRetriever/factory")
 
 // ArgsDataPool holds the arguments needed for NewDataPoolFromConfig function
 type ArgsDataPool struct {
-	Config           *config.Config
-	EconomicsData    process.EconomicsDataHandler
-	ShardCoordinator sharding.Coordinator
-	Marshalizer      marshal.Marshalizer
-	PathManager      storage.PathManagerHandler
+	Config                 *config.Config
+	EconomicsData          process.EconomicsDataHandler
+	ShardCoordinator       sharding.Coordinator
+	Marshalizer            marshal.Marshalizer
+	PathManager            storage.PathManagerHandler
+	AddressPubkeyConverter core.PubkeyConverter
 }
 
 // NewDataPoolFromConfig will return a new instance of a PoolsHolder
@@ -57,11 +60,21 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	if check.IfNil(args.PathManager) {
 		return nil, dataRetriever.ErrNilPathManager
 	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, dataRetriever.ErrNilPubkeyConverter
+	}
 
 	mainConfig := args.Config
 
+	txPoolPolicy, err := createTxPoolPolicy(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the transactions pool policy", err)
+	}
+
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
 		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
+		Policy:         txPoolPolicy,
+		Replacement:    mainConfig.TxPoolReplacement,
 		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
 		SelfShardID:    args.ShardCoordinator.SelfId(),
 		TxGasHandler:   args.EconomicsData,
@@ -168,6 +181,17 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	return dataPool.NewDataPool(dataPoolArgs)
 }
 
+func createTxPoolPolicy(args ArgsDataPool) (txpool.TxPoolPolicyHandler, error) {
+	if !args.Config.TxPoolPolicy.Enabled {
+		return txPoolPolicyDisabled.NewDisabledTxPoolPolicy(), nil
+	}
+
+	return policy.NewTxPoolPolicy(policy.ArgsTxPoolPolicy{
+		Config:                 args.Config.TxPoolPolicy,
+		AddressPubkeyConverter: args.AddressPubkeyConverter,
+	})
+}
+
 func createTrieSyncDB(args ArgsDataPool) (storage.Persister, error) {
 	mainConfig := args.Config
 
@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
 	"github.com/multiversx/mx-chain-go/dataRetriever/mock"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/policy"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
@@ -22,6 +23,15 @@ func TestNewDataPoolFromConfig(t *testing.T) {
 	require.NotNil(t, holder)
 }
 
+func TestNewDataPoolFromConfig_WithTxPoolPolicy(t *testing.T) {
+	args := getGoodArgs()
+	args.Config.TxPoolPolicy.Enabled = true
+	args.Config.TxPoolPolicy.MinGasPrice = 2000000000
+	holder, err := NewDataPoolFromConfig(args)
+	require.Nil(t, err)
+	require.NotNil(t, holder)
+}
+
 func TestNewDataPoolFromConfig_MissingDependencyShouldErr(t *testing.T) {
 	args := getGoodArgs()
 	args.Config = nil
@@ -46,15 +56,29 @@ func TestNewDataPoolFromConfig_MissingDependencyShouldErr(t *testing.T) {
 	holder, err = NewDataPoolFromConfig(args)
 	require.Nil(t, holder)
 	require.Equal(t, dataRetriever.ErrNilPathManager, err)
+
+	args = getGoodArgs()
+	args.AddressPubkeyConverter = nil
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	require.Equal(t, dataRetriever.ErrNilPubkeyConverter, err)
 }
 
 func TestNewDataPoolFromConfig_BadConfigShouldErr(t *testing.T) {
 	// We test one (arbitrary and trivial) erroneous config for each component that needs to be created
 
 	args := getGoodArgs()
-	args.Config.TxDataPool.Capacity = 0
+	args.Config.TxPoolPolicy.Enabled = true
+	args.Config.TxPoolPolicy.SenderLimitAction = "invalid action"
 	holder, err := NewDataPoolFromConfig(args)
 	require.Nil(t, holder)
+	require.True(t, errors.Is(err, policy.ErrInvalidSenderLimitAction))
+	require.True(t, strings.Contains(err.Error(), "the transactions pool policy"))
+
+	args = getGoodArgs()
+	args.Config.TxDataPool.Capacity = 0
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
 	require.True(t, errors.Is(err, dataRetriever.ErrCacheConfigInvalidSize))
 	require.True(t, strings.Contains(err.Error(), "the cache for the transactions"))
 
@@ -154,10 +178,11 @@ func getGoodArgs() ArgsDataPool {
 	config := testscommon.GetGeneralConfig()
 
 	return ArgsDataPool{
-		Config:           &config,
-		EconomicsData:    testEconomics,
-		ShardCoordinator: mock.NewMultipleShardsCoordinatorMock(),
-		Marshalizer:      &mock.MarshalizerMock{},
-		PathManager:      &testscommon.PathManagerStub{},
+		Config:                 &config,
+		EconomicsData:          testEconomics,
+		ShardCoordinator:       mock.NewMultipleShardsCoordinatorMock(),
+		Marshalizer:            &mock.MarshalizerMock{},
+		PathManager:            &testscommon.PathManagerStub{},
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
 	}
 }
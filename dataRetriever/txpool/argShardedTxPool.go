@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
@@ -14,6 +15,8 @@ import (
 type ArgShardedTxPool struct {
 	Config         storageunit.CacheConfig
 	TxGasHandler   txcache.TxGasHandler
+	Policy         TxPoolPolicyHandler
+	Replacement    config.TxPoolReplacementConfig
 	NumberOfShards uint32
 	SelfShardID    uint32
 }
@@ -43,6 +46,12 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.TxGasHandler.MinGasPrice() == 0 {
 		return fmt.Errorf("%w: MinGasPrice is not valid", dataRetriever.ErrCacheConfigInvalidEconomics)
 	}
+	if check.IfNil(args.Policy) {
+		return fmt.Errorf("%w: Policy is not valid", dataRetriever.ErrNilTxPoolPolicy)
+	}
+	if args.Replacement.Enabled && args.Replacement.MinGasPriceBumpPercentage == 0 {
+		return fmt.Errorf("%w: Replacement.MinGasPriceBumpPercentage is not valid", dataRetriever.ErrInvalidTxReplacementGasPriceBump)
+	}
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
@@ -0,0 +1,185 @@
+package changes
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dataRetriever/txpool/changes")
+
+const (
+	// TxAddedChange is the type of the notification sent when a transaction is added in the pool
+	TxAddedChange = "added"
+	// TxRemovedChange is the type of the notification sent when a transaction is removed from the pool
+	TxRemovedChange = "removed"
+	// TxEvictedChange is the type of the notification sent when a transaction is evicted by the pool policy, by the sender
+	// limits or by the capacity of the pool, or replaced by a transaction having a higher gas price
+	TxEvictedChange = "evicted"
+)
+
+// ArgsChangesHub holds the arguments needed to create a new transactions pool changes hub
+type ArgsChangesHub struct {
+	TxPool                  TxPoolChangesNotifier
+	AddressPubKeyConverter  core.PubkeyConverter
+	MaxSubscriptions        uint32
+	NotificationsBufferSize uint32
+}
+
+// changesHub pushes the changes of the transactions pool to the API subscribers. The pool is never blocked by a
+// subscriber: if a subscriber's buffer is full, its subscription is terminated
+type changesHub struct {
+	addressPubKeyConverter  core.PubkeyConverter
+	maxSubscriptions        uint32
+	notificationsBufferSize uint32
+	mutSubscriptions        sync.RWMutex
+	subscriptions           map[uint64]*subscription
+	lastSubscriptionID      uint64
+}
+
+// NewChangesHub creates a new transactions pool changes hub and registers it on the provided pool
+func NewChangesHub(args ArgsChangesHub) (*changesHub, error) {
+	if check.IfNil(args.TxPool) {
+		return nil, ErrNilTxPool
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, ErrNilAddressPubkeyConverter
+	}
+	if args.MaxSubscriptions == 0 {
+		return nil, ErrInvalidMaxSubscriptions
+	}
+	if args.NotificationsBufferSize == 0 {
+		return nil, ErrInvalidNotificationsBufferSize
+	}
+
+	ch := &changesHub{
+		addressPubKeyConverter:  args.AddressPubKeyConverter,
+		maxSubscriptions:        args.MaxSubscriptions,
+		notificationsBufferSize: args.NotificationsBufferSize,
+		subscriptions:           make(map[uint64]*subscription),
+	}
+
+	args.TxPool.RegisterOnAdded(ch.onAdded)
+	args.TxPool.RegisterOnRemoved(ch.onRemoved)
+	args.TxPool.RegisterOnEvicted(ch.onEvicted)
+
+	return ch, nil
+}
+
+// Subscribe creates a new subscription that will receive the changes of the transactions matching the provided filter
+func (ch *changesHub) Subscribe(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	txsFilter, err := NewTransactionsFilter(filter, ch.addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	ch.mutSubscriptions.Lock()
+	defer ch.mutSubscriptions.Unlock()
+
+	if uint32(len(ch.subscriptions)) >= ch.maxSubscriptions {
+		return nil, ErrTooManySubscriptions
+	}
+
+	ch.lastSubscriptionID++
+	sub := newSubscription(ch.lastSubscriptionID, txsFilter, ch.notificationsBufferSize)
+	ch.subscriptions[sub.id] = sub
+
+	log.Debug("changesHub.Subscribe", "subscription", sub.id, "num subscriptions", len(ch.subscriptions))
+
+	return sub, nil
+}
+
+// Unsubscribe terminates the provided subscription
+func (ch *changesHub) Unsubscribe(subscriptionID uint64) {
+	ch.removeSubscription(subscriptionID, ErrUnsubscribed)
+}
+
+func (ch *changesHub) removeSubscription(subscriptionID uint64, reason error) {
+	ch.mutSubscriptions.Lock()
+	sub, found := ch.subscriptions[subscriptionID]
+	delete(ch.subscriptions, subscriptionID)
+	ch.mutSubscriptions.Unlock()
+
+	if !found {
+		return
+	}
+
+	sub.terminate(reason)
+	log.Debug("changesHub: subscription removed", "subscription", subscriptionID, "reason", reason)
+}
+
+func (ch *changesHub) onAdded(key []byte, value interface{}) {
+	ch.notify(TxAddedChange, key, value)
+}
+
+func (ch *changesHub) onRemoved(key []byte, value interface{}) {
+	ch.notify(TxRemovedChange, key, value)
+}
+
+func (ch *changesHub) onEvicted(key []byte, value interface{}) {
+	ch.notify(TxEvictedChange, key, value)
+}
+
+// notify is called synchronously by the pool, so the notification is built only if at least a subscriber is interested
+func (ch *changesHub) notify(changeType string, key []byte, value interface{}) {
+	wrappedTx, ok := value.(*txcache.WrappedTransaction)
+	if !ok || check.IfNil(wrappedTx.Tx) {
+		return
+	}
+
+	var notification *common.TransactionsPoolChangeNotification
+	for _, sub := range ch.getSubscriptions() {
+		if !sub.filter.Matches(wrappedTx.Tx) {
+			continue
+		}
+		if notification == nil {
+			notification = ch.createNotification(changeType, key, wrappedTx)
+		}
+
+		ch.pushOrTerminate(sub, notification)
+	}
+}
+
+func (ch *changesHub) createNotification(changeType string, key []byte, wrappedTx *txcache.WrappedTransaction) *common.TransactionsPoolChangeNotification {
+	return &common.TransactionsPoolChangeNotification{
+		Type:            changeType,
+		Hash:            hex.EncodeToString(key),
+		Sender:          ch.addressPubKeyConverter.SilentEncode(wrappedTx.Tx.GetSndAddr(), log),
+		Receiver:        ch.addressPubKeyConverter.SilentEncode(wrappedTx.Tx.GetRcvAddr(), log),
+		Nonce:           wrappedTx.Tx.GetNonce(),
+		GasPrice:        wrappedTx.Tx.GetGasPrice(),
+		Data:            wrappedTx.Tx.GetData(),
+		SenderShardID:   wrappedTx.SenderShardID,
+		ReceiverShardID: wrappedTx.ReceiverShardID,
+	}
+}
+
+func (ch *changesHub) pushOrTerminate(sub *subscription, notification *common.TransactionsPoolChangeNotification) {
+	if sub.push(notification) {
+		return
+	}
+
+	ch.removeSubscription(sub.id, ErrSlowSubscriber)
+}
+
+func (ch *changesHub) getSubscriptions() []*subscription {
+	ch.mutSubscriptions.RLock()
+	defer ch.mutSubscriptions.RUnlock()
+
+	subscriptions := make([]*subscription, 0, len(ch.subscriptions))
+	for _, sub := range ch.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+
+	return subscriptions
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ch *changesHub) IsInterfaceNil() bool {
+	return ch == nil
+}
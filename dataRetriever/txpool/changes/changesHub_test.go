@@ -0,0 +1,241 @@
+package changes
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+type txPoolNotifierStub struct {
+	onAdded   func(key []byte, value interface{})
+	onRemoved func(key []byte, value interface{})
+	onEvicted func(key []byte, value interface{})
+}
+
+func (stub *txPoolNotifierStub) RegisterOnAdded(handler func(key []byte, value interface{})) {
+	stub.onAdded = handler
+}
+
+func (stub *txPoolNotifierStub) RegisterOnRemoved(handler func(key []byte, value interface{})) {
+	stub.onRemoved = handler
+}
+
+func (stub *txPoolNotifierStub) RegisterOnEvicted(handler func(key []byte, value interface{})) {
+	stub.onEvicted = handler
+}
+
+func (stub *txPoolNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsChangesHub() ArgsChangesHub {
+	return ArgsChangesHub{
+		TxPool:                  &txPoolNotifierStub{},
+		AddressPubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		MaxSubscriptions:        2,
+		NotificationsBufferSize: 2,
+	}
+}
+
+func createWrappedTx(hash string, sender string, receiver string, data string) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			Nonce:    7,
+			SndAddr:  []byte(sender),
+			RcvAddr:  []byte(receiver),
+			GasPrice: 1000000000,
+			Data:     []byte(data),
+		},
+		TxHash:          []byte(hash),
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+	}
+}
+
+func TestNewChangesHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tx pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChangesHub()
+		args.TxPool = nil
+		hub, err := NewChangesHub(args)
+		require.Equal(t, ErrNilTxPool, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChangesHub()
+		args.AddressPubKeyConverter = nil
+		hub, err := NewChangesHub(args)
+		require.Equal(t, ErrNilAddressPubkeyConverter, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid max subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChangesHub()
+		args.MaxSubscriptions = 0
+		hub, err := NewChangesHub(args)
+		require.Equal(t, ErrInvalidMaxSubscriptions, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid notifications buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsChangesHub()
+		args.NotificationsBufferSize = 0
+		hub, err := NewChangesHub(args)
+		require.Equal(t, ErrInvalidNotificationsBufferSize, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("should work and register on the pool", func(t *testing.T) {
+		t.Parallel()
+
+		txPool := &txPoolNotifierStub{}
+		args := createMockArgsChangesHub()
+		args.TxPool = txPool
+		hub, err := NewChangesHub(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(hub))
+		require.NotNil(t, txPool.onAdded)
+		require.NotNil(t, txPool.onRemoved)
+		require.NotNil(t, txPool.onEvicted)
+	})
+}
+
+func TestChangesHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewChangesHub(createMockArgsChangesHub())
+		sub, err := hub.Subscribe(common.TransactionsPoolFilter{Receiver: "not hex"})
+		require.ErrorIs(t, err, ErrInvalidAddress)
+		require.Nil(t, sub)
+	})
+	t.Run("too many subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewChangesHub(createMockArgsChangesHub())
+		_, _ = hub.Subscribe(common.TransactionsPoolFilter{})
+		_, _ = hub.Subscribe(common.TransactionsPoolFilter{})
+		sub, err := hub.Subscribe(common.TransactionsPoolFilter{})
+		require.Equal(t, ErrTooManySubscriptions, err)
+		require.Nil(t, sub)
+	})
+	t.Run("unsubscribe should terminate the subscription", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewChangesHub(createMockArgsChangesHub())
+		sub, _ := hub.Subscribe(common.TransactionsPoolFilter{})
+		hub.Unsubscribe(sub.ID())
+
+		<-sub.Done()
+		require.Equal(t, ErrUnsubscribed, sub.Err())
+
+		_, err := hub.Subscribe(common.TransactionsPoolFilter{})
+		require.Nil(t, err)
+	})
+}
+
+func TestChangesHub_Notifications(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should push the matching changes", func(t *testing.T) {
+		t.Parallel()
+
+		txPool := &txPoolNotifierStub{}
+		args := createMockArgsChangesHub()
+		args.TxPool = txPool
+		args.NotificationsBufferSize = 10
+		hub, _ := NewChangesHub(args)
+
+		contract := "contract"
+		subToContract, _ := hub.Subscribe(common.TransactionsPoolFilter{
+			Receiver:   hex.EncodeToString([]byte(contract)),
+			DataPrefix: "claim@",
+		})
+		subToAll, _ := hub.Subscribe(common.TransactionsPoolFilter{})
+
+		txPool.onAdded([]byte("hash1"), createWrappedTx("hash1", "alice", contract, "claim@01"))
+		txPool.onAdded([]byte("hash2"), createWrappedTx("hash2", "alice", contract, "stake"))
+		txPool.onRemoved([]byte("hash1"), createWrappedTx("hash1", "alice", contract, "claim@01"))
+		txPool.onEvicted([]byte("hash3"), createWrappedTx("hash3", "bob", "carol", ""))
+		txPool.onAdded([]byte("not a wrapped tx"), &transaction.Transaction{})
+
+		require.Equal(t, 2, len(subToContract.Notifications()))
+		notification := <-subToContract.Notifications()
+		require.Equal(t, &common.TransactionsPoolChangeNotification{
+			Type:            TxAddedChange,
+			Hash:            hex.EncodeToString([]byte("hash1")),
+			Sender:          hex.EncodeToString([]byte("alice")),
+			Receiver:        hex.EncodeToString([]byte(contract)),
+			Nonce:           7,
+			GasPrice:        1000000000,
+			Data:            []byte("claim@01"),
+			SenderShardID:   0,
+			ReceiverShardID: 1,
+		}, notification)
+		notification = <-subToContract.Notifications()
+		require.Equal(t, TxRemovedChange, notification.Type)
+
+		require.Equal(t, 4, len(subToAll.Notifications()))
+		changeTypes := make([]string, 0)
+		for i := 0; i < 4; i++ {
+			changeTypes = append(changeTypes, (<-subToAll.Notifications()).Type)
+		}
+		require.Equal(t, []string{TxAddedChange, TxAddedChange, TxRemovedChange, TxEvictedChange}, changeTypes)
+	})
+	t.Run("slow subscriber should be terminated", func(t *testing.T) {
+		t.Parallel()
+
+		txPool := &txPoolNotifierStub{}
+		args := createMockArgsChangesHub()
+		args.TxPool = txPool
+		args.NotificationsBufferSize = 1
+		hub, _ := NewChangesHub(args)
+
+		sub, _ := hub.Subscribe(common.TransactionsPoolFilter{})
+		txPool.onAdded([]byte("hash1"), createWrappedTx("hash1", "alice", "bob", ""))
+		txPool.onAdded([]byte("hash2"), createWrappedTx("hash2", "alice", "bob", ""))
+
+		<-sub.Done()
+		require.Equal(t, ErrSlowSubscriber, sub.Err())
+	})
+}
+
+func TestTransactionsFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	converter := testscommon.NewPubkeyConverterMock(32)
+	tx := createWrappedTx("hash", "alice", "bob", "transfer@01").Tx
+
+	emptyFilter, _ := NewTransactionsFilter(common.TransactionsPoolFilter{}, converter)
+	require.True(t, emptyFilter.Matches(tx))
+	require.False(t, emptyFilter.Matches(nil))
+
+	senderFilter, _ := NewTransactionsFilter(common.TransactionsPoolFilter{Sender: hex.EncodeToString([]byte("alice"))}, converter)
+	require.True(t, senderFilter.Matches(tx))
+
+	otherReceiverFilter, _ := NewTransactionsFilter(common.TransactionsPoolFilter{Receiver: hex.EncodeToString([]byte("carol"))}, converter)
+	require.False(t, otherReceiverFilter.Matches(tx))
+
+	dataPrefixFilter, _ := NewTransactionsFilter(common.TransactionsPoolFilter{DataPrefix: "transfer@"}, converter)
+	require.True(t, dataPrefixFilter.Matches(tx))
+
+	otherDataPrefixFilter, _ := NewTransactionsFilter(common.TransactionsPoolFilter{DataPrefix: "claim"}, converter)
+	require.False(t, otherDataPrefixFilter.Matches(tx))
+
+	_, err := NewTransactionsFilter(common.TransactionsPoolFilter{}, nil)
+	require.Equal(t, ErrNilAddressPubkeyConverter, err)
+}
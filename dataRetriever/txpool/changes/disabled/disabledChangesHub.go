@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/changes"
+)
+
+type disabledChangesHub struct{}
+
+// NewDisabledChangesHub will create a new instance of disabledChangesHub
+func NewDisabledChangesHub() *disabledChangesHub {
+	return new(disabledChangesHub)
+}
+
+// Subscribe returns ErrChangesStreamDisabled
+func (dch *disabledChangesHub) Subscribe(_ common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	return nil, changes.ErrChangesStreamDisabled
+}
+
+// Unsubscribe does nothing
+func (dch *disabledChangesHub) Unsubscribe(_ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dch *disabledChangesHub) IsInterfaceNil() bool {
+	return dch == nil
+}
@@ -0,0 +1,30 @@
+package changes
+
+import "errors"
+
+// ErrNilTxPool signals that a nil transactions pool has been provided
+var ErrNilTxPool = errors.New("nil transactions pool")
+
+// ErrNilAddressPubkeyConverter signals that a nil address public key converter has been provided
+var ErrNilAddressPubkeyConverter = errors.New("nil address public key converter")
+
+// ErrInvalidMaxSubscriptions signals that an invalid maximum number of subscriptions has been provided
+var ErrInvalidMaxSubscriptions = errors.New("invalid maximum number of subscriptions")
+
+// ErrInvalidNotificationsBufferSize signals that an invalid notifications buffer size has been provided
+var ErrInvalidNotificationsBufferSize = errors.New("invalid notifications buffer size")
+
+// ErrInvalidAddress signals that an invalid address has been provided in the filter
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrTooManySubscriptions signals that the maximum number of subscriptions has been reached
+var ErrTooManySubscriptions = errors.New("too many transactions pool subscriptions")
+
+// ErrSlowSubscriber signals that a subscription was terminated because it did not consume its notifications in time
+var ErrSlowSubscriber = errors.New("subscription terminated: the subscriber is too slow")
+
+// ErrUnsubscribed signals that the subscription was cancelled by the subscriber
+var ErrUnsubscribed = errors.New("unsubscribed")
+
+// ErrChangesStreamDisabled signals that the transactions pool changes stream is disabled
+var ErrChangesStreamDisabled = errors.New("transactions pool changes stream is disabled")
@@ -0,0 +1,68 @@
+package changes
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// TransactionsFilter selects the transactions matching all the non-empty criteria of a common.TransactionsPoolFilter
+type TransactionsFilter struct {
+	sender     []byte
+	receiver   []byte
+	dataPrefix []byte
+}
+
+// NewTransactionsFilter creates a new transactions filter, decoding the provided addresses
+func NewTransactionsFilter(filter common.TransactionsPoolFilter, addressPubKeyConverter core.PubkeyConverter) (*TransactionsFilter, error) {
+	if check.IfNil(addressPubKeyConverter) {
+		return nil, ErrNilAddressPubkeyConverter
+	}
+
+	sender, err := decodeOptionalAddress(filter.Sender, addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := decodeOptionalAddress(filter.Receiver, addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TransactionsFilter{
+		sender:     sender,
+		receiver:   receiver,
+		dataPrefix: []byte(filter.DataPrefix),
+	}, nil
+}
+
+func decodeOptionalAddress(address string, addressPubKeyConverter core.PubkeyConverter) ([]byte, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	decoded, err := addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrInvalidAddress, address, err.Error())
+	}
+
+	return decoded, nil
+}
+
+// Matches returns true if the transaction satisfies all the criteria of the filter
+func (tf *TransactionsFilter) Matches(tx data.TransactionHandler) bool {
+	if check.IfNil(tx) {
+		return false
+	}
+	if len(tf.sender) > 0 && !bytes.Equal(tf.sender, tx.GetSndAddr()) {
+		return false
+	}
+	if len(tf.receiver) > 0 && !bytes.Equal(tf.receiver, tx.GetRcvAddr()) {
+		return false
+	}
+
+	return bytes.HasPrefix(tx.GetData(), tf.dataPrefix)
+}
@@ -0,0 +1,9 @@
+package changes
+
+// TxPoolChangesNotifier defines a transactions pool able to notify the added, removed and evicted transactions
+type TxPoolChangesNotifier interface {
+	RegisterOnAdded(handler func(key []byte, value interface{}))
+	RegisterOnRemoved(handler func(key []byte, value interface{}))
+	RegisterOnEvicted(handler func(key []byte, value interface{}))
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,69 @@
+package changes
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type subscription struct {
+	id                uint64
+	filter            *TransactionsFilter
+	chanNotifications chan *common.TransactionsPoolChangeNotification
+	chanDone          chan struct{}
+	closeOnce         sync.Once
+	mutErr            sync.RWMutex
+	err               error
+}
+
+func newSubscription(id uint64, filter *TransactionsFilter, bufferSize uint32) *subscription {
+	return &subscription{
+		id:                id,
+		filter:            filter,
+		chanNotifications: make(chan *common.TransactionsPoolChangeNotification, bufferSize),
+		chanDone:          make(chan struct{}),
+	}
+}
+
+// push tries to deliver the notification without blocking. Returns false if the subscriber's buffer is full
+func (s *subscription) push(notification *common.TransactionsPoolChangeNotification) bool {
+	select {
+	case s.chanNotifications <- notification:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *subscription) terminate(err error) {
+	s.closeOnce.Do(func() {
+		s.mutErr.Lock()
+		s.err = err
+		s.mutErr.Unlock()
+
+		close(s.chanDone)
+	})
+}
+
+// ID returns the subscription identifier
+func (s *subscription) ID() uint64 {
+	return s.id
+}
+
+// Notifications returns the channel on which the matching notifications are pushed
+func (s *subscription) Notifications() <-chan *common.TransactionsPoolChangeNotification {
+	return s.chanNotifications
+}
+
+// Done returns a channel that is closed when the subscription is terminated
+func (s *subscription) Done() <-chan struct{} {
+	return s.chanDone
+}
+
+// Err returns the reason of the subscription termination, nil while the subscription is active
+func (s *subscription) Err() error {
+	s.mutErr.RLock()
+	defer s.mutErr.RUnlock()
+
+	return s.err
+}
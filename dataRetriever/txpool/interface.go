@@ -17,3 +17,19 @@ type txCache interface {
 	Diagnose(deep bool)
 	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
 }
+
+// TxPoolPolicyHandler defines the admission rules and the sender eviction policy applied to the transactions added in the pool
+type TxPoolPolicyHandler interface {
+	CheckAdmission(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error
+	SelectTxsToEvict(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) [][]byte
+	OnTxsEvicted(numTxs int)
+	IsPinned(sender []byte) bool
+	GetCounters() map[string]uint64
+	IsInterfaceNil() bool
+}
+
+type guardedTxCache interface {
+	txCache
+	AddTxWithPolicy(tx *txcache.WrappedTransaction, policy txcache.AdmissionPolicy) (bool, error)
+	GetTxsReplacedBy(tx *txcache.WrappedTransaction) ([]*txcache.WrappedTransaction, error)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/policy/disabled"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
@@ -116,6 +117,7 @@ func newPool() dataRetriever.ShardedDataCacherNotifier {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		Policy:         disabled.NewDisabledTxPoolPolicy(),
 		NumberOfShards: 2,
 		SelfShardID:    0,
 	}
@@ -0,0 +1,154 @@
+package policy
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+const (
+	// MinGasPriceRuleName is the name of the rule rejecting the transactions with a too low gas price
+	MinGasPriceRuleName = "min-gas-price"
+	// MaxPendingTxsPerSenderRuleName is the name of the rule rejecting the transactions of the senders with too many pending transactions
+	MaxPendingTxsPerSenderRuleName = "max-pending-txs-per-sender"
+	// DeniedSendersRuleName is the name of the rule rejecting the transactions from the denied senders
+	DeniedSendersRuleName = "denied-senders"
+	// DeniedReceiversRuleName is the name of the rule rejecting the transactions towards the denied receivers
+	DeniedReceiversRuleName = "denied-receivers"
+	// AllowedSendersRuleName is the name of the rule rejecting the transactions from the senders not allowed
+	AllowedSendersRuleName = "allowed-senders"
+	// AllowedReceiversRuleName is the name of the rule rejecting the transactions towards the receivers not allowed
+	AllowedReceiversRuleName = "allowed-receivers"
+)
+
+type minGasPriceRule struct {
+	minGasPrice uint64
+}
+
+// Name returns the rule's name
+func (rule *minGasPriceRule) Name() string {
+	return MinGasPriceRuleName
+}
+
+// CheckTransaction rejects the transaction if its gas price is lower than the configured minimum
+func (rule *minGasPriceRule) CheckTransaction(tx *txcache.WrappedTransaction, _ func() []*txcache.WrappedTransaction) error {
+	gasPrice := tx.Tx.GetGasPrice()
+	if gasPrice < rule.minGasPrice {
+		return fmt.Errorf("%w: gas price %d is lower than %d", ErrTxRejectedByPolicy, gasPrice, rule.minGasPrice)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rule *minGasPriceRule) IsInterfaceNil() bool {
+	return rule == nil
+}
+
+type maxPendingTxsPerSenderRule struct {
+	maxPendingTxs int
+}
+
+// Name returns the rule's name
+func (rule *maxPendingTxsPerSenderRule) Name() string {
+	return MaxPendingTxsPerSenderRuleName
+}
+
+// CheckTransaction rejects the transaction if its sender already reached the maximum number of pending transactions
+func (rule *maxPendingTxsPerSenderRule) CheckTransaction(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+	senderTxs := getSenderTxs()
+	if len(senderTxs) < rule.maxPendingTxs {
+		return nil
+	}
+	if containsTx(senderTxs, tx.TxHash) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: the sender already has %d pending transactions", ErrTxRejectedByPolicy, len(senderTxs))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rule *maxPendingTxsPerSenderRule) IsInterfaceNil() bool {
+	return rule == nil
+}
+
+type addressListRule struct {
+	name       string
+	addresses  map[string]struct{}
+	isDenyList bool
+	getAddress func(tx *txcache.WrappedTransaction) []byte
+}
+
+func newDeniedSendersRule(addresses map[string]struct{}) *addressListRule {
+	return &addressListRule{
+		name:       DeniedSendersRuleName,
+		addresses:  addresses,
+		isDenyList: true,
+		getAddress: getSender,
+	}
+}
+
+func newDeniedReceiversRule(addresses map[string]struct{}) *addressListRule {
+	return &addressListRule{
+		name:       DeniedReceiversRuleName,
+		addresses:  addresses,
+		isDenyList: true,
+		getAddress: getReceiver,
+	}
+}
+
+func newAllowedSendersRule(addresses map[string]struct{}) *addressListRule {
+	return &addressListRule{
+		name:       AllowedSendersRuleName,
+		addresses:  addresses,
+		isDenyList: false,
+		getAddress: getSender,
+	}
+}
+
+func newAllowedReceiversRule(addresses map[string]struct{}) *addressListRule {
+	return &addressListRule{
+		name:       AllowedReceiversRuleName,
+		addresses:  addresses,
+		isDenyList: false,
+		getAddress: getReceiver,
+	}
+}
+
+// Name returns the rule's name
+func (rule *addressListRule) Name() string {
+	return rule.name
+}
+
+// CheckTransaction rejects the transaction if the checked address is in the deny list or is missing from the allow list
+func (rule *addressListRule) CheckTransaction(tx *txcache.WrappedTransaction, _ func() []*txcache.WrappedTransaction) error {
+	_, isListed := rule.addresses[string(rule.getAddress(tx))]
+	if isListed == rule.isDenyList {
+		return fmt.Errorf("%w: %s", ErrTxRejectedByPolicy, rule.name)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rule *addressListRule) IsInterfaceNil() bool {
+	return rule == nil
+}
+
+func getSender(tx *txcache.WrappedTransaction) []byte {
+	return tx.Tx.GetSndAddr()
+}
+
+func getReceiver(tx *txcache.WrappedTransaction) []byte {
+	return tx.Tx.GetRcvAddr()
+}
+
+func containsTx(txs []*txcache.WrappedTransaction, txHash []byte) bool {
+	for _, tx := range txs {
+		if string(tx.TxHash) == string(txHash) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,40 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-go/storage/txcache"
+
+type disabledTxPoolPolicy struct {
+}
+
+// NewDisabledTxPoolPolicy creates a new disabled transactions pool policy
+func NewDisabledTxPoolPolicy() *disabledTxPoolPolicy {
+	return &disabledTxPoolPolicy{}
+}
+
+// CheckAdmission returns nil
+func (policy *disabledTxPoolPolicy) CheckAdmission(_ *txcache.WrappedTransaction, _ func() []*txcache.WrappedTransaction) error {
+	return nil
+}
+
+// SelectTxsToEvict returns nil
+func (policy *disabledTxPoolPolicy) SelectTxsToEvict(_ *txcache.WrappedTransaction, _ func() []*txcache.WrappedTransaction) [][]byte {
+	return nil
+}
+
+// OnTxsEvicted does nothing
+func (policy *disabledTxPoolPolicy) OnTxsEvicted(_ int) {
+}
+
+// IsPinned returns false
+func (policy *disabledTxPoolPolicy) IsPinned(_ []byte) bool {
+	return false
+}
+
+// GetCounters returns an empty map
+func (policy *disabledTxPoolPolicy) GetCounters() map[string]uint64 {
+	return make(map[string]uint64)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *disabledTxPoolPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
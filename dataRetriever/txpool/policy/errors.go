@@ -0,0 +1,24 @@
+package policy
+
+import "errors"
+
+// ErrNilAddressPubkeyConverter signals that a nil address public key converter was provided
+var ErrNilAddressPubkeyConverter = errors.New("nil address public key converter")
+
+// ErrInvalidSenderLimitAction signals that an invalid sender limit action was provided
+var ErrInvalidSenderLimitAction = errors.New("invalid sender limit action")
+
+// ErrInvalidAddress signals that an invalid address was provided in the policy configuration
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrNilAdmissionRule signals that a nil admission rule was provided
+var ErrNilAdmissionRule = errors.New("nil admission rule")
+
+// ErrDuplicatedRuleName signals that a rule with the same name was already added
+var ErrDuplicatedRuleName = errors.New("duplicated rule name")
+
+// ErrTxRejectedByPolicy signals that the transaction was rejected by one of the admission rules
+var ErrTxRejectedByPolicy = errors.New("transaction rejected by the pool policy")
+
+// ErrNilSenderEvictionPolicy signals that a nil sender eviction policy was provided
+var ErrNilSenderEvictionPolicy = errors.New("nil sender eviction policy")
@@ -0,0 +1,20 @@
+package policy
+
+import "github.com/multiversx/mx-chain-go/storage/txcache"
+
+// AdmissionRule is a pluggable rule that can reject a transaction before it enters the pool. The getSenderTxs function
+// returns the transactions of the same sender already held by the destination cache and should only be called if needed
+type AdmissionRule interface {
+	Name() string
+	CheckTransaction(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error
+	IsInterfaceNil() bool
+}
+
+// SenderEvictionPolicy is a pluggable policy that selects, after the addition of a new transaction, which transactions of
+// the same sender should be evicted from the pool. The sender transactions contain the new one, which is rejected if its
+// hash is selected
+type SenderEvictionPolicy interface {
+	Name() string
+	SelectTxsToEvict(tx *txcache.WrappedTransaction, senderTxs []*txcache.WrappedTransaction) [][]byte
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,87 @@
+package policy
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+const (
+	// SenderLimitActionReject rejects the new transactions of the senders that reached the limit
+	SenderLimitActionReject = "reject"
+	// SenderLimitActionEvictHighestNonce evicts the transactions with the highest nonces of the senders over the limit
+	SenderLimitActionEvictHighestNonce = "evict-highest-nonce"
+	// SenderLimitActionEvictLowestGasPrice evicts the transactions with the highest nonces of the senders over the limit,
+	// only if they have a lower gas price than the new transaction, which is rejected otherwise
+	SenderLimitActionEvictLowestGasPrice = "evict-lowest-gas-price"
+)
+
+// evictionPolicy keeps at most maxTxsPerSender transactions for each sender, the ones with the lowest nonces, so no nonce
+// gap is left behind. The canEvict function decides if the new transaction can push out the transactions with the
+// highest nonces, otherwise the new transaction itself is selected for eviction
+type evictionPolicy struct {
+	name            string
+	maxTxsPerSender int
+	canEvict        func(newTx *txcache.WrappedTransaction, txToEvict *txcache.WrappedTransaction) bool
+}
+
+func newEvictHighestNoncePolicy(maxTxsPerSender int) *evictionPolicy {
+	return &evictionPolicy{
+		name:            SenderLimitActionEvictHighestNonce,
+		maxTxsPerSender: maxTxsPerSender,
+		canEvict: func(_ *txcache.WrappedTransaction, _ *txcache.WrappedTransaction) bool {
+			return true
+		},
+	}
+}
+
+func newEvictLowestGasPricePolicy(maxTxsPerSender int) *evictionPolicy {
+	return &evictionPolicy{
+		name:            SenderLimitActionEvictLowestGasPrice,
+		maxTxsPerSender: maxTxsPerSender,
+		canEvict: func(newTx *txcache.WrappedTransaction, txToEvict *txcache.WrappedTransaction) bool {
+			return txToEvict.Tx.GetGasPrice() < newTx.Tx.GetGasPrice()
+		},
+	}
+}
+
+// Name returns the policy's name
+func (policy *evictionPolicy) Name() string {
+	return policy.name
+}
+
+// SelectTxsToEvict returns the hashes of the sender's transactions exceeding the limit, the ones with the highest nonces.
+// Only the hash of the new transaction is returned if it is one of them or if it cannot push them out
+func (policy *evictionPolicy) SelectTxsToEvict(tx *txcache.WrappedTransaction, senderTxs []*txcache.WrappedTransaction) [][]byte {
+	if len(senderTxs) <= policy.maxTxsPerSender {
+		return nil
+	}
+
+	sortedTxs := make([]*txcache.WrappedTransaction, len(senderTxs))
+	copy(sortedTxs, senderTxs)
+	sort.SliceStable(sortedTxs, func(i, j int) bool {
+		return hasLowerNonce(sortedTxs[i], sortedTxs[j])
+	})
+
+	txsToEvict := make([][]byte, 0, len(sortedTxs)-policy.maxTxsPerSender)
+	for _, txToEvict := range sortedTxs[policy.maxTxsPerSender:] {
+		isNewTx := bytes.Equal(txToEvict.TxHash, tx.TxHash)
+		if isNewTx || !policy.canEvict(tx, txToEvict) {
+			return [][]byte{tx.TxHash}
+		}
+
+		txsToEvict = append(txsToEvict, txToEvict.TxHash)
+	}
+
+	return txsToEvict
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *evictionPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
+
+func hasLowerNonce(a, b *txcache.WrappedTransaction) bool {
+	return a.Tx.GetNonce() < b.Tx.GetNonce()
+}
@@ -0,0 +1,281 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dataRetriever/txpool/policy")
+
+// rejectedCounterSuffix is appended to the name of the eviction policy for the counter of the new transactions it rejects
+const rejectedCounterSuffix = "-rejected"
+
+// ArgsTxPoolPolicy holds the arguments needed to create a new transactions pool policy
+type ArgsTxPoolPolicy struct {
+	Config                 config.TxPoolPolicyConfig
+	AddressPubkeyConverter core.PubkeyConverter
+}
+
+// txPoolPolicy applies the admission rules and the sender eviction policy of the transactions pool. The pinned senders
+// are not subject to any of them
+type txPoolPolicy struct {
+	mutRules       sync.RWMutex
+	rules          []AdmissionRule
+	evictionPolicy SenderEvictionPolicy
+	pinnedSenders  map[string]struct{}
+	mutCounters    sync.RWMutex
+	counters       map[string]uint64
+}
+
+// NewTxPoolPolicy creates a new transactions pool policy from the provided config
+func NewTxPoolPolicy(args ArgsTxPoolPolicy) (*txPoolPolicy, error) {
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilAddressPubkeyConverter
+	}
+
+	cfg := args.Config
+	pinnedSenders, err := decodeAddresses(cfg.PinnedSenders, args.AddressPubkeyConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for PinnedSenders", err)
+	}
+
+	policy := &txPoolPolicy{
+		rules:         make([]AdmissionRule, 0),
+		pinnedSenders: pinnedSenders,
+		counters:      make(map[string]uint64),
+	}
+
+	err = policy.createAddressListRules(cfg, args.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MinGasPrice > 0 {
+		policy.addRule(&minGasPriceRule{minGasPrice: cfg.MinGasPrice})
+	}
+
+	err = policy.createSenderLimitHandler(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("created transactions pool policy", "num rules", len(policy.rules),
+		"num pinned senders", len(policy.pinnedSenders), "sender limit action", cfg.SenderLimitAction)
+
+	return policy, nil
+}
+
+func (policy *txPoolPolicy) createAddressListRules(cfg config.TxPoolPolicyConfig, converter core.PubkeyConverter) error {
+	lists := []struct {
+		name       string
+		addresses  []string
+		createRule func(addresses map[string]struct{}) *addressListRule
+	}{
+		{name: "DeniedSenders", addresses: cfg.DeniedSenders, createRule: newDeniedSendersRule},
+		{name: "AllowedSenders", addresses: cfg.AllowedSenders, createRule: newAllowedSendersRule},
+		{name: "DeniedReceivers", addresses: cfg.DeniedReceivers, createRule: newDeniedReceiversRule},
+		{name: "AllowedReceivers", addresses: cfg.AllowedReceivers, createRule: newAllowedReceiversRule},
+	}
+
+	for _, list := range lists {
+		if len(list.addresses) == 0 {
+			continue
+		}
+
+		addresses, err := decodeAddresses(list.addresses, converter)
+		if err != nil {
+			return fmt.Errorf("%w for %s", err, list.name)
+		}
+
+		policy.addRule(list.createRule(addresses))
+	}
+
+	return nil
+}
+
+func (policy *txPoolPolicy) createSenderLimitHandler(cfg config.TxPoolPolicyConfig) error {
+	maxPendingTxs := int(cfg.MaxPendingTxsPerSender)
+
+	switch cfg.SenderLimitAction {
+	case SenderLimitActionReject, "":
+		if maxPendingTxs > 0 {
+			policy.addRule(&maxPendingTxsPerSenderRule{maxPendingTxs: maxPendingTxs})
+		}
+	case SenderLimitActionEvictHighestNonce:
+		if maxPendingTxs > 0 {
+			policy.setEvictionPolicy(newEvictHighestNoncePolicy(maxPendingTxs))
+		}
+	case SenderLimitActionEvictLowestGasPrice:
+		if maxPendingTxs > 0 {
+			policy.setEvictionPolicy(newEvictLowestGasPricePolicy(maxPendingTxs))
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidSenderLimitAction, cfg.SenderLimitAction)
+	}
+
+	return nil
+}
+
+// AddAdmissionRule registers a new admission rule, applied after the ones created from the config
+func (policy *txPoolPolicy) AddAdmissionRule(rule AdmissionRule) error {
+	if check.IfNil(rule) {
+		return ErrNilAdmissionRule
+	}
+
+	policy.mutRules.RLock()
+	for _, existingRule := range policy.rules {
+		if existingRule.Name() == rule.Name() {
+			policy.mutRules.RUnlock()
+			return fmt.Errorf("%w: %s", ErrDuplicatedRuleName, rule.Name())
+		}
+	}
+	policy.mutRules.RUnlock()
+
+	policy.addRule(rule)
+
+	return nil
+}
+
+func (policy *txPoolPolicy) addRule(rule AdmissionRule) {
+	policy.mutRules.Lock()
+	policy.rules = append(policy.rules, rule)
+	policy.mutRules.Unlock()
+
+	policy.mutCounters.Lock()
+	policy.counters[rule.Name()] = 0
+	policy.mutCounters.Unlock()
+}
+
+// SetSenderEvictionPolicy replaces the sender eviction policy
+func (policy *txPoolPolicy) SetSenderEvictionPolicy(evictionPolicy SenderEvictionPolicy) error {
+	if check.IfNil(evictionPolicy) {
+		return ErrNilSenderEvictionPolicy
+	}
+
+	policy.setEvictionPolicy(evictionPolicy)
+
+	return nil
+}
+
+func (policy *txPoolPolicy) setEvictionPolicy(evictionPolicy SenderEvictionPolicy) {
+	policy.mutRules.Lock()
+	policy.evictionPolicy = evictionPolicy
+	policy.mutRules.Unlock()
+
+	policy.mutCounters.Lock()
+	policy.counters[evictionPolicy.Name()] = 0
+	policy.counters[evictionPolicy.Name()+rejectedCounterSuffix] = 0
+	policy.mutCounters.Unlock()
+}
+
+// CheckAdmission returns an error if one of the admission rules rejects the transaction
+func (policy *txPoolPolicy) CheckAdmission(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+	if policy.IsPinned(tx.Tx.GetSndAddr()) {
+		return nil
+	}
+
+	policy.mutRules.RLock()
+	defer policy.mutRules.RUnlock()
+
+	for _, rule := range policy.rules {
+		err := rule.CheckTransaction(tx, getSenderTxs)
+		if err != nil {
+			policy.increaseCounter(rule.Name(), 1)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelectTxsToEvict returns the hashes of the transactions, of the same sender as the provided one, that should be evicted
+// if the provided transaction is added. The sender transactions passed by the cache already contain the provided one.
+// If the provided transaction is selected, it is rejected by the cache and counted as rejected. The evicted transactions
+// are counted once the cache reports them through OnTxsEvicted
+func (policy *txPoolPolicy) SelectTxsToEvict(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) [][]byte {
+	evictionPolicy := policy.getEvictionPolicy()
+	if check.IfNil(evictionPolicy) {
+		return nil
+	}
+	if policy.IsPinned(tx.Tx.GetSndAddr()) {
+		return nil
+	}
+
+	txsToEvict := evictionPolicy.SelectTxsToEvict(tx, getSenderTxs())
+	for _, txHash := range txsToEvict {
+		if bytes.Equal(txHash, tx.TxHash) {
+			policy.increaseCounter(evictionPolicy.Name()+rejectedCounterSuffix, 1)
+			break
+		}
+	}
+
+	return txsToEvict
+}
+
+// OnTxsEvicted counts the transactions removed by the cache after being selected by the eviction policy
+func (policy *txPoolPolicy) OnTxsEvicted(numTxs int) {
+	evictionPolicy := policy.getEvictionPolicy()
+	if check.IfNil(evictionPolicy) || numTxs <= 0 {
+		return
+	}
+
+	policy.increaseCounter(evictionPolicy.Name(), uint64(numTxs))
+}
+
+func (policy *txPoolPolicy) getEvictionPolicy() SenderEvictionPolicy {
+	policy.mutRules.RLock()
+	defer policy.mutRules.RUnlock()
+
+	return policy.evictionPolicy
+}
+
+func (policy *txPoolPolicy) increaseCounter(name string, value uint64) {
+	policy.mutCounters.Lock()
+	policy.counters[name] += value
+	policy.mutCounters.Unlock()
+}
+
+// IsPinned returns true if the sender is pinned
+func (policy *txPoolPolicy) IsPinned(sender []byte) bool {
+	_, isPinned := policy.pinnedSenders[string(sender)]
+	return isPinned
+}
+
+// GetCounters returns, for each rule and eviction policy, the number of rejected and evicted transactions
+func (policy *txPoolPolicy) GetCounters() map[string]uint64 {
+	policy.mutCounters.RLock()
+	defer policy.mutCounters.RUnlock()
+
+	counters := make(map[string]uint64, len(policy.counters))
+	for name, value := range policy.counters {
+		counters[name] = value
+	}
+
+	return counters
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *txPoolPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
+
+func decodeAddresses(addresses []string, converter core.PubkeyConverter) (map[string]struct{}, error) {
+	decoded := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		buff, err := converter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", ErrInvalidAddress, address, err.Error())
+		}
+
+		decoded[string(buff)] = struct{}{}
+	}
+
+	return decoded, nil
+}
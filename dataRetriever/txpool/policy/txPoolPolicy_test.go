@@ -0,0 +1,328 @@
+package policy
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice   = []byte("alice")
+	bob     = []byte("bob")
+	relayer = []byte("relayer")
+)
+
+func createMockArgsTxPoolPolicy() ArgsTxPoolPolicy {
+	return ArgsTxPoolPolicy{
+		Config: config.TxPoolPolicyConfig{
+			Enabled:           true,
+			SenderLimitAction: SenderLimitActionReject,
+		},
+		AddressPubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func createWrappedTx(hash string, sender []byte, receiver []byte, nonce uint64, gasPrice uint64) *txcache.WrappedTransaction {
+	return &txcache.WrappedTransaction{
+		Tx: &transaction.Transaction{
+			Nonce:    nonce,
+			SndAddr:  sender,
+			RcvAddr:  receiver,
+			GasPrice: gasPrice,
+		},
+		TxHash: []byte(hash),
+	}
+}
+
+func senderTxsProvider(txs ...*txcache.WrappedTransaction) func() []*txcache.WrappedTransaction {
+	return func() []*txcache.WrappedTransaction {
+		return txs
+	}
+}
+
+func TestNewTxPoolPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.AddressPubkeyConverter = nil
+		policy, err := NewTxPoolPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.Equal(t, ErrNilAddressPubkeyConverter, err)
+	})
+	t.Run("invalid pinned sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.PinnedSenders = []string{"not hex"}
+		policy, err := NewTxPoolPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidAddress))
+	})
+	t.Run("invalid denied receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.DeniedReceivers = []string{"not hex"}
+		policy, err := NewTxPoolPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidAddress))
+	})
+	t.Run("invalid sender limit action should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.SenderLimitAction = "invalid"
+		policy, err := NewTxPoolPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidSenderLimitAction))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MinGasPrice = 10
+		args.Config.MaxPendingTxsPerSender = 2
+		args.Config.DeniedSenders = []string{hex.EncodeToString(bob)}
+		policy, err := NewTxPoolPolicy(args)
+		assert.False(t, check.IfNil(policy))
+		assert.Nil(t, err)
+
+		expectedCounters := map[string]uint64{
+			DeniedSendersRuleName:          0,
+			MinGasPriceRuleName:            0,
+			MaxPendingTxsPerSenderRuleName: 0,
+		}
+		assert.Equal(t, expectedCounters, policy.GetCounters())
+	})
+}
+
+func TestTxPoolPolicy_CheckAdmission(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no rules should accept everything", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewTxPoolPolicy(createMockArgsTxPoolPolicy())
+		assert.Nil(t, policy.CheckAdmission(createWrappedTx("h", alice, bob, 0, 1), senderTxsProvider()))
+	})
+	t.Run("min gas price", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MinGasPrice = 10
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Nil(t, policy.CheckAdmission(createWrappedTx("h1", alice, bob, 0, 10), senderTxsProvider()))
+		err := policy.CheckAdmission(createWrappedTx("h2", alice, bob, 1, 9), senderTxsProvider())
+		assert.True(t, errors.Is(err, ErrTxRejectedByPolicy))
+		assert.Equal(t, uint64(1), policy.GetCounters()[MinGasPriceRuleName])
+	})
+	t.Run("max pending txs per sender", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 2
+		policy, _ := NewTxPoolPolicy(args)
+
+		tx0 := createWrappedTx("h0", alice, bob, 0, 1)
+		tx1 := createWrappedTx("h1", alice, bob, 1, 1)
+		tx2 := createWrappedTx("h2", alice, bob, 2, 1)
+		assert.Nil(t, policy.CheckAdmission(tx1, senderTxsProvider(tx0)))
+		// already known transactions are not rejected
+		assert.Nil(t, policy.CheckAdmission(tx1, senderTxsProvider(tx0, tx1)))
+
+		err := policy.CheckAdmission(tx2, senderTxsProvider(tx0, tx1))
+		assert.True(t, errors.Is(err, ErrTxRejectedByPolicy))
+		assert.Equal(t, uint64(1), policy.GetCounters()[MaxPendingTxsPerSenderRuleName])
+	})
+	t.Run("sender provider should not be called if not needed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MinGasPrice = 10
+		policy, _ := NewTxPoolPolicy(args)
+
+		err := policy.CheckAdmission(createWrappedTx("h", alice, bob, 0, 10), func() []*txcache.WrappedTransaction {
+			require.Fail(t, "should not have been called")
+			return nil
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("denied senders and receivers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.DeniedSenders = []string{hex.EncodeToString(bob)}
+		args.Config.DeniedReceivers = []string{hex.EncodeToString(relayer)}
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Nil(t, policy.CheckAdmission(createWrappedTx("h1", alice, bob, 0, 1), senderTxsProvider()))
+		assert.True(t, errors.Is(policy.CheckAdmission(createWrappedTx("h2", bob, alice, 0, 1), senderTxsProvider()), ErrTxRejectedByPolicy))
+		assert.True(t, errors.Is(policy.CheckAdmission(createWrappedTx("h3", alice, relayer, 0, 1), senderTxsProvider()), ErrTxRejectedByPolicy))
+
+		counters := policy.GetCounters()
+		assert.Equal(t, uint64(1), counters[DeniedSendersRuleName])
+		assert.Equal(t, uint64(1), counters[DeniedReceiversRuleName])
+	})
+	t.Run("allowed senders and receivers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.AllowedSenders = []string{hex.EncodeToString(alice)}
+		args.Config.AllowedReceivers = []string{hex.EncodeToString(bob)}
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Nil(t, policy.CheckAdmission(createWrappedTx("h1", alice, bob, 0, 1), senderTxsProvider()))
+		assert.True(t, errors.Is(policy.CheckAdmission(createWrappedTx("h2", bob, bob, 0, 1), senderTxsProvider()), ErrTxRejectedByPolicy))
+		assert.True(t, errors.Is(policy.CheckAdmission(createWrappedTx("h3", alice, alice, 0, 1), senderTxsProvider()), ErrTxRejectedByPolicy))
+
+		counters := policy.GetCounters()
+		assert.Equal(t, uint64(1), counters[AllowedSendersRuleName])
+		assert.Equal(t, uint64(1), counters[AllowedReceiversRuleName])
+	})
+	t.Run("pinned senders bypass the rules", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MinGasPrice = 10
+		args.Config.AllowedSenders = []string{hex.EncodeToString(alice)}
+		args.Config.PinnedSenders = []string{hex.EncodeToString(relayer)}
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.True(t, policy.IsPinned(relayer))
+		assert.False(t, policy.IsPinned(alice))
+		assert.Nil(t, policy.CheckAdmission(createWrappedTx("h", relayer, bob, 0, 1), senderTxsProvider()))
+	})
+}
+
+func TestTxPoolPolicy_AddAdmissionRule(t *testing.T) {
+	t.Parallel()
+
+	policy, _ := NewTxPoolPolicy(createMockArgsTxPoolPolicy())
+	assert.Equal(t, ErrNilAdmissionRule, policy.AddAdmissionRule(nil))
+
+	rule := &minGasPriceRule{minGasPrice: 10}
+	assert.Nil(t, policy.AddAdmissionRule(rule))
+	assert.True(t, errors.Is(policy.AddAdmissionRule(rule), ErrDuplicatedRuleName))
+
+	err := policy.CheckAdmission(createWrappedTx("h", alice, bob, 0, 1), senderTxsProvider())
+	assert.True(t, errors.Is(err, ErrTxRejectedByPolicy))
+	assert.Equal(t, uint64(1), policy.GetCounters()[MinGasPriceRuleName])
+}
+
+func TestTxPoolPolicy_SelectTxsToEvict(t *testing.T) {
+	t.Parallel()
+
+	tx0 := createWrappedTx("h0", alice, bob, 0, 3)
+	tx1 := createWrappedTx("h1", alice, bob, 1, 1)
+	tx2 := createWrappedTx("h2", alice, bob, 2, 2)
+
+	t.Run("no eviction policy should not evict", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 2
+		policy, _ := NewTxPoolPolicy(args)
+		assert.Nil(t, policy.SelectTxsToEvict(tx2, senderTxsProvider(tx0, tx1, tx2)))
+	})
+	t.Run("evict highest nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 2
+		args.Config.SenderLimitAction = SenderLimitActionEvictHighestNonce
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Nil(t, policy.CheckAdmission(tx2, senderTxsProvider(tx0, tx1)))
+		assert.Nil(t, policy.SelectTxsToEvict(tx1, senderTxsProvider(tx0, tx1)))
+		assert.Equal(t, [][]byte{tx2.TxHash}, policy.SelectTxsToEvict(tx0, senderTxsProvider(tx2, tx1, tx0)))
+		assert.Equal(t, uint64(0), policy.GetCounters()[SenderLimitActionEvictHighestNonce])
+
+		policy.OnTxsEvicted(1)
+		assert.Equal(t, uint64(1), policy.GetCounters()[SenderLimitActionEvictHighestNonce])
+		assert.Equal(t, uint64(0), policy.GetCounters()[SenderLimitActionEvictHighestNonce+rejectedCounterSuffix])
+	})
+	t.Run("evict highest nonce should reject the new transaction with the highest nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 2
+		args.Config.SenderLimitAction = SenderLimitActionEvictHighestNonce
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Equal(t, [][]byte{tx2.TxHash}, policy.SelectTxsToEvict(tx2, senderTxsProvider(tx0, tx1, tx2)))
+		assert.Equal(t, uint64(0), policy.GetCounters()[SenderLimitActionEvictHighestNonce])
+		assert.Equal(t, uint64(1), policy.GetCounters()[SenderLimitActionEvictHighestNonce+rejectedCounterSuffix])
+	})
+	t.Run("evict lowest gas price should evict the highest nonces paying less", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 1
+		args.Config.SenderLimitAction = SenderLimitActionEvictLowestGasPrice
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Equal(t, [][]byte{tx1.TxHash, tx2.TxHash}, policy.SelectTxsToEvict(tx0, senderTxsProvider(tx0, tx1, tx2)))
+		assert.Equal(t, uint64(0), policy.GetCounters()[SenderLimitActionEvictLowestGasPrice+rejectedCounterSuffix])
+	})
+	t.Run("evict lowest gas price should not evict the cheapest transaction in the middle of the nonces", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 2
+		args.Config.SenderLimitAction = SenderLimitActionEvictLowestGasPrice
+		policy, _ := NewTxPoolPolicy(args)
+
+		// tx1 has the lowest gas price but evicting it would leave a nonce gap before tx2
+		assert.Equal(t, [][]byte{tx2.TxHash}, policy.SelectTxsToEvict(tx0, senderTxsProvider(tx0, tx1, tx2)))
+		assert.Equal(t, uint64(0), policy.GetCounters()[SenderLimitActionEvictLowestGasPrice+rejectedCounterSuffix])
+
+		// the new transaction does not pay more than the one with the highest nonce
+		tx0WithLowerGasPrice := createWrappedTx("h0-low", alice, bob, 0, 2)
+		assert.Equal(t, [][]byte{tx0WithLowerGasPrice.TxHash}, policy.SelectTxsToEvict(tx0WithLowerGasPrice, senderTxsProvider(tx0WithLowerGasPrice, tx1, tx2)))
+		assert.Equal(t, uint64(1), policy.GetCounters()[SenderLimitActionEvictLowestGasPrice+rejectedCounterSuffix])
+	})
+	t.Run("evict lowest gas price should reject the new transaction not paying more", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 2
+		args.Config.SenderLimitAction = SenderLimitActionEvictLowestGasPrice
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Equal(t, [][]byte{tx1.TxHash}, policy.SelectTxsToEvict(tx1, senderTxsProvider(tx0, tx1, tx2)))
+		assert.Equal(t, uint64(0), policy.GetCounters()[SenderLimitActionEvictLowestGasPrice])
+		assert.Equal(t, uint64(1), policy.GetCounters()[SenderLimitActionEvictLowestGasPrice+rejectedCounterSuffix])
+	})
+	t.Run("pinned senders are not evicted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolPolicy()
+		args.Config.MaxPendingTxsPerSender = 1
+		args.Config.SenderLimitAction = SenderLimitActionEvictHighestNonce
+		args.Config.PinnedSenders = []string{hex.EncodeToString(alice)}
+		policy, _ := NewTxPoolPolicy(args)
+
+		assert.Nil(t, policy.SelectTxsToEvict(tx2, senderTxsProvider(tx0, tx1, tx2)))
+	})
+	t.Run("custom eviction policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewTxPoolPolicy(createMockArgsTxPoolPolicy())
+		assert.Equal(t, ErrNilSenderEvictionPolicy, policy.SetSenderEvictionPolicy(nil))
+		assert.Nil(t, policy.SetSenderEvictionPolicy(newEvictHighestNoncePolicy(2)))
+
+		assert.Equal(t, [][]byte{tx2.TxHash}, policy.SelectTxsToEvict(tx2, senderTxsProvider(tx0, tx1, tx2)))
+	})
+}
@@ -5,8 +5,10 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/counting"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -24,10 +26,15 @@ type shardedTxPool struct {
 	backingMap                   map[string]*txPoolShard
 	mutexAddCallbacks            sync.RWMutex
 	onAddCallbacks               []func(key []byte, value interface{})
+	mutexRemoveCallbacks         sync.RWMutex
+	onRemoveCallbacks            []func(key []byte, value interface{})
+	onEvictCallbacks             []func(key []byte, value interface{})
 	configPrototypeDestinationMe txcache.ConfigDestinationMe
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	policy                       TxPoolPolicyHandler
+	replacement                  config.TxPoolReplacementConfig
 }
 
 type txPoolShard struct {
@@ -74,10 +81,15 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		backingMap:                   make(map[string]*txPoolShard),
 		mutexAddCallbacks:            sync.RWMutex{},
 		onAddCallbacks:               make([]func(key []byte, value interface{}), 0),
+		mutexRemoveCallbacks:         sync.RWMutex{},
+		onRemoveCallbacks:            make([]func(key []byte, value interface{}), 0),
+		onEvictCallbacks:             make([]func(key []byte, value interface{}), 0),
 		configPrototypeDestinationMe: configPrototypeDestinationMe,
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		policy:                       args.Policy,
+		replacement:                  args.Replacement,
 	}
 
 	return shardedTxPoolObject, nil
@@ -134,7 +146,14 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 	if isForSenderMe {
 		config := txPool.configPrototypeSourceMe
 		config.Name = cacheID
-		cache, err := txcache.NewTxCache(config, txPool.txGasHandler)
+		cache, err := txcache.NewGuardedTxCache(txcache.ArgsGuardedTxCache{
+			Config:                    config,
+			TxGasHandler:              txPool.txGasHandler,
+			ReplacementEnabled:        txPool.replacement.Enabled,
+			MinGasPriceBumpPercentage: txPool.replacement.MinGasPriceBumpPercentage,
+			EvictionHandler:           txPool.onTxEvicted,
+			IsPinnedSender:            txPool.policy.IsPinned,
+		})
 		if err != nil {
 			log.Error("shardedTxPool.createTxCache()", "err", err)
 			return txcache.NewDisabledCache()
@@ -160,28 +179,73 @@ func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cac
 	shard.Cache.ImmunizeTxsAgainstEviction(keys)
 }
 
-// AddData adds the transaction to the cache
+// AddData adds the transaction to the cache. The admission rules and the sender eviction policy are not applied, as the
+// transactions added this way were either requested by the node or are referenced by blocks. The transactions of the
+// pinned senders are immunized against eviction
 func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes int, cacheID string) {
-	valueAsTransaction, ok := value.(data.TransactionHandler)
+	wrapper, ok := txPool.wrapTx(key, value, sizeInBytes, cacheID)
 	if !ok {
 		return
 	}
 
+	txPool.addTx(wrapper, cacheID)
+
+	if txPool.policy.IsPinned(wrapper.Tx.GetSndAddr()) {
+		txPool.ImmunizeSetOfDataAgainstEviction([][]byte{key}, cacheID)
+	}
+}
+
+// AddUnsolicitedData adds a transaction received from the network without being requested. The pool policy is applied
+// if the transaction is sent from the own shard
+func (txPool *shardedTxPool) AddUnsolicitedData(key []byte, value interface{}, sizeInBytes int, cacheID string) {
+	wrapper, ok := txPool.wrapTx(key, value, sizeInBytes, cacheID)
+	if !ok {
+		return
+	}
+
+	txPool.addTxWithPolicy(wrapper, cacheID)
+}
+
+func (txPool *shardedTxPool) wrapTx(key []byte, value interface{}, sizeInBytes int, cacheID string) (*txcache.WrappedTransaction, bool) {
+	valueAsTransaction, ok := value.(data.TransactionHandler)
+	if !ok {
+		return nil, false
+	}
+
 	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
 	if err != nil {
-		log.Error("shardedTxPool.AddData()", "err", err)
-		return
+		log.Error("shardedTxPool.wrapTx()", "err", err)
+		return nil, false
 	}
 
-	wrapper := &txcache.WrappedTransaction{
+	return &txcache.WrappedTransaction{
 		Tx:              valueAsTransaction,
 		TxHash:          key,
 		SenderShardID:   sourceShardID,
 		ReceiverShardID: destinationShardID,
 		Size:            int64(sizeInBytes),
+	}, true
+}
+
+// addTxWithPolicy adds the transaction to the cache if it is accepted by the admission rules, then applies the sender
+// eviction policy. The pending transactions replaced by the added transaction are removed from the cache. The checks, the
+// insertion and the replacement are done by the cache, under the lock of the sender, which notifies the evicted transactions
+func (txPool *shardedTxPool) addTxWithPolicy(tx *txcache.WrappedTransaction, cacheID string) {
+	shard := txPool.getOrCreateShard(cacheID)
+	cache, ok := shard.Cache.(guardedTxCache)
+	if !ok {
+		txPool.addTx(tx, cacheID)
+		return
 	}
 
-	txPool.addTx(wrapper, cacheID)
+	added, err := cache.AddTxWithPolicy(tx, txPool.policy)
+	if err != nil {
+		log.Trace("shardedTxPool.addTxWithPolicy()", "name", cacheID, "tx", tx.TxHash, "err", err)
+		return
+	}
+	if added {
+		txPool.onAdded(tx.TxHash, tx)
+	}
 }
 
 // addTx adds the transaction to the cache
@@ -203,6 +267,31 @@ func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	}
 }
 
+func (txPool *shardedTxPool) onRemoved(key []byte, value interface{}) {
+	txPool.mutexRemoveCallbacks.RLock()
+	defer txPool.mutexRemoveCallbacks.RUnlock()
+
+	for _, handler := range txPool.onRemoveCallbacks {
+		handler(key, value)
+	}
+}
+
+func (txPool *shardedTxPool) onTxEvicted(tx *txcache.WrappedTransaction) {
+	txPool.mutexRemoveCallbacks.RLock()
+	defer txPool.mutexRemoveCallbacks.RUnlock()
+
+	for _, handler := range txPool.onEvictCallbacks {
+		handler(tx.TxHash, tx)
+	}
+}
+
+func (txPool *shardedTxPool) hasRemoveCallbacks() bool {
+	txPool.mutexRemoveCallbacks.RLock()
+	defer txPool.mutexRemoveCallbacks.RUnlock()
+
+	return len(txPool.onRemoveCallbacks) > 0
+}
+
 // SearchFirstData searches the transaction against all shard data store, retrieving the first found
 func (txPool *shardedTxPool) SearchFirstData(key []byte) (interface{}, bool) {
 	tx, ok := txPool.searchFirstTx(key)
@@ -234,7 +323,27 @@ func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 // removeTx removes the transaction from the pool
 func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string) bool {
 	shard := txPool.getOrCreateShard(cacheID)
-	return shard.Cache.RemoveTxByHash(txHash)
+	return txPool.removeTxFromCache(shard.Cache, txHash)
+}
+
+// removeTxFromCache removes the transaction from the provided cache and notifies the removal. The transaction is fetched,
+// in order to be passed to the handlers, only if there are handlers registered
+func (txPool *shardedTxPool) removeTxFromCache(cache txCache, txHash []byte) bool {
+	if !txPool.hasRemoveCallbacks() {
+		return cache.RemoveTxByHash(txHash)
+	}
+
+	tx, found := cache.GetByTxHash(txHash)
+	if !found {
+		return false
+	}
+
+	removed := cache.RemoveTxByHash(txHash)
+	if removed {
+		txPool.onRemoved(txHash, tx)
+	}
+
+	return removed
 }
 
 // RemoveSetOfDataFromPool removes a bunch of transactions from the pool
@@ -265,8 +374,7 @@ func (txPool *shardedTxPool) removeTxFromAllShards(txHash []byte) {
 	defer txPool.mutexBackingMap.RUnlock()
 
 	for _, shard := range txPool.backingMap {
-		cache := shard.Cache
-		_ = cache.RemoveTxByHash(txHash)
+		_ = txPool.removeTxFromCache(shard.Cache, txHash)
 	}
 }
 
@@ -312,6 +420,33 @@ func (txPool *shardedTxPool) RegisterOnAdded(handler func(key []byte, value inte
 	txPool.mutexAddCallbacks.Unlock()
 }
 
+// RegisterOnRemoved registers a new handler to be called when a transaction is removed from the pool
+func (txPool *shardedTxPool) RegisterOnRemoved(handler func(key []byte, value interface{})) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler")
+		return
+	}
+
+	txPool.mutexRemoveCallbacks.Lock()
+	txPool.onRemoveCallbacks = append(txPool.onRemoveCallbacks, handler)
+	txPool.mutexRemoveCallbacks.Unlock()
+}
+
+// RegisterOnEvicted registers a new handler to be called when a transaction is evicted by the pool policy or replaced by
+// a transaction having the same sender and nonce and a higher gas price, or evicted from the intra-shard cache because of
+// the sender limits or of the cache capacity. The evictions done by the cross-shard caches and the senders swept after a
+// selection are not notified
+func (txPool *shardedTxPool) RegisterOnEvicted(handler func(key []byte, value interface{})) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler")
+		return
+	}
+
+	txPool.mutexRemoveCallbacks.Lock()
+	txPool.onEvictCallbacks = append(txPool.onEvictCallbacks, handler)
+	txPool.mutexRemoveCallbacks.Unlock()
+}
+
 // GetCounts returns the total number of transactions in the pool
 func (txPool *shardedTxPool) GetCounts() counting.CountsWithSize {
 	txPool.mutexBackingMap.RLock()
@@ -353,6 +488,42 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// GetPolicyCounters returns, for each admission rule and sender eviction policy, the number of rejected and evicted transactions
+func (txPool *shardedTxPool) GetPolicyCounters() map[string]uint64 {
+	return txPool.policy.GetCounters()
+}
+
+// GetTxsReplacedBy returns the hashes of the pending transactions which would be replaced if the provided transaction was
+// added in the pool. Only the transactions sent from the own shard are replaced. The result is only a prediction, as the
+// pending transactions can change until the transaction is received
+func (txPool *shardedTxPool) GetTxsReplacedBy(txHash []byte, tx data.TransactionHandler) ([][]byte, error) {
+	if check.IfNil(tx) {
+		return nil, dataRetriever.ErrNilValue
+	}
+
+	cacheID := strconv.Itoa(int(txPool.selfShardID))
+	cache, ok := txPool.getTxCache(cacheID).(guardedTxCache)
+	if !ok {
+		return make([][]byte, 0), nil
+	}
+
+	wrapper := &txcache.WrappedTransaction{
+		Tx:     tx,
+		TxHash: txHash,
+	}
+	txsToReplace, err := cache.GetTxsReplacedBy(wrapper)
+	if err != nil {
+		return nil, err
+	}
+
+	txsHashes := make([][]byte, 0, len(txsToReplace))
+	for _, replacedTx := range txsToReplace {
+		txsHashes = append(txsHashes, replacedTx.TxHash)
+	}
+
+	return txsHashes, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
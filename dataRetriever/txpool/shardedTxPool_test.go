@@ -1,7 +1,9 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -10,8 +12,11 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/policy/disabled"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -38,6 +43,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 100,
 		},
+		Policy:         disabled.NewDisabledTxPoolPolicy(),
 		NumberOfShards: 1,
 	}
 
@@ -94,6 +100,23 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidEconomics.Error())
 
+	args = goodArgs
+	args.Policy = nil
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.NotNil(t, err)
+	require.Errorf(t, err, dataRetriever.ErrNilTxPoolPolicy.Error())
+
+	args = goodArgs
+	args.Replacement = config.TxPoolReplacementConfig{
+		Enabled:                   true,
+		MinGasPriceBumpPercentage: 0,
+	}
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.NotNil(t, err)
+	require.Errorf(t, err, dataRetriever.ErrInvalidTxReplacementGasPriceBump.Error())
+
 	args = goodArgs
 	args.NumberOfShards = 0
 	pool, err = NewShardedTxPool(args)
@@ -111,6 +134,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 			MinimumGasPrice:      1000000000,
 			GasProcessingDivisor: 1,
 		},
+		Policy:         disabled.NewDisabledTxPoolPolicy(),
 		NumberOfShards: 2,
 	}
 
@@ -214,6 +238,357 @@ func Test_AddData_CallsOnAddedHandlers(t *testing.T) {
 	require.Equal(t, uint32(1), atomic.LoadUint32(&numAdded))
 }
 
+func Test_AddUnsolicitedData_RejectedByPolicy(t *testing.T) {
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+			if string(tx.Tx.GetSndAddr()) == "bob" {
+				return errors.New("rejected")
+			}
+
+			return nil
+		},
+	})
+	cache := pool.getTxCache("0")
+
+	numAdded := uint32(0)
+	pool.RegisterOnAdded(func(key []byte, value interface{}) {
+		atomic.AddUint32(&numAdded, 1)
+	})
+
+	pool.AddUnsolicitedData([]byte("hash-alice"), createTx("alice", 42), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-bob"), createTx("bob", 42), 0, "0")
+
+	waitABit()
+	require.Equal(t, 1, cache.Len())
+	require.Equal(t, uint32(1), atomic.LoadUint32(&numAdded))
+	_, ok := cache.GetByTxHash([]byte("hash-bob"))
+	require.False(t, ok)
+}
+
+func Test_AddUnsolicitedData_EvictedByPolicy(t *testing.T) {
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		SelectTxsToEvictCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) [][]byte {
+			senderTxs := getSenderTxs()
+			if len(senderTxs) <= 1 {
+				return nil
+			}
+
+			// keep the lowest nonce
+			txsToEvict := make([][]byte, 0)
+			for _, senderTx := range senderTxs[1:] {
+				txsToEvict = append(txsToEvict, senderTx.TxHash)
+			}
+
+			return txsToEvict
+		},
+	})
+	cache := pool.getTxCache("0")
+
+	numAdded := uint32(0)
+	pool.RegisterOnAdded(func(key []byte, value interface{}) {
+		atomic.AddUint32(&numAdded, 1)
+	})
+	evicted := make([]string, 0)
+	pool.RegisterOnEvicted(func(key []byte, value interface{}) {
+		evicted = append(evicted, string(key))
+	})
+
+	pool.AddUnsolicitedData([]byte("hash-43"), createTx("alice", 43), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-44"), createTx("alice", 44), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-42"), createTx("alice", 42), 0, "0")
+
+	waitABit()
+	require.Equal(t, 1, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-42"))
+	require.True(t, ok)
+	// the transaction with nonce 44 was selected for eviction before its addition, so it was neither added nor evicted
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numAdded))
+	require.Equal(t, []string{"hash-43"}, evicted)
+}
+
+func Test_AddData_IgnoresPolicy(t *testing.T) {
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+			return errors.New("rejected")
+		},
+	})
+
+	// requested or block referenced transactions are always accepted
+	pool.AddData([]byte("hash-alice"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-bob"), createTx("bob", 42), 0, "1_0")
+
+	require.Equal(t, 1, pool.getTxCache("0").Len())
+	require.Equal(t, 1, pool.getTxCache("1_0").Len())
+}
+
+func Test_AddUnsolicitedData_CrossShardIgnoresPolicy(t *testing.T) {
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+			return errors.New("rejected")
+		},
+	})
+
+	pool.AddUnsolicitedData([]byte("hash-alice"), createTx("alice", 42), 0, "1_0")
+
+	require.Equal(t, 1, pool.getTxCache("1_0").Len())
+}
+
+func Test_AddUnsolicitedData_SenderLimitIsEnforcedConcurrently(t *testing.T) {
+	maxPendingTxs := 3
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+			numPendingTxs := len(getSenderTxs())
+			// give the other goroutines the chance to check the same sender before this transaction is added
+			time.Sleep(time.Millisecond)
+			if numPendingTxs >= maxPendingTxs {
+				return errors.New("too many txs for sender")
+			}
+
+			return nil
+		},
+	})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(nonce int) {
+			defer wg.Done()
+
+			pool.AddUnsolicitedData([]byte(fmt.Sprintf("hash-%d", nonce)), createTx("alice", uint64(nonce)), 0, "0")
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, maxPendingTxs, pool.getTxCache("0").Len())
+}
+
+func Test_AddData_PinnedSenderIsImmunized(t *testing.T) {
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		IsPinnedCalled: func(sender []byte) bool {
+			return string(sender) == "relayer"
+		},
+	})
+
+	pool.AddData([]byte("hash-relayer"), createTx("relayer", 42), 0, "1_0")
+	pool.AddData([]byte("hash-alice"), createTx("alice", 42), 0, "1_0")
+
+	cache := pool.getTxCache("1_0").(*txcache.CrossTxCache)
+	require.Equal(t, 2, cache.Len())
+	require.Equal(t, 1, cache.CountImmune())
+}
+
+func Test_MergeShardStores_IgnoresPolicy(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "1_0")
+
+	pool.policy = &txcachemocks.TxPoolPolicyStub{
+		CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+			return errors.New("rejected")
+		},
+	}
+	pool.MergeShardStores("1_0", "0")
+
+	_, ok := pool.getTxCache("0").GetByTxHash([]byte("hash-x"))
+	require.True(t, ok)
+}
+
+func Test_GetPolicyCounters(t *testing.T) {
+	counters := map[string]uint64{"rule": 7}
+	pool := newTxPoolWithPolicyToTest(&txcachemocks.TxPoolPolicyStub{
+		GetCountersCalled: func() map[string]uint64 {
+			return counters
+		},
+	})
+
+	require.Equal(t, counters, pool.GetPolicyCounters())
+}
+
+func Test_AddUnsolicitedData_ReplacementDisabledKeepsBothTxs(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	cache := pool.getTxCache("0")
+
+	pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000), 0, "0")
+
+	require.Equal(t, 2, cache.Len())
+}
+
+func Test_AddUnsolicitedData_ReplacesTxWithHigherGasPrice(t *testing.T) {
+	pool := newTxPoolWithReplacementToTest(10)
+	cache := pool.getTxCache("0")
+
+	numAdded := uint32(0)
+	pool.RegisterOnAdded(func(key []byte, value interface{}) {
+		atomic.AddUint32(&numAdded, 1)
+	})
+	evicted := make([]string, 0)
+	pool.RegisterOnEvicted(func(key []byte, value interface{}) {
+		evicted = append(evicted, string(key))
+	})
+
+	pool.AddUnsolicitedData([]byte("hash-41"), createTxWithGasPrice("alice", 41, 1000), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 1100), 0, "0")
+
+	waitABit()
+	require.Equal(t, 2, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-low"))
+	require.False(t, ok)
+	_, ok = cache.GetByTxHash([]byte("hash-high"))
+	require.True(t, ok)
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numAdded))
+	require.Equal(t, []string{"hash-low"}, evicted)
+
+	selectedTxs := cache.(*txcache.GuardedTxCache).SelectTransactionsWithBandwidth(10, 10, math.MaxUint64)
+	selectedTxsHashes := make([]string, 0, len(selectedTxs))
+	for _, selectedTx := range selectedTxs {
+		selectedTxsHashes = append(selectedTxsHashes, string(selectedTx.TxHash))
+	}
+	require.Equal(t, []string{"hash-41", "hash-high"}, selectedTxsHashes)
+}
+
+func Test_AddUnsolicitedData_ReplacementUnderpricedIsRejected(t *testing.T) {
+	pool := newTxPoolWithReplacementToTest(10)
+	cache := pool.getTxCache("0")
+
+	pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-same"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-higher"), createTxWithGasPrice("alice", 42, 1099), 0, "0")
+	pool.AddUnsolicitedData([]byte("hash-bob"), createTxWithGasPrice("bob", 42, 1000), 0, "0")
+
+	require.Equal(t, 2, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-low"))
+	require.True(t, ok)
+	_, ok = cache.GetByTxHash([]byte("hash-bob"))
+	require.True(t, ok)
+}
+
+func Test_AddData_IsNeverRejectedByReplacement(t *testing.T) {
+	pool := newTxPoolWithReplacementToTest(10)
+	cache := pool.getTxCache("0")
+
+	pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000), 0, "0")
+	// the original transaction, requested by the node (e.g. referenced by a proposed block), must be accepted
+	pool.AddData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+
+	require.Equal(t, 2, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-low"))
+	require.True(t, ok)
+}
+
+func Test_AddUnsolicitedData_ReplacementIgnoresCrossShardTxs(t *testing.T) {
+	pool := newTxPoolWithReplacementToTest(10)
+	cache := pool.getTxCache("1_0")
+
+	pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "1_0")
+	pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000), 0, "1_0")
+	pool.AddUnsolicitedData([]byte("hash-lower"), createTxWithGasPrice("alice", 42, 500), 0, "1_0")
+
+	require.Equal(t, 3, cache.Len())
+}
+
+func Test_AddUnsolicitedData_ReplacementWithPolicy(t *testing.T) {
+	t.Run("replaced txs are not seen by the policy", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+		pool.policy = &txcachemocks.TxPoolPolicyStub{
+			CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+				if len(getSenderTxs()) >= 1 {
+					return errors.New("too many txs for sender")
+				}
+
+				return nil
+			},
+		}
+		cache := pool.getTxCache("0")
+
+		pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+		pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000), 0, "0")
+
+		require.Equal(t, 1, cache.Len())
+		_, ok := cache.GetByTxHash([]byte("hash-high"))
+		require.True(t, ok)
+	})
+	t.Run("rejected replacement keeps the pending tx", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+		cache := pool.getTxCache("0")
+
+		pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+		pool.policy = &txcachemocks.TxPoolPolicyStub{
+			CheckAdmissionCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+				return errors.New("rejected")
+			},
+		}
+		pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000), 0, "0")
+
+		require.Equal(t, 1, cache.Len())
+		_, ok := cache.GetByTxHash([]byte("hash-low"))
+		require.True(t, ok)
+	})
+	t.Run("evicted replacement keeps the pending tx", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+		cache := pool.getTxCache("0")
+
+		pool.AddUnsolicitedData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+		pool.policy = &txcachemocks.TxPoolPolicyStub{
+			SelectTxsToEvictCalled: func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) [][]byte {
+				return [][]byte{tx.TxHash}
+			},
+		}
+		pool.AddUnsolicitedData([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000), 0, "0")
+
+		require.Equal(t, 1, cache.Len())
+		_, ok := cache.GetByTxHash([]byte("hash-low"))
+		require.True(t, ok)
+	})
+}
+
+func Test_GetTxsReplacedBy(t *testing.T) {
+	t.Run("nil tx should error", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+
+		txsHashes, err := pool.GetTxsReplacedBy([]byte("hash"), nil)
+		require.Nil(t, txsHashes)
+		require.Equal(t, dataRetriever.ErrNilValue, err)
+	})
+	t.Run("replacement disabled should return empty", func(t *testing.T) {
+		poolAsInterface, _ := newTxPoolToTest()
+		pool := poolAsInterface.(*shardedTxPool)
+		pool.AddData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+
+		txsHashes, err := pool.GetTxsReplacedBy([]byte("hash-high"), createTxWithGasPrice("alice", 42, 2000))
+		require.Nil(t, err)
+		require.Empty(t, txsHashes)
+	})
+	t.Run("underpriced replacement should error", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+		pool.AddData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+
+		txsHashes, err := pool.GetTxsReplacedBy([]byte("hash-high"), createTxWithGasPrice("alice", 42, 1001))
+		require.Nil(t, txsHashes)
+		require.Equal(t, txcache.ErrTxReplacementUnderpriced, err)
+	})
+	t.Run("same tx should return empty", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+		pool.AddData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+
+		txsHashes, err := pool.GetTxsReplacedBy([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000))
+		require.Nil(t, err)
+		require.Empty(t, txsHashes)
+	})
+	t.Run("should work", func(t *testing.T) {
+		pool := newTxPoolWithReplacementToTest(10)
+		pool.AddData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 1000), 0, "0")
+		pool.AddData([]byte("hash-cross"), createTxWithGasPrice("alice", 42, 1000), 0, "1_0")
+
+		txsHashes, err := pool.GetTxsReplacedBy([]byte("hash-high"), createTxWithGasPrice("alice", 42, 1100))
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("hash-low")}, txsHashes)
+		require.Equal(t, 1, pool.getTxCache("0").Len())
+	})
+}
+
 func Test_SearchFirstData(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -278,6 +653,56 @@ func Test_RemoveDataFromAllShards(t *testing.T) {
 	require.Zero(t, pool.getTxCache("1").Len())
 }
 
+func Test_RemoveData_CallsOnRemovedHandlers(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	removed := make([]string, 0)
+	pool.RegisterOnRemoved(func(key []byte, value interface{}) {
+		wrappedTx, ok := value.(*txcache.WrappedTransaction)
+		require.True(t, ok)
+		require.Equal(t, key, wrappedTx.TxHash)
+
+		removed = append(removed, string(key))
+	})
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("bob", 43), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("carol", 44), 0, "1")
+
+	pool.RemoveData([]byte("hash-x"), "0")
+	pool.RemoveData([]byte("hash-missing"), "0")
+	pool.RemoveSetOfDataFromPool([][]byte{[]byte("hash-y")}, "0")
+	pool.RemoveDataFromAllShards([]byte("hash-z"))
+
+	require.Equal(t, []string{"hash-x", "hash-y", "hash-z"}, removed)
+}
+
+func Test_AddData_SenderLimitEvictionCallsOnEvictedHandlers(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	evicted := make([]string, 0)
+	pool.RegisterOnEvicted(func(key []byte, value interface{}) {
+		wrappedTx, ok := value.(*txcache.WrappedTransaction)
+		require.True(t, ok)
+		require.Equal(t, key, wrappedTx.TxHash)
+
+		evicted = append(evicted, string(key))
+	})
+
+	for nonce := uint64(2); nonce <= 11; nonce++ {
+		pool.AddData([]byte(fmt.Sprintf("hash-%d", nonce)), createTx("alice", nonce), 0, "0")
+	}
+	require.Empty(t, evicted)
+
+	// the sender limit is 10 transactions, so the one having the highest nonce is evicted
+	pool.AddData([]byte("hash-1"), createTx("alice", 1), 0, "0")
+
+	require.Equal(t, []string{"hash-11"}, evicted)
+	require.Equal(t, 10, pool.getTxCache("0").Len())
+}
+
 func Test_MergeShardStores(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -326,6 +751,19 @@ func Test_RegisterOnAdded(t *testing.T) {
 	require.Equal(t, 1, len(pool.onAddCallbacks))
 }
 
+func Test_RegisterOnRemovedAndOnEvicted(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.RegisterOnRemoved(func(key []byte, value interface{}) {})
+	pool.RegisterOnRemoved(nil)
+	require.Equal(t, 1, len(pool.onRemoveCallbacks))
+
+	pool.RegisterOnEvicted(func(key []byte, value interface{}) {})
+	pool.RegisterOnEvicted(nil)
+	require.Equal(t, 1, len(pool.onEvictCallbacks))
+}
+
 func Test_GetCounts(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -398,6 +836,7 @@ func Test_routeToCacheUnions(t *testing.T) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		Policy:         disabled.NewDisabledTxPoolPolicy(),
 		NumberOfShards: 4,
 		SelfShardID:    42,
 	}
@@ -419,6 +858,15 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		GasLimit: 50000,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -426,6 +874,25 @@ func waitABit() {
 type thisIsNotATransaction struct {
 }
 
+func newTxPoolWithPolicyToTest(policy TxPoolPolicyHandler) *shardedTxPool {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.policy = policy
+
+	return pool
+}
+
+func newTxPoolWithReplacementToTest(minGasPriceBumpPercentage uint32) *shardedTxPool {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.replacement = config.TxPoolReplacementConfig{
+		Enabled:                   true,
+		MinGasPriceBumpPercentage: minGasPriceBumpPercentage,
+	}
+
+	return pool
+}
+
 func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 	config := storageunit.CacheConfig{
 		Capacity:             100,
@@ -441,6 +908,7 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 			MinimumGasPrice:      200000000000,
 			GasProcessingDivisor: 100,
 		},
+		Policy:         disabled.NewDisabledTxPoolPolicy(),
 		NumberOfShards: 4,
 		SelfShardID:    0,
 	}
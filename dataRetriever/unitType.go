@@ -53,6 +53,8 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// AddressTransactionsUnit is the address transactions index storage unit identifier
+	AddressTransactionsUnit UnitType = 25
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -118,6 +120,8 @@ func (ut UnitType) String() string {
 		return "PeerAccountsCheckpointsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case AddressTransactionsUnit:
+		return "AddressTransactionsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -0,0 +1,1235 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: addressTransactions.proto
+
+package addressTransactions
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressTransaction is used to store a reference to a transaction in which an address was involved
+type AddressTransaction struct {
+	TxHash        []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	MiniblockType int32  `protobuf:"varint,2,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+	IsSender      bool   `protobuf:"varint,3,opt,name=IsSender,proto3" json:"IsSender,omitempty"`
+	IsReceiver    bool   `protobuf:"varint,4,opt,name=IsReceiver,proto3" json:"IsReceiver,omitempty"`
+	Epoch         uint32 `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Round         uint64 `protobuf:"varint,6,opt,name=Round,proto3" json:"Round,omitempty"`
+	HeaderNonce   uint64 `protobuf:"varint,7,opt,name=HeaderNonce,proto3" json:"HeaderNonce,omitempty"`
+	HeaderHash    []byte `protobuf:"bytes,8,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	Timestamp     uint64 `protobuf:"varint,9,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *AddressTransaction) Reset()      { *m = AddressTransaction{} }
+func (*AddressTransaction) ProtoMessage() {}
+func (*AddressTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{0}
+}
+func (m *AddressTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransaction.Merge(m, src)
+}
+func (m *AddressTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransaction proto.InternalMessageInfo
+
+func (m *AddressTransaction) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetIsSender() bool {
+	if m != nil {
+		return m.IsSender
+	}
+	return false
+}
+
+func (m *AddressTransaction) GetIsReceiver() bool {
+	if m != nil {
+		return m.IsReceiver
+	}
+	return false
+}
+
+func (m *AddressTransaction) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetHeaderNonce() uint64 {
+	if m != nil {
+		return m.HeaderNonce
+	}
+	return 0
+}
+
+func (m *AddressTransaction) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *AddressTransaction) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// AddressTransactionsCounter is used to store the number of indexed transactions of an address
+type AddressTransactionsCounter struct {
+	Address []byte `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Count   uint64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+}
+
+func (m *AddressTransactionsCounter) Reset()      { *m = AddressTransactionsCounter{} }
+func (*AddressTransactionsCounter) ProtoMessage() {}
+func (*AddressTransactionsCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{1}
+}
+func (m *AddressTransactionsCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransactionsCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransactionsCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransactionsCounter.Merge(m, src)
+}
+func (m *AddressTransactionsCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransactionsCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransactionsCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransactionsCounter proto.InternalMessageInfo
+
+func (m *AddressTransactionsCounter) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AddressTransactionsCounter) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// AddressTransactionsInBlock is used to store, for each address touched by a block, the counter before the block was recorded
+type AddressTransactionsInBlock struct {
+	Counters []*AddressTransactionsCounter `protobuf:"bytes,1,rep,name=Counters,proto3" json:"Counters,omitempty"`
+}
+
+func (m *AddressTransactionsInBlock) Reset()      { *m = AddressTransactionsInBlock{} }
+func (*AddressTransactionsInBlock) ProtoMessage() {}
+func (*AddressTransactionsInBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4213e982049533d, []int{2}
+}
+func (m *AddressTransactionsInBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressTransactionsInBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressTransactionsInBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressTransactionsInBlock.Merge(m, src)
+}
+func (m *AddressTransactionsInBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressTransactionsInBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressTransactionsInBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressTransactionsInBlock proto.InternalMessageInfo
+
+func (m *AddressTransactionsInBlock) GetCounters() []*AddressTransactionsCounter {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AddressTransaction)(nil), "proto.AddressTransaction")
+	proto.RegisterType((*AddressTransactionsCounter)(nil), "proto.AddressTransactionsCounter")
+	proto.RegisterType((*AddressTransactionsInBlock)(nil), "proto.AddressTransactionsInBlock")
+}
+
+func init() { proto.RegisterFile("addressTransactions.proto", fileDescriptor_f4213e982049533d) }
+
+var fileDescriptor_f4213e982049533d = []byte{
+	// 391 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x3f, 0x6f, 0xe2, 0x30,
+	0x18, 0xc6, 0x63, 0x20, 0x10, 0xcc, 0xb1, 0xf8, 0x4e, 0x27, 0x1f, 0x3a, 0x59, 0x39, 0x74, 0x43,
+	0x96, 0x03, 0xe9, 0x6e, 0xbe, 0xa1, 0x54, 0x95, 0x40, 0x2a, 0x1d, 0xdc, 0x4c, 0xed, 0x94, 0x3f,
+	0x2e, 0x44, 0x2d, 0x76, 0x14, 0x27, 0x55, 0xbb, 0xf5, 0x23, 0x74, 0xee, 0x27, 0xe8, 0x47, 0xe9,
+	0xc8, 0xc8, 0x58, 0xcc, 0xd2, 0x91, 0x8f, 0x50, 0xc5, 0x49, 0x29, 0x15, 0x74, 0x4a, 0x7e, 0xcf,
+	0x9b, 0xf7, 0xf1, 0xfb, 0x3e, 0x31, 0xfc, 0xe1, 0x85, 0x61, 0xc2, 0xa4, 0x74, 0x13, 0x8f, 0x4b,
+	0x2f, 0x48, 0x23, 0xc1, 0x65, 0x2f, 0x4e, 0x44, 0x2a, 0x90, 0xa9, 0x1f, 0x9d, 0x3f, 0x93, 0x28,
+	0x9d, 0x66, 0x7e, 0x2f, 0x10, 0xb3, 0xfe, 0x44, 0x4c, 0x44, 0x5f, 0xcb, 0x7e, 0x76, 0xa1, 0x49,
+	0x83, 0x7e, 0x2b, 0xba, 0xba, 0x0f, 0x15, 0x88, 0x0e, 0x76, 0x3c, 0xd1, 0x77, 0x58, 0x77, 0x6f,
+	0x86, 0x9e, 0x9c, 0x62, 0x60, 0x03, 0xe7, 0x0b, 0x2d, 0x09, 0xfd, 0x86, 0xed, 0x71, 0xc4, 0x23,
+	0xff, 0x4a, 0x04, 0x97, 0xee, 0x6d, 0xcc, 0x70, 0xc5, 0x06, 0x8e, 0x49, 0x3f, 0x8a, 0xa8, 0x03,
+	0xad, 0x91, 0x3c, 0x65, 0x3c, 0x64, 0x09, 0xae, 0xda, 0xc0, 0xb1, 0xe8, 0x86, 0x11, 0x81, 0x70,
+	0x24, 0x29, 0x0b, 0x58, 0x74, 0xcd, 0x12, 0x5c, 0xd3, 0xd5, 0x2d, 0x05, 0x7d, 0x83, 0xe6, 0x51,
+	0x2c, 0x82, 0x29, 0x36, 0x6d, 0xe0, 0xb4, 0x69, 0x01, 0xb9, 0x4a, 0x45, 0xc6, 0x43, 0x5c, 0xb7,
+	0x81, 0x53, 0xa3, 0x05, 0x20, 0x1b, 0xb6, 0x86, 0xcc, 0x0b, 0x59, 0x72, 0x22, 0x78, 0xc0, 0x70,
+	0x43, 0xd7, 0xb6, 0xa5, 0xfc, 0xb4, 0x02, 0xf5, 0x2e, 0x96, 0xde, 0x65, 0x4b, 0x41, 0x3f, 0x61,
+	0xd3, 0x8d, 0x66, 0x4c, 0xa6, 0xde, 0x2c, 0xc6, 0x4d, 0xdd, 0xff, 0x2e, 0x74, 0x8f, 0x61, 0x67,
+	0x37, 0x1b, 0x79, 0x28, 0x32, 0x9e, 0xb2, 0x04, 0x61, 0xd8, 0x28, 0xab, 0x65, 0x48, 0x6f, 0x98,
+	0x4f, 0xab, 0x3f, 0xd2, 0xe9, 0xd4, 0x68, 0x01, 0xdd, 0xf3, 0xbd, 0x6e, 0x23, 0x3e, 0xc8, 0x73,
+	0x43, 0xff, 0xa1, 0x55, 0x1a, 0xe7, 0x76, 0x55, 0xa7, 0xf5, 0xf7, 0x57, 0xf1, 0x8b, 0x7a, 0x9f,
+	0x8f, 0x40, 0x37, 0x2d, 0x83, 0xf1, 0x7c, 0x49, 0x8c, 0xc5, 0x92, 0x18, 0xeb, 0x25, 0x01, 0x77,
+	0x8a, 0x80, 0x47, 0x45, 0xc0, 0x93, 0x22, 0x60, 0xae, 0x08, 0x58, 0x28, 0x02, 0x9e, 0x15, 0x01,
+	0x2f, 0x8a, 0x18, 0x6b, 0x45, 0xc0, 0xfd, 0x8a, 0x18, 0xf3, 0x15, 0x31, 0x16, 0x2b, 0x62, 0x9c,
+	0x7d, 0xdd, 0x73, 0xa5, 0xfc, 0xba, 0x3e, 0xfa, 0xdf, 0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1b,
+	0x36, 0x1e, 0xa7, 0x70, 0x02, 0x00, 0x00,
+}
+
+func (this *AddressTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransaction)
+	if !ok {
+		that2, ok := that.(AddressTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	if this.IsSender != that1.IsSender {
+		return false
+	}
+	if this.IsReceiver != that1.IsReceiver {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.HeaderNonce != that1.HeaderNonce {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *AddressTransactionsCounter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransactionsCounter)
+	if !ok {
+		that2, ok := that.(AddressTransactionsCounter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *AddressTransactionsInBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressTransactionsInBlock)
+	if !ok {
+		that2, ok := that.(AddressTransactionsInBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Counters) != len(that1.Counters) {
+		return false
+	}
+	for i := range this.Counters {
+		if !this.Counters[i].Equal(that1.Counters[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddressTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&addressTransactions.AddressTransaction{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "IsSender: "+fmt.Sprintf("%#v", this.IsSender)+",\n")
+	s = append(s, "IsReceiver: "+fmt.Sprintf("%#v", this.IsReceiver)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "HeaderNonce: "+fmt.Sprintf("%#v", this.HeaderNonce)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressTransactionsCounter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&addressTransactions.AddressTransactionsCounter{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressTransactionsInBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&addressTransactions.AddressTransactionsInBlock{")
+	if this.Counters != nil {
+		s = append(s, "Counters: "+fmt.Sprintf("%#v", this.Counters)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAddressTransactions(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AddressTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.HeaderNonce != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.HeaderNonce))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Round != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsReceiver {
+		i--
+		if m.IsReceiver {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsSender {
+		i--
+		if m.IsSender {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MiniblockType != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransactionsCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransactionsCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransactionsCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAddressTransactions(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressTransactionsInBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressTransactionsInBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressTransactionsInBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Counters) > 0 {
+		for iNdEx := len(m.Counters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Counters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAddressTransactions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAddressTransactions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAddressTransactions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.MiniblockType))
+	}
+	if m.IsSender {
+		n += 2
+	}
+	if m.IsReceiver {
+		n += 2
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Epoch))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Round))
+	}
+	if m.HeaderNonce != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.HeaderNonce))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *AddressTransactionsCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAddressTransactions(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovAddressTransactions(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *AddressTransactionsInBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Counters) > 0 {
+		for _, e := range m.Counters {
+			l = e.Size()
+			n += 1 + l + sovAddressTransactions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAddressTransactions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAddressTransactions(x uint64) (n int) {
+	return sovAddressTransactions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AddressTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTransaction{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`IsSender:` + fmt.Sprintf("%v", this.IsSender) + `,`,
+		`IsReceiver:` + fmt.Sprintf("%v", this.IsReceiver) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`HeaderNonce:` + fmt.Sprintf("%v", this.HeaderNonce) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressTransactionsCounter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressTransactionsCounter{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressTransactionsInBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCounters := "[]*AddressTransactionsCounter{"
+	for _, f := range this.Counters {
+		repeatedStringForCounters += strings.Replace(f.String(), "AddressTransactionsCounter", "AddressTransactionsCounter", 1) + ","
+	}
+	repeatedStringForCounters += "}"
+	s := strings.Join([]string{`&AddressTransactionsInBlock{`,
+		`Counters:` + repeatedStringForCounters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAddressTransactions(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AddressTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSender = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReceiver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReceiver = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderNonce", wireType)
+			}
+			m.HeaderNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransactionsCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransactionsCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransactionsCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressTransactionsInBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressTransactionsInBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressTransactionsInBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counters = append(m.Counters, &AddressTransactionsCounter{})
+			if err := m.Counters[len(m.Counters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAddressTransactions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAddressTransactions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAddressTransactions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAddressTransactions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAddressTransactions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAddressTransactions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAddressTransactions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAddressTransactions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAddressTransactions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAddressTransactions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAddressTransactions = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,435 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. addressTransactions.proto
+
+package addressTransactions
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/addressTransactions")
+
+const (
+	counterKeyPrefix = "counter_"
+	entryKeyPrefix   = "entry_"
+	blockKeyPrefix   = "block_"
+)
+
+// ArgsAddressTransactionsProcessor holds the arguments needed to create a new address transactions processor
+type ArgsAddressTransactionsProcessor struct {
+	Marshalizer                marshal.Marshalizer
+	Hasher                     hashing.Hasher
+	ShardCoordinator           sharding.Coordinator
+	AddressTransactionsStorer  storage.Storer
+	TransactionsStorer         storage.Storer
+	UnsignedTransactionsStorer storage.Storer
+	RewardTransactionsStorer   storage.Storer
+}
+
+type addressTransactionsProcessor struct {
+	marshalizer                marshal.Marshalizer
+	hasher                     hashing.Hasher
+	shardCoordinator           sharding.Coordinator
+	addressTransactionsStorer  storage.Storer
+	transactionsStorer         storage.Storer
+	unsignedTransactionsStorer storage.Storer
+	rewardTransactionsStorer   storage.Storer
+	mutex                      sync.RWMutex
+}
+
+// NewAddressTransactionsProcessor will create a new instance of the address transactions processor
+func NewAddressTransactionsProcessor(args ArgsAddressTransactionsProcessor) (*addressTransactionsProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, errNilShardCoordinator
+	}
+	if check.IfNil(args.AddressTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.TransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.UnsignedTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.RewardTransactionsStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	return &addressTransactionsProcessor{
+		marshalizer:                args.Marshalizer,
+		hasher:                     args.Hasher,
+		shardCoordinator:           args.ShardCoordinator,
+		addressTransactionsStorer:  args.AddressTransactionsStorer,
+		transactionsStorer:         args.TransactionsStorer,
+		unsignedTransactionsStorer: args.UnsignedTransactionsStorer,
+		rewardTransactionsStorer:   args.RewardTransactionsStorer,
+	}, nil
+}
+
+// RecordBlock will index, for every address of the current shard, the transactions, smart contract results and
+// rewards contained in the provided miniblocks. The transactions should already be saved in their storers.
+// Recording the same block again is idempotent: the block record, holding the counters the block started from, is
+// saved before the entries and the counters, so that a retry after a partial write overwrites the same indexes.
+func (atp *addressTransactionsProcessor) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error {
+	if check.IfNil(blockHeader) {
+		return errNilHeaderHandler
+	}
+
+	atp.mutex.Lock()
+	defer atp.mutex.Unlock()
+
+	blockKey := buildBlockKey(blockHeaderHash)
+	blockRecord, err := atp.getBlockRecord(blockKey)
+	if err != nil {
+		return err
+	}
+
+	recordedCounts := make(map[string]uint64, len(blockRecord.Counters))
+	for _, counter := range blockRecord.Counters {
+		recordedCounts[string(counter.Address)] = counter.Count
+	}
+
+	addresses, entriesByAddress := atp.extractEntries(blockHeaderHash, blockHeader, miniBlocks)
+	previousCounts := make(map[string]uint64, len(addresses))
+	currentCounts := make(map[string]uint64, len(addresses))
+	for _, address := range addresses {
+		currentCount, errGet := atp.getCounter([]byte(address))
+		if errGet != nil {
+			return errGet
+		}
+
+		currentCounts[address] = currentCount
+		previousCount, isRecorded := recordedCounts[address]
+		if !isRecorded {
+			previousCount = currentCount
+			blockRecord.Counters = append(blockRecord.Counters, &AddressTransactionsCounter{
+				Address: []byte(address),
+				Count:   previousCount,
+			})
+		}
+		previousCounts[address] = previousCount
+	}
+
+	err = atp.putObject(blockKey, blockRecord)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		previousCount := previousCounts[address]
+		entries := entriesByAddress[address]
+		for idx, entry := range entries {
+			err = atp.putEntry([]byte(address), previousCount+uint64(idx), entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		// a retry of an already recorded block should not move the counter backwards
+		newCount := previousCount + uint64(len(entries))
+		if newCount <= currentCounts[address] {
+			continue
+		}
+
+		err = atp.putCounter([]byte(address), newCount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (atp *addressTransactionsProcessor) getBlockRecord(blockKey []byte) (*AddressTransactionsInBlock, error) {
+	blockRecord := &AddressTransactionsInBlock{}
+	err := atp.getObject(blockKey, blockRecord)
+	if err == nil {
+		log.Debug("addressTransactionsProcessor.RecordBlock(): block already recorded, rewriting its entries", "blockKey", blockKey)
+		return blockRecord, nil
+	}
+	if storage.IsNotFoundInStorageErr(err) {
+		return &AddressTransactionsInBlock{}, nil
+	}
+
+	return nil, err
+}
+
+func (atp *addressTransactionsProcessor) extractEntries(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlocks []*block.MiniBlock,
+) ([]string, map[string][]*AddressTransaction) {
+	addresses := make([]string, 0)
+	entriesByAddress := make(map[string][]*AddressTransaction)
+	alreadyIndexed := make(map[string]struct{})
+
+	addEntry := func(address []byte, entry *AddressTransaction) {
+		key := string(address) + string(entry.TxHash)
+		_, exists := alreadyIndexed[key]
+		if exists {
+			return
+		}
+		alreadyIndexed[key] = struct{}{}
+
+		_, hasEntries := entriesByAddress[string(address)]
+		if !hasEntries {
+			addresses = append(addresses, string(address))
+		}
+		entriesByAddress[string(address)] = append(entriesByAddress[string(address)], entry)
+	}
+
+	for _, miniBlock := range miniBlocks {
+		if miniBlock == nil {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, err := atp.getTransaction(miniBlock.Type, txHash)
+			if err != nil {
+				logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "addressTransactionsProcessor.extractEntries(): cannot get transaction",
+					"type", miniBlock.Type, "txHash", txHash, "err", err)
+				continue
+			}
+			if tx == nil {
+				continue
+			}
+
+			sender := tx.GetSndAddr()
+			receiver := tx.GetRcvAddr()
+			isSenderIndexed := atp.isAddressInSelfShard(sender)
+			isReceiverIndexed := atp.isAddressInSelfShard(receiver)
+			isSelfTransfer := bytes.Equal(sender, receiver)
+
+			if isSenderIndexed {
+				entry := newAddressTransaction(blockHeaderHash, blockHeader, miniBlock.Type, txHash)
+				entry.IsSender = true
+				entry.IsReceiver = isSelfTransfer
+				addEntry(sender, entry)
+			}
+			if isReceiverIndexed && !isSelfTransfer {
+				entry := newAddressTransaction(blockHeaderHash, blockHeader, miniBlock.Type, txHash)
+				entry.IsReceiver = true
+				addEntry(receiver, entry)
+			}
+		}
+	}
+
+	return addresses, entriesByAddress
+}
+
+func newAddressTransaction(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlockType block.Type, txHash []byte) *AddressTransaction {
+	return &AddressTransaction{
+		TxHash:        txHash,
+		MiniblockType: int32(miniBlockType),
+		Epoch:         blockHeader.GetEpoch(),
+		Round:         blockHeader.GetRound(),
+		HeaderNonce:   blockHeader.GetNonce(),
+		HeaderHash:    blockHeaderHash,
+		Timestamp:     blockHeader.GetTimeStamp(),
+	}
+}
+
+func (atp *addressTransactionsProcessor) isAddressInSelfShard(address []byte) bool {
+	if len(address) == 0 {
+		return false
+	}
+
+	return atp.shardCoordinator.ComputeId(address) == atp.shardCoordinator.SelfId()
+}
+
+func (atp *addressTransactionsProcessor) getTransaction(miniBlockType block.Type, txHash []byte) (data.TransactionHandler, error) {
+	var storer storage.Storer
+	var tx data.TransactionHandler
+
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		storer = atp.transactionsStorer
+		tx = &transaction.Transaction{}
+	case block.SmartContractResultBlock:
+		storer = atp.unsignedTransactionsStorer
+		tx = &smartContractResult.SmartContractResult{}
+	case block.RewardsBlock:
+		storer = atp.rewardTransactionsStorer
+		tx = &rewardTx.RewardTx{}
+	default:
+		return nil, nil
+	}
+
+	txBytes, err := storer.Get(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = atp.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// RevertBlock will remove the index entries added when the provided block was recorded
+func (atp *addressTransactionsProcessor) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	blockHeaderHash, err := core.CalculateHash(atp.marshalizer, atp.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	atp.mutex.Lock()
+	defer atp.mutex.Unlock()
+
+	blockKey := buildBlockKey(blockHeaderHash)
+	blockRecord := &AddressTransactionsInBlock{}
+	err = atp.getObject(blockKey, blockRecord)
+	if err != nil {
+		if storage.IsNotFoundInStorageErr(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, counter := range blockRecord.Counters {
+		currentCount, errGet := atp.getCounter(counter.Address)
+		if errGet != nil {
+			return errGet
+		}
+
+		for idx := counter.Count; idx < currentCount; idx++ {
+			errRemove := atp.addressTransactionsStorer.Remove(buildEntryKey(counter.Address, idx))
+			if errRemove != nil {
+				log.Debug("addressTransactionsProcessor.RevertBlock(): cannot remove entry",
+					"address", counter.Address, "index", idx, "err", errRemove)
+			}
+		}
+
+		err = atp.putCounter(counter.Address, counter.Count)
+		if err != nil {
+			return err
+		}
+	}
+
+	return atp.addressTransactionsStorer.Remove(blockKey)
+}
+
+// GetTransactionsByAddress returns the indexed transactions of an address, newest first, skipping the first "from"
+// entries and returning at most "size" entries. The total number of indexed transactions is returned as well.
+func (atp *addressTransactionsProcessor) GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*AddressTransaction, uint64, error) {
+	atp.mutex.RLock()
+	defer atp.mutex.RUnlock()
+
+	count, err := atp.getCounter(address)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*AddressTransaction, 0)
+	if from >= count {
+		return result, count, nil
+	}
+
+	numEntries := core.MinUint64(size, count-from)
+	newestIndex := count - 1 - from
+	for i := uint64(0); i < numEntries; i++ {
+		entry := &AddressTransaction{}
+		err = atp.getObject(buildEntryKey(address, newestIndex-i), entry)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		result = append(result, entry)
+	}
+
+	return result, count, nil
+}
+
+func (atp *addressTransactionsProcessor) getCounter(address []byte) (uint64, error) {
+	counter := &AddressTransactionsCounter{}
+	err := atp.getObject(buildCounterKey(address), counter)
+	if err != nil {
+		if storage.IsNotFoundInStorageErr(err) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return counter.Count, nil
+}
+
+func (atp *addressTransactionsProcessor) putCounter(address []byte, count uint64) error {
+	counter := &AddressTransactionsCounter{
+		Address: address,
+		Count:   count,
+	}
+
+	return atp.putObject(buildCounterKey(address), counter)
+}
+
+func (atp *addressTransactionsProcessor) putEntry(address []byte, index uint64, entry *AddressTransaction) error {
+	return atp.putObject(buildEntryKey(address, index), entry)
+}
+
+func (atp *addressTransactionsProcessor) getObject(key []byte, object interface{}) error {
+	objectBytes, err := atp.addressTransactionsStorer.Get(key)
+	if err != nil {
+		return err
+	}
+
+	return atp.marshalizer.Unmarshal(object, objectBytes)
+}
+
+func (atp *addressTransactionsProcessor) putObject(key []byte, object interface{}) error {
+	objectBytes, err := atp.marshalizer.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	return atp.addressTransactionsStorer.Put(key, objectBytes)
+}
+
+func buildCounterKey(address []byte) []byte {
+	return append([]byte(counterKeyPrefix), address...)
+}
+
+func buildEntryKey(address []byte, index uint64) []byte {
+	key := append([]byte(entryKeyPrefix), address...)
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, index)
+
+	return append(key, indexBytes...)
+}
+
+func buildBlockKey(blockHeaderHash []byte) []byte {
+	return append([]byte(blockKeyPrefix), blockHeaderHash...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (atp *addressTransactionsProcessor) IsInterfaceNil() bool {
+	return atp == nil
+}
@@ -0,0 +1,362 @@
+package addressTransactions
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// with 2 shards, the last byte of the address decides the shard
+var (
+	aliceShard0 = []byte("alice_______________________0000")
+	bobShard0   = []byte("bob_________________________0000")
+	carolShard1 = []byte("carol_______________________0001")
+)
+
+func createMockArgsAddressTransactionsProcessor() ArgsAddressTransactionsProcessor {
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 0)
+
+	return ArgsAddressTransactionsProcessor{
+		Marshalizer:                &marshallerMock.MarshalizerMock{},
+		Hasher:                     &hashingMocks.HasherMock{},
+		ShardCoordinator:           shardCoordinator,
+		AddressTransactionsStorer:  testscommon.CreateMemUnit(),
+		TransactionsStorer:         testscommon.CreateMemUnit(),
+		UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+		RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+	}
+}
+
+func TestNewAddressTransactionsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.Marshalizer = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.Hasher = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, core.ErrNilHasher, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.ShardCoordinator = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, errNilShardCoordinator, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("nil address transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.AddressTransactionsStorer = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("nil transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.TransactionsStorer = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("nil unsigned transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.UnsignedTransactionsStorer = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("nil reward transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAddressTransactionsProcessor()
+		args.RewardTransactionsStorer = nil
+		proc, err := NewAddressTransactionsProcessor(args)
+		require.Equal(t, core.ErrNilStore, err)
+		require.True(t, check.IfNil(proc))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proc, err := NewAddressTransactionsProcessor(createMockArgsAddressTransactionsProcessor())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(proc))
+	})
+}
+
+func TestAddressTransactionsProcessor_RecordBlockShouldIndexAddressesOfSelfShard(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressTransactionsProcessor()
+	marshaller := args.Marshalizer
+	putObject := func(storer interface{ Put(key, data []byte) error }, key []byte, obj interface{}) {
+		objBytes, _ := marshaller.Marshal(obj)
+		_ = storer.Put(key, objBytes)
+	}
+	putObject(args.TransactionsStorer, []byte("txIntra"), &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0})
+	putObject(args.TransactionsStorer, []byte("txCross"), &transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1})
+	putObject(args.TransactionsStorer, []byte("txSelf"), &transaction.Transaction{SndAddr: bobShard0, RcvAddr: bobShard0})
+	putObject(args.UnsignedTransactionsStorer, []byte("scrIncoming"), &smartContractResult.SmartContractResult{SndAddr: carolShard1, RcvAddr: bobShard0})
+	putObject(args.RewardTransactionsStorer, []byte("reward"), &rewardTx.RewardTx{RcvAddr: aliceShard0})
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	header := &block.Header{Nonce: 7, Round: 8, Epoch: 2, TimeStamp: 1000}
+	miniBlocks := []*block.MiniBlock{
+		{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txIntra"), []byte("txSelf")}},
+		{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txCross")}, ReceiverShardID: 1},
+		{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scrIncoming")}, SenderShardID: 1},
+		{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("reward")}, SenderShardID: core.MetachainShardId},
+		{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("ignored")}},
+	}
+
+	err := proc.RecordBlock([]byte("headerHash"), header, miniBlocks)
+	require.Nil(t, err)
+
+	aliceTxs, total, err := proc.GetTransactionsByAddress(aliceShard0, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []byte("reward"), aliceTxs[0].TxHash)
+	require.True(t, aliceTxs[0].IsReceiver)
+	require.Equal(t, int32(block.RewardsBlock), aliceTxs[0].MiniblockType)
+	require.Equal(t, []byte("txCross"), aliceTxs[1].TxHash)
+	require.True(t, aliceTxs[1].IsSender)
+	require.False(t, aliceTxs[1].IsReceiver)
+	require.Equal(t, []byte("txIntra"), aliceTxs[2].TxHash)
+	require.Equal(t, uint64(7), aliceTxs[2].HeaderNonce)
+	require.Equal(t, uint64(8), aliceTxs[2].Round)
+	require.Equal(t, uint32(2), aliceTxs[2].Epoch)
+	require.Equal(t, uint64(1000), aliceTxs[2].Timestamp)
+	require.Equal(t, []byte("headerHash"), aliceTxs[2].HeaderHash)
+
+	bobTxs, total, err := proc.GetTransactionsByAddress(bobShard0, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []byte("scrIncoming"), bobTxs[0].TxHash)
+	require.Equal(t, []byte("txSelf"), bobTxs[1].TxHash)
+	require.True(t, bobTxs[1].IsSender)
+	require.True(t, bobTxs[1].IsReceiver)
+	require.Equal(t, []byte("txIntra"), bobTxs[2].TxHash)
+
+	carolTxs, total, err := proc.GetTransactionsByAddress(carolShard1, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Empty(t, carolTxs)
+}
+
+func TestAddressTransactionsProcessor_RecordBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressTransactionsProcessor()
+	txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0})
+	_ = args.TransactionsStorer.Put([]byte("tx"), txBytes)
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	miniBlocks := []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}}}
+
+	_ = proc.RecordBlock([]byte("headerHash"), &block.Header{}, miniBlocks)
+	_ = proc.RecordBlock([]byte("headerHash"), &block.Header{}, miniBlocks)
+
+	_, total, err := proc.GetTransactionsByAddress(aliceShard0, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+}
+
+func TestAddressTransactionsProcessor_RecordBlockRetryAfterPartialWriteShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAddressTransactionsProcessor()
+	for _, txHash := range []string{"tx1", "tx2"} {
+		txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0})
+		_ = args.TransactionsStorer.Put([]byte(txHash), txBytes)
+	}
+	memUnit := testscommon.CreateMemUnit()
+	failCounterPut := true
+	args.AddressTransactionsStorer = &storageStubs.StorerStub{
+		GetCalled:    memUnit.Get,
+		RemoveCalled: memUnit.Remove,
+		PutCalled: func(key, data []byte) error {
+			if failCounterPut && bytes.Equal(key, buildCounterKey(bobShard0)) {
+				return expectedErr
+			}
+
+			return memUnit.Put(key, data)
+		},
+	}
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	header := &block.Header{Nonce: 1}
+	miniBlocks := []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}}}
+
+	// the entries and the counter of alice are written, bob's counter is not
+	err := proc.RecordBlock([]byte("headerHash"), header, miniBlocks)
+	require.Equal(t, expectedErr, err)
+
+	failCounterPut = false
+	err = proc.RecordBlock([]byte("headerHash"), header, miniBlocks)
+	require.Nil(t, err)
+
+	for _, address := range [][]byte{aliceShard0, bobShard0} {
+		txs, total, errGet := proc.GetTransactionsByAddress(address, 0, 10)
+		require.Nil(t, errGet)
+		require.Equal(t, uint64(2), total)
+		require.Equal(t, []byte("tx2"), txs[0].TxHash)
+		require.Equal(t, []byte("tx1"), txs[1].TxHash)
+	}
+}
+
+func TestAddressTransactionsProcessor_RecordBlockAgainAfterNextBlockShouldNotMoveTheCounterBack(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressTransactionsProcessor()
+	for _, txHash := range []string{"tx1", "tx2"} {
+		txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1})
+		_ = args.TransactionsStorer.Put([]byte(txHash), txBytes)
+	}
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	firstMiniBlocks := []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1")}}}
+	_ = proc.RecordBlock([]byte("firstHeaderHash"), &block.Header{Nonce: 1}, firstMiniBlocks)
+	_ = proc.RecordBlock([]byte("secondHeaderHash"), &block.Header{Nonce: 2}, []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx2")}}})
+
+	err := proc.RecordBlock([]byte("firstHeaderHash"), &block.Header{Nonce: 1}, firstMiniBlocks)
+	require.Nil(t, err)
+
+	txs, total, err := proc.GetTransactionsByAddress(aliceShard0, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), total)
+	require.Equal(t, []byte("tx2"), txs[0].TxHash)
+	require.Equal(t, []byte("tx1"), txs[1].TxHash)
+}
+
+func TestAddressTransactionsProcessor_RecordBlockNilHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proc, _ := NewAddressTransactionsProcessor(createMockArgsAddressTransactionsProcessor())
+	err := proc.RecordBlock([]byte("headerHash"), nil, nil)
+	require.Equal(t, errNilHeaderHandler, err)
+}
+
+func TestAddressTransactionsProcessor_RecordBlockStorerErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAddressTransactionsProcessor()
+	txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: aliceShard0, RcvAddr: bobShard0})
+	_ = args.TransactionsStorer.Put([]byte("tx"), txBytes)
+	args.AddressTransactionsStorer = &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, errors.New("not found")
+		},
+		PutCalled: func(key, data []byte) error {
+			return expectedErr
+		},
+	}
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	miniBlocks := []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx")}}}
+	err := proc.RecordBlock([]byte("headerHash"), &block.Header{}, miniBlocks)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestAddressTransactionsProcessor_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressTransactionsProcessor()
+	for _, txHash := range []string{"tx1", "tx2", "tx3"} {
+		txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1})
+		_ = args.TransactionsStorer.Put([]byte(txHash), txBytes)
+	}
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	firstHeader := &block.Header{Nonce: 1}
+	firstHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, firstHeader)
+	secondHeader := &block.Header{Nonce: 2}
+	secondHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, secondHeader)
+
+	_ = proc.RecordBlock(firstHeaderHash, firstHeader, []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx1")}}})
+	_ = proc.RecordBlock(secondHeaderHash, secondHeader, []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx2"), []byte("tx3")}}})
+
+	_, total, _ := proc.GetTransactionsByAddress(aliceShard0, 0, 10)
+	require.Equal(t, uint64(3), total)
+
+	err := proc.RevertBlock(secondHeader)
+	require.Nil(t, err)
+
+	txs, total, err := proc.GetTransactionsByAddress(aliceShard0, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []byte("tx1"), txs[0].TxHash)
+
+	// reverting an unknown block does nothing
+	err = proc.RevertBlock(&block.Header{Nonce: 3})
+	require.Nil(t, err)
+
+	// the reverted block can be recorded again
+	err = proc.RecordBlock(secondHeaderHash, secondHeader, []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx2")}}})
+	require.Nil(t, err)
+	_, total, _ = proc.GetTransactionsByAddress(aliceShard0, 0, 10)
+	require.Equal(t, uint64(2), total)
+}
+
+func TestAddressTransactionsProcessor_GetTransactionsByAddressPagination(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAddressTransactionsProcessor()
+	txHashes := [][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2"), []byte("tx3"), []byte("tx4")}
+	for _, txHash := range txHashes {
+		txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{SndAddr: aliceShard0, RcvAddr: carolShard1})
+		_ = args.TransactionsStorer.Put(txHash, txBytes)
+	}
+
+	proc, _ := NewAddressTransactionsProcessor(args)
+	_ = proc.RecordBlock([]byte("headerHash"), &block.Header{}, []*block.MiniBlock{{Type: block.TxBlock, TxHashes: txHashes}})
+
+	txs, total, err := proc.GetTransactionsByAddress(aliceShard0, 1, 2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Equal(t, 2, len(txs))
+	require.Equal(t, []byte("tx3"), txs[0].TxHash)
+	require.Equal(t, []byte("tx2"), txs[1].TxHash)
+
+	txs, _, err = proc.GetTransactionsByAddress(aliceShard0, 4, 10)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(txs))
+	require.Equal(t, []byte("tx0"), txs[0].TxHash)
+
+	txs, total, err = proc.GetTransactionsByAddress(aliceShard0, 5, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Empty(t, txs)
+}
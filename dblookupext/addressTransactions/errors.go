@@ -0,0 +1,7 @@
+package addressTransactions
+
+import "errors"
+
+var errNilShardCoordinator = errors.New("nil shard coordinator")
+
+var errNilHeaderHandler = errors.New("nil header handler")
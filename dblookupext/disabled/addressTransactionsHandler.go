@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+)
+
+type addressTransactionsHandler struct {
+}
+
+// NewAddressTransactionsHandler returns a disabled address transactions handler
+func NewAddressTransactionsHandler() *addressTransactionsHandler {
+	return &addressTransactionsHandler{}
+}
+
+// RecordBlock does nothing
+func (ath *addressTransactionsHandler) RecordBlock(_ []byte, _ data.HeaderHandler, _ []*block.MiniBlock) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (ath *addressTransactionsHandler) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetTransactionsByAddress returns the address transactions index disabled error
+func (ath *addressTransactionsHandler) GetTransactionsByAddress(_ []byte, _ uint64, _ uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+	return nil, 0, dblookupext.ErrAddressTransactionsIndexDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ath *addressTransactionsHandler) IsInterfaceNil() bool {
+	return ath == nil
+}
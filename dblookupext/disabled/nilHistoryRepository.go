@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -58,6 +59,11 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetTransactionsByAddress returns the address transactions index disabled error
+func (nhr *nilHistoryRepository) GetTransactionsByAddress(_ []byte, _ uint64, _ uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+	return nil, 0, dblookupext.ErrAddressTransactionsIndexDisabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -9,10 +9,15 @@ import (
 // ErrNotFoundInStorage signals that an item was not found in storage
 var ErrNotFoundInStorage = errors.New("not found in storage")
 
+// ErrAddressTransactionsIndexDisabled signals that the address transactions index is not enabled
+var ErrAddressTransactionsIndexDisabled = errors.New("address transactions index is disabled")
+
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
 
 var errNilESDTSuppliesHandler = errors.New("nil esdt supplies handler")
 
+var errNilAddressTransactionsHandler = errors.New("nil address transactions handler")
+
 func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save epoch num for [%s] hash [%s]: %w", what, hex.EncodeToString(hash), originalErr)
 }
@@ -9,15 +9,17 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
 // new instances
 type ArgsHistoryRepositoryFactory struct {
-	SelfShardID              uint32
+	ShardCoordinator         sharding.Coordinator
 	Config                   config.DbLookupExtensionsConfig
 	Store                    dataRetriever.StorageService
 	Marshalizer              marshal.Marshalizer
@@ -26,7 +28,7 @@ type ArgsHistoryRepositoryFactory struct {
 }
 
 type historyRepositoryFactory struct {
-	selfShardID              uint32
+	shardCoordinator         sharding.Coordinator
 	dbLookupExtensionsConfig config.DbLookupExtensionsConfig
 	store                    dataRetriever.StorageService
 	marshalizer              marshal.Marshalizer
@@ -48,9 +50,12 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
-		selfShardID:              args.SelfShardID,
+		shardCoordinator:         args.ShardCoordinator,
 		dbLookupExtensionsConfig: args.Config,
 		store:                    args.Store,
 		marshalizer:              args.Marshalizer,
@@ -109,8 +114,13 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	addressTransactionsHandler, err := hpf.createAddressTransactionsHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
-		SelfShardID:                 hpf.selfShardID,
+		SelfShardID:                 hpf.shardCoordinator.SelfId(),
 		Hasher:                      hpf.hasher,
 		Marshalizer:                 hpf.marshalizer,
 		BlockHashByRound:            roundHdrHashDataStorer,
@@ -120,10 +130,48 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AddressTransactionsHandler:  addressTransactionsHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createAddressTransactionsHandler() (dblookupext.AddressTransactionsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.AddressTransactionsIndexEnabled {
+		return disabled.NewAddressTransactionsHandler(), nil
+	}
+
+	addressTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.AddressTransactionsUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionsStorer, err := hpf.store.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.UnsignedTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardTransactionsStorer, err := hpf.store.GetStorer(dataRetriever.RewardTransactionUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	argsAddressTransactions := addressTransactions.ArgsAddressTransactionsProcessor{
+		Marshalizer:                hpf.marshalizer,
+		Hasher:                     hpf.hasher,
+		ShardCoordinator:           hpf.shardCoordinator,
+		AddressTransactionsStorer:  addressTransactionsStorer,
+		TransactionsStorer:         transactionsStorer,
+		UnsignedTransactionsStorer: unsignedTransactionsStorer,
+		RewardTransactionsStorer:   rewardTransactionsStorer,
+	}
+	return addressTransactions.NewAddressTransactionsProcessor(argsAddressTransactions)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
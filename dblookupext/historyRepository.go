@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -38,6 +39,7 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AddressTransactionsHandler  AddressTransactionsHandler
 }
 
 type historyRepository struct {
@@ -51,6 +53,7 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	addressTransactionsHandler AddressTransactionsHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -97,6 +100,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(arguments.AddressTransactionsHandler) {
+		return nil, errNilAddressTransactionsHandler
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := cache.NewLRUCache(sizeOfDeduplicationCache)
@@ -118,6 +124,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		addressTransactionsHandler:                   arguments.AddressTransactionsHandler,
 	}, nil
 }
 
@@ -173,6 +180,14 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	allMiniBlocks := make([]*block.MiniBlock, 0, len(body.MiniBlocks)+len(createdIntraShardMiniBlocks))
+	allMiniBlocks = append(allMiniBlocks, body.MiniBlocks...)
+	allMiniBlocks = append(allMiniBlocks, createdIntraShardMiniBlocks...)
+	err = hr.addressTransactionsHandler.RecordBlock(blockHeaderHash, blockHeader, allMiniBlocks)
+	if err != nil {
+		return err
+	}
+
 	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
 	if err != nil {
 		return err
@@ -478,9 +493,19 @@ func (hr *historyRepository) IsEnabled() bool {
 	return true
 }
 
-// RevertBlock will return the modification for the current block header
+// RevertBlock will return the modification for the current block header. Both the ESDT supplies and the address
+// transactions are reverted, even if one of them fails
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	errSupplies := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	errAddressTransactions := hr.addressTransactionsHandler.RevertBlock(blockHeader)
+	if errSupplies == nil {
+		return errAddressTransactions
+	}
+	if errAddressTransactions == nil {
+		return errSupplies
+	}
+
+	return fmt.Errorf("%w while reverting the ESDT supplies, %w while reverting the address transactions", errSupplies, errAddressTransactions)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +513,11 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetTransactionsByAddress will return the indexed transactions of the given address, newest first
+func (hr *historyRepository) GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+	return hr.addressTransactionsHandler.GetTransactionsByAddress(address, from, size)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
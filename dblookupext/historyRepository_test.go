@@ -8,11 +8,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
@@ -27,6 +31,17 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		},
 	}, &storageStubs.StorerStub{})
 
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+	atp, _ := addressTransactions.NewAddressTransactionsProcessor(addressTransactions.ArgsAddressTransactionsProcessor{
+		Marshalizer:                &mock.MarshalizerMock{},
+		Hasher:                     &hashingMocks.HasherMock{},
+		ShardCoordinator:           shardCoordinator,
+		AddressTransactionsStorer:  testscommon.CreateMemUnit(),
+		TransactionsStorer:         testscommon.CreateMemUnit(),
+		UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+		RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+	})
+
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
 		MiniblocksMetadataStorer:    genericMocks.NewStorerMockWithEpoch(epoch),
@@ -38,6 +53,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
+		AddressTransactionsHandler:  atp,
 	}
 
 	return args
@@ -88,6 +104,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AddressTransactionsHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilAddressTransactionsHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -152,6 +174,129 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordAndRevertBlockShouldUpdateAddressTransactions(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerMock{}
+	transactionsStorer := testscommon.CreateMemUnit()
+	txBytes, _ := marshaller.Marshal(&transaction.Transaction{SndAddr: []byte("sender"), RcvAddr: []byte("receiver")})
+	_ = transactionsStorer.Put([]byte("txA"), txBytes)
+
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+	atp, _ := addressTransactions.NewAddressTransactionsProcessor(addressTransactions.ArgsAddressTransactionsProcessor{
+		Marshalizer:                marshaller,
+		Hasher:                     &hashingMocks.HasherMock{},
+		ShardCoordinator:           shardCoordinator,
+		AddressTransactionsStorer:  testscommon.CreateMemUnit(),
+		TransactionsStorer:         transactionsStorer,
+		UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+		RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+	})
+	notFoundStorer := &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, storage.ErrKeyNotFound
+		},
+	}
+	sp, _ := esdtSupply.NewSuppliesProcessor(marshaller, notFoundStorer, notFoundStorer)
+	args := createMockHistoryRepoArgs(0)
+	args.ESDTSuppliesHandler = sp
+	args.AddressTransactionsHandler = atp
+	repo, _ := NewHistoryRepository(args)
+
+	blockHeader := &block.Header{Nonce: 4, Round: 5}
+	headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txA")},
+			},
+		},
+	}
+
+	err := repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	txs, total, err := repo.GetTransactionsByAddress([]byte("receiver"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []byte("txA"), txs[0].TxHash)
+	require.Equal(t, headerHash, txs[0].HeaderHash)
+
+	err = repo.RevertBlock(blockHeader, blockBody)
+	require.Nil(t, err)
+
+	txs, total, err = repo.GetTransactionsByAddress([]byte("receiver"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Empty(t, txs)
+}
+
+type unknownBlockBody struct {
+	*block.Body
+}
+
+func TestHistoryRepository_RevertBlockShouldRevertBothHandlers(t *testing.T) {
+	t.Parallel()
+
+	blockHeader := &block.Header{Nonce: 4}
+	createAddressTransactionsProcessor := func(addressTransactionsStorer storage.Storer) AddressTransactionsHandler {
+		shardCoordinator, _ := sharding.NewMultiShardCoordinator(1, 0)
+		atp, _ := addressTransactions.NewAddressTransactionsProcessor(addressTransactions.ArgsAddressTransactionsProcessor{
+			Marshalizer:                &mock.MarshalizerMock{},
+			Hasher:                     &hashingMocks.HasherMock{},
+			ShardCoordinator:           shardCoordinator,
+			AddressTransactionsStorer:  addressTransactionsStorer,
+			TransactionsStorer:         testscommon.CreateMemUnit(),
+			UnsignedTransactionsStorer: testscommon.CreateMemUnit(),
+			RewardTransactionsStorer:   testscommon.CreateMemUnit(),
+		})
+
+		return atp
+	}
+
+	t.Run("supplies revert error should still revert the address transactions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHistoryRepoArgs(0)
+		removedKeys := make([]string, 0)
+		memUnit := testscommon.CreateMemUnit()
+		args.AddressTransactionsHandler = createAddressTransactionsProcessor(&storageStubs.StorerStub{
+			GetCalled: memUnit.Get,
+			PutCalled: memUnit.Put,
+			RemoveCalled: func(key []byte) error {
+				removedKeys = append(removedKeys, string(key))
+				return memUnit.Remove(key)
+			},
+		})
+		repo, _ := NewHistoryRepository(args)
+		headerHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+		_ = args.AddressTransactionsHandler.RecordBlock(headerHash, blockHeader, nil)
+
+		// the supplies processor can not cast the body
+		err := repo.RevertBlock(blockHeader, &unknownBlockBody{Body: &block.Body{}})
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "cannot cast to block body")
+		require.Equal(t, []string{"block_" + string(headerHash)}, removedKeys)
+	})
+	t.Run("both errors should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockHistoryRepoArgs(0)
+		args.AddressTransactionsHandler = createAddressTransactionsProcessor(&storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		})
+		repo, _ := NewHistoryRepository(args)
+
+		err := repo.RevertBlock(blockHeader, &unknownBlockBody{Body: &block.Body{}})
+		require.ErrorIs(t, err, expectedErr)
+		require.Contains(t, err.Error(), "cannot cast to block body")
+	})
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package dblookupext
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -27,6 +28,7 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +49,11 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// AddressTransactionsHandler defines the interface of an address transactions index
+type AddressTransactionsHandler interface {
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error)
+	IsInterfaceNil() bool
+}
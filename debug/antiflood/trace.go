@@ -0,0 +1,62 @@
+package antiflood
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	// MessageTraceType is the type of the trace entries recorded for each message checked against the flood preventers
+	MessageTraceType = "message"
+	// TopicTraceType is the type of the trace entries recorded for each message checked against the topic flood preventer
+	TopicTraceType = "topic"
+	// DroppedTraceType is the type of the trace entries holding the number of entries not recorded since the previous
+	// one, because the recording buffer or the trace file was full. A trace having such entries is incomplete
+	DroppedTraceType = "dropped"
+)
+
+const maxTraceLineSize = 1024 * 1024
+
+// TraceEntry holds the metadata of a message checked by the antiflood component
+type TraceEntry struct {
+	Type        string `json:"type"`
+	Timestamp   int64  `json:"timestamp"`
+	Peer        string `json:"peer"`
+	Originator  string `json:"originator,omitempty"`
+	Topic       string `json:"topic"`
+	NumMessages uint32 `json:"numMessages"`
+	Size        uint64 `json:"size"`
+	Sequence    uint64 `json:"sequence"`
+	NumDropped  uint64 `json:"numDropped,omitempty"`
+}
+
+// ReadTrace will call the handler for each trace entry found in the provided reader, in the recorded order.
+// The reading stops at the first error returned by the handler
+func ReadTrace(reader io.Reader, handler func(entry *TraceEntry) error) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxTraceLineSize)
+
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		entry := &TraceEntry{}
+		err := json.Unmarshal(line, entry)
+		if err != nil {
+			return fmt.Errorf("%w while decoding the trace entry on line %d", err, lineNumber)
+		}
+
+		err = handler(entry)
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,239 @@
+package antiflood
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug"
+)
+
+const traceFilePrefix = "antiflood-trace"
+const traceFileExtension = "jsonl"
+const minTraceBufferSize = 1
+const traceFlushInterval = time.Second
+const megabyte = 1024 * 1024
+
+type traceRecorder struct {
+	entries        chan *TraceEntry
+	file           *os.File
+	writer         *bufio.Writer
+	filePath       string
+	maxFileSize    uint64
+	writtenSize    uint64
+	isFull         atomic.Flag
+	numDropped     atomic.Counter
+	pendingDropped uint64
+	getTimeHandler func() time.Time
+	cancelFunc     func()
+	chanDone       chan struct{}
+	closeErr       error
+}
+
+// NewTraceRecorder creates a new antiflood trace recorder that writes, in a new file, one JSON line with the
+// metadata of each message checked by the antiflood component
+func NewTraceRecorder(cfg config.AntifloodTraceConfig) (*traceRecorder, error) {
+	if cfg.BufferSize < minTraceBufferSize {
+		return nil, fmt.Errorf("%w for BufferSize, minimum is %d", debug.ErrInvalidValue, minTraceBufferSize)
+	}
+	if cfg.MaxFileSizeInMB == 0 {
+		return nil, fmt.Errorf("%w for MaxFileSizeInMB, should be positive", debug.ErrInvalidValue)
+	}
+
+	file, err := core.CreateFile(core.ArgCreateFileArgument{
+		Directory:     cfg.Directory,
+		Prefix:        traceFilePrefix,
+		FileExtension: traceFileExtension,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w when creating the antiflood trace file", err)
+	}
+
+	tr := &traceRecorder{
+		entries:        make(chan *TraceEntry, cfg.BufferSize),
+		file:           file,
+		writer:         bufio.NewWriter(file),
+		filePath:       file.Name(),
+		maxFileSize:    uint64(cfg.MaxFileSizeInMB) * megabyte,
+		getTimeHandler: time.Now,
+		chanDone:       make(chan struct{}),
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	tr.cancelFunc = cancelFunc
+	go tr.writeContinuously(ctx)
+
+	log.Info("antiflood trace recorder started", "file", tr.filePath)
+
+	return tr, nil
+}
+
+// RecordMessage records a message that is checked against the flood preventers
+func (tr *traceRecorder) RecordMessage(fromConnectedPeer core.PeerID, originator core.PeerID, topic string, size uint64, sequence []byte) {
+	tr.record(&TraceEntry{
+		Type:        MessageTraceType,
+		Peer:        fromConnectedPeer.Pretty(),
+		Originator:  originator.Pretty(),
+		Topic:       topic,
+		NumMessages: 1,
+		Size:        size,
+		Sequence:    sequenceToUint64(sequence),
+	})
+}
+
+// RecordMessagesOnTopic records a batch of messages that is checked against the topic flood preventer
+func (tr *traceRecorder) RecordMessagesOnTopic(pid core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) {
+	tr.record(&TraceEntry{
+		Type:        TopicTraceType,
+		Peer:        pid.Pretty(),
+		Topic:       topic,
+		NumMessages: numMessages,
+		Size:        totalSize,
+		Sequence:    sequenceToUint64(sequence),
+	})
+}
+
+func (tr *traceRecorder) record(entry *TraceEntry) {
+	if tr.isFull.IsSet() {
+		tr.numDropped.Increment()
+		return
+	}
+
+	entry.Timestamp = tr.getTimeHandler().UnixNano()
+
+	select {
+	case tr.entries <- entry:
+	default:
+		tr.numDropped.Increment()
+	}
+}
+
+func (tr *traceRecorder) writeContinuously(ctx context.Context) {
+	defer close(tr.chanDone)
+
+	for {
+		select {
+		case entry := <-tr.entries:
+			tr.writeEntry(entry)
+		case <-time.After(traceFlushInterval):
+			tr.flush()
+		case <-ctx.Done():
+			log.Debug("antiflood trace recorder writeContinuously go routine is stopping...")
+			tr.writePendingEntries()
+			tr.writeDroppedEntry(true)
+			tr.flush()
+			tr.closeErr = tr.file.Close()
+			return
+		}
+	}
+}
+
+func (tr *traceRecorder) writePendingEntries() {
+	for {
+		select {
+		case entry := <-tr.entries:
+			tr.writeEntry(entry)
+		default:
+			return
+		}
+	}
+}
+
+func (tr *traceRecorder) writeEntry(entry *TraceEntry) {
+	if tr.isFull.IsSet() {
+		tr.numDropped.Increment()
+		return
+	}
+
+	if !tr.writeLine(entry, false) {
+		tr.numDropped.Increment()
+	}
+}
+
+// writeLine returns true if the entry was written
+func (tr *traceRecorder) writeLine(entry *TraceEntry, ignoreMaxFileSize bool) bool {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Warn("error encoding antiflood trace entry", "error", err)
+		return false
+	}
+	line = append(line, newLineChar...)
+
+	if !ignoreMaxFileSize && tr.writtenSize+uint64(len(line)) > tr.maxFileSize {
+		tr.isFull.SetValue(true)
+		log.Warn("antiflood trace file reached the maximum size, recording stopped", "file", tr.filePath)
+		return false
+	}
+
+	n, err := tr.writer.Write(line)
+	tr.writtenSize += uint64(n)
+	if err != nil {
+		log.Warn("error writing antiflood trace entry", "file", tr.filePath, "error", err)
+		return false
+	}
+
+	return true
+}
+
+// writeDroppedEntry records the number of entries dropped since the last dropped entry written. While the trace file is
+// full, the number is accumulated and written past the maximum file size when the recording stops
+func (tr *traceRecorder) writeDroppedEntry(isStopping bool) {
+	tr.pendingDropped += uint64(tr.numDropped.Reset())
+	if tr.pendingDropped == 0 {
+		return
+	}
+
+	isFull := tr.isFull.IsSet()
+	if isFull && !isStopping {
+		return
+	}
+
+	entry := &TraceEntry{
+		Type:       DroppedTraceType,
+		Timestamp:  tr.getTimeHandler().UnixNano(),
+		NumDropped: tr.pendingDropped,
+	}
+	if !tr.writeLine(entry, isFull) {
+		return
+	}
+
+	log.Debug("antiflood trace recorder dropped entries", "num dropped", tr.pendingDropped)
+	tr.pendingDropped = 0
+}
+
+func (tr *traceRecorder) flush() {
+	tr.writeDroppedEntry(false)
+
+	err := tr.writer.Flush()
+	if err != nil {
+		log.Warn("error flushing antiflood trace file", "file", tr.filePath, "error", err)
+	}
+}
+
+// Close stops the recording and closes the trace file
+func (tr *traceRecorder) Close() error {
+	tr.cancelFunc()
+	<-tr.chanDone
+
+	return tr.closeErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tr *traceRecorder) IsInterfaceNil() bool {
+	return tr == nil
+}
+
+func sequenceToUint64(sequence []byte) uint64 {
+	if len(sequence) < sizeUint64 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(sequence)
+}
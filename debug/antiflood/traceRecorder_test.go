@@ -0,0 +1,207 @@
+package antiflood
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTraceConfig(directory string) config.AntifloodTraceConfig {
+	return config.AntifloodTraceConfig{
+		Enabled:         true,
+		Directory:       directory,
+		BufferSize:      100,
+		MaxFileSizeInMB: 1,
+	}
+}
+
+func readTraceFiles(t *testing.T, directory string) []*TraceEntry {
+	files, err := filepath.Glob(filepath.Join(directory, traceFilePrefix+"*."+traceFileExtension))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(files))
+
+	file, err := os.Open(files[0])
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	entries := make([]*TraceEntry, 0)
+	err = ReadTrace(file, func(entry *TraceEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.Nil(t, err)
+
+	return entries
+}
+
+func TestNewTraceRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTraceConfig(t.TempDir())
+		cfg.BufferSize = 0
+		tr, err := NewTraceRecorder(cfg)
+		assert.True(t, check.IfNil(tr))
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+	})
+	t.Run("invalid max file size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTraceConfig(t.TempDir())
+		cfg.MaxFileSizeInMB = 0
+		tr, err := NewTraceRecorder(cfg)
+		assert.True(t, check.IfNil(tr))
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr, err := NewTraceRecorder(createTraceConfig(t.TempDir()))
+		assert.False(t, check.IfNil(tr))
+		assert.Nil(t, err)
+		assert.Nil(t, tr.Close())
+	})
+}
+
+func TestTraceRecorder_RecordShouldWriteEntries(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	tr, _ := NewTraceRecorder(createTraceConfig(directory))
+	providedTime := time.Unix(1000, 5)
+	tr.getTimeHandler = func() time.Time {
+		return providedTime
+	}
+
+	sequence := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequence, 37)
+	tr.RecordMessage("connected", "originator", "topic", 100, sequence)
+	tr.RecordMessagesOnTopic("connected", "topic", 3, 300, nil)
+	require.Nil(t, tr.Close())
+
+	entries := readTraceFiles(t, directory)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, &TraceEntry{
+		Type:        MessageTraceType,
+		Timestamp:   providedTime.UnixNano(),
+		Peer:        core.PeerID("connected").Pretty(),
+		Originator:  core.PeerID("originator").Pretty(),
+		Topic:       "topic",
+		NumMessages: 1,
+		Size:        100,
+		Sequence:    37,
+	}, entries[0])
+	assert.Equal(t, &TraceEntry{
+		Type:        TopicTraceType,
+		Timestamp:   providedTime.UnixNano(),
+		Peer:        core.PeerID("connected").Pretty(),
+		Topic:       "topic",
+		NumMessages: 3,
+		Size:        300,
+	}, entries[1])
+}
+
+func TestTraceRecorder_MaxFileSizeReachedShouldStopRecording(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	tr, _ := NewTraceRecorder(createTraceConfig(directory))
+	tr.maxFileSize = 300
+
+	for i := 0; i < 10; i++ {
+		tr.RecordMessagesOnTopic("connected", "topic", 1, 1, nil)
+		time.Sleep(time.Millisecond * 10)
+	}
+	require.Nil(t, tr.Close())
+
+	entries := readTraceFiles(t, directory)
+	require.True(t, len(entries) > 1)
+	assert.True(t, len(entries) < 10)
+	assert.True(t, tr.isFull.IsSet())
+
+	// the entries not recorded are counted in a trailer entry written past the maximum file size
+	numRecorded := len(entries) - 1
+	for _, entry := range entries[:numRecorded] {
+		assert.Equal(t, TopicTraceType, entry.Type)
+	}
+	trailer := entries[numRecorded]
+	assert.Equal(t, DroppedTraceType, trailer.Type)
+	assert.Equal(t, uint64(10-numRecorded), trailer.NumDropped)
+}
+
+func TestTraceRecorder_DroppedEntriesShouldBeRecorded(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	tr, _ := NewTraceRecorder(createTraceConfig(directory))
+	providedTime := time.Unix(1000, 5)
+	tr.getTimeHandler = func() time.Time {
+		return providedTime
+	}
+
+	tr.RecordMessagesOnTopic("connected", "topic", 1, 1, nil)
+	// as if the recording buffer was full
+	tr.numDropped.Add(5)
+	require.Nil(t, tr.Close())
+
+	entries := readTraceFiles(t, directory)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, TopicTraceType, entries[0].Type)
+	assert.Equal(t, &TraceEntry{
+		Type:       DroppedTraceType,
+		Timestamp:  providedTime.UnixNano(),
+		NumDropped: 5,
+	}, entries[1])
+}
+
+func TestReadTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid line should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := ReadTrace(strings.NewReader("{\"type\":\"topic\"}\ninvalid\n"), func(entry *TraceEntry) error {
+			return nil
+		})
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "line 2"))
+	})
+	t.Run("handler error should stop reading", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := ReadTrace(strings.NewReader("{\"type\":\"topic\"}\n\n{\"type\":\"message\"}\n"), func(entry *TraceEntry) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		types := make([]string, 0)
+		err := ReadTrace(strings.NewReader("{\"type\":\"topic\"}\n\n{\"type\":\"message\"}\n"), func(entry *TraceEntry) error {
+			types = append(types, entry.Type)
+			return nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{TopicTraceType, MessageTraceType}, types)
+	})
+}
@@ -331,7 +331,8 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 		log.Warn("epochStartBootstrap.Bootstrap: forcing start from network")
 	}
 
-	shouldStartFromNetwork := e.generalConfig.GeneralSettings.StartInEpochEnabled || e.flagsConfig.ForceStartFromNetwork
+	shouldStartFromNetwork := e.generalConfig.GeneralSettings.StartInEpochEnabled || e.flagsConfig.ForceStartFromNetwork ||
+		e.shouldImportStateSnapshot()
 	if !shouldStartFromNetwork {
 		return e.bootstrapFromLocalStorage()
 	}
@@ -346,11 +347,12 @@ func (e *epochStartBootstrap) Bootstrap() (Parameters, error) {
 
 	e.dataPool, err = factoryDataPool.NewDataPoolFromConfig(
 		factoryDataPool.ArgsDataPool{
-			Config:           &e.generalConfig,
-			EconomicsData:    e.economicsData,
-			ShardCoordinator: e.shardCoordinator,
-			Marshalizer:      e.coreComponentsHolder.InternalMarshalizer(),
-			PathManager:      e.coreComponentsHolder.PathHandler(),
+			Config:                 &e.generalConfig,
+			EconomicsData:          e.economicsData,
+			ShardCoordinator:       e.shardCoordinator,
+			Marshalizer:            e.coreComponentsHolder.InternalMarshalizer(),
+			PathManager:            e.coreComponentsHolder.PathHandler(),
+			AddressPubkeyConverter: e.coreComponentsHolder.AddressPubKeyConverter(),
 		},
 	)
 	if err != nil {
@@ -812,14 +814,7 @@ func (e *epochStartBootstrap) requestAndProcessForMeta(peerMiniBlocks []*block.M
 	e.trieContainer = triesContainer
 	e.trieStorageManagers = trieStorageManagers
 
-	log.Debug("start in epoch bootstrap: started syncValidatorAccountsState")
-	err = e.syncValidatorAccountsState(e.epochStartMeta.GetValidatorStatsRootHash())
-	if err != nil {
-		return err
-	}
-	log.Debug("start in epoch bootstrap: syncUserAccountsState")
-
-	err = e.syncUserAccountsState(e.epochStartMeta.GetRootHash())
+	err = e.syncOrImportStateForMeta()
 	if err != nil {
 		return err
 	}
@@ -872,6 +867,22 @@ func (e *epochStartBootstrap) computeAllPendingMiniblocksHeaders() []data.MiniBl
 	return allPendingMiniblocksHeaders
 }
 
+func (e *epochStartBootstrap) syncOrImportStateForMeta() error {
+	imported, err := e.importStateSnapshotForMeta()
+	if err != nil || imported {
+		return err
+	}
+
+	log.Debug("start in epoch bootstrap: started syncValidatorAccountsState")
+	err = e.syncValidatorAccountsState(e.epochStartMeta.GetValidatorStatsRootHash())
+	if err != nil {
+		return err
+	}
+	log.Debug("start in epoch bootstrap: syncUserAccountsState")
+
+	return e.syncUserAccountsState(e.epochStartMeta.GetRootHash())
+}
+
 func (e *epochStartBootstrap) findSelfShardEpochStartData() (data.EpochStartShardDataHandler, error) {
 	var epochStartData data.EpochStartShardDataHandler
 	lastFinalizedHeaderHandlers := e.epochStartMeta.GetEpochStartHandler().GetLastFinalizedHeaderHandlers()
@@ -980,12 +991,10 @@ func (e *epochStartBootstrap) requestAndProcessForShard(peerMiniBlocks []*block.
 	e.trieContainer = triesContainer
 	e.trieStorageManagers = trieStorageManagers
 
-	log.Debug("start in epoch bootstrap: started syncUserAccountsState", "rootHash", dts.rootHashToSync)
-	err = e.syncUserAccountsState(dts.rootHashToSync)
+	err = e.syncOrImportStateForShard(dts.rootHashToSync, epochStartData.GetHeaderHash())
 	if err != nil {
 		return err
 	}
-	log.Debug("start in epoch bootstrap: syncUserAccountsState")
 
 	components := &ComponentsNeededForBootstrap{
 		EpochStartMetaBlock: e.epochStartMeta,
@@ -1006,6 +1015,22 @@ func (e *epochStartBootstrap) requestAndProcessForShard(peerMiniBlocks []*block.
 	return nil
 }
 
+func (e *epochStartBootstrap) syncOrImportStateForShard(rootHash []byte, shardHeaderHash []byte) error {
+	imported, err := e.importStateSnapshotForShard(rootHash, shardHeaderHash)
+	if err != nil || imported {
+		return err
+	}
+
+	log.Debug("start in epoch bootstrap: started syncUserAccountsState", "rootHash", rootHash)
+	err = e.syncUserAccountsState(rootHash)
+	if err != nil {
+		return err
+	}
+	log.Debug("start in epoch bootstrap: syncUserAccountsState")
+
+	return nil
+}
+
 func (e *epochStartBootstrap) getDataToSync(
 	epochStartData data.EpochStartShardDataHandler,
 	shardNotarizedHeader data.ShardHeaderHandler,
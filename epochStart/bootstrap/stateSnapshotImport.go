@@ -0,0 +1,139 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/state/portableSnapshot"
+	"github.com/multiversx/mx-chain-go/trie/storageMarker"
+)
+
+func (e *epochStartBootstrap) shouldImportStateSnapshot() bool {
+	return len(e.flagsConfig.StateSnapshotFile) > 0
+}
+
+// importStateSnapshotForMeta rebuilds the validator and user accounts tries from the state snapshot file, if one was
+// provided. It returns false if the tries still have to be synced from the network
+func (e *epochStartBootstrap) importStateSnapshotForMeta() (bool, error) {
+	expectedTries := map[string]portableSnapshot.ExpectedTrie{
+		dataRetriever.UserAccountsUnit.String(): {
+			RootHash:     e.epochStartMeta.GetRootHash(),
+			HasDataTries: true,
+		},
+		dataRetriever.PeerAccountsUnit.String(): {
+			RootHash: e.epochStartMeta.GetValidatorStatsRootHash(),
+		},
+	}
+
+	return e.importStateSnapshot(expectedTries, nil)
+}
+
+// importStateSnapshotForShard rebuilds the user accounts trie from the state snapshot file, if one was provided.
+// It returns false if the trie still has to be synced from the network
+func (e *epochStartBootstrap) importStateSnapshotForShard(rootHash []byte, shardHeaderHash []byte) (bool, error) {
+	expectedTries := map[string]portableSnapshot.ExpectedTrie{
+		dataRetriever.UserAccountsUnit.String(): {
+			RootHash:     rootHash,
+			HasDataTries: true,
+		},
+	}
+
+	return e.importStateSnapshot(expectedTries, shardHeaderHash)
+}
+
+func (e *epochStartBootstrap) importStateSnapshot(expectedTries map[string]portableSnapshot.ExpectedTrie, shardHeaderHash []byte) (bool, error) {
+	if !e.shouldImportStateSnapshot() {
+		return false, nil
+	}
+
+	file, err := os.Open(e.flagsConfig.StateSnapshotFile)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		errClose := file.Close()
+		log.LogIfError(errClose, "source", "importStateSnapshot", "file", e.flagsConfig.StateSnapshotFile)
+	}()
+
+	reader, err := portableSnapshot.NewSnapshotReader(file, e.coreComponentsHolder.Hasher())
+	if err != nil {
+		return false, err
+	}
+
+	isUsable, err := e.checkStateSnapshotManifest(reader.Manifest(), shardHeaderHash)
+	if err != nil || !isUsable {
+		return false, err
+	}
+
+	snapshotImporter, err := portableSnapshot.NewImporter(portableSnapshot.ArgsImporter{
+		Marshaller:           e.coreComponentsHolder.InternalMarshalizer(),
+		Hasher:               e.coreComponentsHolder.Hasher(),
+		EnableEpochsHandler:  e.coreComponentsHolder.EnableEpochsHandler(),
+		StorageMarker:        storageMarker.NewTrieStorageMarker(),
+		MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManagers := make(map[string]common.StorageManager, len(e.trieStorageManagers))
+	for identifier, trieStorageManager := range e.trieStorageManagers {
+		trieStorageManagers[identifier] = trieStorageManager
+	}
+	e.mutTrieStorageManagers.RUnlock()
+
+	log.Info("start in epoch bootstrap: importing the state from snapshot", "file", e.flagsConfig.StateSnapshotFile)
+	err = snapshotImporter.Import(reader, expectedTries, trieStorageManagers)
+	if err != nil {
+		return false, fmt.Errorf("%w while importing the state snapshot %s", err, e.flagsConfig.StateSnapshotFile)
+	}
+	log.Info("start in epoch bootstrap: imported the state from snapshot", "num leaves", reader.Trailer().NumLeaves)
+
+	return true, nil
+}
+
+// checkStateSnapshotManifest returns false if the snapshot was created for another epoch or shard, so the node can
+// fall back to the network sync. A snapshot of the same epoch that does not match the epoch start data is an error
+func (e *epochStartBootstrap) checkStateSnapshotManifest(manifest *portableSnapshot.Manifest, shardHeaderHash []byte) (bool, error) {
+	chainID := e.coreComponentsHolder.ChainID()
+	if manifest.ChainID != chainID {
+		return false, fmt.Errorf("%w: snapshot chain ID %s, node chain ID %s",
+			epochStart.ErrStateSnapshotMismatch, manifest.ChainID, chainID)
+	}
+
+	epoch := e.epochStartMeta.GetEpoch()
+	if manifest.Epoch != epoch {
+		log.Warn("state snapshot is not for the latest epoch start, will sync the state from the network",
+			"snapshot epoch", manifest.Epoch, "epoch", epoch)
+		return false, nil
+	}
+
+	selfShardID := e.shardCoordinator.SelfId()
+	if manifest.ShardID != selfShardID {
+		log.Warn("state snapshot is for another shard, will sync the state from the network",
+			"snapshot shard", manifest.ShardID, "shard", selfShardID)
+		return false, nil
+	}
+
+	epochStartMetaHash, err := core.CalculateHash(e.coreComponentsHolder.InternalMarshalizer(), e.coreComponentsHolder.Hasher(), e.epochStartMeta)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(manifest.EpochStartMetaHash, epochStartMetaHash) {
+		return false, fmt.Errorf("%w: snapshot epoch start meta hash %x, synced epoch start meta hash %x",
+			epochStart.ErrStateSnapshotMismatch, manifest.EpochStartMetaHash, epochStartMetaHash)
+	}
+
+	if selfShardID != core.MetachainShardId && !bytes.Equal(manifest.ShardHeaderHash, shardHeaderHash) {
+		return false, fmt.Errorf("%w: snapshot shard header hash %x, epoch start shard header hash %x",
+			epochStart.ErrStateSnapshotMismatch, manifest.ShardHeaderHash, shardHeaderHash)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,219 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/epochStart"
+	"github.com/multiversx/mx-chain-go/epochStart/mock"
+	"github.com/multiversx/mx-chain-go/state/portableSnapshot"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "chain"
+
+type snapshotTestTries struct {
+	userRootHash []byte
+	peerRootHash []byte
+	snapshotFile string
+}
+
+func createSnapshotTestTrie(t *testing.T, coreComp *mock.CoreComponentsMock, prefix string) (common.Trie, []byte) {
+	tsm, err := trie.NewTrieStorageManager(storageMock.GetStorageManagerArgs())
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(tsm, coreComp.InternalMarshalizer(), coreComp.Hasher(), coreComp.EnableEpochsHandler(), 5)
+	require.Nil(t, err)
+
+	for _, suffix := range []string{"a", "b", "c", "d"} {
+		require.Nil(t, tr.Update([]byte(prefix+"key"+suffix), []byte(prefix+"value"+suffix)))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return tr, rootHash
+}
+
+func createTrieLeavesProvider(tr common.Trie) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			return tr.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), trieLeafParser)
+		},
+	}
+}
+
+func writeTestStateSnapshot(t *testing.T, coreComp *mock.CoreComponentsMock, epochStartMeta *block.MetaBlock) *snapshotTestTries {
+	userTrie, userRootHash := createSnapshotTestTrie(t, coreComp, "user")
+	peerTrie, peerRootHash := createSnapshotTestTrie(t, coreComp, "peer")
+	epochStartMeta.RootHash = userRootHash
+	epochStartMeta.ValidatorStatsRootHash = peerRootHash
+
+	metaBytes, err := coreComp.InternalMarshalizer().Marshal(epochStartMeta)
+	require.Nil(t, err)
+
+	exporter, err := portableSnapshot.NewExporter(portableSnapshot.ArgsExporter{
+		Marshaller:        coreComp.InternalMarshalizer(),
+		Hasher:            coreComp.Hasher(),
+		MaxLeavesPerChunk: 3,
+	})
+	require.Nil(t, err)
+
+	snapshotFile := filepath.Join(t.TempDir(), "state.snapshot")
+	file, err := os.Create(snapshotFile)
+	require.Nil(t, err)
+	_, err = exporter.Export(file, portableSnapshot.ArgsExport{
+		ChainID:            testChainID,
+		ShardID:            core.MetachainShardId,
+		Epoch:              epochStartMeta.Epoch,
+		EpochStartMetaHash: coreComp.Hasher().Compute(string(metaBytes)),
+		EpochStartMeta:     metaBytes,
+		Tries: []*portableSnapshot.TrieToExport{
+			{
+				Identifier:     dataRetriever.UserAccountsUnit.String(),
+				RootHash:       userRootHash,
+				LeavesProvider: createTrieLeavesProvider(userTrie),
+				HasDataTries:   true,
+			},
+			{
+				Identifier:     dataRetriever.PeerAccountsUnit.String(),
+				RootHash:       peerRootHash,
+				LeavesProvider: createTrieLeavesProvider(peerTrie),
+			},
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return &snapshotTestTries{
+		userRootHash: userRootHash,
+		peerRootHash: peerRootHash,
+		snapshotFile: snapshotFile,
+	}
+}
+
+func createEpochStartBootstrapForSnapshot(t *testing.T) (*epochStartBootstrap, *snapshotTestTries) {
+	coreComp, cryptoComp := createComponentsForEpochStart()
+	coreComp.ChainIdCalled = func() string {
+		return testChainID
+	}
+	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+
+	epochStartMeta := &block.MetaBlock{Epoch: 4, Nonce: 400}
+	testTries := writeTestStateSnapshot(t, coreComp, epochStartMeta)
+	args.FlagsConfig.StateSnapshotFile = testTries.snapshotFile
+
+	epochStartProvider, err := NewEpochStartBootstrap(args)
+	require.Nil(t, err)
+	epochStartProvider.epochStartMeta = epochStartMeta
+	shardCoordinator := mock.NewMultipleShardsCoordinatorMock()
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	epochStartProvider.shardCoordinator = shardCoordinator
+
+	trieStorageManagers := make(map[string]common.StorageManager)
+	for _, identifier := range []string{dataRetriever.UserAccountsUnit.String(), dataRetriever.PeerAccountsUnit.String()} {
+		trieStorageManagers[identifier], err = trie.NewTrieStorageManager(storageMock.GetStorageManagerArgs())
+		require.Nil(t, err)
+	}
+	epochStartProvider.trieStorageManagers = trieStorageManagers
+
+	return epochStartProvider, testTries
+}
+
+func TestEpochStartBootstrap_ImportStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no snapshot file should not import", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, _ := createEpochStartBootstrapForSnapshot(t)
+		epochStartProvider.flagsConfig.StateSnapshotFile = ""
+
+		imported, err := epochStartProvider.importStateSnapshotForMeta()
+		assert.Nil(t, err)
+		assert.False(t, imported)
+	})
+	t.Run("missing snapshot file should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, _ := createEpochStartBootstrapForSnapshot(t)
+		epochStartProvider.flagsConfig.StateSnapshotFile = filepath.Join(t.TempDir(), "missing")
+
+		imported, err := epochStartProvider.importStateSnapshotForMeta()
+		assert.NotNil(t, err)
+		assert.False(t, imported)
+	})
+	t.Run("snapshot of an older epoch should fall back to the network sync", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, _ := createEpochStartBootstrapForSnapshot(t)
+		epochStartProvider.epochStartMeta.(*block.MetaBlock).Epoch = 5
+
+		imported, err := epochStartProvider.importStateSnapshotForMeta()
+		assert.Nil(t, err)
+		assert.False(t, imported)
+	})
+	t.Run("snapshot of another shard should fall back to the network sync", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, testTries := createEpochStartBootstrapForSnapshot(t)
+		epochStartProvider.shardCoordinator = mock.NewMultipleShardsCoordinatorMock()
+
+		imported, err := epochStartProvider.importStateSnapshotForShard(testTries.userRootHash, []byte("header hash"))
+		assert.Nil(t, err)
+		assert.False(t, imported)
+	})
+	t.Run("snapshot of another chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, _ := createEpochStartBootstrapForSnapshot(t)
+		epochStartProvider.coreComponentsHolder.(*mock.CoreComponentsMock).ChainIdCalled = func() string {
+			return "another chain"
+		}
+
+		imported, err := epochStartProvider.importStateSnapshotForMeta()
+		assert.True(t, errors.Is(err, epochStart.ErrStateSnapshotMismatch))
+		assert.False(t, imported)
+	})
+	t.Run("different epoch start meta should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, _ := createEpochStartBootstrapForSnapshot(t)
+		epochStartProvider.epochStartMeta.(*block.MetaBlock).Nonce++
+
+		imported, err := epochStartProvider.importStateSnapshotForMeta()
+		assert.True(t, errors.Is(err, epochStart.ErrStateSnapshotMismatch))
+		assert.False(t, imported)
+	})
+	t.Run("should import the tries committed in the epoch start meta", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider, testTries := createEpochStartBootstrapForSnapshot(t)
+
+		imported, err := epochStartProvider.importStateSnapshotForMeta()
+		require.Nil(t, err)
+		assert.True(t, imported)
+
+		userStorageManager := epochStartProvider.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
+		peerStorageManager := epochStartProvider.trieStorageManagers[dataRetriever.PeerAccountsUnit.String()]
+		_, err = userStorageManager.Get(testTries.userRootHash)
+		assert.Nil(t, err)
+		_, err = peerStorageManager.Get(testTries.peerRootHash)
+		assert.Nil(t, err)
+
+		val, err := userStorageManager.Get([]byte(common.TrieSyncedKey))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(common.TrieSyncedVal), val)
+	})
+}
@@ -104,11 +104,12 @@ func (sesb *storageEpochStartBootstrap) Bootstrap() (Parameters, error) {
 
 	sesb.dataPool, err = factoryDataPool.NewDataPoolFromConfig(
 		factoryDataPool.ArgsDataPool{
-			Config:           &sesb.generalConfig,
-			EconomicsData:    sesb.economicsData,
-			ShardCoordinator: sesb.shardCoordinator,
-			Marshalizer:      sesb.coreComponentsHolder.InternalMarshalizer(),
-			PathManager:      sesb.coreComponentsHolder.PathHandler(),
+			Config:                 &sesb.generalConfig,
+			EconomicsData:          sesb.economicsData,
+			ShardCoordinator:       sesb.shardCoordinator,
+			Marshalizer:            sesb.coreComponentsHolder.InternalMarshalizer(),
+			PathManager:            sesb.coreComponentsHolder.PathHandler(),
+			AddressPubkeyConverter: sesb.coreComponentsHolder.AddressPubKeyConverter(),
 		},
 	)
 	if err != nil {
@@ -331,3 +331,6 @@ var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
 
 // ErrNilExecutionOrderHandler signals that a nil execution order handler has been provided
 var ErrNilExecutionOrderHandler = errors.New("nil execution order handler")
+
+// ErrStateSnapshotMismatch signals that the state snapshot does not match the epoch start data
+var ErrStateSnapshotMismatch = errors.New("state snapshot does not match the epoch start data")
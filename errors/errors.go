@@ -253,6 +253,9 @@ var ErrNilOutputAntiFloodHandler = errors.New("nil output antiflood handler")
 // ErrNilPath signals that a nil path was provided
 var ErrNilPath = errors.New("nil path provided")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
 // ErrNilPathHandler signals that a nil path handler was provided
 var ErrNilPathHandler = errors.New("nil path handler")
 
@@ -322,6 +325,9 @@ var ErrNilRoundHandler = errors.New("nil roundHandler")
 // ErrNilShardCoordinator signals that a nil shard coordinator was provided
 var ErrNilShardCoordinator = errors.New("nil shard coordinator provided")
 
+// ErrNilEventsSubscriptionHandler signals that a nil events subscription handler was provided
+var ErrNilEventsSubscriptionHandler = errors.New("nil events subscription handler")
+
 // ErrNilSoftwareVersion signals that a nil software version was provided
 var ErrNilSoftwareVersion = errors.New("nil software version")
 
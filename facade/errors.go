@@ -29,6 +29,12 @@ var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator
 // ErrNilBlockchain signals that a nil blockchain has been provided
 var ErrNilBlockchain = errors.New("nil blockchain")
 
+// ErrNilConfigReloader signals that a nil config reloader has been provided
+var ErrNilConfigReloader = errors.New("nil config reloader")
+
+// ErrNilEventsSubscriptionHandler signals that a nil events subscription handler has been provided
+var ErrNilEventsSubscriptionHandler = errors.New("nil events subscription handler")
+
 // ErrEmptyRootHash signals that the current root hash is empty
 var ErrEmptyRootHash = errors.New("empty current root hash")
 
@@ -44,5 +50,8 @@ var ErrEmptyGasConfigs = errors.New("empty gas configs")
 // ErrTooManyAddressesInBulk signals that there are too many addresses present in a bulk request
 var ErrTooManyAddressesInBulk = errors.New("too many addresses in the bulk request")
 
+// ErrTooManyVmQueriesInBulk signals that there are too many vm queries present in a bulk request
+var ErrTooManyVmQueriesInBulk = errors.New("too many vm queries in the bulk request")
+
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
@@ -3,6 +3,7 @@ package initial
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -71,6 +72,26 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ common.MultiProofRequest) (*common.MultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ common.VerifyMultiProofRequest) (bool, error) {
+	return false, errNodeStarting
+}
+
+// GetStateDiff -
+func (inf *initialNodeFacade) GetStateDiff(_ common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStorageReport -
+func (inf *initialNodeFacade) GetStorageReport(_ common.StorageReportRequest) (*common.StorageReport, error) {
+	return nil, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -156,6 +177,11 @@ func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transa
 	return nil, errNodeStarting
 }
 
+// TraceTransaction returns nil and error
+func (inf *initialNodeFacade) TraceTransaction(_ string) (*txSimData.TransactionTrace, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransaction returns nil and error
 func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.ApiTransactionResult, error) {
 	return nil, errNodeStarting
@@ -206,6 +232,11 @@ func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputAp
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// ExecuteSCQueries returns nil and error
+func (inf *initialNodeFacade) ExecuteSCQueries(_ []*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // PprofEnabled returns false
 func (inf *initialNodeFacade) PprofEnabled() bool {
 	return inf.pprofEnabled
@@ -377,10 +408,19 @@ func (inf *initialNodeFacade) GetGenesisBalances() ([]*common.InitialAccountAPI,
 }
 
 // GetTransactionsPool returns a nil structure and error
-func (inf *initialNodeFacade) GetTransactionsPool(_ string) (*common.TransactionsPoolAPIResponse, error) {
+func (inf *initialNodeFacade) GetTransactionsPool(_ string, _ common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	return nil, errNodeStarting
 }
 
+// SubscribeTransactionsPool returns nil and error
+func (inf *initialNodeFacade) SubscribeTransactionsPool(_ common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	return nil, errNodeStarting
+}
+
+// UnsubscribeTransactionsPool does nothing
+func (inf *initialNodeFacade) UnsubscribeTransactionsPool(_ uint64) {
+}
+
 // GetLastPoolNonceForSender returns nonce 0 and error
 func (inf *initialNodeFacade) GetLastPoolNonceForSender(_ string) (uint64, error) {
 	return 0, errNodeStarting
@@ -391,6 +431,85 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionsReplacedInPool returns a nil slice and error
+func (inf *initialNodeFacade) GetTransactionsReplacedInPool(_ *transaction.Transaction, _ []byte) ([]string, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolPolicyCounters returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsForAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsForAddress(_ string, _ uint64, _ uint64) (*common.AddressTransactionsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// SubscribeEvents returns a nil subscription and error
+func (inf *initialNodeFacade) SubscribeEvents(_ common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	return nil, errNodeStarting
+}
+
+// UnsubscribeEvents does nothing
+func (inf *initialNodeFacade) UnsubscribeEvents(_ uint64) {
+}
+
+// ReloadConfig returns nil and error
+func (inf *initialNodeFacade) ReloadConfig() ([]common.ConfigChange, error) {
+	return nil, errNodeStarting
+}
+
+// GetRedundancyLeaseStatus returns an empty structure and error
+func (inf *initialNodeFacade) GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error) {
+	return common.RedundancyLeaseStatus{}, errNodeStarting
+}
+
+// GetPeersReputation returns nil and error
+func (inf *initialNodeFacade) GetPeersReputation() ([]*common.PeerReputation, error) {
+	return nil, errNodeStarting
+}
+
+// BanPeer returns error
+func (inf *initialNodeFacade) BanPeer(_ string, _ time.Duration, _ string) error {
+	return errNodeStarting
+}
+
+// UnbanPeer returns error
+func (inf *initialNodeFacade) UnbanPeer(_ string) error {
+	return errNodeStarting
+}
+
+// BanIP returns error
+func (inf *initialNodeFacade) BanIP(_ string, _ time.Duration, _ string) error {
+	return errNodeStarting
+}
+
+// UnbanIP returns error
+func (inf *initialNodeFacade) UnbanIP(_ string) error {
+	return errNodeStarting
+}
+
+// GetOutportDriversCursors returns nil and error
+func (inf *initialNodeFacade) GetOutportDriversCursors() (map[string]uint64, error) {
+	return nil, errNodeStarting
+}
+
+// ReplayOutport returns error
+func (inf *initialNodeFacade) ReplayOutport(_ string, _ uint64) error {
+	return errNodeStarting
+}
+
+// PromoteRedundancyLease returns an empty structure and error
+func (inf *initialNodeFacade) PromoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	return common.RedundancyLeaseStatus{}, errNodeStarting
+}
+
+// DemoteRedundancyLease returns an empty structure and error
+func (inf *initialNodeFacade) DemoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	return common.RedundancyLeaseStatus{}, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
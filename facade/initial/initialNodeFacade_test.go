@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -90,6 +91,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	trace, err := inf.TraceTransaction("")
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
@@ -113,6 +118,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, vo)
 	assert.Equal(t, errNodeStarting, err)
 
+	queryResults, _, err := inf.ExecuteSCQueries(nil)
+	assert.Nil(t, queryResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	b = inf.PprofEnabled()
 	assert.True(t, b)
 
@@ -172,6 +181,22 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.False(t, b)
 	assert.Equal(t, errNodeStarting, err)
 
+	multiProof, err := inf.GetMultiProof(common.MultiProofRequest{})
+	assert.Nil(t, multiProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, err = inf.VerifyMultiProof(common.VerifyMultiProofRequest{})
+	assert.False(t, b)
+	assert.Equal(t, errNodeStarting, err)
+
+	stateDiff, err := inf.GetStateDiff(common.StateDiffRequest{})
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, errNodeStarting, err)
+
+	storageReport, err := inf.GetStorageReport(common.StorageReportRequest{})
+	assert.Nil(t, storageReport)
+	assert.Equal(t, errNodeStarting, err)
+
 	sa, _, err := inf.GetNFTTokenIDsRegisteredByAddress("", api.AccountQueryOptions{})
 	assert.Nil(t, sa)
 	assert.Equal(t, errNodeStarting, err)
@@ -207,7 +232,7 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, supply)
 	assert.Equal(t, errNodeStarting, err)
 
-	txPool, err := inf.GetTransactionsPool("")
+	txPool, err := inf.GetTransactionsPool("", common.TransactionsPoolFilter{})
 	assert.Nil(t, txPool)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -316,6 +341,56 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, txPoolGaps)
 	assert.Equal(t, errNodeStarting, err)
 
+	txPoolSubscription, err := inf.SubscribeTransactionsPool(common.TransactionsPoolFilter{})
+	assert.Nil(t, txPoolSubscription)
+	assert.Equal(t, errNodeStarting, err)
+
+	txPoolPolicyCounters, err := inf.GetTransactionsPoolPolicyCounters()
+	assert.Nil(t, txPoolPolicyCounters)
+	assert.Equal(t, errNodeStarting, err)
+
+	replacedTxsHashes, err := inf.GetTransactionsReplacedInPool(nil, nil)
+	assert.Nil(t, replacedTxsHashes)
+	assert.Equal(t, errNodeStarting, err)
+
+	addressTxs, err := inf.GetTransactionsForAddress("", 0, 0)
+	assert.Nil(t, addressTxs)
+	assert.Equal(t, errNodeStarting, err)
+
+	subscription, err := inf.SubscribeEvents(common.EventsSubscriptionFilter{})
+	assert.Nil(t, subscription)
+	assert.Equal(t, errNodeStarting, err)
+
+	configChanges, err := inf.ReloadConfig()
+	assert.Nil(t, configChanges)
+	assert.Equal(t, errNodeStarting, err)
+
+	leaseStatus, err := inf.GetRedundancyLeaseStatus()
+	assert.Equal(t, common.RedundancyLeaseStatus{}, leaseStatus)
+	assert.Equal(t, errNodeStarting, err)
+
+	leaseStatus, err = inf.PromoteRedundancyLease()
+	assert.Equal(t, common.RedundancyLeaseStatus{}, leaseStatus)
+	assert.Equal(t, errNodeStarting, err)
+
+	leaseStatus, err = inf.DemoteRedundancyLease()
+	assert.Equal(t, common.RedundancyLeaseStatus{}, leaseStatus)
+	assert.Equal(t, errNodeStarting, err)
+
+	peersReputation, err := inf.GetPeersReputation()
+	assert.Nil(t, peersReputation)
+	assert.Equal(t, errNodeStarting, err)
+
+	assert.Equal(t, errNodeStarting, inf.BanPeer("", 0, ""))
+	assert.Equal(t, errNodeStarting, inf.UnbanPeer(""))
+	assert.Equal(t, errNodeStarting, inf.BanIP("", 0, ""))
+	assert.Equal(t, errNodeStarting, inf.UnbanIP(""))
+
+	cursors, err := inf.GetOutportDriversCursors()
+	assert.Nil(t, cursors)
+	assert.Equal(t, errNodeStarting, err)
+	assert.Equal(t, errNodeStarting, inf.ReplayOutport("", 0))
+
 	assert.NotNil(t, inf)
 }
 
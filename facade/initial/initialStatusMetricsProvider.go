@@ -41,6 +41,11 @@ func (provider *initialStatusMetricsProvider) StatusMetricsWithoutP2PPrometheusS
 	return "", errNodeStarting
 }
 
+// StatusMetricsWithoutP2POpenMetricsString returns an empty string and the error which specifies that the node is starting
+func (provider *initialStatusMetricsProvider) StatusMetricsWithoutP2POpenMetricsString() (string, error) {
+	return "", errNodeStarting
+}
+
 // EconomicsMetrics returns an empty map and the error which specifies that the node is starting
 func (provider *initialStatusMetricsProvider) EconomicsMetrics() (map[string]interface{}, error) {
 	return getEmptyReturnValues()
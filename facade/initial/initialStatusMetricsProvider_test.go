@@ -55,6 +55,10 @@ func TestNewInitialStatusMetricsProvider(t *testing.T) {
 		assert.Equal(t, errNodeStarting, err)
 		assert.Equal(t, "", metrics)
 
+		metrics, err = provider.StatusMetricsWithoutP2POpenMetricsString()
+		assert.Equal(t, errNodeStarting, err)
+		assert.Equal(t, "", metrics)
+
 		bootstrapMetrics, err := provider.BootstrapMetrics()
 		assert.Nil(t, err)
 		assert.Equal(t, providedMetrics, bootstrapMetrics)
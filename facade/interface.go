@@ -3,6 +3,7 @@ package facade
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -81,6 +82,36 @@ type NodeHandler interface {
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
+	// GetRedundancyLeaseStatus returns the state of the current node in the redundancy leader lease protocol
+	GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error)
+
+	// PromoteRedundancyLease forces the current node to take over the redundancy lease
+	PromoteRedundancyLease() (common.RedundancyLeaseStatus, error)
+
+	// DemoteRedundancyLease forces the current node to release the redundancy lease
+	DemoteRedundancyLease() (common.RedundancyLeaseStatus, error)
+
+	// GetPeersReputation returns the persisted reputation of the known peer IDs, IP addresses and public keys
+	GetPeersReputation() ([]*common.PeerReputation, error)
+
+	// BanPeer manually bans the provided peer ID for the provided duration
+	BanPeer(pid string, duration time.Duration, reason string) error
+
+	// UnbanPeer removes the ban of the provided peer ID
+	UnbanPeer(pid string) error
+
+	// BanIP manually bans the provided IP address for the provided duration
+	BanIP(ip string, duration time.Duration, reason string) error
+
+	// UnbanIP removes the ban of the provided IP address
+	UnbanIP(ip string) error
+
+	// GetOutportDriversCursors returns the last sequence number acknowledged by each driver of the journaled outport
+	GetOutportDriversCursors() (map[string]uint64, error)
+
+	// ReplayOutport delivers again to the provided driver the journal entries starting with the provided sequence number
+	ReplayOutport(driverID string, sequence uint64) error
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 
@@ -101,6 +132,10 @@ type NodeHandler interface {
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error)
+	VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error)
+	GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error)
+	GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 }
 
@@ -113,17 +148,24 @@ type TransactionSimulatorProcessor interface {
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransaction(hash string) (*txSimData.TransactionTrace, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPool(subscriptionID uint64)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error)
+	GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -148,6 +190,19 @@ type ApiResolver interface {
 	IsInterfaceNil() bool
 }
 
+// EventsSubscriptionHandler defines the actions supported by the events subscriptions hub
+type EventsSubscriptionHandler interface {
+	Subscribe(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	Unsubscribe(subscriptionID uint64)
+	IsInterfaceNil() bool
+}
+
+// ConfigReloader defines the component able to reload the hot-reloadable config values
+type ConfigReloader interface {
+	Reload(source string) ([]common.ConfigChange, error)
+	IsInterfaceNil() bool
+}
+
 // HardforkTrigger defines the structure used to trigger hardforks
 type HardforkTrigger interface {
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
@@ -17,9 +17,11 @@ import (
 // ApiResolverStub -
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteSCQueriesHandler                     func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransactionCalled                      func(hash string) (*txSimData.TransactionTrace, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -38,11 +40,16 @@ type ApiResolverStub struct {
 	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
 	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) ([]*state.ShardValidatorInfo, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolCalled                   func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPoolCalled             func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPoolCalled           func(subscriptionID uint64)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCountersCalled     func() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPoolCalled         func(tx *transaction.Transaction, txHash []byte) ([]string, error)
+	GetTransactionsForAddressCalled             func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
 	GetManagedKeysCountCalled                   func() int
 	GetManagedKeysCalled                        func() []string
@@ -104,6 +111,15 @@ func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nil, nil, nil
 }
 
+// ExecuteSCQueries -
+func (ars *ApiResolverStub) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if ars.ExecuteSCQueriesHandler != nil {
+		return ars.ExecuteSCQueriesHandler(queries)
+	}
+
+	return nil, nil, nil
+}
+
 // StatusMetrics -
 func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 	if ars.StatusMetricsHandler != nil {
@@ -130,6 +146,14 @@ func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transac
 	return nil, nil
 }
 
+// TraceTransaction -
+func (ars *ApiResolverStub) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	if ars.TraceTransactionCalled != nil {
+		return ars.TraceTransactionCalled(hash)
+	}
+	return nil, nil
+}
+
 // GetTotalStakedValue -
 func (ars *ApiResolverStub) GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error) {
 	if ars.GetTotalStakedValueHandler != nil {
@@ -190,14 +214,30 @@ func (ars *ApiResolverStub) GetInternalMetaBlockByNonce(format common.ApiOutputF
 }
 
 // GetTransactionsPool -
-func (ars *ApiResolverStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (ars *ApiResolverStub) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	if ars.GetTransactionsPoolCalled != nil {
-		return ars.GetTransactionsPoolCalled(fields)
+		return ars.GetTransactionsPoolCalled(fields, filter)
+	}
+
+	return nil, nil
+}
+
+// SubscribeTransactionsPool -
+func (ars *ApiResolverStub) SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	if ars.SubscribeTransactionsPoolCalled != nil {
+		return ars.SubscribeTransactionsPoolCalled(filter)
 	}
 
 	return nil, nil
 }
 
+// UnsubscribeTransactionsPool -
+func (ars *ApiResolverStub) UnsubscribeTransactionsPool(subscriptionID uint64) {
+	if ars.UnsubscribeTransactionsPoolCalled != nil {
+		ars.UnsubscribeTransactionsPoolCalled(subscriptionID)
+	}
+}
+
 // GetTransactionsPoolForSender -
 func (ars *ApiResolverStub) GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	if ars.GetTransactionsPoolForSenderCalled != nil {
@@ -225,6 +265,33 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nil, nil
 }
 
+// GetTransactionsReplacedInPool -
+func (ars *ApiResolverStub) GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+	if ars.GetTransactionsReplacedInPoolCalled != nil {
+		return ars.GetTransactionsReplacedInPoolCalled(tx, txHash)
+	}
+
+	return make([]string, 0), nil
+}
+
+// GetTransactionsPoolPolicyCounters -
+func (ars *ApiResolverStub) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	if ars.GetTransactionsPoolPolicyCountersCalled != nil {
+		return ars.GetTransactionsPoolPolicyCountersCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (ars *ApiResolverStub) GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	if ars.GetTransactionsForAddressCalled != nil {
+		return ars.GetTransactionsForAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
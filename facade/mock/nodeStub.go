@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -52,8 +53,22 @@ type NodeStub struct {
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(request common.MultiProofRequest) (*common.MultiProofResponse, error)
+	VerifyMultiProofCalled                         func(request common.VerifyMultiProofRequest) (bool, error)
+	GetStateDiffCalled                             func(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error)
+	GetStorageReportCalled                         func(request common.StorageReportRequest) (*common.StorageReport, error)
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
+	GetRedundancyLeaseStatusCalled                 func() (common.RedundancyLeaseStatus, error)
+	PromoteRedundancyLeaseCalled                   func() (common.RedundancyLeaseStatus, error)
+	DemoteRedundancyLeaseCalled                    func() (common.RedundancyLeaseStatus, error)
+	GetPeersReputationCalled                       func() ([]*common.PeerReputation, error)
+	BanPeerCalled                                  func(pid string, duration time.Duration, reason string) error
+	UnbanPeerCalled                                func(pid string) error
+	BanIPCalled                                    func(ip string, duration time.Duration, reason string) error
+	UnbanIPCalled                                  func(ip string) error
+	GetOutportDriversCursorsCalled                 func() (map[string]uint64, error)
+	ReplayOutportCalled                            func(driverID string, sequence uint64) error
 }
 
 // GetProof -
@@ -83,6 +98,42 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(request)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(request)
+	}
+
+	return false, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetStorageReport -
+func (ns *NodeStub) GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error) {
+	if ns.GetStorageReportCalled != nil {
+		return ns.GetStorageReportCalled(request)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (ns *NodeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetUsernameCalled != nil {
@@ -302,6 +353,96 @@ func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string, options ap
 	return make([]string, 0), api.BlockInfo{}, nil
 }
 
+// GetRedundancyLeaseStatus -
+func (ns *NodeStub) GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error) {
+	if ns.GetRedundancyLeaseStatusCalled != nil {
+		return ns.GetRedundancyLeaseStatusCalled()
+	}
+
+	return common.RedundancyLeaseStatus{}, nil
+}
+
+// PromoteRedundancyLease -
+func (ns *NodeStub) PromoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	if ns.PromoteRedundancyLeaseCalled != nil {
+		return ns.PromoteRedundancyLeaseCalled()
+	}
+
+	return common.RedundancyLeaseStatus{}, nil
+}
+
+// DemoteRedundancyLease -
+func (ns *NodeStub) DemoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	if ns.DemoteRedundancyLeaseCalled != nil {
+		return ns.DemoteRedundancyLeaseCalled()
+	}
+
+	return common.RedundancyLeaseStatus{}, nil
+}
+
+// GetPeersReputation -
+func (ns *NodeStub) GetPeersReputation() ([]*common.PeerReputation, error) {
+	if ns.GetPeersReputationCalled != nil {
+		return ns.GetPeersReputationCalled()
+	}
+
+	return make([]*common.PeerReputation, 0), nil
+}
+
+// BanPeer -
+func (ns *NodeStub) BanPeer(pid string, duration time.Duration, reason string) error {
+	if ns.BanPeerCalled != nil {
+		return ns.BanPeerCalled(pid, duration, reason)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (ns *NodeStub) UnbanPeer(pid string) error {
+	if ns.UnbanPeerCalled != nil {
+		return ns.UnbanPeerCalled(pid)
+	}
+
+	return nil
+}
+
+// BanIP -
+func (ns *NodeStub) BanIP(ip string, duration time.Duration, reason string) error {
+	if ns.BanIPCalled != nil {
+		return ns.BanIPCalled(ip, duration, reason)
+	}
+
+	return nil
+}
+
+// UnbanIP -
+func (ns *NodeStub) UnbanIP(ip string) error {
+	if ns.UnbanIPCalled != nil {
+		return ns.UnbanIPCalled(ip)
+	}
+
+	return nil
+}
+
+// GetOutportDriversCursors -
+func (ns *NodeStub) GetOutportDriversCursors() (map[string]uint64, error) {
+	if ns.GetOutportDriversCursorsCalled != nil {
+		return ns.GetOutportDriversCursorsCalled()
+	}
+
+	return make(map[string]uint64), nil
+}
+
+// ReplayOutport -
+func (ns *NodeStub) ReplayOutport(driverID string, sequence uint64) error {
+	if ns.ReplayOutportCalled != nil {
+		return ns.ReplayOutportCalled(driverID, sequence)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
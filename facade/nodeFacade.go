@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/config/hotReload"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -52,6 +53,8 @@ type ArgNodeFacade struct {
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
 	Blockchain             chainData.ChainHandler
+	EventsSubscriptions    EventsSubscriptionHandler
+	ConfigReloader         ConfigReloader
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -67,6 +70,8 @@ type nodeFacade struct {
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	eventsSubscriptions    EventsSubscriptionHandler
+	configReloader         ConfigReloader
 }
 
 // NewNodeFacade creates a new Facade with a NodeWrapper
@@ -93,6 +98,12 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	if check.IfNil(arg.EventsSubscriptions) {
+		return nil, ErrNilEventsSubscriptionHandler
+	}
+	if check.IfNil(arg.ConfigReloader) {
+		return nil, ErrNilConfigReloader
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -107,6 +118,8 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		eventsSubscriptions:    arg.EventsSubscriptions,
+		configReloader:         arg.ConfigReloader,
 	}
 
 	return nf, nil
@@ -126,6 +139,9 @@ func checkWebserverAntifloodConfig(cfg config.WebServerAntifloodConfig) error {
 	if cfg.SameSourceResetIntervalInSec == 0 {
 		return fmt.Errorf("%w, SameSourceResetIntervalInSec should not be 0", ErrInvalidValue)
 	}
+	if cfg.SimultaneousStreams == 0 {
+		return fmt.Errorf("%w, SimultaneousStreams should not be 0", ErrInvalidValue)
+	}
 	if cfg.TrieOperationsDeadlineMilliseconds == 0 {
 		return fmt.Errorf("%w, TrieOperationsDeadlineMilliseconds should not be 0", ErrInvalidValue)
 	}
@@ -294,14 +310,29 @@ func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction)
 	return nf.apiResolver.SimulateTransactionExecution(tx)
 }
 
+// TraceTransaction will replay an already executed transaction and will return its execution trace
+func (nf *nodeFacade) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	return nf.apiResolver.TraceTransaction(hash)
+}
+
 // GetTransaction gets the transaction with a specified hash
 func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nf.apiResolver.GetTransaction(hash, withResults)
 }
 
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
-func (nf *nodeFacade) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	return nf.apiResolver.GetTransactionsPool(fields)
+func (nf *nodeFacade) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsPool(fields, filter)
+}
+
+// SubscribeTransactionsPool creates a new subscription to the changes of the transactions pool using the provided filter
+func (nf *nodeFacade) SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	return nf.apiResolver.SubscribeTransactionsPool(filter)
+}
+
+// UnsubscribeTransactionsPool terminates the provided transactions pool subscription
+func (nf *nodeFacade) UnsubscribeTransactionsPool(subscriptionID uint64) {
+	nf.apiResolver.UnsubscribeTransactionsPool(subscriptionID)
 }
 
 // GetTransactionsPoolForSender will return a structure containing the transactions for sender that is to be returned on API calls
@@ -324,6 +355,21 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender, accountResponse.Nonce)
 }
 
+// GetTransactionsReplacedInPool will return the hashes of the pending transactions which would be replaced by the provided transaction
+func (nf *nodeFacade) GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+	return nf.apiResolver.GetTransactionsReplacedInPool(tx, txHash)
+}
+
+// GetTransactionsPoolPolicyCounters will return the number of transactions rejected or evicted by each rule of the transactions pool policy
+func (nf *nodeFacade) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolPolicyCounters()
+}
+
+// GetTransactionsForAddress will return the indexed transactions of an address, newest first
+func (nf *nodeFacade) GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsForAddress(address, from, size)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -421,6 +467,32 @@ func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, a
 	return nf.convertVmOutputToApiResponse(vmOutput), queryBlockInfoToApiResource(blockInfo), nil
 }
 
+// ExecuteSCQueries retrieves data from existing SC tries, all the queries being executed on the same block
+func (nf *nodeFacade) ExecuteSCQueries(queries []*process.SCQuery) ([]*common.SCQueryResultApi, apiData.BlockInfo, error) {
+	numQueries := uint32(len(queries))
+	maxBulkSize := nf.wsAntifloodConfig.VmQueriesBulkMaxSize
+	if maxBulkSize > 0 && numQueries > maxBulkSize {
+		return nil, apiData.BlockInfo{}, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrTooManyVmQueriesInBulk, numQueries, maxBulkSize)
+	}
+
+	results, blockInfo, err := nf.apiResolver.ExecuteSCQueries(queries)
+	if err != nil {
+		return nil, apiData.BlockInfo{}, err
+	}
+
+	apiResults := make([]*common.SCQueryResultApi, 0, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			apiResults = append(apiResults, &common.SCQueryResultApi{Error: result.Err.Error()})
+			continue
+		}
+
+		apiResults = append(apiResults, &common.SCQueryResultApi{Data: nf.convertVmOutputToApiResponse(result.VMOutput)})
+	}
+
+	return apiResults, queryBlockInfoToApiResource(blockInfo), nil
+}
+
 // PprofEnabled returns if profiling mode should be active or not on the application
 func (nf *nodeFacade) PprofEnabled() bool {
 	return nf.config.PprofEnabled
@@ -580,6 +652,39 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns the deduplicated Merkle proof for all the requested accounts and data trie keys,
+// together with the block header committing to the root hash
+func (nf *nodeFacade) GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+	numAddresses := uint32(len(request.Accounts))
+	maxBulkSize := nf.wsAntifloodConfig.GetAddressesBulkMaxSize
+	if numAddresses > maxBulkSize {
+		return nil, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrTooManyAddressesInBulk, numAddresses, maxBulkSize)
+	}
+
+	return nf.node.GetMultiProof(request)
+}
+
+// VerifyMultiProof verifies the given Merkle multi-proof
+func (nf *nodeFacade) VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error) {
+	numAddresses := uint32(len(request.Accounts))
+	maxBulkSize := nf.wsAntifloodConfig.GetAddressesBulkMaxSize
+	if numAddresses > maxBulkSize {
+		return false, fmt.Errorf("%w (provided: %d, maximum: %d)", ErrTooManyAddressesInBulk, numAddresses, maxBulkSize)
+	}
+
+	return nf.node.VerifyMultiProof(request)
+}
+
+// GetStateDiff returns a page of the accounts that differ between the states committed by the two given blocks
+func (nf *nodeFacade) GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+	return nf.node.GetStateDiff(request)
+}
+
+// GetStorageReport returns the storage used by the accounts, the contracts, the codes and the tokens of the given state
+func (nf *nodeFacade) GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error) {
+	return nf.node.GetStorageReport(request)
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (nf *nodeFacade) IsDataTrieMigrated(address string, options apiData.AccountQueryOptions) (bool, error) {
 	return nf.node.IsDataTrieMigrated(address, options)
@@ -734,6 +839,71 @@ func (nf *nodeFacade) GetGasConfigs() (map[string]map[string]uint64, error) {
 	return gasConfigs, nil
 }
 
+// SubscribeEvents creates a new events subscription using the provided filter
+func (nf *nodeFacade) SubscribeEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	return nf.eventsSubscriptions.Subscribe(filter)
+}
+
+// UnsubscribeEvents terminates the provided events subscription
+func (nf *nodeFacade) UnsubscribeEvents(subscriptionID uint64) {
+	nf.eventsSubscriptions.Unsubscribe(subscriptionID)
+}
+
+// ReloadConfig reloads the hot-reloadable config values and returns the applied changes
+func (nf *nodeFacade) ReloadConfig() ([]common.ConfigChange, error) {
+	return nf.configReloader.Reload(hotReload.SourceAPI)
+}
+
+// GetRedundancyLeaseStatus returns the state of the current node in the redundancy leader lease protocol
+func (nf *nodeFacade) GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error) {
+	return nf.node.GetRedundancyLeaseStatus()
+}
+
+// PromoteRedundancyLease forces the current node to take over the redundancy lease
+func (nf *nodeFacade) PromoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	return nf.node.PromoteRedundancyLease()
+}
+
+// DemoteRedundancyLease forces the current node to release the redundancy lease
+func (nf *nodeFacade) DemoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	return nf.node.DemoteRedundancyLease()
+}
+
+// GetPeersReputation returns the persisted reputation of the known peer IDs, IP addresses and public keys
+func (nf *nodeFacade) GetPeersReputation() ([]*common.PeerReputation, error) {
+	return nf.node.GetPeersReputation()
+}
+
+// BanPeer manually bans the provided peer ID for the provided duration
+func (nf *nodeFacade) BanPeer(pid string, duration time.Duration, reason string) error {
+	return nf.node.BanPeer(pid, duration, reason)
+}
+
+// UnbanPeer removes the ban of the provided peer ID
+func (nf *nodeFacade) UnbanPeer(pid string) error {
+	return nf.node.UnbanPeer(pid)
+}
+
+// BanIP manually bans the provided IP address for the provided duration
+func (nf *nodeFacade) BanIP(ip string, duration time.Duration, reason string) error {
+	return nf.node.BanIP(ip, duration, reason)
+}
+
+// UnbanIP removes the ban of the provided IP address
+func (nf *nodeFacade) UnbanIP(ip string) error {
+	return nf.node.UnbanIP(ip)
+}
+
+// GetOutportDriversCursors returns the last sequence number acknowledged by each driver of the journaled outport
+func (nf *nodeFacade) GetOutportDriversCursors() (map[string]uint64, error) {
+	return nf.node.GetOutportDriversCursors()
+}
+
+// ReplayOutport delivers again to the provided driver the journal entries starting with the provided sequence number
+func (nf *nodeFacade) ReplayOutport(driverID string, sequence uint64) error {
+	return nf.node.ReplayOutport(driverID, sequence)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
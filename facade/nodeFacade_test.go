@@ -20,7 +20,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/config/hotReload"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade/mock"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -30,6 +32,7 @@ import (
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -47,6 +50,7 @@ func createMockArguments() ArgNodeFacade {
 			SimultaneousRequests:               1,
 			SameSourceRequests:                 1,
 			SameSourceResetIntervalInSec:       1,
+			SimultaneousStreams:                1,
 			TrieOperationsDeadlineMilliseconds: 1,
 		},
 		FacadeConfig: config.FacadeConfig{
@@ -70,6 +74,8 @@ func createMockArguments() ArgNodeFacade {
 				return []byte("root hash")
 			},
 		},
+		EventsSubscriptions: &outportStub.EventsSubscriptionHandlerStub{},
+		ConfigReloader:      &testscommon.ConfigReloaderStub{},
 	}
 }
 
@@ -139,6 +145,17 @@ func TestNewNodeFacade(t *testing.T) {
 		require.Nil(t, nf)
 		require.True(t, errors.Is(err, ErrInvalidValue))
 	})
+	t.Run("invalid SimultaneousStreams should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.WebServerAntifloodEnabled = true
+		arg.WsAntifloodConfig.SimultaneousStreams = 0
+		nf, err := NewNodeFacade(arg)
+
+		require.Nil(t, nf)
+		require.True(t, errors.Is(err, ErrInvalidValue))
+	})
 	t.Run("invalid TrieOperationsDeadlineMilliseconds should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -181,6 +198,26 @@ func TestNewNodeFacade(t *testing.T) {
 		require.Nil(t, nf)
 		require.Equal(t, ErrNilBlockchain, err)
 	})
+	t.Run("nil EventsSubscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.EventsSubscriptions = nil
+		nf, err := NewNodeFacade(arg)
+
+		require.Nil(t, nf)
+		require.Equal(t, ErrNilEventsSubscriptionHandler, err)
+	})
+	t.Run("nil ConfigReloader should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ConfigReloader = nil
+		nf, err := NewNodeFacade(arg)
+
+		require.Nil(t, nf)
+		require.Equal(t, ErrNilConfigReloader, err)
+	})
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
@@ -1232,6 +1269,100 @@ func TestNodeFacade_IsDataTrieMigrated(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.GetAddressesBulkMaxSize = 1
+		arg.Node = &mock.NodeStub{
+			GetMultiProofCalled: func(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+			VerifyMultiProofCalled: func(request common.VerifyMultiProofRequest) (bool, error) {
+				require.Fail(t, "should have not been called")
+				return false, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		accounts := []common.MultiProofAccountRequest{{Address: "alice"}, {Address: "bob"}}
+		response, err := nf.GetMultiProof(common.MultiProofRequest{Accounts: accounts})
+		require.True(t, errors.Is(err, ErrTooManyAddressesInBulk))
+		require.Nil(t, response)
+
+		ok, err := nf.VerifyMultiProof(common.VerifyMultiProofRequest{Accounts: accounts})
+		require.True(t, errors.Is(err, ErrTooManyAddressesInBulk))
+		require.False(t, ok)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResponse := &common.MultiProofResponse{RootHash: []byte("root hash")}
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.GetAddressesBulkMaxSize = 2
+		arg.Node = &mock.NodeStub{
+			GetMultiProofCalled: func(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+				return expectedResponse, nil
+			},
+			VerifyMultiProofCalled: func(request common.VerifyMultiProofRequest) (bool, error) {
+				return true, nil
+			},
+		}
+		nf, _ := NewNodeFacade(arg)
+
+		accounts := []common.MultiProofAccountRequest{{Address: "alice"}, {Address: "bob"}}
+		response, err := nf.GetMultiProof(common.MultiProofRequest{Accounts: accounts})
+		require.Nil(t, err)
+		require.Equal(t, expectedResponse, response)
+
+		ok, err := nf.VerifyMultiProof(common.VerifyMultiProofRequest{Accounts: accounts})
+		require.Nil(t, err)
+		require.True(t, ok)
+	})
+}
+
+func TestNodeFacade_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := common.StateDiffRequest{OldBlockHash: "abcd", Size: 10}
+	expectedResponse := &common.StateDiffAPIResponse{OldRootHash: "root hash"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStateDiffCalled: func(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+			require.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetStateDiff(expectedRequest)
+	require.Nil(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetStorageReport(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := common.StorageReportRequest{RootHash: "abcd", NumTop: 10}
+	expectedReport := &common.StorageReport{RootHash: "abcd"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStorageReportCalled: func(request common.StorageReportRequest) (*common.StorageReport, error) {
+			require.Equal(t, expectedRequest, request)
+			return expectedReport, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	report, err := nf.GetStorageReport(expectedRequest)
+	require.Nil(t, err)
+	require.Equal(t, expectedReport, report)
+}
+
 func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	t.Parallel()
 
@@ -1290,6 +1421,74 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_ExecuteSCQueries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many queries should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.VmQueriesBulkMaxSize = 1
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, _, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.True(t, errors.Is(err, ErrTooManyVmQueriesInBulk))
+		require.Nil(t, results)
+	})
+	t.Run("api resolver error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, _, err := nf.ExecuteSCQueries([]*process.SCQuery{{}})
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.WsAntifloodConfig.VmQueriesBulkMaxSize = 2
+		arg.ApiResolver = &mock.ApiResolverStub{
+			ExecuteSCQueriesHandler: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+				return []*process.SCQueryResult{
+					{VMOutput: &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("data")}, ReturnCode: vmcommon.Ok}},
+					{Err: expectedErr},
+				}, holders.NewBlockInfo([]byte("hash"), 7, []byte("root hash")), nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+
+		results, blockInfo, err := nf.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, [][]byte{[]byte("data")}, results[0].Data.ReturnData)
+		require.Empty(t, results[0].Error)
+		require.Nil(t, results[1].Data)
+		require.Equal(t, expectedErr.Error(), results[1].Error)
+		require.Equal(t, api.BlockInfo{
+			Nonce:    7,
+			Hash:     hex.EncodeToString([]byte("hash")),
+			RootHash: hex.EncodeToString([]byte("root hash")),
+		}, blockInfo)
+	})
+}
+
 func TestNodeFacade_GetBlockByRoundShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1574,13 +1773,13 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 
 		arg := createMockArguments()
 		arg.ApiResolver = &mock.ApiResolverStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
 
 		nf, _ := NewNodeFacade(arg)
-		res, err := nf.GetTransactionsPool("")
+		res, err := nf.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.Nil(t, res)
 		require.Equal(t, expectedErr, err)
 	})
@@ -1623,13 +1822,13 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 			},
 		}
 		arg.ApiResolver = &mock.ApiResolverStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedPool, nil
 			},
 		}
 
 		nf, _ := NewNodeFacade(arg)
-		res, err := nf.GetTransactionsPool("")
+		res, err := nf.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.NoError(t, err)
 		require.Equal(t, expectedPool, res)
 	})
@@ -1958,6 +2157,243 @@ func TestNodeFacade_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_SubscribeAndUnsubscribeTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	expectedFilter := common.TransactionsPoolFilter{Receiver: "erd1contract", DataPrefix: "claim"}
+	unsubscribedID := uint64(0)
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		SubscribeTransactionsPoolCalled: func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+			require.Equal(t, expectedFilter, filter)
+			return nil, expectedErr
+		},
+		UnsubscribeTransactionsPoolCalled: func(subscriptionID uint64) {
+			unsubscribedID = subscriptionID
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	subscription, err := nf.SubscribeTransactionsPool(expectedFilter)
+	require.Nil(t, subscription)
+	require.Equal(t, expectedErr, err)
+
+	nf.UnsubscribeTransactionsPool(5)
+	require.Equal(t, uint64(5), unsubscribedID)
+}
+
+func TestNodeFacade_GetTransactionsReplacedInPool(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.Transaction{Nonce: 42}
+	providedTxHash := []byte("hash")
+	expectedHashes := []string{"aabb"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsReplacedInPoolCalled: func(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+			require.Equal(t, providedTx, tx)
+			require.Equal(t, providedTxHash, txHash)
+			return expectedHashes, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsReplacedInPool(providedTx, providedTxHash)
+	require.NoError(t, err)
+	require.Equal(t, expectedHashes, res)
+}
+
+func TestNodeFacade_GetTransactionsPoolPolicyCounters(t *testing.T) {
+	t.Parallel()
+
+	expectedCounters := &common.TransactionsPoolPolicyCountersApiResponse{
+		Counters: map[string]uint64{"min-gas-price": 2},
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsPoolPolicyCountersCalled: func() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+			return expectedCounters, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsPoolPolicyCounters()
+	require.NoError(t, err)
+	require.Equal(t, expectedCounters, res)
+}
+
+func TestNodeFacade_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.AddressTransactionsAPIResponse{
+		Address: "alice",
+		Total:   3,
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsForAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+			require.Equal(t, "alice", address)
+			require.Equal(t, uint64(1), from)
+			require.Equal(t, uint64(2), size)
+
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsForAddress("alice", 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_SubscribeAndUnsubscribeEvents(t *testing.T) {
+	t.Parallel()
+
+	filter := common.EventsSubscriptionFilter{Addresses: []string{"alice"}}
+	unsubscribedID := uint64(0)
+	arg := createMockArguments()
+	arg.EventsSubscriptions = &outportStub.EventsSubscriptionHandlerStub{
+		SubscribeCalled: func(providedFilter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+			require.Equal(t, filter, providedFilter)
+			return nil, expectedErr
+		},
+		UnsubscribeCalled: func(subscriptionID uint64) {
+			unsubscribedID = subscriptionID
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.SubscribeEvents(filter)
+	require.Equal(t, expectedErr, err)
+
+	nf.UnsubscribeEvents(7)
+	require.Equal(t, uint64(7), unsubscribedID)
+}
+
+func TestNodeFacade_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	expectedChanges := []common.ConfigChange{{Path: "GeneralConfig.Logs.LogLevel", OldValue: "", NewValue: "*:DEBUG"}}
+	arg := createMockArguments()
+	arg.ConfigReloader = &testscommon.ConfigReloaderStub{
+		ReloadCalled: func(source string) ([]common.ConfigChange, error) {
+			require.Equal(t, hotReload.SourceAPI, source)
+			return expectedChanges, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	changes, err := nf.ReloadConfig()
+	require.Nil(t, err)
+	require.Equal(t, expectedChanges, changes)
+}
+
+func TestNodeFacade_RedundancyLease(t *testing.T) {
+	t.Parallel()
+
+	providedStatus := common.RedundancyLeaseStatus{
+		State:      "holder",
+		LeaseEpoch: 2,
+	}
+	numCalls := 0
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetRedundancyLeaseStatusCalled: func() (common.RedundancyLeaseStatus, error) {
+			numCalls++
+			return providedStatus, nil
+		},
+		PromoteRedundancyLeaseCalled: func() (common.RedundancyLeaseStatus, error) {
+			numCalls++
+			return providedStatus, nil
+		},
+		DemoteRedundancyLeaseCalled: func() (common.RedundancyLeaseStatus, error) {
+			numCalls++
+			return common.RedundancyLeaseStatus{}, expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	status, err := nf.GetRedundancyLeaseStatus()
+	require.Nil(t, err)
+	require.Equal(t, providedStatus, status)
+
+	status, err = nf.PromoteRedundancyLease()
+	require.Nil(t, err)
+	require.Equal(t, providedStatus, status)
+
+	_, err = nf.DemoteRedundancyLease()
+	require.Equal(t, expectedErr, err)
+	require.Equal(t, 3, numCalls)
+}
+
+func TestNodeFacade_PeersReputation(t *testing.T) {
+	t.Parallel()
+
+	providedReputations := []*common.PeerReputation{{Type: "ip", Key: "10.0.0.1"}}
+	numCalls := 0
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetPeersReputationCalled: func() ([]*common.PeerReputation, error) {
+			numCalls++
+			return providedReputations, nil
+		},
+		BanPeerCalled: func(pid string, duration time.Duration, reason string) error {
+			numCalls++
+			require.Equal(t, "pid", pid)
+			require.Equal(t, time.Minute, duration)
+			require.Equal(t, "reason", reason)
+			return nil
+		},
+		UnbanPeerCalled: func(pid string) error {
+			numCalls++
+			return expectedErr
+		},
+		BanIPCalled: func(ip string, duration time.Duration, reason string) error {
+			numCalls++
+			require.Equal(t, "10.0.0.1", ip)
+			return nil
+		},
+		UnbanIPCalled: func(ip string) error {
+			numCalls++
+			return expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	reputations, err := nf.GetPeersReputation()
+	require.Nil(t, err)
+	require.Equal(t, providedReputations, reputations)
+
+	require.Nil(t, nf.BanPeer("pid", time.Minute, "reason"))
+	require.Equal(t, expectedErr, nf.UnbanPeer("pid"))
+	require.Nil(t, nf.BanIP("10.0.0.1", time.Minute, "reason"))
+	require.Equal(t, expectedErr, nf.UnbanIP("10.0.0.1"))
+	require.Equal(t, 5, numCalls)
+}
+
+func TestNodeFacade_OutportJournal(t *testing.T) {
+	t.Parallel()
+
+	providedCursors := map[string]uint64{"driver": 7}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetOutportDriversCursorsCalled: func() (map[string]uint64, error) {
+			return providedCursors, nil
+		},
+		ReplayOutportCalled: func(driverID string, sequence uint64) error {
+			require.Equal(t, "driver", driverID)
+			require.Equal(t, uint64(5), sequence)
+			return expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	cursors, err := nf.GetOutportDriversCursors()
+	require.Nil(t, err)
+	require.Equal(t, providedCursors, cursors)
+	require.Equal(t, expectedErr, nf.ReplayOutport("driver", 5))
+}
+
 func TestNodeFacade_InternalValidatorsInfo(t *testing.T) {
 	t.Parallel()
 
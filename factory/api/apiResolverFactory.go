@@ -14,6 +14,9 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dataRetriever/blockchain"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/changes"
+	disabledChanges "github.com/multiversx/mx-chain-go/dataRetriever/txpool/changes/disabled"
+	chainErrors "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -239,6 +242,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	txPoolChangesHub, err := createTxPoolChangesHub(args)
+	if err != nil {
+		return nil, err
+	}
+
 	argsAPITransactionProc := &transactionAPI.ArgAPITransactionProcessor{
 		RoundDuration:            args.CoreComponents.GenesisNodesSetup().GetRoundDuration(),
 		GenesisTime:              args.CoreComponents.GenesisTime(),
@@ -253,6 +261,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TxTypeHandler:            txTypeHandler,
 		LogsFacade:               logsFacade,
 		DataFieldParser:          dataFieldParser,
+		TxPoolChangesHub:         txPoolChangesHub,
 	}
 	apiTransactionProcessor, err := transactionAPI.NewAPITransactionProcessor(argsAPITransactionProc)
 	if err != nil {
@@ -273,6 +282,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
 		APITransactionEvaluator:  args.ProcessComponents.APITransactionEvaluator(),
+		APITransactionTracer:     args.ProcessComponents.APITransactionTracer(),
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
@@ -330,7 +340,12 @@ func createScQueryService(
 		list = append(list, scQueryService)
 	}
 
-	sqQueryDispatcher, err := smartContract.NewScQueryServiceDispatcher(list)
+	argsDispatcher := smartContract.ArgsScQueryServiceDispatcher{
+		List:                list,
+		MaxGasPerQuery:      getMaxGasPerVmQuery(args.generalConfig.VirtualMachine.GasConfig, args.processComponents.ShardCoordinator()),
+		MaxGasPerMultiQuery: getMaxGasPerVmMultiQuery(args.generalConfig.VirtualMachine.GasConfig, args.processComponents.ShardCoordinator()),
+	}
+	sqQueryDispatcher, err := smartContract.NewScQueryServiceDispatcher(argsDispatcher)
 	if err != nil {
 		return nil, err
 	}
@@ -403,9 +418,8 @@ func createScQueryElement(
 
 	var apiBlockchain data.ChainHandler
 	var vmFactory process.VirtualMachinesContainerFactory
-	maxGasForVmQueries := args.generalConfig.VirtualMachine.GasConfig.ShardMaxGasPerVmQuery
+	maxGasForVmQueries := getMaxGasPerVmQuery(args.generalConfig.VirtualMachine.GasConfig, args.processComponents.ShardCoordinator())
 	if args.processComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
-		maxGasForVmQueries = args.generalConfig.VirtualMachine.GasConfig.MetaMaxGasPerVmQuery
 		apiBlockchain, vmFactory, err = createMetaVmContainerFactory(args, argsHook)
 	} else {
 		apiBlockchain, vmFactory, err = createShardVmContainerFactory(args, argsHook)
@@ -452,6 +466,22 @@ func createScQueryElement(
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
 }
 
+func getMaxGasPerVmQuery(gasConfig config.VirtualMachineGasConfig, shardCoordinator sharding.Coordinator) uint64 {
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return gasConfig.MetaMaxGasPerVmQuery
+	}
+
+	return gasConfig.ShardMaxGasPerVmQuery
+}
+
+func getMaxGasPerVmMultiQuery(gasConfig config.VirtualMachineGasConfig, shardCoordinator sharding.Coordinator) uint64 {
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return gasConfig.MetaMaxGasPerVmMultiQuery
+	}
+
+	return gasConfig.ShardMaxGasPerVmMultiQuery
+}
+
 func createMetaVmContainerFactory(args *scQueryElementArgs, argsHook hooks.ArgBlockChainHook) (data.ChainHandler, process.VirtualMachinesContainerFactory, error) {
 	apiBlockchain, err := blockchain.NewMetaChain(disabled.NewAppStatusHandler())
 	if err != nil {
@@ -747,3 +777,22 @@ func createLogsFacade(args *ApiResolverArgs) (factory.LogsFacade, error) {
 		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
 	})
 }
+
+func createTxPoolChangesHub(args *ApiResolverArgs) (transactionAPI.TxPoolChangesHub, error) {
+	streamConfig := args.Configs.GeneralConfig.TxPoolChangesStream
+	if !streamConfig.Enabled {
+		return disabledChanges.NewDisabledChangesHub(), nil
+	}
+
+	txPool, ok := args.DataComponents.Datapool().Transactions().(changes.TxPoolChangesNotifier)
+	if !ok {
+		return nil, fmt.Errorf("%w for the transactions pool while creating the transactions pool changes hub", chainErrors.ErrWrongTypeAssertion)
+	}
+
+	return changes.NewChangesHub(changes.ArgsChangesHub{
+		TxPool:                  txPool,
+		AddressPubKeyConverter:  args.CoreComponents.AddressPubKeyConverter(),
+		MaxSubscriptions:        streamConfig.MaxSubscriptions,
+		NotificationsBufferSize: streamConfig.NotificationsBufferSize,
+	})
+}
@@ -284,6 +284,38 @@ func TestCreateApiResolver(t *testing.T) {
 	})
 }
 
+func TestCreateApiResolver_TxPoolChangesStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.GeneralConfig.TxPoolChangesStream = config.TxPoolChangesStreamConfig{
+			Enabled:                 true,
+			MaxSubscriptions:        0,
+			NotificationsBufferSize: 10,
+		}
+		apiResolver, err := api.CreateApiResolver(args)
+		require.NotNil(t, err)
+		require.True(t, strings.Contains(err.Error(), "subscriptions"))
+		require.True(t, check.IfNil(apiResolver))
+	})
+	t.Run("enabled should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Configs.GeneralConfig.TxPoolChangesStream = config.TxPoolChangesStreamConfig{
+			Enabled:                 true,
+			MaxSubscriptions:        1,
+			NotificationsBufferSize: 10,
+		}
+		apiResolver, err := api.CreateApiResolver(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(apiResolver))
+	})
+}
+
 func createMockSCQueryElementArgs() api.SCQueryElementArgs {
 	return api.SCQueryElementArgs{
 		GeneralConfig: &config.Config{
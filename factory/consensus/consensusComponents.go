@@ -2,12 +2,14 @@ package consensus
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-core-go/core/watchdog"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/disabled"
@@ -15,9 +17,12 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
+	"github.com/multiversx/mx-chain-go/consensus/signingHistory"
+	signingHistoryDisabled "github.com/multiversx/mx-chain-go/consensus/signingHistory/disabled"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
@@ -26,6 +31,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process/sync/storageBootstrap"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state/syncer"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/update"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -77,6 +85,7 @@ type consensusComponents struct {
 	broadcastMessenger   consensus.BroadcastMessenger
 	worker               factory.ConsensusWorker
 	peerBlacklistHandler consensus.PeerBlacklistHandler
+	signingHistory       consensus.SigningHistoryHandler
 	consensusTopic       string
 	consensusGroupSize   int
 }
@@ -229,6 +238,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.signingHistory, err = ccf.createSigningHistory()
+	if err != nil {
+		return nil, err
+	}
+
 	consensusArgs := &spos.ConsensusCoreArgs{
 		BlockChain:                    ccf.dataComponents.Blockchain(),
 		BlockProcessor:                ccf.processComponents.BlockProcessor(),
@@ -252,6 +266,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		MessageSigningHandler:         p2pSigningHandler,
 		PeerBlacklistHandler:          cc.peerBlacklistHandler,
 		SigningHandler:                ccf.cryptoComponents.ConsensusSigningHandler(),
+		SigningHistory:                cc.signingHistory,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -310,10 +325,81 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	err = cc.signingHistory.Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
 
+func (ccf *consensusComponentsFactory) createSigningHistory() (consensus.SigningHistoryHandler, error) {
+	signingHistoryConfig := ccf.config.SigningHistory
+	if !signingHistoryConfig.Enabled {
+		log.Warn("signing history is disabled, the node will not be protected against signing conflicting headers")
+		return signingHistoryDisabled.NewDisabledSigningHistory(), nil
+	}
+	dbConfigValues := signingHistoryConfig.Storage.DB
+	if storageunit.DBType(dbConfigValues.Type) != storageunit.LvlDBSerial {
+		// the other types either do not persist the records or do not sync the writes in the order of the signatures
+		return nil, fmt.Errorf("%w for SigningHistory.Storage.DB: %s, only %s can be used",
+			storage.ErrNotSupportedDBType, dbConfigValues.Type, storageunit.LvlDBSerial)
+	}
+	if dbConfigValues.MaxBatchSize != 1 {
+		// each record is written with a synced LevelDB batch, which happens on every put only for a batch size of 1
+		log.Warn("SigningHistory.Storage.DB.MaxBatchSize is ignored, each signed header is written to disk before the signature is released",
+			"max batch size", dbConfigValues.MaxBatchSize)
+		dbConfigValues.MaxBatchSize = 1
+	}
+
+	// the history is not stored in a shard directory so it will be kept if the keys are moved to another shard
+	dbConfig := storageFactory.GetDBFromConfig(dbConfigValues)
+	dbConfig.FilePath = filepath.Join(ccf.coreComponents.PathHandler().DatabasePath(), dbConfigValues.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(dbConfigValues)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(signingHistoryConfig.Storage.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w for SigningHistory.Storage", err)
+	}
+
+	history, err := signingHistory.NewSigningHistory(signingHistory.ArgsSigningHistory{
+		Storer:          storer,
+		Marshaller:      &marshal.JsonMarshalizer{},
+		ChainID:         ccf.coreComponents.ChainID(),
+		NumEpochsToKeep: signingHistoryConfig.NumEpochsToKeep,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ccf.processComponents.EpochStartNotifier().RegisterHandler(notifier.NewHandlerForEpochStart(
+		func(hdr data.HeaderHandler) {
+			// each removal is a synced write, so the pruning does not delay the other epoch start subscribers
+			go pruneSigningHistory(history, hdr.GetEpoch())
+		},
+		func(_ data.HeaderHandler) {},
+		common.ConsensusOrder,
+	))
+
+	return history, nil
+}
+
+func pruneSigningHistory(history signingHistoryPruner, epoch uint32) {
+	err := history.PruneOldEpochs(epoch)
+	if err != nil {
+		log.Warn("could not prune the signing history", "epoch", epoch, "error", err)
+	}
+}
+
 func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
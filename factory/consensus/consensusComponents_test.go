@@ -10,8 +10,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	retriever "github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/epochStart"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	consensusComp "github.com/multiversx/mx-chain-go/factory/consensus"
 	"github.com/multiversx/mx-chain-go/factory/mock"
@@ -940,6 +942,23 @@ func TestConsensusComponentsFactory_Create(t *testing.T) {
 		require.Equal(t, expectedErr, err)
 		require.Nil(t, cc)
 	})
+	t.Run("createSigningHistory failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SigningHistory = createSigningHistoryConfig()
+		args.Config.SigningHistory.Storage.DB.Type = "invalid type"
+		coreCompStub, ok := args.CoreComponents.(*mock.CoreComponentsMock)
+		require.True(t, ok)
+		coreCompStub.PathHdl = createPathManagerStub(t.TempDir())
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.Error(t, err)
+		require.True(t, strings.Contains(err.Error(), "SigningHistory.Storage"))
+		require.Nil(t, cc)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -952,4 +971,92 @@ func TestConsensusComponentsFactory_Create(t *testing.T) {
 
 		require.Nil(t, cc.Close())
 	})
+	t.Run("should work with signing history enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SigningHistory = createSigningHistoryConfig()
+		coreCompStub, ok := args.CoreComponents.(*mock.CoreComponentsMock)
+		require.True(t, ok)
+		coreCompStub.PathHdl = createPathManagerStub(t.TempDir())
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, cc)
+
+		require.Nil(t, cc.Close())
+	})
+	t.Run("signing history with other DB type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SigningHistory = createSigningHistoryConfig()
+		args.Config.SigningHistory.Storage.DB.Type = "LvlDB"
+		coreCompStub, ok := args.CoreComponents.(*mock.CoreComponentsMock)
+		require.True(t, ok)
+		coreCompStub.PathHdl = createPathManagerStub(t.TempDir())
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.True(t, errors.Is(err, storage.ErrNotSupportedDBType))
+		require.Nil(t, cc)
+	})
+	t.Run("signing history should register for pruning", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.Config.SigningHistory = createSigningHistoryConfig()
+		args.Config.SigningHistory.NumEpochsToKeep = 2
+		args.Config.SigningHistory.Storage.DB.MaxBatchSize = 100
+		coreCompStub, ok := args.CoreComponents.(*mock.CoreComponentsMock)
+		require.True(t, ok)
+		coreCompStub.PathHdl = createPathManagerStub(t.TempDir())
+		processCompStub, ok := args.ProcessComponents.(*testsMocks.ProcessComponentsStub)
+		require.True(t, ok)
+		registeredOrders := make([]uint32, 0)
+		processCompStub.EpochNotifier = &testsMocks.EpochStartNotifierStub{
+			RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+				registeredOrders = append(registeredOrders, handler.NotifyOrder())
+			},
+		}
+		ccf, _ := consensusComp.NewConsensusComponentsFactory(args)
+		require.NotNil(t, ccf)
+
+		cc, err := ccf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, cc)
+		require.Contains(t, registeredOrders, uint32(common.ConsensusOrder))
+
+		require.Nil(t, cc.Close())
+	})
+}
+
+func createSigningHistoryConfig() config.SigningHistoryConfig {
+	return config.SigningHistoryConfig{
+		Enabled: true,
+		Storage: config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 10,
+			},
+			DB: config.DBConfig{
+				FilePath:          "SigningHistory",
+				Type:              "LvlDBSerial",
+				BatchDelaySeconds: 2,
+				MaxBatchSize:      1,
+				MaxOpenFiles:      10,
+			},
+		},
+	}
+}
+
+func createPathManagerStub(databasePath string) *testscommon.PathManagerStub {
+	return &testscommon.PathManagerStub{
+		DatabasePathCalled: func() string {
+			return databasePath
+		},
+	}
 }
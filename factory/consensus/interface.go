@@ -0,0 +1,5 @@
+package consensus
+
+type signingHistoryPruner interface {
+	PruneOldEpochs(currentEpoch uint32) error
+}
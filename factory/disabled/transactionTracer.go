@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+)
+
+// transactionTracer implements the TransactionTracer interface but does nothing as it is disabled
+type transactionTracer struct {
+}
+
+// NewTransactionTracer returns a disabled transactionTracer
+func NewTransactionTracer() *transactionTracer {
+	return &transactionTracer{}
+}
+
+// TraceTransaction returns an error as the tracer is disabled
+func (tracer *transactionTracer) TraceTransaction(_ []byte) (*txSimData.TransactionTrace, error) {
+	return nil, transactionEvaluator.ErrHistoryRepositoryNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *transactionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
@@ -245,11 +245,13 @@ type NetworkComponentsHolder interface {
 	PubKeyCacher() process.TimeCacher
 	PeerBlackListHandler() process.PeerBlackListCacher
 	PeerHonestyHandler() PeerHonestyHandler
+	PeerReputationHandler() process.PeerReputationHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	PeersRatingHandler() p2p.PeersRatingHandler
 	PeersRatingMonitor() p2p.PeersRatingMonitor
 	FullArchiveNetworkMessenger() p2p.Messenger
 	FullArchivePreferredPeersHolderHandler() PreferredPeersHolderHandler
+	ConfigReloadHandlers() []common.ConfigReloadHandler
 	IsInterfaceNil() bool
 }
 
@@ -266,6 +268,12 @@ type TransactionEvaluator interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionTracer defines the transaction tracer actions
+type TransactionTracer interface {
+	TraceTransaction(txHash []byte) (*txSimData.TransactionTrace, error)
+	IsInterfaceNil() bool
+}
+
 // ProcessComponentsHolder holds the process components
 type ProcessComponentsHolder interface {
 	NodesCoordinator() nodesCoordinator.NodesCoordinator
@@ -294,6 +302,7 @@ type ProcessComponentsHolder interface {
 	FullArchivePeerShardMapper() process.NetworkShardingCollector
 	FallbackHeaderValidator() process.FallbackHeaderValidator
 	APITransactionEvaluator() TransactionEvaluator
+	APITransactionTracer() TransactionTracer
 	WhiteListHandler() process.WhiteListHandler
 	WhiteListerVerifiedTxs() process.WhiteListHandler
 	HistoryRepository() dblookupext.HistoryRepository
@@ -340,6 +349,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	EventsSubscriptionHandler() outport.EventsSubscriptionHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	ManagedPeersMonitor() common.ManagedPeersMonitor
 	IsInterfaceNil() bool
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
@@ -12,11 +13,13 @@ type NetworkComponentsMock struct {
 	InputAntiFlood                   factory.P2PAntifloodHandler
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
+	PeerReputationHandlerField       process.PeerReputationHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
 	PeersRatingMonitorField          p2p.PeersRatingMonitor
 	FullArchiveNetworkMessengerField p2p.Messenger
 	FullArchivePreferredPeersHolder  factory.PreferredPeersHolderHandler
+	ConfigReloadHandlersField        []common.ConfigReloadHandler
 }
 
 // PubKeyCacher -
@@ -29,6 +32,11 @@ func (ncm *NetworkComponentsMock) PeerHonestyHandler() factory.PeerHonestyHandle
 	return nil
 }
 
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return ncm.PeerReputationHandlerField
+}
+
 // Create -
 func (ncm *NetworkComponentsMock) Create() error {
 	return nil
@@ -89,6 +97,11 @@ func (ncm *NetworkComponentsMock) FullArchivePreferredPeersHolderHandler() facto
 	return ncm.FullArchivePreferredPeersHolder
 }
 
+// ConfigReloadHandlers -
+func (ncm *NetworkComponentsMock) ConfigReloadHandlers() []common.ConfigReloadHandler {
+	return ncm.ConfigReloadHandlersField
+}
+
 // IsInterfaceNil -
 func (ncm *NetworkComponentsMock) IsInterfaceNil() bool {
 	return ncm == nil
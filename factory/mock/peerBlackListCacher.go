@@ -8,9 +8,10 @@ import (
 
 // PeerBlackListHandlerStub -
 type PeerBlackListHandlerStub struct {
-	UpsertCalled func(pid core.PeerID, span time.Duration) error
-	HasCalled    func(pid core.PeerID) bool
-	SweepCalled  func()
+	UpsertCalled           func(pid core.PeerID, span time.Duration) error
+	UpsertWithReasonCalled func(pid core.PeerID, span time.Duration, reason string) error
+	HasCalled              func(pid core.PeerID) bool
+	SweepCalled            func()
 }
 
 // Upsert -
@@ -22,6 +23,15 @@ func (pblhs *PeerBlackListHandlerStub) Upsert(pid core.PeerID, span time.Duratio
 	return pblhs.UpsertCalled(pid, span)
 }
 
+// UpsertWithReason -
+func (pblhs *PeerBlackListHandlerStub) UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error {
+	if pblhs.UpsertWithReasonCalled == nil {
+		return nil
+	}
+
+	return pblhs.UpsertWithReasonCalled(pid, span, reason)
+}
+
 // Has -
 func (pblhs *PeerBlackListHandlerStub) Has(pid core.PeerID) bool {
 	if pblhs.HasCalled == nil {
@@ -41,6 +41,7 @@ type ProcessComponentsMock struct {
 	MainPeerMapper                       process.NetworkShardingCollector
 	FullArchivePeerMapper                process.NetworkShardingCollector
 	TransactionEvaluator                 factory.TransactionEvaluator
+	TransactionTracer                    factory.TransactionTracer
 	FallbackHdrValidator                 process.FallbackHeaderValidator
 	WhiteListHandlerInternal             process.WhiteListHandler
 	WhiteListerVerifiedTxsInternal       process.WhiteListHandler
@@ -204,6 +205,11 @@ func (pcm *ProcessComponentsMock) APITransactionEvaluator() factory.TransactionE
 	return pcm.TransactionEvaluator
 }
 
+// APITransactionTracer -
+func (pcm *ProcessComponentsMock) APITransactionTracer() factory.TransactionTracer {
+	return pcm.TransactionTracer
+}
+
 // WhiteListHandler -
 func (pcm *ProcessComponentsMock) WhiteListHandler() process.WhiteListHandler {
 	return pcm.WhiteListHandlerInternal
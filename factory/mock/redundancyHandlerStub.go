@@ -7,11 +7,12 @@ import (
 
 // RedundancyHandlerStub -
 type RedundancyHandlerStub struct {
-	IsRedundancyNodeCalled         func() bool
-	IsMainMachineActiveCalled      func() bool
-	ObserverPrivateKeyCalled       func() crypto.PrivateKey
-	AdjustInactivityIfNeededCalled func(selfPubKey string, consensusPubKeys []string, roundIndex int64)
-	ResetInactivityIfNeededCalled  func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
+	IsRedundancyNodeCalled                     func() bool
+	IsMainMachineActiveCalled                  func() bool
+	ObserverPrivateKeyCalled                   func() crypto.PrivateKey
+	AdjustInactivityIfNeededCalled             func(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+	AdjustInactivityWhileNotSynchronizedCalled func(roundIndex int64)
+	ResetInactivityIfNeededCalled              func(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID)
 }
 
 // IsRedundancyNode -
@@ -48,6 +49,13 @@ func (rhs *RedundancyHandlerStub) AdjustInactivityIfNeeded(selfPubKey string, co
 	}
 }
 
+// AdjustInactivityWhileNotSynchronized -
+func (rhs *RedundancyHandlerStub) AdjustInactivityWhileNotSynchronized(roundIndex int64) {
+	if rhs.AdjustInactivityWhileNotSynchronizedCalled != nil {
+		rhs.AdjustInactivityWhileNotSynchronizedCalled(roundIndex)
+	}
+}
+
 // ResetInactivityIfNeeded -
 func (rhs *RedundancyHandlerStub) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
 	if rhs.ResetInactivityIfNeededCalled != nil {
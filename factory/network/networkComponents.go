@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -21,8 +22,12 @@ import (
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/rating/peerHonesty"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	peerReputationDisabled "github.com/multiversx/mx-chain-go/process/rating/peerReputation/disabled"
 	antifloodFactory "github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
+	storageDisabled "github.com/multiversx/mx-chain-go/storage/disabled"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -42,6 +47,7 @@ type NetworkComponentsFactoryArgs struct {
 	NodeOperationMode     common.NodeOperation
 	ConnectionWatcherType string
 	CryptoComponents      factory.CryptoComponentsHolder
+	PathHandler           storage.PathManagerHandler
 }
 
 type networkComponentsFactory struct {
@@ -57,6 +63,7 @@ type networkComponentsFactory struct {
 	nodeOperationMode     common.NodeOperation
 	connectionWatcherType string
 	cryptoComponents      factory.CryptoComponentsHolder
+	pathHandler           storage.PathManagerHandler
 }
 
 type networkComponentsHolder struct {
@@ -78,6 +85,8 @@ type networkComponents struct {
 	peerBlackListHandler     process.PeerBlackListCacher
 	antifloodConfig          config.AntifloodConfig
 	peerHonestyHandler       consensus.PeerHonestyHandler
+	peerReputationHandler    process.PeerReputationHandler
+	configReloadHandlers     []common.ConfigReloadHandler
 	closeFunc                context.CancelFunc
 }
 
@@ -102,6 +111,9 @@ func NewNetworkComponentsFactory(
 	if args.NodeOperationMode != common.NormalOperation && args.NodeOperationMode != common.FullArchiveMode {
 		return nil, errors.ErrInvalidNodeOperationMode
 	}
+	if check.IfNil(args.PathHandler) {
+		return nil, errors.ErrNilPathHandler
+	}
 
 	return &networkComponentsFactory{
 		mainP2PConfig:         args.MainP2pConfig,
@@ -116,6 +128,7 @@ func NewNetworkComponentsFactory(
 		nodeOperationMode:     args.NodeOperationMode,
 		connectionWatcherType: args.ConnectionWatcherType,
 		cryptoComponents:      args.CryptoComponents,
+		pathHandler:           args.PathHandler,
 	}, nil
 }
 
@@ -143,11 +156,22 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		}
 	}()
 
-	antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, err := ncf.createAntifloodComponents(ctx, mainNetworkComp.netMessenger.ID())
+	peerReputationHandler, err := ncf.createPeerReputationHandler()
 	if err != nil {
 		return nil, err
 	}
 
+	antiFloodComponents, inputAntifloodHandler, outputAntifloodHandler, peerHonestyHandler, err := ncf.createAntifloodComponents(ctx, mainNetworkComp.netMessenger.ID(), peerReputationHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	configReloadHandlers := []common.ConfigReloadHandler{antiFloodComponents.ConfigReloadHandler}
+	reloadablePeerHonestyHandler, ok := peerHonestyHandler.(common.ConfigReloadHandler)
+	if ok {
+		configReloadHandlers = append(configReloadHandlers, reloadablePeerHonestyHandler)
+	}
+
 	err = mainNetworkComp.netMessenger.Bootstrap()
 	if err != nil {
 		return nil, err
@@ -173,6 +197,8 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerBlackListHandler:     antiFloodComponents.BlacklistHandler,
 		antifloodConfig:          ncf.mainConfig.Antiflood,
 		peerHonestyHandler:       peerHonestyHandler,
+		peerReputationHandler:    peerReputationHandler,
+		configReloadHandlers:     configReloadHandlers,
 		closeFunc:                cancelFunc,
 	}, nil
 }
@@ -180,9 +206,10 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 func (ncf *networkComponentsFactory) createAntifloodComponents(
 	ctx context.Context,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
 ) (*antifloodFactory.AntiFloodComponents, factory.P2PAntifloodHandler, factory.P2PAntifloodHandler, consensus.PeerHonestyHandler, error) {
 	var antiFloodComponents *antifloodFactory.AntiFloodComponents
-	antiFloodComponents, err := antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, currentPid)
+	antiFloodComponents, err := antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, currentPid, peerReputationHandler)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -229,7 +256,52 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 		return nil, err
 	}
 
-	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache)
+	// the peer honesty scores are persisted only together with the peers reputation registry
+	var storer storage.Storer = storageDisabled.NewStorer()
+	if config.PeerReputation.Enabled {
+		storer, err = ncf.createReputationStorer(config.PeerReputation.PeerHonestyStorage)
+		if err != nil {
+			return nil, fmt.Errorf("%w for PeerReputation.PeerHonestyStorage", err)
+		}
+	}
+
+	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache, storer, &marshal.JsonMarshalizer{})
+}
+
+func (ncf *networkComponentsFactory) createPeerReputationHandler() (process.PeerReputationHandler, error) {
+	peerReputationConfig := ncf.mainConfig.PeerReputation
+	if !peerReputationConfig.Enabled {
+		return peerReputationDisabled.NewDisabledPeerReputation()
+	}
+
+	storer, err := ncf.createReputationStorer(peerReputationConfig.Storage)
+	if err != nil {
+		return nil, fmt.Errorf("%w for PeerReputation.Storage", err)
+	}
+
+	return peerReputation.NewPeerReputation(peerReputation.ArgsPeerReputation{
+		Config:     peerReputationConfig,
+		Storer:     storer,
+		Marshaller: &marshal.JsonMarshalizer{},
+	})
+}
+
+func (ncf *networkComponentsFactory) createReputationStorer(storageConfig config.StorageConfig) (storage.Storer, error) {
+	// the registry is not stored in a shard directory so the scores and the bans will be kept on shard changes
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(ncf.pathHandler.DatabasePath(), storageConfig.DB.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
 }
 
 func (ncf *networkComponentsFactory) createNetworkHolder(
@@ -338,6 +410,9 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peerReputationHandler) {
+		log.LogIfError(nc.peerReputationHandler.Close())
+	}
 
 	mainNetMessenger := nc.mainNetworkHolder.netMessenger
 	if !check.IfNil(mainNetMessenger) {
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
@@ -105,6 +106,9 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.peerReputationHandler) {
+		return errors.ErrNilPeerReputationHandler
+	}
 
 	return nil
 }
@@ -181,6 +185,18 @@ func (mnc *managedNetworkComponents) PeerHonestyHandler() factory.PeerHonestyHan
 	return mnc.networkComponents.peerHonestyHandler
 }
 
+// PeerReputationHandler returns the peer reputation handler
+func (mnc *managedNetworkComponents) PeerReputationHandler() process.PeerReputationHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.peerReputationHandler
+}
+
 // PreferredPeersHolderHandler returns the preferred peers holder of the main network
 func (mnc *managedNetworkComponents) PreferredPeersHolderHandler() factory.PreferredPeersHolderHandler {
 	mnc.mutNetworkComponents.RLock()
@@ -241,6 +257,18 @@ func (mnc *managedNetworkComponents) FullArchivePreferredPeersHolderHandler() fa
 	return mnc.fullArchiveNetworkHolder.preferredPeersHolder
 }
 
+// ConfigReloadHandlers returns the network components able to hot-reload their config values
+func (mnc *managedNetworkComponents) ConfigReloadHandlers() []common.ConfigReloadHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.configReloadHandlers
+}
+
 // IsInterfaceNil returns true if the value under the interface is nil
 func (mnc *managedNetworkComponents) IsInterfaceNil() bool {
 	return mnc == nil
@@ -63,6 +63,7 @@ func TestManagedNetworkComponents_Create(t *testing.T) {
 		require.Nil(t, managedNetworkComponents.PeersRatingHandler())
 		require.Nil(t, managedNetworkComponents.FullArchiveNetworkMessenger())
 		require.Nil(t, managedNetworkComponents.FullArchivePreferredPeersHolderHandler())
+		require.Nil(t, managedNetworkComponents.ConfigReloadHandlers())
 
 		err = managedNetworkComponents.Create()
 		require.NoError(t, err)
@@ -76,6 +77,7 @@ func TestManagedNetworkComponents_Create(t *testing.T) {
 		require.NotNil(t, managedNetworkComponents.PeersRatingHandler())
 		require.NotNil(t, managedNetworkComponents.FullArchiveNetworkMessenger())
 		require.NotNil(t, managedNetworkComponents.FullArchivePreferredPeersHolderHandler())
+		require.Equal(t, 2, len(managedNetworkComponents.ConfigReloadHandlers()))
 
 		require.Equal(t, factory.NetworkComponentsName, managedNetworkComponents.String())
 	})
@@ -54,3 +54,8 @@ func (pcf *processComponentsFactory) NewBlockProcessor(
 func (pcf *processComponentsFactory) CreateAPITransactionEvaluator() (factory.TransactionEvaluator, process.VirtualMachinesContainerFactory, error) {
 	return pcf.createAPITransactionEvaluator()
 }
+
+// CreateAPITransactionTracer -
+func (pcf *processComponentsFactory) CreateAPITransactionTracer() (factory.TransactionTracer, process.VirtualMachinesContainerFactory, error) {
+	return pcf.createAPITransactionTracer()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"path/filepath"
 	"time"
@@ -16,6 +17,8 @@ import (
 	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
 	nodeFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
@@ -110,6 +113,7 @@ type processComponents struct {
 	mainPeerShardMapper              process.NetworkShardingCollector
 	fullArchivePeerShardMapper       process.NetworkShardingCollector
 	apiTransactionEvaluator          factory.TransactionEvaluator
+	apiTransactionTracer             factory.TransactionTracer
 	miniBlocksPoolCleaner            process.PoolsCleaner
 	txsPoolCleaner                   process.PoolsCleaner
 	fallbackHeaderValidator          process.FallbackHeaderValidator
@@ -122,6 +126,7 @@ type processComponents struct {
 	nodeRedundancyHandler            consensus.NodeRedundancyHandler
 	currentEpochProvider             dataRetriever.CurrentNetworkEpochProviderHandler
 	vmFactoryForTxSimulator          process.VirtualMachinesContainerFactory
+	vmFactoryForTxTracer             process.VirtualMachinesContainerFactory
 	vmFactoryForProcessing           process.VirtualMachinesContainerFactory
 	scheduledTxsExecutionHandler     process.ScheduledTxsExecutionHandler
 	txsSender                        process.TxsSenderHandler
@@ -663,13 +668,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 			"if the node is in backup mode and the main node is active", "hex public key", observerBLSPublicKeyBuff)
 	}
 
-	maxRoundsOfInactivity := int(pcf.prefConfigs.Preferences.RedundancyLevel) * pcf.config.Redundancy.MaxRoundsOfInactivityAccepted
-	nodeRedundancyArg := redundancy.ArgNodeRedundancy{
-		MaxRoundsOfInactivity: maxRoundsOfInactivity,
-		Messenger:             pcf.network.NetworkMessenger(),
-		ObserverPrivateKey:    observerBLSPrivateKey,
-	}
-	nodeRedundancyHandler, err := redundancy.NewNodeRedundancy(nodeRedundancyArg)
+	nodeRedundancyHandler, err := pcf.createNodeRedundancyHandler(observerBLSPrivateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -695,6 +694,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, fmt.Errorf("%w when assembling components for the transactions simulator processor", err)
 	}
 
+	apiTransactionTracer, vmFactoryForTxTracer, err := pcf.createAPITransactionTracer()
+	if err != nil {
+		return nil, fmt.Errorf("%w when assembling components for the transactions tracer", err)
+	}
+
 	return &processComponents{
 		nodesCoordinator:                 pcf.nodesCoordinator,
 		shardCoordinator:                 pcf.bootstrapComponents.ShardCoordinator(),
@@ -721,6 +725,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		mainPeerShardMapper:              mainPeerShardMapper,
 		fullArchivePeerShardMapper:       fullArchivePeerShardMapper,
 		apiTransactionEvaluator:          apiTransactionEvaluator,
+		apiTransactionTracer:             apiTransactionTracer,
 		miniBlocksPoolCleaner:            mbsPoolsCleaner,
 		txsPoolCleaner:                   txsPoolsCleaner,
 		fallbackHeaderValidator:          fallbackHeaderValidator,
@@ -733,6 +738,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		nodeRedundancyHandler:            nodeRedundancyHandler,
 		currentEpochProvider:             currentEpochProvider,
 		vmFactoryForTxSimulator:          vmFactoryForTxSimulate,
+		vmFactoryForTxTracer:             vmFactoryForTxTracer,
 		vmFactoryForProcessing:           blockProcessorComponents.vmFactoryForProcessing,
 		scheduledTxsExecutionHandler:     scheduledTxsExecutionHandler,
 		txsSender:                        txsSenderWithAccumulator,
@@ -745,6 +751,89 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 	}, nil
 }
 
+// createLeaderLeaseStorer creates the storer of the lease epoch, outside the shard directories so that it is kept if the
+// key is moved to another shard
+func (pcf *processComponentsFactory) createLeaderLeaseStorer() (storage.Storer, error) {
+	storageConfig := pcf.config.Redundancy.LeaderLease.Storage
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(pcf.coreData.PathHandler().DatabasePath(), storageConfig.DB.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w for Redundancy.LeaderLease.Storage", err)
+	}
+
+	return storer, nil
+}
+
+func (pcf *processComponentsFactory) createNodeRedundancyHandler(observerBLSPrivateKey crypto.PrivateKey) (consensus.NodeRedundancyHandler, error) {
+	if !pcf.config.Redundancy.LeaderLease.Enabled {
+		maxRoundsOfInactivity := int(pcf.prefConfigs.Preferences.RedundancyLevel) * pcf.config.Redundancy.MaxRoundsOfInactivityAccepted
+		nodeRedundancyArg := redundancy.ArgNodeRedundancy{
+			MaxRoundsOfInactivity: maxRoundsOfInactivity,
+			Messenger:             pcf.network.NetworkMessenger(),
+			ObserverPrivateKey:    observerBLSPrivateKey,
+		}
+
+		return redundancy.NewNodeRedundancy(nodeRedundancyArg)
+	}
+
+	storer, err := pcf.createLeaderLeaseStorer()
+	if err != nil {
+		return nil, err
+	}
+
+	shardCoordinator := pcf.bootstrapComponents.ShardCoordinator()
+	topic := common.RedundancyLeaseTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
+	messenger := pcf.network.NetworkMessenger()
+	leaderLeaseArg := redundancy.ArgLeaderLeaseRedundancy{
+		RedundancyLevel:       pcf.prefConfigs.Preferences.RedundancyLevel,
+		LeaseDurationInRounds: pcf.config.Redundancy.LeaderLease.LeaseDurationInRounds,
+		Topic:                 topic,
+		Messenger:             messenger,
+		AntifloodHandler:      pcf.network.InputAntiFloodHandler(),
+		Marshaller:            &marshal.JsonMarshalizer{},
+		SingleSigner:          pcf.crypto.BlockSigner(),
+		PrivateKey:            pcf.crypto.PrivateKey(),
+		PublicKey:             pcf.crypto.PublicKey(),
+		ObserverPrivateKey:    observerBLSPrivateKey,
+		AppStatusHandler:      pcf.statusCoreComponents.AppStatusHandler(),
+		Storer:                storer,
+	}
+	leaderLeaseRedundancy, err := redundancy.NewLeaderLeaseRedundancy(leaderLeaseArg)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+
+	if !messenger.HasTopic(topic) {
+		err = messenger.CreateTopic(topic, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = messenger.RegisterMessageProcessor(topic, common.DefaultInterceptorsIdentifier, leaderLeaseRedundancy)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("created the leader lease node redundancy handler", "topic", topic,
+		"redundancy level", pcf.prefConfigs.Preferences.RedundancyLevel,
+		"lease duration in rounds", pcf.config.Redundancy.LeaderLease.LeaseDurationInRounds)
+
+	return leaderLeaseRedundancy, nil
+}
+
 func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := pcf.data.StorageService()
@@ -2010,12 +2099,19 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.vmFactoryForTxSimulator) {
 		log.LogIfError(pc.vmFactoryForTxSimulator.Close())
 	}
+	if !check.IfNil(pc.vmFactoryForTxTracer) {
+		log.LogIfError(pc.vmFactoryForTxTracer.Close())
+	}
 	if !check.IfNil(pc.vmFactoryForProcessing) {
 		log.LogIfError(pc.vmFactoryForProcessing.Close())
 	}
 	if !check.IfNil(pc.txsSender) {
 		log.LogIfError(pc.txsSender.Close())
 	}
+	closableRedundancyHandler, ok := pc.nodeRedundancyHandler.(io.Closer)
+	if ok {
+		log.LogIfError(closableRedundancyHandler.Close())
+	}
 
 	return nil
 }
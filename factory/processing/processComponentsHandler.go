@@ -493,6 +493,18 @@ func (m *managedProcessComponents) APITransactionEvaluator() factory.Transaction
 	return m.processComponents.apiTransactionEvaluator
 }
 
+// APITransactionTracer returns the api transaction tracer
+func (m *managedProcessComponents) APITransactionTracer() factory.TransactionTracer {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.apiTransactionTracer
+}
+
 // WhiteListHandler returns the white list handler
 func (m *managedProcessComponents) WhiteListHandler() process.WhiteListHandler {
 	m.mutProcessComponents.RLock()
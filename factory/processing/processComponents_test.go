@@ -31,6 +31,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks"
 	txExecOrderStub "github.com/multiversx/mx-chain-go/testscommon/common"
@@ -749,6 +750,26 @@ func TestProcessComponentsFactory_Create(t *testing.T) {
 		args.CoreData = coreCompStub
 		testCreateWithArgs(t, args, "no one staked")
 	})
+	t.Run("NewLeaderLeaseRedundancy fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockProcessComponentsFactoryArgs()
+		args.Config.Redundancy.LeaderLease.Enabled = true
+		args.Config.Redundancy.LeaderLease.LeaseDurationInRounds = 0
+		args.Config.Redundancy.LeaderLease.Storage = config.StorageConfig{
+			Cache: config.CacheConfig{Type: "LRU", Capacity: 10},
+			DB:    config.DBConfig{Type: string(storageunit.MemoryDB), FilePath: "RedundancyLease"},
+		}
+		testCreateWithArgs(t, args, "invalid lease duration")
+	})
+	t.Run("leader lease storer fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockProcessComponentsFactoryArgs()
+		args.Config.Redundancy.LeaderLease.Enabled = true
+		args.Config.Redundancy.LeaderLease.LeaseDurationInRounds = 3
+		testCreateWithArgs(t, args, "Redundancy.LeaderLease.Storage")
+	})
 	t.Run("should work with indexAndReturnGenesisAccounts failing due to RootHash failure", func(t *testing.T) {
 		t.Parallel()
 
@@ -3,9 +3,12 @@ package processing
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	bootstrapDisabled "github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	"github.com/multiversx/mx-chain-go/factory"
+	disabledFactory "github.com/multiversx/mx-chain-go/factory/disabled"
 	"github.com/multiversx/mx-chain-go/genesis"
 	processDisabled "github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/process"
@@ -18,6 +21,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/state"
+	factoryState "github.com/multiversx/mx-chain-go/state/factory"
+	disabledState "github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
@@ -27,6 +32,13 @@ import (
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
+type txSimulatorComponents struct {
+	args               transactionEvaluator.ArgsTxSimulator
+	vmContainerFactory process.VirtualMachinesContainerFactory
+	txTypeHandler      process.TxTypeHandler
+	scrProcessor       process.SmartContractResultProcessor
+}
+
 func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.TransactionEvaluator, process.VirtualMachinesContainerFactory, error) {
 	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(pcf.state.AccountsAdapterAPI())
 	if err != nil {
@@ -47,19 +59,17 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		return nil, nil, err
 	}
 
-	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor)
+	simulatorComponents, err := pcf.createTxSimulatorComponents(simulationAccountsDB, vmOutputCacher, txLogsProcessor)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	dataFieldParser, err := datafield.NewOperationDataFieldParser(&datafield.ArgsOperationDataFieldParser{
-		AddressLength: pcf.coreData.AddressPubKeyConverter().Len(),
-		Marshalizer:   pcf.coreData.InternalMarshalizer(),
-	})
+	dataFieldParser, err := pcf.createDataFieldParser()
 	if err != nil {
 		return nil, nil, err
 	}
 
+	txSimulatorProcessorArgs := simulatorComponents.args
 	txSimulatorProcessorArgs.VMOutputCacher = vmOutputCacher
 	txSimulatorProcessorArgs.AddressPubKeyConverter = pcf.coreData.AddressPubKeyConverter()
 	txSimulatorProcessorArgs.ShardCoordinator = pcf.bootstrapComponents.ShardCoordinator()
@@ -73,7 +83,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 	}
 
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(transactionEvaluator.ArgsApiTransactionEvaluator{
-		TxTypeHandler:       txTypeHandler,
+		TxTypeHandler:       simulatorComponents.txTypeHandler,
 		FeeHandler:          pcf.coreData.EconomicsData(),
 		TxSimulator:         txSimulator,
 		Accounts:            simulationAccountsDB,
@@ -81,29 +91,127 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 	})
 
-	return apiTransactionEvaluator, vmContainerFactory, err
+	return apiTransactionEvaluator, simulatorComponents.vmContainerFactory, err
 }
 
-func (pcf *processComponentsFactory) createArgsTxSimulatorProcessor(
+// createAPITransactionTracer creates the transaction tracer on top of its own accounts adapter and virtual machines,
+// as the tracer recreates the state of the parent block of each traced transaction
+func (pcf *processComponentsFactory) createAPITransactionTracer() (factory.TransactionTracer, process.VirtualMachinesContainerFactory, error) {
+	if !pcf.config.DbLookupExtensions.Enabled {
+		return disabledFactory.NewTransactionTracer(), nil, nil
+	}
+
+	accountsAdapter, err := pcf.createAccountsAdapterForTracing()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracingAccountsDB, err := transactionEvaluator.NewTracingAccountsDB(accountsAdapter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(tracingAccountsDB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmOutputCacherConfig := storageFactory.GetCacherFromConfig(pcf.config.VMOutputCacher)
+	vmOutputCacher, err := storageunit.NewCache(vmOutputCacherConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txLogsProcessor, err := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Marshalizer:          pcf.coreData.InternalMarshalizer(),
+		SaveInStorageEnabled: false, // no storer needed for tx tracer
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracerComponents, err := pcf.createTxSimulatorComponents(simulationAccountsDB, vmOutputCacher, txLogsProcessor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataFieldParser, err := pcf.createDataFieldParser()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txTracer, err := transactionEvaluator.NewTransactionTracer(transactionEvaluator.ArgsTransactionTracer{
+		TransactionProcessor:      tracerComponents.args.TransactionProcessor,
+		SCRProcessor:              tracerComponents.scrProcessor,
+		IntermediateProcContainer: tracerComponents.args.IntermediateProcContainer,
+		BlockChainHook:            tracerComponents.vmContainerFactory.BlockChainHookImpl(),
+		Accounts:                  tracingAccountsDB,
+		SimulationAccounts:        simulationAccountsDB,
+		TxTypeHandler:             tracerComponents.txTypeHandler,
+		FeeHandler:                pcf.coreData.EconomicsData(),
+		VMOutputCacher:            vmOutputCacher,
+		HistoryRepository:         pcf.historyRepo,
+		StorageService:            pcf.data.StorageService(),
+		AddressPubKeyConverter:    pcf.coreData.AddressPubKeyConverter(),
+		ShardCoordinator:          pcf.bootstrapComponents.ShardCoordinator(),
+		Marshalizer:               pcf.coreData.InternalMarshalizer(),
+		DataFieldParser:           dataFieldParser,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txTracer, tracerComponents.vmContainerFactory, nil
+}
+
+func (pcf *processComponentsFactory) createAccountsAdapterForTracing() (state.AccountsAdapter, error) {
+	accountFactory, err := factoryState.NewAccountCreator(factoryState.ArgsAccountCreator{
+		Hasher:              pcf.coreData.Hasher(),
+		Marshaller:          pcf.coreData.InternalMarshalizer(),
+		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  pcf.state.TriesContainer().Get([]byte(dataRetriever.UserAccountsUnit.String())),
+		Hasher:                pcf.coreData.Hasher(),
+		Marshaller:            pcf.coreData.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: disabledState.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.GetNodeProcessingMode(&pcf.importDBConfig),
+		ProcessStatusHandler:  pcf.coreData.ProcessStatusHandler(),
+		AppStatusHandler:      pcf.statusCoreComponents.AppStatusHandler(),
+		AddressConverter:      pcf.coreData.AddressPubKeyConverter(),
+	})
+}
+
+func (pcf *processComponentsFactory) createDataFieldParser() (transactionEvaluator.DataFieldParser, error) {
+	return datafield.NewOperationDataFieldParser(&datafield.ArgsOperationDataFieldParser{
+		AddressLength: pcf.coreData.AddressPubKeyConverter().Len(),
+		Marshalizer:   pcf.coreData.InternalMarshalizer(),
+	})
+}
+
+func (pcf *processComponentsFactory) createTxSimulatorComponents(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
-) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
+) (*txSimulatorComponents, error) {
 	shardID := pcf.bootstrapComponents.ShardCoordinator().SelfId()
 	if shardID == core.MetachainShardId {
-		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createTxSimulatorComponentsForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor)
 	} else {
-		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createTxSimulatorComponentsShard(accountsAdapter, vmOutputCacher, txLogsProcessor)
 	}
 }
 
-func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
+func (pcf *processComponentsFactory) createTxSimulatorComponentsForMeta(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
-) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
-	args := transactionEvaluator.ArgsTxSimulator{}
-
+) (*txSimulatorComponents, error) {
 	argsFactory := shard.ArgsNewIntermediateProcessorsContainerFactory{
 		ShardCoordinator:        pcf.bootstrapComponents.ShardCoordinator(),
 		Marshalizer:             pcf.coreData.InternalMarshalizer(),
@@ -117,17 +225,17 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	}
 	intermediateProcessorsFactory, err := shard.NewIntermediateProcessorsContainerFactory(argsFactory)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	intermediateProcessorsContainer, err := intermediateProcessorsFactory.Create()
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(accountsAdapter, make(map[string]struct{}))
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	vmContainerFactory, err := pcf.createVMFactoryMeta(
@@ -138,17 +246,17 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
 	)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	vmContainer, err := vmContainerFactory.Create()
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	txTypeHandler, err := pcf.createTxTypeHandler(builtInFuncFactory)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	gasHandler, err := preprocess.NewGasComputation(
@@ -157,16 +265,16 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		pcf.coreData.EnableEpochsHandler(),
 	)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	scForwarder, err := intermediateProcessorsContainer.Get(dataBlock.SmartContractResultBlock)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 	badTxInterim, err := intermediateProcessorsContainer.Get(dataBlock.InvalidBlock)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	scProcArgs := scrCommon.ArgsNewSmartContractProcessor{
@@ -196,7 +304,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	argsTxProcessor := transaction.ArgsNewMetaTxProcessor{
@@ -215,13 +323,18 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 
 	txProcessor, err := transaction.NewMetaTxProcessor(argsTxProcessor)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
-	args.TransactionProcessor = txProcessor
-	args.IntermediateProcContainer = intermediateProcessorsContainer
-
-	return args, vmContainerFactory, txTypeHandler, nil
+	return &txSimulatorComponents{
+		args: transactionEvaluator.ArgsTxSimulator{
+			TransactionProcessor:      txProcessor,
+			IntermediateProcContainer: intermediateProcessorsContainer,
+		},
+		vmContainerFactory: vmContainerFactory,
+		txTypeHandler:      txTypeHandler,
+		scrProcessor:       scProcessor,
+	}, nil
 }
 
 func (pcf *processComponentsFactory) createTxTypeHandler(builtInFuncFactory vmcommon.BuiltInFunctionFactory) (process.TxTypeHandler, error) {
@@ -242,13 +355,11 @@ func (pcf *processComponentsFactory) createTxTypeHandler(builtInFuncFactory vmco
 	return coordinator.NewTxTypeHandler(argsTxTypeHandler)
 }
 
-func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
+func (pcf *processComponentsFactory) createTxSimulatorComponentsShard(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
-) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
-	args := transactionEvaluator.ArgsTxSimulator{}
-
+) (*txSimulatorComponents, error) {
 	argsFactory := shard.ArgsNewIntermediateProcessorsContainerFactory{
 		ShardCoordinator:        pcf.bootstrapComponents.ShardCoordinator(),
 		Marshalizer:             pcf.coreData.InternalMarshalizer(),
@@ -263,28 +374,28 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 
 	intermediateProcessorsFactory, err := shard.NewIntermediateProcessorsContainerFactory(argsFactory)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	intermediateProcessorsContainer, err := intermediateProcessorsFactory.Create()
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	mapDNSAddresses, err := pcf.smartContractParser.GetDeployedSCAddresses(genesis.DNSType)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(accountsAdapter, mapDNSAddresses)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	smartContractStorageSimulate := pcf.config.SmartContractsStorageSimulate
 	esdtTransferParser, err := parsers.NewESDTTransferParser(pcf.coreData.InternalMarshalizer())
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	vmContainerFactory, err := pcf.createVMFactoryShard(
@@ -298,22 +409,22 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
 	)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	err = builtInFuncFactory.SetPayableHandler(vmContainerFactory.BlockChainHookImpl())
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	vmContainer, err := vmContainerFactory.Create()
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	txTypeHandler, err := pcf.createTxTypeHandler(builtInFuncFactory)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 	txFeeHandler := &processDisabled.FeeHandler{}
 
@@ -323,20 +434,20 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		pcf.coreData.EnableEpochsHandler(),
 	)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	scForwarder, err := intermediateProcessorsContainer.Get(dataBlock.SmartContractResultBlock)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 	badTxInterim, err := intermediateProcessorsContainer.Get(dataBlock.InvalidBlock)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 	receiptTxInterim, err := intermediateProcessorsContainer.Get(dataBlock.ReceiptBlock)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	argsParser := smartContract.NewArgumentParser()
@@ -368,7 +479,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
 	argsTxProcessor := transaction.ArgsNewTxProcessor{
@@ -395,11 +506,16 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 
 	txProcessor, err := transaction.NewTxProcessor(argsTxProcessor)
 	if err != nil {
-		return args, nil, nil, err
+		return nil, err
 	}
 
-	args.TransactionProcessor = txProcessor
-	args.IntermediateProcContainer = intermediateProcessorsContainer
-
-	return args, vmContainerFactory, txTypeHandler, nil
+	return &txSimulatorComponents{
+		args: transactionEvaluator.ArgsTxSimulator{
+			TransactionProcessor:      txProcessor,
+			IntermediateProcContainer: intermediateProcessorsContainer,
+		},
+		vmContainerFactory: vmContainerFactory,
+		txTypeHandler:      txTypeHandler,
+		scrProcessor:       scProcessor,
+	}, nil
 }
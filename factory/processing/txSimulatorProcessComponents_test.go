@@ -51,3 +51,32 @@ func TestManagedProcessComponents_createAPITransactionEvaluator(t *testing.T) {
 		assert.False(t, check.IfNil(vmContainerFactory))
 	})
 }
+
+func TestManagedProcessComponents_createAPITransactionTracer(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinatorForShardID2 := mock.NewMultiShardsCoordinatorMock(3)
+	shardCoordinatorForShardID2.CurrentShard = 2
+
+	// no further t.Parallel as these tests are quite heavy (they open netMessengers and other components that start a lot of goroutines)
+	t.Run("disabled db lookup extensions should return the disabled tracer", func(t *testing.T) {
+		processArgs := components.GetProcessComponentsFactoryArgs(shardCoordinatorForShardID2)
+		processArgs.Config.DbLookupExtensions.Enabled = false
+		pcf, _ := processing.NewProcessComponentsFactory(processArgs)
+
+		apiTransactionTracer, vmContainerFactory, err := pcf.CreateAPITransactionTracer()
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(apiTransactionTracer))
+		assert.True(t, check.IfNil(vmContainerFactory))
+	})
+	t.Run("should work for shard", func(t *testing.T) {
+		processArgs := components.GetProcessComponentsFactoryArgs(shardCoordinatorForShardID2)
+		processArgs.Config.DbLookupExtensions.Enabled = true
+		pcf, _ := processing.NewProcessComponentsFactory(processArgs)
+
+		apiTransactionTracer, vmContainerFactory, err := pcf.CreateAPITransactionTracer()
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(apiTransactionTracer))
+		assert.False(t, check.IfNil(vmContainerFactory))
+	})
+}
@@ -2,6 +2,7 @@ package status
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -19,10 +20,15 @@ import (
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/keysManagement"
 	"github.com/multiversx/mx-chain-go/outport"
+	outportDisabled "github.com/multiversx/mx-chain-go/outport/disabled"
+	"github.com/multiversx/mx-chain-go/outport/events"
 	outportDriverFactory "github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -30,6 +36,7 @@ type statusComponents struct {
 	nodesCoordinator    nodesCoordinator.NodesCoordinator
 	statusHandler       core.AppStatusHandler
 	outportHandler      outport.OutportHandler
+	eventsHub           outport.EventsSubscriptionHandler
 	softwareVersion     statistics.SoftwareVersionChecker
 	managedPeersMonitor common.ManagedPeersMonitor
 	cancelFunc          func()
@@ -142,6 +149,11 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, err
 	}
 
+	eventsHub, err := scf.createEventsHub(outportHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	managedPeersMonitorArgs := keysManagement.ArgManagedPeersMonitor{
 		ManagedPeersHolder: scf.cryptoComponents.ManagedPeersHolder(),
 		NodesCoordinator:   scf.nodesCoordinator,
@@ -159,6 +171,7 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		nodesCoordinator:    scf.nodesCoordinator,
 		softwareVersion:     softwareVersionChecker,
 		outportHandler:      outportHandler,
+		eventsHub:           eventsHub,
 		statusHandler:       scf.statusCoreComponents.AppStatusHandler(),
 		managedPeersMonitor: managedPeersMonitor,
 		cancelFunc:          cancelFunc,
@@ -221,18 +234,49 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 		return nil, err
 	}
 
+	journalArgs, err := scf.makeOutportJournalArgs()
+	if err != nil {
+		return nil, err
+	}
+
 	outportFactoryArgs := &outportDriverFactory.OutportFactoryArgs{
 		ShardID:                   scf.shardCoordinator.SelfId(),
 		RetrialInterval:           common.RetrialIntervalForOutportDriver,
 		ElasticIndexerFactoryArgs: scf.makeElasticIndexerArgs(),
 		EventNotifierFactoryArgs:  eventNotifierArgs,
 		HostDriversArgs:           hostDriversArgs,
+		JournalArgs:               journalArgs,
 		IsImportDB:                scf.isInImportMode,
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createEventsHub creates the events hub used by the REST API subscriptions and subscribes it as an outport driver
+func (scf *statusComponentsFactory) createEventsHub(outportHandler outport.OutportHandler) (outport.EventsSubscriptionHandler, error) {
+	subscriptionsConfig := scf.externalConfig.EventsSubscriptions
+	if !subscriptionsConfig.Enabled {
+		return outportDisabled.NewDisabledEventsHub(), nil
+	}
+
+	eventsHub, err := events.NewEventsHub(events.ArgsEventsHub{
+		AddressPubKeyConverter:  scf.coreComponents.AddressPubKeyConverter(),
+		Marshaller:              scf.coreComponents.InternalMarshalizer(),
+		MaxSubscriptions:        subscriptionsConfig.MaxSubscriptions,
+		NotificationsBufferSize: subscriptionsConfig.NotificationsBufferSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w for EventsSubscriptions", err)
+	}
+
+	err = outportHandler.SubscribeDriver(eventsHub)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsHub, nil
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return indexerFactory.ArgsIndexerFactory{
@@ -293,3 +337,50 @@ func (scf *statusComponentsFactory) makeHostDriversArgs() ([]outportDriverFactor
 
 	return argsHostDriverFactorySlice, nil
 }
+
+func (scf *statusComponentsFactory) makeOutportJournalArgs() (*outportDriverFactory.OutportJournalFactoryArgs, error) {
+	journalConfig := scf.externalConfig.OutportJournal
+	if !journalConfig.Enabled {
+		return &outportDriverFactory.OutportJournalFactoryArgs{}, nil
+	}
+
+	dbConfigValues := journalConfig.Storage.DB
+	if storageunit.DBType(dbConfigValues.Type) != storageunit.LvlDBSerial {
+		// the other types either do not persist the entries or do not sync the writes in the order of the events
+		return nil, fmt.Errorf("%w for OutportJournal.Storage.DB: %s, only %s can be used",
+			storage.ErrNotSupportedDBType, dbConfigValues.Type, storageunit.LvlDBSerial)
+	}
+	if dbConfigValues.MaxBatchSize != 1 {
+		// each entry and cursor is written with a synced LevelDB batch, which happens on every put only for a batch size of 1
+		log.Warn("OutportJournal.Storage.DB.MaxBatchSize is ignored, each outport event is written to disk before being delivered",
+			"max batch size", dbConfigValues.MaxBatchSize)
+		dbConfigValues.MaxBatchSize = 1
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(dbConfigValues)
+	shardID := core.GetShardIDString(scf.shardCoordinator.SelfId())
+	dbConfig.FilePath = scf.coreComponents.PathHandler().PathForStatic(shardID, dbConfigValues.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(dbConfigValues)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(journalConfig.Storage.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w for OutportJournal.Storage", err)
+	}
+
+	return &outportDriverFactory.OutportJournalFactoryArgs{
+		Enabled:            true,
+		Storer:             storer,
+		Marshaller:         scf.coreComponents.InternalMarshalizer(),
+		MaxBacklog:         journalConfig.MaxBacklog,
+		NumRetainedEntries: journalConfig.NumRetainedEntries,
+	}, nil
+}
@@ -96,6 +96,9 @@ func (msc *managedStatusComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.outportHandler) {
 		return errors.ErrNilOutportHandler
 	}
+	if check.IfNil(msc.eventsHub) {
+		return errors.ErrNilEventsSubscriptionHandler
+	}
 	if check.IfNil(msc.softwareVersion) {
 		return errors.ErrNilSoftwareVersion
 	}
@@ -156,6 +159,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// EventsSubscriptionHandler returns the handler of the REST API events subscriptions
+func (msc *managedStatusComponents) EventsSubscriptionHandler() outport.EventsSubscriptionHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.eventsHub
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
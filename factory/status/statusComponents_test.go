@@ -2,24 +2,39 @@ package status_test
 
 import (
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/multiversx/mx-chain-communication-go/websocket/data"
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/config"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	factoryMx "github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	statusComp "github.com/multiversx/mx-chain-go/factory/status"
 	testsMocks "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/outport/events"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
 	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
 	"github.com/multiversx/mx-chain-go/testscommon/factory"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
 
+type coreComponentsWithPathHandler struct {
+	factoryMx.CoreComponentsHolder
+	pathHandler storage.PathManagerHandler
+}
+
+// PathHandler -
+func (ccph *coreComponentsWithPathHandler) PathHandler() storage.PathManagerHandler {
+	return ccph.pathHandler
+}
+
 func createMockStatusComponentsFactoryArgs() statusComp.StatusComponentsFactoryArgs {
 	return statusComp.StatusComponentsFactoryArgs{
 		Config: testscommon.GetGeneralConfig(),
@@ -211,6 +226,124 @@ func TestStatusComponentsFactory_Create(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, sc)
 	})
+	t.Run("invalid outport journal storage config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockStatusComponentsFactoryArgs()
+		workingDir := t.TempDir()
+		args.CoreComponents.(*mock.CoreComponentsMock).PathHdl = &testscommon.PathManagerStub{
+			PathForStaticCalled: func(shardId string, identifier string) string {
+				return filepath.Join(workingDir, shardId, identifier)
+			},
+		}
+		args.ExternalConfig.OutportJournal = config.OutportJournalConfig{
+			Enabled: true,
+			Storage: config.StorageConfig{
+				Cache: config.CacheConfig{Type: "invalid"},
+				DB:    config.DBConfig{Type: "LvlDBSerial", FilePath: "OutportJournal", MaxBatchSize: 1, MaxOpenFiles: 10},
+			},
+		}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		require.NotNil(t, scf)
+
+		sc, err := scf.Create()
+		require.Error(t, err)
+		require.Nil(t, sc)
+	})
+	t.Run("invalid events subscriptions config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockStatusComponentsFactoryArgs()
+		coreComponents := args.CoreComponents.(*mock.CoreComponentsMock)
+		coreComponents.AddrPubKeyConv = testscommon.NewPubkeyConverterMock(32)
+		coreComponents.IntMarsh = &marshallerMock.MarshalizerMock{}
+		args.ExternalConfig.EventsSubscriptions = config.EventsSubscriptionsConfig{
+			Enabled:                 true,
+			MaxSubscriptions:        0,
+			NotificationsBufferSize: 10,
+		}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		require.NotNil(t, scf)
+
+		sc, err := scf.Create()
+		require.True(t, errors.Is(err, events.ErrInvalidMaxSubscriptions))
+		require.Nil(t, sc)
+	})
+	t.Run("should work with events subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+		args, _ := componentsMock.GetStatusComponentsFactoryArgsAndProcessComponents(shardCoordinator)
+		args.ExternalConfig.EventsSubscriptions = config.EventsSubscriptionsConfig{
+			Enabled:                 true,
+			MaxSubscriptions:        10,
+			NotificationsBufferSize: 10,
+		}
+		scf, err := statusComp.NewStatusComponentsFactory(args)
+		require.Nil(t, err)
+
+		sc, err := scf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, sc)
+
+		require.NoError(t, sc.Close())
+	})
+	t.Run("outport journal with other DB type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockStatusComponentsFactoryArgs()
+		args.CoreComponents.(*mock.CoreComponentsMock).PathHdl = &testscommon.PathManagerStub{}
+		args.ExternalConfig.OutportJournal = config.OutportJournalConfig{
+			Enabled: true,
+			Storage: config.StorageConfig{
+				Cache: config.CacheConfig{Type: "LRU", Capacity: 10, Shards: 1},
+				DB:    config.DBConfig{Type: "MemoryDB", FilePath: "OutportJournal"},
+			},
+		}
+		scf, _ := statusComp.NewStatusComponentsFactory(args)
+		require.NotNil(t, scf)
+
+		sc, err := scf.Create()
+		require.True(t, errors.Is(err, storage.ErrNotSupportedDBType))
+		require.Nil(t, sc)
+	})
+	t.Run("should work with outport journal", func(t *testing.T) {
+		t.Parallel()
+
+		shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+		args, _ := componentsMock.GetStatusComponentsFactoryArgsAndProcessComponents(shardCoordinator)
+		workingDir := t.TempDir()
+		args.CoreComponents = &coreComponentsWithPathHandler{
+			CoreComponentsHolder: args.CoreComponents,
+			pathHandler: &testscommon.PathManagerStub{
+				PathForStaticCalled: func(shardId string, identifier string) string {
+					return filepath.Join(workingDir, shardId, identifier)
+				},
+			},
+		}
+		args.ExternalConfig.OutportJournal = config.OutportJournalConfig{
+			Enabled:    true,
+			MaxBacklog: 10,
+			Storage: config.StorageConfig{
+				Cache: config.CacheConfig{Type: "LRU", Capacity: 10, Shards: 1},
+				DB: config.DBConfig{
+					Type:              "LvlDBSerial",
+					FilePath:          "OutportJournal",
+					BatchDelaySeconds: 2,
+					MaxBatchSize:      100,
+					MaxOpenFiles:      10,
+				},
+			},
+		}
+		scf, err := statusComp.NewStatusComponentsFactory(args)
+		require.Nil(t, err)
+
+		sc, err := scf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, sc)
+
+		require.NoError(t, sc.Close())
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -56,9 +56,10 @@ func CreateDefaultConfig(tb testing.TB) *config.Configs {
 	configs.ExternalConfig = externalConfig
 	configs.EpochConfig = epochConfig
 	configs.RoundConfig = roundConfig
+	workingDir := tb.TempDir()
 	configs.FlagsConfig = &config.ContextFlagsConfig{
-		WorkingDir:  tb.TempDir(),
-		DbDir:       "dbDir",
+		WorkingDir:  workingDir,
+		DbDir:       path.Join(workingDir, "dbDir"),
 		LogsDir:     "logsDir",
 		UseLogView:  true,
 		BaseVersion: BaseVersion,
@@ -2,6 +2,7 @@ package integrationTests
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -91,29 +92,53 @@ type Facade interface {
 	ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTransaction(hash string) (*txSimData.TransactionTrace, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	ValidatorStatisticsApi() (map[string]*accounts.ValidatorApiResponse, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
+	ExecuteSCQueries([]*process.SCQuery) ([]*common.SCQueryResultApi, api.BlockInfo, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error)
+	VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error)
+	GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error)
+	GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPool(subscriptionID uint64)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error)
+	GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
+	SubscribeEvents(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	UnsubscribeEvents(subscriptionID uint64)
 	GetAlteredAccountsForBlock(options dataApi.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
+	ReloadConfig() ([]common.ConfigChange, error)
+	GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error)
+	PromoteRedundancyLease() (common.RedundancyLeaseStatus, error)
+	DemoteRedundancyLease() (common.RedundancyLeaseStatus, error)
+	GetPeersReputation() ([]*common.PeerReputation, error)
+	BanPeer(pid string, duration time.Duration, reason string) error
+	UnbanPeer(pid string) error
+	BanIP(ip string, duration time.Duration, reason string) error
+	UnbanIP(ip string) error
+	GetOutportDriversCursors() (map[string]uint64, error)
+	ReplayOutport(driverID string, sequence uint64) error
 	IsInterfaceNil() bool
 }
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
@@ -14,11 +15,13 @@ type NetworkComponentsStub struct {
 	OutputAntiFlood                  factory.P2PAntifloodHandler
 	PeerBlackList                    process.PeerBlackListCacher
 	PeerHonesty                      factory.PeerHonestyHandler
+	PeerReputationHandlerField       process.PeerReputationHandler
 	PreferredPeersHolder             factory.PreferredPeersHolderHandler
 	PeersRatingHandlerField          p2p.PeersRatingHandler
 	PeersRatingMonitorField          p2p.PeersRatingMonitor
 	FullArchiveNetworkMessengerField p2p.Messenger
 	FullArchivePreferredPeersHolder  factory.PreferredPeersHolderHandler
+	ConfigReloadHandlersField        []common.ConfigReloadHandler
 }
 
 // PubKeyCacher -
@@ -31,6 +34,11 @@ func (ncs *NetworkComponentsStub) PeerHonestyHandler() factory.PeerHonestyHandle
 	return ncs.PeerHonesty
 }
 
+// PeerReputationHandler -
+func (ncs *NetworkComponentsStub) PeerReputationHandler() process.PeerReputationHandler {
+	return ncs.PeerReputationHandlerField
+}
+
 // Create -
 func (ncs *NetworkComponentsStub) Create() error {
 	return nil
@@ -99,6 +107,11 @@ func (ncs *NetworkComponentsStub) String() string {
 	return "NetworkComponentsStub"
 }
 
+// ConfigReloadHandlers -
+func (ncs *NetworkComponentsStub) ConfigReloadHandlers() []common.ConfigReloadHandler {
+	return ncs.ConfigReloadHandlersField
+}
+
 // IsInterfaceNil -
 func (ncs *NetworkComponentsStub) IsInterfaceNil() bool {
 	return ncs == nil
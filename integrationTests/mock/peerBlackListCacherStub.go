@@ -8,10 +8,11 @@ import (
 
 // PeerBlackListCacherStub -
 type PeerBlackListCacherStub struct {
-	AddCalled    func(pid core.PeerID) error
-	UpsertCalled func(pid core.PeerID, span time.Duration) error
-	HasCalled    func(pid core.PeerID) bool
-	SweepCalled  func()
+	AddCalled              func(pid core.PeerID) error
+	UpsertCalled           func(pid core.PeerID, span time.Duration) error
+	UpsertWithReasonCalled func(pid core.PeerID, span time.Duration, reason string) error
+	HasCalled              func(pid core.PeerID) bool
+	SweepCalled            func()
 }
 
 // Add -
@@ -32,6 +33,15 @@ func (pblhs *PeerBlackListCacherStub) Upsert(pid core.PeerID, span time.Duration
 	return pblhs.UpsertCalled(pid, span)
 }
 
+// UpsertWithReason -
+func (pblhs *PeerBlackListCacherStub) UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error {
+	if pblhs.UpsertWithReasonCalled == nil {
+		return nil
+	}
+
+	return pblhs.UpsertWithReasonCalled(pid, span, reason)
+}
+
 // Has -
 func (pblhs *PeerBlackListCacherStub) Has(pid core.PeerID) bool {
 	if pblhs.HasCalled == nil {
@@ -44,6 +44,7 @@ type ProcessComponentsStub struct {
 	MainPeerMapper                       process.NetworkShardingCollector
 	FullArchivePeerMapper                process.NetworkShardingCollector
 	TxCostSimulator                      factory.TransactionEvaluator
+	TransactionTracer                    factory.TransactionTracer
 	FallbackHdrValidator                 process.FallbackHeaderValidator
 	WhiteListHandlerInternal             process.WhiteListHandler
 	WhiteListerVerifiedTxsInternal       process.WhiteListHandler
@@ -216,6 +217,11 @@ func (pcs *ProcessComponentsStub) APITransactionEvaluator() factory.TransactionE
 	return pcs.TxCostSimulator
 }
 
+// APITransactionTracer -
+func (pcs *ProcessComponentsStub) APITransactionTracer() factory.TransactionTracer {
+	return pcs.TransactionTracer
+}
+
 // WhiteListHandler -
 func (pcs *ProcessComponentsStub) WhiteListHandler() process.WhiteListHandler {
 	return pcs.WhiteListHandlerInternal
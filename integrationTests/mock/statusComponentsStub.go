@@ -9,6 +9,7 @@ import (
 // StatusComponentsStub -
 type StatusComponentsStub struct {
 	Outport                  outport.OutportHandler
+	EventsSubscriptions      outport.EventsSubscriptionHandler
 	SoftwareVersionCheck     statistics.SoftwareVersionChecker
 	ManagedPeersMonitorField common.ManagedPeersMonitor
 }
@@ -33,6 +34,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// EventsSubscriptionHandler -
+func (scs *StatusComponentsStub) EventsSubscriptionHandler() outport.EventsSubscriptionHandler {
+	return scs.EventsSubscriptions
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
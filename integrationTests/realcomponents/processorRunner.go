@@ -178,6 +178,7 @@ func (pr *ProcessorRunner) createNetworkComponents(tb testing.TB) {
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: "",
 		CryptoComponents:      pr.CryptoComponents,
+		PathHandler:           pr.CoreComponents.PathHandler(),
 	}
 
 	networkFactory, err := factoryNetwork.NewNetworkComponentsFactory(argsNetwork)
@@ -382,7 +383,7 @@ func (pr *ProcessorRunner) createProcessComponents(tb testing.TB) {
 	require.Nil(tb, err)
 
 	historyRepoFactoryArgs := &dbLookupFactory.ArgsHistoryRepositoryFactory{
-		SelfShardID:              pr.BootstrapComponents.ShardCoordinator().SelfId(),
+		ShardCoordinator:         pr.BootstrapComponents.ShardCoordinator(),
 		Config:                   pr.Config.GeneralConfig.DbLookupExtensions,
 		Hasher:                   pr.CoreComponents.Hasher(),
 		Marshalizer:              pr.CoreComponents.InternalMarshalizer(),
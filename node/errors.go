@@ -138,3 +138,24 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrOutportJournalNotEnabled signals that the outport is not backed by a persistent journal
+var ErrOutportJournalNotEnabled = errors.New("outport journal is not enabled")
+
+// ErrRedundancyLeaseNotEnabled signals that the redundancy leader lease protocol is not enabled
+var ErrRedundancyLeaseNotEnabled = errors.New("redundancy leader lease is not enabled")
+
+// ErrStateSnapshotExport signals that the state snapshot could not be exported
+var ErrStateSnapshotExport = errors.New("state snapshot export error")
+
+// ErrNilTrieStorageManager signals that the trie storage manager of the user accounts was not found
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrStorageReportInProgress signals that a storage report is already being computed
+var ErrStorageReportInProgress = errors.New("a storage report is already being computed")
+
+// ErrInvalidStorageReportFormat signals that an invalid storage report format was provided
+var ErrInvalidStorageReportFormat = errors.New("invalid storage report format")
+
+// ErrInvalidStateDiffCursor signals that an invalid state diff cursor was provided
+var ErrInvalidStateDiffCursor = errors.New("invalid state diff cursor")
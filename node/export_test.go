@@ -1,6 +1,8 @@
 package node
 
 import (
+	"io"
+
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -54,3 +56,18 @@ func (n *Node) GetPendingAndActiveGuardians(
 ) (activeGuardian *api.Guardian, pendingGuardian *api.Guardian, err error) {
 	return n.getPendingAndActiveGuardians(userAccount)
 }
+
+// GetEpochStartShardHeaderHash -
+func GetEpochStartShardHeaderHash(epochStartMeta data.MetaHeaderHandler, shardID uint32) ([]byte, error) {
+	return getEpochStartShardHeaderHash(epochStartMeta, shardID)
+}
+
+// GetShardRootHashToExport -
+func GetShardRootHashToExport(shardHeader data.ShardHeaderHandler, scheduledEnableEpoch uint32) []byte {
+	return getShardRootHashToExport(shardHeader, scheduledEnableEpoch)
+}
+
+// GetStorageReportWriter -
+func GetStorageReportWriter(format string) (func(writer io.Writer, report *common.StorageReport) error, error) {
+	return getStorageReportWriter(format)
+}
@@ -131,7 +131,7 @@ func (bap *baseAPIBlockProcessor) getAndAttachTxsToMb(
 func (bap *baseAPIBlockProcessor) getMiniblockByHashAndEpoch(miniblockHash []byte, epoch uint32) (*block.MiniBlock, error) {
 	buff, err := bap.getFromStorerWithEpoch(dataRetriever.MiniBlockUnit, miniblockHash, epoch)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v, hash = %s", errCannotLoadMiniblocks, err, hex.EncodeToString(miniblockHash))
+		return nil, fmt.Errorf("%w: %w, hash = %s", errCannotLoadMiniblocks, err, hex.EncodeToString(miniblockHash))
 	}
 
 	miniBlock := &block.MiniBlock{}
@@ -190,7 +190,7 @@ func (bap *baseAPIBlockProcessor) getReceiptsFromMiniblock(miniblock *block.Mini
 	start := time.Now()
 	marshalledReceipts, err := storer.GetBulkFromEpoch(miniblock.TxHashes, epoch)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", errCannotLoadReceipts, err)
+		return nil, fmt.Errorf("%w: %w", errCannotLoadReceipts, err)
 	}
 	logging.LogAPIActionDurationIfNeeded(start, "GetBulkFromEpoch")
 
@@ -226,7 +226,7 @@ func (bap *baseAPIBlockProcessor) getTxsFromMiniblock(
 	executedTxHashes := extractExecutedTxHashes(miniblock.TxHashes, firstProcessedTxIndex, lastProcessedTxIndex)
 	marshalledTxs, err := storer.GetBulkFromEpoch(executedTxHashes, header.GetEpoch())
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v, miniblock = %s", errCannotLoadTransactions, err, hex.EncodeToString(miniblockHash))
+		return nil, fmt.Errorf("%w: %w, miniblock = %s", errCannotLoadTransactions, err, hex.EncodeToString(miniblockHash))
 	}
 	logging.LogAPIActionDurationIfNeeded(start, "GetBulkFromEpoch")
 
@@ -11,6 +11,9 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 // ErrNilAPITransactionEvaluator signals that a nil api transaction evaluator was provided
 var ErrNilAPITransactionEvaluator = errors.New("nil api transaction evaluator")
 
+// ErrNilAPITransactionTracer signals that a nil api transaction tracer was provided
+var ErrNilAPITransactionTracer = errors.New("nil api transaction tracer")
+
 // ErrNilTotalStakedValueHandler signals that a nil total staked value handler has been provided
 var ErrNilTotalStakedValueHandler = errors.New("nil total staked value handler")
 
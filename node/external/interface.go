@@ -14,6 +14,7 @@ import (
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -24,6 +25,7 @@ type StatusMetricsHandler interface {
 	StatusMetricsMapWithoutP2P() (map[string]interface{}, error)
 	StatusP2pMetricsMap() (map[string]interface{}, error)
 	StatusMetricsWithoutP2PPrometheusString() (string, error)
+	StatusMetricsWithoutP2POpenMetricsString() (string, error)
 	EconomicsMetrics() (map[string]interface{}, error)
 	ConfigMetrics() (map[string]interface{}, error)
 	EnableEpochsMetrics() (map[string]interface{}, error)
@@ -40,6 +42,12 @@ type TransactionEvaluator interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionTracer defines the actions which should be handled by a transaction tracer
+type TransactionTracer interface {
+	TraceTransaction(txHash []byte) (*txSimData.TransactionTrace, error)
+	IsInterfaceNil() bool
+}
+
 // TotalStakedValueHandler defines the behavior of a component able to return total staked value
 type TotalStakedValueHandler interface {
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
@@ -61,10 +69,15 @@ type DelegatedListHandler interface {
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPool(subscriptionID uint64)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error)
+	GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
@@ -2,6 +2,7 @@ package logs
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -46,7 +47,7 @@ func (repository *logsRepository) getLog(logKey []byte, epoch uint32) (*transact
 func (repository *logsRepository) doGetLog(logKey []byte, epoch uint32) (*transaction.Log, error) {
 	bytes, err := repository.storer.GetFromEpoch(logKey, epoch)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v, epoch = %d, key = %s", errCannotLoadLogs, err, epoch, hex.EncodeToString(logKey))
+		return nil, fmt.Errorf("%w: %w, epoch = %d, key = %s", errCannotLoadLogs, err, epoch, hex.EncodeToString(logKey))
 	}
 
 	txLog := &transaction.Log{}
@@ -68,6 +69,10 @@ func (repository *logsRepository) getLogs(logsKeys [][]byte, epoch uint32) (map[
 
 	if epoch > 0 {
 		logsMapPreviousEpoch, err := repository.doGetLogs(logsKeys, epoch-1)
+		// the previous epoch can be outside the retention window while the requested one is not
+		if errors.Is(err, storage.ErrDataPruned) {
+			return logsMap, nil
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -85,7 +90,7 @@ func (repository *logsRepository) getLogs(logsKeys [][]byte, epoch uint32) (map[
 func (repository *logsRepository) doGetLogs(logsKeys [][]byte, epoch uint32) (map[string]*transaction.Log, error) {
 	keyValuePairs, err := repository.storer.GetBulkFromEpoch(logsKeys, epoch)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v, epoch = %d", errCannotLoadLogs, err, epoch)
+		return nil, fmt.Errorf("%w: %w, epoch = %d", errCannotLoadLogs, err, epoch)
 	}
 
 	results := make(map[string]*transaction.Log)
@@ -143,6 +143,31 @@ func TestLogsRepository_GetLogsShouldNotFallbackToPreviousEpochIfZero(t *testing
 	require.Error(t, err, "expected")
 }
 
+func TestLogsRepository_GetLogsShouldNotFallbackToPrunedPreviousEpoch(t *testing.T) {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	fooBytes, _ := marshaller.Marshal(&transaction.Log{Events: []*transaction.Event{{Identifier: []byte("foo")}}})
+	storageService := &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{
+				GetBulkFromEpochCalled: func(keys [][]byte, epoch uint32) ([]storageCore.KeyValuePair, error) {
+					if epoch == 42 {
+						return []storageCore.KeyValuePair{{Key: []byte{0xaa}, Value: fooBytes}}, nil
+					}
+
+					return nil, storage.ErrDataPruned
+				},
+			}, nil
+		},
+	}
+
+	// the entry of 0xbb is missing in epoch 42 and epoch 41 is outside the retention window
+	repository := newLogsRepository(storageService, marshaller)
+	logEntriesFetched, err := repository.getLogs([][]byte{{0xaa}, {0xbb}}, 42)
+	require.Nil(t, err)
+	require.Len(t, logEntriesFetched, 1)
+	require.Equal(t, []byte("foo"), logEntriesFetched[string([]byte{0xaa})].Events[0].Identifier)
+}
+
 func TestLogsRepository_GetLogsShouldErr(t *testing.T) {
 	epoch := uint32(7)
 
@@ -29,6 +29,7 @@ type ArgNodeApiResolver struct {
 	SCQueryService           SCQueryService
 	StatusMetricsHandler     StatusMetricsHandler
 	APITransactionEvaluator  TransactionEvaluator
+	APITransactionTracer     TransactionTracer
 	TotalStakedValueHandler  TotalStakedValueHandler
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
@@ -47,6 +48,7 @@ type nodeApiResolver struct {
 	scQueryService           SCQueryService
 	statusMetricsHandler     StatusMetricsHandler
 	apiTransactionEvaluator  TransactionEvaluator
+	apiTransactionTracer     TransactionTracer
 	totalStakedValueHandler  TotalStakedValueHandler
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
@@ -71,6 +73,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.APITransactionEvaluator) {
 		return nil, ErrNilAPITransactionEvaluator
 	}
+	if check.IfNil(arg.APITransactionTracer) {
+		return nil, ErrNilAPITransactionTracer
+	}
 	if check.IfNil(arg.TotalStakedValueHandler) {
 		return nil, ErrNilTotalStakedValueHandler
 	}
@@ -109,6 +114,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		scQueryService:           arg.SCQueryService,
 		statusMetricsHandler:     arg.StatusMetricsHandler,
 		apiTransactionEvaluator:  arg.APITransactionEvaluator,
+		apiTransactionTracer:     arg.APITransactionTracer,
 		totalStakedValueHandler:  arg.TotalStakedValueHandler,
 		directStakedListHandler:  arg.DirectStakedListHandler,
 		delegatedListHandler:     arg.DelegatedListHandler,
@@ -128,6 +134,11 @@ func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VM
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
+// ExecuteSCQueries retrieves data stored in SC accounts through a VM, all the queries being executed on the same block
+func (nar *nodeApiResolver) ExecuteSCQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	return nar.scQueryService.ExecuteQueries(queries)
+}
+
 // StatusMetrics returns an implementation of the StatusMetricsHandler interface
 func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 	return nar.statusMetricsHandler
@@ -143,6 +154,16 @@ func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transac
 	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
 }
 
+// TraceTransaction will replay the transaction with the given hash and return its execution trace
+func (nar *nodeApiResolver) TraceTransaction(hash string) (*txSimData.TransactionTrace, error) {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return nar.apiTransactionTracer.TraceTransaction(decodedHash)
+}
+
 // Close closes all underlying components
 func (nar *nodeApiResolver) Close() error {
 	return nar.scQueryService.Close()
@@ -169,8 +190,18 @@ func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*tran
 }
 
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
-func (nar *nodeApiResolver) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	return nar.apiTransactionHandler.GetTransactionsPool(fields)
+func (nar *nodeApiResolver) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPool(fields, filter)
+}
+
+// SubscribeTransactionsPool will create a new subscription to the changes of the transactions pool
+func (nar *nodeApiResolver) SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	return nar.apiTransactionHandler.SubscribeTransactionsPool(filter)
+}
+
+// UnsubscribeTransactionsPool will terminate the provided transactions pool subscription
+func (nar *nodeApiResolver) UnsubscribeTransactionsPool(subscriptionID uint64) {
+	nar.apiTransactionHandler.UnsubscribeTransactionsPool(subscriptionID)
 }
 
 // GetTransactionsPoolForSender will return a structure containing the transactions for sender that is to be returned on API calls
@@ -188,6 +219,21 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender, senderAccountNonce)
 }
 
+// GetTransactionsReplacedInPool will return the hashes of the pending transactions which would be replaced by the provided transaction
+func (nar *nodeApiResolver) GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+	return nar.apiTransactionHandler.GetTransactionsReplacedInPool(tx, txHash)
+}
+
+// GetTransactionsPoolPolicyCounters will return the number of transactions rejected or evicted by each rule of the transactions pool policy
+func (nar *nodeApiResolver) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolPolicyCounters()
+}
+
+// GetTransactionsForAddress will return the indexed transactions of an address, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsForAddress(address, from, size)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -32,6 +33,7 @@ func createMockArgs() external.ArgNodeApiResolver {
 		SCQueryService:           &mock.SCQueryServiceStub{},
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
 		APITransactionEvaluator:  &mock.TransactionCostEstimatorMock{},
+		APITransactionTracer:     &mock.TransactionTracerStub{},
 		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
@@ -79,6 +81,17 @@ func TestNewNodeApiResolver_NilTransactionCostEstimator(t *testing.T) {
 	assert.Equal(t, external.ErrNilAPITransactionEvaluator, err)
 }
 
+func TestNewNodeApiResolver_NilTransactionTracer(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.APITransactionTracer = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAPITransactionTracer, err)
+}
+
 func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	t.Parallel()
 
@@ -173,6 +186,25 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_ExecuteSCQueriesShouldCall(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	wasCalled := false
+	arg.SCQueryService = &mock.SCQueryServiceStub{
+		ExecuteQueriesCalled: func(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+			wasCalled = true
+			return make([]*process.SCQueryResult, len(queries)), nil, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	results, _, err := nar.ExecuteSCQueries([]*process.SCQuery{{}, {}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(results))
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T) {
 	t.Parallel()
 
@@ -360,6 +392,44 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionTracer = &mock.TransactionTracerStub{
+			TraceTransactionCalled: func(txHash []byte) (*txSimData.TransactionTrace, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		trace, err := nar.TraceTransaction("not a hex string")
+		require.NotNil(t, err)
+		require.Nil(t, trace)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedTrace := &txSimData.TransactionTrace{Hash: "0101"}
+		arg := createMockArgs()
+		arg.APITransactionTracer = &mock.TransactionTracerStub{
+			TraceTransactionCalled: func(txHash []byte) (*txSimData.TransactionTrace, error) {
+				require.Equal(t, []byte{1, 1}, txHash)
+				return providedTrace, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		trace, err := nar.TraceTransaction("0101")
+		require.Nil(t, err)
+		require.Equal(t, providedTrace, trace)
+	})
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -369,13 +439,13 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 		expectedErr := errors.New("expected error")
 		arg := createMockArgs()
 		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
 
 		nar, _ := external.NewNodeApiResolver(arg)
-		res, err := nar.GetTransactionsPool("")
+		res, err := nar.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.Nil(t, res)
 		require.Equal(t, expectedErr, err)
 	})
@@ -408,13 +478,13 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 		}
 		arg := createMockArgs()
 		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxsPool, nil
 			},
 		}
 
 		nar, _ := external.NewNodeApiResolver(arg)
-		res, err := nar.GetTransactionsPool("")
+		res, err := nar.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.NoError(t, err)
 		require.Equal(t, expectedTxsPool, res)
 	})
@@ -565,6 +635,96 @@ func TestNodeApiResolver_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_SubscribeAndUnsubscribeTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	expectedFilter := common.TransactionsPoolFilter{Receiver: "erd1contract"}
+	unsubscribedID := uint64(0)
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		SubscribeTransactionsPoolCalled: func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+			require.Equal(t, expectedFilter, filter)
+			return nil, expectedErr
+		},
+		UnsubscribeTransactionsPoolCalled: func(subscriptionID uint64) {
+			unsubscribedID = subscriptionID
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	subscription, err := nar.SubscribeTransactionsPool(expectedFilter)
+	require.Nil(t, subscription)
+	require.Equal(t, expectedErr, err)
+
+	nar.UnsubscribeTransactionsPool(3)
+	require.Equal(t, uint64(3), unsubscribedID)
+}
+
+func TestNodeApiResolver_GetTransactionsReplacedInPool(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.Transaction{Nonce: 42}
+	providedTxHash := []byte("hash")
+	expectedHashes := []string{"aabb"}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsReplacedInPoolCalled: func(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+			require.Equal(t, providedTx, tx)
+			require.Equal(t, providedTxHash, txHash)
+			return expectedHashes, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsReplacedInPool(providedTx, providedTxHash)
+	require.NoError(t, err)
+	require.Equal(t, expectedHashes, res)
+}
+
+func TestNodeApiResolver_GetTransactionsPoolPolicyCounters(t *testing.T) {
+	t.Parallel()
+
+	expectedCounters := &common.TransactionsPoolPolicyCountersApiResponse{
+		Counters: map[string]uint64{"denied-senders": 5},
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsPoolPolicyCountersCalled: func() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+			return expectedCounters, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsPoolPolicyCounters()
+	require.NoError(t, err)
+	require.Equal(t, expectedCounters, res)
+}
+
+func TestNodeApiResolver_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.AddressTransactionsAPIResponse{
+		Address: "alice",
+		Total:   1,
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsForAddressCalled: func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+			require.Equal(t, "alice", address)
+			require.Equal(t, uint64(1), from)
+			require.Equal(t, uint64(2), size)
+
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsForAddress("alice", 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -27,4 +27,5 @@ type ArgAPITransactionProcessor struct {
 	TxTypeHandler            process.TxTypeHandler
 	LogsFacade               LogsFacade
 	DataFieldParser          DataFieldParser
+	TxPoolChangesHub         TxPoolChangesHub
 }
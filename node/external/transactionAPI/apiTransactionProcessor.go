@@ -2,12 +2,14 @@ package transactionAPI
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	rewardTxData "github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
@@ -16,10 +18,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/changes"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -42,6 +46,7 @@ type apiTransactionProcessor struct {
 	transactionResultsProcessor *apiTransactionResultsProcessor
 	refundDetector              *refundDetector
 	gasUsedAndFeeProcessor      *gasUsedAndFeeProcessor
+	txPoolChangesHub            TxPoolChangesHub
 }
 
 // NewAPITransactionProcessor will create a new instance of apiTransactionProcessor
@@ -82,6 +87,7 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		transactionResultsProcessor: txResultsProc,
 		refundDetector:              refundDetectorInstance,
 		gasUsedAndFeeProcessor:      gasUsedAndFeeProc,
+		txPoolChangesHub:            args.TxPoolChangesHub,
 	}, nil
 }
 
@@ -158,23 +164,28 @@ func (atp *apiTransactionProcessor) populateComputedFieldIsRefund(tx *transactio
 	})
 }
 
-// GetTransactionsPool will return a structure containing the transactions pool fields that is to be returned on API calls
-func (atp *apiTransactionProcessor) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+// GetTransactionsPool will return a structure containing the fields of the transactions from pool matching the provided
+// filter, that is to be returned on API calls
+func (atp *apiTransactionProcessor) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+	txsFilter, err := changes.NewTransactionsFilter(filter, atp.addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	transactions := &common.TransactionsPoolAPIResponse{}
 	requestedFieldsHandler := newFieldsHandler(fields)
 
-	var err error
-	transactions.RegularTransactions, err = atp.getRegularTransactionsFromPool(requestedFieldsHandler)
+	transactions.RegularTransactions, err = atp.getRegularTransactionsFromPool(requestedFieldsHandler, txsFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions.Rewards, err = atp.getRewardTransactionsFromPool(requestedFieldsHandler)
+	transactions.Rewards, err = atp.getRewardTransactionsFromPool(requestedFieldsHandler, txsFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions.SmartContractResults = atp.getUnsignedTransactionsFromPool(requestedFieldsHandler)
+	transactions.SmartContractResults = atp.getUnsignedTransactionsFromPool(requestedFieldsHandler, txsFilter)
 
 	return transactions, nil
 }
@@ -243,6 +254,88 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender
 	}, nil
 }
 
+// GetTransactionsPoolPolicyCounters will return the number of transactions rejected or evicted by each rule of the transactions pool policy
+func (atp *apiTransactionProcessor) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	txPool, ok := atp.dataPool.Transactions().(txPoolPolicyCountersHandler)
+	if !ok {
+		return nil, ErrTxPoolPolicyNotAvailable
+	}
+
+	return &common.TransactionsPoolPolicyCountersApiResponse{
+		Counters: txPool.GetPolicyCounters(),
+	}, nil
+}
+
+// GetTransactionsReplacedInPool will return the hashes of the pending transactions which would be replaced by the provided
+// transaction, having the same sender and nonce and a higher gas price. Only the transactions sent from the own shard are replaced
+func (atp *apiTransactionProcessor) GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+	txPool, ok := atp.dataPool.Transactions().(txPoolReplacementHandler)
+	if !ok {
+		return make([]string, 0), nil
+	}
+
+	senderShard := atp.shardCoordinator.ComputeId(tx.SndAddr)
+	if senderShard != atp.shardCoordinator.SelfId() {
+		return make([]string, 0), nil
+	}
+
+	replacedTxsHashes, err := txPool.GetTxsReplacedBy(txHash, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hexHashes := make([]string, 0, len(replacedTxsHashes))
+	for _, replacedTxHash := range replacedTxsHashes {
+		hexHashes = append(hexHashes, hex.EncodeToString(replacedTxHash))
+	}
+
+	return hexHashes, nil
+}
+
+// SubscribeTransactionsPool creates a new subscription to the changes of the transactions pool matching the provided filter
+func (atp *apiTransactionProcessor) SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	return atp.txPoolChangesHub.Subscribe(filter)
+}
+
+// UnsubscribeTransactionsPool terminates the provided transactions pool subscription
+func (atp *apiTransactionProcessor) UnsubscribeTransactionsPool(subscriptionID uint64) {
+	atp.txPoolChangesHub.Unsubscribe(subscriptionID)
+}
+
+// GetTransactionsForAddress will return the indexed transactions of an address, newest first, that is to be returned on API calls
+func (atp *apiTransactionProcessor) GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	addressBytes, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	addressTransactions, total, err := atp.historyRepository.GetTransactionsByAddress(addressBytes, from, size)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCannotRetrieveTransactions.Error(), err)
+	}
+
+	response := &common.AddressTransactionsAPIResponse{
+		Address:      address,
+		Total:        total,
+		Transactions: make([]common.AddressTransactionAPIResponse, 0, len(addressTransactions)),
+	}
+	for _, addressTx := range addressTransactions {
+		response.Transactions = append(response.Transactions, common.AddressTransactionAPIResponse{
+			Hash:          hex.EncodeToString(addressTx.TxHash),
+			MiniBlockType: block.Type(addressTx.MiniblockType).String(),
+			IsSender:      addressTx.IsSender,
+			IsReceiver:    addressTx.IsReceiver,
+			Epoch:         addressTx.Epoch,
+			Round:         addressTx.Round,
+			BlockNonce:    addressTx.HeaderNonce,
+			BlockHash:     hex.EncodeToString(addressTx.HeaderHash),
+			Timestamp:     int64(addressTx.Timestamp),
+		})
+	}
+
+	return response, nil
+}
+
 func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interface{}, txType transaction.TxType, txHash []byte, requestedFieldsHandler fieldsHandler) common.Transaction {
 	txResult := atp.getApiResultFromObj(txObj, txType)
 
@@ -256,57 +349,66 @@ func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interfac
 	return requestedTxInfo
 }
 
-func (atp *apiTransactionProcessor) getRegularTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
+func (atp *apiTransactionProcessor) getRegularTransactionsFromPool(requestedFieldsHandler fieldsHandler, txsFilter *changes.TransactionsFilter) ([]common.Transaction, error) {
 	regularTxKeys := atp.dataPool.Transactions().Keys()
-	regularTxs := make([]common.Transaction, len(regularTxKeys))
-	for idx, key := range regularTxKeys {
+	regularTxs := make([]common.Transaction, 0, len(regularTxKeys))
+	for _, key := range regularTxKeys {
 		txObj, found := atp.getRegularTxObjFromDataPool(key)
-		if !found {
+		if !found || !isTxObjMatching(txObj, txsFilter) {
 			continue
 		}
 
 		tx := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeNormal, key, requestedFieldsHandler)
 
-		regularTxs[idx] = tx
+		regularTxs = append(regularTxs, tx)
 	}
 
 	return regularTxs, nil
 }
 
-func (atp *apiTransactionProcessor) getRewardTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
+func (atp *apiTransactionProcessor) getRewardTransactionsFromPool(requestedFieldsHandler fieldsHandler, txsFilter *changes.TransactionsFilter) ([]common.Transaction, error) {
 	rewardTxKeys := atp.dataPool.RewardTransactions().Keys()
-	rewardTxs := make([]common.Transaction, len(rewardTxKeys))
-	for idx, key := range rewardTxKeys {
+	rewardTxs := make([]common.Transaction, 0, len(rewardTxKeys))
+	for _, key := range rewardTxKeys {
 		txObj, found := atp.getRewardTxObjFromDataPool(key)
-		if !found {
+		if !found || !isTxObjMatching(txObj, txsFilter) {
 			continue
 		}
 
 		tx := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeReward, key, requestedFieldsHandler)
 
-		rewardTxs[idx] = tx
+		rewardTxs = append(rewardTxs, tx)
 	}
 
 	return rewardTxs, nil
 }
 
-func (atp *apiTransactionProcessor) getUnsignedTransactionsFromPool(requestedFieldsHandler fieldsHandler) []common.Transaction {
+func (atp *apiTransactionProcessor) getUnsignedTransactionsFromPool(requestedFieldsHandler fieldsHandler, txsFilter *changes.TransactionsFilter) []common.Transaction {
 	unsignedTxKeys := atp.dataPool.UnsignedTransactions().Keys()
-	unsignedTxs := make([]common.Transaction, len(unsignedTxKeys))
-	for idx, key := range unsignedTxKeys {
+	unsignedTxs := make([]common.Transaction, 0, len(unsignedTxKeys))
+	for _, key := range unsignedTxKeys {
 		txObj, found := atp.getUnsignedTxObjFromDataPool(key)
-		if !found {
+		if !found || !isTxObjMatching(txObj, txsFilter) {
 			continue
 		}
 
 		tx := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeUnsigned, key, requestedFieldsHandler)
 
-		unsignedTxs[idx] = tx
+		unsignedTxs = append(unsignedTxs, tx)
 	}
 
 	return unsignedTxs
 }
 
+func isTxObjMatching(txObj interface{}, txsFilter *changes.TransactionsFilter) bool {
+	txHandler, ok := txObj.(data.TransactionHandler)
+	if !ok {
+		return false
+	}
+
+	return txsFilter.Matches(txHandler)
+}
+
 func (atp *apiTransactionProcessor) extractRequestedTxInfo(wrappedTx *txcache.WrappedTransaction, requestedFieldsHandler fieldsHandler) common.Transaction {
 	tx := common.Transaction{
 		TxFields: make(map[string]interface{}),
@@ -452,12 +554,18 @@ func (atp *apiTransactionProcessor) computeTimestampForRound(round uint64) int64
 
 func (atp *apiTransactionProcessor) lookupHistoricalTransaction(hash []byte, withResults bool) (*transaction.ApiTransactionResult, error) {
 	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if errors.Is(err, storage.ErrDataPruned) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
 	}
 
-	txBytes, txType, found := atp.getTxBytesFromStorageByEpoch(hash, miniblockMetadata.Epoch)
-	if !found {
+	txBytes, txType, err := atp.getTxBytesFromStorageByEpoch(hash, miniblockMetadata.Epoch)
+	if errors.Is(err, storage.ErrDataPruned) {
+		return nil, err
+	}
+	if err != nil {
 		log.Warn("lookupHistoricalTransaction(): unexpected condition, cannot find transaction in storage")
 		return nil, ErrCannotRetrieveTransaction
 	}
@@ -618,39 +726,52 @@ func (atp *apiTransactionProcessor) getTxBytesFromStorage(hash []byte) ([]byte,
 	return nil, transaction.TxTypeInvalid, false
 }
 
-func (atp *apiTransactionProcessor) getTxBytesFromStorageByEpoch(hash []byte, epoch uint32) ([]byte, transaction.TxType, bool) {
+// getTxBytesFromStorageByEpoch returns the pruned data error if the transaction was not found and the epoch is outside
+// the retention window of any of the searched storers
+func (atp *apiTransactionProcessor) getTxBytesFromStorageByEpoch(hash []byte, epoch uint32) ([]byte, transaction.TxType, error) {
 	store := atp.storageService
 	txsStorer, err := store.GetStorer(dataRetriever.TransactionUnit)
 	if err != nil {
-		return nil, transaction.TxTypeInvalid, false
+		return nil, transaction.TxTypeInvalid, err
 	}
 
-	txBytes, err := txsStorer.GetFromEpoch(hash, epoch)
-	if err == nil {
-		return txBytes, transaction.TxTypeNormal, true
+	txBytes, errGet := txsStorer.GetFromEpoch(hash, epoch)
+	if errGet == nil {
+		return txBytes, transaction.TxTypeNormal, nil
 	}
+	errNotFound := getNotFoundInEpochError(errGet, ErrTransactionNotFound)
 
 	rewardTxsStorer, err := store.GetStorer(dataRetriever.RewardTransactionUnit)
 	if err != nil {
-		return nil, transaction.TxTypeInvalid, false
+		return nil, transaction.TxTypeInvalid, err
 	}
 
-	txBytes, err = rewardTxsStorer.GetFromEpoch(hash, epoch)
-	if err == nil {
-		return txBytes, transaction.TxTypeReward, true
+	txBytes, errGet = rewardTxsStorer.GetFromEpoch(hash, epoch)
+	if errGet == nil {
+		return txBytes, transaction.TxTypeReward, nil
 	}
+	errNotFound = getNotFoundInEpochError(errGet, errNotFound)
 
 	unsignedTxsStorer, err := store.GetStorer(dataRetriever.UnsignedTransactionUnit)
 	if err != nil {
-		return nil, transaction.TxTypeInvalid, false
+		return nil, transaction.TxTypeInvalid, err
 	}
 
-	txBytes, err = unsignedTxsStorer.GetFromEpoch(hash, epoch)
-	if err == nil {
-		return txBytes, transaction.TxTypeUnsigned, true
+	txBytes, errGet = unsignedTxsStorer.GetFromEpoch(hash, epoch)
+	if errGet == nil {
+		return txBytes, transaction.TxTypeUnsigned, nil
 	}
+	errNotFound = getNotFoundInEpochError(errGet, errNotFound)
 
-	return nil, transaction.TxTypeInvalid, false
+	return nil, transaction.TxTypeInvalid, errNotFound
+}
+
+func getNotFoundInEpochError(errGet error, previousErr error) error {
+	if errors.Is(errGet, storage.ErrDataPruned) {
+		return errGet
+	}
+
+	return previousErr
 }
 
 func (atp *apiTransactionProcessor) castObjToTransaction(txObj interface{}, txType transaction.TxType) *transaction.ApiTransactionResult {
@@ -23,7 +23,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/changes"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	processMocks "github.com/multiversx/mx-chain-go/process/mock"
@@ -59,6 +61,7 @@ func createMockArgAPITransactionProcessor() *ArgAPITransactionProcessor {
 				return &datafield.ResponseParseData{}
 			},
 		},
+		TxPoolChangesHub: &txcachemocks.TxPoolChangesHubStub{},
 	}
 }
 
@@ -181,6 +184,16 @@ func TestNewAPITransactionProcessor(t *testing.T) {
 		_, err := NewAPITransactionProcessor(arguments)
 		require.Equal(t, ErrNilDataFieldParser, err)
 	})
+
+	t.Run("NilTxPoolChangesHub", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createMockArgAPITransactionProcessor()
+		arguments.TxPoolChangesHub = nil
+
+		_, err := NewAPITransactionProcessor(arguments)
+		require.Equal(t, ErrNilTxPoolChangesHub, err)
+	})
 }
 
 func TestNode_GetTransactionInvalidHashShouldErr(t *testing.T) {
@@ -459,6 +472,7 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 				return &datafield.ResponseParseData{}
 			},
 		},
+		TxPoolChangesHub: &txcachemocks.TxPoolChangesHubStub{},
 	}
 	apiTransactionProc, _ := NewAPITransactionProcessor(args)
 
@@ -633,6 +647,72 @@ func TestNode_lookupHistoricalTransaction(t *testing.T) {
 	require.Equal(t, transaction.TxStatusRewardReverted, actualH.Status)
 }
 
+func TestNode_lookupHistoricalTransactionOutsideRetention(t *testing.T) {
+	t.Parallel()
+
+	errPruned := fmt.Errorf("%w: epoch 3 in Transactions", storage.ErrDataPruned)
+	createStorageService := func(prunedUnit dataRetriever.UnitType) dataRetriever.StorageService {
+		return &storageStubs.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+				return &storageStubs.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						if unitType == prunedUnit {
+							return nil, errPruned
+						}
+						return nil, errors.New("not found")
+					},
+				}, nil
+			},
+		}
+	}
+	historyRepo := &dblookupextMock.HistoryRepositoryStub{
+		GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			return &dblookupext.MiniblockMetadata{Epoch: 3}, nil
+		},
+	}
+
+	t.Run("miniblock metadata outside retention should return the pruned error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return nil, errPruned
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		tx, err := atp.lookupHistoricalTransaction([]byte("hash"), false)
+		require.Nil(t, tx)
+		require.True(t, errors.Is(err, storage.ErrDataPruned))
+		require.False(t, strings.Contains(err.Error(), ErrTransactionNotFound.Error()))
+	})
+	t.Run("transaction outside retention should return the pruned error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = historyRepo
+		args.StorageService = createStorageService(dataRetriever.TransactionUnit)
+		atp, _ := NewAPITransactionProcessor(args)
+
+		tx, err := atp.lookupHistoricalTransaction([]byte("hash"), false)
+		require.Nil(t, tx)
+		require.Equal(t, errPruned, err)
+	})
+	t.Run("transaction not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = historyRepo
+		args.StorageService = createStorageService(dataRetriever.MetaBlockUnit)
+		atp, _ := NewAPITransactionProcessor(args)
+
+		tx, err := atp.lookupHistoricalTransaction([]byte("hash"), false)
+		require.Nil(t, tx)
+		require.Equal(t, ErrCannotRetrieveTransaction, err)
+	})
+}
+
 func TestNode_PutHistoryFieldsInTransaction(t *testing.T) {
 	tx := &transaction.ApiTransactionResult{}
 	metadata := &dblookupext.MiniblockMetadata{
@@ -708,7 +788,7 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, atp)
 
-	res, err := atp.GetTransactionsPool("")
+	res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{})
 	require.NoError(t, err)
 
 	regularTxs := []common.Transaction{
@@ -744,6 +824,94 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.Equal(t, rewardTxs, res.Rewards)
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolWithFilter(t *testing.T) {
+	t.Parallel()
+
+	// the mocked shard coordinator only knows alice and bob, so bob plays the contract
+	contract := []byte("bob")
+	txs := map[string]*transaction.Transaction{
+		"claim": {SndAddr: []byte("alice"), RcvAddr: contract, Data: []byte("claim@01")},
+		"stake": {SndAddr: []byte("alice"), RcvAddr: contract, Data: []byte("stake")},
+		"move":  {SndAddr: []byte("bob"), RcvAddr: []byte("alice"), Data: []byte("claim@02")},
+	}
+	args := createMockArgAPITransactionProcessor()
+	args.AddressPubKeyConverter = testscommon.NewPubkeyConverterMock(32)
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				KeysCalled: func() [][]byte {
+					return [][]byte{[]byte("claim"), []byte("stake"), []byte("move")}
+				},
+				SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+					tx, found := txs[string(key)]
+					return tx, found
+				},
+			}
+		},
+		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{}
+		},
+		RewardTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{}
+		},
+	}
+	atp, _ := NewAPITransactionProcessor(args)
+
+	getHashes := func(res *common.TransactionsPoolAPIResponse) []string {
+		hashes := make([]string, 0, len(res.RegularTransactions))
+		for _, tx := range res.RegularTransactions {
+			hashes = append(hashes, tx.TxFields[hashField].(string))
+		}
+
+		return hashes
+	}
+
+	res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{Receiver: hex.EncodeToString(contract)})
+	require.NoError(t, err)
+	require.Equal(t, []string{hex.EncodeToString([]byte("claim")), hex.EncodeToString([]byte("stake"))}, getHashes(res))
+
+	res, err = atp.GetTransactionsPool("", common.TransactionsPoolFilter{DataPrefix: "claim@"})
+	require.NoError(t, err)
+	require.Equal(t, []string{hex.EncodeToString([]byte("claim")), hex.EncodeToString([]byte("move"))}, getHashes(res))
+
+	res, err = atp.GetTransactionsPool("", common.TransactionsPoolFilter{
+		Sender:     hex.EncodeToString([]byte("alice")),
+		Receiver:   hex.EncodeToString(contract),
+		DataPrefix: "claim@",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{hex.EncodeToString([]byte("claim"))}, getHashes(res))
+
+	res, err = atp.GetTransactionsPool("", common.TransactionsPoolFilter{Receiver: "not hex"})
+	require.Nil(t, res)
+	require.ErrorIs(t, err, changes.ErrInvalidAddress)
+}
+
+func TestApiTransactionProcessor_SubscribeAndUnsubscribeTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	expectedFilter := common.TransactionsPoolFilter{Receiver: "contract"}
+	unsubscribedID := uint64(0)
+	args := createMockArgAPITransactionProcessor()
+	args.TxPoolChangesHub = &txcachemocks.TxPoolChangesHubStub{
+		SubscribeCalled: func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+			require.Equal(t, expectedFilter, filter)
+			return nil, expectedErr
+		},
+		UnsubscribeCalled: func(subscriptionID uint64) {
+			unsubscribedID = subscriptionID
+		},
+	}
+	atp, _ := NewAPITransactionProcessor(args)
+
+	_, err := atp.SubscribeTransactionsPool(expectedFilter)
+	require.Equal(t, expectedErr, err)
+
+	atp.UnsubscribeTransactionsPool(7)
+	require.Equal(t, uint64(7), unsubscribedID)
+}
+
 func createTx(hash []byte, sender string, nonce uint64) *txcache.WrappedTransaction {
 	tx := &transaction.Transaction{
 		SndAddr: []byte(sender),
@@ -998,6 +1166,99 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionsForAddress(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsForAddress("alice", 0, 10)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return []byte(humanReadable), nil
+			},
+		}
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetTransactionsByAddressCalled: func(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+				return nil, 0, expectedErr
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsForAddress("alice", 0, 10)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return []byte(humanReadable), nil
+			},
+		}
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetTransactionsByAddressCalled: func(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+				require.Equal(t, []byte("alice"), address)
+				require.Equal(t, uint64(2), from)
+				require.Equal(t, uint64(10), size)
+
+				return []*addressTransactions.AddressTransaction{
+					{
+						TxHash:        []byte("txHash"),
+						MiniblockType: int32(block.SmartContractResultBlock),
+						IsReceiver:    true,
+						Epoch:         3,
+						Round:         40,
+						HeaderNonce:   39,
+						HeaderHash:    []byte("headerHash"),
+						Timestamp:     1234,
+					},
+				}, 5, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsForAddress("alice", 2, 10)
+		require.NoError(t, err)
+		require.Equal(t, &common.AddressTransactionsAPIResponse{
+			Address: "alice",
+			Total:   5,
+			Transactions: []common.AddressTransactionAPIResponse{
+				{
+					Hash:          hex.EncodeToString([]byte("txHash")),
+					MiniBlockType: block.SmartContractResultBlock.String(),
+					IsReceiver:    true,
+					Epoch:         3,
+					Round:         40,
+					BlockNonce:    39,
+					BlockHash:     hex.EncodeToString([]byte("headerHash")),
+					Timestamp:     1234,
+				},
+			},
+		}, res)
+	})
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -1027,6 +1288,7 @@ func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool)
 		TxTypeHandler:            &testscommon.TxTypeHandlerMock{},
 		LogsFacade:               &testscommon.LogsFacadeStub{},
 		DataFieldParser:          dataFieldParser,
+		TxPoolChangesHub:         &txcachemocks.TxPoolChangesHubStub{},
 	}
 	apiTransactionProc, err := NewAPITransactionProcessor(args)
 	require.Nil(t, err)
@@ -1247,3 +1509,141 @@ func TestApiTransactionProcessor_PopulateComputedFields(t *testing.T) {
 	require.Equal(t, "SCDeployment", apiTx.ProcessingTypeOnDestination)
 	require.Equal(t, "1000", apiTx.InitiallyPaidFee)
 }
+
+type shardedDataWithPolicyCountersStub struct {
+	testscommon.ShardedDataStub
+	counters map[string]uint64
+}
+
+func (stub *shardedDataWithPolicyCountersStub) GetPolicyCounters() map[string]uint64 {
+	return stub.counters
+}
+
+type shardedDataWithReplacementStub struct {
+	testscommon.ShardedDataStub
+	getTxsReplacedByCalled func(txHash []byte, tx data.TransactionHandler) ([][]byte, error)
+}
+
+func (stub *shardedDataWithReplacementStub) GetTxsReplacedBy(txHash []byte, tx data.TransactionHandler) ([][]byte, error) {
+	return stub.getTxsReplacedByCalled(txHash, tx)
+}
+
+func TestApiTransactionProcessor_GetTransactionsReplacedInPool(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{SndAddr: []byte("alice"), Nonce: 42, GasPrice: 2000}
+	txHash := []byte("hash")
+
+	t.Run("pool without replacement should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsReplacedInPool(tx, txHash)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("sender in another shard should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		crossShardTx := &transaction.Transaction{SndAddr: []byte("bob"), Nonce: 42, GasPrice: 2000}
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &shardedDataWithReplacementStub{
+					getTxsReplacedByCalled: func(txHash []byte, tx data.TransactionHandler) ([][]byte, error) {
+						require.Fail(t, "should have not been called")
+						return nil, nil
+					},
+				}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsReplacedInPool(crossShardTx, txHash)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("underpriced replacement should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &shardedDataWithReplacementStub{
+					getTxsReplacedByCalled: func(txHash []byte, tx data.TransactionHandler) ([][]byte, error) {
+						return nil, txcache.ErrTxReplacementUnderpriced
+					},
+				}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsReplacedInPool(tx, txHash)
+		require.Nil(t, res)
+		require.Equal(t, txcache.ErrTxReplacementUnderpriced, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &shardedDataWithReplacementStub{
+					getTxsReplacedByCalled: func(providedTxHash []byte, providedTx data.TransactionHandler) ([][]byte, error) {
+						require.Equal(t, txHash, providedTxHash)
+						require.Equal(t, tx, providedTx)
+						return [][]byte{[]byte("replaced")}, nil
+					},
+				}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsReplacedInPool(tx, txHash)
+		require.NoError(t, err)
+		require.Equal(t, []string{hex.EncodeToString([]byte("replaced"))}, res)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolPolicyCounters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pool without policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolPolicyCounters()
+		require.Nil(t, res)
+		require.Equal(t, ErrTxPoolPolicyNotAvailable, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		counters := map[string]uint64{"min-gas-price": 7}
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &shardedDataWithPolicyCountersStub{counters: counters}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolPolicyCounters()
+		require.NoError(t, err)
+		require.Equal(t, &common.TransactionsPoolPolicyCountersApiResponse{Counters: counters}, res)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/node/filters"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 type apiTransactionResultsProcessor struct {
@@ -55,7 +56,10 @@ func newAPITransactionResultProcessor(
 func (arp *apiTransactionResultsProcessor) putResultsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) error {
 	// TODO: Note that the following call produces an effect even if the function "putResultsInTransaction" results in an error.
 	// TODO: Refactor this package to use less functions with side-effects.
-	arp.loadLogsIntoTransaction(hash, tx, epoch)
+	err := arp.loadLogsIntoTransaction(hash, tx, epoch)
+	if err != nil {
+		return err
+	}
 
 	resultsHashes, err := arp.historyRepository.GetResultsHashesByTxHash(hash, epoch)
 	if err != nil {
@@ -76,7 +80,7 @@ func (arp *apiTransactionResultsProcessor) putResultsInTransaction(hash []byte,
 func (arp *apiTransactionResultsProcessor) putReceiptInTransaction(tx *transaction.ApiTransactionResult, receiptHash []byte, epoch uint32) error {
 	rec, err := arp.getReceiptFromStorage(receiptHash, epoch)
 	if err != nil {
-		return fmt.Errorf("%w: %v, hash = %s", errCannotLoadReceipts, err, hex.EncodeToString(receiptHash))
+		return fmt.Errorf("%w: %w, hash = %s", errCannotLoadReceipts, err, hex.EncodeToString(receiptHash))
 	}
 
 	tx.Receipt = rec
@@ -117,12 +121,15 @@ func (arp *apiTransactionResultsProcessor) putSmartContractResultsInTransactionB
 	for _, scrHash := range scrsHashes {
 		scr, err := arp.getScrFromStorage(scrHash, epoch)
 		if err != nil {
-			return fmt.Errorf("%w: %v, hash = %s", errCannotLoadContractResults, err, hex.EncodeToString(scrHash))
+			return fmt.Errorf("%w: %w, hash = %s", errCannotLoadContractResults, err, hex.EncodeToString(scrHash))
 		}
 
 		scrAPI := arp.adaptSmartContractResult(scrHash, scr)
 
-		arp.loadLogsIntoContractResults(scrHash, epoch, scrAPI)
+		err = arp.loadLogsIntoContractResults(scrHash, epoch, scrAPI)
+		if err != nil {
+			return err
+		}
 
 		tx.SmartContractResults = append(tx.SmartContractResults, scrAPI)
 	}
@@ -130,22 +137,36 @@ func (arp *apiTransactionResultsProcessor) putSmartContractResultsInTransactionB
 	return nil
 }
 
-func (arp *apiTransactionResultsProcessor) loadLogsIntoTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) {
+// loadLogsIntoTransaction loads the logs on a best-effort basis, the only returned error being the pruned data one, so
+// the caller does not receive a transaction silently missing its logs
+func (arp *apiTransactionResultsProcessor) loadLogsIntoTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32) error {
 	var err error
 
 	tx.Logs, err = arp.logsFacade.GetLog(hash, epoch)
 	if err != nil {
 		log.Trace("loadLogsIntoTransaction()", "hash", hash, "epoch", epoch, "err", err)
 	}
+
+	return getDataPrunedError(err)
 }
 
-func (arp *apiTransactionResultsProcessor) loadLogsIntoContractResults(scrHash []byte, epoch uint32, scr *transaction.ApiSmartContractResult) {
+func (arp *apiTransactionResultsProcessor) loadLogsIntoContractResults(scrHash []byte, epoch uint32, scr *transaction.ApiSmartContractResult) error {
 	var err error
 
 	scr.Logs, err = arp.logsFacade.GetLog(scrHash, epoch)
 	if err != nil {
 		log.Trace("loadLogsIntoContractResults()", "hash", scrHash, "epoch", epoch, "err", err)
 	}
+
+	return getDataPrunedError(err)
+}
+
+func getDataPrunedError(err error) error {
+	if errors.Is(err, storage.ErrDataPruned) {
+		return err
+	}
+
+	return nil
 }
 
 func (arp *apiTransactionResultsProcessor) getScrFromStorage(hash []byte, epoch uint32) (*smartContractResult.SmartContractResult, error) {
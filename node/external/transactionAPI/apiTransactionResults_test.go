@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -325,3 +326,90 @@ func TestPutLogsInTransaction(t *testing.T) {
 	require.Errorf(t, err, "local err")
 	require.Equal(t, logs, tx.Logs)
 }
+
+func TestApiTransactionProcessor_PutResultsInTransactionOutsideRetention(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	errPruned := fmt.Errorf("%w: epoch 3 in Receipts", storage.ErrDataPruned)
+	dataFieldParser := &testscommon.DataFieldParserStub{
+		ParseCalled: func(dataField []byte, sender, receiver []byte, _ uint32) *datafield.ResponseParseData {
+			return &datafield.ResponseParseData{}
+		},
+	}
+	prunedStorageService := &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					return nil, errPruned
+				},
+			}, nil
+		},
+	}
+	createResultsProcessor := func(
+		resultsHashes *dblookupext.ResultsHashesByTxHash,
+		logsFacade LogsFacade,
+	) *apiTransactionResultsProcessor {
+		historyRepo := &dbLookupExtMock.HistoryRepositoryStub{
+			GetEventsHashesByTxHashCalled: func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+				return resultsHashes, nil
+			},
+		}
+		marshaller := &mock.MarshalizerFake{}
+		pubKeyConverter := &testscommon.PubkeyConverterMock{}
+		shardCoordinator := mock.NewOneShardCoordinatorMock()
+		txUnmarshaller := newTransactionUnmarshaller(marshaller, pubKeyConverter, dataFieldParser, shardCoordinator)
+
+		return newAPITransactionResultProcessor(pubKeyConverter, historyRepo, prunedStorageService, marshaller, txUnmarshaller, logsFacade, shardCoordinator, dataFieldParser)
+	}
+
+	t.Run("pruned receipt should return the pruned error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createResultsProcessor(&dblookupext.ResultsHashesByTxHash{ReceiptsHash: []byte("receipt")}, &testscommon.LogsFacadeStub{})
+
+		err := n.putResultsInTransaction([]byte("txHash"), &transaction.ApiTransactionResult{}, epoch)
+		require.ErrorIs(t, err, storage.ErrDataPruned)
+		require.ErrorIs(t, err, errCannotLoadReceipts)
+	})
+	t.Run("pruned smart contract result should return the pruned error", func(t *testing.T) {
+		t.Parallel()
+
+		resultsHashes := &dblookupext.ResultsHashesByTxHash{
+			ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+				{Epoch: epoch, ScResultsHashes: [][]byte{[]byte("scr")}},
+			},
+		}
+		n := createResultsProcessor(resultsHashes, &testscommon.LogsFacadeStub{})
+
+		err := n.putResultsInTransaction([]byte("txHash"), &transaction.ApiTransactionResult{}, epoch)
+		require.ErrorIs(t, err, storage.ErrDataPruned)
+		require.ErrorIs(t, err, errCannotLoadContractResults)
+	})
+	t.Run("pruned logs should return the pruned error", func(t *testing.T) {
+		t.Parallel()
+
+		logsFacade := &testscommon.LogsFacadeStub{
+			GetLogCalled: func(txHash []byte, epoch uint32) (*transaction.ApiLogs, error) {
+				return nil, errPruned
+			},
+		}
+		n := createResultsProcessor(&dblookupext.ResultsHashesByTxHash{}, logsFacade)
+
+		err := n.putResultsInTransaction([]byte("txHash"), &transaction.ApiTransactionResult{}, epoch)
+		require.ErrorIs(t, err, storage.ErrDataPruned)
+	})
+	t.Run("missing logs should not error", func(t *testing.T) {
+		t.Parallel()
+
+		logsFacade := &testscommon.LogsFacadeStub{
+			GetLogCalled: func(txHash []byte, epoch uint32) (*transaction.ApiLogs, error) {
+				return nil, errors.New("not found")
+			},
+		}
+		n := createResultsProcessor(&dblookupext.ResultsHashesByTxHash{}, logsFacade)
+
+		err := n.putResultsInTransaction([]byte("txHash"), &transaction.ApiTransactionResult{}, epoch)
+		require.Nil(t, err)
+	})
+}
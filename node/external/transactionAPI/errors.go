@@ -26,6 +26,12 @@ var errCannotLoadContractResults = errors.New("cannot load contract result(s)")
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
 
+// ErrNilTxPoolChangesHub signals that a nil transactions pool changes hub has been provided
+var ErrNilTxPoolChangesHub = errors.New("nil transactions pool changes hub")
+
+// ErrTxPoolPolicyNotAvailable signals that the transactions pool does not expose the counters of its policy
+var ErrTxPoolPolicyNotAvailable = errors.New("transactions pool policy not available")
+
 // ErrCannotRetrieveTransactions signals that transactions cannot be retrieved
 var ErrCannotRetrieveTransactions = errors.New("transactions cannot be retrieved")
 
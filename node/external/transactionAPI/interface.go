@@ -3,7 +3,9 @@ package transactionAPI
 import (
 	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
@@ -20,6 +22,21 @@ type FeesProcessorHandler interface {
 	IsInterfaceNil() bool
 }
 
+type txPoolPolicyCountersHandler interface {
+	GetPolicyCounters() map[string]uint64
+}
+
+type txPoolReplacementHandler interface {
+	GetTxsReplacedBy(txHash []byte, tx data.TransactionHandler) ([][]byte, error)
+}
+
+// TxPoolChangesHub defines the component streaming the changes of the transactions pool to the API subscribers
+type TxPoolChangesHub interface {
+	Subscribe(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	Unsubscribe(subscriptionID uint64)
+	IsInterfaceNil() bool
+}
+
 // LogsFacade defines the interface of a logs facade
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
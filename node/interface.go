@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/update"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -62,3 +63,22 @@ type accountHandlerWithDataTrieMigrationStatus interface {
 	vmcommon.AccountHandler
 	IsDataTrieMigrated() (bool, error)
 }
+
+type trieMultiProofBuilder interface {
+	AddProof(proof [][]byte)
+	Nodes() [][]byte
+}
+
+type configReloaderHandler interface {
+	RegisterHandler(handler common.ConfigReloadHandler) error
+	Reload(source string) ([]common.ConfigChange, error)
+	StartListeningForSIGHUP()
+	Close() error
+	IsInterfaceNil() bool
+}
+
+type redundancyLeaseHandler interface {
+	Promote() common.RedundancyLeaseStatus
+	Demote() common.RedundancyLeaseStatus
+	Status() common.RedundancyLeaseStatus
+}
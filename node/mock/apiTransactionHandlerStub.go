@@ -8,10 +8,15 @@ import (
 // TransactionAPIHandlerStub -
 type TransactionAPIHandlerStub struct {
 	GetTransactionCalled                        func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolCalled                   func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
+	SubscribeTransactionsPoolCalled             func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeTransactionsPoolCalled           func(subscriptionID uint64)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolPolicyCountersCalled     func() (*common.TransactionsPoolPolicyCountersApiResponse, error)
+	GetTransactionsReplacedInPoolCalled         func(tx *transaction.Transaction, txHash []byte) ([]string, error)
+	GetTransactionsForAddressCalled             func(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -27,14 +32,30 @@ func (tas *TransactionAPIHandlerStub) GetTransaction(hash string, withResults bo
 }
 
 // GetTransactionsPool -
-func (tas *TransactionAPIHandlerStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (tas *TransactionAPIHandlerStub) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	if tas.GetTransactionsPoolCalled != nil {
-		return tas.GetTransactionsPoolCalled(fields)
+		return tas.GetTransactionsPoolCalled(fields, filter)
 	}
 
 	return nil, nil
 }
 
+// SubscribeTransactionsPool -
+func (tas *TransactionAPIHandlerStub) SubscribeTransactionsPool(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	if tas.SubscribeTransactionsPoolCalled != nil {
+		return tas.SubscribeTransactionsPoolCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// UnsubscribeTransactionsPool -
+func (tas *TransactionAPIHandlerStub) UnsubscribeTransactionsPool(subscriptionID uint64) {
+	if tas.UnsubscribeTransactionsPoolCalled != nil {
+		tas.UnsubscribeTransactionsPoolCalled(subscriptionID)
+	}
+}
+
 // GetTransactionsPoolForSender -
 func (tas *TransactionAPIHandlerStub) GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	if tas.GetTransactionsPoolForSenderCalled != nil {
@@ -62,6 +83,33 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionsReplacedInPool -
+func (tas *TransactionAPIHandlerStub) GetTransactionsReplacedInPool(tx *transaction.Transaction, txHash []byte) ([]string, error) {
+	if tas.GetTransactionsReplacedInPoolCalled != nil {
+		return tas.GetTransactionsReplacedInPoolCalled(tx, txHash)
+	}
+
+	return make([]string, 0), nil
+}
+
+// GetTransactionsPoolPolicyCounters -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolPolicyCounters() (*common.TransactionsPoolPolicyCountersApiResponse, error) {
+	if tas.GetTransactionsPoolPolicyCountersCalled != nil {
+		return tas.GetTransactionsPoolPolicyCountersCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsForAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsForAddress(address string, from uint64, size uint64) (*common.AddressTransactionsAPIResponse, error) {
+	if tas.GetTransactionsForAddressCalled != nil {
+		return tas.GetTransactionsForAddressCalled(address, from, size)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
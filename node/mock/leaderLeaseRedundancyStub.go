@@ -0,0 +1,40 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// LeaderLeaseRedundancyStub -
+type LeaderLeaseRedundancyStub struct {
+	NodeRedundancyHandlerStub
+	PromoteCalled func() common.RedundancyLeaseStatus
+	DemoteCalled  func() common.RedundancyLeaseStatus
+	StatusCalled  func() common.RedundancyLeaseStatus
+}
+
+// Promote -
+func (stub *LeaderLeaseRedundancyStub) Promote() common.RedundancyLeaseStatus {
+	if stub.PromoteCalled != nil {
+		return stub.PromoteCalled()
+	}
+	return common.RedundancyLeaseStatus{}
+}
+
+// Demote -
+func (stub *LeaderLeaseRedundancyStub) Demote() common.RedundancyLeaseStatus {
+	if stub.DemoteCalled != nil {
+		return stub.DemoteCalled()
+	}
+	return common.RedundancyLeaseStatus{}
+}
+
+// Status -
+func (stub *LeaderLeaseRedundancyStub) Status() common.RedundancyLeaseStatus {
+	if stub.StatusCalled != nil {
+		return stub.StatusCalled()
+	}
+	return common.RedundancyLeaseStatus{}
+}
+
+// IsInterfaceNil -
+func (stub *LeaderLeaseRedundancyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
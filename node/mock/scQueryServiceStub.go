@@ -10,6 +10,7 @@ import (
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
 	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueriesCalled         func([]*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
@@ -19,6 +20,15 @@ func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vm
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
+// ExecuteQueries -
+func (serviceStub *SCQueryServiceStub) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if serviceStub.ExecuteQueriesCalled != nil {
+		return serviceStub.ExecuteQueriesCalled(queries)
+	}
+
+	return nil, nil, nil
+}
+
 // ComputeScCallGasLimit -
 func (serviceStub *SCQueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	return serviceStub.ComputeScCallGasLimitHandler(tx)
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+)
+
+// TransactionTracerStub -
+type TransactionTracerStub struct {
+	TraceTransactionCalled func(txHash []byte) (*txSimData.TransactionTrace, error)
+}
+
+// TraceTransaction -
+func (stub *TransactionTracerStub) TraceTransaction(txHash []byte) (*txSimData.TransactionTrace, error) {
+	if stub.TraceTransactionCalled != nil {
+		return stub.TraceTransactionCalled(txHash)
+	}
+
+	return &txSimData.TransactionTrace{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
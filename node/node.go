@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
@@ -31,6 +32,7 @@ import (
 	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/disabled"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/dataValidators"
@@ -100,6 +102,7 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+	isStorageReportInProgress atomic.Flag
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -1106,6 +1109,41 @@ func (n *Node) GetConnectedPeersRatingsOnMainNetwork() (string, error) {
 	return n.networkComponents.PeersRatingMonitor().GetConnectedPeersRatings(n.networkComponents.NetworkMessenger())
 }
 
+// GetPeersReputation returns the persisted reputation of the known peer IDs, IP addresses and public keys
+func (n *Node) GetPeersReputation() ([]*common.PeerReputation, error) {
+	return n.networkComponents.PeerReputationHandler().GetPeersReputation(), nil
+}
+
+// BanPeer manually bans the provided peer ID for the provided duration
+func (n *Node) BanPeer(pid string, duration time.Duration, reason string) error {
+	peerID, err := core.NewPeerID(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s", err, pid)
+	}
+
+	return n.networkComponents.PeerReputationHandler().BanPeer(peerID, duration, reason)
+}
+
+// UnbanPeer removes the ban of the provided peer ID
+func (n *Node) UnbanPeer(pid string) error {
+	peerID, err := core.NewPeerID(pid)
+	if err != nil {
+		return fmt.Errorf("%w for provided peer %s", err, pid)
+	}
+
+	return n.networkComponents.PeerReputationHandler().UnbanPeer(peerID)
+}
+
+// BanIP manually bans the provided IP address for the provided duration
+func (n *Node) BanIP(ip string, duration time.Duration, reason string) error {
+	return n.networkComponents.PeerReputationHandler().BanIP(ip, duration, reason)
+}
+
+// UnbanIP removes the ban of the provided IP address
+func (n *Node) UnbanIP(ip string) error {
+	return n.networkComponents.PeerReputationHandler().UnbanIP(ip)
+}
+
 // GetEpochStartDataAPI returns epoch start data of a given epoch
 func (n *Node) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	if epoch == 0 {
@@ -1459,6 +1497,74 @@ func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	return hex.DecodeString(key)
 }
 
+// GetRedundancyLeaseStatus returns the state of the current node in the redundancy leader lease protocol
+func (n *Node) GetRedundancyLeaseStatus() (common.RedundancyLeaseStatus, error) {
+	leaseHandler, err := n.getRedundancyLeaseHandler()
+	if err != nil {
+		return common.RedundancyLeaseStatus{}, err
+	}
+
+	return leaseHandler.Status(), nil
+}
+
+// PromoteRedundancyLease forces the current node to take over the redundancy lease
+func (n *Node) PromoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	leaseHandler, err := n.getRedundancyLeaseHandler()
+	if err != nil {
+		return common.RedundancyLeaseStatus{}, err
+	}
+
+	return leaseHandler.Promote(), nil
+}
+
+// DemoteRedundancyLease forces the current node to release the redundancy lease and stop taking it over
+func (n *Node) DemoteRedundancyLease() (common.RedundancyLeaseStatus, error) {
+	leaseHandler, err := n.getRedundancyLeaseHandler()
+	if err != nil {
+		return common.RedundancyLeaseStatus{}, err
+	}
+
+	return leaseHandler.Demote(), nil
+}
+
+func (n *Node) getRedundancyLeaseHandler() (redundancyLeaseHandler, error) {
+	leaseHandler, ok := n.processComponents.NodeRedundancyHandler().(redundancyLeaseHandler)
+	if !ok {
+		return nil, ErrRedundancyLeaseNotEnabled
+	}
+
+	return leaseHandler, nil
+}
+
+// GetOutportDriversCursors returns the last sequence number acknowledged by each driver of the journaled outport
+func (n *Node) GetOutportDriversCursors() (map[string]uint64, error) {
+	replayHandler, err := n.getOutportJournalReplayHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	return replayHandler.GetDriversCursors(), nil
+}
+
+// ReplayOutport delivers again to the provided driver the journal entries starting with the provided sequence number
+func (n *Node) ReplayOutport(driverID string, sequence uint64) error {
+	replayHandler, err := n.getOutportJournalReplayHandler()
+	if err != nil {
+		return err
+	}
+
+	return replayHandler.ReplayFrom(driverID, sequence)
+}
+
+func (n *Node) getOutportJournalReplayHandler() (outport.JournalReplayHandler, error) {
+	replayHandler, ok := n.statusComponents.OutportHandler().(outport.JournalReplayHandler)
+	if !ok {
+		return nil, ErrOutportJournalNotEnabled
+	}
+
+	return replayHandler, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	return n == nil
@@ -63,30 +63,42 @@ func (n *Node) getOptionalEpochByHash(hash []byte) (core.OptionalUint32, error)
 }
 
 func (n *Node) getBlockHeaderInEpochByHash(headerHash []byte, epoch core.OptionalUint32) (data.HeaderHandler, error) {
+	headerBuffer, err := n.getBlockHeaderBufferInEpochByHash(headerHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
 	shardId := n.processComponents.ShardCoordinator().SelfId()
-	unitType := dataRetriever.GetHeadersDataUnit(shardId)
-	storer, err := n.dataComponents.StorageService().GetStorer(unitType)
+	header, err := process.UnmarshalHeader(shardId, n.coreComponents.InternalMarshalizer(), headerBuffer)
 	if err != nil {
 		return nil, err
 	}
 
-	var headerBuffer []byte
+	return header, nil
+}
 
-	if epoch.HasValue {
-		headerBuffer, err = storer.GetFromEpoch(headerHash, epoch.Value)
-	} else {
-		headerBuffer, err = storer.Get(headerHash)
-	}
+func (n *Node) getBlockHeaderBufferByHash(headerHash []byte) ([]byte, error) {
+	epoch, err := n.getOptionalEpochByHash(headerHash)
 	if err != nil {
 		return nil, err
 	}
 
-	header, err := process.UnmarshalHeader(shardId, n.coreComponents.InternalMarshalizer(), headerBuffer)
+	return n.getBlockHeaderBufferInEpochByHash(headerHash, epoch)
+}
+
+func (n *Node) getBlockHeaderBufferInEpochByHash(headerHash []byte, epoch core.OptionalUint32) ([]byte, error) {
+	shardId := n.processComponents.ShardCoordinator().SelfId()
+	unitType := dataRetriever.GetHeadersDataUnit(shardId)
+	storer, err := n.dataComponents.StorageService().GetStorer(unitType)
 	if err != nil {
 		return nil, err
 	}
 
-	return header, nil
+	if epoch.HasValue {
+		return storer.GetFromEpoch(headerHash, epoch.Value)
+	}
+
+	return storer.Get(headerHash)
 }
 
 func (n *Node) getBlockRootHash(headerHash []byte, header data.HeaderHandler) []byte {
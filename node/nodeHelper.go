@@ -10,7 +10,9 @@ import (
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/node/nodeDebugFactory"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
 	procFactory "github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/blackList"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
@@ -58,7 +60,7 @@ func CreateNode(
 ) (*Node, error) {
 	prepareOpenTopics(networkComponents.InputAntiFloodHandler(), processComponents.ShardCoordinator())
 
-	peerDenialEvaluator, err := createAndAttachPeerDenialEvaluators(networkComponents, processComponents)
+	peerDenialEvaluator, err := createAndAttachPeerDenialEvaluators(networkComponents, processComponents, config.PeerReputation.Enabled)
 	if err != nil {
 		return nil, err
 	}
@@ -126,11 +128,13 @@ func CreateNode(
 func createAndAttachPeerDenialEvaluators(
 	networkComponents factory.NetworkComponentsHandler,
 	processComponents factory.ProcessComponentsHandler,
+	isPeerReputationEnabled bool,
 ) (p2p.PeerDenialEvaluator, error) {
-	mainPeerDenialEvaluator, err := blackList.NewPeerDenialEvaluator(
-		networkComponents.PeerBlackListHandler(),
-		networkComponents.PubKeyCacher(),
+	mainPeerDenialEvaluator, err := createPeerDenialEvaluator(
+		networkComponents,
 		processComponents.PeerShardMapper(),
+		networkComponents.NetworkMessenger(),
+		isPeerReputationEnabled,
 	)
 	if err != nil {
 		return nil, err
@@ -141,10 +145,11 @@ func createAndAttachPeerDenialEvaluators(
 		return nil, err
 	}
 
-	fullArchivePeerDenialEvaluator, err := blackList.NewPeerDenialEvaluator(
-		networkComponents.PeerBlackListHandler(),
-		networkComponents.PubKeyCacher(),
+	fullArchivePeerDenialEvaluator, err := createPeerDenialEvaluator(
+		networkComponents,
 		processComponents.FullArchivePeerShardMapper(),
+		networkComponents.FullArchiveNetworkMessenger(),
+		isPeerReputationEnabled,
 	)
 	if err != nil {
 		return nil, err
@@ -157,3 +162,30 @@ func createAndAttachPeerDenialEvaluators(
 
 	return mainPeerDenialEvaluator, nil
 }
+
+// createPeerDenialEvaluator creates a peer denial evaluator that, when the peer reputation is enabled, also denies the
+// peers connected from the IP addresses banned through the peer reputation handler
+func createPeerDenialEvaluator(
+	networkComponents factory.NetworkComponentsHandler,
+	peerShardMapper process.PeerShardMapper,
+	messenger p2p.Messenger,
+	isPeerReputationEnabled bool,
+) (p2p.PeerDenialEvaluator, error) {
+	peerDenialEvaluator, err := blackList.NewPeerDenialEvaluator(
+		networkComponents.PeerBlackListHandler(),
+		networkComponents.PubKeyCacher(),
+		peerShardMapper,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !isPeerReputationEnabled {
+		return peerDenialEvaluator, nil
+	}
+
+	return peerReputation.NewIPDenialEvaluator(peerReputation.ArgsIPDenialEvaluator{
+		PeerDenialEvaluator:   peerDenialEvaluator,
+		PeerReputationHandler: networkComponents.PeerReputationHandler(),
+		PeerAddressesProvider: messenger,
+	})
+}
@@ -0,0 +1,229 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// GetMultiProof returns a single set of trie nodes proving all the requested accounts and data trie keys. The nodes
+// shared between the proofs are included only once. The proof is anchored to the block header committing to the root hash
+func (n *Node) GetMultiProof(request common.MultiProofRequest) (*common.MultiProofResponse, error) {
+	headerHash, headerBuffer, header, err := n.getStateAnchor(request.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := trie.NewMultiProofBuilder(n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash := header.GetRootHash()
+	accounts := make([]*common.MultiProofAccountResponse, 0, len(request.Accounts))
+	for _, accountRequest := range request.Accounts {
+		accountResponse, errProve := n.proveAccountForMultiProof(rootHash, accountRequest, builder)
+		if errProve != nil {
+			return nil, fmt.Errorf("%w for address %s", errProve, accountRequest.Address)
+		}
+
+		accounts = append(accounts, accountResponse)
+	}
+
+	return &common.MultiProofResponse{
+		HeaderHash: headerHash,
+		Header:     headerBuffer,
+		RootHash:   rootHash,
+		Nodes:      builder.Nodes(),
+		Accounts:   accounts,
+	}, nil
+}
+
+func (n *Node) getStateAnchor(blockHash string) ([]byte, []byte, data.HeaderHandler, error) {
+	headerHash, err := hex.DecodeString(blockHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(headerHash) == 0 {
+		headerHash = n.dataComponents.Blockchain().GetCurrentBlockHeaderHash()
+	}
+	if len(headerHash) == 0 {
+		return nil, nil, nil, process.ErrNilBlockHeader
+	}
+
+	headerBuffer, err := n.getBlockHeaderBufferByHash(headerHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	shardId := n.processComponents.ShardCoordinator().SelfId()
+	header, err := process.UnmarshalHeader(shardId, n.coreComponents.InternalMarshalizer(), headerBuffer)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return headerHash, headerBuffer, header, nil
+}
+
+func (n *Node) proveAccountForMultiProof(
+	rootHash []byte,
+	accountRequest common.MultiProofAccountRequest,
+	builder trieMultiProofBuilder,
+) (*common.MultiProofAccountResponse, error) {
+	addressBytes, err := n.getKeyBytes(accountRequest.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	mainProofResponse, err := n.getProof(rootHash, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddProof(mainProofResponse.Proof)
+
+	accountResponse := &common.MultiProofAccountResponse{
+		Address: accountRequest.Address,
+		Value:   mainProofResponse.Value,
+		Keys:    make([]*common.MultiProofKeyResponse, 0),
+	}
+
+	dataTrieKeys, err := getMultiProofDataTrieKeys(accountRequest)
+	if err != nil {
+		return nil, err
+	}
+	if len(dataTrieKeys) == 0 {
+		return accountResponse, nil
+	}
+
+	dataTrieRootHash, err := n.getAccountDataTrieRootHash(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, err
+	}
+	accountResponse.DataTrieRootHash = dataTrieRootHash
+
+	for _, key := range dataTrieKeys {
+		_, value, errGet := n.getAccountRootHashAndVal(addressBytes, mainProofResponse.Value, key)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		dataTrieKey := n.coreComponents.Hasher().Compute(string(key))
+		dataTrieProofResponse, errProof := n.getProof(dataTrieRootHash, dataTrieKey)
+		if errProof != nil {
+			dataTrieProofResponse, errProof = n.getProof(dataTrieRootHash, key)
+			if errProof != nil {
+				return nil, errProof
+			}
+		}
+		builder.AddProof(dataTrieProofResponse.Proof)
+
+		accountResponse.Keys = append(accountResponse.Keys, &common.MultiProofKeyResponse{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return accountResponse, nil
+}
+
+func getMultiProofDataTrieKeys(accountRequest common.MultiProofAccountRequest) ([][]byte, error) {
+	keys := make([][]byte, 0, len(accountRequest.Keys)+len(accountRequest.Tokens))
+	for _, hexKey := range accountRequest.Keys {
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	for _, tokenID := range accountRequest.Tokens {
+		keys = append(keys, []byte(core.ProtectedKeyPrefix+core.ESDTKeyIdentifier+tokenID))
+	}
+
+	return keys, nil
+}
+
+func (n *Node) getAccountDataTrieRootHash(address []byte, accBytes []byte) ([]byte, error) {
+	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("the address does not belong to a user account")
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return nil, fmt.Errorf("empty dataTrie rootHash")
+	}
+
+	return dataTrieRootHash, nil
+}
+
+// VerifyMultiProof verifies that the given set of trie nodes proves all the requested accounts and data trie keys
+// against the provided root hash
+func (n *Node) VerifyMultiProof(request common.VerifyMultiProofRequest) (bool, error) {
+	rootHash, err := hex.DecodeString(request.RootHash)
+	if err != nil {
+		return false, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, err
+	}
+
+	addresses := make([][]byte, 0, len(request.Accounts))
+	for _, accountRequest := range request.Accounts {
+		addressBytes, errDecode := n.getKeyBytes(accountRequest.Address)
+		if errDecode != nil {
+			return false, errDecode
+		}
+
+		addresses = append(addresses, addressBytes)
+	}
+
+	accountsValues, err := mpv.VerifyMultiProof(rootHash, addresses, request.Nodes)
+	if errors.Is(err, trie.ErrKeyNotProven) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for i, accountRequest := range request.Accounts {
+		dataTrieKeys, errKeys := getMultiProofDataTrieKeys(accountRequest)
+		if errKeys != nil {
+			return false, errKeys
+		}
+		if len(dataTrieKeys) == 0 {
+			continue
+		}
+
+		// the data trie root hash is taken from the proven account, so the data trie keys are bound to the main trie
+		dataTrieRootHash, errRootHash := n.getAccountDataTrieRootHash(addresses[i], accountsValues[i])
+		if errRootHash != nil {
+			return false, errRootHash
+		}
+
+		_, err = mpv.VerifyMultiProof(dataTrieRootHash, dataTrieKeys, request.Nodes)
+		if errors.Is(err, trie.ErrKeyNotProven) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,213 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	mockStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/hashesHolder"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	multiProofAddress = "0123"
+	multiProofToken   = "TKN-abcdef"
+)
+
+var multiProofDataKey = []byte("storage key")
+
+func createMultiProofTrie(t *testing.T, marshaller marshal.Marshalizer) common.Trie {
+	storageManager, err := trie.NewTrieStorageManager(trie.NewTrieStorageManagerArgs{
+		MainStorer:        testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer: testscommon.NewSnapshotPruningStorerMock(),
+		Marshalizer:       marshaller,
+		Hasher:            &testscommon.KeccakMock{},
+		GeneralConfig: config.TrieStorageManagerConfig{
+			PruningBufferLen:      1000,
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, testscommon.HashSize),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		Identifier:             dataRetriever.UserAccountsUnit.String(),
+	})
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(storageManager, marshaller, &testscommon.KeccakMock{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func createNodeForMultiProof(t *testing.T) (*node.Node, []byte) {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	address, _ := hex.DecodeString(multiProofAddress)
+	esdtKey := []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier + multiProofToken)
+
+	dataTrie := createMultiProofTrie(t, marshaller)
+	_ = dataTrie.Update(multiProofDataKey, []byte("storage value"))
+	_ = dataTrie.Update(esdtKey, []byte("esdt value"))
+	_ = dataTrie.Update([]byte("other key"), []byte("other value"))
+	_ = dataTrie.Commit()
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	mainTrie := createMultiProofTrie(t, marshaller)
+	_ = mainTrie.Update(address, []byte("account bytes"))
+	_ = mainTrie.Update([]byte("other address"), []byte("other account bytes"))
+	_ = mainTrie.Commit()
+	mainTrieRootHash, _ := mainTrie.RootHash()
+
+	headerHash := []byte("header hash")
+	headerBytes, _ := marshaller.Marshal(&block.Header{Nonce: 37, RootHash: mainTrieRootHash})
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshaller
+	coreComponents.Hash = &testscommon.KeccakMock{}
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			if string(rootHash) == string(dataTrieRootHash) {
+				return dataTrie, nil
+			}
+
+			return mainTrie, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			acc := &stateMock.AccountWrapMock{}
+			acc.SetTrackableDataTrie(&trieMock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+					value, _, err := dataTrie.Get(key)
+					return value, 0, err
+				},
+			})
+			acc.SetRootHash(dataTrieRootHash)
+			return acc, nil
+		},
+	}
+
+	dataComponents := getDefaultDataComponents()
+	storageService := dataComponents.StorageService().(*mockStorage.ChainStorerStub)
+	storageService.GetStorerCalled = func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+		require.Equal(t, dataRetriever.BlockHeaderUnit, unitType)
+		return &mockStorage.StorerStub{
+			GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+				require.Equal(t, headerHash, key)
+				return headerBytes, nil
+			},
+		}, nil
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(getDefaultProcessComponents()),
+	)
+	require.Nil(t, err)
+
+	return n, headerBytes
+}
+
+func TestNode_GetMultiProofAndVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	n, headerBytes := createNodeForMultiProof(t)
+	accountRequest := common.MultiProofAccountRequest{
+		Address: multiProofAddress,
+		Keys:    []string{hex.EncodeToString(multiProofDataKey)},
+		Tokens:  []string{multiProofToken},
+	}
+
+	response, err := n.GetMultiProof(common.MultiProofRequest{
+		BlockHash: hex.EncodeToString([]byte("header hash")),
+		Accounts:  []common.MultiProofAccountRequest{accountRequest},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []byte("header hash"), response.HeaderHash)
+	require.Equal(t, headerBytes, response.Header)
+	require.Len(t, response.Accounts, 1)
+	require.Equal(t, []byte("account bytes"), response.Accounts[0].Value)
+	require.Len(t, response.Accounts[0].Keys, 2)
+	require.Equal(t, []byte("storage value"), response.Accounts[0].Keys[0].Value)
+	require.Equal(t, []byte("esdt value"), response.Accounts[0].Keys[1].Value)
+
+	t.Run("should verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, errVerify := n.VerifyMultiProof(common.VerifyMultiProofRequest{
+			RootHash: hex.EncodeToString(response.RootHash),
+			Nodes:    response.Nodes,
+			Accounts: []common.MultiProofAccountRequest{accountRequest},
+		})
+		assert.Nil(t, errVerify)
+		assert.True(t, ok)
+	})
+	t.Run("key not in the proof should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, errVerify := n.VerifyMultiProof(common.VerifyMultiProofRequest{
+			RootHash: hex.EncodeToString(response.RootHash),
+			Nodes:    response.Nodes,
+			Accounts: []common.MultiProofAccountRequest{
+				{
+					Address: multiProofAddress,
+					Keys:    []string{hex.EncodeToString([]byte("other key"))},
+				},
+			},
+		})
+		assert.Nil(t, errVerify)
+		assert.False(t, ok)
+	})
+	t.Run("different root hash should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, errVerify := n.VerifyMultiProof(common.VerifyMultiProofRequest{
+			RootHash: hex.EncodeToString([]byte("root hash")),
+			Nodes:    response.Nodes,
+			Accounts: []common.MultiProofAccountRequest{accountRequest},
+		})
+		assert.Nil(t, errVerify)
+		assert.False(t, ok)
+	})
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		ok, errVerify := n.VerifyMultiProof(common.VerifyMultiProofRequest{RootHash: "invalid root hash"})
+		assert.NotNil(t, errVerify)
+		assert.False(t, ok)
+	})
+}
+
+func TestNode_GetMultiProofInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeForMultiProof(t)
+	response, err := n.GetMultiProof(common.MultiProofRequest{
+		BlockHash: hex.EncodeToString([]byte("header hash")),
+		Accounts: []common.MultiProofAccountRequest{
+			{
+				Address: multiProofAddress,
+				Keys:    []string{"invalid key"},
+			},
+		},
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), multiProofAddress)
+	assert.Nil(t, response)
+}
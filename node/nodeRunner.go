@@ -29,6 +29,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/ordering"
 	"github.com/multiversx/mx-chain-go/common/statistics"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/config/hotReload"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	dbLookupFactory "github.com/multiversx/mx-chain-go/dblookupext/factory"
@@ -308,6 +309,12 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	log.Debug("creating config reloader")
+	configReloader, err := nr.createConfigReloader(managedNetworkComponents, webServerHandler)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("creating bootstrap components")
 	managedBootstrapComponents, err := nr.CreateManagedBootstrapComponents(managedStatusCoreComponents, managedCoreComponents, managedCryptoComponents, managedNetworkComponents)
 	if err != nil {
@@ -516,11 +523,13 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	allowExternalVMQueriesChan := make(chan struct{})
 
 	log.Debug("updating the API service after creating the node facade")
-	facadeInstance, err := nr.createApiFacade(currentNode, webServerHandler, gasScheduleNotifier, allowExternalVMQueriesChan)
+	facadeInstance, err := nr.createApiFacade(currentNode, webServerHandler, configReloader, gasScheduleNotifier, allowExternalVMQueriesChan)
 	if err != nil {
 		return true, err
 	}
 
+	configReloader.StartListeningForSIGHUP()
+
 	log.Info("application is now running")
 
 	delayInSecBeforeAllowingVmQueries := configs.GeneralConfig.WebServerAntiflood.VmQueryDelayAfterStartInSec
@@ -547,6 +556,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		currentNode,
 		goRoutinesNumberStart,
 	)
+	log.LogIfError(configReloader.Close())
 
 	return nextOperation == nextOperationShouldStop, nil
 }
@@ -689,6 +699,7 @@ func getBaseAccountSyncerArgs(
 func (nr *nodeRunner) createApiFacade(
 	currentNode *Node,
 	upgradableHttpServer shared.UpgradeableHttpServerHandler,
+	configReloader facade.ConfigReloader,
 	gasScheduleNotifier common.GasScheduleNotifierAPI,
 	allowVMQueriesChan chan struct{},
 ) (closing.Closer, error) {
@@ -730,10 +741,12 @@ func (nr *nodeRunner) createApiFacade(
 			RestApiInterface: flagsConfig.RestApiInterface,
 			PprofEnabled:     flagsConfig.EnablePprof,
 		},
-		ApiRoutesConfig: *configs.ApiRoutesConfig,
-		AccountsState:   currentNode.stateComponents.AccountsAdapter(),
-		PeerState:       currentNode.stateComponents.PeerAccounts(),
-		Blockchain:      currentNode.dataComponents.Blockchain(),
+		ApiRoutesConfig:     *configs.ApiRoutesConfig,
+		AccountsState:       currentNode.stateComponents.AccountsAdapter(),
+		PeerState:           currentNode.stateComponents.PeerAccounts(),
+		Blockchain:          currentNode.dataComponents.Blockchain(),
+		EventsSubscriptions: currentNode.statusComponents.EventsSubscriptionHandler(),
+		ConfigReloader:      configReloader,
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -783,6 +796,31 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 	return httpServerWrapper, nil
 }
 
+func (nr *nodeRunner) createConfigReloader(
+	networkComponents mainFactory.NetworkComponentsHolder,
+	httpServer shared.UpgradeableHttpServerHandler,
+) (configReloaderHandler, error) {
+	configReloader, err := hotReload.NewConfigReloader(nr.configs)
+	if err != nil {
+		return nil, err
+	}
+
+	handlers := make([]common.ConfigReloadHandler, 0)
+	handlers = append(handlers, networkComponents.ConfigReloadHandlers()...)
+	handlers = append(handlers, httpServer)
+	// the log level handler is registered last as it can not restore an empty log level on rollback
+	handlers = append(handlers, hotReload.NewLogLevelReloadHandler())
+
+	for _, handler := range handlers {
+		err = configReloader.RegisterHandler(handler)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return configReloader, nil
+}
+
 func (nr *nodeRunner) createMetrics(
 	statusCoreComponents mainFactory.StatusCoreComponentsHolder,
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -1176,7 +1214,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 	}
 
 	historyRepoFactoryArgs := &dbLookupFactory.ArgsHistoryRepositoryFactory{
-		SelfShardID:              bootstrapComponents.ShardCoordinator().SelfId(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
 		Config:                   configs.GeneralConfig.DbLookupExtensions,
 		Hasher:                   coreComponents.Hasher(),
 		Marshalizer:              coreComponents.InternalMarshalizer(),
@@ -1404,6 +1442,7 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		NodeOperationMode:     common.NormalOperation,
 		ConnectionWatcherType: nr.configs.PreferencesConfig.Preferences.ConnectionWatcherType,
 		CryptoComponents:      cryptoComponents,
+		PathHandler:           coreComponents.PathHandler(),
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitTime = 0
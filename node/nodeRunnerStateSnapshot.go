@@ -0,0 +1,373 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/endProcess"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/epochStart/notifier"
+	mainFactory "github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	factoryState "github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/state/portableSnapshot"
+	disabledStoragePruning "github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/latestData"
+	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
+)
+
+// ArgsExportStateSnapshot holds the arguments needed to export a portable state snapshot
+type ArgsExportStateSnapshot struct {
+	OutputFile        string
+	MaxLeavesPerChunk int
+}
+
+// offlineState holds the components used to read the state from the database of a stopped node
+type offlineState struct {
+	coreComponents   mainFactory.CoreComponentsHandler
+	cryptoComponents mainFactory.CryptoComponentsHandler
+	storageService   dataRetriever.StorageService
+	shardCoordinator sharding.Coordinator
+	epoch            uint32
+}
+
+type stateSnapshotHeaders struct {
+	epochStartMetaHash []byte
+	epochStartMeta     []byte
+	shardHeaderHash    []byte
+	shardHeader        []byte
+	userRootHash       []byte
+	peerRootHash       []byte
+}
+
+// ExportStateSnapshot writes a portable state snapshot of the accounts tries committed at the latest epoch start found
+// in the node's database. The storers are opened directly, so the node must not be running while exporting
+func (nr *nodeRunner) ExportStateSnapshot(args ArgsExportStateSnapshot) error {
+	offline, err := nr.openOfflineState()
+	if err != nil {
+		return err
+	}
+	defer offline.close()
+
+	shardID := offline.shardCoordinator.SelfId()
+	headers, err := getStateSnapshotHeaders(offline.coreComponents, offline.storageService, shardID, offline.epoch)
+	if err != nil {
+		return err
+	}
+
+	triesToExport, err := nr.createTriesToExport(offline.coreComponents, offline.storageService, shardID, headers)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := portableSnapshot.NewExporter(portableSnapshot.ArgsExporter{
+		Marshaller:        offline.coreComponents.InternalMarshalizer(),
+		Hasher:            offline.coreComponents.Hasher(),
+		MaxLeavesPerChunk: args.MaxLeavesPerChunk,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(args.OutputFile)
+	if err != nil {
+		return err
+	}
+
+	log.Info("exporting the state snapshot",
+		"shard", shardID,
+		"epoch", offline.epoch,
+		"file", args.OutputFile,
+	)
+
+	trailer, err := exporter.Export(file, portableSnapshot.ArgsExport{
+		ChainID:            offline.coreComponents.ChainID(),
+		ShardID:            shardID,
+		Epoch:              offline.epoch,
+		EpochStartMetaHash: headers.epochStartMetaHash,
+		EpochStartMeta:     headers.epochStartMeta,
+		ShardHeaderHash:    headers.shardHeaderHash,
+		ShardHeader:        headers.shardHeader,
+		Tries:              triesToExport,
+	})
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Info("exported the state snapshot",
+		"file", args.OutputFile,
+		"num leaves", trailer.NumLeaves,
+		"num data tries", trailer.NumDataTries,
+		"num chunks", trailer.NumChunks,
+	)
+
+	return nil
+}
+
+// openOfflineState creates the components needed to read the state from the database of a stopped node, for the
+// shard and the epoch of the latest data found in the database
+func (nr *nodeRunner) openOfflineState() (*offlineState, error) {
+	chanStopNodeProcess := make(chan endProcess.ArgEndProcess, 1)
+	managedCoreComponents, err := nr.CreateManagedCoreComponents(chanStopNodeProcess)
+	if err != nil {
+		return nil, err
+	}
+
+	offline := &offlineState{
+		coreComponents: managedCoreComponents,
+	}
+	offline.cryptoComponents, err = nr.CreateManagedCryptoComponents(managedCoreComponents)
+	if err != nil {
+		offline.close()
+		return nil, err
+	}
+
+	latestStorageData, err := nr.getLatestStorageData(managedCoreComponents)
+	if err != nil {
+		offline.close()
+		return nil, fmt.Errorf("%w while reading the latest data from the node's database", err)
+	}
+	offline.epoch = latestStorageData.Epoch
+
+	numOfShards := managedCoreComponents.GenesisNodesSetup().NumberOfShards()
+	offline.shardCoordinator, err = sharding.NewMultiShardCoordinator(numOfShards, latestStorageData.ShardID)
+	if err != nil {
+		offline.close()
+		return nil, err
+	}
+
+	offline.storageService, err = nr.createOfflineStorageService(managedCoreComponents, offline.cryptoComponents, offline.shardCoordinator, offline.epoch)
+	if err != nil {
+		offline.close()
+		return nil, err
+	}
+
+	return offline, nil
+}
+
+func (offline *offlineState) close() {
+	if !check.IfNilReflect(offline.storageService) {
+		log.LogIfError(offline.storageService.CloseAll())
+	}
+	if !check.IfNil(offline.cryptoComponents) {
+		log.LogIfError(offline.cryptoComponents.Close())
+	}
+	log.LogIfError(offline.coreComponents.Close())
+}
+
+func (nr *nodeRunner) getLatestStorageData(coreComponents mainFactory.CoreComponentsHolder) (storage.LatestDataFromStorage, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(coreComponents.InternalMarshalizer())
+	if err != nil {
+		return storage.LatestDataFromStorage{}, err
+	}
+
+	latestStorageDataProvider, err := latestData.NewLatestDataProvider(latestData.ArgsLatestDataProvider{
+		GeneralConfig:         *nr.configs.GeneralConfig,
+		BootstrapDataProvider: bootstrapDataProvider,
+		DirectoryReader:       directoryhandler.NewDirectoryReader(),
+		ParentDir:             filepath.Join(nr.configs.FlagsConfig.DbDir, common.DefaultDBPath, coreComponents.ChainID()),
+		DefaultEpochString:    storage.DefaultEpochString,
+		DefaultShardString:    storage.DefaultShardString,
+	})
+	if err != nil {
+		return storage.LatestDataFromStorage{}, err
+	}
+
+	return latestStorageDataProvider.Get()
+}
+
+func (nr *nodeRunner) createOfflineStorageService(
+	coreComponents mainFactory.CoreComponentsHolder,
+	cryptoComponents mainFactory.CryptoComponentsHolder,
+	shardCoordinator sharding.Coordinator,
+	epoch uint32,
+) (dataRetriever.StorageService, error) {
+	storageServiceCreator, err := storageFactory.NewStorageServiceFactory(
+		storageFactory.StorageServiceFactoryArgs{
+			Config:             *nr.configs.GeneralConfig,
+			PrefsConfig:        nr.configs.PreferencesConfig.Preferences,
+			ShardCoordinator:   shardCoordinator,
+			PathManager:        coreComponents.PathHandler(),
+			EpochStartNotifier: notifier.NewEpochStartSubscriptionHandler(),
+			NodeTypeProvider:   coreComponents.NodeTypeProvider(),
+			StorageType:        storageFactory.BootstrapStorageService,
+			ManagedPeersHolder: cryptoComponents.ManagedPeersHolder(),
+			CurrentEpoch:       epoch,
+			NodeProcessingMode: common.Normal,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return storageServiceCreator.CreateForMeta()
+	}
+
+	return storageServiceCreator.CreateForShard()
+}
+
+// getStateSnapshotHeaders reads the epoch start meta block and, for a shard, its epoch start header, which commit to
+// the root hashes of the exported tries
+func getStateSnapshotHeaders(
+	coreComponents mainFactory.CoreComponentsHolder,
+	storageService dataRetriever.StorageService,
+	shardID uint32,
+	epoch uint32,
+) (*stateSnapshotHeaders, error) {
+	marshaller := coreComponents.InternalMarshalizer()
+	hasher := coreComponents.Hasher()
+
+	metaBlockStorer, err := storageService.GetStorer(dataRetriever.MetaBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+	epochStartMetaBytes, err := metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the epoch start meta block of epoch %d", err, epoch)
+	}
+	epochStartMeta, err := process.UnmarshalMetaHeader(marshaller, epochStartMetaBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := &stateSnapshotHeaders{
+		epochStartMetaHash: hasher.Compute(string(epochStartMetaBytes)),
+		epochStartMeta:     epochStartMetaBytes,
+		userRootHash:       epochStartMeta.GetRootHash(),
+		peerRootHash:       epochStartMeta.GetValidatorStatsRootHash(),
+	}
+	if shardID == core.MetachainShardId {
+		return headers, nil
+	}
+
+	shardHeaderHash, err := getEpochStartShardHeaderHash(epochStartMeta, shardID)
+	if err != nil {
+		return nil, err
+	}
+	shardHeaderStorer, err := storageService.GetStorer(dataRetriever.BlockHeaderUnit)
+	if err != nil {
+		return nil, err
+	}
+	shardHeaderBytes, err := shardHeaderStorer.SearchFirst(shardHeaderHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the epoch start shard header %x", err, shardHeaderHash)
+	}
+	shardHeader, err := process.UnmarshalShardHeader(marshaller, shardHeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	headers.shardHeaderHash = shardHeaderHash
+	headers.shardHeader = shardHeaderBytes
+	headers.userRootHash = getShardRootHashToExport(shardHeader, coreComponents.EnableEpochsHandler().ScheduledMiniBlocksEnableEpoch())
+
+	return headers, nil
+}
+
+func getEpochStartShardHeaderHash(epochStartMeta data.MetaHeaderHandler, shardID uint32) ([]byte, error) {
+	for _, shardData := range epochStartMeta.GetEpochStartHandler().GetLastFinalizedHeaderHandlers() {
+		if shardData.GetShardID() == shardID {
+			return shardData.GetHeaderHash(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no epoch start data for shard %d", ErrStateSnapshotExport, shardID)
+}
+
+// getShardRootHashToExport returns the same root hash the start in epoch bootstrap expects for the shard header
+func getShardRootHashToExport(shardHeader data.ShardHeaderHandler, scheduledEnableEpoch uint32) []byte {
+	if scheduledEnableEpoch > shardHeader.GetEpoch() {
+		return shardHeader.GetRootHash()
+	}
+
+	additionalData := shardHeader.GetAdditionalData()
+	if additionalData != nil {
+		return additionalData.GetScheduledRootHash()
+	}
+
+	return shardHeader.GetRootHash()
+}
+
+func (nr *nodeRunner) createTriesToExport(
+	coreComponents mainFactory.CoreComponentsHolder,
+	storageService dataRetriever.StorageService,
+	shardID uint32,
+	headers *stateSnapshotHeaders,
+) ([]*portableSnapshot.TrieToExport, error) {
+	triesContainer, _, err := trieFactory.CreateTriesComponentsForShardId(*nr.configs.GeneralConfig, coreComponents, storageService)
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := factoryState.NewAccountCreator(factoryState.ArgsAccountCreator{
+		Hasher:              coreComponents.Hasher(),
+		Marshaller:          coreComponents.InternalMarshalizer(),
+		EnableEpochsHandler: coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	userIdentifier := dataRetriever.UserAccountsUnit.String()
+	userAccounts, err := createAccountsDBForExport(coreComponents, triesContainer.Get([]byte(userIdentifier)), accountFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	triesToExport := []*portableSnapshot.TrieToExport{
+		{
+			Identifier:     userIdentifier,
+			RootHash:       headers.userRootHash,
+			LeavesProvider: userAccounts,
+			HasDataTries:   true,
+		},
+	}
+	if shardID != core.MetachainShardId {
+		return triesToExport, nil
+	}
+
+	peerIdentifier := dataRetriever.PeerAccountsUnit.String()
+	peerAccounts, err := createAccountsDBForExport(coreComponents, triesContainer.Get([]byte(peerIdentifier)), factoryState.NewPeerAccountCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return append(triesToExport, &portableSnapshot.TrieToExport{
+		Identifier:     peerIdentifier,
+		RootHash:       headers.peerRootHash,
+		LeavesProvider: peerAccounts,
+	}), nil
+}
+
+func createAccountsDBForExport(
+	coreComponents mainFactory.CoreComponentsHolder,
+	tr common.Trie,
+	accountFactory state.AccountFactory,
+) (*state.AccountsDB, error) {
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  tr,
+		Hasher:                coreComponents.Hasher(),
+		Marshaller:            coreComponents.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: disabledStoragePruning.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  coreComponents.ProcessStatusHandler(),
+		AppStatusHandler:      disabled.NewAppStatusHandler(),
+		AddressConverter:      coreComponents.AddressPubKeyConverter(),
+	})
+}
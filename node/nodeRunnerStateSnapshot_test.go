@@ -0,0 +1,63 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEpochStartShardHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	epochStartMeta := &block.MetaBlock{
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, HeaderHash: []byte("hash0")},
+				{ShardID: 1, HeaderHash: []byte("hash1")},
+			},
+		},
+	}
+
+	hash, err := node.GetEpochStartShardHeaderHash(epochStartMeta, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hash1"), hash)
+
+	hash, err = node.GetEpochStartShardHeaderHash(epochStartMeta, 2)
+	assert.Nil(t, hash)
+	assert.True(t, errors.Is(err, node.ErrStateSnapshotExport))
+}
+
+func TestGetShardRootHashToExport(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	scheduledRootHash := []byte("scheduled root hash")
+
+	t.Run("header before the scheduled activation should return the root hash", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.HeaderV2{
+			Header:            &block.Header{Epoch: 2, RootHash: rootHash},
+			ScheduledRootHash: scheduledRootHash,
+		}
+		assert.Equal(t, rootHash, node.GetShardRootHashToExport(header, 3))
+	})
+	t.Run("header with scheduled data should return the scheduled root hash", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.HeaderV2{
+			Header:            &block.Header{Epoch: 3, RootHash: rootHash},
+			ScheduledRootHash: scheduledRootHash,
+		}
+		assert.Equal(t, scheduledRootHash, node.GetShardRootHashToExport(header, 3))
+	})
+	t.Run("header without scheduled data should return the root hash", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.Header{Epoch: 3, RootHash: rootHash}
+		assert.Equal(t, rootHash, node.GetShardRootHashToExport(header, 3))
+	})
+}
@@ -0,0 +1,116 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/storageReport"
+	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
+)
+
+const (
+	// storageReportFormatJSON is the format of a storage report written as a single JSON object
+	storageReportFormatJSON = "json"
+	// storageReportFormatCSV is the format of a storage report written as consecutive CSV tables
+	storageReportFormatCSV = "csv"
+)
+
+// ArgsExportStorageReport holds the arguments needed to export a storage report
+type ArgsExportStorageReport struct {
+	OutputFile string
+	Format     string
+	RootHash   []byte
+	NumTop     int
+}
+
+// ExportStorageReport writes the storage report of the user accounts trie with the given root hash or, if no root hash
+// is provided, of the one committed at the latest epoch start found in the node's database. The storers are opened
+// directly, so the node must not be running while exporting
+func (nr *nodeRunner) ExportStorageReport(args ArgsExportStorageReport) error {
+	writeReport, err := getStorageReportWriter(args.Format)
+	if err != nil {
+		return err
+	}
+
+	offline, err := nr.openOfflineState()
+	if err != nil {
+		return err
+	}
+	defer offline.close()
+
+	rootHash := args.RootHash
+	if len(rootHash) == 0 {
+		headers, errGet := getStateSnapshotHeaders(offline.coreComponents, offline.storageService, offline.shardCoordinator.SelfId(), offline.epoch)
+		if errGet != nil {
+			return errGet
+		}
+		rootHash = headers.userRootHash
+	}
+
+	triesContainer, _, err := trieFactory.CreateTriesComponentsForShardId(*nr.configs.GeneralConfig, offline.coreComponents, offline.storageService)
+	if err != nil {
+		return err
+	}
+	mainTrie, err := triesContainer.Get([]byte(dataRetriever.UserAccountsUnit.String())).Recreate(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while opening the accounts trie with root hash %x", err, rootHash)
+	}
+
+	reporter, err := storageReport.NewStorageReporter(storageReport.ArgsStorageReporter{
+		Marshaller:          offline.coreComponents.InternalMarshalizer(),
+		EnableEpochsHandler: offline.coreComponents.EnableEpochsHandler(),
+		AddressConverter:    offline.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("computing the storage report",
+		"shard", offline.shardCoordinator.SelfId(),
+		"epoch", offline.epoch,
+		"root hash", rootHash,
+	)
+
+	report, err := reporter.Report(context.Background(), mainTrie, args.NumTop)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(args.OutputFile)
+	if err != nil {
+		return err
+	}
+	err = writeReport(file, report)
+	errClose := file.Close()
+	if err != nil {
+		return err
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Info("exported the storage report",
+		"file", args.OutputFile,
+		"num accounts", report.NumAccounts,
+		"num contracts", report.NumContracts,
+		"num data tries", report.NumDataTries,
+	)
+
+	return nil
+}
+
+func getStorageReportWriter(format string) (func(writer io.Writer, report *common.StorageReport) error, error) {
+	switch format {
+	case storageReportFormatJSON:
+		return storageReport.WriteJSON, nil
+	case storageReportFormatCSV:
+		return storageReport.WriteCSV, nil
+	default:
+		return nil, fmt.Errorf("%w: %s, it should be %s or %s",
+			ErrInvalidStorageReportFormat, format, storageReportFormatJSON, storageReportFormatCSV)
+	}
+}
@@ -0,0 +1,55 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStorageReportWriter(t *testing.T) {
+	t.Parallel()
+
+	report := &common.StorageReport{
+		RootHash:    "726f6f74",
+		NumAccounts: 7,
+	}
+
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		writeReport, err := node.GetStorageReportWriter("xml")
+		assert.True(t, errors.Is(err, node.ErrInvalidStorageReportFormat))
+		assert.Nil(t, writeReport)
+	})
+	t.Run("json format should work", func(t *testing.T) {
+		t.Parallel()
+
+		writeReport, err := node.GetStorageReportWriter("json")
+		require.Nil(t, err)
+
+		buff := &bytes.Buffer{}
+		require.Nil(t, writeReport(buff, report))
+
+		recovered := &common.StorageReport{}
+		require.Nil(t, json.Unmarshal(buff.Bytes(), recovered))
+		assert.Equal(t, report, recovered)
+	})
+	t.Run("csv format should work", func(t *testing.T) {
+		t.Parallel()
+
+		writeReport, err := node.GetStorageReportWriter("csv")
+		require.Nil(t, err)
+
+		buff := &bytes.Buffer{}
+		require.Nil(t, writeReport(buff, report))
+		assert.True(t, strings.HasPrefix(buff.String(), "summary\n"))
+		assert.Contains(t, buff.String(), "726f6f74,7,")
+	})
+}
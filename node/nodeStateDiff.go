@@ -0,0 +1,142 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/stateDiff"
+)
+
+// GetStateDiff returns a page of the accounts added, removed or modified between the states committed by two blocks,
+// optionally with their changed data trie keys. The accounts are returned in the trie traversal order, and each page
+// resumes the diff after the account of the provided cursor, without walking again the previous pages
+func (n *Node) GetStateDiff(request common.StateDiffRequest) (*common.StateDiffAPIResponse, error) {
+	startAfterAddress, err := hex.DecodeString(request.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStateDiffCursor, err)
+	}
+
+	oldHeaderHash, _, oldHeader, err := n.getStateAnchor(request.OldBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	newHeaderHash, _, newHeader, err := n.getStateAnchor(request.NewBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	trieStorageManager := n.stateComponents.TrieStorageManagers()[dataRetriever.UserAccountsUnit.String()]
+	if check.IfNil(trieStorageManager) {
+		return nil, ErrNilTrieStorageManager
+	}
+
+	differ, err := stateDiff.NewStateDiffer(stateDiff.ArgsStateDiffer{
+		TrieStorageManager:  trieStorageManager,
+		Marshaller:          n.coreComponents.InternalMarshalizer(),
+		Hasher:              n.coreComponents.Hasher(),
+		EnableEpochsHandler: n.coreComponents.EnableEpochsHandler(),
+		MaxKeysPerAccount:   int(request.MaxKeysPerAccount),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.StateDiffAPIResponse{
+		OldBlockHash: hex.EncodeToString(oldHeaderHash),
+		OldRootHash:  hex.EncodeToString(oldHeader.GetRootHash()),
+		NewBlockHash: hex.EncodeToString(newHeaderHash),
+		NewRootHash:  hex.EncodeToString(newHeader.GetRootHash()),
+		Accounts:     make([]*common.StateDiffAccountAPIResponse, 0, request.Size),
+	}
+
+	// the data tries are compared only for the accounts in the requested page
+	pageDiffs := make([]*stateDiff.AccountDiff, 0, request.Size)
+	err = differ.Diff(oldHeader.GetRootHash(), newHeader.GetRootHash(), startAfterAddress, false, func(diff *stateDiff.AccountDiff) bool {
+		if uint64(len(pageDiffs)) == request.Size {
+			response.HasMore = true
+			return false
+		}
+
+		pageDiffs = append(pageDiffs, diff)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.HasMore {
+		response.NextCursor = hex.EncodeToString(pageDiffs[len(pageDiffs)-1].Address)
+	}
+
+	for _, diff := range pageDiffs {
+		if request.WithDataTries {
+			err = differ.DiffDataTries(diff)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		response.Accounts = append(response.Accounts, n.createStateDiffAccountResponse(diff))
+	}
+
+	return response, nil
+}
+
+func (n *Node) createStateDiffAccountResponse(diff *stateDiff.AccountDiff) *common.StateDiffAccountAPIResponse {
+	accountResponse := &common.StateDiffAccountAPIResponse{
+		Address:     n.coreComponents.AddressPubKeyConverter().SilentEncode(diff.Address, log),
+		Status:      diff.Status,
+		Old:         n.createStateDiffAccountStateResponse(diff.OldAccount),
+		New:         n.createStateDiffAccountStateResponse(diff.NewAccount),
+		HasMoreKeys: diff.HasMoreKeys,
+	}
+	if diff.Keys == nil {
+		return accountResponse
+	}
+
+	accountResponse.Keys = make([]*common.StateDiffKeyAPIResponse, 0, len(diff.Keys))
+	for _, keyDiff := range diff.Keys {
+		accountResponse.Keys = append(accountResponse.Keys, &common.StateDiffKeyAPIResponse{
+			Key:      hex.EncodeToString(keyDiff.Key),
+			Status:   keyDiff.Status,
+			OldValue: hex.EncodeToString(keyDiff.OldValue),
+			NewValue: hex.EncodeToString(keyDiff.NewValue),
+		})
+	}
+
+	return accountResponse
+}
+
+func (n *Node) createStateDiffAccountStateResponse(account *accounts.UserAccountData) *common.StateDiffAccountStateAPIResponse {
+	if account == nil {
+		return nil
+	}
+
+	ownerAddress := ""
+	if len(account.OwnerAddress) > 0 {
+		ownerAddress = n.coreComponents.AddressPubKeyConverter().SilentEncode(account.OwnerAddress, log)
+	}
+
+	return &common.StateDiffAccountStateAPIResponse{
+		Nonce:           account.Nonce,
+		Balance:         bigIntToString(account.Balance),
+		DeveloperReward: bigIntToString(account.DeveloperReward),
+		CodeHash:        hex.EncodeToString(account.CodeHash),
+		RootHash:        hex.EncodeToString(account.RootHash),
+		CodeMetadata:    hex.EncodeToString(account.CodeMetadata),
+		OwnerAddress:    ownerAddress,
+		Username:        string(account.UserName),
+	}
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,263 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	mockStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	stateDiffOldHeaderHash = []byte("old header hash")
+	stateDiffNewHeaderHash = []byte("new header hash")
+)
+
+func getStateDiffAddress(index int) []byte {
+	return bytes.Repeat([]byte{byte(index + 1)}, 32)
+}
+
+func saveStateDiffAccount(t *testing.T, marshaller marshal.Marshalizer, mainTrie common.Trie, index int, balance int64, rootHash []byte) {
+	accountBytes, err := marshaller.Marshal(&accounts.UserAccountData{
+		Balance:  big.NewInt(balance),
+		RootHash: rootHash,
+		Address:  getStateDiffAddress(index),
+	})
+	require.Nil(t, err)
+	require.Nil(t, mainTrie.Update(getStateDiffAddress(index), accountBytes))
+}
+
+func createNodeForStateDiff(t *testing.T) *node.Node {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	mainTrie := createMultiProofTrie(t, marshaller)
+
+	dataTrie, err := mainTrie.Recreate(common.EmptyTrieHash)
+	require.Nil(t, err)
+	address := getStateDiffAddress(1)
+	require.Nil(t, dataTrie.Update([]byte("key"), append([]byte("value"), append([]byte("key"), address...)...)))
+	require.Nil(t, dataTrie.Commit())
+	oldDataTrieRootHash, _ := dataTrie.RootHash()
+
+	for i := 0; i < 4; i++ {
+		rootHash := []byte(nil)
+		if i == 1 {
+			rootHash = oldDataTrieRootHash
+		}
+		saveStateDiffAccount(t, marshaller, mainTrie, i, int64(i), rootHash)
+	}
+	require.Nil(t, mainTrie.Commit())
+	oldRootHash, _ := mainTrie.RootHash()
+
+	require.Nil(t, dataTrie.Update([]byte("key"), append([]byte("modified"), append([]byte("key"), address...)...)))
+	require.Nil(t, dataTrie.Commit())
+	newDataTrieRootHash, _ := dataTrie.RootHash()
+
+	saveStateDiffAccount(t, marshaller, mainTrie, 0, 100, nil)
+	saveStateDiffAccount(t, marshaller, mainTrie, 1, 1, newDataTrieRootHash)
+	require.Nil(t, mainTrie.Delete(getStateDiffAddress(2)))
+	saveStateDiffAccount(t, marshaller, mainTrie, 4, 4, nil)
+	require.Nil(t, mainTrie.Commit())
+	newRootHash, _ := mainTrie.RootHash()
+
+	headers := make(map[string][]byte)
+	headers[string(stateDiffOldHeaderHash)], _ = marshaller.Marshal(&block.Header{Nonce: 1, RootHash: oldRootHash})
+	headers[string(stateDiffNewHeaderHash)], _ = marshaller.Marshal(&block.Header{Nonce: 2, RootHash: newRootHash})
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = marshaller
+	coreComponents.Hash = &testscommon.KeccakMock{}
+	coreComponents.EnableEpochsHandlerField = &enableEpochsHandlerMock.EnableEpochsHandlerStub{}
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.StorageManagers = map[string]common.StorageManager{
+		dataRetriever.UserAccountsUnit.String(): mainTrie.GetStorageManager(),
+	}
+
+	dataComponents := getDefaultDataComponents()
+	storageService := dataComponents.StorageService().(*mockStorage.ChainStorerStub)
+	storageService.GetStorerCalled = func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+		return &mockStorage.StorerStub{
+			GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+				headerBytes, found := headers[string(key)]
+				if !found {
+					return nil, storage.ErrKeyNotFound
+				}
+
+				return headerBytes, nil
+			},
+		}, nil
+	}
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(getDefaultProcessComponents()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid block hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t)
+		response, err := n.GetStateDiff(common.StateDiffRequest{OldBlockHash: "not hex", Size: 10, MaxKeysPerAccount: 10})
+		assert.NotNil(t, err)
+		assert.Nil(t, response)
+
+		response, err = n.GetStateDiff(common.StateDiffRequest{
+			OldBlockHash:      hex.EncodeToString(stateDiffOldHeaderHash),
+			NewBlockHash:      hex.EncodeToString([]byte("missing header hash")),
+			Size:              10,
+			MaxKeysPerAccount: 10,
+		})
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Nil(t, response)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t)
+		response, err := n.GetStateDiff(common.StateDiffRequest{
+			OldBlockHash:      hex.EncodeToString(stateDiffOldHeaderHash),
+			NewBlockHash:      hex.EncodeToString(stateDiffNewHeaderHash),
+			Cursor:            "not hex",
+			Size:              10,
+			MaxKeysPerAccount: 10,
+		})
+		assert.True(t, errors.Is(err, node.ErrInvalidStateDiffCursor))
+		assert.Nil(t, response)
+	})
+	t.Run("missing trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateComponents := getDefaultStateComponents()
+		dataComponents := getDefaultDataComponents()
+		storageService := dataComponents.StorageService().(*mockStorage.ChainStorerStub)
+		storageService.GetStorerCalled = func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &mockStorage.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					return (&marshal.GogoProtoMarshalizer{}).Marshal(&block.Header{})
+				},
+			}, nil
+		}
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.IntMarsh = &marshal.GogoProtoMarshalizer{}
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		response, err := n.GetStateDiff(common.StateDiffRequest{
+			OldBlockHash:      hex.EncodeToString(stateDiffOldHeaderHash),
+			NewBlockHash:      hex.EncodeToString(stateDiffNewHeaderHash),
+			Size:              10,
+			MaxKeysPerAccount: 10,
+		})
+		assert.Equal(t, node.ErrNilTrieStorageManager, err)
+		assert.Nil(t, response)
+	})
+	t.Run("should return the changed accounts page by page", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t)
+		request := common.StateDiffRequest{
+			OldBlockHash:      hex.EncodeToString(stateDiffOldHeaderHash),
+			NewBlockHash:      hex.EncodeToString(stateDiffNewHeaderHash),
+			Size:              3,
+			MaxKeysPerAccount: 10,
+		}
+		firstPage, err := n.GetStateDiff(request)
+		require.Nil(t, err)
+		assert.True(t, firstPage.HasMore)
+		assert.NotEmpty(t, firstPage.NextCursor)
+		assert.Equal(t, 3, len(firstPage.Accounts))
+		assert.Equal(t, request.OldBlockHash, firstPage.OldBlockHash)
+		assert.Equal(t, request.NewBlockHash, firstPage.NewBlockHash)
+		assert.NotEqual(t, firstPage.OldRootHash, firstPage.NewRootHash)
+
+		request.Cursor = firstPage.NextCursor
+		secondPage, err := n.GetStateDiff(request)
+		require.Nil(t, err)
+		assert.False(t, secondPage.HasMore)
+		assert.Empty(t, secondPage.NextCursor)
+		require.Equal(t, 1, len(secondPage.Accounts))
+
+		converter := testscommon.RealWorldBech32PubkeyConverter
+		changedAccounts := make(map[string]*common.StateDiffAccountAPIResponse)
+		for _, account := range append(firstPage.Accounts, secondPage.Accounts...) {
+			changedAccounts[account.Address] = account
+			assert.Nil(t, account.Keys)
+		}
+		require.Equal(t, 4, len(changedAccounts))
+
+		modified := changedAccounts[converter.SilentEncode(getStateDiffAddress(0), nil)]
+		require.NotNil(t, modified)
+		assert.Equal(t, "modified", modified.Status)
+		assert.Equal(t, "0", modified.Old.Balance)
+		assert.Equal(t, "100", modified.New.Balance)
+
+		removed := changedAccounts[converter.SilentEncode(getStateDiffAddress(2), nil)]
+		require.NotNil(t, removed)
+		assert.Equal(t, "removed", removed.Status)
+		assert.Nil(t, removed.New)
+
+		added := changedAccounts[converter.SilentEncode(getStateDiffAddress(4), nil)]
+		require.NotNil(t, added)
+		assert.Equal(t, "added", added.Status)
+		assert.Nil(t, added.Old)
+		assert.Equal(t, "4", added.New.Balance)
+	})
+	t.Run("should return the changed data trie keys", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStateDiff(t)
+		response, err := n.GetStateDiff(common.StateDiffRequest{
+			OldBlockHash:      hex.EncodeToString(stateDiffOldHeaderHash),
+			NewBlockHash:      hex.EncodeToString(stateDiffNewHeaderHash),
+			Size:              10,
+			WithDataTries:     true,
+			MaxKeysPerAccount: 10,
+		})
+		require.Nil(t, err)
+		require.Equal(t, 4, len(response.Accounts))
+
+		address := testscommon.RealWorldBech32PubkeyConverter.SilentEncode(getStateDiffAddress(1), nil)
+		for _, account := range response.Accounts {
+			if account.Address != address {
+				assert.Empty(t, account.Keys, fmt.Sprintf("account %s", account.Address))
+				continue
+			}
+
+			require.Equal(t, 1, len(account.Keys))
+			assert.Equal(t, &common.StateDiffKeyAPIResponse{
+				Key:      hex.EncodeToString([]byte("key")),
+				Status:   "modified",
+				OldValue: hex.EncodeToString([]byte("value")),
+				NewValue: hex.EncodeToString([]byte("modified")),
+			}, account.Keys[0])
+			assert.False(t, account.HasMoreKeys)
+		}
+	})
+}
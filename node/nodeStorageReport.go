@@ -0,0 +1,46 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/storageReport"
+)
+
+// GetStorageReport walks the accounts trie with the requested root hash, or the one of the current block, together
+// with all the data tries, and returns the storage used by the accounts, the contracts, the codes and the tokens.
+// Only one report is computed at a time, as the walk is long for a large state
+func (n *Node) GetStorageReport(request common.StorageReportRequest) (*common.StorageReport, error) {
+	rootHash, err := hex.DecodeString(request.RootHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(rootHash) == 0 {
+		rootHash = n.dataComponents.Blockchain().GetCurrentBlockRootHash()
+	}
+
+	reporter, err := storageReport.NewStorageReporter(storageReport.ArgsStorageReporter{
+		Marshaller:          n.coreComponents.InternalMarshalizer(),
+		EnableEpochsHandler: n.coreComponents.EnableEpochsHandler(),
+		AddressConverter:    n.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	isInProgress := n.isStorageReportInProgress.SetReturningPrevious()
+	if isInProgress {
+		return nil, ErrStorageReportInProgress
+	}
+	defer n.isStorageReportInProgress.Reset()
+
+	mainTrie, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("computing the storage report", "root hash", rootHash, "num top", request.NumTop)
+
+	return reporter.Report(context.Background(), mainTrie, request.NumTop)
+}
@@ -0,0 +1,120 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForStorageReport(t *testing.T, accountsAPI *stateMock.AccountsStub) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = &marshal.GogoProtoMarshalizer{}
+	coreComponents.EnableEpochsHandlerField = &enableEpochsHandlerMock.EnableEpochsHandlerStub{}
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = accountsAPI
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetStorageReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForStorageReport(t, &stateMock.AccountsStub{})
+		report, err := n.GetStorageReport(common.StorageReportRequest{RootHash: "not hex", NumTop: 10})
+		assert.NotNil(t, err)
+		assert.Nil(t, report)
+	})
+	t.Run("empty root hash should use the current block root hash", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeForStorageReport(t, &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				assert.Equal(t, []byte("root hash"), rootHash)
+				return nil, expectedErr
+			},
+		})
+		report, err := n.GetStorageReport(common.StorageReportRequest{NumTop: 10})
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, report)
+	})
+	t.Run("report in progress should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		chStarted := make(chan struct{})
+		chDone := make(chan struct{})
+		n := createNodeForStorageReport(t, &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				close(chStarted)
+				<-chDone
+				return nil, expectedErr
+			},
+		})
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := n.GetStorageReport(common.StorageReportRequest{NumTop: 10})
+			assert.Equal(t, expectedErr, err)
+		}()
+
+		<-chStarted
+		report, err := n.GetStorageReport(common.StorageReportRequest{NumTop: 10})
+		assert.Equal(t, node.ErrStorageReportInProgress, err)
+		assert.Nil(t, report)
+
+		close(chDone)
+		wg.Wait()
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &marshal.GogoProtoMarshalizer{}
+		mainTrie := createMultiProofTrie(t, marshaller)
+		for i := 0; i < 3; i++ {
+			saveStateDiffAccount(t, marshaller, mainTrie, i, int64(i), nil)
+		}
+		require.Nil(t, mainTrie.Commit())
+		rootHash, _ := mainTrie.RootHash()
+
+		n := createNodeForStorageReport(t, &stateMock.AccountsStub{
+			GetTrieCalled: func(providedRootHash []byte) (common.Trie, error) {
+				assert.Equal(t, rootHash, providedRootHash)
+				return mainTrie.Recreate(providedRootHash)
+			},
+		})
+		report, err := n.GetStorageReport(common.StorageReportRequest{RootHash: hex.EncodeToString(rootHash), NumTop: 10})
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(rootHash), report.RootHash)
+		assert.Equal(t, uint64(3), report.NumAccounts)
+		assert.Equal(t, uint64(0), report.NumContracts)
+		assert.NotZero(t, report.MainTrieSize)
+
+		// the in progress flag is reset after each report
+		_, err = n.GetStorageReport(common.StorageReportRequest{RootHash: hex.EncodeToString(rootHash), NumTop: 10})
+		assert.Nil(t, err)
+	})
+}
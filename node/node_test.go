@@ -58,6 +58,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/mainFactoryMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
@@ -5177,6 +5178,107 @@ func getDefaultBootstrapComponents() *mainFactoryMocks.BootstrapComponentsStub {
 	}
 }
 
+func TestNode_RedundancyLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lease not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		processComponents := getDefaultProcessComponents()
+		processComponents.NodeRedundancyHandlerInternal = &mock.NodeRedundancyHandlerStub{}
+		n, _ := node.NewNode(node.WithProcessComponents(processComponents))
+
+		_, err := n.GetRedundancyLeaseStatus()
+		require.Equal(t, node.ErrRedundancyLeaseNotEnabled, err)
+		_, err = n.PromoteRedundancyLease()
+		require.Equal(t, node.ErrRedundancyLeaseNotEnabled, err)
+		_, err = n.DemoteRedundancyLease()
+		require.Equal(t, node.ErrRedundancyLeaseNotEnabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		processComponents := getDefaultProcessComponents()
+		processComponents.NodeRedundancyHandlerInternal = &mock.LeaderLeaseRedundancyStub{
+			StatusCalled: func() common.RedundancyLeaseStatus {
+				return common.RedundancyLeaseStatus{State: "follower", LeaseEpoch: 1}
+			},
+			PromoteCalled: func() common.RedundancyLeaseStatus {
+				return common.RedundancyLeaseStatus{State: "holder", LeaseEpoch: 2}
+			},
+			DemoteCalled: func() common.RedundancyLeaseStatus {
+				return common.RedundancyLeaseStatus{State: "demoted", LeaseEpoch: 2}
+			},
+		}
+		n, _ := node.NewNode(node.WithProcessComponents(processComponents))
+
+		status, err := n.GetRedundancyLeaseStatus()
+		require.Nil(t, err)
+		require.Equal(t, common.RedundancyLeaseStatus{State: "follower", LeaseEpoch: 1}, status)
+
+		status, err = n.PromoteRedundancyLease()
+		require.Nil(t, err)
+		require.Equal(t, common.RedundancyLeaseStatus{State: "holder", LeaseEpoch: 2}, status)
+
+		status, err = n.DemoteRedundancyLease()
+		require.Nil(t, err)
+		require.Equal(t, common.RedundancyLeaseStatus{State: "demoted", LeaseEpoch: 2}, status)
+	})
+}
+
+type journaledOutportStub struct {
+	*outportStub.OutportStub
+	cursors          map[string]uint64
+	replayFromCalled func(driverID string, sequence uint64) error
+}
+
+func (stub *journaledOutportStub) ReplayFrom(driverID string, sequence uint64) error {
+	return stub.replayFromCalled(driverID, sequence)
+}
+
+func (stub *journaledOutportStub) GetDriversCursors() map[string]uint64 {
+	return stub.cursors
+}
+
+func TestNode_OutportJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("journal not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStatusComponents(&mainFactoryMocks.StatusComponentsStub{
+			Outport: &outportStub.OutportStub{},
+		}))
+
+		cursors, err := n.GetOutportDriversCursors()
+		require.Nil(t, cursors)
+		require.Equal(t, node.ErrOutportJournalNotEnabled, err)
+		require.Equal(t, node.ErrOutportJournalNotEnabled, n.ReplayOutport("driver", 1))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayed := make(map[string]uint64)
+		n, _ := node.NewNode(node.WithStatusComponents(&mainFactoryMocks.StatusComponentsStub{
+			Outport: &journaledOutportStub{
+				OutportStub: &outportStub.OutportStub{},
+				cursors:     map[string]uint64{"driver": 7},
+				replayFromCalled: func(driverID string, sequence uint64) error {
+					replayed[driverID] = sequence
+					return nil
+				},
+			},
+		}))
+
+		cursors, err := n.GetOutportDriversCursors()
+		require.Nil(t, err)
+		require.Equal(t, map[string]uint64{"driver": 7}, cursors)
+
+		require.Nil(t, n.ReplayOutport("driver", 5))
+		require.Equal(t, map[string]uint64{"driver": 5}, replayed)
+	})
+}
+
 func TestNode_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
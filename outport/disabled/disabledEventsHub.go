@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport"
+)
+
+type disabledEventsHub struct{}
+
+// NewDisabledEventsHub will create a new instance of disabledEventsHub
+func NewDisabledEventsHub() *disabledEventsHub {
+	return new(disabledEventsHub)
+}
+
+// Subscribe returns ErrEventsSubscriptionsDisabled
+func (deh *disabledEventsHub) Subscribe(_ common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	return nil, outport.ErrEventsSubscriptionsDisabled
+}
+
+// Unsubscribe does nothing
+func (deh *disabledEventsHub) Unsubscribe(_ uint64) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (deh *disabledEventsHub) IsInterfaceNil() bool {
+	return deh == nil
+}
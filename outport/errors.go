@@ -14,6 +14,21 @@ var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
 // ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
 
+// ErrNilJournal signals that a nil outport journal has been provided
+var ErrNilJournal = errors.New("nil outport journal")
+
+// ErrNilBlockContainer signals that a nil block container has been provided
+var ErrNilBlockContainer = errors.New("nil block container")
+
+// ErrUnknownDriver signals that the provided driver identifier is not subscribed to the outport
+var ErrUnknownDriver = errors.New("unknown driver")
+
+// ErrDuplicatedDriverID signals that a driver with the same identifier is already subscribed to the outport
+var ErrDuplicatedDriverID = errors.New("duplicated driver identifier")
+
+// ErrEventsSubscriptionsDisabled signals that the events subscriptions are disabled on this node
+var ErrEventsSubscriptionsDisabled = errors.New("events subscriptions are disabled")
+
 var errNilSaveBlockArgs = errors.New("nil save blocks args provided")
 
 var errNilHeaderAndBodyArgs = errors.New("nil header and body args provided")
@@ -0,0 +1,27 @@
+package events
+
+import "errors"
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrInvalidMaxSubscriptions signals that an invalid maximum number of subscriptions has been provided
+var ErrInvalidMaxSubscriptions = errors.New("invalid maximum number of subscriptions")
+
+// ErrInvalidNotificationsBufferSize signals that an invalid notifications buffer size has been provided
+var ErrInvalidNotificationsBufferSize = errors.New("invalid notifications buffer size")
+
+// ErrTooManySubscriptions signals that the maximum number of subscriptions has been reached
+var ErrTooManySubscriptions = errors.New("too many events subscriptions")
+
+// ErrSlowSubscriber signals that a subscription was terminated because it did not consume its notifications in time
+var ErrSlowSubscriber = errors.New("subscription terminated: the subscriber is too slow")
+
+// ErrEventsHubClosed signals that the events hub was closed
+var ErrEventsHubClosed = errors.New("events hub closed")
+
+// ErrUnsubscribed signals that the subscription was cancelled by the subscriber
+var ErrUnsubscribed = errors.New("unsubscribed")
@@ -0,0 +1,283 @@
+package events
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/events")
+
+const (
+	// PushNotification is the type of the notification sent when a block is saved
+	PushNotification = "push"
+	// RevertNotification is the type of the notification sent when a block is reverted
+	RevertNotification = "revert"
+	// FinalizedNotification is the type of the notification sent when a block is finalized
+	FinalizedNotification = "finalized"
+)
+
+// ArgsEventsHub holds the arguments needed to create a new events hub
+type ArgsEventsHub struct {
+	AddressPubKeyConverter  core.PubkeyConverter
+	Marshaller              marshal.Marshalizer
+	MaxSubscriptions        uint32
+	NotificationsBufferSize uint32
+}
+
+// eventsHub is an outport driver that pushes the events produced by the outport to the API subscribers.
+// The outport is never blocked by a subscriber: if a subscriber's buffer is full, its subscription is terminated
+type eventsHub struct {
+	addressPubKeyConverter  core.PubkeyConverter
+	marshaller              marshal.Marshalizer
+	maxSubscriptions        uint32
+	notificationsBufferSize uint32
+	mutSubscriptions        sync.RWMutex
+	subscriptions           map[uint64]*subscription
+	lastSubscriptionID      uint64
+	closed                  bool
+}
+
+// NewEventsHub creates a new events hub
+func NewEventsHub(args ArgsEventsHub) (*eventsHub, error) {
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if args.MaxSubscriptions == 0 {
+		return nil, ErrInvalidMaxSubscriptions
+	}
+	if args.NotificationsBufferSize == 0 {
+		return nil, ErrInvalidNotificationsBufferSize
+	}
+
+	return &eventsHub{
+		addressPubKeyConverter:  args.AddressPubKeyConverter,
+		marshaller:              args.Marshaller,
+		maxSubscriptions:        args.MaxSubscriptions,
+		notificationsBufferSize: args.NotificationsBufferSize,
+		subscriptions:           make(map[uint64]*subscription),
+	}, nil
+}
+
+// Subscribe creates a new subscription that will receive the notifications matching the provided filter
+func (eh *eventsHub) Subscribe(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	eh.mutSubscriptions.Lock()
+	defer eh.mutSubscriptions.Unlock()
+
+	if eh.closed {
+		return nil, ErrEventsHubClosed
+	}
+	if uint32(len(eh.subscriptions)) >= eh.maxSubscriptions {
+		return nil, ErrTooManySubscriptions
+	}
+
+	eh.lastSubscriptionID++
+	sub := newSubscription(eh.lastSubscriptionID, filter, eh.notificationsBufferSize)
+	eh.subscriptions[sub.id] = sub
+
+	log.Debug("eventsHub.Subscribe", "subscription", sub.id, "num subscriptions", len(eh.subscriptions))
+
+	return sub, nil
+}
+
+// Unsubscribe terminates the provided subscription
+func (eh *eventsHub) Unsubscribe(subscriptionID uint64) {
+	eh.removeSubscription(subscriptionID, ErrUnsubscribed)
+}
+
+func (eh *eventsHub) removeSubscription(subscriptionID uint64, reason error) {
+	eh.mutSubscriptions.Lock()
+	sub, found := eh.subscriptions[subscriptionID]
+	delete(eh.subscriptions, subscriptionID)
+	eh.mutSubscriptions.Unlock()
+
+	if !found {
+		return
+	}
+
+	sub.terminate(reason)
+	log.Debug("eventsHub: subscription removed", "subscription", subscriptionID, "reason", reason)
+}
+
+// SaveBlock pushes the matching log events of the block to each subscriber
+func (eh *eventsHub) SaveBlock(outportBlock *outport.OutportBlock) error {
+	if outportBlock == nil {
+		return nil
+	}
+
+	hash := ""
+	if outportBlock.BlockData != nil {
+		hash = hex.EncodeToString(outportBlock.BlockData.HeaderHash)
+	}
+
+	blockEvents := eh.extractEvents(outportBlock.TransactionPool)
+	for _, sub := range eh.getSubscriptions() {
+		matchingEvents := make([]*common.EventNotificationData, 0)
+		for _, event := range blockEvents {
+			if sub.filter.matches(event) {
+				matchingEvents = append(matchingEvents, event)
+			}
+		}
+		if len(matchingEvents) == 0 {
+			continue
+		}
+
+		eh.pushOrTerminate(sub, &common.EventsNotification{
+			Type:    PushNotification,
+			Hash:    hash,
+			ShardID: outportBlock.ShardID,
+			Events:  matchingEvents,
+		})
+	}
+
+	return nil
+}
+
+func (eh *eventsHub) extractEvents(pool *outport.TransactionPool) []*common.EventNotificationData {
+	if pool == nil {
+		return nil
+	}
+
+	blockEvents := make([]*common.EventNotificationData, 0)
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			blockEvents = append(blockEvents, &common.EventNotificationData{
+				TxHash:     logData.TxHash,
+				Address:    eh.addressPubKeyConverter.SilentEncode(event.Address, log),
+				Identifier: string(event.Identifier),
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+		}
+	}
+
+	return blockEvents
+}
+
+// RevertIndexedBlock notifies all the subscribers that a block was reverted
+func (eh *eventsHub) RevertIndexedBlock(blockData *outport.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	eh.pushToAll(&common.EventsNotification{
+		Type:    RevertNotification,
+		Hash:    hex.EncodeToString(blockData.HeaderHash),
+		ShardID: blockData.ShardID,
+	})
+
+	return nil
+}
+
+// FinalizedBlock notifies all the subscribers that a block was finalized
+func (eh *eventsHub) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	eh.pushToAll(&common.EventsNotification{
+		Type:    FinalizedNotification,
+		Hash:    hex.EncodeToString(finalizedBlock.HeaderHash),
+		ShardID: finalizedBlock.ShardID,
+	})
+
+	return nil
+}
+
+func (eh *eventsHub) pushToAll(notification *common.EventsNotification) {
+	for _, sub := range eh.getSubscriptions() {
+		eh.pushOrTerminate(sub, notification)
+	}
+}
+
+func (eh *eventsHub) pushOrTerminate(sub *subscription, notification *common.EventsNotification) {
+	if sub.push(notification) {
+		return
+	}
+
+	eh.removeSubscription(sub.id, ErrSlowSubscriber)
+}
+
+func (eh *eventsHub) getSubscriptions() []*subscription {
+	eh.mutSubscriptions.RLock()
+	defer eh.mutSubscriptions.RUnlock()
+
+	subscriptions := make([]*subscription, 0, len(eh.subscriptions))
+	for _, sub := range eh.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+
+	return subscriptions
+}
+
+// SaveRoundsInfo does nothing
+func (eh *eventsHub) SaveRoundsInfo(_ *outport.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (eh *eventsHub) SaveValidatorsPubKeys(_ *outport.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (eh *eventsHub) SaveValidatorsRating(_ *outport.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (eh *eventsHub) SaveAccounts(_ *outport.Accounts) error {
+	return nil
+}
+
+// GetMarshaller returns the internal marshaller
+func (eh *eventsHub) GetMarshaller() marshal.Marshalizer {
+	return eh.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (eh *eventsHub) SetCurrentSettings(_ outport.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (eh *eventsHub) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close terminates all the active subscriptions
+func (eh *eventsHub) Close() error {
+	eh.mutSubscriptions.Lock()
+	eh.closed = true
+	subscriptions := eh.subscriptions
+	eh.subscriptions = make(map[uint64]*subscription)
+	eh.mutSubscriptions.Unlock()
+
+	for _, sub := range subscriptions {
+		sub.terminate(ErrEventsHubClosed)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eh *eventsHub) IsInterfaceNil() bool {
+	return eh == nil
+}
@@ -0,0 +1,205 @@
+package events
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsEventsHub() ArgsEventsHub {
+	return ArgsEventsHub{
+		AddressPubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		Marshaller:              &marshallerMock.MarshalizerMock{},
+		MaxSubscriptions:        2,
+		NotificationsBufferSize: 2,
+	}
+}
+
+func createOutportBlockWithEvents(events ...*transaction.Event) *outportcore.OutportBlock {
+	return &outportcore.OutportBlock{
+		ShardID: 1,
+		BlockData: &outportcore.BlockData{
+			HeaderHash: []byte("hash"),
+		},
+		TransactionPool: &outportcore.TransactionPool{
+			Logs: []*outportcore.LogData{
+				{
+					TxHash: "txHash",
+					Log: &transaction.Log{
+						Events: events,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewEventsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.AddressPubKeyConverter = nil
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrNilPubKeyConverter, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.Marshaller = nil
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrNilMarshaller, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid max subscriptions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.MaxSubscriptions = 0
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrInvalidMaxSubscriptions, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("invalid notifications buffer size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsHub()
+		args.NotificationsBufferSize = 0
+		hub, err := NewEventsHub(args)
+		require.Equal(t, ErrInvalidNotificationsBufferSize, err)
+		require.True(t, check.IfNil(hub))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewEventsHub(createMockArgsEventsHub())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(hub))
+	})
+}
+
+func TestEventsHub_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+
+	sub1, err := hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Nil(t, err)
+	sub2, err := hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Nil(t, err)
+	require.NotEqual(t, sub1.ID(), sub2.ID())
+
+	_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Equal(t, ErrTooManySubscriptions, err)
+
+	hub.Unsubscribe(sub1.ID())
+	<-sub1.Done()
+	require.Equal(t, ErrUnsubscribed, sub1.Err())
+
+	_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Nil(t, err)
+
+	_ = hub.Close()
+	<-sub2.Done()
+	require.Equal(t, ErrEventsHubClosed, sub2.Err())
+
+	_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Equal(t, ErrEventsHubClosed, err)
+}
+
+func TestEventsHub_SaveBlockShouldPushFilteredEvents(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+
+	transferEvent := &transaction.Event{
+		Address:    []byte("sender"),
+		Identifier: []byte("ESDTTransfer"),
+		Topics:     [][]byte{[]byte("TKN-abcdef"), {}, []byte("value")},
+	}
+	otherEvent := &transaction.Event{
+		Address:    []byte("contract"),
+		Identifier: []byte("writeLog"),
+		Topics:     [][]byte{[]byte("topic")},
+	}
+
+	tokenSub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Tokens: []string{"TKN-abcdef"}})
+	topicSub, _ := hub.Subscribe(common.EventsSubscriptionFilter{
+		Addresses: []string{hex.EncodeToString([]byte("contract"))},
+		Topics:    []string{hex.EncodeToString([]byte("topic"))},
+	})
+
+	err := hub.SaveBlock(createOutportBlockWithEvents(transferEvent, otherEvent))
+	require.Nil(t, err)
+
+	notification := <-tokenSub.Notifications()
+	require.Equal(t, PushNotification, notification.Type)
+	require.Equal(t, hex.EncodeToString([]byte("hash")), notification.Hash)
+	require.Equal(t, uint32(1), notification.ShardID)
+	require.Equal(t, []*common.EventNotificationData{
+		{
+			TxHash:     "txHash",
+			Address:    hex.EncodeToString([]byte("sender")),
+			Identifier: "ESDTTransfer",
+			Topics:     transferEvent.Topics,
+		},
+	}, notification.Events)
+
+	notification = <-topicSub.Notifications()
+	require.Len(t, notification.Events, 1)
+	require.Equal(t, "writeLog", notification.Events[0].Identifier)
+
+	// blocks without matching events are not pushed
+	err = hub.SaveBlock(createOutportBlockWithEvents(otherEvent))
+	require.Nil(t, err)
+	require.Len(t, tokenSub.Notifications(), 0)
+	require.Len(t, topicSub.Notifications(), 1)
+}
+
+func TestEventsHub_RevertAndFinalizedShouldBePushedToAll(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	sub, _ := hub.Subscribe(common.EventsSubscriptionFilter{Tokens: []string{"TKN-abcdef"}})
+
+	err := hub.RevertIndexedBlock(&outportcore.BlockData{ShardID: 1, HeaderHash: []byte("reverted")})
+	require.Nil(t, err)
+	err = hub.FinalizedBlock(&outportcore.FinalizedBlock{ShardID: 1, HeaderHash: []byte("final")})
+	require.Nil(t, err)
+
+	notification := <-sub.Notifications()
+	require.Equal(t, &common.EventsNotification{Type: RevertNotification, Hash: hex.EncodeToString([]byte("reverted")), ShardID: 1}, notification)
+	notification = <-sub.Notifications()
+	require.Equal(t, &common.EventsNotification{Type: FinalizedNotification, Hash: hex.EncodeToString([]byte("final")), ShardID: 1}, notification)
+}
+
+func TestEventsHub_SlowSubscriberShouldBeTerminated(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewEventsHub(createMockArgsEventsHub())
+	slowSub, _ := hub.Subscribe(common.EventsSubscriptionFilter{})
+
+	for i := 0; i < 3; i++ {
+		_ = hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("hash")})
+	}
+
+	<-slowSub.Done()
+	require.Equal(t, ErrSlowSubscriber, slowSub.Err())
+
+	// the slot of the terminated subscription is released
+	_, err := hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Nil(t, err)
+	_, err = hub.Subscribe(common.EventsSubscriptionFilter{})
+	require.Nil(t, err)
+}
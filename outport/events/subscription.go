@@ -0,0 +1,134 @@
+package events
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type eventsFilter struct {
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+	topics      map[string]struct{}
+	tokens      map[string]struct{}
+}
+
+func newEventsFilter(filter common.EventsSubscriptionFilter) *eventsFilter {
+	return &eventsFilter{
+		addresses:   sliceToSet(filter.Addresses),
+		identifiers: sliceToSet(filter.Identifiers),
+		topics:      sliceToSet(filter.Topics),
+		tokens:      sliceToSet(filter.Tokens),
+	}
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		set[value] = struct{}{}
+	}
+
+	return set
+}
+
+// matches returns true if the event satisfies all the non-empty criteria of the filter.
+// Topics are compared hex encoded while the token is the first topic of the ESDT events
+func (ef *eventsFilter) matches(event *common.EventNotificationData) bool {
+	if !matchesSet(ef.addresses, event.Address) {
+		return false
+	}
+	if !matchesSet(ef.identifiers, event.Identifier) {
+		return false
+	}
+	if len(ef.tokens) > 0 {
+		if len(event.Topics) == 0 || !matchesSet(ef.tokens, string(event.Topics[0])) {
+			return false
+		}
+	}
+	if len(ef.topics) == 0 {
+		return true
+	}
+
+	for _, topic := range event.Topics {
+		if matchesSet(ef.topics, hex.EncodeToString(topic)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesSet(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[value]
+	return found
+}
+
+type subscription struct {
+	id                uint64
+	filter            *eventsFilter
+	chanNotifications chan *common.EventsNotification
+	chanDone          chan struct{}
+	closeOnce         sync.Once
+	mutErr            sync.RWMutex
+	err               error
+}
+
+func newSubscription(id uint64, filter common.EventsSubscriptionFilter, bufferSize uint32) *subscription {
+	return &subscription{
+		id:                id,
+		filter:            newEventsFilter(filter),
+		chanNotifications: make(chan *common.EventsNotification, bufferSize),
+		chanDone:          make(chan struct{}),
+	}
+}
+
+// push tries to deliver the notification without blocking. Returns false if the subscriber's buffer is full
+func (s *subscription) push(notification *common.EventsNotification) bool {
+	select {
+	case s.chanNotifications <- notification:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *subscription) terminate(err error) {
+	s.closeOnce.Do(func() {
+		s.mutErr.Lock()
+		s.err = err
+		s.mutErr.Unlock()
+
+		close(s.chanDone)
+	})
+}
+
+// ID returns the subscription identifier
+func (s *subscription) ID() uint64 {
+	return s.id
+}
+
+// Notifications returns the channel on which the matching notifications are pushed
+func (s *subscription) Notifications() <-chan *common.EventsNotification {
+	return s.chanNotifications
+}
+
+// Done returns a channel that is closed when the subscription is terminated
+func (s *subscription) Done() <-chan struct{} {
+	return s.chanDone
+}
+
+// Err returns the reason of the subscription termination, nil while the subscription is active
+func (s *subscription) Err() error {
+	s.mutErr.RLock()
+	defer s.mutErr.RUnlock()
+
+	return s.err
+}
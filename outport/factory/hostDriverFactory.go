@@ -41,5 +41,6 @@ func CreateHostDriver(args ArgsHostDriverFactory) (outport.Driver, error) {
 		Marshaller: args.Marshaller,
 		SenderHost: wsHost,
 		Log:        log,
+		Name:       args.HostConfig.URL,
 	})
 }
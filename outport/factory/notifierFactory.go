@@ -60,7 +60,7 @@ func checkInputArgs(args *EventNotifierFactoryArgs) error {
 	return nil
 }
 
-func createBlockCreatorsContainer() (notifier.BlockContainerHandler, error) {
+func createBlockCreatorsContainer() (outport.BlockContainerHandler, error) {
 	container := block.NewEmptyBlockCreatorsContainer()
 	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
 	if err != nil {
@@ -5,8 +5,11 @@ import (
 	"time"
 
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/journal"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 // OutportFactoryArgs holds the factory arguments of different outport drivers
@@ -17,6 +20,16 @@ type OutportFactoryArgs struct {
 	ElasticIndexerFactoryArgs indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs  *EventNotifierFactoryArgs
 	HostDriversArgs           []ArgsHostDriverFactory
+	JournalArgs               *OutportJournalFactoryArgs
+}
+
+// OutportJournalFactoryArgs holds the arguments needed to back the outport with a persistent journal
+type OutportJournalFactoryArgs struct {
+	Enabled            bool
+	Storer             storage.Storer
+	Marshaller         marshal.Marshalizer
+	MaxBacklog         uint64
+	NumRetainedEntries uint64
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -31,7 +44,7 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 		IsInImportDBMode: args.IsImportDB,
 	}
 
-	outportHandler, err := outport.NewOutport(args.RetrialInterval, cfg)
+	outportHandler, err := createOutportHandler(args, cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +57,35 @@ func CreateOutport(args *OutportFactoryArgs) (outport.OutportHandler, error) {
 	return outportHandler, nil
 }
 
+func createOutportHandler(args *OutportFactoryArgs, cfg outportcore.OutportConfig) (outport.OutportHandler, error) {
+	if args.JournalArgs == nil || !args.JournalArgs.Enabled {
+		return outport.NewOutport(args.RetrialInterval, cfg)
+	}
+
+	outportJournal, err := journal.NewJournal(journal.ArgsJournal{
+		Storer:     args.JournalArgs.Storer,
+		Marshaller: args.JournalArgs.Marshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return outport.NewJournaledOutport(outport.ArgsJournaledOutport{
+		RetrialInterval:    args.RetrialInterval,
+		Config:             cfg,
+		Journal:            outportJournal,
+		Marshaller:         args.JournalArgs.Marshaller,
+		BlockContainer:     blockContainer,
+		MaxBacklog:         args.JournalArgs.MaxBacklog,
+		NumRetainedEntries: args.JournalArgs.NumRetainedEntries,
+	})
+}
+
 func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFactoryArgs) error {
 	err := createAndSubscribeElasticDriverIfNeeded(outport, args.ElasticIndexerFactoryArgs)
 	if err != nil {
@@ -10,8 +10,10 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/journal"
 	notifierFactory "github.com/multiversx/mx-chain-go/outport/factory"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-storage-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
@@ -161,6 +163,40 @@ func TestCreateOutport_SubscribeMultipleHostDrivers(t *testing.T) {
 	require.True(t, outPort.HasDrivers())
 }
 
+func TestCreateOutport_WithJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid journal args should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportHandler(false, false)
+		args.JournalArgs = &factory.OutportJournalFactoryArgs{
+			Enabled:    true,
+			Marshaller: &mock.MarshalizerMock{},
+		}
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, outPort)
+		require.Equal(t, journal.ErrNilStorer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOutportHandler(false, false)
+		args.JournalArgs = &factory.OutportJournalFactoryArgs{
+			Enabled:    true,
+			Storer:     genericMocks.NewStorerMock(),
+			Marshaller: &mock.MarshalizerMock{},
+			MaxBacklog: 10,
+		}
+		outPort, err := factory.CreateOutport(args)
+		require.Nil(t, err)
+
+		_, isJournaled := outPort.(outport.JournalReplayHandler)
+		require.True(t, isJournaled)
+		_ = outPort.Close()
+	})
+}
+
 func TestCreateAndSubscribeDriversShouldReturnError(t *testing.T) {
 	args := &factory.OutportFactoryArgs{
 		RetrialInterval: time.Second,
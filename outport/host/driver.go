@@ -15,9 +15,12 @@ type ArgsHostDriver struct {
 	Marshaller marshal.Marshalizer
 	SenderHost SenderHost
 	Log        core.Logger
+	// Name identifies the driver among the other host drivers, e.g. by the URL it connects to
+	Name string
 }
 
 type hostDriver struct {
+	name        string
 	marshaller  marshal.Marshalizer
 	senderHost  SenderHost
 	isClosed    atomic.Flag
@@ -48,6 +51,7 @@ func NewHostDriver(args ArgsHostDriver) (*hostDriver, error) {
 	}
 
 	return &hostDriver{
+		name:        args.Name,
 		marshaller:  args.Marshaller,
 		senderHost:  args.SenderHost,
 		log:         args.Log,
@@ -56,6 +60,11 @@ func NewHostDriver(args ArgsHostDriver) (*hostDriver, error) {
 	}, nil
 }
 
+// GetName returns the name identifying the driver among the other host drivers
+func (o *hostDriver) GetName() string {
+	return o.name
+}
+
 // SaveBlock will handle the saving of block
 func (o *hostDriver) SaveBlock(outportBlock *outport.OutportBlock) error {
 	return o.handleAction(outportBlock, outport.TopicSaveBlock)
@@ -54,10 +54,12 @@ func TestNewWebsocketOutportDriverNodePart(t *testing.T) {
 		t.Parallel()
 
 		args := getMockArgs()
+		args.Name = "ws://localhost:22111"
 
 		o, err := NewHostDriver(args)
 		require.NotNil(t, o)
 		require.NoError(t, err)
+		require.Equal(t, "ws://localhost:22111", o.GetName())
 		require.False(t, o.IsInterfaceNil())
 	})
 }
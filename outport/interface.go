@@ -1,8 +1,12 @@
 package outport
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/outport/journal"
 	"github.com/multiversx/mx-chain-go/outport/process"
 )
 
@@ -23,6 +27,12 @@ type Driver interface {
 	IsInterfaceNil() bool
 }
 
+// namedDriver is implemented by the drivers that can be subscribed several times with the same type, such as the host
+// drivers, their name being derived from their config
+type namedDriver interface {
+	GetName() string
+}
+
 // OutportHandler is interface that defines what a proxy implementation should be able to do
 // The node is able to talk only with this interface
 type OutportHandler interface {
@@ -44,3 +54,36 @@ type DataProviderOutport interface {
 	PrepareOutportSaveBlockData(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.OutportBlockWithHeaderAndBody, error)
 	IsInterfaceNil() bool
 }
+
+// Journal defines the operations of a persistent outport journal
+type Journal interface {
+	Append(topic string, payload []byte) (uint64, error)
+	Get(sequence uint64) (*journal.JournalEntry, error)
+	FirstSequence() uint64
+	LastSequence() uint64
+	GetCursor(driverID string) (uint64, bool, error)
+	SetCursor(driverID string, sequence uint64) error
+	RemoveUpTo(sequence uint64) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// JournalReplayHandler defines what a journaled outport exposes to reconnecting consumers
+type JournalReplayHandler interface {
+	ReplayFrom(driverID string, sequence uint64) error
+	GetDriversCursors() map[string]uint64
+	IsInterfaceNil() bool
+}
+
+// BlockContainerHandler defines what a block container should be able to do
+type BlockContainerHandler interface {
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+	IsInterfaceNil() bool
+}
+
+// EventsSubscriptionHandler defines what an events hub exposes to the API subscribers
+type EventsSubscriptionHandler interface {
+	Subscribe(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	Unsubscribe(subscriptionID uint64)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,15 @@
+package journal
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrSequenceNotAvailable signals that the requested sequence number is not (or no longer) available in the journal
+var ErrSequenceNotAvailable = errors.New("sequence not available in the outport journal")
+
+// ErrCorruptedJournal signals that the journal metadata could not be decoded
+var ErrCorruptedJournal = errors.New("corrupted outport journal")
+
+// ErrInvalidCursor signals that an invalid driver cursor was found in the journal
+var ErrInvalidCursor = errors.New("invalid driver cursor")
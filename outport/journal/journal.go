@@ -0,0 +1,230 @@
+package journal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/journal")
+
+const (
+	lastSequenceKey  = "lastSequence"
+	firstSequenceKey = "firstSequence"
+	entryKeyPrefix   = "entry_"
+	cursorKeyPrefix  = "cursor_"
+)
+
+// ArgsJournal holds the arguments needed to create a new outport journal
+type ArgsJournal struct {
+	Storer     storage.Storer
+	Marshaller marshal.Marshalizer
+}
+
+type journal struct {
+	storer        storage.Storer
+	marshaller    marshal.Marshalizer
+	mut           sync.RWMutex
+	firstSequence uint64
+	lastSequence  uint64
+}
+
+// NewJournal creates a new outport journal, loading the sequence boundaries from the provided storer
+func NewJournal(args ArgsJournal) (*journal, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	j := &journal{
+		storer:     args.Storer,
+		marshaller: args.Marshaller,
+	}
+
+	var err error
+	j.lastSequence, err = j.loadUint64(lastSequenceKey, 0)
+	if err != nil {
+		return nil, err
+	}
+	j.firstSequence, err = j.loadUint64(firstSequenceKey, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("outport journal loaded", "first sequence", j.firstSequence, "last sequence", j.lastSequence)
+
+	return j, nil
+}
+
+// Append persists a new entry in the journal and returns its sequence number
+func (j *journal) Append(topic string, payload []byte) (uint64, error) {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+
+	sequence := j.lastSequence + 1
+	entry := &JournalEntry{
+		Sequence: sequence,
+		Topic:    topic,
+		Payload:  payload,
+	}
+	entryBytes, err := j.marshaller.Marshal(entry)
+	if err != nil {
+		return 0, err
+	}
+
+	err = j.storer.Put(createEntryKey(sequence), entryBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	err = j.storer.Put([]byte(lastSequenceKey), uint64ToBytes(sequence))
+	if err != nil {
+		return 0, err
+	}
+
+	j.lastSequence = sequence
+
+	return sequence, nil
+}
+
+// Get returns the entry stored under the provided sequence number
+func (j *journal) Get(sequence uint64) (*JournalEntry, error) {
+	j.mut.RLock()
+	defer j.mut.RUnlock()
+
+	if sequence < j.firstSequence || sequence > j.lastSequence {
+		return nil, fmt.Errorf("%w: %d, available interval [%d, %d]", ErrSequenceNotAvailable, sequence, j.firstSequence, j.lastSequence)
+	}
+
+	entryBytes, err := j.storer.Get(createEntryKey(sequence))
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &JournalEntry{}
+	err = j.marshaller.Unmarshal(entry, entryBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// FirstSequence returns the sequence number of the oldest entry still retained in the journal
+func (j *journal) FirstSequence() uint64 {
+	j.mut.RLock()
+	defer j.mut.RUnlock()
+
+	return j.firstSequence
+}
+
+// LastSequence returns the sequence number of the newest entry in the journal, 0 if nothing was ever appended
+func (j *journal) LastSequence() uint64 {
+	j.mut.RLock()
+	defer j.mut.RUnlock()
+
+	return j.lastSequence
+}
+
+// GetCursor returns the last sequence number acknowledged by the provided driver.
+// The second returned value is false if no cursor was ever saved for the driver
+func (j *journal) GetCursor(driverID string) (uint64, bool, error) {
+	key := createCursorKey(driverID)
+	if j.storer.Has(key) != nil {
+		return 0, false, nil
+	}
+
+	cursorBytes, err := j.storer.Get(key)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(cursorBytes) != 8 {
+		return 0, false, fmt.Errorf("%w for driver %s", ErrInvalidCursor, driverID)
+	}
+
+	return binary.BigEndian.Uint64(cursorBytes), true, nil
+}
+
+// SetCursor persists the last sequence number acknowledged by the provided driver
+func (j *journal) SetCursor(driverID string, sequence uint64) error {
+	return j.storer.Put(createCursorKey(driverID), uint64ToBytes(sequence))
+}
+
+// RemoveUpTo removes all the entries having the sequence number lower or equal to the provided one
+func (j *journal) RemoveUpTo(sequence uint64) error {
+	j.mut.Lock()
+	defer j.mut.Unlock()
+
+	if sequence > j.lastSequence {
+		sequence = j.lastSequence
+	}
+
+	for seq := j.firstSequence; seq <= sequence; seq++ {
+		err := j.storer.Remove(createEntryKey(seq))
+		if err != nil {
+			log.Debug("journal.RemoveUpTo: cannot remove entry", "sequence", seq, "error", err)
+		}
+	}
+
+	if sequence < j.firstSequence {
+		return nil
+	}
+
+	err := j.storer.Put([]byte(firstSequenceKey), uint64ToBytes(sequence+1))
+	if err != nil {
+		return err
+	}
+
+	j.firstSequence = sequence + 1
+
+	return nil
+}
+
+// Close closes the underlying storer
+func (j *journal) Close() error {
+	return j.storer.Close()
+}
+
+func (j *journal) loadUint64(key string, defaultValue uint64) (uint64, error) {
+	if j.storer.Has([]byte(key)) != nil {
+		return defaultValue, nil
+	}
+
+	valueBytes, err := j.storer.Get([]byte(key))
+	if err != nil {
+		return 0, err
+	}
+	if len(valueBytes) != 8 {
+		return 0, fmt.Errorf("%w for key %s", ErrCorruptedJournal, key)
+	}
+
+	return binary.BigEndian.Uint64(valueBytes), nil
+}
+
+func createEntryKey(sequence uint64) []byte {
+	return append([]byte(entryKeyPrefix), uint64ToBytes(sequence)...)
+}
+
+func createCursorKey(driverID string) []byte {
+	return []byte(cursorKeyPrefix + driverID)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (j *journal) IsInterfaceNil() bool {
+	return j == nil
+}
@@ -0,0 +1,169 @@
+package journal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsJournal() ArgsJournal {
+	return ArgsJournal{
+		Storer:     testscommon.CreateMemUnit(),
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func TestNewJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournal()
+		args.Storer = nil
+		j, err := NewJournal(args)
+		require.Equal(t, ErrNilStorer, err)
+		require.True(t, check.IfNil(j))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournal()
+		args.Marshaller = nil
+		j, err := NewJournal(args)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(j))
+	})
+	t.Run("corrupted metadata should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournal()
+		_ = args.Storer.Put([]byte(lastSequenceKey), []byte("corrupted"))
+		j, err := NewJournal(args)
+		require.True(t, errors.Is(err, ErrCorruptedJournal))
+		require.True(t, check.IfNil(j))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		j, err := NewJournal(createMockArgsJournal())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(j))
+		require.Equal(t, uint64(1), j.FirstSequence())
+		require.Equal(t, uint64(0), j.LastSequence())
+	})
+}
+
+func TestJournal_AppendAndGet(t *testing.T) {
+	t.Parallel()
+
+	j, _ := NewJournal(createMockArgsJournal())
+
+	seq, err := j.Append("topic1", []byte("payload1"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), seq)
+
+	seq, err = j.Append("topic2", []byte("payload2"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), seq)
+	require.Equal(t, uint64(2), j.LastSequence())
+
+	entry, err := j.Get(2)
+	require.Nil(t, err)
+	require.Equal(t, &JournalEntry{Sequence: 2, Topic: "topic2", Payload: []byte("payload2")}, entry)
+
+	_, err = j.Get(0)
+	require.True(t, errors.Is(err, ErrSequenceNotAvailable))
+
+	_, err = j.Get(3)
+	require.True(t, errors.Is(err, ErrSequenceNotAvailable))
+}
+
+func TestJournal_ShouldReloadFromStorer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsJournal()
+	j, _ := NewJournal(args)
+	_, _ = j.Append("topic", []byte("payload1"))
+	_, _ = j.Append("topic", []byte("payload2"))
+	_, _ = j.Append("topic", []byte("payload3"))
+	_ = j.SetCursor("driver", 2)
+	_ = j.RemoveUpTo(1)
+
+	reloaded, err := NewJournal(args)
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), reloaded.FirstSequence())
+	require.Equal(t, uint64(3), reloaded.LastSequence())
+
+	cursor, found, err := reloaded.GetCursor("driver")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(2), cursor)
+
+	entry, err := reloaded.Get(3)
+	require.Nil(t, err)
+	require.Equal(t, []byte("payload3"), entry.Payload)
+
+	seq, err := reloaded.Append("topic", []byte("payload4"))
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), seq)
+}
+
+func TestJournal_GetCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing cursor should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		j, _ := NewJournal(createMockArgsJournal())
+		cursor, found, err := j.GetCursor("driver")
+		require.Nil(t, err)
+		require.False(t, found)
+		require.Equal(t, uint64(0), cursor)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournal()
+		_ = args.Storer.Put(createCursorKey("driver"), []byte("invalid"))
+		j, _ := NewJournal(args)
+		_, _, err := j.GetCursor("driver")
+		require.True(t, errors.Is(err, ErrInvalidCursor))
+	})
+}
+
+func TestJournal_RemoveUpTo(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsJournal()
+	j, _ := NewJournal(args)
+	for i := 0; i < 5; i++ {
+		_, _ = j.Append("topic", []byte("payload"))
+	}
+
+	err := j.RemoveUpTo(3)
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), j.FirstSequence())
+	require.NotNil(t, args.Storer.Has(createEntryKey(1)))
+	require.NotNil(t, args.Storer.Has(createEntryKey(3)))
+	require.Nil(t, args.Storer.Has(createEntryKey(4)))
+
+	_, err = j.Get(3)
+	require.True(t, errors.Is(err, ErrSequenceNotAvailable))
+
+	// removing beyond the last sequence is capped
+	err = j.RemoveUpTo(100)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), j.FirstSequence())
+	require.Equal(t, uint64(5), j.LastSequence())
+
+	// removing an already removed interval is a no-op
+	err = j.RemoveUpTo(2)
+	require.Nil(t, err)
+	require.Equal(t, uint64(6), j.FirstSequence())
+}
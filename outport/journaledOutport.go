@@ -0,0 +1,521 @@
+package outport
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/outport/journal"
+)
+
+// ArgsJournaledOutport holds the arguments needed to create a journaled outport
+type ArgsJournaledOutport struct {
+	RetrialInterval    time.Duration
+	Config             outportcore.OutportConfig
+	Journal            Journal
+	Marshaller         marshal.Marshalizer
+	BlockContainer     BlockContainerHandler
+	MaxBacklog         uint64
+	NumRetainedEntries uint64
+}
+
+// driverDelivery holds the acknowledged cursor of a driver. The generation is incremented by each replay request, so an
+// entry delivered before the replay is not acknowledged over the rewound cursor
+type driverDelivery struct {
+	id               string
+	driver           Driver
+	mutCursor        sync.RWMutex
+	cursor           uint64
+	generation       uint64
+	chanNewEntry     chan struct{}
+	chanAcknowledged chan struct{}
+}
+
+func (dd *driverDelivery) getCursor() uint64 {
+	dd.mutCursor.RLock()
+	defer dd.mutCursor.RUnlock()
+
+	return dd.cursor
+}
+
+func (dd *driverDelivery) getCursorAndGeneration() (uint64, uint64) {
+	dd.mutCursor.RLock()
+	defer dd.mutCursor.RUnlock()
+
+	return dd.cursor, dd.generation
+}
+
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// journaledOutport is an outport that persists the SaveBlock, RevertIndexedBlock and FinalizedBlock events
+// in a journal before delivering them asynchronously to each driver. Each driver has its own acknowledged cursor,
+// so undelivered events survive a node restart and a slow driver only blocks the processing once its backlog
+// exceeds the configured maximum. The other events are delivered synchronously, as in the plain outport.
+type journaledOutport struct {
+	*outport
+	journal            Journal
+	marshaller         marshal.Marshalizer
+	blockContainer     BlockContainerHandler
+	maxBacklog         uint64
+	numRetainedEntries uint64
+	mutDeliveries      sync.RWMutex
+	deliveries         []*driverDelivery
+}
+
+// NewJournaledOutport will create a new instance of an outport backed by a persistent journal
+func NewJournaledOutport(args ArgsJournaledOutport) (*journaledOutport, error) {
+	if check.IfNil(args.Journal) {
+		return nil, ErrNilJournal
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.BlockContainer) {
+		return nil, ErrNilBlockContainer
+	}
+
+	baseOutport, err := NewOutport(args.RetrialInterval, args.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &journaledOutport{
+		outport:            baseOutport,
+		journal:            args.Journal,
+		marshaller:         args.Marshaller,
+		blockContainer:     args.BlockContainer,
+		maxBacklog:         args.MaxBacklog,
+		numRetainedEntries: args.NumRetainedEntries,
+		deliveries:         make([]*driverDelivery, 0),
+	}, nil
+}
+
+// SaveBlock will persist the block in the journal and schedule it for every driver
+func (jo *journaledOutport) SaveBlock(args *outportcore.OutportBlockWithHeaderAndBody) error {
+	if args == nil {
+		return fmt.Errorf("outport.SaveBlock error: %w", errNilSaveBlockArgs)
+	}
+
+	blockData, err := prepareBlockData(args.HeaderDataWithBody, jo.marshaller)
+	if err != nil {
+		return err
+	}
+
+	args.OutportBlock.BlockData = blockData
+	payload, err := jo.marshaller.Marshal(args.OutportBlock)
+	if err != nil {
+		return err
+	}
+
+	return jo.appendAndSchedule(outportcore.TopicSaveBlock, payload)
+}
+
+// RevertIndexedBlock will persist the revert event in the journal and schedule it for every driver
+func (jo *journaledOutport) RevertIndexedBlock(headerDataWithBody *outportcore.HeaderDataWithBody) error {
+	blockData, err := prepareBlockData(headerDataWithBody, jo.marshaller)
+	if err != nil {
+		return err
+	}
+
+	payload, err := jo.marshaller.Marshal(blockData)
+	if err != nil {
+		return err
+	}
+
+	return jo.appendAndSchedule(outportcore.TopicRevertIndexedBlock, payload)
+}
+
+// FinalizedBlock will persist the finalized block event in the journal and schedule it for every driver
+func (jo *journaledOutport) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) {
+	payload, err := jo.marshaller.Marshal(finalizedBlock)
+	if err != nil {
+		log.Error("journaledOutport.FinalizedBlock: cannot marshal finalized block", "error", err)
+		return
+	}
+
+	err = jo.appendAndSchedule(outportcore.TopicFinalizedBlock, payload)
+	if err != nil {
+		log.Error("journaledOutport.FinalizedBlock: cannot append to journal", "error", err)
+	}
+}
+
+func (jo *journaledOutport) appendAndSchedule(topic string, payload []byte) error {
+	sequence, err := jo.journal.Append(topic, payload)
+	if err != nil {
+		return fmt.Errorf("%w while appending %s to the outport journal", err, topic)
+	}
+
+	deliveries := jo.getDeliveries()
+	for _, delivery := range deliveries {
+		signal(delivery.chanNewEntry)
+	}
+
+	for _, delivery := range deliveries {
+		jo.waitBacklog(delivery, sequence)
+	}
+
+	return nil
+}
+
+func (jo *journaledOutport) waitBacklog(delivery *driverDelivery, lastSequence uint64) {
+	for {
+		cursor := delivery.getCursor()
+		if cursor >= lastSequence {
+			return
+		}
+		backlog := lastSequence - cursor
+		if backlog <= jo.maxBacklog {
+			return
+		}
+
+		log.Debug("journaledOutport.waitBacklog: driver backlog is full, waiting",
+			"driver", delivery.id, "backlog", backlog, "max backlog", jo.maxBacklog)
+
+		select {
+		case <-delivery.chanAcknowledged:
+		case <-jo.chanClose:
+			return
+		}
+	}
+}
+
+// SubscribeDriver can subscribe a driver to the outport. The driver will resume from its last acknowledged
+// sequence number, if any was persisted in the journal under its identifier
+func (jo *journaledOutport) SubscribeDriver(driver Driver) error {
+	if check.IfNil(driver) {
+		return ErrNilDriver
+	}
+
+	jo.mutDeliveries.Lock()
+	defer jo.mutDeliveries.Unlock()
+
+	driverID := createDriverID(driver)
+	for _, delivery := range jo.deliveries {
+		if delivery.id == driverID {
+			return fmt.Errorf("%w: %s", ErrDuplicatedDriverID, driverID)
+		}
+	}
+
+	err := jo.outport.SubscribeDriver(driver)
+	if err != nil {
+		return err
+	}
+
+	cursor, err := jo.loadCursor(driverID)
+	if err != nil {
+		return err
+	}
+
+	delivery := &driverDelivery{
+		id:               driverID,
+		driver:           driver,
+		cursor:           cursor,
+		chanNewEntry:     make(chan struct{}, 1),
+		chanAcknowledged: make(chan struct{}, 1),
+	}
+	jo.deliveries = append(jo.deliveries, delivery)
+
+	log.Debug("journaledOutport.SubscribeDriver", "driver", driverID, "cursor", cursor,
+		"last sequence", jo.journal.LastSequence())
+
+	go jo.deliverEntries(delivery)
+
+	return nil
+}
+
+// createDriverID returns the identifier of the driver's cursor, derived from the driver type and, for the drivers that can
+// be subscribed several times, from the name given by their config, so it does not depend on the subscription order
+func createDriverID(driver Driver) string {
+	driverID := driverString(driver)
+	named, isNamed := driver.(namedDriver)
+	if isNamed && len(named.GetName()) > 0 {
+		driverID = fmt.Sprintf("%s_%s", driverID, named.GetName())
+	}
+
+	return driverID
+}
+
+func (jo *journaledOutport) loadCursor(driverID string) (uint64, error) {
+	cursor, found, err := jo.journal.GetCursor(driverID)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		// a newly added driver only receives the events produced from now on
+		cursor = jo.journal.LastSequence()
+		return cursor, jo.journal.SetCursor(driverID, cursor)
+	}
+
+	firstSequence := jo.journal.FirstSequence()
+	if cursor+1 < firstSequence {
+		log.Warn("journaledOutport: driver cursor points to already removed entries, skipping them",
+			"driver", driverID, "cursor", cursor, "first available sequence", firstSequence)
+		cursor = firstSequence - 1
+	}
+
+	return cursor, nil
+}
+
+func (jo *journaledOutport) deliverEntries(delivery *driverDelivery) {
+	for {
+		for delivery.getCursor() < jo.journal.LastSequence() {
+			cursor, generation := delivery.getCursorAndGeneration()
+			sequence := cursor + 1
+			entry, err := jo.journal.Get(sequence)
+			if errors.Is(err, journal.ErrSequenceNotAvailable) {
+				log.Warn("journaledOutport.deliverEntries: entry not available, skipping",
+					"driver", delivery.id, "sequence", sequence, "error", err)
+				jo.acknowledge(delivery, sequence, generation)
+				continue
+			}
+			if err != nil {
+				log.Error("journaledOutport.deliverEntries: cannot read entry, will retry",
+					"driver", delivery.id, "sequence", sequence, "error", err)
+				if jo.shouldTerminate() {
+					return
+				}
+				continue
+			}
+
+			delivered := jo.deliverEntryBlocking(entry, delivery.driver)
+			if !delivered {
+				return
+			}
+
+			jo.acknowledge(delivery, sequence, generation)
+		}
+
+		select {
+		case <-delivery.chanNewEntry:
+		case <-jo.chanClose:
+			return
+		}
+	}
+}
+
+func (jo *journaledOutport) deliverEntryBlocking(entry *journal.JournalEntry, driver Driver) bool {
+	ch := jo.monitorCompletionOnDriver("deliverEntryBlocking", driver)
+	defer close(ch)
+
+	for {
+		err := jo.deliverEntry(entry, driver)
+		if err == nil {
+			return true
+		}
+
+		log.Error("error delivering journal entry, will retry",
+			"driver", driverString(driver),
+			"topic", entry.Topic,
+			"sequence", entry.Sequence,
+			"retrial in", jo.retrialInterval,
+			"error", err)
+
+		countDriverCallError("deliverEntryBlocking", driver)
+
+		if jo.shouldTerminate() {
+			return false
+		}
+	}
+}
+
+func (jo *journaledOutport) deliverEntry(entry *journal.JournalEntry, driver Driver) error {
+	switch entry.Topic {
+	case outportcore.TopicSaveBlock:
+		outportBlock := &outportcore.OutportBlock{}
+		err := jo.marshaller.Unmarshal(outportBlock, entry.Payload)
+		if err != nil {
+			return err
+		}
+
+		err = jo.convertHeaderBytes(outportBlock.BlockData, driver.GetMarshaller())
+		if err != nil {
+			return err
+		}
+
+		return driver.SaveBlock(outportBlock)
+	case outportcore.TopicRevertIndexedBlock:
+		blockData := &outportcore.BlockData{}
+		err := jo.marshaller.Unmarshal(blockData, entry.Payload)
+		if err != nil {
+			return err
+		}
+
+		err = jo.convertHeaderBytes(blockData, driver.GetMarshaller())
+		if err != nil {
+			return err
+		}
+
+		return driver.RevertIndexedBlock(blockData)
+	case outportcore.TopicFinalizedBlock:
+		finalizedBlock := &outportcore.FinalizedBlock{}
+		err := jo.marshaller.Unmarshal(finalizedBlock, entry.Payload)
+		if err != nil {
+			return err
+		}
+
+		return driver.FinalizedBlock(finalizedBlock)
+	default:
+		log.Warn("journaledOutport.deliverEntry: unknown topic, skipping", "topic", entry.Topic, "sequence", entry.Sequence)
+		return nil
+	}
+}
+
+// convertHeaderBytes re-encodes the header, stored with the journal marshaller, using the driver's marshaller
+func (jo *journaledOutport) convertHeaderBytes(blockData *outportcore.BlockData, driverMarshaller marshal.Marshalizer) error {
+	if blockData == nil {
+		return errNilHeaderAndBodyArgs
+	}
+
+	creator, err := jo.blockContainer.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return err
+	}
+
+	header, err := block.GetHeaderFromBytes(jo.marshaller, creator, blockData.HeaderBytes)
+	if err != nil {
+		return err
+	}
+
+	blockData.HeaderBytes, err = driverMarshaller.Marshal(header)
+
+	return err
+}
+
+// acknowledge moves the cursor of the driver to the delivered sequence, unless a replay request was received since the
+// entry was read. The cursor is persisted under the same lock as the replay requests, so the stored cursor is the last one
+func (jo *journaledOutport) acknowledge(delivery *driverDelivery, sequence uint64, generation uint64) {
+	delivery.mutCursor.Lock()
+	if delivery.generation != generation {
+		delivery.mutCursor.Unlock()
+		return
+	}
+	delivery.cursor = sequence
+	err := jo.journal.SetCursor(delivery.id, sequence)
+	delivery.mutCursor.Unlock()
+	if err != nil {
+		log.Warn("journaledOutport.acknowledge: cannot persist cursor", "driver", delivery.id, "sequence", sequence, "error", err)
+	}
+
+	signal(delivery.chanAcknowledged)
+	jo.removeAcknowledgedEntries()
+}
+
+func (jo *journaledOutport) removeAcknowledgedEntries() {
+	deliveries := jo.getDeliveries()
+	if len(deliveries) == 0 {
+		return
+	}
+
+	minCursor := deliveries[0].getCursor()
+	for _, delivery := range deliveries[1:] {
+		cursor := delivery.getCursor()
+		if cursor < minCursor {
+			minCursor = cursor
+		}
+	}
+	if minCursor <= jo.numRetainedEntries {
+		return
+	}
+
+	upToSequence := minCursor - jo.numRetainedEntries
+	if upToSequence < jo.journal.FirstSequence() {
+		return
+	}
+
+	err := jo.journal.RemoveUpTo(upToSequence)
+	if err != nil {
+		log.Warn("journaledOutport.removeAcknowledgedEntries", "up to sequence", upToSequence, "error", err)
+	}
+}
+
+// ReplayFrom will rewind the cursor of the provided driver so the journal entries will be delivered again
+// starting with the provided sequence number
+func (jo *journaledOutport) ReplayFrom(driverID string, sequence uint64) error {
+	firstSequence := jo.journal.FirstSequence()
+	lastSequence := jo.journal.LastSequence()
+	if sequence < firstSequence || sequence > lastSequence+1 {
+		return fmt.Errorf("%w: %d, available interval [%d, %d]", journal.ErrSequenceNotAvailable, sequence, firstSequence, lastSequence)
+	}
+
+	delivery := jo.getDelivery(driverID)
+	if delivery == nil {
+		return fmt.Errorf("%w: %s", ErrUnknownDriver, driverID)
+	}
+
+	delivery.mutCursor.Lock()
+	delivery.cursor = sequence - 1
+	delivery.generation++
+	err := jo.journal.SetCursor(driverID, sequence-1)
+	delivery.mutCursor.Unlock()
+	if err != nil {
+		return err
+	}
+
+	log.Debug("journaledOutport.ReplayFrom", "driver", driverID, "sequence", sequence)
+	signal(delivery.chanNewEntry)
+
+	return nil
+}
+
+// GetDriversCursors returns the last acknowledged sequence number for each subscribed driver
+func (jo *journaledOutport) GetDriversCursors() map[string]uint64 {
+	deliveries := jo.getDeliveries()
+	cursors := make(map[string]uint64, len(deliveries))
+	for _, delivery := range deliveries {
+		cursors[delivery.id] = delivery.getCursor()
+	}
+
+	return cursors
+}
+
+func (jo *journaledOutport) getDeliveries() []*driverDelivery {
+	jo.mutDeliveries.RLock()
+	defer jo.mutDeliveries.RUnlock()
+
+	deliveries := make([]*driverDelivery, len(jo.deliveries))
+	copy(deliveries, jo.deliveries)
+
+	return deliveries
+}
+
+func (jo *journaledOutport) getDelivery(driverID string) *driverDelivery {
+	jo.mutDeliveries.RLock()
+	defer jo.mutDeliveries.RUnlock()
+
+	for _, delivery := range jo.deliveries {
+		if delivery.id == driverID {
+			return delivery
+		}
+	}
+
+	return nil
+}
+
+// Close will close all the drivers and the journal
+func (jo *journaledOutport) Close() error {
+	err := jo.outport.Close()
+
+	errClose := jo.journal.Close()
+	if errClose != nil {
+		log.Error("cannot close outport journal", "error", errClose.Error())
+		err = errClose
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jo *journaledOutport) IsInterfaceNil() bool {
+	return jo == nil
+}
@@ -0,0 +1,454 @@
+package outport
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/outport/journal"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+const testDriverID = "*mock.DriverStub"
+
+type nonClosingStorer struct {
+	storage.Storer
+}
+
+// Close -
+func (ncs *nonClosingStorer) Close() error {
+	return nil
+}
+
+func createBlockContainer() BlockContainerHandler {
+	container := block.NewEmptyBlockCreatorsContainer()
+	_ = container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	_ = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	_ = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+
+	return container
+}
+
+func createMockArgsJournaledOutport(storer storage.Storer) ArgsJournaledOutport {
+	outportJournal, _ := journal.NewJournal(journal.ArgsJournal{
+		Storer:     storer,
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+	})
+
+	return ArgsJournaledOutport{
+		RetrialInterval:    minimumRetrialInterval,
+		Journal:            outportJournal,
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		BlockContainer:     createBlockContainer(),
+		MaxBacklog:         10,
+		NumRetainedEntries: 0,
+	}
+}
+
+func createJournaledSaveBlockArgs(nonce uint64) *outportcore.OutportBlockWithHeaderAndBody {
+	return &outportcore.OutportBlockWithHeaderAndBody{
+		OutportBlock: &outportcore.OutportBlock{
+			HighestFinalBlockNonce: nonce,
+		},
+		HeaderDataWithBody: &outportcore.HeaderDataWithBody{
+			Body:       &block.Body{},
+			Header:     &block.HeaderV2{Header: &block.Header{Nonce: nonce}},
+			HeaderHash: []byte("hash"),
+		},
+	}
+}
+
+func TestNewJournaledOutport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil journal should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+		args.Journal = nil
+		jo, err := NewJournaledOutport(args)
+		require.Equal(t, ErrNilJournal, err)
+		require.True(t, check.IfNil(jo))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+		args.Marshaller = nil
+		jo, err := NewJournaledOutport(args)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(jo))
+	})
+	t.Run("nil block container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+		args.BlockContainer = nil
+		jo, err := NewJournaledOutport(args)
+		require.Equal(t, ErrNilBlockContainer, err)
+		require.True(t, check.IfNil(jo))
+	})
+	t.Run("invalid retrial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+		args.RetrialInterval = 0
+		jo, err := NewJournaledOutport(args)
+		require.True(t, errors.Is(err, ErrInvalidRetrialInterval))
+		require.True(t, check.IfNil(jo))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		jo, err := NewJournaledOutport(createMockArgsJournaledOutport(testscommon.CreateMemUnit()))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(jo))
+	})
+}
+
+func TestJournaledOutport_SaveBlockShouldDeliverWithDriverMarshaller(t *testing.T) {
+	t.Parallel()
+
+	chanDelivered := make(chan *outportcore.OutportBlock, 1)
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			chanDelivered <- outportBlock
+			return nil
+		},
+	}
+
+	jo, _ := NewJournaledOutport(createMockArgsJournaledOutport(testscommon.CreateMemUnit()))
+	require.Nil(t, jo.SubscribeDriver(driver))
+
+	err := jo.SaveBlock(createJournaledSaveBlockArgs(7))
+	require.Nil(t, err)
+
+	select {
+	case delivered := <-chanDelivered:
+		require.Equal(t, uint64(7), delivered.HighestFinalBlockNonce)
+		require.Equal(t, []byte("hash"), delivered.BlockData.HeaderHash)
+
+		header := &block.HeaderV2{}
+		err = (&marshallerMock.MarshalizerMock{}).Unmarshal(header, delivered.BlockData.HeaderBytes)
+		require.Nil(t, err)
+		require.Equal(t, uint64(7), header.GetNonce())
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for the block to be delivered")
+	}
+
+	_ = jo.Close()
+}
+
+func TestJournaledOutport_RevertAndFinalizedShouldBeDeliveredInOrder(t *testing.T) {
+	t.Parallel()
+
+	mutTopics := sync.Mutex{}
+	topics := make([]string, 0)
+	chanDone := make(chan struct{})
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			mutTopics.Lock()
+			topics = append(topics, outportcore.TopicSaveBlock)
+			mutTopics.Unlock()
+			return nil
+		},
+		RevertIndexedBlockCalled: func(blockData *outportcore.BlockData) error {
+			mutTopics.Lock()
+			topics = append(topics, outportcore.TopicRevertIndexedBlock)
+			mutTopics.Unlock()
+			return nil
+		},
+		FinalizedBlockCalled: func(finalizedBlock *outportcore.FinalizedBlock) error {
+			mutTopics.Lock()
+			topics = append(topics, outportcore.TopicFinalizedBlock)
+			mutTopics.Unlock()
+			close(chanDone)
+			return nil
+		},
+	}
+
+	jo, _ := NewJournaledOutport(createMockArgsJournaledOutport(testscommon.CreateMemUnit()))
+	_ = jo.SubscribeDriver(driver)
+
+	saveBlockArgs := createJournaledSaveBlockArgs(1)
+	_ = jo.SaveBlock(saveBlockArgs)
+	_ = jo.RevertIndexedBlock(saveBlockArgs.HeaderDataWithBody)
+	jo.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("hash")})
+
+	select {
+	case <-chanDone:
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout waiting for the entries to be delivered")
+	}
+
+	mutTopics.Lock()
+	require.Equal(t, []string{outportcore.TopicSaveBlock, outportcore.TopicRevertIndexedBlock, outportcore.TopicFinalizedBlock}, topics)
+	mutTopics.Unlock()
+
+	require.Equal(t, map[string]uint64{testDriverID: 3}, jo.GetDriversCursors())
+	// all acknowledged entries are removed when no entries are retained
+	require.Equal(t, uint64(4), jo.journal.FirstSequence())
+
+	_ = jo.Close()
+}
+
+func TestJournaledOutport_ShouldResumeAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	storer := &nonClosingStorer{Storer: testscommon.CreateMemUnit()}
+	failingDriver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			return errors.New("consumer offline")
+		},
+	}
+
+	jo, _ := NewJournaledOutport(createMockArgsJournaledOutport(storer))
+	_ = jo.SubscribeDriver(failingDriver)
+	_ = jo.SaveBlock(createJournaledSaveBlockArgs(1))
+	_ = jo.SaveBlock(createJournaledSaveBlockArgs(2))
+	_ = jo.Close()
+
+	chanDelivered := make(chan uint64, 2)
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			chanDelivered <- outportBlock.HighestFinalBlockNonce
+			return nil
+		},
+	}
+
+	restarted, _ := NewJournaledOutport(createMockArgsJournaledOutport(storer))
+	_ = restarted.SubscribeDriver(driver)
+
+	for _, expectedNonce := range []uint64{1, 2} {
+		select {
+		case nonce := <-chanDelivered:
+			require.Equal(t, expectedNonce, nonce)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout waiting for the pending blocks to be delivered")
+		}
+	}
+
+	_ = restarted.Close()
+}
+
+func TestJournaledOutport_ShouldBlockWhenBacklogIsFull(t *testing.T) {
+	t.Parallel()
+
+	chanRelease := make(chan struct{})
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			<-chanRelease
+			return nil
+		},
+	}
+
+	args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+	args.MaxBacklog = 1
+	jo, _ := NewJournaledOutport(args)
+	_ = jo.SubscribeDriver(driver)
+
+	_ = jo.SaveBlock(createJournaledSaveBlockArgs(1))
+
+	chanSaved := make(chan struct{})
+	go func() {
+		_ = jo.SaveBlock(createJournaledSaveBlockArgs(2))
+		close(chanSaved)
+	}()
+
+	select {
+	case <-chanSaved:
+		require.Fail(t, "should have blocked while the backlog is full")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	close(chanRelease)
+	select {
+	case <-chanSaved:
+	case <-time.After(time.Second):
+		require.Fail(t, "should have unblocked after the driver acknowledged")
+	}
+
+	_ = jo.Close()
+}
+
+func TestJournaledOutport_ReplayFrom(t *testing.T) {
+	t.Parallel()
+
+	chanDelivered := make(chan uint64, 10)
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			chanDelivered <- outportBlock.HighestFinalBlockNonce
+			return nil
+		},
+	}
+
+	args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+	args.NumRetainedEntries = 10
+	jo, _ := NewJournaledOutport(args)
+	_ = jo.SubscribeDriver(driver)
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		_ = jo.SaveBlock(createJournaledSaveBlockArgs(nonce))
+		<-chanDelivered
+	}
+
+	err := jo.ReplayFrom("unknown", 1)
+	require.True(t, errors.Is(err, ErrUnknownDriver))
+
+	err = jo.ReplayFrom(testDriverID, 5)
+	require.True(t, errors.Is(err, journal.ErrSequenceNotAvailable))
+
+	err = jo.ReplayFrom(testDriverID, 2)
+	require.Nil(t, err)
+
+	for _, expectedNonce := range []uint64{2, 3} {
+		select {
+		case nonce := <-chanDelivered:
+			require.Equal(t, expectedNonce, nonce)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout waiting for the replayed blocks")
+		}
+	}
+
+	_ = jo.Close()
+}
+
+func TestJournaledOutport_ReplayFromDriverCursorAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	storer := &nonClosingStorer{Storer: testscommon.CreateMemUnit()}
+	chanDelivered := make(chan uint64, 10)
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			chanDelivered <- outportBlock.HighestFinalBlockNonce
+			return nil
+		},
+	}
+	waitDelivered := func(expectedNonces ...uint64) {
+		for _, expectedNonce := range expectedNonces {
+			select {
+			case nonce := <-chanDelivered:
+				require.Equal(t, expectedNonce, nonce)
+			case <-time.After(time.Second):
+				require.Fail(t, "timeout waiting for the delivered blocks")
+			}
+		}
+	}
+
+	args := createMockArgsJournaledOutport(storer)
+	args.NumRetainedEntries = 10
+	jo, _ := NewJournaledOutport(args)
+	_ = jo.SubscribeDriver(driver)
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		_ = jo.SaveBlock(createJournaledSaveBlockArgs(nonce))
+	}
+	waitDelivered(1, 2, 3)
+	_ = jo.Close()
+
+	// the consumer lost the last acknowledged block while the node was restarting
+	args = createMockArgsJournaledOutport(storer)
+	args.NumRetainedEntries = 10
+	restarted, _ := NewJournaledOutport(args)
+	_ = restarted.SubscribeDriver(driver)
+
+	cursors := restarted.GetDriversCursors()
+	require.Equal(t, map[string]uint64{testDriverID: 3}, cursors)
+
+	err := restarted.ReplayFrom(testDriverID, cursors[testDriverID])
+	require.Nil(t, err)
+	waitDelivered(3)
+
+	_ = restarted.SaveBlock(createJournaledSaveBlockArgs(4))
+	waitDelivered(4)
+	require.Equal(t, map[string]uint64{testDriverID: 4}, restarted.GetDriversCursors())
+
+	_ = restarted.Close()
+}
+
+func TestJournaledOutport_SubscribeDriverShouldUseStableIDs(t *testing.T) {
+	t.Parallel()
+
+	createDriver := func(name string) *mock.DriverStub {
+		return &mock.DriverStub{Name: name}
+	}
+
+	// the entries can not be decoded, so the cursors stay where they were persisted
+	args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		_, _ = args.Journal.Append(outportcore.TopicSaveBlock, []byte("invalid payload"))
+	}
+	_ = args.Journal.SetCursor(testDriverID+"_ws://host-a", 2)
+	_ = args.Journal.SetCursor(testDriverID+"_ws://host-b", 1)
+	jo, _ := NewJournaledOutport(args)
+
+	// the drivers find their cursors whatever the subscription order
+	require.Nil(t, jo.SubscribeDriver(createDriver("ws://host-b")))
+	require.Nil(t, jo.SubscribeDriver(createDriver("ws://host-a")))
+	require.Equal(t, map[string]uint64{
+		testDriverID + "_ws://host-a": 2,
+		testDriverID + "_ws://host-b": 1,
+	}, jo.GetDriversCursors())
+
+	err := jo.SubscribeDriver(createDriver("ws://host-a"))
+	require.True(t, errors.Is(err, ErrDuplicatedDriverID))
+
+	_ = jo.Close()
+}
+
+func TestJournaledOutport_ReplayDuringDeliveryShouldDeliverAgain(t *testing.T) {
+	t.Parallel()
+
+	chanDelivered := make(chan uint64, 10)
+	chanDelivering := make(chan struct{})
+	chanRelease := make(chan struct{})
+	numDeliveries := 0
+	driver := &mock.DriverStub{
+		SaveBlockCalled: func(outportBlock *outportcore.OutportBlock) error {
+			numDeliveries++
+			if numDeliveries == 2 {
+				// the replay request is received while the second block is being delivered
+				close(chanDelivering)
+				<-chanRelease
+			}
+
+			chanDelivered <- outportBlock.HighestFinalBlockNonce
+			return nil
+		},
+	}
+
+	args := createMockArgsJournaledOutport(testscommon.CreateMemUnit())
+	args.NumRetainedEntries = 10
+	jo, _ := NewJournaledOutport(args)
+	_ = jo.SubscribeDriver(driver)
+
+	_ = jo.SaveBlock(createJournaledSaveBlockArgs(1))
+	_ = jo.SaveBlock(createJournaledSaveBlockArgs(2))
+	<-chanDelivering
+	err := jo.ReplayFrom(testDriverID, 2)
+	require.Nil(t, err)
+	close(chanRelease)
+
+	for _, expectedNonce := range []uint64{1, 2, 2} {
+		select {
+		case nonce := <-chanDelivered:
+			require.Equal(t, expectedNonce, nonce)
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout waiting for the replayed block")
+		}
+	}
+	require.Eventually(t, func() bool {
+		return jo.GetDriversCursors()[testDriverID] == 2
+	}, time.Second, time.Millisecond)
+
+	_ = jo.Close()
+}
@@ -18,6 +18,7 @@ type DriverStub struct {
 	CloseCalled                 func() error
 	RegisterHandlerCalled       func(handlerFunction func() error, topic string) error
 	SetCurrentSettingsCalled    func(config outportcore.OutportConfig) error
+	Name                        string
 }
 
 // SaveBlock -
@@ -83,6 +84,11 @@ func (d *DriverStub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock)
 	return nil
 }
 
+// GetName -
+func (d *DriverStub) GetName() string {
+	return d.Name
+}
+
 // GetMarshaller -
 func (d *DriverStub) GetMarshaller() marshal.Marshalizer {
 	return marshallerMock.MarshalizerMock{}
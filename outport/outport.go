@@ -8,6 +8,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -54,7 +56,7 @@ func (o *outport) SaveBlock(args *outportcore.OutportBlockWithHeaderAndBody) err
 	}
 
 	for _, driver := range o.drivers {
-		blockData, err := prepareBlockData(args.HeaderDataWithBody, driver)
+		blockData, err := prepareBlockData(args.HeaderDataWithBody, driver.GetMarshaller())
 		if err != nil {
 			return err
 		}
@@ -68,13 +70,12 @@ func (o *outport) SaveBlock(args *outportcore.OutportBlockWithHeaderAndBody) err
 
 func prepareBlockData(
 	headerBodyData *outportcore.HeaderDataWithBody,
-	driver Driver,
+	marshaller marshal.Marshalizer,
 ) (*outportcore.BlockData, error) {
 	if headerBodyData == nil {
 		return nil, fmt.Errorf("outport.prepareBlockData error: %w", errNilHeaderAndBodyArgs)
 	}
 
-	marshaller := driver.GetMarshaller()
 	headerBytes, headerType, err := outportcore.GetHeaderBytesAndType(marshaller, headerBodyData.Header)
 	if err != nil {
 		return nil, err
@@ -110,14 +111,20 @@ func (o *outport) monitorCompletionOnDriver(function string, driver Driver) chan
 		case <-timer.C:
 			o.logHandler(logger.LogWarning, "outport.monitorCompletionOnDriver took too long",
 				"function", function, "driver", driverString(driver), "message counter", counter, "time", o.timeForDriverCall)
+			<-ch
 		}
 
 		timer.Stop()
+		metricsRegistry.ObserveDuration(metricsRegistry.OutportDriverCallDuration.WithLabelValues(function, driverString(driver)), startTime)
 	}(time.Now())
 
 	return ch
 }
 
+func countDriverCallError(function string, driver Driver) {
+	metricsRegistry.OutportDriverCallErrors.WithLabelValues(function, driverString(driver)).Inc()
+}
+
 func (o *outport) saveBlockBlocking(args *outportcore.OutportBlock, driver Driver) {
 	ch := o.monitorCompletionOnDriver("saveBlockBlocking", driver)
 	defer close(ch)
@@ -133,6 +140,8 @@ func (o *outport) saveBlockBlocking(args *outportcore.OutportBlock, driver Drive
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("saveBlockBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
@@ -154,7 +163,7 @@ func (o *outport) RevertIndexedBlock(headerDataWithBody *outportcore.HeaderDataW
 	defer o.mutex.RUnlock()
 
 	for _, driver := range o.drivers {
-		blockData, err := prepareBlockData(headerDataWithBody, driver)
+		blockData, err := prepareBlockData(headerDataWithBody, driver.GetMarshaller())
 		if err != nil {
 			return err
 		}
@@ -180,6 +189,8 @@ func (o *outport) revertIndexedBlockBlocking(blockData *outportcore.BlockData, d
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("revertIndexedBlockBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
@@ -211,6 +222,8 @@ func (o *outport) saveRoundsInfoBlocking(roundsInfo *outportcore.RoundsInfo, dri
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("saveRoundsInfoBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
@@ -242,6 +255,8 @@ func (o *outport) saveValidatorsPubKeysBlocking(validatorsPubKeys *outportcore.V
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("saveValidatorsPubKeysBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
@@ -273,6 +288,8 @@ func (o *outport) saveValidatorsRatingBlocking(validatorsRating *outportcore.Val
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("saveValidatorsRatingBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
@@ -304,6 +321,8 @@ func (o *outport) saveAccountsBlocking(accounts *outportcore.Accounts, driver Dr
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("saveAccountsBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
@@ -335,6 +354,8 @@ func (o *outport) finalizedBlockBlocking(finalizedBlock *outportcore.FinalizedBl
 			"retrial in", o.retrialInterval,
 			"error", err)
 
+		countDriverCallError("finalizedBlockBlocking", driver)
+
 		if o.shouldTerminate() {
 			return
 		}
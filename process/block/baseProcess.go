@@ -25,6 +25,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -43,6 +44,8 @@ import (
 	"github.com/multiversx/mx-chain-go/state/parsers"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var log = logger.GetOrCreate("process/block")
@@ -2135,3 +2138,15 @@ func (bp *baseProcessor) checkSentSignaturesAtCommitTime(header data.HeaderHandl
 
 	return nil
 }
+
+func (bp *baseProcessor) startBlockSpan(name string, header data.HeaderHandler) trace.Span {
+	attributes := []attribute.KeyValue{tracing.ShardIDKey.Int64(int64(bp.shardCoordinator.SelfId()))}
+	if !check.IfNil(header) {
+		attributes = append(attributes,
+			tracing.BlockNonceKey.Int64(int64(header.GetNonce())),
+			tracing.BlockRoundKey.Int64(int64(header.GetRound())),
+		)
+	}
+
+	return tracing.StartBlockSpan(name, attributes...)
+}
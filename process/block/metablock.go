@@ -14,6 +14,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/headerVersionData"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	processOutport "github.com/multiversx/mx-chain-go/outport/process"
 	"github.com/multiversx/mx-chain-go/process"
@@ -189,6 +191,20 @@ func (mp *metaProcessor) ProcessBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
+) error {
+	span := mp.startBlockSpan("block.processBlock", headerHandler)
+	startTime := time.Now()
+	err := mp.processBlock(headerHandler, bodyHandler, haveTime)
+	metricsRegistry.ObserveDuration(metricsRegistry.BlockProcessingDuration.WithLabelValues(metricsRegistry.PhaseProcessBlock, metricsRegistry.Outcome(err)), startTime)
+	tracing.EndBlockSpan(span, err)
+
+	return err
+}
+
+func (mp *metaProcessor) processBlock(
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
 ) error {
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -716,6 +732,17 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 func (mp *metaProcessor) CreateBlock(
 	initialHdr data.HeaderHandler,
 	haveTime func() bool,
+) (data.HeaderHandler, data.BodyHandler, error) {
+	span := mp.startBlockSpan("block.createBlock", initialHdr)
+	header, body, err := mp.createBlock(initialHdr, haveTime)
+	tracing.EndBlockSpan(span, err)
+
+	return header, body, err
+}
+
+func (mp *metaProcessor) createBlock(
+	initialHdr data.HeaderHandler,
+	haveTime func() bool,
 ) (data.HeaderHandler, data.BodyHandler, error) {
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
@@ -1158,6 +1185,19 @@ func (mp *metaProcessor) requestShardHeadersIfNeeded(
 func (mp *metaProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
+) error {
+	span := mp.startBlockSpan("block.commitBlock", headerHandler)
+	startTime := time.Now()
+	err := mp.commitBlock(headerHandler, bodyHandler)
+	metricsRegistry.ObserveDuration(metricsRegistry.BlockProcessingDuration.WithLabelValues(metricsRegistry.PhaseCommitBlock, metricsRegistry.Outcome(err)), startTime)
+	tracing.EndBlockSpan(span, err)
+
+	return err
+}
+
+func (mp *metaProcessor) commitBlock(
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
 ) error {
 	mp.processStatusHandler.SetBusy("metaProcessor.CommitBlock")
 	var err error
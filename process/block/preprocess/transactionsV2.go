@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 )
@@ -63,12 +64,17 @@ func (txs *transactions) createAndProcessMiniBlocksFromMeV2(
 			break
 		}
 
+		_, span := tracing.StartTxSpan(tracing.BlockContext(), "preprocess.processTransaction", txHash,
+			tracing.SenderShardIDKey.Int64(int64(senderShardID)),
+			tracing.ReceiverShardIDKey.Int64(int64(receiverShardID)),
+		)
 		shouldAddToRemaining, err := txs.processTransaction(
 			tx,
 			txHash,
 			senderShardID,
 			receiverShardID,
 			mbInfo)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			if core.IsGetNodeFromDBError(err) {
 				return nil, nil, nil, err
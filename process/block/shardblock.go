@@ -12,6 +12,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/headerVersionData"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	processOutport "github.com/multiversx/mx-chain-go/outport/process"
 	"github.com/multiversx/mx-chain-go/process"
@@ -159,6 +161,20 @@ func (sp *shardProcessor) ProcessBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
+) error {
+	span := sp.startBlockSpan("block.processBlock", headerHandler)
+	startTime := time.Now()
+	err := sp.processBlock(headerHandler, bodyHandler, haveTime)
+	metricsRegistry.ObserveDuration(metricsRegistry.BlockProcessingDuration.WithLabelValues(metricsRegistry.PhaseProcessBlock, metricsRegistry.Outcome(err)), startTime)
+	tracing.EndBlockSpan(span, err)
+
+	return err
+}
+
+func (sp *shardProcessor) processBlock(
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
 ) error {
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
@@ -803,6 +819,17 @@ func (sp *shardProcessor) rollBackProcessedMiniBlockInfo(miniBlockHeader data.Mi
 func (sp *shardProcessor) CreateBlock(
 	initialHdr data.HeaderHandler,
 	haveTime func() bool,
+) (data.HeaderHandler, data.BodyHandler, error) {
+	span := sp.startBlockSpan("block.createBlock", initialHdr)
+	header, body, err := sp.createBlock(initialHdr, haveTime)
+	tracing.EndBlockSpan(span, err)
+
+	return header, body, err
+}
+
+func (sp *shardProcessor) createBlock(
+	initialHdr data.HeaderHandler,
+	haveTime func() bool,
 ) (data.HeaderHandler, data.BodyHandler, error) {
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
@@ -891,6 +918,19 @@ func (sp *shardProcessor) createBlockBody(shardHdr data.HeaderHandler, haveTime
 func (sp *shardProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
+) error {
+	span := sp.startBlockSpan("block.commitBlock", headerHandler)
+	startTime := time.Now()
+	err := sp.commitBlock(headerHandler, bodyHandler)
+	metricsRegistry.ObserveDuration(metricsRegistry.BlockProcessingDuration.WithLabelValues(metricsRegistry.PhaseCommitBlock, metricsRegistry.Outcome(err)), startTime)
+	tracing.EndBlockSpan(span, err)
+
+	return err
+}
+
+func (sp *shardProcessor) commitBlock(
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
 ) error {
 	var err error
 	sp.processStatusHandler.SetBusy("shardProcessor.CommitBlock")
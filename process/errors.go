@@ -822,6 +822,9 @@ var ErrNilRatingsInfoHandler = errors.New("nil ratings info handler")
 // ErrNilDebugger signals that a nil debug handler has been provided
 var ErrNilDebugger = errors.New("nil debug handler")
 
+// ErrNilTraceRecorder signals that a nil trace recorder has been provided
+var ErrNilTraceRecorder = errors.New("nil trace recorder")
+
 // ErrEmptyFloodPreventerList signals that an empty flood preventer list has been provided
 var ErrEmptyFloodPreventerList = errors.New("empty flood preventer provided")
 
@@ -861,6 +864,9 @@ var ErrTxValueOutOfBounds = errors.New("tx value is out of bounds")
 // ErrNilBlackListedPkCache signals that a nil black listed public key cache has been provided
 var ErrNilBlackListedPkCache = errors.New("nil black listed public key cache")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
 // ErrInvalidDecayCoefficient signals that the provided decay coefficient is invalid
 var ErrInvalidDecayCoefficient = errors.New("decay coefficient is invalid")
 
@@ -1229,3 +1235,12 @@ var ErrInvalidAsyncArguments = errors.New("invalid arguments to process async/ca
 
 // ErrNilSentSignatureTracker defines the error for setting a nil SentSignatureTracker
 var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
+
+// ErrStateNotAvailable signals that the state for the requested root hash is not available, it might have been pruned
+var ErrStateNotAvailable = errors.New("the state for the requested root hash is not available, it might have been pruned")
+
+// ErrNilSCQuery signals that a nil smart contract query has been provided
+var ErrNilSCQuery = errors.New("nil smart contract query")
+
+// ErrMultiQueryGasBudgetExceeded signals that the combined gas budget of a batch of smart contract queries has been exhausted
+var ErrMultiQueryGasBudgetExceeded = errors.New("the gas budget of the multi-query has been exhausted")
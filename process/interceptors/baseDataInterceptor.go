@@ -2,14 +2,20 @@ package interceptors
 
 import (
 	"bytes"
+	"context"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const interceptorSpanName = "interceptor.processInterceptedData"
+
 type baseDataInterceptor struct {
 	throttler            process.InterceptorThrottler
 	antifloodHandler     process.P2PAntifloodHandler
@@ -63,7 +69,13 @@ func (bdi *baseDataInterceptor) isMessageFromSelfToSelf(fromConnectedPeer core.P
 }
 
 func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedData, msg p2p.MessageP2P) {
-	err := bdi.processor.Validate(data, msg.Peer())
+	span := bdi.startSpan(data)
+	var err error
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	err = bdi.processor.Validate(data, msg.Peer())
 	if err != nil {
 		log.Trace("intercepted data is not valid",
 			"hash", data.Hash(),
@@ -103,6 +115,25 @@ func (bdi *baseDataInterceptor) processInterceptedData(data process.InterceptedD
 	bdi.processDebugInterceptedData(data, err)
 }
 
+// startSpan starts the span of the intercepted data processing. A transaction broadcast by the current node is
+// intercepted on a different call stack than its API request, so its span is linked to the span of the request
+func (bdi *baseDataInterceptor) startSpan(data process.InterceptedData) trace.Span {
+	attributes := []attribute.KeyValue{
+		tracing.TopicKey.String(bdi.topic),
+		tracing.DataTypeKey.String(data.Type()),
+	}
+
+	_, isTransaction := data.(process.InterceptedTransactionHandler)
+	if isTransaction {
+		_, span := tracing.StartTxSpan(context.Background(), interceptorSpanName, data.Hash(), attributes...)
+		return span
+	}
+
+	_, span := tracing.StartSpan(context.Background(), interceptorSpanName, attributes...)
+
+	return span
+}
+
 func (bdi *baseDataInterceptor) processDebugInterceptedData(interceptedData process.InterceptedData, err error) {
 	identifiers := interceptedData.Identifiers()
 
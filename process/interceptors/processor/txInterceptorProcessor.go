@@ -10,11 +10,18 @@ import (
 var _ process.InterceptorProcessor = (*TxInterceptorProcessor)(nil)
 var txLog = logger.GetOrCreate("process/interceptors/processor/txlog")
 
+// unsolicitedDataPool is implemented by the pools applying a policy on the data received without being requested
+type unsolicitedDataPool interface {
+	AddUnsolicitedData(key []byte, data interface{}, sizeInBytes int, cacheID string)
+}
+
 // TxInterceptorProcessor is the processor used when intercepting transactions
 // (smart contract results, receipts, transaction) structs which satisfy TransactionHandler interface.
 type TxInterceptorProcessor struct {
-	shardedPool process.ShardedPool
-	txValidator process.TxValidator
+	shardedPool      process.ShardedPool
+	unsolicitedPool  unsolicitedDataPool
+	txValidator      process.TxValidator
+	whiteListHandler process.WhiteListHandler
 }
 
 // NewTxInterceptorProcessor creates a new TxInterceptorProcessor instance
@@ -28,10 +35,17 @@ func NewTxInterceptorProcessor(argument *ArgTxInterceptorProcessor) (*TxIntercep
 	if check.IfNil(argument.TxValidator) {
 		return nil, process.ErrNilTxValidator
 	}
+	if check.IfNil(argument.WhiteListHandler) {
+		return nil, process.ErrNilWhiteListHandler
+	}
+
+	unsolicitedPool, _ := argument.ShardedDataCache.(unsolicitedDataPool)
 
 	return &TxInterceptorProcessor{
-		shardedPool: argument.ShardedDataCache,
-		txValidator: argument.TxValidator,
+		shardedPool:      argument.ShardedDataCache,
+		unsolicitedPool:  unsolicitedPool,
+		txValidator:      argument.TxValidator,
+		whiteListHandler: argument.WhiteListHandler,
 	}, nil
 }
 
@@ -66,6 +80,17 @@ func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, peerOrigi
 
 	txLog.Trace("received transaction", "pid", peerOriginator.Pretty(), "hash", data.Hash())
 	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	isUnsolicited := txip.unsolicitedPool != nil && !txip.whiteListHandler.IsWhiteListed(data)
+	if isUnsolicited {
+		txip.unsolicitedPool.AddUnsolicitedData(
+			data.Hash(),
+			interceptedTx.Transaction(),
+			interceptedTx.Transaction().Size(),
+			cacherIdentifier,
+		)
+		return nil
+	}
+
 	txip.shardedPool.AddData(
 		data.Hash(),
 		interceptedTx.Transaction(),
@@ -19,6 +19,40 @@ func createMockTxArgument() *processor.ArgTxInterceptorProcessor {
 	return &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: testscommon.NewShardedDataStub(),
 		TxValidator:      &mock.TxValidatorStub{},
+		WhiteListHandler: &testscommon.WhiteListHandlerStub{},
+	}
+}
+
+type unsolicitedDataPoolStub struct {
+	*testscommon.ShardedDataStub
+	addUnsolicitedDataCalled func(key []byte, data interface{}, sizeInBytes int, cacheID string)
+}
+
+func (stub *unsolicitedDataPoolStub) AddUnsolicitedData(key []byte, data interface{}, sizeInBytes int, cacheID string) {
+	stub.addUnsolicitedDataCalled(key, data, sizeInBytes, cacheID)
+}
+
+func createInterceptedTxStub() process.InterceptedData {
+	return &struct {
+		testscommon.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: testscommon.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return make([]byte, 0)
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+		},
 	}
 }
 
@@ -53,6 +87,17 @@ func TestNewTxInterceptorProcessor_NilTxValidatorShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTxValidator, err)
 }
 
+func TestNewTxInterceptorProcessor_NilWhiteListHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTxArgument()
+	arg.WhiteListHandler = nil
+	txip, err := processor.NewTxInterceptorProcessor(arg)
+
+	assert.Nil(t, txip)
+	assert.Equal(t, process.ErrNilWhiteListHandler, err)
+}
+
 func TestNewTxInterceptorProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -167,6 +212,69 @@ func TestTxInterceptorProcessor_SaveShouldWork(t *testing.T) {
 	assert.True(t, addedWasCalled)
 }
 
+func TestTxInterceptorProcessor_SaveUnsolicitedTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not whitelisted transaction should be added with the pool policy", func(t *testing.T) {
+		t.Parallel()
+
+		addDataCalled := false
+		addUnsolicitedDataCalled := false
+		arg := createMockTxArgument()
+		arg.ShardedDataCache = &unsolicitedDataPoolStub{
+			ShardedDataStub: &testscommon.ShardedDataStub{
+				AddDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+					addDataCalled = true
+				},
+			},
+			addUnsolicitedDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheID string) {
+				addUnsolicitedDataCalled = true
+			},
+		}
+		arg.WhiteListHandler = &testscommon.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return false
+			},
+		}
+		txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+		err := txip.Save(createInterceptedTxStub(), "", "")
+
+		assert.Nil(t, err)
+		assert.False(t, addDataCalled)
+		assert.True(t, addUnsolicitedDataCalled)
+	})
+	t.Run("requested transaction should be added without the pool policy", func(t *testing.T) {
+		t.Parallel()
+
+		addDataCalled := false
+		addUnsolicitedDataCalled := false
+		arg := createMockTxArgument()
+		arg.ShardedDataCache = &unsolicitedDataPoolStub{
+			ShardedDataStub: &testscommon.ShardedDataStub{
+				AddDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+					addDataCalled = true
+				},
+			},
+			addUnsolicitedDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheID string) {
+				addUnsolicitedDataCalled = true
+			},
+		}
+		arg.WhiteListHandler = &testscommon.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return true
+			},
+		}
+		txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+		err := txip.Save(createInterceptedTxStub(), "", "")
+
+		assert.Nil(t, err)
+		assert.True(t, addDataCalled)
+		assert.False(t, addUnsolicitedDataCalled)
+	})
+}
+
 //------- IsInterfaceNil
 
 func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
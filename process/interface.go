@@ -744,14 +744,36 @@ type TimeCacher interface {
 	IsInterfaceNil() bool
 }
 
-// PeerBlackListCacher can determine if a certain peer id is or not blacklisted
+// PeerBlackListCacher can determine if a certain peer id is or not blacklisted. The implementations able to record why
+// a peer ID was blacklisted keep the reason provided to UpsertWithReason
 type PeerBlackListCacher interface {
 	Upsert(pid core.PeerID, span time.Duration) error
+	UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error
 	Has(pid core.PeerID) bool
 	Sweep()
 	IsInterfaceNil() bool
 }
 
+// PublicKeyBlacklistReasonRecorder defines a public keys time cacher able to also record why a public key was blacklisted
+type PublicKeyBlacklistReasonRecorder interface {
+	UpsertWithReason(pk string, span time.Duration, reason string, topic string) error
+}
+
+// PeerReputationHandler defines the behavior of a component that keeps the scores and the bans of the peer IDs,
+// IP addresses and public keys
+type PeerReputationHandler interface {
+	PeersBlackListCacher() PeerBlackListCacher
+	PublicKeysBlackListCacher() TimeCacher
+	IsIPDenied(ip string) bool
+	GetPeersReputation() []*common.PeerReputation
+	BanPeer(pid core.PeerID, duration time.Duration, reason string) error
+	UnbanPeer(pid core.PeerID) error
+	BanIP(ip string, duration time.Duration, reason string) error
+	UnbanIP(ip string) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	UpdatePeerIDPublicKeyPair(pid core.PeerID, pk []byte)
@@ -785,6 +807,15 @@ type SCQuery struct {
 	ShouldBeSynced bool
 	BlockNonce     core.OptionalUint64
 	BlockHash      []byte
+	BlockRootHash  []byte
+	HintEpoch      core.OptionalUint32
+	GasLimit       uint64
+}
+
+// SCQueryResult holds the outcome of one smart contract query executed in a batch
+type SCQueryResult struct {
+	VMOutput *vmcommon.VMOutput
+	Err      error
 }
 
 // GasHandler is able to perform some gas calculation
@@ -926,6 +957,7 @@ type PeerValidatorMapper interface {
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+	ExecuteQueries(queries []*SCQuery) ([]*SCQueryResult, common.BlockInfo, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -1082,6 +1114,14 @@ type AntifloodDebugger interface {
 	IsInterfaceNil() bool
 }
 
+// AntifloodTraceRecorder defines an interface for recording the metadata of the messages checked by the antiflood
+type AntifloodTraceRecorder interface {
+	RecordMessage(fromConnectedPeer core.PeerID, originator core.PeerID, topic string, size uint64, sequence []byte)
+	RecordMessagesOnTopic(pid core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // PoolsCleaner defines the functionality to clean pools for old records
 type PoolsCleaner interface {
 	Close() error
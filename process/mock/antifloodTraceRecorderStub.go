@@ -0,0 +1,38 @@
+package mock
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+// AntifloodTraceRecorderStub -
+type AntifloodTraceRecorderStub struct {
+	RecordMessageCalled         func(fromConnectedPeer core.PeerID, originator core.PeerID, topic string, size uint64, sequence []byte)
+	RecordMessagesOnTopicCalled func(pid core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte)
+	CloseCalled                 func() error
+}
+
+// RecordMessage -
+func (stub *AntifloodTraceRecorderStub) RecordMessage(fromConnectedPeer core.PeerID, originator core.PeerID, topic string, size uint64, sequence []byte) {
+	if stub.RecordMessageCalled != nil {
+		stub.RecordMessageCalled(fromConnectedPeer, originator, topic, size, sequence)
+	}
+}
+
+// RecordMessagesOnTopic -
+func (stub *AntifloodTraceRecorderStub) RecordMessagesOnTopic(pid core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) {
+	if stub.RecordMessagesOnTopicCalled != nil {
+		stub.RecordMessagesOnTopicCalled(pid, topic, numMessages, totalSize, sequence)
+	}
+}
+
+// Close -
+func (stub *AntifloodTraceRecorderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AntifloodTraceRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
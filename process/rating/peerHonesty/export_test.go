@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/disabled"
 )
 
 func NewP2pPeerHonestyWithCustomExecuteDelayFunction(
@@ -22,8 +24,12 @@ func NewP2pPeerHonestyWithCustomExecuteDelayFunction(
 		minScore:               peerHonestyConfig.MinScore,
 		badPeerThreshold:       peerHonestyConfig.BadPeerThreshold,
 		unitValue:              peerHonestyConfig.UnitValue,
+		peerHonestyConfig:      peerHonestyConfig,
 		cache:                  cache,
 		blackListedPkCache:     blackListedPkCache,
+		changedPks:             make(map[string]struct{}),
+		storer:                 disabled.NewStorer(),
+		marshaller:             &marshal.JsonMarshalizer{},
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
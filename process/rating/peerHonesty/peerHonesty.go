@@ -9,6 +9,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
@@ -33,23 +34,42 @@ type p2pPeerHonesty struct {
 	minScore               float64
 	badPeerThreshold       float64
 	unitValue              float64
+	peerHonestyConfig      config.PeerHonestyConfig
 	cache                  storage.Cacher
 	mut                    sync.RWMutex
 	blackListedPkCache     process.TimeCacher
 	cancelFunc             func()
+	changedPks             map[string]struct{}
+	storer                 storage.Storer
+	marshaller             marshal.Marshalizer
+	mutStorer              sync.Mutex
+}
+
+// peerScoreRecord is the record persisted for each public key having a non-zero score on at least one topic
+type peerScoreRecord struct {
+	ScoresByTopic map[string]float64 `json:"scoresByTopic"`
 }
 
 // NewP2pPeerHonesty creates a new peer honesty handler able to manage a provided set of public keys withing
-// the provided cache
+// the provided cache. The scores are restored from the provided storer and the changed ones are written back on each
+// decay, so they survive a node restart
 func NewP2pPeerHonesty(
 	peerHonestyConfig config.PeerHonestyConfig,
 	blackListedPkCache process.TimeCacher,
 	cache storage.Cacher,
+	storer storage.Storer,
+	marshaller marshal.Marshalizer,
 ) (*p2pPeerHonesty, error) {
 	err := checkParams(peerHonestyConfig, blackListedPkCache, cache)
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating an instance of p2pPeerHonesty", err)
 	}
+	if check.IfNil(storer) {
+		return nil, fmt.Errorf("%w while creating an instance of p2pPeerHonesty", process.ErrNilStorage)
+	}
+	if check.IfNil(marshaller) {
+		return nil, fmt.Errorf("%w while creating an instance of p2pPeerHonesty", process.ErrNilMarshalizer)
+	}
 
 	instance := &p2pPeerHonesty{
 		decayCoefficient:       peerHonestyConfig.DecayCoefficient,
@@ -58,10 +78,16 @@ func NewP2pPeerHonesty(
 		minScore:               peerHonestyConfig.MinScore,
 		badPeerThreshold:       peerHonestyConfig.BadPeerThreshold,
 		unitValue:              peerHonestyConfig.UnitValue,
+		peerHonestyConfig:      peerHonestyConfig,
 		cache:                  cache,
 		blackListedPkCache:     blackListedPkCache,
+		changedPks:             make(map[string]struct{}),
+		storer:                 storer,
+		marshaller:             marshaller,
 	}
 
+	instance.restore()
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 	instance.cancelFunc = cancelFunc
 
@@ -70,6 +96,31 @@ func NewP2pPeerHonesty(
 	return instance, nil
 }
 
+func (pph *p2pPeerHonesty) restore() {
+	numRestored := 0
+	pph.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &peerScoreRecord{}
+		err := pph.marshaller.Unmarshal(record, val)
+		if err != nil {
+			log.Warn("p2pPeerHonesty: could not restore scores",
+				"pk", core.GetTrimmedPk(hex.EncodeToString(key)),
+				"error", err)
+			return true
+		}
+
+		ps := newPeerScore(string(key))
+		for topic, score := range record.ScoresByTopic {
+			ps.scoresByTopic[topic] = score
+		}
+		pph.cache.Put(key, ps, ps.size())
+		numRestored++
+
+		return true
+	})
+
+	log.Debug("restored peer honesty scores", "num public keys", numRestored)
+}
+
 func checkParams(
 	peerHonestyConfig config.PeerHonestyConfig,
 	blackListedPkCache process.TimeCacher,
@@ -83,6 +134,10 @@ func checkParams(
 		return process.ErrNilCacher
 	}
 
+	return checkPeerHonestyConfig(peerHonestyConfig)
+}
+
+func checkPeerHonestyConfig(peerHonestyConfig config.PeerHonestyConfig) error {
 	isDecayCoefficientOk := peerHonestyConfig.DecayCoefficient > minDecayCoefficient &&
 		peerHonestyConfig.DecayCoefficient < maxDecayCoefficient
 	if !isDecayCoefficientOk {
@@ -128,7 +183,7 @@ func checkParams(
 func (pph *p2pPeerHonesty) executeDecayContinuously(ctx context.Context, handler func()) {
 	for {
 		select {
-		case <-time.After(pph.updateIntervalForDecay):
+		case <-time.After(pph.getUpdateIntervalForDecay()):
 			handler()
 		case <-ctx.Done():
 			log.Debug("closing p2pPeerHonesty.executeDecayContinuously go routine")
@@ -137,7 +192,23 @@ func (pph *p2pPeerHonesty) executeDecayContinuously(ctx context.Context, handler
 	}
 }
 
+func (pph *p2pPeerHonesty) getUpdateIntervalForDecay() time.Duration {
+	pph.mut.RLock()
+	defer pph.mut.RUnlock()
+
+	return pph.updateIntervalForDecay
+}
+
+// applyDecay decays the scores under the lock and then writes only the changed ones, without holding it
 func (pph *p2pPeerHonesty) applyDecay() {
+	pph.mutStorer.Lock()
+	defer pph.mutStorer.Unlock()
+
+	pph.decayScores()
+	pph.saveChangedScores(pph.getChangedScores())
+}
+
+func (pph *p2pPeerHonesty) decayScores() {
 	pph.mut.Lock()
 	defer pph.mut.Unlock()
 
@@ -154,12 +225,79 @@ func (pph *p2pPeerHonesty) applyDecay() {
 		}
 
 		for topic, score := range ps.scoresByTopic {
+			if score == 0 {
+				continue
+			}
+
 			score = score * pph.decayCoefficient
 			if check.IsZeroFloat64(score, approximateZero) {
 				score = 0
 			}
 
 			ps.scoresByTopic[topic] = score
+			pph.changedPks[ps.pk] = struct{}{}
+		}
+	}
+}
+
+// getChangedScores returns the marshalled records of the public keys whose scores changed since the last call, a nil
+// record meaning that the public key has no score left and its record should be removed
+func (pph *p2pPeerHonesty) getChangedScores() map[string][]byte {
+	pph.mut.Lock()
+	defer pph.mut.Unlock()
+
+	changedScores := make(map[string][]byte, len(pph.changedPks))
+	for pk := range pph.changedPks {
+		changedScores[pk] = pph.marshalScoresNoLock(pk)
+	}
+	pph.changedPks = make(map[string]struct{})
+
+	return changedScores
+}
+
+func (pph *p2pPeerHonesty) marshalScoresNoLock(pk string) []byte {
+	psObj, _ := pph.cache.Get([]byte(pk))
+	ps, ok := psObj.(*peerScore)
+	if !ok {
+		// evicted from the cache
+		return nil
+	}
+
+	record := &peerScoreRecord{
+		ScoresByTopic: make(map[string]float64, len(ps.scoresByTopic)),
+	}
+	for topic, score := range ps.scoresByTopic {
+		if score != 0 {
+			record.ScoresByTopic[topic] = score
+		}
+	}
+	if len(record.ScoresByTopic) == 0 {
+		return nil
+	}
+
+	buff, err := pph.marshaller.Marshal(record)
+	if err != nil {
+		log.Warn("p2pPeerHonesty: could not marshal scores",
+			"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(pk))),
+			"error", err)
+		return nil
+	}
+
+	return buff
+}
+
+func (pph *p2pPeerHonesty) saveChangedScores(changedScores map[string][]byte) {
+	for pk, buff := range changedScores {
+		var err error
+		if buff == nil {
+			err = pph.storer.Remove([]byte(pk))
+		} else {
+			err = pph.storer.Put([]byte(pk), buff)
+		}
+		if err != nil {
+			log.Warn("p2pPeerHonesty: could not save scores",
+				"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(pk))),
+				"error", err)
 		}
 	}
 }
@@ -170,6 +308,7 @@ func (pph *p2pPeerHonesty) ChangeScore(pk string, topic string, units int) {
 	defer pph.mut.Unlock()
 
 	ps := pph.getValidPeerScoreNoLock(pk)
+	pph.changedPks[pk] = struct{}{}
 
 	oldValue := ps.scoresByTopic[topic]
 	change := float64(units) * pph.unitValue
@@ -224,13 +363,16 @@ func (pph *p2pPeerHonesty) createDefaultPeerScore(pk string) *peerScore {
 }
 
 func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
-	shouldBlacklist := false
-	for _, score := range ps.scoresByTopic {
-		if score < pph.badPeerThreshold {
-			shouldBlacklist = true
+	offendingTopic := ""
+	lowestScore := pph.badPeerThreshold
+	for topic, score := range ps.scoresByTopic {
+		if score < lowestScore {
+			offendingTopic = topic
+			lowestScore = score
 		}
 	}
 
+	shouldBlacklist := len(offendingTopic) > 0
 	if !shouldBlacklist {
 		return
 	}
@@ -242,9 +384,10 @@ func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
 	log.Debug("p2pPeerHonesty.checkBlacklist: added blacklisted pk",
 		"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(ps.pk))),
 		"duration", common.PublicKeyBlacklistDuration,
+		"topic", offendingTopic,
 	)
 
-	err := pph.blackListedPkCache.Upsert(ps.pk, common.PublicKeyBlacklistDuration)
+	err := pph.upsertInBlacklist(ps.pk, offendingTopic)
 	if err != nil {
 		log.Warn("p2pPeerHonesty.checkBlacklist",
 			"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(ps.pk))),
@@ -252,10 +395,67 @@ func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
 	}
 }
 
-// Close closes the running go routines related to this instance
+func (pph *p2pPeerHonesty) upsertInBlacklist(pk string, offendingTopic string) error {
+	reasonRecorder, ok := pph.blackListedPkCache.(process.PublicKeyBlacklistReasonRecorder)
+	if ok {
+		reason := fmt.Sprintf("peer honesty score below the bad peer threshold on topic %s", offendingTopic)
+		return reasonRecorder.UpsertWithReason(pk, common.PublicKeyBlacklistDuration, reason, offendingTopic)
+	}
+
+	return pph.blackListedPkCache.Upsert(pk, common.PublicKeyBlacklistDuration)
+}
+
+// ValidateConfig checks the reloaded peer honesty values
+func (pph *p2pPeerHonesty) ValidateConfig(configs *config.Configs) error {
+	return checkPeerHonestyConfig(configs.RatingsConfig.PeerHonesty)
+}
+
+// ApplyConfig sets the reloaded peer honesty values, if they changed. The new decay interval is used starting with
+// the next decay
+func (pph *p2pPeerHonesty) ApplyConfig(configs *config.Configs) error {
+	peerHonestyConfig := configs.RatingsConfig.PeerHonesty
+	err := checkPeerHonestyConfig(peerHonestyConfig)
+	if err != nil {
+		return err
+	}
+
+	pph.mut.Lock()
+	defer pph.mut.Unlock()
+
+	if peerHonestyConfig == pph.peerHonestyConfig {
+		return nil
+	}
+
+	pph.decayCoefficient = peerHonestyConfig.DecayCoefficient
+	pph.updateIntervalForDecay = time.Duration(peerHonestyConfig.DecayUpdateIntervalInSeconds) * time.Second
+	pph.maxScore = peerHonestyConfig.MaxScore
+	pph.minScore = peerHonestyConfig.MinScore
+	pph.badPeerThreshold = peerHonestyConfig.BadPeerThreshold
+	pph.unitValue = peerHonestyConfig.UnitValue
+	pph.peerHonestyConfig = peerHonestyConfig
+
+	log.Info("peer honesty values reloaded",
+		"decay coefficient", peerHonestyConfig.DecayCoefficient,
+		"decay interval in seconds", peerHonestyConfig.DecayUpdateIntervalInSeconds,
+		"max score", peerHonestyConfig.MaxScore,
+		"min score", peerHonestyConfig.MinScore,
+		"bad peer threshold", peerHonestyConfig.BadPeerThreshold,
+		"unit value", peerHonestyConfig.UnitValue,
+	)
+
+	return nil
+}
+
+// Close closes the running go routines related to this instance, writes the changed scores and closes the storer
 func (pph *p2pPeerHonesty) Close() error {
 	pph.cancelFunc()
-	return nil
+
+	pph.mutStorer.Lock()
+	defer pph.mutStorer.Unlock()
+
+	pph.saveChangedScores(pph.getChangedScores())
+
+	return pph.storer.Close()
 }
 
 // IsInterfaceNil returns true if underlying object is nil
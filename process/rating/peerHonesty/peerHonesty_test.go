@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // createMockPeerHonestyConfig creates a peer honesty config with reasonable values
@@ -32,6 +35,8 @@ func TestNewP2pPeerHonesty_NilCacheShouldErr(t *testing.T) {
 		createMockPeerHonestyConfig(),
 		&testscommon.TimeCacheStub{},
 		nil,
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -45,6 +50,8 @@ func TestNewP2pPeerHonesty_NilBlacklistedPkCacheShouldErr(t *testing.T) {
 		createMockPeerHonestyConfig(),
 		nil,
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -60,6 +67,8 @@ func TestNewP2pPeerHonesty_InvalidDecayCoefficientShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -75,6 +84,8 @@ func TestNewP2pPeerHonesty_InvalidDecayUpdateIntervalShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -90,6 +101,8 @@ func TestNewP2pPeerHonesty_InvalidMinScoreShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -105,6 +118,8 @@ func TestNewP2pPeerHonesty_InvalidMaxScoreShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -120,6 +135,8 @@ func TestNewP2pPeerHonesty_InvalidUnitValueShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
@@ -135,12 +152,44 @@ func TestNewP2pPeerHonesty_InvalidBadPeerThresholdShouldErr(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.True(t, check.IfNil(pph))
 	assert.True(t, errors.Is(err, process.ErrInvalidBadPeerThreshold))
 }
 
+func TestNewP2pPeerHonesty_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pph, err := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		&testscommon.CacherStub{},
+		nil,
+		&marshal.JsonMarshalizer{},
+	)
+
+	assert.True(t, check.IfNil(pph))
+	assert.True(t, errors.Is(err, process.ErrNilStorage))
+}
+
+func TestNewP2pPeerHonesty_NilMarshallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pph, err := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		nil,
+	)
+
+	assert.True(t, check.IfNil(pph))
+	assert.True(t, errors.Is(err, process.ErrNilMarshalizer))
+}
+
 func TestNewP2pPeerHonesty_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -149,6 +198,8 @@ func TestNewP2pPeerHonesty_ShouldWork(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	assert.False(t, check.IfNil(pph))
@@ -190,6 +241,8 @@ func TestP2pPeerHonesty_ChangeScoreShouldWork(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -211,6 +264,8 @@ func TestP2pPeerHonesty_DoubleChangeScoreShouldWork(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -244,6 +299,8 @@ func TestP2pPeerHonesty_CheckBlacklistNotBlacklisted(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -276,6 +333,8 @@ func TestP2pPeerHonesty_CheckBlacklistMaxScoreReached(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -311,6 +370,8 @@ func TestP2pPeerHonesty_CheckBlacklistMinScoreReached(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -346,6 +407,8 @@ func TestP2pPeerHonesty_CheckBlacklistHasShouldNotCallUpsert(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -375,6 +438,8 @@ func TestP2pPeerHonesty_CheckBlacklistUpsertErrorsShouldWork(t *testing.T) {
 			},
 		},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -393,6 +458,8 @@ func TestP2pPeerHonesty_ApplyDecay(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pks := []string{"pkMin", "pkMax", "pkNearZero", "pkZero", "pkValue"}
@@ -423,6 +490,8 @@ func TestP2pPeerHonesty_ApplyDecayWillEventuallyGoTheScoreToZero(t *testing.T) {
 		cfg,
 		&testscommon.TimeCacheStub{},
 		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
 	)
 
 	pk := "pk"
@@ -441,3 +510,142 @@ func checkScore(t *testing.T, pph *p2pPeerHonesty, pk string, topic string, valu
 	ps := pph.Get(pk)
 	assert.Equal(t, value, ps.scoresByTopic[topic])
 }
+
+func TestP2pPeerHonesty_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	pph, _ := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
+	)
+	defer func() {
+		_ = pph.Close()
+	}()
+
+	cfg := createMockPeerHonestyConfig()
+	assert.Nil(t, pph.ValidateConfig(&config.Configs{RatingsConfig: &config.RatingsConfig{PeerHonesty: cfg}}))
+
+	cfg.BadPeerThreshold = 1
+	err := pph.ValidateConfig(&config.Configs{RatingsConfig: &config.RatingsConfig{PeerHonesty: cfg}})
+	assert.True(t, errors.Is(err, process.ErrInvalidBadPeerThreshold))
+}
+
+func TestP2pPeerHonesty_ApplyConfig(t *testing.T) {
+	t.Parallel()
+
+	pph, _ := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		&testscommon.CacherStub{},
+		&storageStubs.StorerStub{},
+		&marshal.JsonMarshalizer{},
+	)
+	defer func() {
+		_ = pph.Close()
+	}()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.DecayCoefficient = 0.5
+	cfg.MinScore = -10
+	cfg.BadPeerThreshold = -5
+	cfg.UnitValue = 2
+	cfg.DecayUpdateIntervalInSeconds = 5
+	cfg.MaxScore = 10
+
+	err := pph.ApplyConfig(&config.Configs{RatingsConfig: &config.RatingsConfig{PeerHonesty: cfg}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, pph.decayCoefficient)
+	assert.Equal(t, -10.0, pph.minScore)
+	assert.Equal(t, -5.0, pph.badPeerThreshold)
+	assert.Equal(t, 2.0, pph.unitValue)
+	assert.Equal(t, 10.0, pph.maxScore)
+	assert.Equal(t, time.Second*5, pph.getUpdateIntervalForDecay())
+
+	cfg.MinScore = 1
+	err = pph.ApplyConfig(&config.Configs{RatingsConfig: &config.RatingsConfig{PeerHonesty: cfg}})
+	assert.True(t, errors.Is(err, process.ErrInvalidMinScore))
+	assert.Equal(t, -10.0, pph.minScore)
+}
+
+func TestP2pPeerHonesty_ScoresShouldBePersistedAndRestored(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.DecayUpdateIntervalInSeconds = 3600
+	memUnit := testscommon.CreateMemUnit()
+	// the storer outlives the closed instance, as after a node restart
+	storer := &storageStubs.StorerStub{
+		PutCalled:       memUnit.Put,
+		RemoveCalled:    memUnit.Remove,
+		RangeKeysCalled: memUnit.RangeKeys,
+	}
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+		storer,
+		&marshal.JsonMarshalizer{},
+	)
+
+	pk := "pk"
+	topic := "topic"
+	pph.ChangeScore(pk, topic, -10)
+	pph.ChangeScore("other pk", topic, 1)
+	pph.applyDecay()
+	pph.Put("other pk", topic, 0)
+	pph.ChangeScore("other pk", topic, 0)
+	require.Nil(t, pph.Close())
+
+	restored, _ := NewP2pPeerHonesty(
+		cfg,
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+		storer,
+		&marshal.JsonMarshalizer{},
+	)
+	defer func() {
+		_ = restored.Close()
+	}()
+
+	ps := restored.Get(pk)
+	require.NotNil(t, ps)
+	assert.Equal(t, -10*cfg.DecayCoefficient, ps.scoresByTopic[topic])
+	// the public keys without a score are removed
+	assert.Nil(t, restored.Get("other pk"))
+}
+
+func TestP2pPeerHonesty_ApplyDecayShouldWriteOnlyTheChangedScores(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.DecayUpdateIntervalInSeconds = 3600
+	writes := make(map[string]int)
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+		&storageStubs.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				writes[string(key)]++
+				return nil
+			},
+			RemoveCalled: func(key []byte) error {
+				writes[string(key)]++
+				return nil
+			},
+		},
+		&marshal.JsonMarshalizer{},
+	)
+	defer func() {
+		_ = pph.Close()
+	}()
+
+	pph.ChangeScore("changed pk", "topic", -10)
+	pph.Put("zero pk", "topic", 0)
+	pph.applyDecay()
+	assert.Equal(t, 1, writes["changed pk"])
+	assert.Equal(t, 0, writes["zero pk"])
+}
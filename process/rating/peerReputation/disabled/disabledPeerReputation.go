@@ -0,0 +1,82 @@
+package disabled
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+)
+
+const defaultSpan = 300 * time.Second
+
+type disabledPeerReputation struct {
+	peersBlackList      process.PeerBlackListCacher
+	publicKeysBlackList process.TimeCacher
+}
+
+// NewDisabledPeerReputation returns a new instance of disabledPeerReputation. The blacklists are kept only in memory
+func NewDisabledPeerReputation() (*disabledPeerReputation, error) {
+	publicKeysBlackList := cache.NewTimeCache(defaultSpan)
+	peersBlackList, err := cache.NewPeerTimeCache(cache.NewTimeCache(defaultSpan))
+	if err != nil {
+		return nil, err
+	}
+
+	return &disabledPeerReputation{
+		peersBlackList:      peersBlackList,
+		publicKeysBlackList: publicKeysBlackList,
+	}, nil
+}
+
+// PeersBlackListCacher returns the in memory peer IDs blacklist
+func (dpr *disabledPeerReputation) PeersBlackListCacher() process.PeerBlackListCacher {
+	return dpr.peersBlackList
+}
+
+// PublicKeysBlackListCacher returns the in memory public keys blacklist
+func (dpr *disabledPeerReputation) PublicKeysBlackListCacher() process.TimeCacher {
+	return dpr.publicKeysBlackList
+}
+
+// IsIPDenied returns false as it is disabled
+func (dpr *disabledPeerReputation) IsIPDenied(_ string) bool {
+	return false
+}
+
+// GetPeersReputation returns an empty slice as it is disabled
+func (dpr *disabledPeerReputation) GetPeersReputation() []*common.PeerReputation {
+	return make([]*common.PeerReputation, 0)
+}
+
+// BanPeer returns ErrPeerReputationDisabled
+func (dpr *disabledPeerReputation) BanPeer(_ core.PeerID, _ time.Duration, _ string) error {
+	return peerReputation.ErrPeerReputationDisabled
+}
+
+// UnbanPeer returns ErrPeerReputationDisabled
+func (dpr *disabledPeerReputation) UnbanPeer(_ core.PeerID) error {
+	return peerReputation.ErrPeerReputationDisabled
+}
+
+// BanIP returns ErrPeerReputationDisabled
+func (dpr *disabledPeerReputation) BanIP(_ string, _ time.Duration, _ string) error {
+	return peerReputation.ErrPeerReputationDisabled
+}
+
+// UnbanIP returns ErrPeerReputationDisabled
+func (dpr *disabledPeerReputation) UnbanIP(_ string) error {
+	return peerReputation.ErrPeerReputationDisabled
+}
+
+// Close returns nil as it is disabled
+func (dpr *disabledPeerReputation) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dpr *disabledPeerReputation) IsInterfaceNil() bool {
+	return dpr == nil
+}
@@ -0,0 +1,33 @@
+package peerReputation
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidBlacklistPenalty signals that an invalid blacklist penalty has been provided
+var ErrInvalidBlacklistPenalty = errors.New("invalid blacklist penalty")
+
+// ErrInvalidMaxScoreHistoryEntries signals that an invalid maximum number of score history entries has been provided
+var ErrInvalidMaxScoreHistoryEntries = errors.New("invalid max score history entries")
+
+// ErrInvalidBanDuration signals that an invalid ban duration has been provided
+var ErrInvalidBanDuration = errors.New("invalid ban duration")
+
+// ErrInvalidIPAddress signals that an invalid IP address has been provided
+var ErrInvalidIPAddress = errors.New("invalid IP address")
+
+// ErrEmptyPeerID signals that an empty peer ID has been provided
+var ErrEmptyPeerID = errors.New("empty peer ID")
+
+// ErrNotBanned signals that the provided peer ID or IP address is not banned
+var ErrNotBanned = errors.New("not banned")
+
+// ErrPeerReputationDisabled signals that the peer reputation registry is disabled
+var ErrPeerReputationDisabled = errors.New("peer reputation is disabled")
+
+// ErrNilPeerDenialEvaluator signals that a nil peer denial evaluator has been provided
+var ErrNilPeerDenialEvaluator = errors.New("nil peer denial evaluator")
+
+// ErrNilPeerAddressesProvider signals that a nil peer addresses provider has been provided
+var ErrNilPeerAddressesProvider = errors.New("nil peer addresses provider")
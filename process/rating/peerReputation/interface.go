@@ -0,0 +1,8 @@
+package peerReputation
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+type peerAddressesProvider interface {
+	PeerAddresses(pid core.PeerID) []string
+	IsInterfaceNil() bool
+}
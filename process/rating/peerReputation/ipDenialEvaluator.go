@@ -0,0 +1,83 @@
+package peerReputation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ArgsIPDenialEvaluator holds the arguments needed to create a new IP denial evaluator
+type ArgsIPDenialEvaluator struct {
+	PeerDenialEvaluator   p2p.PeerDenialEvaluator
+	PeerReputationHandler process.PeerReputationHandler
+	PeerAddressesProvider peerAddressesProvider
+}
+
+type ipDenialEvaluator struct {
+	p2p.PeerDenialEvaluator
+	peerReputationHandler process.PeerReputationHandler
+	peerAddressesProvider peerAddressesProvider
+}
+
+// NewIPDenialEvaluator creates a peer denial evaluator that also denies the peers connected from a banned IP address
+func NewIPDenialEvaluator(args ArgsIPDenialEvaluator) (*ipDenialEvaluator, error) {
+	if check.IfNil(args.PeerDenialEvaluator) {
+		return nil, ErrNilPeerDenialEvaluator
+	}
+	if check.IfNil(args.PeerReputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(args.PeerAddressesProvider) {
+		return nil, ErrNilPeerAddressesProvider
+	}
+
+	return &ipDenialEvaluator{
+		PeerDenialEvaluator:   args.PeerDenialEvaluator,
+		peerReputationHandler: args.PeerReputationHandler,
+		peerAddressesProvider: args.PeerAddressesProvider,
+	}, nil
+}
+
+// IsDenied returns true if the provided peer ID is denied by the wrapped evaluator or if any of its known addresses
+// is on a banned IP
+func (ide *ipDenialEvaluator) IsDenied(pid core.PeerID) bool {
+	if ide.PeerDenialEvaluator.IsDenied(pid) {
+		return true
+	}
+
+	for _, address := range ide.peerAddressesProvider.PeerAddresses(pid) {
+		ip := extractIP(address)
+		if len(ip) > 0 && ide.peerReputationHandler.IsIPDenied(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpsertPeerID will update or insert the provided peer ID in the wrapped evaluator
+func (ide *ipDenialEvaluator) UpsertPeerID(pid core.PeerID, duration time.Duration) error {
+	return ide.PeerDenialEvaluator.UpsertPeerID(pid, duration)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ide *ipDenialEvaluator) IsInterfaceNil() bool {
+	return ide == nil
+}
+
+// extractIP returns the IP address of a multiaddress such as /ip4/127.0.0.1/tcp/37373
+func extractIP(address string) string {
+	parts := strings.Split(address, "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	if parts[1] != "ip4" && parts[1] != "ip6" {
+		return ""
+	}
+
+	return parts[2]
+}
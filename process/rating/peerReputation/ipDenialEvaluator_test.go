@@ -0,0 +1,117 @@
+package peerReputation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsIPDenialEvaluator() ArgsIPDenialEvaluator {
+	return ArgsIPDenialEvaluator{
+		PeerDenialEvaluator:   &p2pmocks.PeerDenialEvaluatorStub{},
+		PeerReputationHandler: &p2pmocks.PeerReputationHandlerStub{},
+		PeerAddressesProvider: &p2pmocks.MessengerStub{},
+	}
+}
+
+func TestNewIPDenialEvaluator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil peer denial evaluator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIPDenialEvaluator()
+		args.PeerDenialEvaluator = nil
+		ide, err := NewIPDenialEvaluator(args)
+		assert.True(t, check.IfNil(ide))
+		assert.Equal(t, ErrNilPeerDenialEvaluator, err)
+	})
+	t.Run("nil peer reputation handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIPDenialEvaluator()
+		args.PeerReputationHandler = nil
+		ide, err := NewIPDenialEvaluator(args)
+		assert.True(t, check.IfNil(ide))
+		assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+	})
+	t.Run("nil peer addresses provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIPDenialEvaluator()
+		args.PeerAddressesProvider = nil
+		ide, err := NewIPDenialEvaluator(args)
+		assert.True(t, check.IfNil(ide))
+		assert.Equal(t, ErrNilPeerAddressesProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ide, err := NewIPDenialEvaluator(createMockArgsIPDenialEvaluator())
+		assert.False(t, check.IfNil(ide))
+		assert.Nil(t, err)
+	})
+}
+
+func TestIPDenialEvaluator_IsDenied(t *testing.T) {
+	t.Parallel()
+
+	deniedPid := core.PeerID("denied pid")
+	bannedIPPid := core.PeerID("banned IP pid")
+	args := createMockArgsIPDenialEvaluator()
+	args.PeerDenialEvaluator = &p2pmocks.PeerDenialEvaluatorStub{
+		IsDeniedCalled: func(pid core.PeerID) bool {
+			return pid == deniedPid
+		},
+	}
+	args.PeerReputationHandler = &p2pmocks.PeerReputationHandlerStub{
+		IsIPDeniedCalled: func(ip string) bool {
+			return ip == "10.0.0.1"
+		},
+	}
+	args.PeerAddressesProvider = &p2pmocks.MessengerStub{
+		PeerAddressesCalled: func(pid core.PeerID) []string {
+			if pid == bannedIPPid {
+				return []string{"/dns4/example.com/tcp/37373", "/ip4/10.0.0.1/tcp/37373"}
+			}
+			return []string{"/ip4/10.0.0.2/tcp/37373"}
+		},
+	}
+	ide, _ := NewIPDenialEvaluator(args)
+
+	assert.True(t, ide.IsDenied(deniedPid))
+	assert.True(t, ide.IsDenied(bannedIPPid))
+	assert.False(t, ide.IsDenied("other pid"))
+}
+
+func TestIPDenialEvaluator_UpsertPeerID(t *testing.T) {
+	t.Parallel()
+
+	upsertCalled := false
+	args := createMockArgsIPDenialEvaluator()
+	args.PeerDenialEvaluator = &p2pmocks.PeerDenialEvaluatorStub{
+		UpsertPeerIDCalled: func(pid core.PeerID, duration time.Duration) error {
+			upsertCalled = true
+			return nil
+		},
+	}
+	ide, _ := NewIPDenialEvaluator(args)
+
+	err := ide.UpsertPeerID("pid", time.Second)
+	assert.Nil(t, err)
+	assert.True(t, upsertCalled)
+}
+
+func TestExtractIP(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "127.0.0.1", extractIP("/ip4/127.0.0.1/tcp/37373"))
+	assert.Equal(t, "::1", extractIP("/ip6/::1/tcp/37373"))
+	assert.Equal(t, "", extractIP("/dns4/example.com/tcp/37373"))
+	assert.Equal(t, "", extractIP("invalid"))
+}
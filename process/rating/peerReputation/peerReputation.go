@@ -0,0 +1,586 @@
+package peerReputation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/rating/peerreputation")
+
+const (
+	// PeerType is the type of the records holding the reputation of a peer ID
+	PeerType = "peer"
+	// IPType is the type of the records holding the reputation of an IP address
+	IPType = "ip"
+	// PublicKeyType is the type of the records holding the reputation of a validator public key
+	PublicKeyType = "pk"
+)
+
+const (
+	blacklistEvent   = "blacklist"
+	manualBanEvent   = "manual ban"
+	manualUnbanEvent = "manual unban"
+)
+
+const minScore = -100.0
+const approximateZero = 0.001
+const keySeparator = ":"
+const defaultSpan = 300 * time.Second
+
+const minDecayCoefficient = 0.0
+const maxDecayCoefficient = 1.0
+const minDecayIntervalInSeconds = uint32(1)
+
+// the interceptors add the topic to the blacklist reason as in "..., topic <topic>, error ..." or "... on topic <topic>"
+var topicInReason = regexp.MustCompile(`topic ([^\s,]+)`)
+
+// ArgsPeerReputation holds the arguments needed to create a new peer reputation registry
+type ArgsPeerReputation struct {
+	Config     config.PeerReputationConfig
+	Storer     storage.Storer
+	Marshaller marshal.Marshalizer
+}
+
+// reputationRecord is the record persisted for each peer ID, IP address or public key
+type reputationRecord struct {
+	Type            string                   `json:"type"`
+	Key             string                   `json:"key"`
+	Score           float64                  `json:"score"`
+	ScoreHistory    []common.PeerScoreChange `json:"scoreHistory"`
+	BanExpiry       int64                    `json:"banExpiry"`
+	IsManualBan     bool                     `json:"isManualBan"`
+	BlacklistReason string                   `json:"blacklistReason"`
+	OffendingTopic  string                   `json:"offendingTopic"`
+}
+
+// peerReputation keeps the scores and the bans of the peer IDs, IP addresses and public keys in a storer so that they
+// survive a node restart. A blacklisting lowers the score of the key by the configured penalty, the scores decaying
+// back to 0 over time. The records are loaded in memory when the registry is created
+type peerReputation struct {
+	mut                    sync.RWMutex
+	records                map[string]*reputationRecord
+	storer                 storage.Storer
+	marshaller             marshal.Marshalizer
+	decayCoefficient       float64
+	updateIntervalForDecay time.Duration
+	blacklistPenalty       float64
+	maxScoreHistoryEntries int
+	getTimeHandler         func() time.Time
+	cancelFunc             func()
+
+	// the decayed records are written outside the registry lock, so each storer key has a write generation, increased
+	// on every write, which lets a decayed record be skipped if its key was written again since the decay
+	mutStorer        sync.Mutex
+	writeGenerations map[string]uint64
+}
+
+// decayedRecord is a record changed by the decay, waiting to be written. A nil buffer means the record is removed
+type decayedRecord struct {
+	storerKey  string
+	buff       []byte
+	generation uint64
+}
+
+// NewPeerReputation creates a new peer reputation registry, restoring the records from the provided storer
+func NewPeerReputation(args ArgsPeerReputation) (*peerReputation, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating an instance of peerReputation", err)
+	}
+
+	pr := &peerReputation{
+		records:                make(map[string]*reputationRecord),
+		storer:                 args.Storer,
+		marshaller:             args.Marshaller,
+		decayCoefficient:       args.Config.DecayCoefficient,
+		updateIntervalForDecay: time.Duration(args.Config.DecayUpdateIntervalInSeconds) * time.Second,
+		blacklistPenalty:       args.Config.BlacklistPenalty,
+		maxScoreHistoryEntries: int(args.Config.MaxScoreHistoryEntries),
+		getTimeHandler:         time.Now,
+		writeGenerations:       make(map[string]uint64),
+	}
+
+	err = pr.restore()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	pr.cancelFunc = cancelFunc
+
+	go pr.executeDecayContinuously(ctx)
+
+	return pr, nil
+}
+
+func checkArgs(args ArgsPeerReputation) error {
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+
+	isDecayCoefficientOk := args.Config.DecayCoefficient > minDecayCoefficient &&
+		args.Config.DecayCoefficient < maxDecayCoefficient
+	if !isDecayCoefficientOk {
+		return fmt.Errorf("%w, decay coefficient should be in interval (%.2f, %.2f)",
+			process.ErrInvalidDecayCoefficient,
+			minDecayCoefficient,
+			maxDecayCoefficient,
+		)
+	}
+	if args.Config.DecayUpdateIntervalInSeconds < minDecayIntervalInSeconds {
+		return fmt.Errorf("%w, decay interval in seconds should be greater or equal to %d",
+			process.ErrInvalidDecayIntervalInSeconds,
+			minDecayIntervalInSeconds,
+		)
+	}
+	if args.Config.BlacklistPenalty <= 0 {
+		return fmt.Errorf("%w, BlacklistPenalty value should be positive", ErrInvalidBlacklistPenalty)
+	}
+	if args.Config.MaxScoreHistoryEntries == 0 {
+		return ErrInvalidMaxScoreHistoryEntries
+	}
+
+	return nil
+}
+
+func (pr *peerReputation) restore() error {
+	var errUnmarshal error
+	pr.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &reputationRecord{}
+		errUnmarshal = pr.marshaller.Unmarshal(record, val)
+		if errUnmarshal != nil {
+			errUnmarshal = fmt.Errorf("%w for key %s", errUnmarshal, hex.EncodeToString(key))
+			return false
+		}
+
+		pr.records[string(key)] = record
+
+		return true
+	})
+	if errUnmarshal != nil {
+		return errUnmarshal
+	}
+
+	log.Debug("restored peers reputation", "num records", len(pr.records))
+
+	return nil
+}
+
+func (pr *peerReputation) executeDecayContinuously(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(pr.updateIntervalForDecay):
+			pr.applyDecay()
+		case <-ctx.Done():
+			log.Debug("closing peerReputation.executeDecayContinuously go routine")
+			return
+		}
+	}
+}
+
+// applyDecay decays the scores under the registry lock and then writes only the changed records, without holding it
+func (pr *peerReputation) applyDecay() {
+	for _, record := range pr.decayRecords() {
+		pr.writeDecayedRecord(record)
+	}
+}
+
+func (pr *peerReputation) decayRecords() []*decayedRecord {
+	pr.mut.Lock()
+	defer pr.mut.Unlock()
+
+	now := pr.getTimeHandler().Unix()
+	decayedRecords := make([]*decayedRecord, 0)
+	for key, record := range pr.records {
+		if record.Score == 0 && isBanned(record, now) {
+			continue
+		}
+
+		record.Score *= pr.decayCoefficient
+		if record.Score > -approximateZero {
+			record.Score = 0
+		}
+
+		decayed := &decayedRecord{
+			storerKey:  key,
+			generation: pr.getWriteGeneration(key),
+		}
+		if record.Score == 0 && !isBanned(record, now) {
+			delete(pr.records, key)
+			decayedRecords = append(decayedRecords, decayed)
+			continue
+		}
+
+		buff, err := pr.marshaller.Marshal(record)
+		if err != nil {
+			log.Warn("peerReputation: could not marshal record", "type", record.Type,
+				"key", displayKey(record.Type, record.Key), "error", err)
+			continue
+		}
+
+		decayed.buff = buff
+		decayedRecords = append(decayedRecords, decayed)
+	}
+
+	return decayedRecords
+}
+
+func (pr *peerReputation) getWriteGeneration(storerKey string) uint64 {
+	pr.mutStorer.Lock()
+	defer pr.mutStorer.Unlock()
+
+	return pr.writeGenerations[storerKey]
+}
+
+func (pr *peerReputation) writeDecayedRecord(record *decayedRecord) {
+	pr.mutStorer.Lock()
+	defer pr.mutStorer.Unlock()
+
+	if pr.writeGenerations[record.storerKey] != record.generation {
+		// the key was written after the decay, so the decayed record is stale
+		return
+	}
+
+	if record.buff == nil {
+		delete(pr.writeGenerations, record.storerKey)
+		err := pr.storer.Remove([]byte(record.storerKey))
+		if err != nil {
+			log.Warn("peerReputation: could not remove record", "key", record.storerKey, "error", err)
+		}
+
+		return
+	}
+
+	pr.writeGenerations[record.storerKey]++
+	err := pr.storer.Put([]byte(record.storerKey), record.buff)
+	if err != nil {
+		log.Warn("peerReputation: could not save decayed record", "key", record.storerKey, "error", err)
+	}
+}
+
+// BanPeer manually bans the provided peer ID for the provided duration
+func (pr *peerReputation) BanPeer(pid core.PeerID, duration time.Duration, reason string) error {
+	if len(pid) == 0 {
+		return ErrEmptyPeerID
+	}
+
+	return pr.manualBan(PeerType, string(pid), duration, reason)
+}
+
+// UnbanPeer removes the ban of the provided peer ID, regardless of how it was banned
+func (pr *peerReputation) UnbanPeer(pid core.PeerID) error {
+	if len(pid) == 0 {
+		return ErrEmptyPeerID
+	}
+
+	return pr.manualUnban(PeerType, string(pid))
+}
+
+// BanIP manually bans the provided IP address for the provided duration
+func (pr *peerReputation) BanIP(ip string, duration time.Duration, reason string) error {
+	normalizedIP, err := normalizeIP(ip)
+	if err != nil {
+		return err
+	}
+
+	return pr.manualBan(IPType, normalizedIP, duration, reason)
+}
+
+// UnbanIP removes the ban of the provided IP address
+func (pr *peerReputation) UnbanIP(ip string) error {
+	normalizedIP, err := normalizeIP(ip)
+	if err != nil {
+		return err
+	}
+
+	return pr.manualUnban(IPType, normalizedIP)
+}
+
+// IsIPDenied returns true if the provided IP address is currently banned
+func (pr *peerReputation) IsIPDenied(ip string) bool {
+	normalizedIP, err := normalizeIP(ip)
+	if err != nil {
+		return false
+	}
+
+	return pr.has(IPType, normalizedIP)
+}
+
+func (pr *peerReputation) manualBan(recordType string, key string, duration time.Duration, reason string) error {
+	if duration <= 0 {
+		return ErrInvalidBanDuration
+	}
+
+	pr.mut.Lock()
+	defer pr.mut.Unlock()
+
+	record := pr.getOrCreateRecordNoLock(recordType, key)
+	record.BanExpiry = pr.getTimeHandler().Add(duration).Unix()
+	record.IsManualBan = true
+	record.BlacklistReason = reason
+	record.OffendingTopic = ""
+	pr.changeScoreNoLock(record, -pr.blacklistPenalty, manualBanEvent)
+
+	log.Info("manually banned", "type", recordType, "key", displayKey(recordType, key), "duration", duration, "reason", reason)
+
+	return pr.saveRecordNoLock(createStorerKey(recordType, key), record)
+}
+
+func (pr *peerReputation) manualUnban(recordType string, key string) error {
+	pr.mut.Lock()
+	defer pr.mut.Unlock()
+
+	storerKey := createStorerKey(recordType, key)
+	record, found := pr.records[storerKey]
+	if !found || !isBanned(record, pr.getTimeHandler().Unix()) {
+		return fmt.Errorf("%w: %s %s", ErrNotBanned, recordType, displayKey(recordType, key))
+	}
+
+	record.BanExpiry = 0
+	record.IsManualBan = false
+	pr.changeScoreNoLock(record, 0, manualUnbanEvent)
+
+	log.Info("manually unbanned", "type", recordType, "key", displayKey(recordType, key))
+
+	return pr.saveRecordNoLock(storerKey, record)
+}
+
+func (pr *peerReputation) upsert(recordType string, key string, span time.Duration, reason string, topic string) error {
+	pr.mut.Lock()
+	defer pr.mut.Unlock()
+
+	now := pr.getTimeHandler()
+	record := pr.getOrCreateRecordNoLock(recordType, key)
+	wasBanned := isBanned(record, now.Unix())
+
+	// same as the time cache, the longest ban is kept
+	banExpiry := now.Add(span).Unix()
+	if banExpiry > record.BanExpiry {
+		record.BanExpiry = banExpiry
+	}
+	if wasBanned && record.IsManualBan {
+		return pr.saveRecordNoLock(createStorerKey(recordType, key), record)
+	}
+
+	record.IsManualBan = false
+	if len(reason) > 0 {
+		record.BlacklistReason = reason
+		record.OffendingTopic = topic
+	}
+	if !wasBanned {
+		pr.changeScoreNoLock(record, -pr.blacklistPenalty, blacklistEvent)
+	}
+
+	return pr.saveRecordNoLock(createStorerKey(recordType, key), record)
+}
+
+func (pr *peerReputation) has(recordType string, key string) bool {
+	pr.mut.RLock()
+	defer pr.mut.RUnlock()
+
+	record, found := pr.records[createStorerKey(recordType, key)]
+	if !found {
+		return false
+	}
+
+	return isBanned(record, pr.getTimeHandler().Unix())
+}
+
+func (pr *peerReputation) numBanned(recordType string) int {
+	pr.mut.RLock()
+	defer pr.mut.RUnlock()
+
+	now := pr.getTimeHandler().Unix()
+	counter := 0
+	for _, record := range pr.records {
+		if record.Type == recordType && isBanned(record, now) {
+			counter++
+		}
+	}
+
+	return counter
+}
+
+// sweep clears the expired bans of the provided type. The records are kept until their score decays to 0
+func (pr *peerReputation) sweep(recordType string) {
+	pr.mut.Lock()
+	defer pr.mut.Unlock()
+
+	now := pr.getTimeHandler().Unix()
+	for key, record := range pr.records {
+		if record.Type != recordType || record.BanExpiry == 0 || isBanned(record, now) {
+			continue
+		}
+
+		record.BanExpiry = 0
+		record.IsManualBan = false
+		pr.saveRecordNoLock(key, record)
+	}
+}
+
+// GetPeersReputation returns the reputation of all the known peer IDs, IP addresses and public keys, sorted by score
+func (pr *peerReputation) GetPeersReputation() []*common.PeerReputation {
+	pr.mut.RLock()
+	defer pr.mut.RUnlock()
+
+	now := pr.getTimeHandler().Unix()
+	reputations := make([]*common.PeerReputation, 0, len(pr.records))
+	for _, record := range pr.records {
+		banned := isBanned(record, now)
+		reputation := &common.PeerReputation{
+			Type:            record.Type,
+			Key:             displayKey(record.Type, record.Key),
+			Score:           record.Score,
+			ScoreHistory:    make([]common.PeerScoreChange, len(record.ScoreHistory)),
+			IsBanned:        banned,
+			IsManualBan:     banned && record.IsManualBan,
+			BlacklistReason: record.BlacklistReason,
+			OffendingTopic:  record.OffendingTopic,
+		}
+		if banned {
+			reputation.BanExpiry = record.BanExpiry
+		}
+		copy(reputation.ScoreHistory, record.ScoreHistory)
+
+		reputations = append(reputations, reputation)
+	}
+
+	sort.Slice(reputations, func(i, j int) bool {
+		if reputations[i].Score == reputations[j].Score {
+			return reputations[i].Key < reputations[j].Key
+		}
+		return reputations[i].Score < reputations[j].Score
+	})
+
+	return reputations
+}
+
+func (pr *peerReputation) getOrCreateRecordNoLock(recordType string, key string) *reputationRecord {
+	storerKey := createStorerKey(recordType, key)
+	record, found := pr.records[storerKey]
+	if found {
+		return record
+	}
+
+	record = &reputationRecord{
+		Type:         recordType,
+		Key:          key,
+		ScoreHistory: make([]common.PeerScoreChange, 0),
+	}
+	pr.records[storerKey] = record
+
+	return record
+}
+
+func (pr *peerReputation) changeScoreNoLock(record *reputationRecord, delta float64, event string) {
+	record.Score += delta
+	if record.Score < minScore {
+		record.Score = minScore
+	}
+
+	record.ScoreHistory = append(record.ScoreHistory, common.PeerScoreChange{
+		Timestamp: pr.getTimeHandler().Unix(),
+		Score:     record.Score,
+		Event:     event,
+	})
+	if len(record.ScoreHistory) > pr.maxScoreHistoryEntries {
+		record.ScoreHistory = record.ScoreHistory[len(record.ScoreHistory)-pr.maxScoreHistoryEntries:]
+	}
+}
+
+func (pr *peerReputation) saveRecordNoLock(storerKey string, record *reputationRecord) error {
+	buff, err := pr.marshaller.Marshal(record)
+	if err != nil {
+		log.Warn("peerReputation: could not marshal record", "type", record.Type,
+			"key", displayKey(record.Type, record.Key), "error", err)
+		return err
+	}
+
+	pr.mutStorer.Lock()
+	pr.writeGenerations[storerKey]++
+	err = pr.storer.Put([]byte(storerKey), buff)
+	pr.mutStorer.Unlock()
+	if err != nil {
+		log.Warn("peerReputation: could not save record", "type", record.Type,
+			"key", displayKey(record.Type, record.Key), "error", err)
+	}
+
+	return err
+}
+
+// PeersBlackListCacher returns the peer IDs blacklist backed by this registry
+func (pr *peerReputation) PeersBlackListCacher() process.PeerBlackListCacher {
+	return &peersBlackList{registry: pr}
+}
+
+// PublicKeysBlackListCacher returns the public keys blacklist backed by this registry
+func (pr *peerReputation) PublicKeysBlackListCacher() process.TimeCacher {
+	return &publicKeysBlackList{registry: pr}
+}
+
+// Close stops the decay go routine and closes the underlying storer
+func (pr *peerReputation) Close() error {
+	pr.cancelFunc()
+
+	return pr.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *peerReputation) IsInterfaceNil() bool {
+	return pr == nil
+}
+
+func isBanned(record *reputationRecord, now int64) bool {
+	return record.BanExpiry > now
+}
+
+func createStorerKey(recordType string, key string) string {
+	return recordType + keySeparator + key
+}
+
+func displayKey(recordType string, key string) string {
+	switch recordType {
+	case PeerType:
+		return core.PeerID(key).Pretty()
+	case PublicKeyType:
+		return hex.EncodeToString([]byte(key))
+	default:
+		return key
+	}
+}
+
+func normalizeIP(ip string) (string, error) {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidIPAddress, ip)
+	}
+
+	return parsedIP.String(), nil
+}
+
+func extractTopic(reason string) string {
+	matches := topicInReason.FindStringSubmatch(reason)
+	if len(matches) < 2 {
+		return ""
+	}
+
+	return matches[1]
+}
@@ -0,0 +1,432 @@
+package peerReputation
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var startTime = time.Unix(1700000000, 0)
+
+func createMockArgsPeerReputation() ArgsPeerReputation {
+	return ArgsPeerReputation{
+		Config: config.PeerReputationConfig{
+			Enabled:                      true,
+			DecayCoefficient:             0.5,
+			DecayUpdateIntervalInSeconds: 3600,
+			BlacklistPenalty:             10,
+			MaxScoreHistoryEntries:       3,
+		},
+		Storer:     testscommon.CreateMemUnit(),
+		Marshaller: &marshal.JsonMarshalizer{},
+	}
+}
+
+func createPeerReputationWithTime(tb testing.TB, args ArgsPeerReputation, currentTime *time.Time) *peerReputation {
+	pr, err := NewPeerReputation(args)
+	require.Nil(tb, err)
+	pr.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return pr
+}
+
+func TestNewPeerReputation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputation()
+		args.Storer = nil
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.True(t, errors.Is(err, ErrNilStorer))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputation()
+		args.Marshaller = nil
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.True(t, errors.Is(err, core.ErrNilMarshalizer))
+	})
+	t.Run("invalid decay coefficient should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputation()
+		args.Config.DecayCoefficient = 1
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.True(t, errors.Is(err, process.ErrInvalidDecayCoefficient))
+	})
+	t.Run("invalid decay interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputation()
+		args.Config.DecayUpdateIntervalInSeconds = 0
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.True(t, errors.Is(err, process.ErrInvalidDecayIntervalInSeconds))
+	})
+	t.Run("invalid blacklist penalty should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputation()
+		args.Config.BlacklistPenalty = 0
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.True(t, errors.Is(err, ErrInvalidBlacklistPenalty))
+	})
+	t.Run("invalid max score history entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeerReputation()
+		args.Config.MaxScoreHistoryEntries = 0
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.True(t, errors.Is(err, ErrInvalidMaxScoreHistoryEntries))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pr, err := NewPeerReputation(createMockArgsPeerReputation())
+		assert.False(t, check.IfNil(pr))
+		assert.Nil(t, err)
+		assert.Nil(t, pr.Close())
+	})
+}
+
+func TestPeerReputation_PeersBlackListCacher(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	pr := createPeerReputationWithTime(t, createMockArgsPeerReputation(), &currentTime)
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	blackList := pr.PeersBlackListCacher()
+	assert.False(t, blackList.Has(pid))
+
+	reason := "can not create object from received bytes, topic transactions_0, error invalid data"
+	err := blackList.UpsertWithReason(pid, time.Minute, reason)
+	require.Nil(t, err)
+	assert.True(t, blackList.Has(pid))
+
+	// an already blacklisted peer is not penalized again, the longest ban being kept
+	err = blackList.Upsert(pid, time.Second)
+	require.Nil(t, err)
+
+	reputations := pr.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, &common.PeerReputation{
+		Type:  PeerType,
+		Key:   pid.Pretty(),
+		Score: -10,
+		ScoreHistory: []common.PeerScoreChange{
+			{Timestamp: startTime.Unix(), Score: -10, Event: blacklistEvent},
+		},
+		IsBanned:        true,
+		BanExpiry:       startTime.Add(time.Minute).Unix(),
+		BlacklistReason: reason,
+		OffendingTopic:  "transactions_0",
+	}, reputations[0])
+
+	currentTime = startTime.Add(time.Minute)
+	assert.False(t, blackList.Has(pid))
+	blackList.Sweep()
+	reputations = pr.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.False(t, reputations[0].IsBanned)
+	assert.Equal(t, int64(0), reputations[0].BanExpiry)
+	assert.Equal(t, float64(-10), reputations[0].Score)
+}
+
+func TestPeerReputation_PublicKeysBlackListCacher(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	pr := createPeerReputationWithTime(t, createMockArgsPeerReputation(), &currentTime)
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	pk := "public key"
+	blackList := pr.PublicKeysBlackListCacher()
+	reasonRecorder, ok := blackList.(process.PublicKeyBlacklistReasonRecorder)
+	require.True(t, ok)
+	err := reasonRecorder.UpsertWithReason(pk, time.Minute, "bad score", "consensus_0")
+	require.Nil(t, err)
+	err = blackList.Add("another public key")
+	require.Nil(t, err)
+
+	assert.True(t, blackList.Has(pk))
+	assert.Equal(t, 2, blackList.Len())
+
+	reputations := pr.GetPeersReputation()
+	require.Equal(t, 2, len(reputations))
+	assert.Equal(t, hex.EncodeToString([]byte("another public key")), reputations[0].Key)
+	assert.Equal(t, hex.EncodeToString([]byte(pk)), reputations[1].Key)
+	assert.Equal(t, PublicKeyType, reputations[1].Type)
+	assert.Equal(t, "consensus_0", reputations[1].OffendingTopic)
+
+	currentTime = startTime.Add(time.Minute)
+	assert.False(t, blackList.Has(pk))
+	assert.Equal(t, 1, blackList.Len())
+}
+
+func TestPeerReputation_BanUnbanPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		pr, _ := NewPeerReputation(createMockArgsPeerReputation())
+		defer func() {
+			_ = pr.Close()
+		}()
+
+		assert.Equal(t, ErrEmptyPeerID, pr.BanPeer("", time.Minute, ""))
+		assert.Equal(t, ErrInvalidBanDuration, pr.BanPeer("pid", 0, ""))
+		assert.Equal(t, ErrEmptyPeerID, pr.UnbanPeer(""))
+		assert.True(t, errors.Is(pr.UnbanPeer("pid"), ErrNotBanned))
+	})
+	t.Run("manual ban should work and should not be overwritten by the automatic bans", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := startTime
+		pr := createPeerReputationWithTime(t, createMockArgsPeerReputation(), &currentTime)
+		defer func() {
+			_ = pr.Close()
+		}()
+
+		pid := core.PeerID("pid")
+		err := pr.BanPeer(pid, time.Hour, "spammer")
+		require.Nil(t, err)
+		assert.True(t, pr.PeersBlackListCacher().Has(pid))
+
+		err = pr.PeersBlackListCacher().UpsertWithReason(pid, time.Minute, "unmarshalable data got on topic headers")
+		require.Nil(t, err)
+
+		reputations := pr.GetPeersReputation()
+		require.Equal(t, 1, len(reputations))
+		assert.True(t, reputations[0].IsManualBan)
+		assert.Equal(t, "spammer", reputations[0].BlacklistReason)
+		assert.Equal(t, "", reputations[0].OffendingTopic)
+		assert.Equal(t, startTime.Add(time.Hour).Unix(), reputations[0].BanExpiry)
+		assert.Equal(t, float64(-10), reputations[0].Score)
+
+		err = pr.UnbanPeer(pid)
+		require.Nil(t, err)
+		assert.False(t, pr.PeersBlackListCacher().Has(pid))
+
+		reputations = pr.GetPeersReputation()
+		require.Equal(t, 1, len(reputations))
+		assert.False(t, reputations[0].IsBanned)
+		assert.False(t, reputations[0].IsManualBan)
+		assert.Equal(t, manualUnbanEvent, reputations[0].ScoreHistory[1].Event)
+	})
+}
+
+func TestPeerReputation_BanUnbanIP(t *testing.T) {
+	t.Parallel()
+
+	pr, _ := NewPeerReputation(createMockArgsPeerReputation())
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	err := pr.BanIP("not an IP", time.Minute, "")
+	assert.True(t, errors.Is(err, ErrInvalidIPAddress))
+	err = pr.UnbanIP("not an IP")
+	assert.True(t, errors.Is(err, ErrInvalidIPAddress))
+	assert.False(t, pr.IsIPDenied("not an IP"))
+
+	err = pr.BanIP("2001:0db8::0001", time.Minute, "")
+	require.Nil(t, err)
+	assert.True(t, pr.IsIPDenied("2001:db8::1"))
+	assert.False(t, pr.IsIPDenied("127.0.0.1"))
+
+	err = pr.UnbanIP("2001:db8::1")
+	require.Nil(t, err)
+	assert.False(t, pr.IsIPDenied("2001:db8::1"))
+}
+
+func TestPeerReputation_ScoreHistoryAndMinScore(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	args := createMockArgsPeerReputation()
+	args.Config.BlacklistPenalty = 40
+	pr := createPeerReputationWithTime(t, args, &currentTime)
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	for i := 0; i < 4; i++ {
+		currentTime = currentTime.Add(time.Hour)
+		err := pr.PeersBlackListCacher().Upsert(pid, time.Minute)
+		require.Nil(t, err)
+	}
+
+	reputations := pr.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, float64(-100), reputations[0].Score)
+	require.Equal(t, 3, len(reputations[0].ScoreHistory))
+	assert.Equal(t, float64(-80), reputations[0].ScoreHistory[0].Score)
+	assert.Equal(t, float64(-100), reputations[0].ScoreHistory[2].Score)
+	assert.Equal(t, currentTime.Unix(), reputations[0].ScoreHistory[2].Timestamp)
+}
+
+func TestPeerReputation_ApplyDecay(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	pr := createPeerReputationWithTime(t, createMockArgsPeerReputation(), &currentTime)
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	pidBanned := core.PeerID("banned")
+	pidExpired := core.PeerID("expired")
+	_ = pr.PeersBlackListCacher().Upsert(pidBanned, time.Hour)
+	_ = pr.PeersBlackListCacher().Upsert(pidExpired, time.Second)
+	currentTime = startTime.Add(time.Minute)
+
+	pr.applyDecay()
+	reputations := pr.GetPeersReputation()
+	require.Equal(t, 2, len(reputations))
+	assert.Equal(t, float64(-5), reputations[0].Score)
+	assert.Equal(t, float64(-5), reputations[1].Score)
+
+	for i := 0; i < 20; i++ {
+		pr.applyDecay()
+	}
+
+	// the expired record decayed to 0 so it is removed, the banned one is kept until the ban expires
+	reputations = pr.GetPeersReputation()
+	require.Equal(t, 1, len(reputations))
+	assert.Equal(t, pidBanned.Pretty(), reputations[0].Key)
+	assert.Equal(t, float64(0), reputations[0].Score)
+	assert.True(t, reputations[0].IsBanned)
+}
+
+func TestPeerReputation_ApplyDecayShouldWriteOnlyTheChangedRecords(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	args := createMockArgsPeerReputation()
+	writes := make(map[string]int)
+	args.Storer = &storageStubs.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			writes[string(key)]++
+			return nil
+		},
+		RemoveCalled: func(key []byte) error {
+			writes[string(key)]++
+			return nil
+		},
+	}
+	pr := createPeerReputationWithTime(t, args, &currentTime)
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	pidBanned := core.PeerID("banned")
+	pidExpired := core.PeerID("expired")
+	_ = pr.PeersBlackListCacher().Upsert(pidBanned, time.Hour)
+	_ = pr.PeersBlackListCacher().Upsert(pidExpired, time.Second)
+	currentTime = startTime.Add(time.Minute)
+	for i := 0; i < 20; i++ {
+		pr.applyDecay()
+	}
+
+	bannedKey := createStorerKey(PeerType, string(pidBanned))
+	expiredKey := createStorerKey(PeerType, string(pidExpired))
+	numBannedWrites := writes[bannedKey]
+	numExpiredWrites := writes[expiredKey]
+	require.Less(t, numBannedWrites, 21)
+	require.Less(t, numExpiredWrites, 21)
+
+	// the banned record has a 0 score and the expired one was removed, so there is nothing left to write
+	pr.applyDecay()
+	assert.Equal(t, numBannedWrites, writes[bannedKey])
+	assert.Equal(t, numExpiredWrites, writes[expiredKey])
+}
+
+func TestPeerReputation_ApplyDecayShouldNotOverwriteNewerRecords(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	args := createMockArgsPeerReputation()
+	pr := createPeerReputationWithTime(t, args, &currentTime)
+	defer func() {
+		_ = pr.Close()
+	}()
+
+	pid := core.PeerID("pid")
+	_ = pr.PeersBlackListCacher().Upsert(pid, time.Hour)
+	decayedRecords := pr.decayRecords()
+	require.Equal(t, 1, len(decayedRecords))
+
+	// the manual ban is written between the decay and the write of the decayed record
+	err := pr.BanPeer(pid, time.Hour, "spammer")
+	require.Nil(t, err)
+	pr.writeDecayedRecord(decayedRecords[0])
+
+	buff, err := args.Storer.Get([]byte(createStorerKey(PeerType, string(pid))))
+	require.Nil(t, err)
+	record := &reputationRecord{}
+	require.Nil(t, args.Marshaller.Unmarshal(record, buff))
+	assert.True(t, record.IsManualBan)
+	assert.Equal(t, "spammer", record.BlacklistReason)
+}
+
+func TestPeerReputation_ShouldRestoreFromStorer(t *testing.T) {
+	t.Parallel()
+
+	currentTime := startTime
+	args := createMockArgsPeerReputation()
+	pr := createPeerReputationWithTime(t, args, &currentTime)
+
+	pid := core.PeerID("pid")
+	err := pr.BanPeer(pid, time.Hour, "spammer")
+	require.Nil(t, err)
+	err = pr.BanIP("10.0.0.1", time.Hour, "")
+	require.Nil(t, err)
+	pr.cancelFunc()
+
+	restored := createPeerReputationWithTime(t, args, &currentTime)
+	defer func() {
+		_ = restored.Close()
+	}()
+
+	assert.True(t, restored.PeersBlackListCacher().Has(pid))
+	assert.True(t, restored.IsIPDenied("10.0.0.1"))
+	assert.Equal(t, pr.GetPeersReputation(), restored.GetPeersReputation())
+}
+
+func TestExtractTopic(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "transactions_0_1", extractTopic("can not create object from received bytes, topic transactions_0_1, error err"))
+	assert.Equal(t, "headers", extractTopic("unmarshalable data got on topic headers"))
+	assert.Equal(t, "", extractTopic("blacklisted due to invalid consensus message: err"))
+}
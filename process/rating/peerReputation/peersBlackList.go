@@ -0,0 +1,38 @@
+package peerReputation
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// peersBlackList is the peer IDs blacklist backed by the peer reputation registry
+type peersBlackList struct {
+	registry *peerReputation
+}
+
+// Upsert blacklists the provided peer ID for the provided span, without recording a reason
+func (pbl *peersBlackList) Upsert(pid core.PeerID, span time.Duration) error {
+	return pbl.registry.upsert(PeerType, string(pid), span, "", "")
+}
+
+// UpsertWithReason blacklists the provided peer ID for the provided span, recording the reason and the offending
+// topic, if the reason contains one
+func (pbl *peersBlackList) UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error {
+	return pbl.registry.upsert(PeerType, string(pid), span, reason, extractTopic(reason))
+}
+
+// Has returns true if the provided peer ID is blacklisted
+func (pbl *peersBlackList) Has(pid core.PeerID) bool {
+	return pbl.registry.has(PeerType, string(pid))
+}
+
+// Sweep clears the expired peer IDs bans
+func (pbl *peersBlackList) Sweep() {
+	pbl.registry.sweep(PeerType)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pbl *peersBlackList) IsInterfaceNil() bool {
+	return pbl == nil
+}
@@ -0,0 +1,45 @@
+package peerReputation
+
+import (
+	"time"
+)
+
+// publicKeysBlackList is the public keys blacklist backed by the peer reputation registry
+type publicKeysBlackList struct {
+	registry *peerReputation
+}
+
+// Add blacklists the provided public key for the default span
+func (pkbl *publicKeysBlackList) Add(pk string) error {
+	return pkbl.registry.upsert(PublicKeyType, pk, defaultSpan, "", "")
+}
+
+// Upsert blacklists the provided public key for the provided span, without recording a reason
+func (pkbl *publicKeysBlackList) Upsert(pk string, span time.Duration) error {
+	return pkbl.registry.upsert(PublicKeyType, pk, span, "", "")
+}
+
+// UpsertWithReason blacklists the provided public key for the provided span, recording the reason and the offending topic
+func (pkbl *publicKeysBlackList) UpsertWithReason(pk string, span time.Duration, reason string, topic string) error {
+	return pkbl.registry.upsert(PublicKeyType, pk, span, reason, topic)
+}
+
+// Has returns true if the provided public key is blacklisted
+func (pkbl *publicKeysBlackList) Has(pk string) bool {
+	return pkbl.registry.has(PublicKeyType, pk)
+}
+
+// Sweep clears the expired public keys bans
+func (pkbl *publicKeysBlackList) Sweep() {
+	pkbl.registry.sweep(PublicKeyType)
+}
+
+// Len returns the number of blacklisted public keys
+func (pkbl *publicKeysBlackList) Len() int {
+	return pkbl.registry.numBanned(PublicKeyType)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pkbl *publicKeysBlackList) IsInterfaceNil() bool {
+	return pkbl == nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
 	"github.com/multiversx/mx-chain-go/sharding"
@@ -387,7 +388,9 @@ func (sc *scProcessor) executeSmartContractCall(
 	defer sc.printBlockchainHookCounters(tx)
 
 	var vmOutput *vmcommon.VMOutput
+	startTime := time.Now()
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	observeSCExecution(metricsRegistry.OperationSCCall, startTime, vmOutput, err)
 
 	sc.wasmVMChangeLocker.RUnlock()
 	if err != nil {
@@ -416,6 +419,15 @@ func (sc *scProcessor) executeSmartContractCall(
 	return vmOutput, nil
 }
 
+func observeSCExecution(operation string, startTime time.Time, vmOutput *vmcommon.VMOutput, err error) {
+	returnCode := metricsRegistry.OutcomeError
+	if err == nil && vmOutput != nil {
+		returnCode = vmOutput.ReturnCode.String()
+	}
+
+	metricsRegistry.ObserveDuration(metricsRegistry.SCExecutionDuration.WithLabelValues(operation, returnCode), startTime)
+}
+
 func (sc *scProcessor) isInformativeTxHandler(txHandler data.TransactionHandler) bool {
 	if txHandler.GetValue().Cmp(zero) > 0 {
 		return false
@@ -1741,7 +1753,9 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
+	startTime := time.Now()
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	observeSCExecution(metricsRegistry.OperationSCDeploy, startTime, vmOutput, err)
 	sc.wasmVMChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -0,0 +1,167 @@
+package smartContract
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type queryExecutor func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
+
+// gasBudget is the combined gas budget of a multi-query. Each query reserves at most maxGasPerQuery before
+// its execution and gives back the unused gas afterwards
+type gasBudget struct {
+	mut            sync.Mutex
+	cond           *sync.Cond
+	maxGasPerQuery uint64
+	remaining      uint64
+	numInFlight    int
+}
+
+func newGasBudget(maxGasPerQuery uint64, maxGasPerMultiQuery uint64) *gasBudget {
+	if maxGasPerQuery == 0 {
+		maxGasPerQuery = MaxGasLimitPerQuery
+	}
+	if maxGasPerMultiQuery == 0 {
+		maxGasPerMultiQuery = math.MaxUint64
+	}
+
+	budget := &gasBudget{
+		maxGasPerQuery: maxGasPerQuery,
+		remaining:      maxGasPerMultiQuery,
+	}
+	budget.cond = sync.NewCond(&budget.mut)
+
+	return budget
+}
+
+// reserve waits until some gas is available. It fails only if the budget is exhausted and no other query is
+// in flight, as there is no more gas to be given back
+func (gb *gasBudget) reserve() (uint64, error) {
+	gb.mut.Lock()
+	defer gb.mut.Unlock()
+
+	for gb.remaining == 0 && gb.numInFlight > 0 {
+		gb.cond.Wait()
+	}
+	if gb.remaining == 0 {
+		return 0, process.ErrMultiQueryGasBudgetExceeded
+	}
+
+	reserved := gb.maxGasPerQuery
+	if reserved > gb.remaining {
+		reserved = gb.remaining
+	}
+	gb.remaining -= reserved
+	gb.numInFlight++
+
+	return reserved, nil
+}
+
+func (gb *gasBudget) release(reserved uint64, used uint64) {
+	gb.mut.Lock()
+	gb.remaining += reserved - used
+	gb.numInFlight--
+	gb.mut.Unlock()
+
+	gb.cond.Broadcast()
+}
+
+// executeMultiQuery executes the first query that succeeds in order to establish the block the whole batch is
+// executed on, then executes the remaining queries in parallel, pinned to that block
+func executeMultiQuery(
+	queries []*process.SCQuery,
+	executor queryExecutor,
+	numWorkers int,
+	budget *gasBudget,
+) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	if len(queries) == 0 {
+		return nil, nil, fmt.Errorf("%w of queries", process.ErrNilOrEmptyList)
+	}
+	for i, query := range queries {
+		if query == nil {
+			return nil, nil, fmt.Errorf("%w at index %d", process.ErrNilSCQuery, i)
+		}
+	}
+
+	results := make([]*process.SCQueryResult, len(queries))
+	var blockInfo common.BlockInfo
+	anchorIndex := 0
+	for ; anchorIndex < len(queries); anchorIndex++ {
+		results[anchorIndex], blockInfo = executeWithinBudget(copyQuery(queries[anchorIndex]), executor, budget)
+		if results[anchorIndex].Err == nil {
+			break
+		}
+	}
+	if anchorIndex >= len(queries)-1 {
+		return results, blockInfo, nil
+	}
+
+	anchor := queries[anchorIndex]
+	chanWorkers := make(chan struct{}, numWorkers)
+	wg := sync.WaitGroup{}
+	for i := anchorIndex + 1; i < len(queries); i++ {
+		chanWorkers <- struct{}{}
+		wg.Add(1)
+
+		go func(index int) {
+			defer func() {
+				<-chanWorkers
+				wg.Done()
+			}()
+
+			pinnedQuery := pinQueryToBlock(queries[index], anchor, blockInfo)
+			results[index], _ = executeWithinBudget(pinnedQuery, executor, budget)
+		}(i)
+	}
+	wg.Wait()
+
+	return results, blockInfo, nil
+}
+
+func executeWithinBudget(query *process.SCQuery, executor queryExecutor, budget *gasBudget) (*process.SCQueryResult, common.BlockInfo) {
+	reserved, err := budget.reserve()
+	if err != nil {
+		return &process.SCQueryResult{Err: err}, nil
+	}
+
+	query.GasLimit = reserved
+	vmOutput, blockInfo, err := executor(query)
+
+	used := reserved
+	if err == nil && vmOutput != nil && vmOutput.GasRemaining <= reserved {
+		used = reserved - vmOutput.GasRemaining
+	}
+	budget.release(reserved, used)
+
+	return &process.SCQueryResult{
+		VMOutput: vmOutput,
+		Err:      err,
+	}, blockInfo
+}
+
+// pinQueryToBlock applies the block coordinates of the anchor query. If the anchor query did not specify any
+// coordinate, the root hash it was executed on is used, so that all the queries observe the same state
+func pinQueryToBlock(query *process.SCQuery, anchor *process.SCQuery, blockInfo common.BlockInfo) *process.SCQuery {
+	pinnedQuery := copyQuery(query)
+	pinnedQuery.BlockNonce = anchor.BlockNonce
+	pinnedQuery.BlockHash = anchor.BlockHash
+	pinnedQuery.BlockRootHash = anchor.BlockRootHash
+	pinnedQuery.HintEpoch = anchor.HintEpoch
+
+	if !isHistoricalQuery(anchor) && !check.IfNil(blockInfo) && len(blockInfo.GetRootHash()) > 0 {
+		pinnedQuery.BlockRootHash = blockInfo.GetRootHash()
+	}
+
+	return pinnedQuery
+}
+
+func copyQuery(query *process.SCQuery) *process.SCQuery {
+	queryCopy := *query
+	return &queryCopy
+}
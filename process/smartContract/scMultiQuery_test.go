@@ -0,0 +1,185 @@
+package smartContract
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasBudget_Reserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero values should use defaults", func(t *testing.T) {
+		t.Parallel()
+
+		budget := newGasBudget(0, 0)
+		reserved, err := budget.reserve()
+		require.Nil(t, err)
+		require.Equal(t, uint64(MaxGasLimitPerQuery), reserved)
+	})
+	t.Run("should reserve at most the remaining gas", func(t *testing.T) {
+		t.Parallel()
+
+		budget := newGasBudget(100, 150)
+		reserved, err := budget.reserve()
+		require.Nil(t, err)
+		require.Equal(t, uint64(100), reserved)
+
+		reserved, err = budget.reserve()
+		require.Nil(t, err)
+		require.Equal(t, uint64(50), reserved)
+	})
+	t.Run("exhausted budget without queries in flight should error", func(t *testing.T) {
+		t.Parallel()
+
+		budget := newGasBudget(100, 100)
+		reserved, _ := budget.reserve()
+		budget.release(reserved, reserved)
+
+		_, err := budget.reserve()
+		require.Equal(t, process.ErrMultiQueryGasBudgetExceeded, err)
+	})
+	t.Run("exhausted budget should wait for the unused gas of the queries in flight", func(t *testing.T) {
+		t.Parallel()
+
+		budget := newGasBudget(100, 100)
+		reserved, _ := budget.reserve()
+
+		chanReserved := make(chan uint64)
+		go func() {
+			value, _ := budget.reserve()
+			chanReserved <- value
+		}()
+
+		select {
+		case <-chanReserved:
+			require.Fail(t, "should have waited for the query in flight")
+		case <-time.After(time.Millisecond * 50):
+		}
+
+		budget.release(reserved, 60)
+		select {
+		case value := <-chanReserved:
+			require.Equal(t, uint64(40), value)
+		case <-time.After(time.Second):
+			require.Fail(t, "should have reserved the unused gas")
+		}
+	})
+}
+
+func TestExecuteMultiQuery_InvalidQueriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	executor := func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+		require.Fail(t, "should have not been called")
+		return nil, nil, nil
+	}
+
+	results, _, err := executeMultiQuery(nil, executor, 1, newGasBudget(0, 0))
+	assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
+	assert.Nil(t, results)
+
+	results, _, err = executeMultiQuery([]*process.SCQuery{{}, nil}, executor, 1, newGasBudget(0, 0))
+	assert.True(t, errors.Is(err, process.ErrNilSCQuery))
+	assert.Nil(t, results)
+}
+
+func TestExecuteMultiQuery_ShouldPinQueriesToTheAnchorBlock(t *testing.T) {
+	t.Parallel()
+
+	providedBlockInfo := holders.NewBlockInfo([]byte("hash"), 10, []byte("root hash"))
+	expectedErr := errors.New("expected error")
+	mutQueries := sync.Mutex{}
+	executedQueries := make(map[string]*process.SCQuery)
+	executor := func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+		mutQueries.Lock()
+		executedQueries[query.FuncName] = query
+		mutQueries.Unlock()
+
+		if query.FuncName == "failing" {
+			return nil, nil, expectedErr
+		}
+
+		return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte(query.FuncName)}}, providedBlockInfo, nil
+	}
+
+	queries := []*process.SCQuery{
+		{FuncName: "failing"},
+		{FuncName: "anchor"},
+		{FuncName: "second"},
+		{FuncName: "third"},
+	}
+	results, blockInfo, err := executeMultiQuery(queries, executor, 2, newGasBudget(0, 0))
+	require.Nil(t, err)
+	require.Equal(t, providedBlockInfo, blockInfo)
+	require.Len(t, results, 4)
+	require.Equal(t, expectedErr, results[0].Err)
+	for i := 1; i < len(queries); i++ {
+		require.Nil(t, results[i].Err)
+		require.Equal(t, []byte(queries[i].FuncName), results[i].VMOutput.ReturnData[0])
+	}
+
+	require.Nil(t, executedQueries["anchor"].BlockRootHash)
+	require.Equal(t, []byte("root hash"), executedQueries["second"].BlockRootHash)
+	require.Equal(t, []byte("root hash"), executedQueries["third"].BlockRootHash)
+	// the provided queries are not altered
+	require.Nil(t, queries[2].BlockRootHash)
+}
+
+func TestExecuteMultiQuery_HistoricalAnchorCoordinatesShouldBeKept(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := core.OptionalUint64{Value: 7, HasValue: true}
+	mutQueries := sync.Mutex{}
+	executedQueries := make([]*process.SCQuery, 0)
+	executor := func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+		mutQueries.Lock()
+		executedQueries = append(executedQueries, query)
+		mutQueries.Unlock()
+
+		return &vmcommon.VMOutput{}, holders.NewBlockInfo([]byte("hash"), 7, []byte("root hash")), nil
+	}
+
+	queries := []*process.SCQuery{
+		{BlockNonce: blockNonce},
+		{},
+	}
+	_, _, err := executeMultiQuery(queries, executor, 1, newGasBudget(0, 0))
+	require.Nil(t, err)
+	require.Len(t, executedQueries, 2)
+	require.Equal(t, blockNonce, executedQueries[1].BlockNonce)
+	require.Nil(t, executedQueries[1].BlockRootHash)
+}
+
+func TestExecuteMultiQuery_ShouldEnforceTheGasBudget(t *testing.T) {
+	t.Parallel()
+
+	mutGasLimits := sync.Mutex{}
+	gasLimits := make([]uint64, 0)
+	executor := func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+		mutGasLimits.Lock()
+		gasLimits = append(gasLimits, query.GasLimit)
+		mutGasLimits.Unlock()
+
+		// each query consumes all the provided gas
+		return &vmcommon.VMOutput{}, nil, nil
+	}
+
+	queries := []*process.SCQuery{{}, {}, {}, {}}
+	results, _, err := executeMultiQuery(queries, executor, 1, newGasBudget(100, 250))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{100, 100, 50}, gasLimits)
+	for i := 0; i < 3; i++ {
+		require.Nil(t, results[i].Err)
+	}
+	require.Equal(t, process.ErrMultiQueryGasBudgetExceeded, results[3].Err)
+}
@@ -2,6 +2,7 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -169,6 +170,12 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return service.executeScCall(query, 0)
 }
 
+// ExecuteQueries executes the provided queries one after another, on the same block. The queries are not bounded
+// by a combined gas budget, each one being limited only by the maximum gas per query
+func (service *SCQueryService) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	return executeMultiQuery(queries, service.ExecuteQuery, 1, newGasBudget(service.gasForQuery, 0))
+}
+
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	select {
 	case <-service.allowExternalQueriesChan:
@@ -193,13 +200,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	}
 
 	if len(blockRootHash) > 0 {
-		err = service.apiBlockChain.SetCurrentBlockHeaderAndRootHash(blockHeader, blockRootHash)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		accountsAdapter := service.blockChainHook.GetAccountsAdapter()
-		err = accountsAdapter.RecreateTrie(blockRootHash)
+		err = service.recreateTrie(query, blockHeader, blockRootHash)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -247,7 +248,8 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 
 	var blockHash []byte
 	var blockNonce uint64
-	if !check.IfNil(blockHeader) {
+	isRootHashQuery := len(query.BlockRootHash) > 0
+	if !check.IfNil(blockHeader) && !isRootHashQuery {
 		blockNonce = blockHeader.GetNonce()
 		blockHash, err = core.CalculateHash(service.marshaller, service.hasher, blockHeader)
 		if err != nil {
@@ -258,9 +260,50 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	return vmOutput, blockInfo, nil
 }
 
+func (service *SCQueryService) recreateTrie(query *process.SCQuery, blockHeader data.HeaderHandler, blockRootHash []byte) error {
+	err := service.apiBlockChain.SetCurrentBlockHeaderAndRootHash(blockHeader, blockRootHash)
+	if err != nil {
+		return err
+	}
+
+	accountsAdapter := service.blockChainHook.GetAccountsAdapter()
+	if !isHistoricalQuery(query) {
+		return accountsAdapter.RecreateTrie(blockRootHash)
+	}
+
+	// historical queries recreate the trie in the epoch of the requested block, so that the state can be found
+	// on the nodes that do not clean the old epochs data. The consecutive queries on the same block reuse the trie
+	epoch := query.HintEpoch
+	if len(query.BlockRootHash) == 0 && !check.IfNil(blockHeader) {
+		epoch = core.OptionalUint32{Value: blockHeader.GetEpoch(), HasValue: true}
+	}
+
+	err = accountsAdapter.RecreateTrieFromEpoch(holders.NewRootHashHolder(blockRootHash, epoch))
+	if core.IsGetNodeFromDBError(err) {
+		return fmt.Errorf("%w, root hash: %s, epoch: %s", process.ErrStateNotAvailable, hex.EncodeToString(blockRootHash), epochToString(epoch))
+	}
+
+	return err
+}
+
+func isHistoricalQuery(query *process.SCQuery) bool {
+	return query.BlockNonce.HasValue || len(query.BlockHash) > 0 || len(query.BlockRootHash) > 0
+}
+
+func epochToString(epoch core.OptionalUint32) string {
+	if !epoch.HasValue {
+		return "not provided"
+	}
+
+	return fmt.Sprintf("%d", epoch.Value)
+}
+
 // TODO: extract duplicated code with nodeBlocks.go
 func (service *SCQueryService) extractBlockHeaderAndRootHash(query *process.SCQuery) (data.HeaderHandler, []byte, error) {
-
+	if len(query.BlockRootHash) > 0 {
+		// the block that produced the root hash is not known, the current block is used as the query's context
+		return service.mainBlockChain.GetCurrentBlockHeader(), query.BlockRootHash, nil
+	}
 	if len(query.BlockHash) > 0 {
 		currentHeader, err := service.getBlockHeaderByHash(query.BlockHash)
 		if err != nil {
@@ -403,7 +446,7 @@ func (service *SCQueryService) createVMCallInput(query *process.SCQuery, gasPric
 		CallerAddr:  query.CallerAddr,
 		CallValue:   query.CallValue,
 		GasPrice:    gasPrice,
-		GasProvided: service.gasForQuery,
+		GasProvided: service.getGasForQuery(query),
 		Arguments:   query.Arguments,
 		CallType:    vmData.DirectCall,
 	}
@@ -417,6 +460,14 @@ func (service *SCQueryService) createVMCallInput(query *process.SCQuery, gasPric
 	return vmContractCallInput
 }
 
+func (service *SCQueryService) getGasForQuery(query *process.SCQuery) uint64 {
+	if query.GasLimit > 0 && query.GasLimit < service.gasForQuery {
+		return query.GasLimit
+	}
+
+	return service.gasForQuery
+}
+
 func (service *SCQueryService) hasRetriableExecutionError(vmOutput *vmcommon.VMOutput) bool {
 	return vmOutput.ReturnMessage == "allocation error"
 }
@@ -11,17 +11,27 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+// ArgsScQueryServiceDispatcher holds the arguments needed to create a new smart contract query service dispatcher
+type ArgsScQueryServiceDispatcher struct {
+	List                []process.SCQueryService
+	MaxGasPerQuery      uint64
+	MaxGasPerMultiQuery uint64
+}
+
 type scQueryServiceDispatcher struct {
-	mutList     sync.RWMutex
-	list        []process.SCQueryService
-	mutIndex    sync.Mutex
-	index       int
-	maxListSize int
+	mutList             sync.RWMutex
+	list                []process.SCQueryService
+	mutIndex            sync.Mutex
+	index               int
+	maxListSize         int
+	maxGasPerQuery      uint64
+	maxGasPerMultiQuery uint64
 }
 
 // NewScQueryServiceDispatcher returns a smart contract query service dispatcher that for each function call
 // will forward the request towards the provided list in a round-robin fashion
-func NewScQueryServiceDispatcher(list []process.SCQueryService) (*scQueryServiceDispatcher, error) {
+func NewScQueryServiceDispatcher(args ArgsScQueryServiceDispatcher) (*scQueryServiceDispatcher, error) {
+	list := args.List
 	if len(list) == 0 {
 		return nil, fmt.Errorf("%w in NewScQueryServiceDispatcher", process.ErrNilOrEmptyList)
 	}
@@ -32,9 +42,11 @@ func NewScQueryServiceDispatcher(list []process.SCQueryService) (*scQueryService
 	}
 
 	return &scQueryServiceDispatcher{
-		list:        list,
-		maxListSize: len(list),
-		index:       0,
+		list:                list,
+		maxListSize:         len(list),
+		index:               0,
+		maxGasPerQuery:      args.MaxGasPerQuery,
+		maxGasPerMultiQuery: args.MaxGasPerMultiQuery,
 	}, nil
 }
 
@@ -48,6 +60,14 @@ func (sqsd *scQueryServiceDispatcher) ExecuteQuery(query *process.SCQuery) (*vmc
 	return sqsd.list[index].ExecuteQuery(query)
 }
 
+// ExecuteQueries executes the provided queries on the same block, in parallel on the elements from the provided list.
+// The gas consumed by all the queries is bounded by the maximum gas per multi-query
+func (sqsd *scQueryServiceDispatcher) ExecuteQueries(queries []*process.SCQuery) ([]*process.SCQueryResult, common.BlockInfo, error) {
+	budget := newGasBudget(sqsd.maxGasPerQuery, sqsd.maxGasPerMultiQuery)
+
+	return executeMultiQuery(queries, sqsd.ExecuteQuery, sqsd.maxListSize, budget)
+}
+
 // ComputeScCallGasLimit will call this method on one of the element from provided list
 func (sqsd *scQueryServiceDispatcher) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
 	index := sqsd.getNewIndex()
@@ -18,11 +18,11 @@ import (
 func TestNewScQueryServiceDispatcher_NilEmptyListShouldErr(t *testing.T) {
 	t.Parallel()
 
-	sqsd, err := NewScQueryServiceDispatcher(nil)
+	sqsd, err := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{})
 	assert.True(t, check.IfNil(sqsd))
 	assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
 
-	sqsd, err = NewScQueryServiceDispatcher(make([]process.SCQueryService, 0))
+	sqsd, err = NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: make([]process.SCQueryService, 0)})
 	assert.True(t, check.IfNil(sqsd))
 	assert.True(t, errors.Is(err, process.ErrNilOrEmptyList))
 }
@@ -30,11 +30,11 @@ func TestNewScQueryServiceDispatcher_NilEmptyListShouldErr(t *testing.T) {
 func TestNewScQueryServiceDispatcher_OneElementIsNilShouldErr(t *testing.T) {
 	t.Parallel()
 
-	sqsd, err := NewScQueryServiceDispatcher([]process.SCQueryService{
+	sqsd, err := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: []process.SCQueryService{
 		&mock.ScQueryStub{},
 		nil,
 		&mock.ScQueryStub{},
-	})
+	}})
 	assert.True(t, check.IfNil(sqsd))
 	assert.True(t, errors.Is(err, process.ErrNilScQueryElement))
 }
@@ -42,10 +42,10 @@ func TestNewScQueryServiceDispatcher_OneElementIsNilShouldErr(t *testing.T) {
 func TestNewScQueryServiceDispatcher_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	sqsd, err := NewScQueryServiceDispatcher([]process.SCQueryService{
+	sqsd, err := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: []process.SCQueryService{
 		&mock.ScQueryStub{},
 		&mock.ScQueryStub{},
-	})
+	}})
 	assert.False(t, check.IfNil(sqsd))
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(sqsd.list))
@@ -56,7 +56,7 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 
 	calledElement1 := 0
 	calledElement2 := 0
-	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+	sqsd, _ := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: []process.SCQueryService{
 		&mock.ScQueryStub{
 			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 				calledElement1++
@@ -71,7 +71,7 @@ func TestScQueryServiceDispatcher_ExecuteQueryShouldCallInRoundRobinFashion(t *t
 				return nil, nil, nil
 			},
 		},
-	})
+	}})
 
 	_, _, _ = sqsd.ExecuteQuery(nil)
 	_, _, _ = sqsd.ExecuteQuery(nil)
@@ -86,7 +86,7 @@ func TestScQueryServiceDispatcher_ComputeScCallGasLimitShouldCallInRoundRobinFas
 
 	calledElement1 := 0
 	calledElement2 := 0
-	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+	sqsd, _ := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: []process.SCQueryService{
 		&mock.ScQueryStub{
 			ComputeScCallGasLimitHandler: func(tx *transaction.Transaction) (uint64, error) {
 				calledElement1++
@@ -101,7 +101,7 @@ func TestScQueryServiceDispatcher_ComputeScCallGasLimitShouldCallInRoundRobinFas
 				return 0, nil
 			},
 		},
-	})
+	}})
 
 	_, _ = sqsd.ComputeScCallGasLimit(nil)
 	_, _ = sqsd.ComputeScCallGasLimit(nil)
@@ -116,7 +116,7 @@ func TestScQueryServiceDispatcher_ShouldWorkInAConcurrentManner(t *testing.T) {
 
 	calledElement1 := uint32(0)
 	calledElement2 := uint32(0)
-	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+	sqsd, _ := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: []process.SCQueryService{
 		&mock.ScQueryStub{
 			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
 				atomic.AddUint32(&calledElement1, 1)
@@ -141,7 +141,7 @@ func TestScQueryServiceDispatcher_ShouldWorkInAConcurrentManner(t *testing.T) {
 				return 0, nil
 			},
 		},
-	})
+	}})
 
 	numCalls := 100
 	wg := &sync.WaitGroup{}
@@ -163,13 +163,49 @@ func TestScQueryServiceDispatcher_ShouldWorkInAConcurrentManner(t *testing.T) {
 	assert.Equal(t, uint32(numCalls), atomic.LoadUint32(&calledElement2))
 }
 
+func TestScQueryServiceDispatcher_ExecuteQueriesShouldUseAllElements(t *testing.T) {
+	t.Parallel()
+
+	numCalledElement1 := uint32(0)
+	numCalledElement2 := uint32(0)
+	sqsd, _ := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{
+		List: []process.SCQueryService{
+			&mock.ScQueryStub{
+				ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+					atomic.AddUint32(&numCalledElement1, 1)
+					assert.Equal(t, uint64(1000), query.GasLimit)
+					return &vmcommon.VMOutput{GasRemaining: query.GasLimit}, nil, nil
+				},
+			},
+			&mock.ScQueryStub{
+				ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error) {
+					atomic.AddUint32(&numCalledElement2, 1)
+					assert.Equal(t, uint64(1000), query.GasLimit)
+					return &vmcommon.VMOutput{GasRemaining: query.GasLimit}, nil, nil
+				},
+			},
+		},
+		MaxGasPerQuery:      1000,
+		MaxGasPerMultiQuery: 1500,
+	})
+
+	results, _, err := sqsd.ExecuteQueries([]*process.SCQuery{{}, {}, {}, {}})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(results))
+	for _, result := range results {
+		assert.Nil(t, result.Err)
+	}
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalledElement1))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalledElement2))
+}
+
 func TestNewScQueryServiceDispatcher_CloseShouldWork(t *testing.T) {
 	t.Parallel()
 
 	expectedErr := errors.New("expected error")
 	closeCalled1 := false
 	closeCalled2 := false
-	sqsd, _ := NewScQueryServiceDispatcher([]process.SCQueryService{
+	sqsd, _ := NewScQueryServiceDispatcher(ArgsScQueryServiceDispatcher{List: []process.SCQueryService{
 		&mock.ScQueryStub{
 			CloseCalled: func() error {
 				closeCalled1 = true
@@ -182,7 +218,7 @@ func TestNewScQueryServiceDispatcher_CloseShouldWork(t *testing.T) {
 				return nil
 			},
 		},
-	})
+	}})
 
 	err := sqsd.Close()
 	assert.Equal(t, expectedErr, err)
@@ -2,10 +2,12 @@ package smartContract
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -16,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
@@ -410,6 +413,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 						}
 						hdr := &block.Header{
 							RootHash: providedRootHash,
+							Epoch:    7,
 						}
 						buff, _ := argsNewSCQuery.Marshaller.Marshal(hdr)
 						return buff, nil
@@ -427,9 +431,10 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		}
 		wasRecreateTrieCalled := false
 		providedAccountsAdapter := &stateMocks.AccountsStub{
-			RecreateTrieCalled: func(rootHash []byte) error {
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
 				wasRecreateTrieCalled = true
-				assert.Equal(t, providedRootHash, rootHash)
+				assert.Equal(t, providedRootHash, options.GetRootHash())
+				assert.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, options.GetEpoch())
 				return nil
 			},
 		}
@@ -503,6 +508,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 						}
 						hdr := &block.Header{
 							RootHash: providedRootHash,
+							Epoch:    7,
 						}
 						buff, _ := argsNewSCQuery.Marshaller.Marshal(hdr)
 						return buff, nil
@@ -521,9 +527,10 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		}
 		wasRecreateTrieCalled := false
 		providedAccountsAdapter := &stateMocks.AccountsStub{
-			RecreateTrieCalled: func(rootHash []byte) error {
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
 				wasRecreateTrieCalled = true
-				assert.Equal(t, providedRootHash, rootHash)
+				assert.Equal(t, providedRootHash, options.GetRootHash())
+				assert.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, options.GetEpoch())
 				return nil
 			},
 		}
@@ -555,6 +562,79 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 	})
 }
 
+func TestExecuteQuery_BlockRootHash(t *testing.T) {
+	t.Parallel()
+
+	providedRootHash := []byte("provided root hash")
+	hintEpoch := core.OptionalUint32{Value: 3, HasValue: true}
+	createArgs := func(accountsAdapter state.AccountsAdapter) ArgsNewSCQueryService {
+		argsNewSCQuery := createMockArgumentsForSCQuery()
+		argsNewSCQuery.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						return &vmcommon.VMOutput{
+							ReturnCode: vmcommon.Ok,
+						}, nil
+					},
+				}, nil
+			},
+		}
+		argsNewSCQuery.MainBlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{Nonce: 100}
+			},
+		}
+		argsNewSCQuery.BlockChainHook = &testscommon.BlockChainHookStub{
+			GetAccountsAdapterCalled: func() state.AccountsAdapter {
+				return accountsAdapter
+			},
+		}
+
+		return argsNewSCQuery
+	}
+	query := &process.SCQuery{
+		ScAddress:     []byte(DummyScAddress),
+		FuncName:      "function",
+		BlockRootHash: providedRootHash,
+		HintEpoch:     hintEpoch,
+	}
+
+	t.Run("should recreate the trie in the hint epoch", func(t *testing.T) {
+		t.Parallel()
+
+		wasRecreateTrieCalled := false
+		accountsAdapter := &stateMocks.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				wasRecreateTrieCalled = true
+				assert.Equal(t, providedRootHash, options.GetRootHash())
+				assert.Equal(t, hintEpoch, options.GetEpoch())
+				return nil
+			},
+		}
+		target, _ := NewSCQueryService(createArgs(accountsAdapter))
+
+		_, blockInfo, err := target.ExecuteQuery(query)
+		require.Nil(t, err)
+		require.True(t, wasRecreateTrieCalled)
+		require.Equal(t, holders.NewBlockInfo(nil, 0, providedRootHash), blockInfo)
+	})
+	t.Run("pruned root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsAdapter := &stateMocks.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				return core.NewGetNodeFromDBErrWithKey(options.GetRootHash(), errors.New("key not found"), "AccountsUnit")
+			},
+		}
+		target, _ := NewSCQueryService(createArgs(accountsAdapter))
+
+		_, _, err := target.ExecuteQuery(query)
+		require.True(t, errors.Is(err, process.ErrStateNotAvailable))
+		require.True(t, strings.Contains(err.Error(), hex.EncodeToString(providedRootHash)))
+	})
+}
+
 func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 	t.Parallel()
 
@@ -92,7 +92,8 @@ func (pbp *p2pBlackListProcessor) ResetStatistics() {
 				"peer ID", pid.Pretty(),
 				"ban period", pbp.banDuration,
 			)
-			err := pbp.peerBlacklistCacher.Upsert(pid, pbp.banDuration)
+			reason := fmt.Sprintf("flooding detected by the %s flood preventer", pbp.name)
+			err := pbp.peerBlacklistCacher.UpsertWithReason(pid, pbp.banDuration, reason)
 			if err != nil {
 				log.Warn("error adding peer id in peer ids cache", ""+
 					"pid", p2p.PeerIdToShortString(pid),
@@ -411,9 +411,10 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsUnderNumFloodingRoundsShouldN
 			},
 		},
 		&mock.PeerBlackListHandlerStub{
-			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string) error {
 				upsertCalled = true
 				assert.Equal(t, duration, span)
+				assert.Contains(t, reason, "flooding detected")
 
 				return nil
 			},
@@ -456,9 +457,10 @@ func TestP2PQuotaBlacklistProcessor_ResetStatisticsOverNumFloodingRoundsShouldBl
 			},
 		},
 		&mock.PeerBlackListHandlerStub{
-			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string) error {
 				upsertCalled = true
 				assert.Equal(t, duration, span)
+				assert.Contains(t, reason, "flooding detected")
 
 				return nil
 			},
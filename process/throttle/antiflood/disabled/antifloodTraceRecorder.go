@@ -0,0 +1,25 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+// AntifloodTraceRecorder is a disabled instance of the antiflood trace recorder
+type AntifloodTraceRecorder struct {
+}
+
+// RecordMessage does nothing
+func (atr *AntifloodTraceRecorder) RecordMessage(_ core.PeerID, _ core.PeerID, _ string, _ uint64, _ []byte) {
+}
+
+// RecordMessagesOnTopic does nothing
+func (atr *AntifloodTraceRecorder) RecordMessagesOnTopic(_ core.PeerID, _ string, _ uint32, _ uint64, _ []byte) {
+}
+
+// Close returns nil
+func (atr *AntifloodTraceRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (atr *AntifloodTraceRecorder) IsInterfaceNil() bool {
+	return atr == nil
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-go/config"
+
+// ConfigReloadHandler is the config reload handler used when the antiflood is disabled
+type ConfigReloadHandler struct {
+}
+
+// ValidateConfig returns nil
+func (handler *ConfigReloadHandler) ValidateConfig(_ *config.Configs) error {
+	return nil
+}
+
+// ApplyConfig returns nil
+func (handler *ConfigReloadHandler) ApplyConfig(_ *config.Configs) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *ConfigReloadHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
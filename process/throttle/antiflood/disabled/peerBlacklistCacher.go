@@ -19,6 +19,11 @@ func (pbc *PeerBlacklistCacher) Upsert(_ core.PeerID, _ time.Duration) error {
 	return nil
 }
 
+// UpsertWithReason does nothing
+func (pbc *PeerBlacklistCacher) UpsertWithReason(_ core.PeerID, _ time.Duration, _ string) error {
+	return nil
+}
+
 // Sweep does nothing
 func (pbc *PeerBlacklistCacher) Sweep() {
 }
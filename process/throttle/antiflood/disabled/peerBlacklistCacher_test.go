@@ -25,5 +25,8 @@ func TestBlacklistHandler_ShouldNotPanic(t *testing.T) {
 	err := pbc.Upsert("", time.Second)
 	assert.Nil(t, err)
 
+	err = pbc.UpsertWithReason("", time.Second, "reason")
+	assert.Nil(t, err)
+
 	pbc.Sweep()
 }
@@ -0,0 +1,127 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
+)
+
+// reloadableFloodPreventer defines a flood preventer able to change its per peer limits at runtime
+type reloadableFloodPreventer interface {
+	process.FloodPreventer
+	SetPeerMaxInput(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64, percentReserved float32) error
+}
+
+type peerMaxInput struct {
+	baseMessagesPerInterval uint32
+	totalSizePerInterval    uint64
+	reservedPercent         float32
+}
+
+type reloadableFloodPreventerHolder struct {
+	identifier     string
+	getConfig      func(antifloodConfig config.AntifloodConfig) config.FloodPreventerConfig
+	floodPreventer reloadableFloodPreventer
+	lastApplied    peerMaxInput
+}
+
+type antifloodReloadHandler struct {
+	mut     sync.Mutex
+	holders []*reloadableFloodPreventerHolder
+}
+
+func newAntifloodReloadHandler(
+	antifloodConfig config.AntifloodConfig,
+	fastReactingFloodPreventer reloadableFloodPreventer,
+	slowReactingFloodPreventer reloadableFloodPreventer,
+	outOfSpecsFloodPreventer reloadableFloodPreventer,
+) *antifloodReloadHandler {
+	holders := []*reloadableFloodPreventerHolder{
+		{
+			identifier: fastReactingIdentifier,
+			getConfig: func(antifloodConfig config.AntifloodConfig) config.FloodPreventerConfig {
+				return antifloodConfig.FastReacting
+			},
+			floodPreventer: fastReactingFloodPreventer,
+		},
+		{
+			identifier: slowReactingIdentifier,
+			getConfig: func(antifloodConfig config.AntifloodConfig) config.FloodPreventerConfig {
+				return antifloodConfig.SlowReacting
+			},
+			floodPreventer: slowReactingFloodPreventer,
+		},
+		{
+			identifier: outOfSpecsIdentifier,
+			getConfig: func(antifloodConfig config.AntifloodConfig) config.FloodPreventerConfig {
+				return antifloodConfig.OutOfSpecs
+			},
+			floodPreventer: outOfSpecsFloodPreventer,
+		},
+	}
+
+	for _, holder := range holders {
+		holder.lastApplied = getPeerMaxInput(holder.getConfig(antifloodConfig))
+	}
+
+	return &antifloodReloadHandler{
+		holders: holders,
+	}
+}
+
+func getPeerMaxInput(floodPreventerConfig config.FloodPreventerConfig) peerMaxInput {
+	return peerMaxInput{
+		baseMessagesPerInterval: floodPreventerConfig.PeerMaxInput.BaseMessagesPerInterval,
+		totalSizePerInterval:    floodPreventerConfig.PeerMaxInput.TotalSizePerInterval,
+		reservedPercent:         floodPreventerConfig.ReservedPercent,
+	}
+}
+
+// ValidateConfig checks the reloaded per peer limits of the fast reacting, slow reacting and out of specs flood preventers
+func (handler *antifloodReloadHandler) ValidateConfig(configs *config.Configs) error {
+	for _, holder := range handler.holders {
+		input := getPeerMaxInput(holder.getConfig(configs.GeneralConfig.Antiflood))
+		err := floodPreventers.CheckPeerMaxInput(input.baseMessagesPerInterval, input.totalSizePerInterval, input.reservedPercent)
+		if err != nil {
+			return fmt.Errorf("%w for the %s flood preventer", err, holder.identifier)
+		}
+	}
+
+	return nil
+}
+
+// ApplyConfig sets the reloaded per peer limits on the flood preventers whose values changed
+func (handler *antifloodReloadHandler) ApplyConfig(configs *config.Configs) error {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	for _, holder := range handler.holders {
+		input := getPeerMaxInput(holder.getConfig(configs.GeneralConfig.Antiflood))
+		if input == holder.lastApplied {
+			continue
+		}
+
+		err := holder.floodPreventer.SetPeerMaxInput(input.baseMessagesPerInterval, input.totalSizePerInterval, input.reservedPercent)
+		if err != nil {
+			return fmt.Errorf("%w for the %s flood preventer", err, holder.identifier)
+		}
+
+		holder.lastApplied = input
+		log.Info("antiflood peer limits reloaded",
+			"type", holder.identifier,
+			"base peerMaxMessagesPerInterval", input.baseMessagesPerInterval,
+			"peerMaxTotalSizePerInterval", input.totalSizePerInterval,
+			"reservedPercent", input.reservedPercent,
+		)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *antifloodReloadHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
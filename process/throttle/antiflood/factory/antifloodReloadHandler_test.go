@@ -0,0 +1,119 @@
+package factory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type reloadableFloodPreventerStub struct {
+	mock.FloodPreventerStub
+	setPeerMaxInputCalled func(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64, percentReserved float32) error
+}
+
+func (stub *reloadableFloodPreventerStub) SetPeerMaxInput(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64, percentReserved float32) error {
+	if stub.setPeerMaxInputCalled != nil {
+		return stub.setPeerMaxInputCalled(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer, percentReserved)
+	}
+
+	return nil
+}
+
+func createReloadConfigs(antifloodConfig config.AntifloodConfig) *config.Configs {
+	return &config.Configs{
+		GeneralConfig: &config.Config{
+			Antiflood: antifloodConfig,
+		},
+	}
+}
+
+func createReloadAntifloodConfig() config.AntifloodConfig {
+	return config.AntifloodConfig{
+		FastReacting: createFloodPreventerConfig(),
+		SlowReacting: createFloodPreventerConfig(),
+		OutOfSpecs:   createFloodPreventerConfig(),
+	}
+}
+
+func TestAntifloodReloadHandler_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	antifloodConfig := createReloadAntifloodConfig()
+	handler := newAntifloodReloadHandler(
+		antifloodConfig,
+		&reloadableFloodPreventerStub{},
+		&reloadableFloodPreventerStub{},
+		&reloadableFloodPreventerStub{},
+	)
+	assert.False(t, check.IfNil(handler))
+	assert.Nil(t, handler.ValidateConfig(createReloadConfigs(antifloodConfig)))
+
+	antifloodConfig.SlowReacting.PeerMaxInput.BaseMessagesPerInterval = 0
+	err := handler.ValidateConfig(createReloadConfigs(antifloodConfig))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.Contains(t, err.Error(), slowReactingIdentifier)
+}
+
+func TestAntifloodReloadHandler_ApplyConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should apply only on the changed flood preventers", func(t *testing.T) {
+		t.Parallel()
+
+		antifloodConfig := createReloadAntifloodConfig()
+		numFastCalls := 0
+		numSlowCalls := 0
+		handler := newAntifloodReloadHandler(
+			antifloodConfig,
+			&reloadableFloodPreventerStub{
+				setPeerMaxInputCalled: func(_ uint32, _ uint64, _ float32) error {
+					numFastCalls++
+					return nil
+				},
+			},
+			&reloadableFloodPreventerStub{
+				setPeerMaxInputCalled: func(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64, percentReserved float32) error {
+					numSlowCalls++
+					assert.Equal(t, uint32(37), baseMaxNumMessagesPerPeer)
+					assert.Equal(t, antifloodConfig.SlowReacting.PeerMaxInput.TotalSizePerInterval, maxTotalSizePerPeer)
+					assert.Equal(t, float32(15), percentReserved)
+					return nil
+				},
+			},
+			&reloadableFloodPreventerStub{},
+		)
+
+		newAntifloodConfig := createReloadAntifloodConfig()
+		newAntifloodConfig.SlowReacting.PeerMaxInput.BaseMessagesPerInterval = 37
+		newAntifloodConfig.SlowReacting.ReservedPercent = 15
+		assert.Nil(t, handler.ApplyConfig(createReloadConfigs(newAntifloodConfig)))
+		assert.Nil(t, handler.ApplyConfig(createReloadConfigs(newAntifloodConfig)))
+		assert.Equal(t, 0, numFastCalls)
+		assert.Equal(t, 1, numSlowCalls)
+	})
+	t.Run("set error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		antifloodConfig := createReloadAntifloodConfig()
+		handler := newAntifloodReloadHandler(
+			antifloodConfig,
+			&reloadableFloodPreventerStub{
+				setPeerMaxInputCalled: func(_ uint32, _ uint64, _ float32) error {
+					return expectedErr
+				},
+			},
+			&reloadableFloodPreventerStub{},
+			&reloadableFloodPreventerStub{},
+		)
+
+		antifloodConfig.FastReacting.PeerMaxInput.TotalSizePerInterval++
+		err := handler.ApplyConfig(createReloadConfigs(antifloodConfig))
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+}
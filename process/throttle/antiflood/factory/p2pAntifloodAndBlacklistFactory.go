@@ -7,6 +7,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	antifloodDebug "github.com/multiversx/mx-chain-go/debug/antiflood"
 	"github.com/multiversx/mx-chain-go/p2p"
@@ -16,7 +17,6 @@ import (
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/floodPreventers"
 	"github.com/multiversx/mx-chain-go/statusHandler/p2pQuota"
-	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -24,7 +24,6 @@ import (
 
 var log = logger.GetOrCreate("p2p/antiflood/factory")
 
-const defaultSpan = 300 * time.Second
 const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
@@ -34,28 +33,40 @@ var durationSweepP2PBlacklist = time.Second * 5
 
 // AntiFloodComponents holds the handlers for the anti-flood and blacklist mechanisms
 type AntiFloodComponents struct {
-	AntiFloodHandler process.P2PAntifloodHandler
-	BlacklistHandler process.PeerBlackListCacher
-	FloodPreventers  []process.FloodPreventer
-	TopicPreventer   process.TopicFloodPreventer
-	PubKeysCacher    process.TimeCacher
+	AntiFloodHandler    process.P2PAntifloodHandler
+	BlacklistHandler    process.PeerBlackListCacher
+	FloodPreventers     []process.FloodPreventer
+	TopicPreventer      process.TopicFloodPreventer
+	PubKeysCacher       process.TimeCacher
+	ConfigReloadHandler common.ConfigReloadHandler
 }
 
-// NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
-func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, statusHandler core.AppStatusHandler, currentPid core.PeerID) (*AntiFloodComponents, error) {
+// NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config. The blacklists are
+// provided by the peer reputation handler
+func NewP2PAntiFloodComponents(
+	ctx context.Context,
+	config config.Config,
+	statusHandler core.AppStatusHandler,
+	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
+) (*AntiFloodComponents, error) {
 	if check.IfNil(statusHandler) {
 		return nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(peerReputationHandler) {
+		return nil, process.ErrNilPeerReputationHandler
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid)
+		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid, peerReputationHandler)
 	}
 
 	return &AntiFloodComponents{
-		AntiFloodHandler: &disabled.AntiFlood{},
-		BlacklistHandler: &disabled.PeerBlacklistCacher{},
-		FloodPreventers:  make([]process.FloodPreventer, 0),
-		TopicPreventer:   disabled.NewNilTopicFloodPreventer(),
-		PubKeysCacher:    &disabled.TimeCache{},
+		AntiFloodHandler:    &disabled.AntiFlood{},
+		BlacklistHandler:    &disabled.PeerBlacklistCacher{},
+		FloodPreventers:     make([]process.FloodPreventer, 0),
+		TopicPreventer:      disabled.NewNilTopicFloodPreventer(),
+		PubKeysCacher:       &disabled.TimeCache{},
+		ConfigReloadHandler: &disabled.ConfigReloadHandler{},
 	}, nil
 }
 
@@ -64,14 +75,10 @@ func initP2PAntiFloodComponents(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	peerReputationHandler process.PeerReputationHandler,
 ) (*AntiFloodComponents, error) {
-	timeCache := cache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := cache.NewPeerTimeCache(timeCache)
-	if err != nil {
-		return nil, err
-	}
-
-	publicKeysCache := cache.NewTimeCache(defaultSpan)
+	p2pPeerBlackList := peerReputationHandler.PeersBlackListCacher()
+	publicKeysCache := peerReputationHandler.PublicKeysBlackListCacher()
 
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		ctx,
@@ -143,6 +150,18 @@ func initP2PAntiFloodComponents(
 		}
 	}
 
+	if mainConfig.Debug.Antiflood.Trace.Enabled {
+		traceRecorder, errRecorder := antifloodDebug.NewTraceRecorder(mainConfig.Debug.Antiflood.Trace)
+		if errRecorder != nil {
+			return nil, errRecorder
+		}
+
+		err = p2pAntiflood.SetTraceRecorder(traceRecorder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	startResettingTopicFloodPreventer(ctx, topicFloodPreventer, topicMaxMessages)
 	startSweepingTimeCaches(ctx, p2pPeerBlackList, publicKeysCache)
 
@@ -156,6 +175,12 @@ func initP2PAntiFloodComponents(
 			outOfSpecsFloodPreventer,
 		},
 		TopicPreventer: topicFloodPreventer,
+		ConfigReloadHandler: newAntifloodReloadHandler(
+			mainConfig.Antiflood,
+			fastReactingFloodPreventer,
+			slowReactingFloodPreventer,
+			outOfSpecsFloodPreventer,
+		),
 	}, nil
 }
 
@@ -218,7 +243,7 @@ func createFloodPreventer(
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
 	selfPid core.PeerID,
-) (process.FloodPreventer, error) {
+) (reloadableFloodPreventer, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageunit.NewCache(cacheConfig)
 	if err != nil {
@@ -2,12 +2,16 @@ package factory
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+	peerReputationDisabled "github.com/multiversx/mx-chain-go/process/rating/peerReputation/disabled"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -20,11 +24,22 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 
 	ctx := context.Background()
 	cfg := config.Config{}
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid, createPeerReputationHandler())
 	assert.Nil(t, components)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := config.Config{}
+	ash := statusHandler.NewAppStatusHandlerMock()
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, nil)
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -35,16 +50,18 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 	}
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, createPeerReputationHandler())
 	assert.NotNil(t, components)
 	assert.Nil(t, err)
 
 	_, ok1 := components.AntiFloodHandler.(*disabled.AntiFlood)
 	_, ok2 := components.BlacklistHandler.(*disabled.PeerBlacklistCacher)
 	_, ok3 := components.PubKeysCacher.(*disabled.TimeCache)
+	_, ok4 := components.ConfigReloadHandler.(*disabled.ConfigReloadHandler)
 	assert.True(t, ok1)
 	assert.True(t, ok2)
 	assert.True(t, ok3)
+	assert.True(t, ok4)
 }
 
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *testing.T) {
@@ -69,17 +86,79 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, createPeerReputationHandler())
 	assert.Nil(t, err)
 	assert.NotNil(t, components.AntiFloodHandler)
 	assert.NotNil(t, components.BlacklistHandler)
 	assert.NotNil(t, components.PubKeysCacher)
+	assert.NotNil(t, components.ConfigReloadHandler)
 
 	// we need this time sleep as to allow the code coverage tool to deterministically compute the code coverage
 	//on the go routines that are automatically launched
 	time.Sleep(time.Second * 2)
 }
 
+func TestNewP2PAntiFloodAndBlackList_TraceRecorder(t *testing.T) {
+	t.Parallel()
+
+	createConfig := func(traceConfig config.AntifloodTraceConfig) config.Config {
+		return config.Config{
+			Antiflood: config.AntifloodConfig{
+				Enabled: true,
+				Cache: config.CacheConfig{
+					Type:     "LRU",
+					Capacity: 10,
+					Shards:   2,
+				},
+				FastReacting: createFloodPreventerConfig(),
+				SlowReacting: createFloodPreventerConfig(),
+				OutOfSpecs:   createFloodPreventerConfig(),
+				Topic: config.TopicAntifloodConfig{
+					DefaultMaxMessagesPerSec: 10,
+				},
+			},
+			Debug: config.DebugConfig{
+				Antiflood: config.AntifloodDebugConfig{
+					Trace: traceConfig,
+				},
+			},
+		}
+	}
+
+	t.Run("invalid trace config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.AntifloodTraceConfig{
+			Enabled:         true,
+			Directory:       t.TempDir(),
+			BufferSize:      0,
+			MaxFileSizeInMB: 1,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		components, err := NewP2PAntiFloodComponents(ctx, cfg, statusHandler.NewAppStatusHandlerMock(), currentPid, createPeerReputationHandler())
+		assert.Nil(t, components)
+		assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createConfig(config.AntifloodTraceConfig{
+			Enabled:         true,
+			Directory:       t.TempDir(),
+			BufferSize:      10,
+			MaxFileSizeInMB: 1,
+		})
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		components, err := NewP2PAntiFloodComponents(ctx, cfg, statusHandler.NewAppStatusHandlerMock(), currentPid, createPeerReputationHandler())
+		assert.Nil(t, err)
+		assert.Nil(t, components.AntiFloodHandler.Close())
+	})
+}
+
 func createFloodPreventerConfig() config.FloodPreventerConfig {
 	return config.FloodPreventerConfig{
 		IntervalInSeconds: 1,
@@ -95,3 +174,9 @@ func createFloodPreventerConfig() config.FloodPreventerConfig {
 		},
 	}
 }
+
+func createPeerReputationHandler() process.PeerReputationHandler {
+	peerReputationHandler, _ := peerReputationDisabled.NewDisabledPeerReputation()
+
+	return peerReputationHandler
+}
@@ -59,7 +59,6 @@ type quotaFloodPreventer struct {
 
 // NewQuotaFloodPreventer creates a new flood preventer based on quota / peer
 func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, error) {
-
 	if check.IfNil(arg.Cacher) {
 		return nil, process.ErrNilCacher
 	}
@@ -68,33 +67,9 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 			return nil, process.ErrNilQuotaStatusHandler
 		}
 	}
-	if arg.BaseMaxNumMessagesPerPeer < minMessages {
-		return nil, fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
-			process.ErrInvalidValue,
-			arg.BaseMaxNumMessagesPerPeer,
-			minMessages,
-		)
-	}
-	if arg.MaxTotalSizePerPeer < minTotalSize {
-		return nil, fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
-			process.ErrInvalidValue,
-			arg.MaxTotalSizePerPeer,
-			minTotalSize,
-		)
-	}
-	if arg.PercentReserved > maxPercentReserved {
-		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
-			process.ErrInvalidValue,
-			arg.PercentReserved,
-			maxPercentReserved,
-		)
-	}
-	if arg.PercentReserved < minPercentReserved {
-		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, minimum %0.3f",
-			process.ErrInvalidValue,
-			arg.PercentReserved,
-			minPercentReserved,
-		)
+	err := CheckPeerMaxInput(arg.BaseMaxNumMessagesPerPeer, arg.MaxTotalSizePerPeer, arg.PercentReserved)
+	if err != nil {
+		return nil, err
 	}
 	if arg.IncreaseFactor < 0 {
 		return nil, fmt.Errorf("%w, increaseFactor is negative: provided %0.3f",
@@ -116,6 +91,40 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 	}, nil
 }
 
+// CheckPeerMaxInput returns error if the provided per peer limits can not be used by a quota flood preventer
+func CheckPeerMaxInput(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64, percentReserved float32) error {
+	if baseMaxNumMessagesPerPeer < minMessages {
+		return fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			baseMaxNumMessagesPerPeer,
+			minMessages,
+		)
+	}
+	if maxTotalSizePerPeer < minTotalSize {
+		return fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			maxTotalSizePerPeer,
+			minTotalSize,
+		)
+	}
+	if percentReserved > maxPercentReserved {
+		return fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
+			process.ErrInvalidValue,
+			percentReserved,
+			maxPercentReserved,
+		)
+	}
+	if percentReserved < minPercentReserved {
+		return fmt.Errorf("%w, percentReserved: provided %0.3f, minimum %0.3f",
+			process.ErrInvalidValue,
+			percentReserved,
+			minPercentReserved,
+		)
+	}
+
+	return nil
+}
+
 // IncreaseLoad tries to increment the counter values held at "pid" position
 // It returns true if it had succeeded incrementing (existing counter value is lower or equal with provided maxOperations)
 // We need the mutOperation here as the get and put should be done atomically.
@@ -266,6 +275,33 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// SetPeerMaxInput changes the per peer limits. The increase already computed from the consensus size is kept
+func (qfp *quotaFloodPreventer) SetPeerMaxInput(baseMaxNumMessagesPerPeer uint32, maxTotalSizePerPeer uint64, percentReserved float32) error {
+	err := CheckPeerMaxInput(baseMaxNumMessagesPerPeer, maxTotalSizePerPeer, percentReserved)
+	if err != nil {
+		return err
+	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	consensusIncrease := qfp.computedMaxNumMessagesPerPeer - qfp.baseMaxNumMessagesPerPeer
+	qfp.baseMaxNumMessagesPerPeer = baseMaxNumMessagesPerPeer
+	qfp.computedMaxNumMessagesPerPeer = baseMaxNumMessagesPerPeer + consensusIncrease
+	qfp.maxTotalSizePerPeer = maxTotalSizePerPeer
+	qfp.percentReserved = percentReserved
+
+	log.Debug("quotaFloodPreventer.SetPeerMaxInput",
+		"name", qfp.name,
+		"base", qfp.baseMaxNumMessagesPerPeer,
+		"computed", qfp.computedMaxNumMessagesPerPeer,
+		"max total size", maxTotalSizePerPeer,
+		"percent reserved", percentReserved,
+	)
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
@@ -488,3 +488,42 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+//------- SetPeerMaxInput
+
+func TestQuotaFloodPreventer_SetPeerMaxInputInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	err := qfp.SetPeerMaxInput(minMessages-1, minTotalSize, 10)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = qfp.SetPeerMaxInput(minMessages, minTotalSize-1, 10)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = qfp.SetPeerMaxInput(minMessages, minTotalSize, maxPercentReserved+1)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	assert.Equal(t, arg.BaseMaxNumMessagesPerPeer, qfp.baseMaxNumMessagesPerPeer)
+}
+
+func TestQuotaFloodPreventer_SetPeerMaxInputShouldKeepTheConsensusIncrease(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	arg.IncreaseThreshold = 1000
+	arg.IncreaseFactor = 0.25
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.ApplyConsensusSize(2000)
+
+	err := qfp.SetPeerMaxInput(3000, 5000, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3000), qfp.baseMaxNumMessagesPerPeer)
+	assert.Equal(t, uint32(3250), qfp.computedMaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(5000), qfp.maxTotalSizePerPeer)
+	assert.Equal(t, float32(20), qfp.percentReserved)
+}
@@ -24,6 +24,7 @@ type p2pAntiflood struct {
 	topicPreventer      process.TopicFloodPreventer
 	mutDebugger         sync.RWMutex
 	debugger            process.AntifloodDebugger
+	traceRecorder       process.AntifloodTraceRecorder
 	peerValidatorMapper process.PeerValidatorMapper
 	mapTopicsFromAll    map[string]struct{}
 	mutTopicCheck       sync.RWMutex
@@ -52,6 +53,7 @@ func NewP2PAntiflood(
 		floodPreventers:     floodPreventers,
 		topicPreventer:      topicFloodPreventer,
 		debugger:            &disabled.AntifloodDebugger{},
+		traceRecorder:       &disabled.AntifloodTraceRecorder{},
 		mapTopicsFromAll:    make(map[string]struct{}),
 		peerValidatorMapper: &disabled.PeerValidatorMapper{},
 	}, nil
@@ -63,6 +65,8 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 		return p2p.ErrNilMessage
 	}
 
+	af.recordMessageTrace(message, fromConnectedPeer)
+
 	var lastErrFound error
 	for _, fp := range af.floodPreventers {
 		err := af.canProcessMessage(fp, message, fromConnectedPeer)
@@ -145,6 +149,13 @@ func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topic string, numRejec
 	af.debugger.AddData(pid, topic, numRejected, sizeRejected, sequence, isBlacklisted)
 }
 
+func (af *p2pAntiflood) recordMessageTrace(message p2p.MessageP2P, fromConnectedPeer core.PeerID) {
+	af.mutDebugger.RLock()
+	defer af.mutDebugger.RUnlock()
+
+	af.traceRecorder.RecordMessage(fromConnectedPeer, message.Peer(), message.Topic(), uint64(len(message.Data())), message.SeqNo())
+}
+
 func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	//protect from directly connected peer
 	err := fp.IncreaseLoad(fromConnectedPeer, uint64(len(message.Data())))
@@ -181,6 +192,10 @@ func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p
 
 // CanProcessMessagesOnTopic signals if a p2p message can be processed or not for a given topic
 func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+	af.mutDebugger.RLock()
+	af.traceRecorder.RecordMessagesOnTopic(peer, topic, numMessages, totalSize, sequence)
+	af.mutDebugger.RUnlock()
+
 	err := af.topicPreventer.IncreaseLoad(peer, topic, numMessages)
 	if err != nil {
 		log.Trace("topicFloodPreventer.Accumulate peer",
@@ -231,11 +246,25 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 	return nil
 }
 
+// SetTraceRecorder sets the antiflood trace recorder
+func (af *p2pAntiflood) SetTraceRecorder(traceRecorder process.AntifloodTraceRecorder) error {
+	if check.IfNil(traceRecorder) {
+		return process.ErrNilTraceRecorder
+	}
+
+	af.mutDebugger.Lock()
+	log.LogIfError(af.traceRecorder.Close())
+	af.traceRecorder = traceRecorder
+	af.mutDebugger.Unlock()
+
+	return nil
+}
+
 // BlacklistPeer will add a peer to the black list
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
 
-	err := af.blacklistHandler.Upsert(peer, duration)
+	err := af.blacklistHandler.UpsertWithReason(peer, duration, reason)
 	if err != nil {
 		log.Warn("error adding in blacklist",
 			"pid", peer.Pretty(),
@@ -257,7 +286,16 @@ func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration
 
 // Close will call the close function on all sub components
 func (af *p2pAntiflood) Close() error {
-	return af.debugger.Close()
+	af.mutDebugger.RLock()
+	defer af.mutDebugger.RUnlock()
+
+	errTraceRecorder := af.traceRecorder.Close()
+	errDebugger := af.debugger.Close()
+	if errDebugger != nil {
+		return errDebugger
+	}
+
+	return errTraceRecorder
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
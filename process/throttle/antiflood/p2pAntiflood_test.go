@@ -356,6 +356,74 @@ func TestP2pAntiflood_SetDebuggerShouldWork(t *testing.T) {
 	assert.True(t, afm.Debugger() == debugger)
 }
 
+func TestP2pAntiflood_SetTraceRecorderNilTraceRecorderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetTraceRecorder(nil)
+	assert.Equal(t, process.ErrNilTraceRecorder, err)
+}
+
+func TestP2pAntiflood_SetTraceRecorderShouldRecordMessages(t *testing.T) {
+	t.Parallel()
+
+	fromConnectedPeer := core.PeerID("from connected peer")
+	originator := core.PeerID("originator")
+	message := &p2pmocks.P2PMessageMock{
+		DataField:  []byte("data"),
+		PeerField:  originator,
+		TopicField: "topic",
+		SeqNoField: []byte("seq"),
+	}
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			IncreaseLoadCalled: func(pid core.PeerID, topic string, numMessages uint32) error {
+				return nil
+			},
+		},
+		&mock.FloodPreventerStub{
+			IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+				return nil
+			},
+		},
+	)
+
+	numRecordMessage := int32(0)
+	numRecordMessagesOnTopic := int32(0)
+	err := afm.SetTraceRecorder(&mock.AntifloodTraceRecorderStub{
+		RecordMessageCalled: func(connectedPeer core.PeerID, originatorPeer core.PeerID, topic string, size uint64, sequence []byte) {
+			atomic.AddInt32(&numRecordMessage, 1)
+			assert.Equal(t, fromConnectedPeer, connectedPeer)
+			assert.Equal(t, originator, originatorPeer)
+			assert.Equal(t, "topic", topic)
+			assert.Equal(t, uint64(4), size)
+			assert.Equal(t, []byte("seq"), sequence)
+		},
+		RecordMessagesOnTopicCalled: func(pid core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) {
+			atomic.AddInt32(&numRecordMessagesOnTopic, 1)
+			assert.Equal(t, fromConnectedPeer, pid)
+			assert.Equal(t, "topic", topic)
+			assert.Equal(t, uint32(2), numMessages)
+			assert.Equal(t, uint64(8), totalSize)
+		},
+	})
+	assert.Nil(t, err)
+
+	err = afm.CanProcessMessage(message, fromConnectedPeer)
+	assert.Nil(t, err)
+	err = afm.CanProcessMessagesOnTopic(fromConnectedPeer, "topic", 2, 8, []byte("seq"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numRecordMessage))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numRecordMessagesOnTopic))
+}
+
 func TestP2pAntiflood_Close(t *testing.T) {
 	t.Parallel()
 
@@ -372,11 +440,18 @@ func TestP2pAntiflood_Close(t *testing.T) {
 			return nil
 		},
 	})
+	_ = afm.SetTraceRecorder(&mock.AntifloodTraceRecorderStub{
+		CloseCalled: func() error {
+			atomic.AddInt32(&numCalls, 1)
+
+			return nil
+		},
+	})
 
 	err := afm.Close()
 
 	assert.Nil(t, err)
-	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
 }
 
 func TestP2pAntiflood_BlacklistPeerErrShouldDoNothing(t *testing.T) {
@@ -386,7 +461,7 @@ func TestP2pAntiflood_BlacklistPeerErrShouldDoNothing(t *testing.T) {
 	expectedErr := errors.New("expected error")
 	afm, _ := antiflood.NewP2PAntiflood(
 		&mock.PeerBlackListHandlerStub{
-			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string) error {
 				atomic.AddInt32(&numCalls, 1)
 
 				return expectedErr
@@ -407,8 +482,10 @@ func TestP2pAntiflood_BlacklistPeerShouldWork(t *testing.T) {
 	numCalls := int32(0)
 	afm, _ := antiflood.NewP2PAntiflood(
 		&mock.PeerBlackListHandlerStub{
-			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string) error {
 				atomic.AddInt32(&numCalls, 1)
+				assert.Equal(t, "reason", reason)
+				assert.Equal(t, time.Second, span)
 
 				return nil
 			},
@@ -0,0 +1,71 @@
+package data
+
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
+// TransactionTrace is the data transfer object which holds the structured trace of an already executed transaction,
+// re-executed on the state of its parent block
+type TransactionTrace struct {
+	Hash                string               `json:"hash"`
+	BlockHash           string               `json:"blockHash"`
+	BlockNonce          uint64               `json:"blockNonce"`
+	ParentRootHash      string               `json:"parentRootHash"`
+	Status              transaction.TxStatus `json:"status"`
+	FailReason          string               `json:"failReason,omitempty"`
+	SenderNonceAdjusted bool                 `json:"senderNonceAdjusted,omitempty"`
+	Truncated           bool                 `json:"truncated,omitempty"`
+	GasConsumed         uint64               `json:"gasConsumed"`
+	Steps               []*TraceStep         `json:"steps"`
+}
+
+// TraceStep holds the execution details of the traced transaction or of one of the smart contract results it generated
+type TraceStep struct {
+	Index          int                   `json:"index"`
+	ParentIndex    int                   `json:"parentIndex"`
+	Hash           string                `json:"hash"`
+	Kind           string                `json:"kind"`
+	ProcessingType string                `json:"processingType"`
+	CallType       string                `json:"callType"`
+	Sender         string                `json:"sender"`
+	Receiver       string                `json:"receiver"`
+	Value          string                `json:"value"`
+	Operation      string                `json:"operation,omitempty"`
+	Function       string                `json:"function,omitempty"`
+	Executed       bool                  `json:"executed"`
+	ReturnCode     string                `json:"returnCode,omitempty"`
+	ReturnMessage  string                `json:"returnMessage,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	GasLimit       uint64                `json:"gasLimit"`
+	GasConsumed    uint64                `json:"gasConsumed"`
+	StorageReads   []*StorageAccessTrace `json:"storageReads,omitempty"`
+	StorageWrites  []*StorageAccessTrace `json:"storageWrites,omitempty"`
+	Calls          []*CallTrace          `json:"calls,omitempty"`
+	ESDTTransfers  []*ESDTTransferTrace  `json:"esdtTransfers,omitempty"`
+	Logs           []*transaction.Events `json:"logs,omitempty"`
+}
+
+// StorageAccessTrace holds a storage read or write of an account, with hex encoded key and value
+type StorageAccessTrace struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// CallTrace holds an output transfer or contract call requested during a step
+type CallTrace struct {
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	Function string `json:"function,omitempty"`
+	CallType string `json:"callType"`
+	GasLimit uint64 `json:"gasLimit"`
+}
+
+// ESDTTransferTrace holds an ESDT transfer performed during a step
+type ESDTTransferTrace struct {
+	Sender     string `json:"sender"`
+	Receiver   string `json:"receiver"`
+	Identifier string `json:"identifier"`
+	Token      string `json:"token"`
+	Nonce      uint64 `json:"nonce"`
+	Value      string `json:"value"`
+}
@@ -28,3 +28,21 @@ var ErrNilHasher = errors.New("nil hasher provided")
 
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrNilSCRProcessor signals that a nil smart contract results processor has been provided
+var ErrNilSCRProcessor = errors.New("nil smart contract results processor")
+
+// ErrNilBlockChainHook signals that a nil blockchain hook has been provided
+var ErrNilBlockChainHook = errors.New("nil blockchain hook")
+
+// ErrNilHistoryRepository signals that a nil history repository has been provided
+var ErrNilHistoryRepository = errors.New("nil history repository")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrHistoryRepositoryNotEnabled signals that the history repository is not enabled, so transactions can not be traced
+var ErrHistoryRepositoryNotEnabled = errors.New("history repository is not enabled")
+
+// ErrTransactionNotTraceable signals that the requested transaction is not a regular transaction and can not be traced
+var ErrTransactionNotTraceable = errors.New("only regular transactions can be traced")
@@ -2,6 +2,7 @@ package transactionEvaluator
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
@@ -17,3 +18,10 @@ type TransactionProcessor interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// TracingAccountsAdapter defines an accounts adapter able to record the storage values read through it
+type TracingAccountsAdapter interface {
+	state.AccountsAdapter
+	StartRecording()
+	StopRecording() []*StorageRead
+}
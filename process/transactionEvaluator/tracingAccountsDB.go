@@ -0,0 +1,167 @@
+package transactionEvaluator
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// StorageRead holds a storage value read while recording
+type StorageRead struct {
+	Address []byte
+	Key     []byte
+	Value   []byte
+}
+
+type storageReadsRecorder struct {
+	mut       sync.Mutex
+	recording bool
+	reads     []*StorageRead
+	seen      map[string]struct{}
+}
+
+func (recorder *storageReadsRecorder) record(address []byte, key []byte, value []byte) {
+	recorder.mut.Lock()
+	defer recorder.mut.Unlock()
+
+	if !recorder.recording {
+		return
+	}
+
+	id := string(address) + string(key)
+	_, alreadyRead := recorder.seen[id]
+	if alreadyRead {
+		return
+	}
+
+	recorder.seen[id] = struct{}{}
+	recorder.reads = append(recorder.reads, &StorageRead{
+		Address: address,
+		Key:     key,
+		Value:   value,
+	})
+}
+
+// tracingAccountsDB is a wrapper over an accounts db which records the storage values read from the returned user accounts
+type tracingAccountsDB struct {
+	state.AccountsAdapter
+	recorder *storageReadsRecorder
+}
+
+// NewTracingAccountsDB returns a new instance of tracingAccountsDB
+func NewTracingAccountsDB(accountsDB state.AccountsAdapter) (*tracingAccountsDB, error) {
+	if check.IfNil(accountsDB) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return &tracingAccountsDB{
+		AccountsAdapter: accountsDB,
+		recorder:        &storageReadsRecorder{},
+	}, nil
+}
+
+// StartRecording starts recording the storage reads, each storage key being recorded once
+func (adb *tracingAccountsDB) StartRecording() {
+	adb.recorder.mut.Lock()
+	adb.recorder.recording = true
+	adb.recorder.reads = make([]*StorageRead, 0)
+	adb.recorder.seen = make(map[string]struct{})
+	adb.recorder.mut.Unlock()
+}
+
+// StopRecording stops recording and returns the storage reads recorded since the last StartRecording call
+func (adb *tracingAccountsDB) StopRecording() []*StorageRead {
+	adb.recorder.mut.Lock()
+	defer adb.recorder.mut.Unlock()
+
+	reads := adb.recorder.reads
+	adb.recorder.recording = false
+	adb.recorder.reads = nil
+	adb.recorder.seen = nil
+
+	return reads
+}
+
+// GetExistingAccount will call the original accounts' function with the same name and will wrap the user account
+func (adb *tracingAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := adb.AccountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return adb.wrapAccount(account), nil
+}
+
+// LoadAccount will call the original accounts' function with the same name and will wrap the user account
+func (adb *tracingAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := adb.AccountsAdapter.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return adb.wrapAccount(account), nil
+}
+
+func (adb *tracingAccountsDB) wrapAccount(account vmcommon.AccountHandler) vmcommon.AccountHandler {
+	userAccount, ok := account.(userAccountHandler)
+	if !ok {
+		return account
+	}
+
+	return &tracedUserAccount{
+		userAccountHandler: userAccount,
+		recorder:           adb.recorder,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adb *tracingAccountsDB) IsInterfaceNil() bool {
+	return adb == nil
+}
+
+type userAccountHandler interface {
+	state.UserAccountHandler
+	AccountDataHandler() vmcommon.AccountDataHandler
+}
+
+type tracedUserAccount struct {
+	userAccountHandler
+	recorder *storageReadsRecorder
+}
+
+// RetrieveValue will call the original account's function with the same name and will record the read value
+func (account *tracedUserAccount) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, depth, err := account.userAccountHandler.RetrieveValue(key)
+	if err == nil {
+		account.recorder.record(account.AddressBytes(), key, value)
+	}
+
+	return value, depth, err
+}
+
+// AccountDataHandler returns the wrapped data handler of the original account
+func (account *tracedUserAccount) AccountDataHandler() vmcommon.AccountDataHandler {
+	return &tracedAccountDataHandler{
+		AccountDataHandler: account.userAccountHandler.AccountDataHandler(),
+		address:            account.AddressBytes(),
+		recorder:           account.recorder,
+	}
+}
+
+type tracedAccountDataHandler struct {
+	vmcommon.AccountDataHandler
+	address  []byte
+	recorder *storageReadsRecorder
+}
+
+// RetrieveValue will call the original data handler's function with the same name and will record the read value
+func (handler *tracedAccountDataHandler) RetrieveValue(key []byte) ([]byte, uint32, error) {
+	value, depth, err := handler.AccountDataHandler.RetrieveValue(key)
+	if err == nil {
+		handler.recorder.record(handler.address, key, value)
+	}
+
+	return value, depth, err
+}
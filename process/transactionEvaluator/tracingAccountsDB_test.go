@@ -0,0 +1,96 @@
+package transactionEvaluator
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTracingAccountsDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracingAccountsDB, err := NewTracingAccountsDB(nil)
+		require.True(t, check.IfNil(tracingAccountsDB))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracingAccountsDB, err := NewTracingAccountsDB(&stateMock.AccountsStub{})
+		require.False(t, check.IfNil(tracingAccountsDB))
+		require.Nil(t, err)
+	})
+}
+
+func TestTracingAccountsDB_ShouldRecordStorageReads(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	dataTrie := &trie.DataTrieTrackerStub{
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return append([]byte("value of "), key...), 0, nil
+		},
+	}
+	account := &stateMock.UserAccountStub{
+		Address: address,
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return dataTrie.RetrieveValue(key)
+		},
+		AccountDataHandlerCalled: func() vmcommon.AccountDataHandler {
+			return dataTrie
+		},
+	}
+	tracingAccountsDB, _ := NewTracingAccountsDB(&stateMock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return account, nil
+		},
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return account, nil
+		},
+	})
+
+	loadedAccount, _ := tracingAccountsDB.LoadAccount(address)
+	userAccount := loadedAccount.(userAccountHandler)
+
+	// reads before recording are not recorded
+	_, _, _ = userAccount.RetrieveValue([]byte("key0"))
+	tracingAccountsDB.StartRecording()
+
+	_, _, _ = userAccount.RetrieveValue([]byte("key1"))
+	_, _, _ = userAccount.RetrieveValue([]byte("key1"))
+	existingAccount, _ := tracingAccountsDB.GetExistingAccount(address)
+	_, _, _ = existingAccount.(userAccountHandler).AccountDataHandler().RetrieveValue([]byte("key2"))
+
+	reads := tracingAccountsDB.StopRecording()
+	require.Equal(t, []*StorageRead{
+		{Address: address, Key: []byte("key1"), Value: []byte("value of key1")},
+		{Address: address, Key: []byte("key2"), Value: []byte("value of key2")},
+	}, reads)
+
+	// reads after recording are not recorded
+	_, _, _ = userAccount.RetrieveValue([]byte("key3"))
+	tracingAccountsDB.StartRecording()
+	require.Empty(t, tracingAccountsDB.StopRecording())
+}
+
+func TestTracingAccountsDB_NonUserAccountsShouldNotBeWrapped(t *testing.T) {
+	t.Parallel()
+
+	account := &stateMock.PeerAccountHandlerMock{}
+	tracingAccountsDB, _ := NewTracingAccountsDB(&stateMock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return account, nil
+		},
+	})
+
+	loadedAccount, err := tracingAccountsDB.LoadAccount([]byte("address"))
+	require.Nil(t, err)
+	require.True(t, account == loadedAccount)
+}
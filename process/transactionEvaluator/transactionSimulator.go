@@ -138,17 +138,22 @@ func (ts *transactionSimulator) addLogsFromVmOutput(results *txSimData.Simulatio
 	}
 
 	results.Logs = &transaction.ApiLogs{
-		Events: make([]*transaction.Events, 0, len(vmOutput.Logs)),
+		Events: convertLogEntries(ts.addressPubKeyConverter, vmOutput.Logs),
 	}
+}
 
-	for _, entry := range vmOutput.Logs {
-		results.Logs.Events = append(results.Logs.Events, &transaction.Events{
-			Address:    ts.addressPubKeyConverter.SilentEncode(entry.Address, log),
+func convertLogEntries(addressPubKeyConverter core.PubkeyConverter, entries []*vmcommon.LogEntry) []*transaction.Events {
+	events := make([]*transaction.Events, 0, len(entries))
+	for _, entry := range entries {
+		events = append(events, &transaction.Events{
+			Address:    addressPubKeyConverter.SilentEncode(entry.Address, log),
 			Identifier: string(entry.Identifier),
 			Topics:     entry.Topics,
 			Data:       entry.GetFirstDataItem(),
 		})
 	}
+
+	return events
 }
 
 func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
@@ -0,0 +1,598 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const maxNumTraceSteps = 1000
+
+const (
+	traceStepKindTransaction     = "transaction"
+	traceStepKindAsyncCall       = "asyncCall"
+	traceStepKindAsyncCallback   = "asyncCallback"
+	traceStepKindBuiltInFunction = "builtInFunctionCall"
+	traceStepKindSCCall          = "scCall"
+	traceStepKindSCDeployment    = "scDeployment"
+	traceStepKindTransfer        = "transfer"
+)
+
+var esdtTransferIdentifiers = map[string]struct{}{
+	core.BuiltInFunctionESDTTransfer:         {},
+	core.BuiltInFunctionESDTNFTTransfer:      {},
+	core.BuiltInFunctionMultiESDTNFTTransfer: {},
+}
+
+// ArgsTransactionTracer holds the arguments required for creating a new transaction tracer
+type ArgsTransactionTracer struct {
+	TransactionProcessor      TransactionProcessor
+	SCRProcessor              process.SmartContractResultProcessor
+	IntermediateProcContainer process.IntermediateProcessorContainer
+	BlockChainHook            process.BlockChainHookHandler
+	Accounts                  TracingAccountsAdapter
+	SimulationAccounts        state.AccountsAdapterWithClean
+	TxTypeHandler             process.TxTypeHandler
+	FeeHandler                process.FeeHandler
+	VMOutputCacher            storage.Cacher
+	HistoryRepository         dblookupext.HistoryRepository
+	StorageService            dataRetriever.StorageService
+	AddressPubKeyConverter    core.PubkeyConverter
+	ShardCoordinator          sharding.Coordinator
+	Marshalizer               marshal.Marshalizer
+	DataFieldParser           DataFieldParser
+}
+
+type traceItem struct {
+	parentIndex int
+	hash        []byte
+	txHandler   data.TransactionHandler
+}
+
+// transactionTracer re-executes already included transactions on the state of their parent block, in an environment
+// where state-writing is not allowed, and builds a structured trace of the execution. The transaction is executed
+// first, then the smart contract results it generates in this shard are executed in a breadth-first manner
+type transactionTracer struct {
+	mutOperation           sync.Mutex
+	txProcessor            TransactionProcessor
+	scrProcessor           process.SmartContractResultProcessor
+	intermProcContainer    process.IntermediateProcessorContainer
+	blockChainHook         process.BlockChainHookHandler
+	accounts               TracingAccountsAdapter
+	simulationAccounts     state.AccountsAdapterWithClean
+	txTypeHandler          process.TxTypeHandler
+	feeHandler             process.FeeHandler
+	vmOutputCacher         storage.Cacher
+	historyRepository      dblookupext.HistoryRepository
+	storageService         dataRetriever.StorageService
+	addressPubKeyConverter core.PubkeyConverter
+	shardCoordinator       sharding.Coordinator
+	marshalizer            marshal.Marshalizer
+	dataFieldParser        DataFieldParser
+}
+
+// NewTransactionTracer returns a new instance of a transactionTracer
+func NewTransactionTracer(args ArgsTransactionTracer) (*transactionTracer, error) {
+	if check.IfNil(args.TransactionProcessor) {
+		return nil, ErrNilTxSimulatorProcessor
+	}
+	if check.IfNil(args.SCRProcessor) {
+		return nil, ErrNilSCRProcessor
+	}
+	if check.IfNil(args.IntermediateProcContainer) {
+		return nil, ErrNilIntermediateProcessorContainer
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, ErrNilBlockChainHook
+	}
+	if check.IfNil(args.Accounts) || check.IfNil(args.SimulationAccounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.TxTypeHandler) {
+		return nil, process.ErrNilTxTypeHandler
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.VMOutputCacher) {
+		return nil, ErrNilCacher
+	}
+	if check.IfNil(args.HistoryRepository) {
+		return nil, ErrNilHistoryRepository
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNilReflect(args.DataFieldParser) {
+		return nil, ErrNilDataFieldParser
+	}
+
+	return &transactionTracer{
+		txProcessor:            args.TransactionProcessor,
+		scrProcessor:           args.SCRProcessor,
+		intermProcContainer:    args.IntermediateProcContainer,
+		blockChainHook:         args.BlockChainHook,
+		accounts:               args.Accounts,
+		simulationAccounts:     args.SimulationAccounts,
+		txTypeHandler:          args.TxTypeHandler,
+		feeHandler:             args.FeeHandler,
+		vmOutputCacher:         args.VMOutputCacher,
+		historyRepository:      args.HistoryRepository,
+		storageService:         args.StorageService,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		shardCoordinator:       args.ShardCoordinator,
+		marshalizer:            args.Marshalizer,
+		dataFieldParser:        args.DataFieldParser,
+	}, nil
+}
+
+// TraceTransaction re-executes the already included transaction on the state of its parent block and returns
+// the structured trace of the execution. The effects of the transactions executed before it in the same block
+// are not replayed, only the sender's nonce being adjusted, if needed
+func (tt *transactionTracer) TraceTransaction(txHash []byte) (*txSimData.TransactionTrace, error) {
+	if !tt.historyRepository.IsEnabled() {
+		return nil, ErrHistoryRepositoryNotEnabled
+	}
+
+	tx, miniblockMetadata, err := tt.getTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := tt.getHeader(miniblockMetadata.HeaderHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHeader, err := tt.getHeader(header.GetPrevHash(), header.GetEpoch())
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the parent block", err)
+	}
+
+	tt.mutOperation.Lock()
+	defer tt.mutOperation.Unlock()
+
+	return tt.replay(tx, txHash, header, miniblockMetadata.HeaderHash, parentHeader)
+}
+
+func (tt *transactionTracer) getTransaction(txHash []byte) (*transaction.Transaction, *dblookupext.MiniblockMetadata, error) {
+	miniblockMetadata, err := tt.historyRepository.GetMiniblockMetadataByTxHash(txHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", transactionAPI.ErrTransactionNotFound.Error(), err)
+	}
+
+	miniblockType := block.Type(miniblockMetadata.Type)
+	if miniblockType != block.TxBlock && miniblockType != block.InvalidBlock {
+		return nil, nil, fmt.Errorf("%w, provided transaction is part of a %s miniblock", ErrTransactionNotTraceable, miniblockType.String())
+	}
+
+	txsStorer, err := tt.storageService.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txBytes, err := txsStorer.GetFromEpoch(txHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", transactionAPI.ErrTransactionNotFound.Error(), err)
+	}
+
+	tx := &transaction.Transaction{}
+	err = tt.marshalizer.Unmarshal(tx, txBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tx, miniblockMetadata, nil
+}
+
+func (tt *transactionTracer) getHeader(headerHash []byte, epoch uint32) (data.HeaderHandler, error) {
+	headersStorer, err := tt.storageService.GetStorer(dataRetriever.GetHeadersDataUnit(tt.shardCoordinator.SelfId()))
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := headersStorer.GetFromEpoch(headerHash, epoch)
+	if err != nil {
+		// the block might be in the previous epoch
+		headerBytes, err = headersStorer.SearchFirst(headerHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return process.UnmarshalHeader(tt.shardCoordinator.SelfId(), tt.marshalizer, headerBytes)
+}
+
+func (tt *transactionTracer) replay(
+	tx *transaction.Transaction,
+	txHash []byte,
+	header data.HeaderHandler,
+	headerHash []byte,
+	parentHeader data.HeaderHandler,
+) (*txSimData.TransactionTrace, error) {
+	epoch := core.OptionalUint32{Value: parentHeader.GetEpoch(), HasValue: true}
+	err := tt.accounts.RecreateTrieFromEpoch(holders.NewRootHashHolder(parentHeader.GetRootHash(), epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	tt.simulationAccounts.CleanCache()
+	defer func() {
+		tt.cleanIntermediateProcessors()
+		tt.simulationAccounts.CleanCache()
+	}()
+
+	tt.blockChainHook.SetCurrentHeader(header)
+
+	senderNonceAdjusted, err := tt.adjustSenderNonce(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, truncated := tt.executeSteps(tx, txHash)
+	trace := &txSimData.TransactionTrace{
+		Hash:                hex.EncodeToString(txHash),
+		BlockHash:           hex.EncodeToString(headerHash),
+		BlockNonce:          header.GetNonce(),
+		ParentRootHash:      hex.EncodeToString(parentHeader.GetRootHash()),
+		Status:              transaction.TxStatusSuccess,
+		SenderNonceAdjusted: senderNonceAdjusted,
+		Truncated:           truncated,
+		Steps:               steps,
+	}
+
+	for _, step := range steps {
+		trace.GasConsumed += step.GasConsumed
+	}
+
+	rootStep := steps[0]
+	if len(rootStep.Error) > 0 {
+		trace.Status = transaction.TxStatusFail
+		trace.FailReason = rootStep.Error
+	}
+	if len(rootStep.ReturnCode) > 0 && rootStep.ReturnCode != vmcommon.Ok.String() {
+		trace.Status = transaction.TxStatusFail
+		trace.FailReason = rootStep.ReturnMessage
+	}
+
+	return trace, nil
+}
+
+// adjustSenderNonce sets the sender's nonce to the one of the transaction, as other transactions of the same sender
+// might have been executed before it, in the same block
+func (tt *transactionTracer) adjustSenderNonce(tx *transaction.Transaction) (bool, error) {
+	if tt.shardCoordinator.ComputeId(tx.SndAddr) != tt.shardCoordinator.SelfId() {
+		return false, nil
+	}
+
+	sender, err := tt.simulationAccounts.LoadAccount(tx.SndAddr)
+	if err != nil {
+		return false, err
+	}
+	if sender.GetNonce() >= tx.Nonce {
+		return false, nil
+	}
+
+	sender.IncreaseNonce(tx.Nonce - sender.GetNonce())
+
+	return true, tt.simulationAccounts.SaveAccount(sender)
+}
+
+func (tt *transactionTracer) executeSteps(tx *transaction.Transaction, txHash []byte) ([]*txSimData.TraceStep, bool) {
+	steps := make([]*txSimData.TraceStep, 0)
+	knownResults := make(map[string]struct{})
+	queue := []*traceItem{{parentIndex: -1, hash: txHash, txHandler: tx}}
+
+	for len(queue) > 0 {
+		if len(steps) >= maxNumTraceSteps {
+			return steps, true
+		}
+
+		item := queue[0]
+		queue = queue[1:]
+
+		step := tt.createStep(len(steps), item)
+		steps = append(steps, step)
+
+		isRootStep := item.parentIndex < 0
+		isExecutableInSelfShard := tt.shardCoordinator.ComputeId(item.txHandler.GetRcvAddr()) == tt.shardCoordinator.SelfId()
+		if !isRootStep && !isExecutableInSelfShard {
+			continue
+		}
+
+		tt.executeStep(step, item)
+		for _, result := range tt.getNewResults(knownResults) {
+			result.parentIndex = step.Index
+			queue = append(queue, result)
+		}
+	}
+
+	return steps, false
+}
+
+func (tt *transactionTracer) createStep(index int, item *traceItem) *txSimData.TraceStep {
+	callType := vm.DirectCall
+	scr, isSCR := item.txHandler.(*smartContractResult.SmartContractResult)
+	if isSCR {
+		callType = scr.CallType
+	}
+
+	processingType, _ := tt.txTypeHandler.ComputeTransactionType(item.txHandler)
+	parsedData := tt.dataFieldParser.Parse(item.txHandler.GetData(), item.txHandler.GetSndAddr(), item.txHandler.GetRcvAddr(), tt.shardCoordinator.NumberOfShards())
+
+	return &txSimData.TraceStep{
+		Index:          index,
+		ParentIndex:    item.parentIndex,
+		Hash:           hex.EncodeToString(item.hash),
+		Kind:           computeStepKind(item.parentIndex < 0, callType, processingType),
+		ProcessingType: processingType.String(),
+		CallType:       callType.ToString(),
+		Sender:         tt.addressPubKeyConverter.SilentEncode(item.txHandler.GetSndAddr(), log),
+		Receiver:       tt.addressPubKeyConverter.SilentEncode(item.txHandler.GetRcvAddr(), log),
+		Value:          bigIntToString(item.txHandler.GetValue()),
+		Operation:      parsedData.Operation,
+		Function:       parsedData.Function,
+		GasLimit:       item.txHandler.GetGasLimit(),
+	}
+}
+
+func computeStepKind(isRootStep bool, callType vm.CallType, processingType process.TransactionType) string {
+	switch {
+	case isRootStep:
+		return traceStepKindTransaction
+	case callType == vm.AsynchronousCall:
+		return traceStepKindAsyncCall
+	case callType == vm.AsynchronousCallBack:
+		return traceStepKindAsyncCallback
+	case processingType == process.BuiltInFunctionCall:
+		return traceStepKindBuiltInFunction
+	case processingType == process.SCInvoking:
+		return traceStepKindSCCall
+	case processingType == process.SCDeployment:
+		return traceStepKindSCDeployment
+	default:
+		return traceStepKindTransfer
+	}
+}
+
+func (tt *transactionTracer) executeStep(step *txSimData.TraceStep, item *traceItem) {
+	tt.accounts.StartRecording()
+	retCode, err := tt.processItem(item)
+	storageReads := tt.accounts.StopRecording()
+
+	step.Executed = true
+	step.ReturnCode = retCode.String()
+	if err != nil {
+		step.ReturnCode = ""
+		step.Error = err.Error()
+	}
+	step.StorageReads = tt.convertStorageReads(storageReads)
+
+	vmOutput, found := tt.getVMOutput(item.hash)
+	if !found {
+		tx, isTx := item.txHandler.(*transaction.Transaction)
+		if isTx && err == nil {
+			step.GasConsumed = tt.feeHandler.ComputeGasLimit(tx)
+		}
+		return
+	}
+
+	step.GasConsumed = step.GasLimit
+	if vmOutput.GasRemaining <= step.GasLimit {
+		step.GasConsumed = step.GasLimit - vmOutput.GasRemaining
+	}
+	if err == nil {
+		step.ReturnCode = vmOutput.ReturnCode.String()
+	}
+	step.ReturnMessage = vmOutput.ReturnMessage
+	step.StorageWrites = tt.extractStorageWrites(vmOutput)
+	step.Calls = tt.extractCalls(vmOutput)
+	step.ESDTTransfers = tt.extractESDTTransfers(vmOutput.Logs)
+	step.Logs = convertLogEntries(tt.addressPubKeyConverter, vmOutput.Logs)
+}
+
+func (tt *transactionTracer) processItem(item *traceItem) (vmcommon.ReturnCode, error) {
+	switch txHandler := item.txHandler.(type) {
+	case *transaction.Transaction:
+		return tt.txProcessor.ProcessTransaction(txHandler)
+	case *smartContractResult.SmartContractResult:
+		return tt.scrProcessor.ProcessSmartContractResult(txHandler)
+	default:
+		return vmcommon.ExecutionFailed, process.ErrWrongTypeAssertion
+	}
+}
+
+func (tt *transactionTracer) getVMOutput(hash []byte) (*vmcommon.VMOutput, bool) {
+	defer tt.vmOutputCacher.Remove(hash)
+
+	vmOutputInterface, ok := tt.vmOutputCacher.Get(hash)
+	if !ok || check.IfNilReflect(vmOutputInterface) {
+		return nil, false
+	}
+
+	vmOutput, ok := vmOutputInterface.(*vmcommon.VMOutput)
+	return vmOutput, ok
+}
+
+// getNewResults returns the smart contract results generated since the last call, in the order of their hashes
+func (tt *transactionTracer) getNewResults(knownResults map[string]struct{}) []*traceItem {
+	scrForwarder, err := tt.intermProcContainer.Get(block.SmartContractResultBlock)
+	if err != nil {
+		log.Warn("transactionTracer.getNewResults: cannot get the smart contract results forwarder", "error", err)
+		return nil
+	}
+
+	newResults := make([]*traceItem, 0)
+	for hash, value := range scrForwarder.GetAllCurrentFinishedTxs() {
+		_, isKnown := knownResults[hash]
+		if isKnown {
+			continue
+		}
+		knownResults[hash] = struct{}{}
+
+		newResults = append(newResults, &traceItem{
+			hash:      []byte(hash),
+			txHandler: value,
+		})
+	}
+
+	sort.Slice(newResults, func(i, j int) bool {
+		return bytes.Compare(newResults[i].hash, newResults[j].hash) < 0
+	})
+
+	return newResults
+}
+
+func (tt *transactionTracer) convertStorageReads(storageReads []*StorageRead) []*txSimData.StorageAccessTrace {
+	if len(storageReads) == 0 {
+		return nil
+	}
+
+	converted := make([]*txSimData.StorageAccessTrace, 0, len(storageReads))
+	for _, storageRead := range storageReads {
+		converted = append(converted, &txSimData.StorageAccessTrace{
+			Address: tt.addressPubKeyConverter.SilentEncode(storageRead.Address, log),
+			Key:     hex.EncodeToString(storageRead.Key),
+			Value:   hex.EncodeToString(storageRead.Value),
+		})
+	}
+
+	return converted
+}
+
+func (tt *transactionTracer) extractStorageWrites(vmOutput *vmcommon.VMOutput) []*txSimData.StorageAccessTrace {
+	storageWrites := make([]*txSimData.StorageAccessTrace, 0)
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		keys := make([]string, 0, len(outputAccount.StorageUpdates))
+		for key := range outputAccount.StorageUpdates {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			storageUpdate := outputAccount.StorageUpdates[key]
+			storageWrites = append(storageWrites, &txSimData.StorageAccessTrace{
+				Address: tt.addressPubKeyConverter.SilentEncode(outputAccount.Address, log),
+				Key:     hex.EncodeToString(storageUpdate.Offset),
+				Value:   hex.EncodeToString(storageUpdate.Data),
+			})
+		}
+	}
+
+	return storageWrites
+}
+
+func (tt *transactionTracer) extractCalls(vmOutput *vmcommon.VMOutput) []*txSimData.CallTrace {
+	calls := make([]*txSimData.CallTrace, 0)
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		for _, outputTransfer := range outputAccount.OutputTransfers {
+			parsedData := tt.dataFieldParser.Parse(outputTransfer.Data, outputTransfer.SenderAddress, outputAccount.Address, tt.shardCoordinator.NumberOfShards())
+			calls = append(calls, &txSimData.CallTrace{
+				Sender:   tt.addressPubKeyConverter.SilentEncode(outputTransfer.SenderAddress, log),
+				Receiver: tt.addressPubKeyConverter.SilentEncode(outputAccount.Address, log),
+				Value:    bigIntToString(outputTransfer.Value),
+				Function: parsedData.Function,
+				CallType: outputTransfer.CallType.ToString(),
+				GasLimit: outputTransfer.GasLimit,
+			})
+		}
+	}
+
+	return calls
+}
+
+// extractESDTTransfers parses the ESDT transfer events. Their topics hold the token identifier, the nonce and
+// the value of each transferred token, followed by the receiver's address
+func (tt *transactionTracer) extractESDTTransfers(entries []*vmcommon.LogEntry) []*txSimData.ESDTTransferTrace {
+	transfers := make([]*txSimData.ESDTTransferTrace, 0)
+	for _, entry := range entries {
+		_, isTransfer := esdtTransferIdentifiers[string(entry.Identifier)]
+		if !isTransfer || len(entry.Topics) < 4 || (len(entry.Topics)-1)%3 != 0 {
+			continue
+		}
+
+		receiver := tt.addressPubKeyConverter.SilentEncode(entry.Topics[len(entry.Topics)-1], log)
+		for i := 0; i < len(entry.Topics)-1; i += 3 {
+			transfers = append(transfers, &txSimData.ESDTTransferTrace{
+				Sender:     tt.addressPubKeyConverter.SilentEncode(entry.Address, log),
+				Receiver:   receiver,
+				Identifier: string(entry.Identifier),
+				Token:      string(entry.Topics[i]),
+				Nonce:      big.NewInt(0).SetBytes(entry.Topics[i+1]).Uint64(),
+				Value:      big.NewInt(0).SetBytes(entry.Topics[i+2]).String(),
+			})
+		}
+	}
+
+	return transfers
+}
+
+func (tt *transactionTracer) cleanIntermediateProcessors() {
+	for _, procKey := range tt.intermProcContainer.Keys() {
+		processor, errGetProc := tt.intermProcContainer.Get(procKey)
+		if errGetProc != nil || check.IfNil(processor) {
+			continue
+		}
+
+		processor.CreateBlockStarted()
+	}
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(addresses))
+	for _, address := range addresses {
+		outputAccounts = append(outputAccounts, vmOutput.OutputAccounts[address])
+	}
+
+	return outputAccounts
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tt *transactionTracer) IsInterfaceNil() bool {
+	return tt == nil
+}
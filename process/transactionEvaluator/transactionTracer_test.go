@@ -0,0 +1,480 @@
+package transactionEvaluator
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dblookupextMock "github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTransactionTracerArgs() ArgsTransactionTracer {
+	pubKeyConverter := testscommon.NewPubkeyConverterMock(32)
+	dataFieldParser, _ := datafield.NewOperationDataFieldParser(&datafield.ArgsOperationDataFieldParser{
+		AddressLength: pubKeyConverter.Len(),
+		Marshalizer:   &mock.MarshalizerMock{},
+	})
+	tracingAccounts, _ := NewTracingAccountsDB(&stateMock.AccountsStub{})
+	simulationAccounts, _ := NewSimulationAccountsDB(tracingAccounts)
+
+	return ArgsTransactionTracer{
+		TransactionProcessor:      &testscommon.TxProcessorStub{},
+		SCRProcessor:              &testscommon.SCProcessorMock{},
+		IntermediateProcContainer: &mock.IntermProcessorContainerStub{},
+		BlockChainHook:            &testscommon.BlockChainHookStub{},
+		Accounts:                  tracingAccounts,
+		SimulationAccounts:        simulationAccounts,
+		TxTypeHandler:             &testscommon.TxTypeHandlerMock{},
+		FeeHandler:                &economicsmocks.EconomicsHandlerStub{},
+		VMOutputCacher:            testscommon.NewCacherMock(),
+		HistoryRepository:         &dblookupextMock.HistoryRepositoryStub{},
+		StorageService:            genericMocks.NewChainStorerMock(0),
+		AddressPubKeyConverter:    pubKeyConverter,
+		ShardCoordinator:          mock.NewMultiShardsCoordinatorMock(2),
+		Marshalizer:               &mock.MarshalizerMock{},
+		DataFieldParser:           dataFieldParser,
+	}
+}
+
+func TestNewTransactionTracer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		argsFunc func() ArgsTransactionTracer
+		exError  error
+	}{
+		{
+			name: "NilTransactionProcessor",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.TransactionProcessor = nil
+				return args
+			},
+			exError: ErrNilTxSimulatorProcessor,
+		},
+		{
+			name: "NilSCRProcessor",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.SCRProcessor = nil
+				return args
+			},
+			exError: ErrNilSCRProcessor,
+		},
+		{
+			name: "NilIntermProcessorContainer",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.IntermediateProcContainer = nil
+				return args
+			},
+			exError: ErrNilIntermediateProcessorContainer,
+		},
+		{
+			name: "NilBlockChainHook",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.BlockChainHook = nil
+				return args
+			},
+			exError: ErrNilBlockChainHook,
+		},
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.Accounts = nil
+				return args
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilSimulationAccounts",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.SimulationAccounts = nil
+				return args
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilTxTypeHandler",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.TxTypeHandler = nil
+				return args
+			},
+			exError: process.ErrNilTxTypeHandler,
+		},
+		{
+			name: "NilFeeHandler",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.FeeHandler = nil
+				return args
+			},
+			exError: process.ErrNilEconomicsFeeHandler,
+		},
+		{
+			name: "NilVMOutputCacher",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.VMOutputCacher = nil
+				return args
+			},
+			exError: ErrNilCacher,
+		},
+		{
+			name: "NilHistoryRepository",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.HistoryRepository = nil
+				return args
+			},
+			exError: ErrNilHistoryRepository,
+		},
+		{
+			name: "NilStorageService",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.StorageService = nil
+				return args
+			},
+			exError: ErrNilStorageService,
+		},
+		{
+			name: "NilPubkeyConverter",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.AddressPubKeyConverter = nil
+				return args
+			},
+			exError: ErrNilPubkeyConverter,
+		},
+		{
+			name: "NilShardCoordinator",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.ShardCoordinator = nil
+				return args
+			},
+			exError: ErrNilShardCoordinator,
+		},
+		{
+			name: "NilMarshalizer",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.Marshalizer = nil
+				return args
+			},
+			exError: ErrNilMarshalizer,
+		},
+		{
+			name: "NilDataFieldParser",
+			argsFunc: func() ArgsTransactionTracer {
+				args := createMockTransactionTracerArgs()
+				args.DataFieldParser = nil
+				return args
+			},
+			exError: ErrNilDataFieldParser,
+		},
+		{
+			name: "Ok",
+			argsFunc: func() ArgsTransactionTracer {
+				return createMockTransactionTracerArgs()
+			},
+			exError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewTransactionTracer(tt.argsFunc())
+		require.Equal(t, tt.exError, err, tt.name)
+	}
+}
+
+func TestTransactionTracer_TraceTransactionInvalidRequestsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	t.Run("history repository not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionTracerArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		tracer, _ := NewTransactionTracer(args)
+		trace, err := tracer.TraceTransaction(txHash)
+		require.Equal(t, ErrHistoryRepositoryNotEnabled, err)
+		require.Nil(t, trace)
+	})
+	t.Run("unknown transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionTracerArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+		}
+		tracer, _ := NewTransactionTracer(args)
+		trace, err := tracer.TraceTransaction(txHash)
+		require.NotNil(t, err)
+		require.Nil(t, trace)
+	})
+	t.Run("rewards transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionTracerArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return &dblookupext.MiniblockMetadata{Type: int32(block.RewardsBlock)}, nil
+			},
+		}
+		tracer, _ := NewTransactionTracer(args)
+		trace, err := tracer.TraceTransaction(txHash)
+		require.True(t, errors.Is(err, ErrTransactionNotTraceable))
+		require.Nil(t, trace)
+	})
+	t.Run("missing parent block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTransactionTracerArgs()
+		storageService := genericMocks.NewChainStorerMock(0)
+		args.StorageService = storageService
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return &dblookupext.MiniblockMetadata{Type: int32(block.TxBlock), HeaderHash: []byte("header hash")}, nil
+			},
+		}
+		txBytes, _ := args.Marshalizer.Marshal(&transaction.Transaction{})
+		_ = storageService.Transactions.Put(txHash, txBytes)
+		headerBytes, _ := args.Marshalizer.Marshal(&block.HeaderV2{Header: &block.Header{PrevHash: []byte("parent hash")}})
+		_ = storageService.BlockHeaders.Put([]byte("header hash"), headerBytes)
+
+		tracer, _ := NewTransactionTracer(args)
+		trace, err := tracer.TraceTransaction(txHash)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "while fetching the parent block")
+		require.Nil(t, trace)
+	})
+}
+
+func TestTransactionTracer_TraceTransactionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("tx hash")
+	scrHash := []byte("scr hash")
+	sender := []byte("sender")
+	contract := []byte("contract")
+	tx := &transaction.Transaction{
+		Nonce:    7,
+		SndAddr:  sender,
+		RcvAddr:  contract,
+		Value:    big.NewInt(0),
+		GasLimit: 100000,
+		Data:     []byte("add@01"),
+	}
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:  contract,
+		RcvAddr:  sender,
+		Value:    big.NewInt(10),
+		GasLimit: 0,
+		CallType: vm.DirectCall,
+	}
+	header := &block.HeaderV2{Header: &block.Header{Nonce: 11, PrevHash: []byte("parent hash")}}
+	parentHeader := &block.HeaderV2{Header: &block.Header{Nonce: 10, RootHash: []byte("parent root hash")}}
+
+	args := createMockTransactionTracerArgs()
+	storageService := genericMocks.NewChainStorerMock(0)
+	args.StorageService = storageService
+	txBytes, _ := args.Marshalizer.Marshal(tx)
+	_ = storageService.Transactions.Put(txHash, txBytes)
+	headerBytes, _ := args.Marshalizer.Marshal(header)
+	_ = storageService.BlockHeaders.Put([]byte("header hash"), headerBytes)
+	parentHeaderBytes, _ := args.Marshalizer.Marshal(parentHeader)
+	_ = storageService.BlockHeaders.Put([]byte("parent hash"), parentHeaderBytes)
+
+	args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			require.Equal(t, txHash, hash)
+			return &dblookupext.MiniblockMetadata{Type: int32(block.TxBlock), HeaderHash: []byte("header hash")}, nil
+		},
+	}
+
+	recreatedRootHash := make([]byte, 0)
+	contractAccount := &stateMock.UserAccountStub{
+		Address: contract,
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return []byte("old value"), 0, nil
+		},
+	}
+	tracingAccounts, _ := NewTracingAccountsDB(&stateMock.AccountsStub{
+		RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+			recreatedRootHash = options.GetRootHash()
+			return nil
+		},
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if string(address) == string(contract) {
+				return contractAccount, nil
+			}
+			return &stateMock.UserAccountStub{Address: address}, nil
+		},
+	})
+	args.Accounts = tracingAccounts
+	args.SimulationAccounts, _ = NewSimulationAccountsDB(tracingAccounts)
+
+	var currentHeader data.HeaderHandler
+	args.BlockChainHook = &testscommon.BlockChainHookStub{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			currentHeader = hdr
+		},
+	}
+
+	var currentResults map[string]data.TransactionHandler
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{
+				GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+					return currentResults
+				},
+			}, nil
+		},
+	}
+
+	vmOutputCacher := args.VMOutputCacher
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			account, _ := args.SimulationAccounts.LoadAccount(contract)
+			_, _, _ = account.(userAccountHandler).RetrieveValue([]byte("key"))
+
+			vmOutputCacher.Put(txHash, &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: 40000,
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					string(contract): {
+						Address: contract,
+						StorageUpdates: map[string]*vmcommon.StorageUpdate{
+							"key": {Offset: []byte("key"), Data: []byte("new value")},
+						},
+					},
+					string(sender): {
+						Address: sender,
+						OutputTransfers: []vmcommon.OutputTransfer{
+							{Value: big.NewInt(10), SenderAddress: contract, CallType: vm.DirectCall},
+						},
+					},
+				},
+				Logs: []*vmcommon.LogEntry{
+					{
+						Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+						Address:    contract,
+						Topics:     [][]byte{[]byte("TKN-abcdef"), nil, big.NewInt(5).Bytes(), sender},
+					},
+				},
+			}, 0)
+			currentResults = map[string]data.TransactionHandler{string(scrHash): scr}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	args.SCRProcessor = &testscommon.SCProcessorMock{
+		ProcessSmartContractResultCalled: func(processedSCR *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			require.Equal(t, scr, processedSCR)
+			return vmcommon.Ok, nil
+		},
+	}
+
+	tracer, _ := NewTransactionTracer(args)
+	trace, err := tracer.TraceTransaction(txHash)
+	require.Nil(t, err)
+	require.Equal(t, []byte("parent root hash"), recreatedRootHash)
+	require.Equal(t, header, currentHeader)
+
+	require.Equal(t, hex.EncodeToString(txHash), trace.Hash)
+	require.Equal(t, hex.EncodeToString([]byte("header hash")), trace.BlockHash)
+	require.Equal(t, uint64(11), trace.BlockNonce)
+	require.Equal(t, transaction.TxStatusSuccess, trace.Status)
+	require.True(t, trace.SenderNonceAdjusted)
+	require.False(t, trace.Truncated)
+	require.Equal(t, uint64(60000), trace.GasConsumed)
+	require.Len(t, trace.Steps, 2)
+
+	rootStep := trace.Steps[0]
+	require.Equal(t, -1, rootStep.ParentIndex)
+	require.Equal(t, traceStepKindTransaction, rootStep.Kind)
+	require.True(t, rootStep.Executed)
+	require.Equal(t, vmcommon.Ok.String(), rootStep.ReturnCode)
+	require.Equal(t, uint64(60000), rootStep.GasConsumed)
+	require.Equal(t, []*txSimData.StorageAccessTrace{
+		{Address: hex.EncodeToString(contract), Key: hex.EncodeToString([]byte("key")), Value: hex.EncodeToString([]byte("old value"))},
+	}, rootStep.StorageReads)
+	require.Equal(t, []*txSimData.StorageAccessTrace{
+		{Address: hex.EncodeToString(contract), Key: hex.EncodeToString([]byte("key")), Value: hex.EncodeToString([]byte("new value"))},
+	}, rootStep.StorageWrites)
+	require.Len(t, rootStep.Calls, 1)
+	require.Equal(t, hex.EncodeToString(sender), rootStep.Calls[0].Receiver)
+	require.Equal(t, "10", rootStep.Calls[0].Value)
+	require.Equal(t, []*txSimData.ESDTTransferTrace{
+		{
+			Sender:     hex.EncodeToString(contract),
+			Receiver:   hex.EncodeToString(sender),
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			Token:      "TKN-abcdef",
+			Nonce:      0,
+			Value:      "5",
+		},
+	}, rootStep.ESDTTransfers)
+	require.Len(t, rootStep.Logs, 1)
+
+	scrStep := trace.Steps[1]
+	require.Equal(t, 0, scrStep.ParentIndex)
+	require.Equal(t, hex.EncodeToString(scrHash), scrStep.Hash)
+	require.Equal(t, traceStepKindTransfer, scrStep.Kind)
+	require.True(t, scrStep.Executed)
+	require.Equal(t, "10", scrStep.Value)
+	require.Empty(t, scrStep.StorageReads)
+}
+
+func TestTransactionTracer_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var tracer *transactionTracer
+	require.True(t, check.IfNil(tracer))
+
+	tracer, _ = NewTransactionTracer(createMockTransactionTracerArgs())
+	require.False(t, check.IfNil(tracer))
+}
@@ -9,3 +9,42 @@ var ErrNilMessenger = errors.New("nil messenger")
 
 // ErrNilObserverPrivateKey signals that a nil observer private key has been provided
 var ErrNilObserverPrivateKey = errors.New("nil observer private key")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilPublicKey signals that a nil public key has been provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrInvalidLeaseDuration signals that an invalid lease duration has been provided
+var ErrInvalidLeaseDuration = errors.New("invalid lease duration")
+
+// ErrInvalidRedundancyLevel signals that an invalid redundancy level has been provided
+var ErrInvalidRedundancyLevel = errors.New("invalid redundancy level")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrPeerMismatch signals that the peer found in the lease heartbeat is not the one that sent the message
+var ErrPeerMismatch = errors.New("lease heartbeat peer mismatch")
+
+// ErrNilAntifloodHandler signals that a nil antiflood handler has been provided
+var ErrNilAntifloodHandler = errors.New("nil antiflood handler")
+
+// ErrInvalidLeaseMessage signals that a lease message too short to be decrypted has been received
+var ErrInvalidLeaseMessage = errors.New("invalid lease message")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidLeaseEpoch signals that an invalid lease epoch was found in the storer
+var ErrInvalidLeaseEpoch = errors.New("invalid lease epoch")
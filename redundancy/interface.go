@@ -2,6 +2,7 @@ package redundancy
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
 )
 
 // P2PMessenger defines a subset of the p2p.Messenger interface
@@ -9,3 +10,19 @@ type P2PMessenger interface {
 	ID() core.PeerID
 	IsInterfaceNil() bool
 }
+
+// LeaseMessenger defines the subset of the p2p.Messenger interface used by the leader lease redundancy
+type LeaseMessenger interface {
+	ID() core.PeerID
+	Broadcast(topic string, buff []byte)
+	IsInterfaceNil() bool
+}
+
+// LeaseAntifloodHandler defines the subset of the antiflood handler used by the leader lease redundancy
+type LeaseAntifloodHandler interface {
+	CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error
+	ResetForTopic(topic string)
+	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,721 @@
+package redundancy
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	// LeaseStateFollower is the state of a machine that does not hold the lease but will take over if the lease expires
+	LeaseStateFollower = "follower"
+	// LeaseStateHolder is the state of the machine holding the lease, the only one allowed to sign
+	LeaseStateHolder = "holder"
+	// LeaseStateDemoted is the state of a machine manually demoted, that will not take over until promoted
+	LeaseStateDemoted = "demoted"
+	// LeaseStateFenced is the state of a lease holder whose heartbeats were not acknowledged for a whole lease duration,
+	// or that waits for the previous lease to be released or to expire. It keeps sending heartbeats, but does not sign
+	// until they are acknowledged
+	LeaseStateFenced = "fenced"
+
+	reasonLeaseExpired       = "lease expired"
+	reasonLeaseNotRenewed    = "lease renewal not acknowledged"
+	reasonLeaseRenewed       = "lease renewal acknowledged"
+	reasonPreviousLease      = "previous lease not yet released or expired"
+	reasonPreviousExpired    = "previous lease expired"
+	reasonHigherLeaseEpoch   = "heartbeat with a higher lease epoch received"
+	reasonLeaseConflict      = "conflicting heartbeat with the same lease epoch received"
+	reasonManuallyPromoted   = "manually promoted"
+	reasonManuallyDemoted    = "manually demoted"
+	reasonNotSynchronized    = "node not synchronized for a lease duration"
+	noRoundYet               = int64(-1)
+	minLeaseDurationInRounds = int64(1)
+	// a peer sends, in a round, at most a heartbeat and the acknowledgements of the heartbeats of the conflicting holders
+	maxLeaseMessagesInARoundPerPeer = uint32(4)
+	leaseEncryptionKeyPrefix        = "redundancy lease"
+	leaseEpochKey                   = "leaseEpoch"
+	// leaseSignatureContext prefixes the signed payload, so a lease message signature can not be replayed as the
+	// signature of another message signed with the validator key
+	leaseSignatureContext = "redundancy lease message"
+)
+
+// LeaseHeartbeat is the message broadcast, each round, by the lease holder in order to renew its lease. The other
+// machines answer each heartbeat with a message having IsAck set, acknowledging the lease epoch of the holder. A
+// heartbeat with a lower lease epoch is answered with the current lease epoch and holder, so the stale machine steps down
+type LeaseHeartbeat struct {
+	LeaseEpoch      uint64 `json:"leaseEpoch"`
+	Round           int64  `json:"round"`
+	RedundancyLevel int64  `json:"redundancyLevel"`
+	PeerID          []byte `json:"peerID"`
+	IsAck           bool   `json:"isAck,omitempty"`
+	Holder          []byte `json:"holder,omitempty"`
+	Signature       []byte `json:"signature,omitempty"`
+}
+
+// ArgLeaderLeaseRedundancy represents the DTO structure used by the leaderLeaseRedundancy's constructor
+type ArgLeaderLeaseRedundancy struct {
+	RedundancyLevel       int64
+	LeaseDurationInRounds int64
+	Topic                 string
+	Messenger             LeaseMessenger
+	AntifloodHandler      LeaseAntifloodHandler
+	Marshaller            marshal.Marshalizer
+	SingleSigner          crypto.SingleSigner
+	PrivateKey            crypto.PrivateKey
+	PublicKey             crypto.PublicKey
+	ObserverPrivateKey    crypto.PrivateKey
+	AppStatusHandler      core.AppStatusHandler
+	Storer                storage.Storer
+}
+
+// leaderLeaseRedundancy implements the single-key redundancy with an explicit lease: only the lease holder signs, and
+// the lease is renewed each round by a heartbeat signed with the shared validator key. The lease epoch acts as a fencing
+// token, a holder stepping down as soon as it sees a heartbeat carrying a higher epoch. A holder whose heartbeats were
+// acknowledged once, but not in the last lease duration, stops signing before any other machine can take over, and a
+// lease never acknowledged is held only while no other machine was heard from. The lease epoch is persisted, so a
+// restarted machine never acquires a lower lease epoch. The messages are encrypted with a key derived from the validator key, so they do not link the peer IDs of the
+// machines to the validator key for the other nodes of the topic
+type leaderLeaseRedundancy struct {
+	mut                   sync.RWMutex
+	redundancyLevel       int64
+	leaseDurationInRounds int64
+	takeOverDelayInRounds int64
+	topic                 string
+	messenger             LeaseMessenger
+	antifloodHandler      LeaseAntifloodHandler
+	marshaller            marshal.Marshalizer
+	singleSigner          crypto.SingleSigner
+	privateKey            crypto.PrivateKey
+	publicKey             crypto.PublicKey
+	observerPrivateKey    crypto.PrivateKey
+	appStatusHandler      core.AppStatusHandler
+	aead                  cipher.AEAD
+	storer                storage.Storer
+
+	state                     string
+	leaseEpoch                uint64
+	currentRound              int64
+	lastHeartbeatRound        int64
+	lastAckRound              int64
+	lastPeerMessageRound      int64
+	previousLeaseExpiryRound  int64
+	notSynchronizedSinceRound int64
+	lastHolder                core.PeerID
+	numTransitions            uint64
+}
+
+// NewLeaderLeaseRedundancy creates a lease based node redundancy object which implements the NodeRedundancyHandler interface
+func NewLeaderLeaseRedundancy(arg ArgLeaderLeaseRedundancy) (*leaderLeaseRedundancy, error) {
+	err := checkLeaderLeaseArgs(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := createLeaseCipher(arg.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseEpoch, err := loadLeaseEpoch(arg.Storer)
+	if err != nil {
+		return nil, err
+	}
+
+	llr := &leaderLeaseRedundancy{
+		redundancyLevel:       arg.RedundancyLevel,
+		leaseDurationInRounds: arg.LeaseDurationInRounds,
+		// a follower takes over only after a fenced holder stopped signing, which happens a lease duration after the
+		// last acknowledged heartbeat
+		takeOverDelayInRounds:     arg.LeaseDurationInRounds * (2 + arg.RedundancyLevel),
+		topic:                     arg.Topic,
+		messenger:                 arg.Messenger,
+		antifloodHandler:          arg.AntifloodHandler,
+		marshaller:                arg.Marshaller,
+		singleSigner:              arg.SingleSigner,
+		privateKey:                arg.PrivateKey,
+		publicKey:                 arg.PublicKey,
+		observerPrivateKey:        arg.ObserverPrivateKey,
+		appStatusHandler:          arg.AppStatusHandler,
+		aead:                      aead,
+		storer:                    arg.Storer,
+		state:                     LeaseStateFollower,
+		leaseEpoch:                leaseEpoch,
+		currentRound:              noRoundYet,
+		lastHeartbeatRound:        noRoundYet,
+		lastAckRound:              noRoundYet,
+		lastPeerMessageRound:      noRoundYet,
+		previousLeaseExpiryRound:  noRoundYet,
+		notSynchronizedSinceRound: noRoundYet,
+	}
+	llr.antifloodHandler.SetMaxMessagesForTopic(llr.topic, maxLeaseMessagesInARoundPerPeer)
+	llr.updateMetrics("")
+
+	return llr, nil
+}
+
+// createLeaseCipher creates the authenticated cipher of the lease messages, keyed by a hash of the validator key shared
+// by all the machines
+func createLeaseCipher(privateKey crypto.PrivateKey) (cipher.AEAD, error) {
+	privateKeyBytes, err := privateKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	key := sha256.Sum256(append([]byte(leaseEncryptionKeyPrefix), privateKeyBytes...))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// loadLeaseEpoch returns the last lease epoch persisted, or 0 if the machine never took part in the lease protocol
+func loadLeaseEpoch(storer storage.Storer) (uint64, error) {
+	buff, err := storer.Get([]byte(leaseEpochKey))
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(buff) != 8 {
+		return 0, fmt.Errorf("%w, persisted lease epoch of %d bytes", ErrInvalidLeaseEpoch, len(buff))
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
+
+func checkLeaderLeaseArgs(arg ArgLeaderLeaseRedundancy) error {
+	if arg.RedundancyLevel < 0 {
+		return fmt.Errorf("%w, got %d", ErrInvalidRedundancyLevel, arg.RedundancyLevel)
+	}
+	if arg.LeaseDurationInRounds < minLeaseDurationInRounds {
+		return fmt.Errorf("%w, minimum %d, got %d", ErrInvalidLeaseDuration, minLeaseDurationInRounds, arg.LeaseDurationInRounds)
+	}
+	if check.IfNil(arg.Messenger) {
+		return ErrNilMessenger
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(arg.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(arg.PrivateKey) {
+		return ErrNilPrivateKey
+	}
+	if check.IfNil(arg.PublicKey) {
+		return ErrNilPublicKey
+	}
+	if check.IfNil(arg.ObserverPrivateKey) {
+		return ErrNilObserverPrivateKey
+	}
+	if check.IfNil(arg.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if check.IfNil(arg.Storer) {
+		return ErrNilStorer
+	}
+
+	return nil
+}
+
+// IsRedundancyNode returns true as, with the lease protocol, every machine signs only while holding the lease
+func (llr *leaderLeaseRedundancy) IsRedundancyNode() bool {
+	return true
+}
+
+// IsMainMachineActive returns true if the current machine does not hold the lease
+func (llr *leaderLeaseRedundancy) IsMainMachineActive() bool {
+	llr.mut.RLock()
+	defer llr.mut.RUnlock()
+
+	return llr.state != LeaseStateHolder
+}
+
+// AdjustInactivityIfNeeded is called once per round. The lease holder renews its lease, or fences itself if its renewals
+// were not acknowledged, while a follower takes over if the lease expired
+func (llr *leaderLeaseRedundancy) AdjustInactivityIfNeeded(_ string, _ []string, roundIndex int64) {
+	llr.mut.Lock()
+	defer llr.mut.Unlock()
+
+	if roundIndex <= llr.currentRound {
+		return
+	}
+
+	llr.startRound(roundIndex)
+	llr.notSynchronizedSinceRound = noRoundYet
+
+	switch llr.state {
+	case LeaseStateHolder, LeaseStateFenced:
+		llr.updateHolderState()
+		llr.broadcastHeartbeat()
+	case LeaseStateFollower:
+		roundsWithoutHeartbeat := roundIndex - llr.lastHeartbeatRound
+		if roundsWithoutHeartbeat <= llr.takeOverDelayInRounds {
+			return
+		}
+
+		llr.acquireLease(reasonLeaseExpired)
+	}
+}
+
+// AdjustInactivityWhileNotSynchronized is called once per round while the node is not synchronized. The rounds are still
+// counted, so the messages received meanwhile are attributed to the right round, but a follower does not take over. A
+// holder keeps renewing its lease for a lease duration, then steps down so that another machine can take over
+func (llr *leaderLeaseRedundancy) AdjustInactivityWhileNotSynchronized(roundIndex int64) {
+	llr.mut.Lock()
+	defer llr.mut.Unlock()
+
+	if roundIndex <= llr.currentRound {
+		return
+	}
+
+	llr.startRound(roundIndex)
+	if llr.notSynchronizedSinceRound == noRoundYet {
+		llr.notSynchronizedSinceRound = roundIndex
+	}
+	if !llr.isHoldingLease() {
+		return
+	}
+
+	if roundIndex-llr.notSynchronizedSinceRound >= llr.leaseDurationInRounds {
+		llr.setState(LeaseStateFollower, reasonNotSynchronized)
+		// the machine stepping down waits for another one to take over, as a follower hearing no heartbeat would
+		llr.lastHeartbeatRound = roundIndex
+		return
+	}
+
+	llr.updateHolderState()
+	llr.broadcastHeartbeat()
+}
+
+func (llr *leaderLeaseRedundancy) startRound(roundIndex int64) {
+	llr.currentRound = roundIndex
+	llr.antifloodHandler.ResetForTopic(llr.topic)
+	if llr.lastHeartbeatRound == noRoundYet {
+		// the machine just started, so it waits for the heartbeats of a possible lease holder before taking over
+		llr.lastHeartbeatRound = roundIndex
+	}
+}
+
+// updateHolderState fences the holder whose lease renewals are not acknowledged and lets it sign again otherwise
+func (llr *leaderLeaseRedundancy) updateHolderState() {
+	if llr.isLeaseRenewalUnacknowledged() {
+		reason := reasonLeaseNotRenewed
+		if llr.isPreviousLeaseValid() {
+			reason = reasonPreviousLease
+		}
+		llr.setState(LeaseStateFenced, reason)
+		return
+	}
+
+	if llr.lastAckRound == noRoundYet {
+		llr.setState(LeaseStateHolder, reasonPreviousExpired)
+	}
+}
+
+// isLeaseRenewalUnacknowledged returns true if the heartbeats of the current lease were acknowledged by another machine,
+// but not in the last lease duration. A lease never acknowledged is unacknowledged until the previous lease expired
+// and while another machine is heard from, as that machine might still sign. A lease acquired after all the other
+// machines stopped does not fence the holder, since there is no other machine to take over
+func (llr *leaderLeaseRedundancy) isLeaseRenewalUnacknowledged() bool {
+	if llr.lastAckRound != noRoundYet {
+		return llr.currentRound-llr.lastAckRound > llr.leaseDurationInRounds
+	}
+	if llr.isPreviousLeaseValid() {
+		return true
+	}
+
+	return llr.isPeerKnown()
+}
+
+func (llr *leaderLeaseRedundancy) isPreviousLeaseValid() bool {
+	return llr.previousLeaseExpiryRound != noRoundYet && llr.currentRound <= llr.previousLeaseExpiryRound
+}
+
+// isPeerKnown returns true if a message of another machine sharing the validator key was received in the last lease duration
+func (llr *leaderLeaseRedundancy) isPeerKnown() bool {
+	if llr.lastPeerMessageRound == noRoundYet {
+		return false
+	}
+
+	return llr.currentRound-llr.lastPeerMessageRound <= llr.leaseDurationInRounds
+}
+
+// ResetInactivityIfNeeded logs the consensus messages signed with the own key by another machine while holding the lease
+func (llr *leaderLeaseRedundancy) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
+	if selfPubKey != consensusMsgPubKey {
+		return
+	}
+	if consensusMsgPeerID == llr.messenger.ID() {
+		return
+	}
+
+	llr.mut.RLock()
+	defer llr.mut.RUnlock()
+
+	if llr.state == LeaseStateHolder {
+		log.Error("consensus message signed with the own key received from another machine while holding the redundancy lease",
+			"peer", consensusMsgPeerID.Pretty(),
+			"lease epoch", llr.leaseEpoch)
+	}
+}
+
+// ProcessReceivedMessage handles the lease heartbeats and acknowledgements received from the other machines sharing the
+// validator key. The messages of the machines sharing other validator keys can not be decrypted and are only relayed
+func (llr *leaderLeaseRedundancy) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, _ p2p.MessageHandler) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+	if message.Peer() == llr.messenger.ID() {
+		return nil
+	}
+
+	err := llr.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = llr.antifloodHandler.CanProcessMessagesOnTopic(message.Peer(), llr.topic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	payload, err := llr.decrypt(message.Data())
+	if err != nil {
+		log.Trace("redundancy lease message of another validator key", "peer", message.Peer().Pretty())
+		return nil
+	}
+
+	heartbeat := &LeaseHeartbeat{}
+	err = llr.marshaller.Unmarshal(heartbeat, payload)
+	if err != nil {
+		return err
+	}
+	err = llr.verifyHeartbeat(heartbeat, message.Peer())
+	if err != nil {
+		return err
+	}
+
+	llr.mut.Lock()
+	defer llr.mut.Unlock()
+
+	if llr.currentRound != noRoundYet {
+		llr.lastPeerMessageRound = llr.currentRound
+	}
+	if heartbeat.IsAck {
+		llr.processAck(heartbeat, message.Peer())
+		return nil
+	}
+
+	llr.processHeartbeat(heartbeat, message.Peer())
+
+	return nil
+}
+
+func (llr *leaderLeaseRedundancy) verifyHeartbeat(heartbeat *LeaseHeartbeat, sender core.PeerID) error {
+	if !bytes.Equal(heartbeat.PeerID, sender.Bytes()) {
+		return fmt.Errorf("%w, sender %s", ErrPeerMismatch, sender.Pretty())
+	}
+
+	signature := heartbeat.Signature
+	heartbeat.Signature = nil
+	payload, err := llr.marshaller.Marshal(heartbeat)
+	heartbeat.Signature = signature
+	if err != nil {
+		return err
+	}
+
+	return llr.singleSigner.Verify(llr.publicKey, buildLeaseSignedPayload(payload), signature)
+}
+
+func (llr *leaderLeaseRedundancy) isStale(heartbeat *LeaseHeartbeat) bool {
+	return llr.currentRound != noRoundYet && heartbeat.Round < llr.currentRound-llr.leaseDurationInRounds
+}
+
+func (llr *leaderLeaseRedundancy) processAck(ack *LeaseHeartbeat, sender core.PeerID) {
+	if llr.isStale(ack) {
+		return
+	}
+	if ack.LeaseEpoch > llr.leaseEpoch {
+		// the answer to a heartbeat with a lower lease epoch, or the acknowledgement of another holder
+		llr.followLease(ack.LeaseEpoch, core.PeerID(ack.Holder), reasonHigherLeaseEpoch)
+		return
+	}
+	if ack.LeaseEpoch != llr.leaseEpoch {
+		return
+	}
+	if llr.state != LeaseStateHolder && llr.state != LeaseStateFenced {
+		return
+	}
+	if !bytes.Equal(ack.Holder, llr.messenger.ID().Bytes()) {
+		log.Debug("ignoring redundancy lease acknowledgement of another holder",
+			"peer", sender.Pretty(), "holder", core.PeerID(ack.Holder).Pretty(), "lease epoch", ack.LeaseEpoch)
+		return
+	}
+
+	log.Trace("redundancy lease renewal acknowledged", "peer", sender.Pretty(), "lease epoch", ack.LeaseEpoch)
+	llr.lastAckRound = llr.currentRound
+	llr.setState(LeaseStateHolder, reasonLeaseRenewed)
+}
+
+func (llr *leaderLeaseRedundancy) processHeartbeat(heartbeat *LeaseHeartbeat, sender core.PeerID) {
+	if llr.isStale(heartbeat) {
+		log.Debug("ignoring stale redundancy lease heartbeat",
+			"peer", sender.Pretty(), "round", heartbeat.Round, "current round", llr.currentRound)
+		return
+	}
+	if heartbeat.LeaseEpoch < llr.leaseEpoch {
+		log.Debug("answering redundancy lease heartbeat with a lower lease epoch",
+			"peer", sender.Pretty(), "lease epoch", heartbeat.LeaseEpoch, "own lease epoch", llr.leaseEpoch)
+		llr.broadcastAck(llr.leaseEpoch, llr.lastHolder)
+		return
+	}
+
+	reason := reasonHigherLeaseEpoch
+	if heartbeat.LeaseEpoch == llr.leaseEpoch {
+		if llr.isHoldingLease() && llr.winsLeaseConflict(heartbeat, sender) {
+			return
+		}
+		reason = reasonLeaseConflict
+	}
+
+	llr.followLease(heartbeat.LeaseEpoch, sender, reason)
+	llr.broadcastAck(heartbeat.LeaseEpoch, sender)
+}
+
+// followLease makes the machine step down, if it holds the lease, and follow the lease of the given holder
+func (llr *leaderLeaseRedundancy) followLease(leaseEpoch uint64, holder core.PeerID, reason string) {
+	if llr.isHoldingLease() {
+		llr.setState(LeaseStateFollower, reason)
+	}
+
+	if leaseEpoch > llr.leaseEpoch {
+		llr.leaseEpoch = leaseEpoch
+		err := llr.persistLeaseEpoch()
+		if err != nil {
+			log.Error("cannot persist the redundancy lease epoch", "lease epoch", leaseEpoch, "error", err)
+		}
+	}
+	if len(holder) > 0 {
+		llr.lastHolder = holder
+	}
+	if llr.currentRound != noRoundYet {
+		llr.lastHeartbeatRound = llr.currentRound
+	}
+	llr.updateMetrics("")
+}
+
+func (llr *leaderLeaseRedundancy) isHoldingLease() bool {
+	return llr.state == LeaseStateHolder || llr.state == LeaseStateFenced
+}
+
+func (llr *leaderLeaseRedundancy) persistLeaseEpoch() error {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, llr.leaseEpoch)
+
+	return llr.storer.Put([]byte(leaseEpochKey), buff)
+}
+
+// winsLeaseConflict decides which of the two machines that acquired the same lease epoch keeps it: the lower
+// redundancy level wins, with the peer ID breaking the ties
+func (llr *leaderLeaseRedundancy) winsLeaseConflict(heartbeat *LeaseHeartbeat, sender core.PeerID) bool {
+	if llr.redundancyLevel != heartbeat.RedundancyLevel {
+		return llr.redundancyLevel < heartbeat.RedundancyLevel
+	}
+
+	return bytes.Compare(llr.messenger.ID().Bytes(), sender.Bytes()) < 0
+}
+
+// acquireLease takes the lease with a higher lease epoch, persisted before the first heartbeat is sent. The machine
+// signs only after the previous holder acknowledged the new lease, or after the previous lease expired, which happens
+// a lease duration after the last heartbeat received from the previous holder
+func (llr *leaderLeaseRedundancy) acquireLease(reason string) {
+	llr.leaseEpoch++
+	err := llr.persistLeaseEpoch()
+	if err != nil {
+		log.Error("cannot persist the redundancy lease epoch, not acquiring the lease", "lease epoch", llr.leaseEpoch, "error", err)
+		llr.leaseEpoch--
+		return
+	}
+
+	llr.previousLeaseExpiryRound = noRoundYet
+	if len(llr.lastHolder) > 0 && llr.lastHolder != llr.messenger.ID() && llr.lastHeartbeatRound != noRoundYet {
+		llr.previousLeaseExpiryRound = llr.lastHeartbeatRound + llr.leaseDurationInRounds + 1
+	}
+	llr.lastAckRound = noRoundYet
+	llr.lastHolder = llr.messenger.ID()
+	if llr.isLeaseRenewalUnacknowledged() {
+		llr.setState(LeaseStateFenced, reason)
+	} else {
+		llr.setState(LeaseStateHolder, reason)
+	}
+	llr.updateMetrics("")
+	llr.broadcastHeartbeat()
+}
+
+func (llr *leaderLeaseRedundancy) setState(state string, reason string) {
+	if llr.state == state {
+		return
+	}
+
+	transition := fmt.Sprintf("%s -> %s: %s", llr.state, state, reason)
+	log.Info("redundancy lease state changed",
+		"transition", transition,
+		"lease epoch", llr.leaseEpoch,
+		"round", llr.currentRound)
+
+	llr.state = state
+	llr.numTransitions++
+	llr.updateMetrics(transition)
+}
+
+func (llr *leaderLeaseRedundancy) updateMetrics(transition string) {
+	llr.appStatusHandler.SetStringValue(common.MetricRedundancyLeaseState, llr.state)
+	llr.appStatusHandler.SetUInt64Value(common.MetricRedundancyLeaseEpoch, llr.leaseEpoch)
+	llr.appStatusHandler.SetUInt64Value(common.MetricRedundancyLeaseTransitions, llr.numTransitions)
+	if len(transition) > 0 {
+		llr.appStatusHandler.SetStringValue(common.MetricRedundancyLeaseLastTransition, transition)
+	}
+}
+
+func (llr *leaderLeaseRedundancy) broadcastHeartbeat() {
+	llr.broadcast(&LeaseHeartbeat{
+		LeaseEpoch:      llr.leaseEpoch,
+		Round:           llr.currentRound,
+		RedundancyLevel: llr.redundancyLevel,
+		PeerID:          llr.messenger.ID().Bytes(),
+	})
+}
+
+func (llr *leaderLeaseRedundancy) broadcastAck(leaseEpoch uint64, holder core.PeerID) {
+	llr.broadcast(&LeaseHeartbeat{
+		LeaseEpoch:      leaseEpoch,
+		Round:           llr.currentRound,
+		RedundancyLevel: llr.redundancyLevel,
+		PeerID:          llr.messenger.ID().Bytes(),
+		IsAck:           true,
+		Holder:          holder.Bytes(),
+	})
+}
+
+func (llr *leaderLeaseRedundancy) broadcast(heartbeat *LeaseHeartbeat) {
+	payload, err := llr.marshaller.Marshal(heartbeat)
+	if err != nil {
+		log.Warn("cannot marshal the redundancy lease message", "error", err)
+		return
+	}
+	heartbeat.Signature, err = llr.singleSigner.Sign(llr.privateKey, buildLeaseSignedPayload(payload))
+	if err != nil {
+		log.Warn("cannot sign the redundancy lease message", "error", err)
+		return
+	}
+	buff, err := llr.marshaller.Marshal(heartbeat)
+	if err != nil {
+		log.Warn("cannot marshal the signed redundancy lease message", "error", err)
+		return
+	}
+	buff, err = llr.encrypt(buff)
+	if err != nil {
+		log.Warn("cannot encrypt the redundancy lease message", "error", err)
+		return
+	}
+
+	llr.messenger.Broadcast(llr.topic, buff)
+}
+
+func buildLeaseSignedPayload(payload []byte) []byte {
+	return append([]byte(leaseSignatureContext), payload...)
+}
+
+// encrypt seals the message, prefixing it with the random nonce used
+func (llr *leaderLeaseRedundancy) encrypt(buff []byte) ([]byte, error) {
+	nonce := make([]byte, llr.aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return llr.aead.Seal(nonce, nonce, buff, nil), nil
+}
+
+func (llr *leaderLeaseRedundancy) decrypt(buff []byte) ([]byte, error) {
+	nonceSize := llr.aead.NonceSize()
+	if len(buff) < nonceSize {
+		return nil, ErrInvalidLeaseMessage
+	}
+
+	return llr.aead.Open(nil, buff[:nonceSize], buff[nonceSize:], nil)
+}
+
+// Promote makes the current machine acquire the lease, with a higher lease epoch, so that the current holder steps down.
+// The machine is fenced until the current holder acknowledged the new lease, or until the current lease expired
+func (llr *leaderLeaseRedundancy) Promote() common.RedundancyLeaseStatus {
+	llr.mut.Lock()
+	defer llr.mut.Unlock()
+
+	llr.acquireLease(reasonManuallyPromoted)
+
+	return llr.status()
+}
+
+// Demote makes the current machine release the lease, if held, and not take over until promoted again
+func (llr *leaderLeaseRedundancy) Demote() common.RedundancyLeaseStatus {
+	llr.mut.Lock()
+	defer llr.mut.Unlock()
+
+	llr.setState(LeaseStateDemoted, reasonManuallyDemoted)
+
+	return llr.status()
+}
+
+// Status returns the state of the current machine in the lease protocol
+func (llr *leaderLeaseRedundancy) Status() common.RedundancyLeaseStatus {
+	llr.mut.RLock()
+	defer llr.mut.RUnlock()
+
+	return llr.status()
+}
+
+func (llr *leaderLeaseRedundancy) status() common.RedundancyLeaseStatus {
+	return common.RedundancyLeaseStatus{
+		State:           llr.state,
+		LeaseEpoch:      llr.leaseEpoch,
+		RedundancyLevel: llr.redundancyLevel,
+		LastHolder:      llr.lastHolder.Pretty(),
+	}
+}
+
+// Close closes the storer of the lease epoch
+func (llr *leaderLeaseRedundancy) Close() error {
+	return llr.storer.Close()
+}
+
+// ObserverPrivateKey returns the stored private key by this instance. This key will be used whenever a new key,
+// different from the main key is required. Example: sending anonymous heartbeat messages while the node does not hold the lease.
+func (llr *leaderLeaseRedundancy) ObserverPrivateKey() crypto.PrivateKey {
+	return llr.observerPrivateKey
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (llr *leaderLeaseRedundancy) IsInterfaceNil() bool {
+	return llr == nil
+}
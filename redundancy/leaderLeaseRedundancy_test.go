@@ -0,0 +1,856 @@
+package redundancy_test
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/redundancy"
+	"github.com/multiversx/mx-chain-go/redundancy/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const leaseTopic = "redundancyLease_0"
+
+var errInvalidSignature = errors.New("invalid signature")
+
+func createSingleSignerStub() *cryptoMocks.SingleSignerStub {
+	return &cryptoMocks.SingleSignerStub{
+		SignCalled: func(_ crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return append([]byte("sig:"), msg...), nil
+		},
+		VerifyCalled: func(_ crypto.PublicKey, msg []byte, sig []byte) error {
+			if !bytes.Equal(append([]byte("sig:"), msg...), sig) {
+				return errInvalidSignature
+			}
+			return nil
+		},
+	}
+}
+
+func createMockArgLeaderLeaseRedundancy(pid core.PeerID, broadcast func(topic string, buff []byte)) redundancy.ArgLeaderLeaseRedundancy {
+	return redundancy.ArgLeaderLeaseRedundancy{
+		RedundancyLevel:       0,
+		LeaseDurationInRounds: 2,
+		Topic:                 leaseTopic,
+		Messenger: &p2pmocks.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return pid
+			},
+			BroadcastCalled: broadcast,
+		},
+		AntifloodHandler:   &mock.P2PAntifloodHandlerStub{},
+		Marshaller:         &marshal.JsonMarshalizer{},
+		SingleSigner:       createSingleSignerStub(),
+		PrivateKey:         &mock.PrivateKeyStub{},
+		PublicKey:          &cryptoMocks.PublicKeyStub{},
+		ObserverPrivateKey: &mock.PrivateKeyStub{},
+		AppStatusHandler:   statusHandler.NewAppStatusHandlerMock(),
+		Storer:             genericMocks.NewStorerMockWithErrKeyNotFound(0),
+	}
+}
+
+// createLeaseCipher derives the cipher of the lease messages from the empty key returned by the private key stub
+func createLeaseCipher(t *testing.T) cipher.AEAD {
+	key := sha256.Sum256([]byte("redundancy lease"))
+	block, err := aes.NewCipher(key[:])
+	require.Nil(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.Nil(t, err)
+
+	return aead
+}
+
+func encryptLeaseMessage(t *testing.T, buff []byte) []byte {
+	aead := createLeaseCipher(t)
+	nonce := make([]byte, aead.NonceSize())
+
+	return aead.Seal(nonce, nonce, buff, nil)
+}
+
+func decryptLeaseMessage(t *testing.T, buff []byte) *redundancy.LeaseHeartbeat {
+	aead := createLeaseCipher(t)
+	payload, err := aead.Open(nil, buff[:aead.NonceSize()], buff[aead.NonceSize():], nil)
+	require.Nil(t, err)
+
+	heartbeat := &redundancy.LeaseHeartbeat{}
+	require.Nil(t, (&marshal.JsonMarshalizer{}).Unmarshal(heartbeat, payload))
+
+	return heartbeat
+}
+
+func createSignedHeartbeat(t *testing.T, heartbeat *redundancy.LeaseHeartbeat) []byte {
+	marshaller := &marshal.JsonMarshalizer{}
+	payload, err := marshaller.Marshal(heartbeat)
+	require.Nil(t, err)
+	heartbeat.Signature = append([]byte("sig:redundancy lease message"), payload...)
+
+	buff, err := marshaller.Marshal(heartbeat)
+	require.Nil(t, err)
+
+	return encryptLeaseMessage(t, buff)
+}
+
+func TestNewLeaderLeaseRedundancy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid redundancy level should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.RedundancyLevel = -1
+		llr, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.True(t, errors.Is(err, redundancy.ErrInvalidRedundancyLevel))
+		require.True(t, check.IfNil(llr))
+	})
+	t.Run("invalid lease duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.LeaseDurationInRounds = 0
+		llr, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.True(t, errors.Is(err, redundancy.ErrInvalidLeaseDuration))
+		require.True(t, check.IfNil(llr))
+	})
+	t.Run("nil components should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.Messenger = nil
+		_, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilMessenger, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.AntifloodHandler = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilAntifloodHandler, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.Marshaller = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilMarshaller, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.SingleSigner = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilSingleSigner, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.PrivateKey = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilPrivateKey, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.PublicKey = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilPublicKey, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.ObserverPrivateKey = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilObserverPrivateKey, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.AppStatusHandler = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilAppStatusHandler, err)
+
+		arg = createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.Storer = nil
+		_, err = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, redundancy.ErrNilStorer, err)
+	})
+	t.Run("invalid persisted lease epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("pid", nil)
+		_ = arg.Storer.Put([]byte("leaseEpoch"), []byte("invalid"))
+		llr, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.True(t, errors.Is(err, redundancy.ErrInvalidLeaseEpoch))
+		require.True(t, check.IfNil(llr))
+	})
+	t.Run("private key error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.PrivateKey = &mock.PrivateKeyStub{
+			ToByteArrayCalled: func() ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		llr, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, expectedErr, err)
+		require.True(t, check.IfNil(llr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		maxMessagesPerTopic := make(map[string]uint32)
+		arg := createMockArgLeaderLeaseRedundancy("pid", nil)
+		arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+			SetMaxMessagesForTopicCalled: func(topic string, maxNum uint32) {
+				maxMessagesPerTopic[topic] = maxNum
+			},
+		}
+		llr, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Nil(t, err)
+		require.Equal(t, map[string]uint32{leaseTopic: 4}, maxMessagesPerTopic)
+		require.False(t, check.IfNil(llr))
+		require.True(t, llr.IsRedundancyNode())
+		require.True(t, llr.IsMainMachineActive())
+		require.Equal(t, redundancy.LeaseStateFollower, llr.Status().State)
+	})
+}
+
+func TestLeaderLeaseRedundancy_ShouldTakeOverAfterTheLeaseExpired(t *testing.T) {
+	t.Parallel()
+
+	broadcasts := make([][]byte, 0)
+	arg := createMockArgLeaderLeaseRedundancy("backup", func(topic string, buff []byte) {
+		require.Equal(t, leaseTopic, topic)
+		broadcasts = append(broadcasts, buff)
+	})
+	arg.RedundancyLevel = 1
+	llr, _ := redundancy.NewLeaderLeaseRedundancy(arg)
+
+	// the take over delay is LeaseDurationInRounds * (2 + RedundancyLevel) = 6 rounds
+	for round := int64(10); round <= 16; round++ {
+		llr.AdjustInactivityIfNeeded("", nil, round)
+		require.True(t, llr.IsMainMachineActive())
+	}
+	require.Empty(t, broadcasts)
+
+	llr.AdjustInactivityIfNeeded("", nil, 17)
+	require.False(t, llr.IsMainMachineActive())
+	require.Equal(t, uint64(1), llr.Status().LeaseEpoch)
+	require.Len(t, broadcasts, 1)
+
+	heartbeat := decryptLeaseMessage(t, broadcasts[0])
+	require.Equal(t, uint64(1), heartbeat.LeaseEpoch)
+	require.Equal(t, int64(17), heartbeat.Round)
+	require.Equal(t, int64(1), heartbeat.RedundancyLevel)
+	require.Equal(t, []byte("backup"), heartbeat.PeerID)
+	require.False(t, heartbeat.IsAck)
+
+	// the holder renews its lease once per round and, as no other machine acknowledged it, never fences itself
+	llr.AdjustInactivityIfNeeded("", nil, 17)
+	for round := int64(18); round <= 30; round++ {
+		llr.AdjustInactivityIfNeeded("", nil, round)
+	}
+	require.Len(t, broadcasts, 14)
+	require.False(t, llr.IsMainMachineActive())
+}
+
+func TestLeaderLeaseRedundancy_ShouldFenceWhenTheRenewalIsNotAcknowledged(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", func(_ string, _ []byte) {
+		numBroadcasts++
+	}))
+	llr.AdjustInactivityIfNeeded("", nil, 1)
+	llr.Promote()
+
+	ack := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, Round: 1, PeerID: []byte("other"), IsAck: true, Holder: []byte("self")})
+	require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: ack}, "", nil))
+
+	// the lease duration is 2 rounds
+	for round := int64(2); round <= 3; round++ {
+		llr.AdjustInactivityIfNeeded("", nil, round)
+		require.False(t, llr.IsMainMachineActive())
+	}
+	llr.AdjustInactivityIfNeeded("", nil, 4)
+	require.True(t, llr.IsMainMachineActive())
+	require.Equal(t, redundancy.LeaseStateFenced, llr.Status().State)
+
+	// a fenced machine keeps sending heartbeats, but does not sign
+	numBroadcastsWhenFenced := numBroadcasts
+	for round := int64(5); round <= 20; round++ {
+		llr.AdjustInactivityIfNeeded("", nil, round)
+		require.True(t, llr.IsMainMachineActive())
+	}
+	require.Equal(t, numBroadcastsWhenFenced+16, numBroadcasts)
+
+	// the acknowledgements of lower lease epochs or of other holders are ignored
+	ack = createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 0, Round: 20, PeerID: []byte("other"), IsAck: true, Holder: []byte("self")})
+	require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: ack}, "", nil))
+	require.Equal(t, redundancy.LeaseStateFenced, llr.Status().State)
+	ack = createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, Round: 20, PeerID: []byte("other"), IsAck: true, Holder: []byte("third")})
+	require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: ack}, "", nil))
+	require.Equal(t, redundancy.LeaseStateFenced, llr.Status().State)
+
+	// an acknowledgement of the fenced lease lets the machine sign again
+	ack = createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, Round: 20, PeerID: []byte("other"), IsAck: true, Holder: []byte("self")})
+	require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: ack}, "", nil))
+	require.Equal(t, redundancy.LeaseStateHolder, llr.Status().State)
+	require.False(t, llr.IsMainMachineActive())
+
+	// the heartbeat of a machine that took over makes the fenced machine a follower, acknowledging the new lease
+	for round := int64(21); round <= 23; round++ {
+		llr.AdjustInactivityIfNeeded("", nil, round)
+	}
+	require.Equal(t, redundancy.LeaseStateFenced, llr.Status().State)
+	numBroadcastsWhenFenced = numBroadcasts
+	heartbeat := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 2, Round: 23, PeerID: []byte("other")})
+	require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: heartbeat}, "", nil))
+	require.Equal(t, redundancy.LeaseStateFollower, llr.Status().State)
+	require.Equal(t, numBroadcastsWhenFenced+1, numBroadcasts)
+}
+
+func TestLeaderLeaseRedundancy_AdjustInactivityWhileNotSynchronized(t *testing.T) {
+	t.Parallel()
+
+	t.Run("follower should not take over while not synchronized", func(t *testing.T) {
+		t.Parallel()
+
+		numBroadcasts := 0
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("backup", func(_ string, _ []byte) {
+			numBroadcasts++
+		}))
+
+		// the take over delay is LeaseDurationInRounds * (2 + RedundancyLevel) = 4 rounds
+		for round := int64(10); round <= 20; round++ {
+			llr.AdjustInactivityWhileNotSynchronized(round)
+			require.True(t, llr.IsMainMachineActive())
+		}
+		require.Zero(t, numBroadcasts)
+		require.Equal(t, redundancy.LeaseStateFollower, llr.Status().State)
+
+		// the heartbeats received while not synchronized are attributed to the current round
+		heartbeat := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, Round: 20, PeerID: []byte("main")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "main", DataField: heartbeat}, "", nil))
+		llr.AdjustInactivityIfNeeded("", nil, 21)
+		require.True(t, llr.IsMainMachineActive())
+		require.Equal(t, uint64(1), llr.Status().LeaseEpoch)
+	})
+	t.Run("holder should step down after a lease duration while not synchronized", func(t *testing.T) {
+		t.Parallel()
+
+		numBroadcasts := 0
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("main", func(_ string, _ []byte) {
+			numBroadcasts++
+		}))
+		llr.AdjustInactivityIfNeeded("", nil, 1)
+		llr.Promote()
+		require.False(t, llr.IsMainMachineActive())
+
+		// the lease duration is 2 rounds, the holder keeps renewing its lease meanwhile
+		numBroadcastsBefore := numBroadcasts
+		llr.AdjustInactivityWhileNotSynchronized(2)
+		llr.AdjustInactivityWhileNotSynchronized(3)
+		require.False(t, llr.IsMainMachineActive())
+		require.Equal(t, numBroadcastsBefore+2, numBroadcasts)
+
+		llr.AdjustInactivityWhileNotSynchronized(4)
+		require.True(t, llr.IsMainMachineActive())
+		require.Equal(t, redundancy.LeaseStateFollower, llr.Status().State)
+		require.Equal(t, numBroadcastsBefore+2, numBroadcasts)
+
+		// once synchronized again, the machine waits for another one to take over before taking the lease back
+		for round := int64(5); round <= 8; round++ {
+			llr.AdjustInactivityIfNeeded("", nil, round)
+			require.True(t, llr.IsMainMachineActive())
+		}
+	})
+	t.Run("holder synchronized again should keep the lease", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("main", nil))
+		llr.AdjustInactivityIfNeeded("", nil, 1)
+		llr.Promote()
+
+		llr.AdjustInactivityWhileNotSynchronized(2)
+		llr.AdjustInactivityIfNeeded("", nil, 3)
+		llr.AdjustInactivityWhileNotSynchronized(4)
+		llr.AdjustInactivityWhileNotSynchronized(5)
+		require.False(t, llr.IsMainMachineActive())
+	})
+}
+
+func TestLeaderLeaseRedundancy_ProcessReceivedMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil message should error", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		err := llr.ProcessReceivedMessage(nil, "", nil)
+		require.Equal(t, redundancy.ErrNilMessage, err)
+	})
+	t.Run("own message should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "self", DataField: []byte("invalid")}, "", nil)
+		require.Nil(t, err)
+	})
+	t.Run("peer mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, PeerID: []byte("other")})
+		err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "attacker", DataField: buff}, "", nil)
+		require.True(t, errors.Is(err, redundancy.ErrPeerMismatch))
+		require.Equal(t, uint64(0), llr.Status().LeaseEpoch)
+	})
+	t.Run("invalid signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		buff, _ := (&marshal.JsonMarshalizer{}).Marshal(&redundancy.LeaseHeartbeat{LeaseEpoch: 1, PeerID: []byte("other"), Signature: []byte("sig")})
+		err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: encryptLeaseMessage(t, buff)}, "", nil)
+		require.Equal(t, errInvalidSignature, err)
+		require.Equal(t, uint64(0), llr.Status().LeaseEpoch)
+	})
+	t.Run("signature without the lease context should error", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		marshaller := &marshal.JsonMarshalizer{}
+		heartbeat := &redundancy.LeaseHeartbeat{LeaseEpoch: 1, PeerID: []byte("other")}
+		payload, _ := marshaller.Marshal(heartbeat)
+		heartbeat.Signature = append([]byte("sig:"), payload...)
+		buff, _ := marshaller.Marshal(heartbeat)
+
+		err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: encryptLeaseMessage(t, buff)}, "", nil)
+		require.Equal(t, errInvalidSignature, err)
+		require.Equal(t, uint64(0), llr.Status().LeaseEpoch)
+	})
+	t.Run("antiflood rejection should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("flooding")
+		arg := createMockArgLeaderLeaseRedundancy("self", nil)
+		arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+			CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+				require.Equal(t, core.PeerID("other"), peer)
+				require.Equal(t, leaseTopic, topic)
+				return expectedErr
+			},
+		}
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(arg)
+		buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, PeerID: []byte("other")})
+		err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "relayer", nil)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, uint64(0), llr.Status().LeaseEpoch)
+
+		arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+				require.Equal(t, core.PeerID("relayer"), fromConnectedPeer)
+				return expectedErr
+			},
+		}
+		llr, _ = redundancy.NewLeaderLeaseRedundancy(arg)
+		err = llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "relayer", nil)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("message of another validator key should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		buff, _ := (&marshal.JsonMarshalizer{}).Marshal(&redundancy.LeaseHeartbeat{LeaseEpoch: 1, PeerID: []byte("other")})
+		err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "", nil)
+		require.Nil(t, err)
+		err = llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: []byte("short")}, "", nil)
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), llr.Status().LeaseEpoch)
+	})
+	t.Run("heartbeats should delay the take over and be acknowledged", func(t *testing.T) {
+		t.Parallel()
+
+		acks := make([]*redundancy.LeaseHeartbeat, 0)
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", func(_ string, buff []byte) {
+			acks = append(acks, decryptLeaseMessage(t, buff))
+		}))
+		for round := int64(1); round <= 10; round++ {
+			llr.AdjustInactivityIfNeeded("", nil, round)
+			buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 3, Round: round, PeerID: []byte("other")})
+			err := llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "", nil)
+			require.Nil(t, err)
+			require.True(t, llr.IsMainMachineActive())
+		}
+		require.Equal(t, uint64(3), llr.Status().LeaseEpoch)
+		require.Equal(t, core.PeerID("other").Pretty(), llr.Status().LastHolder)
+		require.Len(t, acks, 10)
+		require.True(t, acks[9].IsAck)
+		require.Equal(t, uint64(3), acks[9].LeaseEpoch)
+		require.Equal(t, int64(10), acks[9].Round)
+		require.Equal(t, []byte("self"), acks[9].PeerID)
+
+		for round := int64(11); round <= 14; round++ {
+			llr.AdjustInactivityIfNeeded("", nil, round)
+			require.True(t, llr.IsMainMachineActive())
+		}
+		llr.AdjustInactivityIfNeeded("", nil, 15)
+		require.False(t, llr.IsMainMachineActive())
+		require.Equal(t, uint64(4), llr.Status().LeaseEpoch)
+	})
+	t.Run("holder should step down on a higher lease epoch", func(t *testing.T) {
+		t.Parallel()
+
+		answers := make([]*redundancy.LeaseHeartbeat, 0)
+		arg := createMockArgLeaderLeaseRedundancy("self", func(_ string, buff []byte) {
+			answers = append(answers, decryptLeaseMessage(t, buff))
+		})
+		appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+		arg.AppStatusHandler = appStatusHandler
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(arg)
+		llr.Promote()
+		require.False(t, llr.IsMainMachineActive())
+		require.Equal(t, uint64(1), appStatusHandler.GetUint64(common.MetricRedundancyLeaseTransitions))
+
+		// lower lease epochs are answered with the current lease
+		buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 0, PeerID: []byte("other")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "", nil))
+		require.False(t, llr.IsMainMachineActive())
+		require.Len(t, answers, 2)
+		require.True(t, answers[1].IsAck)
+		require.Equal(t, uint64(1), answers[1].LeaseEpoch)
+		require.Equal(t, []byte("self"), answers[1].Holder)
+
+		buff = createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 2, PeerID: []byte("other")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "", nil))
+		require.True(t, llr.IsMainMachineActive())
+		require.Equal(t, redundancy.LeaseStateFollower, llr.Status().State)
+		require.Equal(t, uint64(2), appStatusHandler.GetUint64(common.MetricRedundancyLeaseEpoch))
+		require.Equal(t, uint64(2), appStatusHandler.GetUint64(common.MetricRedundancyLeaseTransitions))
+	})
+	t.Run("same lease epoch conflict should be won by the lower redundancy level", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("self", nil)
+		arg.RedundancyLevel = 1
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(arg)
+		llr.Promote()
+
+		buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, RedundancyLevel: 2, PeerID: []byte("other")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "", nil))
+		require.False(t, llr.IsMainMachineActive())
+
+		buff = createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, RedundancyLevel: 0, PeerID: []byte("main")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "main", DataField: buff}, "", nil))
+		require.True(t, llr.IsMainMachineActive())
+	})
+}
+
+func TestLeaderLeaseRedundancy_LeaseEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lease epoch should be restored after a restart", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("self", nil)
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(arg)
+		llr.Promote()
+		llr.Promote()
+		require.Nil(t, llr.Close())
+
+		llr, _ = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, uint64(2), llr.Status().LeaseEpoch)
+		require.Equal(t, uint64(3), llr.Promote().LeaseEpoch)
+
+		// the lease epochs of the other holders are persisted as well
+		buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 7, PeerID: []byte("other")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: buff}, "", nil))
+		llr, _ = redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Equal(t, uint64(7), llr.Status().LeaseEpoch)
+	})
+	t.Run("lease should not be acquired if the lease epoch can not be persisted", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgLeaderLeaseRedundancy("self", nil)
+		arg.Storer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, storage.ErrKeyNotFound
+			},
+			PutCalled: func(key, data []byte) error {
+				return errors.New("disk full")
+			},
+		}
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(arg)
+		status := llr.Promote()
+		require.Equal(t, redundancy.LeaseStateFollower, status.State)
+		require.Equal(t, uint64(0), status.LeaseEpoch)
+		require.True(t, llr.IsMainMachineActive())
+	})
+	t.Run("follower should answer a heartbeat with a lower lease epoch with the current lease", func(t *testing.T) {
+		t.Parallel()
+
+		answers := make([]*redundancy.LeaseHeartbeat, 0)
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", func(_ string, buff []byte) {
+			answers = append(answers, decryptLeaseMessage(t, buff))
+		}))
+		llr.AdjustInactivityIfNeeded("", nil, 1)
+		buff := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 3, Round: 1, PeerID: []byte("backup")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "backup", DataField: buff}, "", nil))
+
+		buff = createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, Round: 1, PeerID: []byte("main")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "main", DataField: buff}, "", nil))
+		require.Len(t, answers, 2)
+		require.Equal(t, &redundancy.LeaseHeartbeat{
+			LeaseEpoch: 3,
+			Round:      1,
+			PeerID:     []byte("self"),
+			IsAck:      true,
+			Holder:     []byte("backup"),
+			Signature:  answers[1].Signature,
+		}, answers[1])
+		require.Equal(t, core.PeerID("backup").Pretty(), llr.Status().LastHolder)
+	})
+	t.Run("stale holder should step down on the answer with a higher lease epoch", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("main", nil))
+		llr.AdjustInactivityIfNeeded("", nil, 1)
+		llr.Promote()
+		require.False(t, llr.IsMainMachineActive())
+
+		answer := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 3, Round: 1, PeerID: []byte("other"), IsAck: true, Holder: []byte("backup")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: answer}, "", nil))
+		require.True(t, llr.IsMainMachineActive())
+		status := llr.Status()
+		require.Equal(t, redundancy.LeaseStateFollower, status.State)
+		require.Equal(t, uint64(3), status.LeaseEpoch)
+		require.Equal(t, core.PeerID("backup").Pretty(), status.LastHolder)
+	})
+	t.Run("lease never acknowledged should be fenced while another machine is heard from", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		llr.AdjustInactivityIfNeeded("", nil, 1)
+		ack := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 0, Round: 1, PeerID: []byte("other"), IsAck: true, Holder: []byte("third")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: ack}, "", nil))
+
+		require.Equal(t, redundancy.LeaseStateFenced, llr.Promote().State)
+		require.True(t, llr.IsMainMachineActive())
+
+		// the lease duration is 2 rounds
+		for round := int64(2); round <= 3; round++ {
+			llr.AdjustInactivityIfNeeded("", nil, round)
+			require.Equal(t, redundancy.LeaseStateFenced, llr.Status().State)
+		}
+		llr.AdjustInactivityIfNeeded("", nil, 4)
+		require.Equal(t, redundancy.LeaseStateHolder, llr.Status().State)
+	})
+}
+
+func TestLeaderLeaseRedundancy_PromoteDemote(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", func(_ string, _ []byte) {
+		numBroadcasts++
+	}))
+
+	status := llr.Promote()
+	require.Equal(t, redundancy.LeaseStateHolder, status.State)
+	require.Equal(t, uint64(1), status.LeaseEpoch)
+	require.Equal(t, core.PeerID("self").Pretty(), status.LastHolder)
+	require.Equal(t, 1, numBroadcasts)
+
+	status = llr.Demote()
+	require.Equal(t, redundancy.LeaseStateDemoted, status.State)
+	require.True(t, llr.IsMainMachineActive())
+
+	// a demoted machine neither renews nor takes over the lease
+	for round := int64(1); round < 20; round++ {
+		llr.AdjustInactivityIfNeeded("", nil, round)
+	}
+	require.True(t, llr.IsMainMachineActive())
+	require.Equal(t, 1, numBroadcasts)
+
+	status = llr.Promote()
+	require.Equal(t, redundancy.LeaseStateHolder, status.State)
+	require.Equal(t, uint64(2), status.LeaseEpoch)
+	require.Equal(t, 2, numBroadcasts)
+}
+
+func TestLeaderLeaseRedundancy_PromoteShouldWaitForThePreviousLease(t *testing.T) {
+	t.Parallel()
+
+	followOtherHolder := func(llr p2p.MessageProcessor, round func(roundIndex int64)) {
+		round(5)
+		heartbeat := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 1, Round: 5, PeerID: []byte("other")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: heartbeat}, "", nil))
+	}
+
+	t.Run("should sign once the previous holder released the lease", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		followOtherHolder(llr, func(roundIndex int64) {
+			llr.AdjustInactivityIfNeeded("", nil, roundIndex)
+		})
+		status := llr.Promote()
+		require.Equal(t, redundancy.LeaseStateFenced, status.State)
+		require.Equal(t, uint64(2), status.LeaseEpoch)
+		require.True(t, llr.IsMainMachineActive())
+
+		ack := createSignedHeartbeat(t, &redundancy.LeaseHeartbeat{LeaseEpoch: 2, Round: 5, PeerID: []byte("other"), IsAck: true, Holder: []byte("self")})
+		require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: "other", DataField: ack}, "", nil))
+		require.Equal(t, redundancy.LeaseStateHolder, llr.Status().State)
+		require.False(t, llr.IsMainMachineActive())
+	})
+	t.Run("should sign once the previous lease expired", func(t *testing.T) {
+		t.Parallel()
+
+		llr, _ := redundancy.NewLeaderLeaseRedundancy(createMockArgLeaderLeaseRedundancy("self", nil))
+		followOtherHolder(llr, func(roundIndex int64) {
+			llr.AdjustInactivityIfNeeded("", nil, roundIndex)
+		})
+		require.Equal(t, redundancy.LeaseStateFenced, llr.Promote().State)
+
+		// the previous holder fences itself after the lease duration of 2 rounds without acknowledgements
+		for round := int64(6); round <= 8; round++ {
+			llr.AdjustInactivityIfNeeded("", nil, round)
+			require.True(t, llr.IsMainMachineActive())
+		}
+		llr.AdjustInactivityIfNeeded("", nil, 9)
+		require.False(t, llr.IsMainMachineActive())
+	})
+}
+
+func TestLeaderLeaseRedundancy_MainAndBackupFailover(t *testing.T) {
+	t.Parallel()
+
+	type machine struct {
+		pid     core.PeerID
+		handler interface {
+			AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64)
+			IsMainMachineActive() bool
+		}
+		isOnline      bool
+		isPartitioned bool
+	}
+	type broadcastMessage struct {
+		from core.PeerID
+		buff []byte
+	}
+
+	var machines []*machine
+	processors := make(map[core.PeerID]func(buff []byte, from core.PeerID))
+	// the messages are delivered after the broadcast returns, as the messenger does
+	pendingMessages := make([]broadcastMessage, 0)
+	broadcastFrom := func(from core.PeerID) func(topic string, buff []byte) {
+		return func(_ string, buff []byte) {
+			pendingMessages = append(pendingMessages, broadcastMessage{from: from, buff: buff})
+		}
+	}
+	isReachable := func(m *machine) bool {
+		return m.isOnline && !m.isPartitioned
+	}
+	deliverMessages := func() {
+		for len(pendingMessages) > 0 {
+			msg := pendingMessages[0]
+			pendingMessages = pendingMessages[1:]
+			for _, m := range machines {
+				sender := machines[0]
+				if machines[1].pid == msg.from {
+					sender = machines[1]
+				}
+				if m.pid != msg.from && isReachable(m) && isReachable(sender) {
+					processors[m.pid](msg.buff, msg.from)
+				}
+			}
+		}
+	}
+	// the storers survive the restarts of the machines
+	storers := make(map[core.PeerID]*genericMocks.StorerMock)
+	createMachine := func(pid core.PeerID, redundancyLevel int64) *machine {
+		_, found := storers[pid]
+		if !found {
+			storers[pid] = genericMocks.NewStorerMockWithErrKeyNotFound(0)
+		}
+		arg := createMockArgLeaderLeaseRedundancy(pid, broadcastFrom(pid))
+		arg.RedundancyLevel = redundancyLevel
+		arg.Storer = storers[pid]
+		llr, err := redundancy.NewLeaderLeaseRedundancy(arg)
+		require.Nil(t, err)
+		processors[pid] = func(buff []byte, from core.PeerID) {
+			require.Nil(t, llr.ProcessReceivedMessage(&p2pmocks.P2PMessageMock{PeerField: from, DataField: buff}, from, nil))
+		}
+
+		return &machine{pid: pid, handler: llr, isOnline: true}
+	}
+	main := createMachine("main", 0)
+	backup := createMachine("backup", 1)
+	machines = []*machine{main, backup}
+
+	numSigners := func() int {
+		signers := 0
+		for _, m := range machines {
+			if m.isOnline && !m.handler.IsMainMachineActive() {
+				signers++
+			}
+		}
+		return signers
+	}
+	runRounds := func(start int64, end int64) {
+		for round := start; round <= end; round++ {
+			for _, m := range machines {
+				if m.isOnline {
+					m.handler.AdjustInactivityIfNeeded("", nil, round)
+				}
+			}
+			deliverMessages()
+			require.LessOrEqual(t, numSigners(), 1)
+		}
+	}
+
+	// the main machine acquires the lease first
+	runRounds(1, 10)
+	require.False(t, main.handler.IsMainMachineActive())
+	require.True(t, backup.handler.IsMainMachineActive())
+
+	// the main machine fails, the backup takes over
+	main.isOnline = false
+	runRounds(11, 20)
+	require.False(t, backup.handler.IsMainMachineActive())
+
+	// the main machine is restarted, but does not take the lease back while the backup renews it
+	main = createMachine("main", 0)
+	machines[0] = main
+	runRounds(21, 40)
+	require.True(t, main.handler.IsMainMachineActive())
+	require.False(t, backup.handler.IsMainMachineActive())
+
+	// the backup machine is partitioned: it fences itself before the main takes over, so they never sign both
+	backup.isPartitioned = true
+	runRounds(41, 50)
+	require.False(t, main.handler.IsMainMachineActive())
+	require.True(t, backup.handler.IsMainMachineActive())
+
+	// the partition heals, the fenced backup follows the main again
+	backup.isPartitioned = false
+	runRounds(51, 60)
+	require.False(t, main.handler.IsMainMachineActive())
+	require.True(t, backup.handler.IsMainMachineActive())
+
+	// the backup machine fails, the main stops signing as it can not tell a failure from a partition
+	backup.isOnline = false
+	runRounds(61, 70)
+	require.Zero(t, numSigners())
+
+	// the backup machine is restarted and acknowledges the lease of the main, which signs again
+	backup = createMachine("backup", 1)
+	machines[1] = backup
+	runRounds(71, 80)
+	require.False(t, main.handler.IsMainMachineActive())
+	require.True(t, backup.handler.IsMainMachineActive())
+}
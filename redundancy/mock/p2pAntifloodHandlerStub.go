@@ -0,0 +1,51 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// P2PAntifloodHandlerStub -
+type P2PAntifloodHandlerStub struct {
+	CanProcessMessageCalled         func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	CanProcessMessagesOnTopicCalled func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error
+	ResetForTopicCalled             func(topic string)
+	SetMaxMessagesForTopicCalled    func(topic string, maxNum uint32)
+}
+
+// CanProcessMessage -
+func (stub *P2PAntifloodHandlerStub) CanProcessMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if stub.CanProcessMessageCalled != nil {
+		return stub.CanProcessMessageCalled(message, fromConnectedPeer)
+	}
+
+	return nil
+}
+
+// CanProcessMessagesOnTopic -
+func (stub *P2PAntifloodHandlerStub) CanProcessMessagesOnTopic(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+	if stub.CanProcessMessagesOnTopicCalled != nil {
+		return stub.CanProcessMessagesOnTopicCalled(peer, topic, numMessages, totalSize, sequence)
+	}
+
+	return nil
+}
+
+// ResetForTopic -
+func (stub *P2PAntifloodHandlerStub) ResetForTopic(topic string) {
+	if stub.ResetForTopicCalled != nil {
+		stub.ResetForTopicCalled(topic)
+	}
+}
+
+// SetMaxMessagesForTopic -
+func (stub *P2PAntifloodHandlerStub) SetMaxMessagesForTopic(topic string, maxNum uint32) {
+	if stub.SetMaxMessagesForTopicCalled != nil {
+		stub.SetMaxMessagesForTopicCalled(topic, maxNum)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *P2PAntifloodHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
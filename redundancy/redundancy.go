@@ -100,6 +100,10 @@ func (nr *nodeRedundancy) AdjustInactivityIfNeeded(selfPubKey string, consensusP
 	nr.lastRoundIndexCheck = roundIndex
 }
 
+// AdjustInactivityWhileNotSynchronized does nothing, as the rounds of inactivity are only counted while synchronized
+func (nr *nodeRedundancy) AdjustInactivityWhileNotSynchronized(_ int64) {
+}
+
 // ResetInactivityIfNeeded resets rounds of inactivity for main or lower level redundancy machines if needed
 func (nr *nodeRedundancy) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
 	if selfPubKey != consensusMsgPubKey {
@@ -18,6 +18,8 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
+	"github.com/multiversx/mx-chain-go/common/tracing"
 	"github.com/multiversx/mx-chain-go/state/iteratorChannelsProvider"
 	"github.com/multiversx/mx-chain-go/state/parsers"
 	"github.com/multiversx/mx-chain-go/state/stateMetrics"
@@ -92,6 +94,7 @@ type AccountsDB struct {
 	mutOp                sync.RWMutex
 	loadCodeMeasurements *loadingMeasurements
 	addressConverter     core.PubkeyConverter
+	trieLabel            string
 
 	stackDebug []byte
 }
@@ -144,10 +147,10 @@ func NewAccountsDB(args ArgsAccountsDB) (*AccountsDB, error) {
 		return nil, err
 	}
 
-	return createAccountsDb(args, snapshotManager), nil
+	return createAccountsDb(args, snapshotManager, metricsRegistry.TrieAccounts), nil
 }
 
-func createAccountsDb(args ArgsAccountsDB, snapshotManager SnapshotsManager) *AccountsDB {
+func createAccountsDb(args ArgsAccountsDB, snapshotManager SnapshotsManager, trieLabel string) *AccountsDB {
 	return &AccountsDB{
 		mainTrie:               args.Trie,
 		hasher:                 args.Hasher,
@@ -163,6 +166,7 @@ func createAccountsDb(args ArgsAccountsDB, snapshotManager SnapshotsManager) *Ac
 		},
 		addressConverter: args.AddressConverter,
 		snapshotsManger:  snapshotManager,
+		trieLabel:        trieLabel,
 	}
 }
 
@@ -821,7 +825,7 @@ func (adb *AccountsDB) CommitInEpoch(currentEpoch uint32, epochToCommit uint32)
 
 	adb.mainTrie.GetStorageManager().SetEpochForPutOperation(epochToCommit)
 
-	return adb.commit()
+	return adb.commitAndObserve()
 }
 
 // Commit will persist all data inside the trie
@@ -832,7 +836,17 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 		adb.loadCodeMeasurements.resetAndPrint()
 	}()
 
-	return adb.commit()
+	return adb.commitAndObserve()
+}
+
+func (adb *AccountsDB) commitAndObserve() ([]byte, error) {
+	_, span := tracing.StartSpan(tracing.BlockContext(), "state.commit", tracing.TrieKey.String(adb.trieLabel))
+	startTime := time.Now()
+	rootHash, err := adb.commit()
+	metricsRegistry.ObserveDuration(metricsRegistry.TrieCommitDuration.WithLabelValues(adb.trieLabel, metricsRegistry.Outcome(err)), startTime)
+	tracing.EndSpan(span, err)
+
+	return rootHash, err
 }
 
 func (adb *AccountsDB) commit() ([]byte, error) {
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"sync"
@@ -170,9 +171,34 @@ func (accountsDB *accountsDBApi) RecreateTrie(rootHash []byte) error {
 	return err
 }
 
-// RecreateTrieFromEpoch is a not permitted operation in this implementation and thus, will return an error
-func (accountsDB *accountsDBApi) RecreateTrieFromEpoch(_ common.RootHashHolder) error {
-	return ErrOperationNotPermitted
+// RecreateTrieFromEpoch will recreate the inner trie in the provided epoch. If the root hash is the one of the block
+// provided by the block info provider, the recreated trie is kept for all the subsequent calls on that block
+func (accountsDB *accountsDBApi) RecreateTrieFromEpoch(options common.RootHashHolder) error {
+	if check.IfNil(options) {
+		return ErrNilRootHashHolder
+	}
+
+	newBlockInfo := accountsDB.blockInfoProvider.GetBlockInfo()
+	if check.IfNil(newBlockInfo) || !bytes.Equal(newBlockInfo.GetRootHash(), options.GetRootHash()) {
+		newBlockInfo = holders.NewBlockInfo([]byte{}, 0, options.GetRootHash())
+	}
+
+	accountsDB.mutRecreatedTrieBlockInfo.Lock()
+	defer accountsDB.mutRecreatedTrieBlockInfo.Unlock()
+
+	if newBlockInfo.Equal(accountsDB.blockInfo) {
+		return nil
+	}
+
+	err := accountsDB.innerAccountsAdapter.RecreateTrieFromEpoch(options)
+	if err != nil {
+		accountsDB.blockInfo = nil
+		return err
+	}
+
+	accountsDB.blockInfo = newBlockInfo
+
+	return nil
 }
 
 // PruneTrie is a not permitted operation in this implementation and thus, does nothing
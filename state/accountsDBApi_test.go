@@ -195,7 +195,6 @@ func TestAccountsDBApi_NotPermittedOperations(t *testing.T) {
 	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.SaveAccount(nil))
 	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.RemoveAccount(nil))
 	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.RevertToSnapshot(0))
-	assert.Equal(t, state.ErrOperationNotPermitted, accountsApi.RecreateTrieFromEpoch(nil))
 
 	buff, err := accountsApi.CommitInEpoch(0, 0)
 	assert.Nil(t, buff)
@@ -226,6 +225,84 @@ func TestAccountsDBApi_RecreateTrie(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestAccountsDBApi_RecreateTrieFromEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil options should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsApi, _ := state.NewAccountsDBApi(&mockState.AccountsStub{}, createBlockInfoProviderStub(dummyRootHash))
+
+		err := accountsApi.RecreateTrieFromEpoch(nil)
+		assert.Equal(t, state.ErrNilRootHashHolder, err)
+	})
+	t.Run("inner error should error and recreate on the next call", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalled := 0
+		accountsApi, _ := state.NewAccountsDBApi(&mockState.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				numCalled++
+				return expectedErr
+			},
+		}, createBlockInfoProviderStub(dummyRootHash))
+
+		options := holders.NewRootHashHolder(dummyRootHash, core.OptionalUint32{Value: 3, HasValue: true})
+		assert.Equal(t, expectedErr, accountsApi.RecreateTrieFromEpoch(options))
+		assert.Equal(t, expectedErr, accountsApi.RecreateTrieFromEpoch(options))
+		assert.Equal(t, 2, numCalled)
+	})
+	t.Run("same root hash as the provided block should recreate once", func(t *testing.T) {
+		t.Parallel()
+
+		numRecreateFromEpoch := 0
+		numRecreate := 0
+		accountsApi, _ := state.NewAccountsDBApi(&mockState.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				numRecreateFromEpoch++
+				assert.Equal(t, dummyRootHash, options.GetRootHash())
+				assert.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, options.GetEpoch())
+				return nil
+			},
+			RecreateTrieCalled: func(rootHash []byte) error {
+				numRecreate++
+				return nil
+			},
+			GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+				return &mockState.AccountWrapMock{}, nil
+			},
+		}, createBlockInfoProviderStub(dummyRootHash))
+
+		options := holders.NewRootHashHolder(dummyRootHash, core.OptionalUint32{Value: 3, HasValue: true})
+		assert.Nil(t, accountsApi.RecreateTrieFromEpoch(options))
+		assert.Nil(t, accountsApi.RecreateTrieFromEpoch(options))
+
+		// the accounts are read from the trie recreated in the requested epoch
+		_, err := accountsApi.GetExistingAccount([]byte("address"))
+		assert.Nil(t, err)
+
+		assert.Equal(t, 1, numRecreateFromEpoch)
+		assert.Equal(t, 0, numRecreate)
+	})
+	t.Run("different root hash than the provided block should recreate each time", func(t *testing.T) {
+		t.Parallel()
+
+		numRecreateFromEpoch := 0
+		accountsApi, _ := state.NewAccountsDBApi(&mockState.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				numRecreateFromEpoch++
+				return nil
+			},
+		}, createBlockInfoProviderStub(dummyRootHash))
+
+		options := holders.NewRootHashHolder([]byte("other root hash"), core.OptionalUint32{})
+		assert.Nil(t, accountsApi.RecreateTrieFromEpoch(options))
+		assert.Nil(t, accountsApi.RecreateTrieFromEpoch(holders.NewRootHashHolder(dummyRootHash, core.OptionalUint32{})))
+		assert.Equal(t, 2, numRecreateFromEpoch)
+	})
+}
+
 func TestAccountsDBApi_EmptyMethodsShouldNotPanic(t *testing.T) {
 	t.Parallel()
 
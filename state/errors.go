@@ -121,6 +121,9 @@ var ErrNilAppStatusHandler = errors.New("nil app status handler")
 // ErrNilBlockInfo signals that a nil block info was provided
 var ErrNilBlockInfo = errors.New("nil block info")
 
+// ErrNilRootHashHolder signals that a nil root hash holder was provided
+var ErrNilRootHashHolder = errors.New("nil root hash holder provided")
+
 // ErrNilBlockInfoProvider signals that a nil block info provider was provided
 var ErrNilBlockInfoProvider = errors.New("nil block info provider")
 
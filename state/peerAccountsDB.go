@@ -3,6 +3,7 @@ package state
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
 	"github.com/multiversx/mx-chain-go/state/iteratorChannelsProvider"
 	"github.com/multiversx/mx-chain-go/state/stateMetrics"
 )
@@ -45,7 +46,7 @@ func NewPeerAccountsDB(args ArgsAccountsDB) (*PeerAccountsDB, error) {
 	}
 
 	adb := &PeerAccountsDB{
-		AccountsDB: createAccountsDb(args, snapshotManager),
+		AccountsDB: createAccountsDb(args, snapshotManager, metricsRegistry.TriePeerAccounts),
 	}
 
 	return adb, nil
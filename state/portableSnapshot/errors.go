@@ -0,0 +1,69 @@
+package portableSnapshot
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler was provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNilStorageMarker signals that a nil storage marker was provided
+var ErrNilStorageMarker = errors.New("nil storage marker")
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager was provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrNilLeavesProvider signals that a nil leaves provider was provided
+var ErrNilLeavesProvider = errors.New("nil leaves provider")
+
+// ErrNilSnapshotReader signals that a nil snapshot reader was provided
+var ErrNilSnapshotReader = errors.New("nil snapshot reader")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNoTriesToExport signals that no tries were provided for the export
+var ErrNoTriesToExport = errors.New("no tries to export")
+
+// ErrNoTriesToImport signals that no tries were provided for the import
+var ErrNoTriesToImport = errors.New("no tries to import")
+
+// ErrInvalidSnapshotFile signals that the provided file is not a state snapshot
+var ErrInvalidSnapshotFile = errors.New("invalid state snapshot file")
+
+// ErrUnsupportedSnapshotVersion signals that the state snapshot was written in an unsupported format version
+var ErrUnsupportedSnapshotVersion = errors.New("unsupported state snapshot version")
+
+// ErrUnexpectedFrameType signals that an unexpected frame type was read
+var ErrUnexpectedFrameType = errors.New("unexpected frame type")
+
+// ErrFrameTooLarge signals that a frame exceeds the maximum allowed size
+var ErrFrameTooLarge = errors.New("frame too large")
+
+// ErrFrameHashMismatch signals that the hash of a frame does not match its content
+var ErrFrameHashMismatch = errors.New("frame hash mismatch")
+
+// ErrInvalidChunk signals that a chunk could not be decoded
+var ErrInvalidChunk = errors.New("invalid chunk")
+
+// ErrHeaderHashMismatch signals that a header embedded in the manifest does not match its hash
+var ErrHeaderHashMismatch = errors.New("header hash mismatch")
+
+// ErrTrieNotFoundInSnapshot signals that a required trie is missing from the snapshot
+var ErrTrieNotFoundInSnapshot = errors.New("trie not found in snapshot")
+
+// ErrRootHashMismatch signals that a root hash does not match the expected one
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrMissingDataTrie signals that a data trie referenced by an account was not found in the snapshot
+var ErrMissingDataTrie = errors.New("missing data trie")
+
+// ErrInvalidChunkOrder signals that the leaves of a data trie are not contiguous in the snapshot
+var ErrInvalidChunkOrder = errors.New("invalid chunk order")
+
+// ErrTrailerMismatch signals that the snapshot trailer does not match the content that was read
+var ErrTrailerMismatch = errors.New("trailer mismatch")
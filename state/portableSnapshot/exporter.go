@@ -0,0 +1,245 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/portableSnapshot")
+
+// TrieToExport holds a trie that will be written in the state snapshot
+type TrieToExport struct {
+	Identifier     string
+	RootHash       []byte
+	LeavesProvider LeavesProvider
+	HasDataTries   bool
+}
+
+// ArgsExport holds the data that will be written in the state snapshot
+type ArgsExport struct {
+	ChainID            string
+	ShardID            uint32
+	Epoch              uint32
+	EpochStartMetaHash []byte
+	EpochStartMeta     []byte
+	ShardHeaderHash    []byte
+	ShardHeader        []byte
+	Tries              []*TrieToExport
+}
+
+// ArgsExporter holds the arguments needed to create a state snapshot exporter
+type ArgsExporter struct {
+	Marshaller        marshal.Marshalizer
+	Hasher            hashing.Hasher
+	MaxLeavesPerChunk int
+}
+
+type exporter struct {
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	maxLeavesPerChunk int
+}
+
+// NewExporter creates a new state snapshot exporter
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.MaxLeavesPerChunk < 1 {
+		return nil, fmt.Errorf("%w for MaxLeavesPerChunk: %d", ErrInvalidValue, args.MaxLeavesPerChunk)
+	}
+
+	return &exporter{
+		marshaller:        args.Marshaller,
+		hasher:            args.Hasher,
+		maxLeavesPerChunk: args.MaxLeavesPerChunk,
+	}, nil
+}
+
+// Export writes the state snapshot described by the provided arguments. The data tries of a trie are written after
+// all the leaves of that trie
+func (e *exporter) Export(writer io.Writer, args ArgsExport) (*Trailer, error) {
+	if len(args.Tries) == 0 {
+		return nil, ErrNoTriesToExport
+	}
+
+	manifest := &Manifest{
+		Version:            SnapshotFormatVersion,
+		ChainID:            args.ChainID,
+		ShardID:            args.ShardID,
+		Epoch:              args.Epoch,
+		EpochStartMetaHash: args.EpochStartMetaHash,
+		EpochStartMeta:     args.EpochStartMeta,
+		ShardHeaderHash:    args.ShardHeaderHash,
+		ShardHeader:        args.ShardHeader,
+		Tries:              make([]*TrieManifest, 0, len(args.Tries)),
+	}
+	for _, trieToExport := range args.Tries {
+		if check.IfNil(trieToExport.LeavesProvider) {
+			return nil, fmt.Errorf("%w for trie %s", ErrNilLeavesProvider, trieToExport.Identifier)
+		}
+
+		manifest.Tries = append(manifest.Tries, &TrieManifest{
+			Identifier: trieToExport.Identifier,
+			RootHash:   trieToExport.RootHash,
+		})
+	}
+
+	sw := newSnapshotWriter(writer, e.hasher, e.maxLeavesPerChunk)
+	err := sw.writeManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for trieIndex, trieToExport := range args.Tries {
+		err = e.exportTrieWithDataTries(sw, uint32(trieIndex), trieToExport)
+		if err != nil {
+			return nil, fmt.Errorf("%w while exporting trie %s", err, trieToExport.Identifier)
+		}
+	}
+
+	return sw.close()
+}
+
+func (e *exporter) exportTrieWithDataTries(sw *snapshotWriter, trieIndex uint32, trieToExport *TrieToExport) error {
+	numLeavesBefore := sw.trailer.NumLeaves
+	dataTriesRootHashes, err := e.exportTrie(sw, trieIndex, trieToExport.LeavesProvider, trieToExport.RootHash, trieToExport.HasDataTries)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("exported trie",
+		"identifier", trieToExport.Identifier,
+		"root hash", trieToExport.RootHash,
+		"num leaves", sw.trailer.NumLeaves-numLeavesBefore,
+		"num data tries", len(dataTriesRootHashes),
+	)
+
+	numLeavesBefore = sw.trailer.NumLeaves
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		_, err = e.exportTrie(sw, trieIndex, trieToExport.LeavesProvider, dataTrieRootHash, false)
+		if err != nil {
+			return fmt.Errorf("%w for data trie %x", err, dataTrieRootHash)
+		}
+
+		sw.markDataTrieWritten()
+	}
+
+	if len(dataTriesRootHashes) > 0 {
+		log.Debug("exported data tries",
+			"identifier", trieToExport.Identifier,
+			"num data tries", len(dataTriesRootHashes),
+			"num leaves", sw.trailer.NumLeaves-numLeavesBefore,
+		)
+	}
+
+	return nil
+}
+
+func (e *exporter) exportTrie(
+	sw *snapshotWriter,
+	trieIndex uint32,
+	leavesProvider LeavesProvider,
+	rootHash []byte,
+	collectDataTries bool,
+) ([][]byte, error) {
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := leavesProvider.GetAllLeaves(iteratorChannels, ctx, rootHash, &rawLeafParser{})
+	if err != nil {
+		return nil, err
+	}
+
+	dataTriesRootHashes := make([][]byte, 0)
+	uniqueDataTries := make(map[string]struct{})
+	var errWrite error
+	for keyValue := range iteratorChannels.LeavesChan {
+		if errWrite != nil {
+			// keep draining the channel until the iteration acknowledges the cancelled context
+			continue
+		}
+
+		leaf, ok := keyValue.(*trieLeaf)
+		if !ok {
+			errWrite = fmt.Errorf("%w, unexpected leaf type %T", ErrInvalidValue, keyValue)
+			cancel()
+			continue
+		}
+
+		errWrite = sw.addLeaf(trieIndex, rootHash, leaf.toLeaf())
+		if errWrite != nil {
+			cancel()
+			continue
+		}
+
+		if !collectDataTries {
+			continue
+		}
+
+		dataTrieRootHash := getDataTrieRootHash(e.marshaller, leaf.key, leaf.value)
+		if len(dataTrieRootHash) == 0 {
+			continue
+		}
+		_, found := uniqueDataTries[string(dataTrieRootHash)]
+		if found {
+			continue
+		}
+
+		uniqueDataTries[string(dataTrieRootHash)] = struct{}{}
+		dataTriesRootHashes = append(dataTriesRootHashes, dataTrieRootHash)
+	}
+
+	if errWrite != nil {
+		return nil, errWrite
+	}
+
+	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return dataTriesRootHashes, nil
+}
+
+// getDataTrieRootHash returns the root hash of the data trie if the leaf holds an account with a non-empty data trie.
+// The main trie also holds the code leaves, so the account address must match the leaf key
+func getDataTrieRootHash(marshaller marshal.Marshalizer, key []byte, value []byte) []byte {
+	accountData := &accounts.UserAccountData{}
+	err := marshaller.Unmarshal(accountData, value)
+	if err != nil {
+		return nil
+	}
+	if !bytes.Equal(accountData.Address, key) {
+		return nil
+	}
+	if common.IsEmptyTrie(accountData.RootHash) {
+		return nil
+	}
+
+	return accountData.RootHash
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
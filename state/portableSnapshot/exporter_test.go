@@ -0,0 +1,309 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userAccountsIdentifier = "UserAccounts"
+	peerAccountsIdentifier = "PeerAccounts"
+)
+
+var (
+	testMarshaller          = &marshal.GogoProtoMarshalizer{}
+	testHasher              = &testscommon.KeccakMock{}
+	testEpochStartMeta      = []byte("epoch start meta")
+	testEpochStartMetaHash  = testHasher.Compute(string(testEpochStartMeta))
+	testEnableEpochsHandler = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+		IsAutoBalanceDataTriesEnabledField: true,
+	}
+)
+
+type testState struct {
+	userTrie            state.DataTrie
+	userRootHash        []byte
+	dataTriesRootHashes [][]byte
+	peerTrie            state.DataTrie
+	peerRootHash        []byte
+}
+
+func createStorageManager(t *testing.T) common.StorageManager {
+	args := storageMock.GetStorageManagerArgs()
+	args.Marshalizer = testMarshaller
+	args.Hasher = testHasher
+	tsm, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	return tsm
+}
+
+func createTrie(t *testing.T, tsm common.StorageManager) state.DataTrie {
+	tr, err := trie.NewTrie(tsm, testMarshaller, testHasher, testEnableEpochsHandler, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func commitAndGetRootHash(t *testing.T, tr common.Trie) []byte {
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+// createTestState creates a user accounts trie holding a code leaf and numAccounts accounts, every second account
+// having a data trie with leaves of both versions, and a peer accounts trie with numAccounts leaves
+func createTestState(t *testing.T, numAccounts int) *testState {
+	userStorageManager := createStorageManager(t)
+	userTrie := createTrie(t, userStorageManager)
+	dataTriesRootHashes := make([][]byte, 0)
+	for i := 0; i < numAccounts; i++ {
+		address := testHasher.Compute(fmt.Sprintf("address%d", i))
+		accountData := &accounts.UserAccountData{
+			Nonce:   uint64(i),
+			Address: address,
+		}
+
+		if i%2 == 0 {
+			dataTrie := createTrie(t, userStorageManager)
+			for j := 0; j < 3+i; j++ {
+				version := core.NotSpecified
+				if j%2 == 1 {
+					version = core.AutoBalanceEnabled
+				}
+				value := []byte(fmt.Sprintf("value%d-%d", i, j))
+				require.Nil(t, dataTrie.UpdateWithVersion([]byte(fmt.Sprintf("key%d", j)), value, version))
+			}
+
+			accountData.RootHash = commitAndGetRootHash(t, dataTrie)
+			dataTriesRootHashes = append(dataTriesRootHashes, accountData.RootHash)
+		}
+
+		accountBytes, err := testMarshaller.Marshal(accountData)
+		require.Nil(t, err)
+		require.Nil(t, userTrie.Update(address, accountBytes))
+	}
+
+	code := []byte("smart contract code")
+	require.Nil(t, userTrie.Update(testHasher.Compute(string(code)), code))
+
+	peerTrie := createTrie(t, createStorageManager(t))
+	for i := 0; i < numAccounts; i++ {
+		require.Nil(t, peerTrie.Update([]byte(fmt.Sprintf("validator%d", i)), []byte(fmt.Sprintf("rating%d", i))))
+	}
+
+	return &testState{
+		userTrie:            userTrie,
+		userRootHash:        commitAndGetRootHash(t, userTrie),
+		dataTriesRootHashes: dataTriesRootHashes,
+		peerTrie:            peerTrie,
+		peerRootHash:        commitAndGetRootHash(t, peerTrie),
+	}
+}
+
+func createLeavesProvider(tr common.Trie) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+			return tr.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), trieLeafParser)
+		},
+	}
+}
+
+func createMockArgsExporter() ArgsExporter {
+	return ArgsExporter{
+		Marshaller:        testMarshaller,
+		Hasher:            testHasher,
+		MaxLeavesPerChunk: 4,
+	}
+}
+
+func createArgsExport(ts *testState) ArgsExport {
+	return ArgsExport{
+		ChainID:            "chain",
+		ShardID:            core.MetachainShardId,
+		Epoch:              7,
+		EpochStartMetaHash: testEpochStartMetaHash,
+		EpochStartMeta:     testEpochStartMeta,
+		Tries: []*TrieToExport{
+			{
+				Identifier:     peerAccountsIdentifier,
+				RootHash:       ts.peerRootHash,
+				LeavesProvider: createLeavesProvider(ts.peerTrie),
+			},
+			{
+				Identifier:     userAccountsIdentifier,
+				RootHash:       ts.userRootHash,
+				LeavesProvider: createLeavesProvider(ts.userTrie),
+				HasDataTries:   true,
+			},
+		},
+	}
+}
+
+func exportTestState(t *testing.T, ts *testState) []byte {
+	exp, _ := NewExporter(createMockArgsExporter())
+	buff := bytes.NewBuffer(nil)
+	_, err := exp.Export(buff, createArgsExport(ts))
+	require.Nil(t, err)
+
+	return buff.Bytes()
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter()
+		args.Marshaller = nil
+		exp, err := NewExporter(args)
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter()
+		args.Hasher = nil
+		exp, err := NewExporter(args)
+		assert.True(t, check.IfNil(exp))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("invalid max leaves per chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExporter()
+		args.MaxLeavesPerChunk = 0
+		exp, err := NewExporter(args)
+		assert.True(t, check.IfNil(exp))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exp, err := NewExporter(createMockArgsExporter())
+		assert.False(t, check.IfNil(exp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		exp, _ := NewExporter(createMockArgsExporter())
+		trailer, err := exp.Export(bytes.NewBuffer(nil), ArgsExport{})
+		assert.Nil(t, trailer)
+		assert.Equal(t, ErrNoTriesToExport, err)
+	})
+	t.Run("nil leaves provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsExport(createTestState(t, 1))
+		args.Tries[1].LeavesProvider = nil
+		exp, _ := NewExporter(createMockArgsExporter())
+		trailer, err := exp.Export(bytes.NewBuffer(nil), args)
+		assert.Nil(t, trailer)
+		assert.True(t, errors.Is(err, ErrNilLeavesProvider))
+	})
+	t.Run("iteration error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgsExport(createTestState(t, 1))
+		args.Tries[0].LeavesProvider = &stateMock.AccountsStub{
+			GetAllLeavesCalled: func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error {
+				leavesChannels.ErrChan.WriteInChanNonBlocking(expectedErr)
+				close(leavesChannels.LeavesChan)
+				return nil
+			},
+		}
+		exp, _ := NewExporter(createMockArgsExporter())
+		trailer, err := exp.Export(bytes.NewBuffer(nil), args)
+		assert.Nil(t, trailer)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should write all the leaves, grouped by trie", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 5)
+		exp, _ := NewExporter(createMockArgsExporter())
+		buff := bytes.NewBuffer(nil)
+		trailer, err := exp.Export(buff, createArgsExport(ts))
+		require.Nil(t, err)
+
+		// 5 peer leaves, 5 accounts + 1 code leaf, data tries with 3, 5 and 7 leaves
+		assert.Equal(t, uint64(26), trailer.NumLeaves)
+		assert.Equal(t, uint64(3), trailer.NumDataTries)
+		// peer trie: 2 chunks, user trie: 2 chunks, data tries: 1 + 2 + 2 chunks
+		assert.Equal(t, uint64(9), trailer.NumChunks)
+
+		reader, err := NewSnapshotReader(buff, testHasher)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(7), reader.Manifest().Epoch)
+		assert.Equal(t, ts.userRootHash, reader.Manifest().Tries[1].RootHash)
+
+		leavesPerRootHash := make(map[string]int)
+		rootHashesOrder := make([][]byte, 0)
+		for {
+			chunk, errRead := reader.NextChunk()
+			if errRead == io.EOF {
+				break
+			}
+			require.Nil(t, errRead)
+
+			_, found := leavesPerRootHash[string(chunk.RootHash)]
+			if !found {
+				rootHashesOrder = append(rootHashesOrder, chunk.RootHash)
+			}
+			leavesPerRootHash[string(chunk.RootHash)] += len(chunk.Leaves)
+		}
+
+		expectedOrder := append([][]byte{ts.peerRootHash, ts.userRootHash}, ts.dataTriesRootHashes...)
+		assert.Equal(t, len(expectedOrder), len(rootHashesOrder))
+		for _, rootHash := range expectedOrder {
+			assert.Contains(t, rootHashesOrder, rootHash)
+		}
+		assert.Equal(t, ts.peerRootHash, rootHashesOrder[0])
+		assert.Equal(t, ts.userRootHash, rootHashesOrder[1])
+		assert.Equal(t, 6, leavesPerRootHash[string(ts.userRootHash)])
+		assert.Equal(t, *trailer, *reader.Trailer())
+	})
+}
+
+func TestGetDataTrieRootHash(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	accountBytes, _ := testMarshaller.Marshal(&accounts.UserAccountData{Address: address, RootHash: []byte("root hash")})
+	assert.Equal(t, []byte("root hash"), getDataTrieRootHash(testMarshaller, address, accountBytes))
+	assert.Nil(t, getDataTrieRootHash(testMarshaller, []byte("code hash"), accountBytes))
+	assert.Nil(t, getDataTrieRootHash(testMarshaller, address, []byte("not an account")))
+
+	accountBytes, _ = testMarshaller.Marshal(&accounts.UserAccountData{Address: address, RootHash: common.EmptyTrieHash})
+	assert.Nil(t, getDataTrieRootHash(testMarshaller, address, accountBytes))
+}
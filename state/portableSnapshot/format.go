@@ -0,0 +1,191 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// SnapshotFormatVersion is the version of the state snapshot format written by this package
+const SnapshotFormatVersion = uint32(1)
+
+// snapshotMagic is written at the beginning of each state snapshot file
+const snapshotMagic = "MXSTSNAP"
+
+const (
+	manifestFrameType = byte(1)
+	chunkFrameType    = byte(2)
+	trailerFrameType  = byte(3)
+)
+
+// frameHeaderSize is the size of the frame type and of the frame payload length
+const frameHeaderSize = 5
+
+// maxFrameSizeInBytes limits the memory allocated when reading a frame from an untrusted file
+const maxFrameSizeInBytes = 256 * core.MegabyteSize
+
+// maxChunkSizeInBytes triggers a chunk flush even if the maximum number of leaves was not reached
+const maxChunkSizeInBytes = 16 * core.MegabyteSize
+
+// TrieManifest holds the identifier and the root hash of a trie contained in the snapshot
+type TrieManifest struct {
+	Identifier string `json:"identifier"`
+	RootHash   []byte `json:"rootHash"`
+}
+
+// Manifest is the first frame of a state snapshot and describes its content
+type Manifest struct {
+	Version            uint32          `json:"version"`
+	ChainID            string          `json:"chainID"`
+	ShardID            uint32          `json:"shardID"`
+	Epoch              uint32          `json:"epoch"`
+	EpochStartMetaHash []byte          `json:"epochStartMetaHash"`
+	EpochStartMeta     []byte          `json:"epochStartMeta"`
+	ShardHeaderHash    []byte          `json:"shardHeaderHash,omitempty"`
+	ShardHeader        []byte          `json:"shardHeader,omitempty"`
+	Tries              []*TrieManifest `json:"tries"`
+}
+
+// Trailer is the last frame of a state snapshot and holds the totals used to check the snapshot completeness
+type Trailer struct {
+	NumChunks    uint64 `json:"numChunks"`
+	NumLeaves    uint64 `json:"numLeaves"`
+	NumDataTries uint64 `json:"numDataTries"`
+}
+
+// Leaf holds a trie leaf as it is stored in the trie
+type Leaf struct {
+	Key     []byte
+	Value   []byte
+	Version core.TrieNodeVersion
+}
+
+// Chunk holds a batch of leaves belonging to the same trie. The root hash is either the root hash of one of the
+// tries from the manifest or the root hash of a data trie referenced by an account from that trie
+type Chunk struct {
+	TrieIndex uint32
+	RootHash  []byte
+	Leaves    []*Leaf
+}
+
+func (c *Chunk) belongsTo(trieIndex uint32, rootHash []byte) bool {
+	return c.TrieIndex == trieIndex && bytes.Equal(c.RootHash, rootHash)
+}
+
+func (c *Chunk) encode() []byte {
+	size := 3*binary.MaxVarintLen64 + len(c.RootHash)
+	for _, leaf := range c.Leaves {
+		size += leafEncodedSize(leaf)
+	}
+
+	buff := make([]byte, 0, size)
+	buff = binary.AppendUvarint(buff, uint64(c.TrieIndex))
+	buff = appendBytes(buff, c.RootHash)
+	buff = binary.AppendUvarint(buff, uint64(len(c.Leaves)))
+	for _, leaf := range c.Leaves {
+		buff = appendBytes(buff, leaf.Key)
+		buff = appendBytes(buff, leaf.Value)
+		buff = append(buff, byte(leaf.Version))
+	}
+
+	return buff
+}
+
+func leafEncodedSize(leaf *Leaf) int {
+	return 2*binary.MaxVarintLen64 + len(leaf.Key) + len(leaf.Value) + 1
+}
+
+func appendBytes(buff []byte, data []byte) []byte {
+	buff = binary.AppendUvarint(buff, uint64(len(data)))
+	return append(buff, data...)
+}
+
+func decodeChunk(buff []byte) (*Chunk, error) {
+	decoder := &chunkDecoder{buff: buff}
+
+	chunk := &Chunk{
+		TrieIndex: uint32(decoder.readUvarint()),
+		RootHash:  decoder.readBytes(),
+	}
+	numLeaves := decoder.readUvarint()
+	if decoder.err != nil {
+		return nil, decoder.err
+	}
+	// each leaf needs at least 3 bytes, this prevents huge allocations on corrupted data
+	if numLeaves > uint64(len(buff)) {
+		return nil, fmt.Errorf("%w: %d leaves declared in a %d bytes chunk", ErrInvalidChunk, numLeaves, len(buff))
+	}
+
+	chunk.Leaves = make([]*Leaf, 0, numLeaves)
+	for i := uint64(0); i < numLeaves; i++ {
+		leaf := &Leaf{
+			Key:   decoder.readBytes(),
+			Value: decoder.readBytes(),
+		}
+		leaf.Version = core.TrieNodeVersion(decoder.readByte())
+		if decoder.err != nil {
+			return nil, decoder.err
+		}
+
+		chunk.Leaves = append(chunk.Leaves, leaf)
+	}
+	if decoder.offset != len(buff) {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidChunk, len(buff)-decoder.offset)
+	}
+
+	return chunk, nil
+}
+
+type chunkDecoder struct {
+	buff   []byte
+	offset int
+	err    error
+}
+
+func (cd *chunkDecoder) readUvarint() uint64 {
+	if cd.err != nil {
+		return 0
+	}
+
+	value, n := binary.Uvarint(cd.buff[cd.offset:])
+	if n <= 0 {
+		cd.err = fmt.Errorf("%w: malformed length at offset %d", ErrInvalidChunk, cd.offset)
+		return 0
+	}
+	cd.offset += n
+
+	return value
+}
+
+func (cd *chunkDecoder) readBytes() []byte {
+	length := cd.readUvarint()
+	if cd.err != nil {
+		return nil
+	}
+	if length > uint64(len(cd.buff)-cd.offset) {
+		cd.err = fmt.Errorf("%w: data length %d exceeds the chunk size at offset %d", ErrInvalidChunk, length, cd.offset)
+		return nil
+	}
+
+	data := cd.buff[cd.offset : cd.offset+int(length)]
+	cd.offset += int(length)
+
+	return data
+}
+
+func (cd *chunkDecoder) readByte() byte {
+	if cd.err != nil {
+		return 0
+	}
+	if cd.offset >= len(cd.buff) {
+		cd.err = fmt.Errorf("%w: unexpected end of chunk", ErrInvalidChunk)
+		return 0
+	}
+
+	value := cd.buff[cd.offset]
+	cd.offset++
+
+	return value
+}
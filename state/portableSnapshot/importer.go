@@ -0,0 +1,354 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// ExpectedTrie holds the root hash a trie from the snapshot must have once imported
+type ExpectedTrie struct {
+	RootHash     []byte
+	HasDataTries bool
+}
+
+// ArgsImporter holds the arguments needed to create a state snapshot importer
+type ArgsImporter struct {
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	EnableEpochsHandler  common.EnableEpochsHandler
+	StorageMarker        common.StorageMarker
+	MaxTrieLevelInMemory uint
+}
+
+type importer struct {
+	marshaller           marshal.Marshalizer
+	hasher               hashing.Hasher
+	enableEpochsHandler  common.EnableEpochsHandler
+	storageMarker        common.StorageMarker
+	maxTrieLevelInMemory uint
+}
+
+type importedTrie struct {
+	identifier        string
+	expected          ExpectedTrie
+	storageManager    common.StorageManager
+	mainTrie          state.DataTrie
+	requiredDataTries map[string]struct{}
+	importedDataTries map[string]struct{}
+}
+
+type dataTrieImport struct {
+	owner    *importedTrie
+	rootHash []byte
+	trie     state.DataTrie
+}
+
+// NewImporter creates a new state snapshot importer
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(args.StorageMarker) {
+		return nil, ErrNilStorageMarker
+	}
+	if args.MaxTrieLevelInMemory == 0 {
+		return nil, fmt.Errorf("%w for MaxTrieLevelInMemory: %d", ErrInvalidValue, args.MaxTrieLevelInMemory)
+	}
+
+	return &importer{
+		marshaller:           args.Marshaller,
+		hasher:               args.Hasher,
+		enableEpochsHandler:  args.EnableEpochsHandler,
+		storageMarker:        args.StorageMarker,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+	}, nil
+}
+
+// Import rebuilds the expected tries from the snapshot into the provided trie storage managers. Each rebuilt trie,
+// including the data tries, has to match the root hash it was exported with. The tries from the snapshot that are
+// not expected are skipped
+func (i *importer) Import(
+	reader SnapshotReader,
+	expectedTries map[string]ExpectedTrie,
+	trieStorageManagers map[string]common.StorageManager,
+) error {
+	if check.IfNil(reader) {
+		return ErrNilSnapshotReader
+	}
+	if len(expectedTries) == 0 {
+		return ErrNoTriesToImport
+	}
+
+	importedTries, err := i.prepareImportedTries(reader.Manifest(), expectedTries, trieStorageManagers)
+	if err != nil {
+		return err
+	}
+
+	numDataTries, err := i.importChunks(reader, importedTries)
+	if err != nil {
+		return err
+	}
+
+	trailer := reader.Trailer()
+	if trailer.NumDataTries != numDataTries {
+		return fmt.Errorf("%w: trailer has %d data tries, read %d data tries", ErrTrailerMismatch, trailer.NumDataTries, numDataTries)
+	}
+
+	for _, it := range importedTries {
+		err = i.finalizeImportedTrie(it)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, it := range importedTries {
+		i.storageMarker.MarkStorerAsSyncedAndActive(it.storageManager)
+	}
+
+	return nil
+}
+
+func (i *importer) prepareImportedTries(
+	manifest *Manifest,
+	expectedTries map[string]ExpectedTrie,
+	trieStorageManagers map[string]common.StorageManager,
+) (map[uint32]*importedTrie, error) {
+	importedTries := make(map[uint32]*importedTrie, len(expectedTries))
+	for identifier, expected := range expectedTries {
+		trieIndex, found := findTrieInManifest(manifest, identifier)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrTrieNotFoundInSnapshot, identifier)
+		}
+
+		snapshotRootHash := manifest.Tries[trieIndex].RootHash
+		if !bytes.Equal(snapshotRootHash, expected.RootHash) {
+			return nil, fmt.Errorf("%w for trie %s: snapshot has %x, expected %x",
+				ErrRootHashMismatch, identifier, snapshotRootHash, expected.RootHash)
+		}
+
+		storageManager := trieStorageManagers[identifier]
+		if check.IfNil(storageManager) {
+			return nil, fmt.Errorf("%w for trie %s", ErrNilTrieStorageManager, identifier)
+		}
+
+		mainTrie, err := i.newTrie(storageManager)
+		if err != nil {
+			return nil, err
+		}
+
+		importedTries[trieIndex] = &importedTrie{
+			identifier:        identifier,
+			expected:          expected,
+			storageManager:    storageManager,
+			mainTrie:          mainTrie,
+			requiredDataTries: make(map[string]struct{}),
+			importedDataTries: make(map[string]struct{}),
+		}
+	}
+
+	return importedTries, nil
+}
+
+func findTrieInManifest(manifest *Manifest, identifier string) (uint32, bool) {
+	for index, trieManifest := range manifest.Tries {
+		if trieManifest.Identifier == identifier {
+			return uint32(index), true
+		}
+	}
+
+	return 0, false
+}
+
+func (i *importer) importChunks(reader SnapshotReader, importedTries map[uint32]*importedTrie) (uint64, error) {
+	manifest := reader.Manifest()
+	numDataTries := uint64(0)
+	var currentDataTrie *dataTrieImport
+	var lastChunk *Chunk
+	for {
+		chunk, err := reader.NextChunk()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if int(chunk.TrieIndex) >= len(manifest.Tries) {
+			return 0, fmt.Errorf("%w: trie index %d out of range", ErrInvalidChunk, chunk.TrieIndex)
+		}
+
+		isMainTrieChunk := bytes.Equal(chunk.RootHash, manifest.Tries[chunk.TrieIndex].RootHash)
+		isNewDataTrie := !isMainTrieChunk && (lastChunk == nil || !lastChunk.belongsTo(chunk.TrieIndex, chunk.RootHash))
+		if isNewDataTrie {
+			numDataTries++
+		}
+		lastChunk = chunk
+
+		it, found := importedTries[chunk.TrieIndex]
+		if !found {
+			continue
+		}
+
+		if isMainTrieChunk {
+			err = i.importMainTrieChunk(it, chunk)
+			if err != nil {
+				return 0, err
+			}
+
+			continue
+		}
+
+		if isNewDataTrie {
+			err = i.finalizeDataTrie(currentDataTrie)
+			if err != nil {
+				return 0, err
+			}
+
+			currentDataTrie, err = i.startDataTrie(it, chunk.RootHash)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		err = i.importLeaves(currentDataTrie.trie, chunk)
+		if err != nil {
+			return 0, fmt.Errorf("%w for data trie %x", err, chunk.RootHash)
+		}
+	}
+
+	err := i.finalizeDataTrie(currentDataTrie)
+	if err != nil {
+		return 0, err
+	}
+
+	return numDataTries, nil
+}
+
+func (i *importer) importMainTrieChunk(it *importedTrie, chunk *Chunk) error {
+	err := i.importLeaves(it.mainTrie, chunk)
+	if err != nil {
+		return fmt.Errorf("%w for trie %s", err, it.identifier)
+	}
+
+	if !it.expected.HasDataTries {
+		return nil
+	}
+
+	for _, leaf := range chunk.Leaves {
+		dataTrieRootHash := getDataTrieRootHash(i.marshaller, leaf.Key, leaf.Value)
+		if len(dataTrieRootHash) > 0 {
+			it.requiredDataTries[string(dataTrieRootHash)] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) startDataTrie(it *importedTrie, rootHash []byte) (*dataTrieImport, error) {
+	if !it.expected.HasDataTries {
+		return nil, fmt.Errorf("%w: trie %s should not have data tries, got data trie %x", ErrInvalidChunk, it.identifier, rootHash)
+	}
+
+	_, alreadyImported := it.importedDataTries[string(rootHash)]
+	if alreadyImported {
+		return nil, fmt.Errorf("%w: the leaves of data trie %x are not contiguous", ErrInvalidChunkOrder, rootHash)
+	}
+
+	dataTrie, err := i.newTrie(it.storageManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataTrieImport{
+		owner:    it,
+		rootHash: rootHash,
+		trie:     dataTrie,
+	}, nil
+}
+
+func (i *importer) finalizeDataTrie(dti *dataTrieImport) error {
+	if dti == nil {
+		return nil
+	}
+
+	err := checkRootHash(dti.trie, dti.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w for a data trie of %s", err, dti.owner.identifier)
+	}
+
+	dti.owner.importedDataTries[string(dti.rootHash)] = struct{}{}
+
+	return nil
+}
+
+func (i *importer) finalizeImportedTrie(it *importedTrie) error {
+	err := checkRootHash(it.mainTrie, it.expected.RootHash)
+	if err != nil {
+		return fmt.Errorf("%w for trie %s", err, it.identifier)
+	}
+
+	for dataTrieRootHash := range it.requiredDataTries {
+		_, found := it.importedDataTries[dataTrieRootHash]
+		if !found {
+			return fmt.Errorf("%w: %x for trie %s", ErrMissingDataTrie, dataTrieRootHash, it.identifier)
+		}
+	}
+
+	log.Debug("imported trie from the state snapshot",
+		"identifier", it.identifier,
+		"root hash", it.expected.RootHash,
+		"num data tries", len(it.importedDataTries),
+	)
+
+	return nil
+}
+
+// importLeaves inserts the leaves and commits the trie, so that only the top levels of the trie are kept in memory
+func (i *importer) importLeaves(tr state.DataTrie, chunk *Chunk) error {
+	for _, leaf := range chunk.Leaves {
+		if len(leaf.Value) == 0 {
+			return fmt.Errorf("%w: empty value for key %x", ErrInvalidChunk, leaf.Key)
+		}
+
+		err := tr.UpdateWithVersion(leaf.Key, leaf.Value, leaf.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tr.Commit()
+}
+
+func checkRootHash(tr common.Trie, expectedRootHash []byte) error {
+	rootHash, err := tr.RootHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rootHash, expectedRootHash) {
+		return fmt.Errorf("%w: rebuilt %x, expected %x", ErrRootHashMismatch, rootHash, expectedRootHash)
+	}
+
+	return nil
+}
+
+func (i *importer) newTrie(storageManager common.StorageManager) (state.DataTrie, error) {
+	return trie.NewTrie(storageManager, i.marshaller, i.hasher, i.enableEpochsHandler, i.maxTrieLevelInMemory)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *importer) IsInterfaceNil() bool {
+	return i == nil
+}
@@ -0,0 +1,310 @@
+package portableSnapshot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
+	"github.com/multiversx/mx-chain-go/trie/storageMarker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsImporter() ArgsImporter {
+	return ArgsImporter{
+		Marshaller:           testMarshaller,
+		Hasher:               testHasher,
+		EnableEpochsHandler:  testEnableEpochsHandler,
+		StorageMarker:        storageMarker.NewDisabledStorageMarker(),
+		MaxTrieLevelInMemory: 5,
+	}
+}
+
+func createExpectedTries(ts *testState) map[string]ExpectedTrie {
+	return map[string]ExpectedTrie{
+		userAccountsIdentifier: {
+			RootHash:     ts.userRootHash,
+			HasDataTries: true,
+		},
+		peerAccountsIdentifier: {
+			RootHash: ts.peerRootHash,
+		},
+	}
+}
+
+func createTrieStorageManagers(t *testing.T) map[string]common.StorageManager {
+	return map[string]common.StorageManager{
+		userAccountsIdentifier: createStorageManager(t),
+		peerAccountsIdentifier: createStorageManager(t),
+	}
+}
+
+func importSnapshot(
+	t *testing.T,
+	snapshot []byte,
+	expectedTries map[string]ExpectedTrie,
+	trieStorageManagers map[string]common.StorageManager,
+) error {
+	reader, err := NewSnapshotReader(bytes.NewReader(snapshot), testHasher)
+	require.Nil(t, err)
+
+	imp, _ := NewImporter(createMockArgsImporter())
+
+	return imp.Import(reader, expectedTries, trieStorageManagers)
+}
+
+func TestNewImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImporter()
+		args.Marshaller = nil
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImporter()
+		args.Hasher = nil
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImporter()
+		args.EnableEpochsHandler = nil
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("nil storage marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImporter()
+		args.StorageMarker = nil
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.Equal(t, ErrNilStorageMarker, err)
+	})
+	t.Run("invalid max trie level in memory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsImporter()
+		args.MaxTrieLevelInMemory = 0
+		imp, err := NewImporter(args)
+		assert.True(t, check.IfNil(imp))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		imp, err := NewImporter(createMockArgsImporter())
+		assert.False(t, check.IfNil(imp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil reader should error", func(t *testing.T) {
+		t.Parallel()
+
+		imp, _ := NewImporter(createMockArgsImporter())
+		err := imp.Import(nil, map[string]ExpectedTrie{}, createTrieStorageManagers(t))
+		assert.Equal(t, ErrNilSnapshotReader, err)
+	})
+	t.Run("no expected tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		snapshot := exportTestState(t, createTestState(t, 1))
+		err := importSnapshot(t, snapshot, nil, createTrieStorageManagers(t))
+		assert.Equal(t, ErrNoTriesToImport, err)
+	})
+	t.Run("trie not in snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		expectedTries := createExpectedTries(ts)
+		expectedTries["unknown"] = ExpectedTrie{RootHash: ts.userRootHash}
+		err := importSnapshot(t, exportTestState(t, ts), expectedTries, createTrieStorageManagers(t))
+		assert.True(t, errors.Is(err, ErrTrieNotFoundInSnapshot))
+	})
+	t.Run("root hash different than the expected one should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		expectedTries := createExpectedTries(ts)
+		expectedTries[userAccountsIdentifier] = ExpectedTrie{RootHash: []byte("another root hash")}
+		err := importSnapshot(t, exportTestState(t, ts), expectedTries, createTrieStorageManagers(t))
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	})
+	t.Run("missing trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		trieStorageManagers := createTrieStorageManagers(t)
+		delete(trieStorageManagers, peerAccountsIdentifier)
+		err := importSnapshot(t, exportTestState(t, ts), createExpectedTries(ts), trieStorageManagers)
+		assert.True(t, errors.Is(err, ErrNilTrieStorageManager))
+	})
+	t.Run("altered leaf should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 5)
+		snapshot := exportTestState(t, ts)
+		alteredSnapshot := rewriteSnapshot(t, snapshot, func(chunk *Chunk) bool {
+			if bytes.Equal(chunk.RootHash, ts.dataTriesRootHashes[1]) {
+				chunk.Leaves[0].Value = []byte("altered value")
+			}
+
+			return true
+		})
+
+		err := importSnapshot(t, alteredSnapshot, createExpectedTries(ts), createTrieStorageManagers(t))
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	})
+	t.Run("missing data trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 5)
+		snapshot := exportTestState(t, ts)
+		alteredSnapshot := rewriteSnapshot(t, snapshot, func(chunk *Chunk) bool {
+			return !bytes.Equal(chunk.RootHash, ts.dataTriesRootHashes[0])
+		})
+
+		err := importSnapshot(t, alteredSnapshot, createExpectedTries(ts), createTrieStorageManagers(t))
+		assert.True(t, errors.Is(err, ErrMissingDataTrie))
+	})
+	t.Run("data trie for a trie without data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 5)
+		expectedTries := createExpectedTries(ts)
+		expectedTries[userAccountsIdentifier] = ExpectedTrie{RootHash: ts.userRootHash}
+		err := importSnapshot(t, exportTestState(t, ts), expectedTries, createTrieStorageManagers(t))
+		assert.True(t, errors.Is(err, ErrInvalidChunk))
+	})
+	t.Run("should rebuild the tries", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 10)
+		trieStorageManagers := createTrieStorageManagers(t)
+		err := importSnapshot(t, exportTestState(t, ts), createExpectedTries(ts), trieStorageManagers)
+		require.Nil(t, err)
+
+		checkImportedTrie(t, ts.userTrie, ts.userRootHash, trieStorageManagers[userAccountsIdentifier])
+		checkImportedTrie(t, ts.peerTrie, ts.peerRootHash, trieStorageManagers[peerAccountsIdentifier])
+		for _, dataTrieRootHash := range ts.dataTriesRootHashes {
+			checkImportedTrie(t, ts.userTrie, dataTrieRootHash, trieStorageManagers[userAccountsIdentifier])
+		}
+	})
+	t.Run("should import only the expected tries and mark them as synced", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 4)
+		markedStorageManagers := make([]common.StorageManager, 0)
+		args := createMockArgsImporter()
+		args.StorageMarker = &storageManager.StorageMarkerStub{
+			MarkStorerAsSyncedAndActiveCalled: func(storer common.StorageManager) {
+				markedStorageManagers = append(markedStorageManagers, storer)
+			},
+		}
+		imp, _ := NewImporter(args)
+		reader, _ := NewSnapshotReader(bytes.NewReader(exportTestState(t, ts)), testHasher)
+		trieStorageManagers := createTrieStorageManagers(t)
+		expectedTries := map[string]ExpectedTrie{
+			userAccountsIdentifier: {
+				RootHash:     ts.userRootHash,
+				HasDataTries: true,
+			},
+		}
+
+		err := imp.Import(reader, expectedTries, trieStorageManagers)
+		require.Nil(t, err)
+
+		checkImportedTrie(t, ts.userTrie, ts.userRootHash, trieStorageManagers[userAccountsIdentifier])
+		assert.Equal(t, []common.StorageManager{trieStorageManagers[userAccountsIdentifier]}, markedStorageManagers)
+		_, err = trieStorageManagers[peerAccountsIdentifier].Get(ts.peerRootHash)
+		assert.NotNil(t, err)
+	})
+}
+
+// checkImportedTrie compares the leaves of the source trie with the leaves of the trie rebuilt in the storage manager
+func checkImportedTrie(t *testing.T, sourceTrie common.Trie, rootHash []byte, importedStorageManager common.StorageManager) {
+	importedTrie := createTrie(t, importedStorageManager)
+	sourceProvider := createLeavesProvider(sourceTrie)
+	importedProvider := createLeavesProvider(importedTrie)
+
+	sourceLeaves := collectLeaves(t, sourceProvider, rootHash)
+	importedLeaves := collectLeaves(t, importedProvider, rootHash)
+	require.NotEmpty(t, sourceLeaves)
+	assert.Equal(t, sourceLeaves, importedLeaves, fmt.Sprintf("leaves for root hash %x", rootHash))
+}
+
+func collectLeaves(t *testing.T, provider LeavesProvider, rootHash []byte) []*Leaf {
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := provider.GetAllLeaves(iteratorChannels, context.Background(), rootHash, &rawLeafParser{})
+	require.Nil(t, err)
+
+	leaves := make([]*Leaf, 0)
+	for keyValue := range iteratorChannels.LeavesChan {
+		leaves = append(leaves, keyValue.(*trieLeaf).toLeaf())
+	}
+	require.Nil(t, iteratorChannels.ErrChan.ReadFromChanNonBlocking())
+
+	return leaves
+}
+
+// rewriteSnapshot reads the snapshot and writes it back, one leaf per chunk, after applying the handler on each
+// chunk. The chunks for which the handler returns false are dropped
+func rewriteSnapshot(t *testing.T, snapshot []byte, handler func(chunk *Chunk) bool) []byte {
+	reader, err := NewSnapshotReader(bytes.NewReader(snapshot), testHasher)
+	require.Nil(t, err)
+
+	manifest := reader.Manifest()
+	buff := bytes.NewBuffer(nil)
+	sw := newSnapshotWriter(buff, testHasher, 1)
+	require.Nil(t, sw.writeManifest(manifest))
+
+	dataTries := make(map[string]struct{})
+	for {
+		chunk, errRead := reader.NextChunk()
+		if errRead == io.EOF {
+			break
+		}
+		require.Nil(t, errRead)
+
+		if !handler(chunk) {
+			continue
+		}
+		if !bytes.Equal(chunk.RootHash, manifest.Tries[chunk.TrieIndex].RootHash) {
+			dataTries[string(chunk.RootHash)] = struct{}{}
+		}
+		for _, leaf := range chunk.Leaves {
+			require.Nil(t, sw.addLeaf(chunk.TrieIndex, chunk.RootHash, leaf))
+		}
+	}
+	sw.trailer.NumDataTries = uint64(len(dataTries))
+	_, err = sw.close()
+	require.Nil(t, err)
+
+	return buff.Bytes()
+}
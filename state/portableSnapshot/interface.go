@@ -0,0 +1,21 @@
+package portableSnapshot
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// LeavesProvider defines the component able to iterate over the leaves of a trie, as the accounts adapters do
+type LeavesProvider interface {
+	GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error
+	IsInterfaceNil() bool
+}
+
+// SnapshotReader defines the component able to read a state snapshot
+type SnapshotReader interface {
+	Manifest() *Manifest
+	NextChunk() (*Chunk, error)
+	Trailer() *Trailer
+	IsInterfaceNil() bool
+}
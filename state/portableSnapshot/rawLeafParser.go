@@ -0,0 +1,46 @@
+package portableSnapshot
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+// trieLeaf keeps the leaf exactly as it is stored in the trie, so it can be inserted back with the same hash
+type trieLeaf struct {
+	key     []byte
+	value   []byte
+	version core.TrieNodeVersion
+}
+
+// Key returns the trie key of the leaf
+func (tl *trieLeaf) Key() []byte {
+	return tl.key
+}
+
+// Value returns the leaf value as stored in the trie
+func (tl *trieLeaf) Value() []byte {
+	return tl.value
+}
+
+func (tl *trieLeaf) toLeaf() *Leaf {
+	return &Leaf{
+		Key:     tl.key,
+		Value:   tl.value,
+		Version: tl.version,
+	}
+}
+
+// rawLeafParser does not alter the leaves and keeps their version
+type rawLeafParser struct {
+}
+
+// ParseLeaf returns the leaf as it is stored in the trie
+func (rlp *rawLeafParser) ParseLeaf(trieKey []byte, trieVal []byte, version core.TrieNodeVersion) (core.KeyValueHolder, error) {
+	return &trieLeaf{
+		key:     trieKey,
+		value:   trieVal,
+		version: version,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rlp *rawLeafParser) IsInterfaceNil() bool {
+	return rlp == nil
+}
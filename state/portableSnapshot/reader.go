@@ -0,0 +1,194 @@
+package portableSnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+type snapshotReader struct {
+	reader    *bufio.Reader
+	hasher    hashing.Hasher
+	manifest  *Manifest
+	trailer   *Trailer
+	numChunks uint64
+	numLeaves uint64
+}
+
+// NewSnapshotReader creates a state snapshot reader. It reads and checks the snapshot manifest
+// so the caller can inspect it before reading the chunks
+func NewSnapshotReader(reader io.Reader, hasher hashing.Hasher) (*snapshotReader, error) {
+	if reader == nil {
+		return nil, ErrNilSnapshotReader
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	sr := &snapshotReader{
+		reader: bufio.NewReader(reader),
+		hasher: hasher,
+	}
+
+	err := sr.readManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	return sr, nil
+}
+
+func (sr *snapshotReader) readManifest() error {
+	magic := make([]byte, len(snapshotMagic))
+	_, err := io.ReadFull(sr.reader, magic)
+	if err != nil || string(magic) != snapshotMagic {
+		return ErrInvalidSnapshotFile
+	}
+
+	frameType, payload, err := sr.readFrame()
+	if err != nil {
+		return err
+	}
+	if frameType != manifestFrameType {
+		return fmt.Errorf("%w: expected the manifest, got frame type %d", ErrUnexpectedFrameType, frameType)
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(payload, manifest)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the manifest", err)
+	}
+	if manifest.Version != SnapshotFormatVersion {
+		return fmt.Errorf("%w: got %d, supported %d", ErrUnsupportedSnapshotVersion, manifest.Version, SnapshotFormatVersion)
+	}
+
+	err = sr.checkHeaderHash(manifest.EpochStartMeta, manifest.EpochStartMetaHash, "epoch start meta")
+	if err != nil {
+		return err
+	}
+	if len(manifest.ShardHeader) > 0 {
+		err = sr.checkHeaderHash(manifest.ShardHeader, manifest.ShardHeaderHash, "shard header")
+		if err != nil {
+			return err
+		}
+	}
+
+	sr.manifest = manifest
+
+	return nil
+}
+
+func (sr *snapshotReader) checkHeaderHash(headerBytes []byte, expectedHash []byte, headerName string) error {
+	computedHash := sr.hasher.Compute(string(headerBytes))
+	if !bytes.Equal(computedHash, expectedHash) {
+		return fmt.Errorf("%w for the %s: expected %x, computed %x", ErrHeaderHashMismatch, headerName, expectedHash, computedHash)
+	}
+
+	return nil
+}
+
+// Manifest returns the snapshot manifest
+func (sr *snapshotReader) Manifest() *Manifest {
+	return sr.manifest
+}
+
+// NextChunk returns the next chunk from the snapshot. It returns io.EOF after the trailer was read and checked
+func (sr *snapshotReader) NextChunk() (*Chunk, error) {
+	if sr.trailer != nil {
+		return nil, io.EOF
+	}
+
+	frameType, payload, err := sr.readFrame()
+	if err != nil {
+		return nil, err
+	}
+
+	switch frameType {
+	case chunkFrameType:
+		chunk, errDecode := decodeChunk(payload)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for chunk %d", errDecode, sr.numChunks)
+		}
+
+		sr.numChunks++
+		sr.numLeaves += uint64(len(chunk.Leaves))
+
+		return chunk, nil
+	case trailerFrameType:
+		return nil, sr.processTrailer(payload)
+	default:
+		return nil, fmt.Errorf("%w: got frame type %d after %d chunks", ErrUnexpectedFrameType, frameType, sr.numChunks)
+	}
+}
+
+func (sr *snapshotReader) processTrailer(payload []byte) error {
+	trailer := &Trailer{}
+	err := json.Unmarshal(payload, trailer)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the trailer", err)
+	}
+	if trailer.NumChunks != sr.numChunks || trailer.NumLeaves != sr.numLeaves {
+		return fmt.Errorf("%w: trailer has %d chunks and %d leaves, read %d chunks and %d leaves",
+			ErrTrailerMismatch, trailer.NumChunks, trailer.NumLeaves, sr.numChunks, sr.numLeaves)
+	}
+
+	sr.trailer = trailer
+
+	return io.EOF
+}
+
+// Trailer returns the snapshot trailer or nil if it was not read yet
+func (sr *snapshotReader) Trailer() *Trailer {
+	return sr.trailer
+}
+
+func (sr *snapshotReader) readFrame() (byte, []byte, error) {
+	header := make([]byte, frameHeaderSize)
+	_, err := io.ReadFull(sr.reader, header)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w while reading the frame header", convertEOF(err))
+	}
+
+	payloadSize := binary.BigEndian.Uint32(header[1:])
+	if payloadSize > maxFrameSizeInBytes {
+		return 0, nil, fmt.Errorf("%w: %d bytes", ErrFrameTooLarge, payloadSize)
+	}
+
+	payload := make([]byte, payloadSize)
+	_, err = io.ReadFull(sr.reader, payload)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w while reading the frame payload", convertEOF(err))
+	}
+
+	frameHash := make([]byte, sr.hasher.Size())
+	_, err = io.ReadFull(sr.reader, frameHash)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w while reading the frame hash", convertEOF(err))
+	}
+
+	if !bytes.Equal(frameHash, sr.hasher.Compute(string(payload))) {
+		return 0, nil, fmt.Errorf("%w for frame type %d after %d chunks", ErrFrameHashMismatch, header[0], sr.numChunks)
+	}
+
+	return header[0], payload, nil
+}
+
+// convertEOF makes sure a truncated snapshot is not mistaken for a complete one
+func convertEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *snapshotReader) IsInterfaceNil() bool {
+	return sr == nil
+}
@@ -0,0 +1,128 @@
+package portableSnapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+// snapshotWriter splits the leaves in hash protected chunks and writes them, framed, after the manifest
+type snapshotWriter struct {
+	writer            *bufio.Writer
+	hasher            hashing.Hasher
+	maxLeavesPerChunk int
+	currentChunk      *Chunk
+	currentChunkSize  int
+	trailer           Trailer
+}
+
+func newSnapshotWriter(writer io.Writer, hasher hashing.Hasher, maxLeavesPerChunk int) *snapshotWriter {
+	return &snapshotWriter{
+		writer:            bufio.NewWriter(writer),
+		hasher:            hasher,
+		maxLeavesPerChunk: maxLeavesPerChunk,
+	}
+}
+
+func (sw *snapshotWriter) writeManifest(manifest *Manifest) error {
+	_, err := sw.writer.WriteString(snapshotMagic)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	return sw.writeFrame(manifestFrameType, buff)
+}
+
+func (sw *snapshotWriter) addLeaf(trieIndex uint32, rootHash []byte, leaf *Leaf) error {
+	if sw.currentChunk != nil && !sw.currentChunk.belongsTo(trieIndex, rootHash) {
+		err := sw.flushChunk()
+		if err != nil {
+			return err
+		}
+	}
+
+	if sw.currentChunk == nil {
+		sw.currentChunk = &Chunk{
+			TrieIndex: trieIndex,
+			RootHash:  rootHash,
+			Leaves:    make([]*Leaf, 0, sw.maxLeavesPerChunk),
+		}
+		sw.currentChunkSize = 0
+	}
+
+	sw.currentChunk.Leaves = append(sw.currentChunk.Leaves, leaf)
+	sw.currentChunkSize += leafEncodedSize(leaf)
+	sw.trailer.NumLeaves++
+
+	isChunkFull := len(sw.currentChunk.Leaves) >= sw.maxLeavesPerChunk || sw.currentChunkSize >= maxChunkSizeInBytes
+	if isChunkFull {
+		return sw.flushChunk()
+	}
+
+	return nil
+}
+
+func (sw *snapshotWriter) flushChunk() error {
+	if sw.currentChunk == nil {
+		return nil
+	}
+
+	buff := sw.currentChunk.encode()
+	sw.currentChunk = nil
+	sw.trailer.NumChunks++
+
+	return sw.writeFrame(chunkFrameType, buff)
+}
+
+func (sw *snapshotWriter) markDataTrieWritten() {
+	sw.trailer.NumDataTries++
+}
+
+func (sw *snapshotWriter) close() (*Trailer, error) {
+	err := sw.flushChunk()
+	if err != nil {
+		return nil, err
+	}
+
+	buff, err := json.Marshal(&sw.trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sw.writeFrame(trailerFrameType, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	trailer := sw.trailer
+
+	return &trailer, sw.writer.Flush()
+}
+
+func (sw *snapshotWriter) writeFrame(frameType byte, payload []byte) error {
+	header := make([]byte, frameHeaderSize)
+	header[0] = frameType
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+
+	_, err := sw.writer.Write(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.writer.Write(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = sw.writer.Write(sw.hasher.Compute(string(payload)))
+
+	return err
+}
@@ -0,0 +1,12 @@
+package stateDiff
+
+import "errors"
+
+// ErrNilAccountDiffHandler signals that a nil account diff handler was provided
+var ErrNilAccountDiffHandler = errors.New("nil account diff handler")
+
+// ErrNilAccountDiff signals that a nil account diff was provided
+var ErrNilAccountDiff = errors.New("nil account diff")
+
+// ErrInvalidMaxKeysPerAccount signals that an invalid maximum number of data trie keys per account was provided
+var ErrInvalidMaxKeysPerAccount = errors.New("invalid maximum number of data trie keys per account")
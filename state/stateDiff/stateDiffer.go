@@ -0,0 +1,327 @@
+package stateDiff
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const (
+	// StatusAdded marks an account or a data trie key that exists only in the new state
+	StatusAdded = "added"
+	// StatusRemoved marks an account or a data trie key that exists only in the old state
+	StatusRemoved = "removed"
+	// StatusModified marks an account or a data trie key that exists in both states, with different values
+	StatusModified = "modified"
+)
+
+// KeyDiff holds a data trie key that differs between two states
+type KeyDiff struct {
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+	Status   string
+}
+
+// AccountDiff holds an account that differs between two states. OldAccount is nil for an added account and
+// NewAccount is nil for a removed account. Keys is filled only if the data tries were requested, and HasMoreKeys is set
+// if the data trie diff was stopped after the maximum number of keys per account
+type AccountDiff struct {
+	Address     []byte
+	Status      string
+	OldAccount  *accounts.UserAccountData
+	NewAccount  *accounts.UserAccountData
+	Keys        []*KeyDiff
+	HasMoreKeys bool
+}
+
+// AccountDiffHandler is called by the state differ for each different account. Returning false stops the diff
+type AccountDiffHandler func(diff *AccountDiff) bool
+
+// ArgsStateDiffer holds the arguments needed to create a state differ
+type ArgsStateDiffer struct {
+	TrieStorageManager  common.StorageManager
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	EnableEpochsHandler common.EnableEpochsHandler
+	MaxKeysPerAccount   int
+}
+
+type trieDiffer interface {
+	Diff(oldRootHash []byte, newRootHash []byte, handler trie.LeafDiffHandler) error
+	DiffAfterKey(oldRootHash []byte, newRootHash []byte, startAfterKey []byte, handler trie.LeafDiffHandler) error
+}
+
+type stateDiffer struct {
+	trieDiffer          trieDiffer
+	marshaller          marshal.Marshalizer
+	enableEpochsHandler common.EnableEpochsHandler
+	maxKeysPerAccount   int
+}
+
+// NewStateDiffer creates a new state differ, which compares two versions of the user accounts trie and, for the
+// changed accounts, their data tries
+func NewStateDiffer(args ArgsStateDiffer) (*stateDiffer, error) {
+	if check.IfNil(args.TrieStorageManager) {
+		return nil, state.ErrNilStorageManager
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, state.ErrNilEnableEpochsHandler
+	}
+	if args.MaxKeysPerAccount < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxKeysPerAccount, args.MaxKeysPerAccount)
+	}
+
+	td, err := trie.NewTrieDiffer(trie.ArgsTrieDiffer{
+		Storer:     args.TrieStorageManager,
+		Marshaller: args.Marshaller,
+		Hasher:     args.Hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateDiffer{
+		trieDiffer:          td,
+		marshaller:          args.Marshaller,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		maxKeysPerAccount:   args.MaxKeysPerAccount,
+	}, nil
+}
+
+// Diff calls the handler for each account that differs between the states with the given root hashes and is placed
+// after the provided address in the trie traversal order, so a diff can be resumed from the last reported account.
+// An empty address starts the diff from the beginning. If withDataTries is set, the data tries of the changed accounts
+// are compared as well
+func (sd *stateDiffer) Diff(
+	oldRootHash []byte,
+	newRootHash []byte,
+	startAfterAddress []byte,
+	withDataTries bool,
+	handler AccountDiffHandler,
+) error {
+	if handler == nil {
+		return ErrNilAccountDiffHandler
+	}
+
+	var errHandle error
+	err := sd.trieDiffer.DiffAfterKey(oldRootHash, newRootHash, startAfterAddress, func(leafDiff *trie.LeafDiff) bool {
+		var accountDiff *AccountDiff
+		accountDiff, errHandle = sd.createAccountDiff(leafDiff, withDataTries)
+		if errHandle != nil {
+			return false
+		}
+
+		return handler(accountDiff)
+	})
+	if err != nil {
+		return err
+	}
+
+	return errHandle
+}
+
+func (sd *stateDiffer) createAccountDiff(leafDiff *trie.LeafDiff, withDataTries bool) (*AccountDiff, error) {
+	accountDiff := &AccountDiff{
+		Address: leafDiff.Key,
+		Status:  getStatus(leafDiff.HasOld, leafDiff.HasNew),
+	}
+
+	var err error
+	if leafDiff.HasOld {
+		accountDiff.OldAccount, err = sd.unmarshalAccount(leafDiff.OldValue)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the old version of account %x", err, leafDiff.Key)
+		}
+	}
+	if leafDiff.HasNew {
+		accountDiff.NewAccount, err = sd.unmarshalAccount(leafDiff.NewValue)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the new version of account %x", err, leafDiff.Key)
+		}
+	}
+	if !withDataTries {
+		return accountDiff, nil
+	}
+
+	err = sd.DiffDataTries(accountDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountDiff, nil
+}
+
+// DiffDataTries fills the data trie keys of an account diff created without the data tries, up to the maximum number
+// of keys per account
+func (sd *stateDiffer) DiffDataTries(accountDiff *AccountDiff) error {
+	if accountDiff == nil {
+		return ErrNilAccountDiff
+	}
+
+	keys, hasMoreKeys, err := sd.diffDataTries(accountDiff.Address, accountDiff.OldAccount, accountDiff.NewAccount)
+	if err != nil {
+		return fmt.Errorf("%w for the data trie of account %x", err, accountDiff.Address)
+	}
+	accountDiff.Keys = keys
+	accountDiff.HasMoreKeys = hasMoreKeys
+
+	return nil
+}
+
+func (sd *stateDiffer) unmarshalAccount(buff []byte) (*accounts.UserAccountData, error) {
+	account := &accounts.UserAccountData{}
+	err := sd.marshaller.Unmarshal(account, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// diffDataTries returns the data trie keys that differ between the two versions of an account. A key that only moved
+// to a different trie node version, as it happens with the data trie migration, is not reported. The diff is stopped
+// once more keys than the maximum per account were found, in which case the second returned value is true and the
+// keys are truncated to the maximum
+func (sd *stateDiffer) diffDataTries(
+	address []byte,
+	oldAccount *accounts.UserAccountData,
+	newAccount *accounts.UserAccountData,
+) ([]*KeyDiff, bool, error) {
+	keys := make([]*KeyDiff, 0)
+	oldRootHash := getDataTrieRootHash(oldAccount)
+	newRootHash := getDataTrieRootHash(newAccount)
+	if bytes.Equal(oldRootHash, newRootHash) {
+		return keys, false, nil
+	}
+
+	leafParser, err := parsers.NewDataTrieLeafParser(address, sd.marshaller, sd.enableEpochsHandler)
+	if err != nil {
+		return nil, false, err
+	}
+
+	hasMoreKeys := false
+	keysIndexes := make(map[string]int)
+	var errParse error
+	err = sd.trieDiffer.Diff(oldRootHash, newRootHash, func(leafDiff *trie.LeafDiff) bool {
+		if len(keys) > sd.maxKeysPerAccount {
+			hasMoreKeys = true
+			return false
+		}
+
+		var keyDiff *KeyDiff
+		keyDiff, errParse = parseLeafDiff(leafParser, leafDiff)
+		if errParse != nil {
+			return false
+		}
+
+		index, found := keysIndexes[string(keyDiff.Key)]
+		if !found {
+			keysIndexes[string(keyDiff.Key)] = len(keys)
+			keys = append(keys, keyDiff)
+			return true
+		}
+
+		// the same key was found under a different trie key, so the two entries are merged
+		mergeKeyDiffs(keys[index], keyDiff)
+
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if errParse != nil {
+		return nil, false, errParse
+	}
+
+	changedKeys := make([]*KeyDiff, 0, len(keys))
+	for _, keyDiff := range keys {
+		if keyDiff.Status == StatusModified && bytes.Equal(keyDiff.OldValue, keyDiff.NewValue) {
+			continue
+		}
+
+		changedKeys = append(changedKeys, keyDiff)
+	}
+	if len(changedKeys) > sd.maxKeysPerAccount {
+		changedKeys = changedKeys[:sd.maxKeysPerAccount]
+		hasMoreKeys = true
+	}
+
+	return changedKeys, hasMoreKeys, nil
+}
+
+func getDataTrieRootHash(account *accounts.UserAccountData) []byte {
+	if account == nil || common.IsEmptyTrie(account.RootHash) {
+		return nil
+	}
+
+	return account.RootHash
+}
+
+func parseLeafDiff(leafParser common.TrieLeafParser, leafDiff *trie.LeafDiff) (*KeyDiff, error) {
+	keyDiff := &KeyDiff{
+		Status: getStatus(leafDiff.HasOld, leafDiff.HasNew),
+	}
+	if leafDiff.HasOld {
+		keyValue, err := leafParser.ParseLeaf(leafDiff.Key, leafDiff.OldValue, leafDiff.OldVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		keyDiff.Key = keyValue.Key()
+		keyDiff.OldValue = keyValue.Value()
+	}
+	if leafDiff.HasNew {
+		keyValue, err := leafParser.ParseLeaf(leafDiff.Key, leafDiff.NewValue, leafDiff.NewVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		keyDiff.Key = keyValue.Key()
+		keyDiff.NewValue = keyValue.Value()
+	}
+
+	return keyDiff, nil
+}
+
+func mergeKeyDiffs(existing *KeyDiff, other *KeyDiff) {
+	hasOld := existing.Status != StatusAdded || other.Status != StatusAdded
+	hasNew := existing.Status != StatusRemoved || other.Status != StatusRemoved
+	if other.Status != StatusAdded {
+		existing.OldValue = other.OldValue
+	}
+	if other.Status != StatusRemoved {
+		existing.NewValue = other.NewValue
+	}
+	existing.Status = getStatus(hasOld, hasNew)
+}
+
+func getStatus(hasOld bool, hasNew bool) string {
+	if !hasOld {
+		return StatusAdded
+	}
+	if !hasNew {
+		return StatusRemoved
+	}
+
+	return StatusModified
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *stateDiffer) IsInterfaceNil() bool {
+	return sd == nil
+}
@@ -0,0 +1,389 @@
+package stateDiff
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type versionedTrie interface {
+	UpdateWithVersion(key []byte, value []byte, version core.TrieNodeVersion) error
+}
+
+type testState struct {
+	t                   *testing.T
+	storageManager      common.StorageManager
+	mainTrie            common.Trie
+	marshaller          *marshallerMock.MarshalizerMock
+	hasher              *hashingMocks.HasherMock
+	dataTriesRootHashes map[string][]byte
+}
+
+func newTestState(t *testing.T) *testState {
+	args := storageMock.GetStorageManagerArgs()
+	args.Marshalizer = &marshallerMock.MarshalizerMock{}
+	args.Hasher = &hashingMocks.HasherMock{}
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	ts := &testState{
+		t:                   t,
+		storageManager:      storageManager,
+		marshaller:          &marshallerMock.MarshalizerMock{},
+		hasher:              &hashingMocks.HasherMock{},
+		dataTriesRootHashes: make(map[string][]byte),
+	}
+	ts.mainTrie = ts.newTrie()
+
+	return ts
+}
+
+func (ts *testState) newTrie() common.Trie {
+	tr, err := trie.NewTrie(ts.storageManager, ts.marshaller, ts.hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(ts.t, err)
+
+	return tr
+}
+
+func (ts *testState) recreateTrie(rootHash []byte) common.Trie {
+	if len(rootHash) == 0 {
+		return ts.newTrie()
+	}
+
+	tr, err := ts.mainTrie.Recreate(rootHash)
+	require.Nil(ts.t, err)
+
+	return tr
+}
+
+// saveAccount writes the account and the given data trie keys. The keys with an empty value are removed from the
+// data trie, and the keys are written with the auto balance version only if migrate is set
+func (ts *testState) saveAccount(address string, balance int64, keys map[string]string, migrate bool) {
+	dataTrie := ts.recreateTrie(ts.dataTriesRootHashes[address])
+	for key, value := range keys {
+		if len(value) == 0 {
+			require.Nil(ts.t, dataTrie.Delete([]byte(key)))
+			require.Nil(ts.t, dataTrie.Delete(ts.hasher.Compute(key)))
+			continue
+		}
+
+		if !migrate {
+			require.Nil(ts.t, dataTrie.Update([]byte(key), []byte(value+key+address)))
+			continue
+		}
+
+		leafData, err := ts.marshaller.Marshal(&dataTrieValue.TrieLeafData{
+			Key:     []byte(key),
+			Value:   []byte(value),
+			Address: []byte(address),
+		})
+		require.Nil(ts.t, err)
+		require.Nil(ts.t, dataTrie.Delete([]byte(key)))
+		require.Nil(ts.t, dataTrie.(versionedTrie).UpdateWithVersion(ts.hasher.Compute(key), leafData, core.AutoBalanceEnabled))
+	}
+	require.Nil(ts.t, dataTrie.Commit())
+	dataTrieRootHash, err := dataTrie.RootHash()
+	require.Nil(ts.t, err)
+	ts.dataTriesRootHashes[address] = dataTrieRootHash
+
+	accountBytes, err := ts.marshaller.Marshal(&accounts.UserAccountData{
+		Balance:  big.NewInt(balance),
+		RootHash: dataTrieRootHash,
+		Address:  []byte(address),
+	})
+	require.Nil(ts.t, err)
+	require.Nil(ts.t, ts.mainTrie.Update([]byte(address), accountBytes))
+}
+
+func (ts *testState) removeAccount(address string) {
+	require.Nil(ts.t, ts.mainTrie.Delete([]byte(address)))
+	delete(ts.dataTriesRootHashes, address)
+}
+
+func (ts *testState) commit() []byte {
+	require.Nil(ts.t, ts.mainTrie.Commit())
+	rootHash, err := ts.mainTrie.RootHash()
+	require.Nil(ts.t, err)
+
+	return rootHash
+}
+
+func createMockArgsStateDiffer(ts *testState) ArgsStateDiffer {
+	return ArgsStateDiffer{
+		TrieStorageManager: ts.storageManager,
+		Marshaller:         ts.marshaller,
+		Hasher:             ts.hasher,
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsAutoBalanceDataTriesEnabledField: true,
+		},
+		MaxKeysPerAccount: 100,
+	}
+}
+
+func collectAccountDiffs(t *testing.T, sd *stateDiffer, oldRootHash []byte, newRootHash []byte, withDataTries bool) map[string]*AccountDiff {
+	diffs := make(map[string]*AccountDiff)
+	err := sd.Diff(oldRootHash, newRootHash, nil, withDataTries, func(diff *AccountDiff) bool {
+		diffs[string(diff.Address)] = diff
+		return true
+	})
+	require.Nil(t, err)
+
+	return diffs
+}
+
+func getKeyDiff(diff *AccountDiff, key string) *KeyDiff {
+	for _, keyDiff := range diff.Keys {
+		if string(keyDiff.Key) == key {
+			return keyDiff
+		}
+	}
+
+	return nil
+}
+
+func TestNewStateDiffer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer(newTestState(t))
+		args.TrieStorageManager = nil
+		sd, err := NewStateDiffer(args)
+		assert.Equal(t, state.ErrNilStorageManager, err)
+		assert.Nil(t, sd)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer(newTestState(t))
+		args.Marshaller = nil
+		sd, err := NewStateDiffer(args)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+		assert.Nil(t, sd)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer(newTestState(t))
+		args.Hasher = nil
+		sd, err := NewStateDiffer(args)
+		assert.Equal(t, state.ErrNilHasher, err)
+		assert.Nil(t, sd)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer(newTestState(t))
+		args.EnableEpochsHandler = nil
+		sd, err := NewStateDiffer(args)
+		assert.Equal(t, state.ErrNilEnableEpochsHandler, err)
+		assert.Nil(t, sd)
+	})
+	t.Run("invalid maximum number of keys per account should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateDiffer(newTestState(t))
+		args.MaxKeysPerAccount = 0
+		sd, err := NewStateDiffer(args)
+		assert.True(t, errors.Is(err, ErrInvalidMaxKeysPerAccount))
+		assert.Nil(t, sd)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sd, err := NewStateDiffer(createMockArgsStateDiffer(newTestState(t)))
+		assert.Nil(t, err)
+		assert.False(t, sd.IsInterfaceNil())
+	})
+}
+
+func TestStateDiffer_DiffDataTries(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewStateDiffer(createMockArgsStateDiffer(newTestState(t)))
+	err := sd.DiffDataTries(nil)
+	assert.Equal(t, ErrNilAccountDiff, err)
+}
+
+func TestStateDiffer_Diff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		sd, _ := NewStateDiffer(createMockArgsStateDiffer(newTestState(t)))
+		err := sd.Diff(nil, nil, nil, true, nil)
+		assert.Equal(t, ErrNilAccountDiffHandler, err)
+	})
+	t.Run("should report the added, removed and modified accounts and keys", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		for i := 0; i < 20; i++ {
+			ts.saveAccount(fmt.Sprintf("address%d", i), int64(i), map[string]string{"key": "value", "other key": "other value"}, false)
+		}
+		oldRootHash := ts.commit()
+
+		ts.saveAccount("address1", 100, nil, false)
+		ts.saveAccount("address2", 2, map[string]string{"key": "modified", "other key": "", "new key": "new value"}, false)
+		ts.removeAccount("address3")
+		ts.saveAccount("new address", 5, map[string]string{"key": "value"}, false)
+		newRootHash := ts.commit()
+
+		sd, _ := NewStateDiffer(createMockArgsStateDiffer(ts))
+		diffs := collectAccountDiffs(t, sd, oldRootHash, newRootHash, true)
+		require.Equal(t, 4, len(diffs))
+
+		balanceChanged := diffs["address1"]
+		assert.Equal(t, StatusModified, balanceChanged.Status)
+		assert.Equal(t, big.NewInt(1), balanceChanged.OldAccount.Balance)
+		assert.Equal(t, big.NewInt(100), balanceChanged.NewAccount.Balance)
+		assert.Empty(t, balanceChanged.Keys)
+
+		storageChanged := diffs["address2"]
+		assert.Equal(t, StatusModified, storageChanged.Status)
+		require.Equal(t, 3, len(storageChanged.Keys))
+		assert.Equal(t, &KeyDiff{Key: []byte("key"), OldValue: []byte("value"), NewValue: []byte("modified"), Status: StatusModified}, getKeyDiff(storageChanged, "key"))
+		assert.Equal(t, &KeyDiff{Key: []byte("other key"), OldValue: []byte("other value"), Status: StatusRemoved}, getKeyDiff(storageChanged, "other key"))
+		assert.Equal(t, &KeyDiff{Key: []byte("new key"), NewValue: []byte("new value"), Status: StatusAdded}, getKeyDiff(storageChanged, "new key"))
+
+		removed := diffs["address3"]
+		assert.Equal(t, StatusRemoved, removed.Status)
+		assert.NotNil(t, removed.OldAccount)
+		assert.Nil(t, removed.NewAccount)
+		assert.Equal(t, 2, len(removed.Keys))
+		assert.Equal(t, StatusRemoved, getKeyDiff(removed, "key").Status)
+
+		added := diffs["new address"]
+		assert.Equal(t, StatusAdded, added.Status)
+		assert.Nil(t, added.OldAccount)
+		assert.NotNil(t, added.NewAccount)
+		require.Equal(t, 1, len(added.Keys))
+		assert.Equal(t, &KeyDiff{Key: []byte("key"), NewValue: []byte("value"), Status: StatusAdded}, added.Keys[0])
+
+		diffs = collectAccountDiffs(t, sd, oldRootHash, newRootHash, false)
+		require.Equal(t, 4, len(diffs))
+		assert.Nil(t, diffs["address2"].Keys)
+		require.Nil(t, sd.DiffDataTries(diffs["address2"]))
+		assert.Equal(t, 3, len(diffs["address2"].Keys))
+	})
+	t.Run("migrated data trie keys should be reported only if their value changed", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		ts.saveAccount("address", 1, map[string]string{"key": "value", "other key": "other value"}, false)
+		oldRootHash := ts.commit()
+
+		ts.saveAccount("address", 1, map[string]string{"key": "value", "other key": "modified"}, true)
+		newRootHash := ts.commit()
+
+		sd, _ := NewStateDiffer(createMockArgsStateDiffer(ts))
+		diffs := collectAccountDiffs(t, sd, oldRootHash, newRootHash, true)
+		require.Equal(t, 1, len(diffs))
+		keys := diffs["address"].Keys
+		require.Equal(t, 1, len(keys))
+		assert.Equal(t, &KeyDiff{Key: []byte("other key"), OldValue: []byte("other value"), NewValue: []byte("modified"), Status: StatusModified}, keys[0])
+	})
+	t.Run("data trie keys should be limited per account", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		oldRootHash := ts.commit()
+		data := make(map[string]string)
+		for i := 0; i < 10; i++ {
+			data[fmt.Sprintf("key%d", i)] = "value"
+		}
+		ts.saveAccount("large", 1, data, false)
+		ts.saveAccount("small", 1, map[string]string{"key": "value"}, false)
+		newRootHash := ts.commit()
+
+		args := createMockArgsStateDiffer(ts)
+		args.MaxKeysPerAccount = 3
+		sd, _ := NewStateDiffer(args)
+		diffs := collectAccountDiffs(t, sd, oldRootHash, newRootHash, true)
+		assert.Len(t, diffs["large"].Keys, 3)
+		assert.True(t, diffs["large"].HasMoreKeys)
+		assert.Len(t, diffs["small"].Keys, 1)
+		assert.False(t, diffs["small"].HasMoreKeys)
+	})
+	t.Run("diff should be resumed after the provided address", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		for i := 0; i < 20; i++ {
+			ts.saveAccount(fmt.Sprintf("address%d", i), int64(i), nil, false)
+		}
+		rootHash := ts.commit()
+
+		sd, _ := NewStateDiffer(createMockArgsStateDiffer(ts))
+		allAddresses := make([]string, 0)
+		err := sd.Diff(nil, rootHash, nil, false, func(diff *AccountDiff) bool {
+			allAddresses = append(allAddresses, string(diff.Address))
+			return true
+		})
+		require.Nil(t, err)
+		require.Len(t, allAddresses, 20)
+
+		resumedAddresses := make([]string, 0)
+		err = sd.Diff(nil, rootHash, []byte(allAddresses[11]), false, func(diff *AccountDiff) bool {
+			resumedAddresses = append(resumedAddresses, string(diff.Address))
+			return true
+		})
+		require.Nil(t, err)
+		assert.Equal(t, allAddresses[12:], resumedAddresses)
+	})
+	t.Run("handler returning false should stop the diff", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		for i := 0; i < 20; i++ {
+			ts.saveAccount(fmt.Sprintf("address%d", i), int64(i), nil, false)
+		}
+		rootHash := ts.commit()
+
+		sd, _ := NewStateDiffer(createMockArgsStateDiffer(ts))
+		numCalls := 0
+		err := sd.Diff(nil, rootHash, nil, true, func(diff *AccountDiff) bool {
+			numCalls++
+			return false
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("invalid account should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		require.Nil(t, ts.mainTrie.Update([]byte("address"), []byte("not an account")))
+		rootHash := ts.commit()
+
+		args := createMockArgsStateDiffer(ts)
+		expectedErr := errors.New("expected error")
+		args.Marshaller = &marshallerMock.MarshalizerStub{
+			UnmarshalCalled: func(obj interface{}, buff []byte) error {
+				return expectedErr
+			},
+		}
+		sd, _ := NewStateDiffer(args)
+		err := sd.Diff(nil, rootHash, nil, false, func(diff *AccountDiff) bool {
+			assert.Fail(t, "should not have been called")
+			return true
+		})
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+}
@@ -0,0 +1,9 @@
+package storageReport
+
+import "errors"
+
+// ErrInvalidNumTop signals that an invalid length of the top lists was provided
+var ErrInvalidNumTop = errors.New("invalid number of top entries")
+
+// ErrMissingCode signals that the code of a smart contract was not found in the accounts trie
+var ErrMissingCode = errors.New("missing code")
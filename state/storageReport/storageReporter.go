@@ -0,0 +1,416 @@
+package storageReport
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/storageReport")
+
+const kilobyte = 1024
+
+// codeSizeBucketsBounds holds the upper bounds of the code size histogram buckets, the last bucket having no upper bound
+var codeSizeBucketsBounds = []uint64{kilobyte, 4 * kilobyte, 16 * kilobyte, 64 * kilobyte, 256 * kilobyte, 1024 * kilobyte}
+
+var esdtKeyPrefix = []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+// ArgsStorageReporter holds the arguments needed to create a storage reporter
+type ArgsStorageReporter struct {
+	Marshaller          marshal.Marshalizer
+	EnableEpochsHandler common.EnableEpochsHandler
+	AddressConverter    core.PubkeyConverter
+}
+
+type storageReporter struct {
+	marshaller          marshal.Marshalizer
+	enableEpochsHandler common.EnableEpochsHandler
+	addressConverter    core.PubkeyConverter
+}
+
+// reportBuilder holds the partial results of a single report
+type reportBuilder struct {
+	*storageReporter
+	mainTrie          common.Trie
+	trieStats         common.TrieStats
+	numTop            int
+	report            *common.StorageReport
+	dataTriesStats    common.TrieStatisticsHandler
+	codeSizes         map[string]uint64
+	tokens            map[string]*common.TokenStorageReport
+	codeSizeHistogram []*common.CodeSizeBucket
+}
+
+// NewStorageReporter creates a new storage reporter, which reports the storage used by the accounts of a user
+// accounts trie: the largest data tries, the storage of each smart contract, the code sizes and the ESDT keys per token
+func NewStorageReporter(args ArgsStorageReporter) (*storageReporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, state.ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, state.ErrNilAddressConverter
+	}
+
+	return &storageReporter{
+		marshaller:          args.Marshaller,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		addressConverter:    args.AddressConverter,
+	}, nil
+}
+
+// Report walks the given user accounts trie and the data tries of all its accounts. The top lists hold at most numTop
+// entries each. The walk is long for a large state, so it can be cancelled through the provided context
+func (sr *storageReporter) Report(ctx context.Context, mainTrie common.Trie, numTop int) (*common.StorageReport, error) {
+	if check.IfNil(mainTrie) {
+		return nil, state.ErrNilTrie
+	}
+	if numTop < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumTop, numTop)
+	}
+	trieStats, ok := mainTrie.(common.TrieStats)
+	if !ok {
+		return nil, fmt.Errorf("invalid trie, type is %T", mainTrie)
+	}
+	rootHash, err := mainTrie.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	rb := &reportBuilder{
+		storageReporter: sr,
+		mainTrie:        mainTrie,
+		trieStats:       trieStats,
+		numTop:          numTop,
+		report: &common.StorageReport{
+			RootHash:            hex.EncodeToString(rootHash),
+			TopDataTriesBySize:  make([]*common.DataTrieStorageReport, 0, numTop),
+			TopDataTriesByDepth: make([]*common.DataTrieStorageReport, 0, numTop),
+			TopContracts:        make([]*common.ContractStorageReport, 0, numTop),
+		},
+		dataTriesStats:    statistics.NewTrieStatistics(),
+		codeSizes:         make(map[string]uint64),
+		tokens:            make(map[string]*common.TokenStorageReport),
+		codeSizeHistogram: createCodeSizeHistogram(),
+	}
+
+	err = rb.build(ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return rb.report, nil
+}
+
+func createCodeSizeHistogram() []*common.CodeSizeBucket {
+	histogram := make([]*common.CodeSizeBucket, 0, len(codeSizeBucketsBounds)+1)
+	minSize := uint64(0)
+	for _, maxSize := range codeSizeBucketsBounds {
+		histogram = append(histogram, &common.CodeSizeBucket{
+			MinSize: minSize,
+			MaxSize: maxSize,
+		})
+		minSize = maxSize
+	}
+
+	return append(histogram, &common.CodeSizeBucket{
+		MinSize: minSize,
+	})
+}
+
+func (rb *reportBuilder) build(ctx context.Context, rootHash []byte) error {
+	rb.report.CodeSizeHistogram = rb.codeSizeHistogram
+	rb.report.TopTokens = make([]*common.TokenStorageReport, 0)
+	if common.IsEmptyTrie(rootHash) {
+		return nil
+	}
+
+	mainTrieStats, err := rb.trieStats.GetTrieStats("", rootHash)
+	if err != nil {
+		return err
+	}
+	rb.report.MainTrieSize = mainTrieStats.GetTotalNodesSize()
+	rb.report.MainTrieNumNodes = mainTrieStats.GetTotalNumNodes()
+	rb.report.MainTrieMaxDepth = mainTrieStats.GetMaxTrieDepth()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = rb.mainTrie.GetAllLeavesOnChannel(iteratorChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return err
+	}
+
+	for leaf := range iteratorChannels.LeavesChan {
+		account := &accounts.UserAccountData{}
+		errUnmarshal := rb.marshaller.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			// the codes are saved in the same trie as the accounts
+			continue
+		}
+
+		err = rb.addAccount(ctx, leaf.Key(), account)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return err
+	}
+	err = ctx.Err()
+	if err != nil {
+		return err
+	}
+
+	rb.report.DataTriesSize = rb.dataTriesStats.GetTotalNodesSize()
+	rb.report.DataTriesNumNodes = rb.dataTriesStats.GetTotalNumNodes()
+	rb.report.TopTokens = rb.getTopTokens()
+
+	return nil
+}
+
+func (rb *reportBuilder) addAccount(ctx context.Context, address []byte, account *accounts.UserAccountData) error {
+	rb.report.NumAccounts++
+
+	encodedAddress := rb.addressConverter.SilentEncode(address, log)
+	contract := &common.ContractStorageReport{
+		Address: encodedAddress,
+	}
+	if !common.IsEmptyTrie(account.RootHash) {
+		err := rb.addDataTrie(ctx, address, encodedAddress, account.RootHash, contract)
+		if err != nil {
+			return fmt.Errorf("%w for the data trie of account %s", err, encodedAddress)
+		}
+	}
+	if len(account.CodeHash) == 0 {
+		return nil
+	}
+
+	codeSize, err := rb.getCodeSize(account.CodeHash)
+	if err != nil {
+		return fmt.Errorf("%w for contract %s", err, encodedAddress)
+	}
+
+	rb.report.NumContracts++
+	rb.addToCodeSizeHistogram(codeSize)
+	contract.CodeSize = codeSize
+	contract.StorageSize = contract.CodeSize + contract.DataTrieSize
+	rb.report.TopContracts = insertContractStorageReport(rb.report.TopContracts, contract, rb.numTop)
+
+	return nil
+}
+
+// addDataTrie collects the statistics and the ESDT keys of a data trie. The size and the keys of the data trie are
+// also set in the given contract report, which is used only if the account is a smart contract
+func (rb *reportBuilder) addDataTrie(
+	ctx context.Context,
+	address []byte,
+	encodedAddress string,
+	rootHash []byte,
+	contract *common.ContractStorageReport,
+) error {
+	trieStats, err := rb.trieStats.GetTrieStats(encodedAddress, rootHash)
+	if err != nil {
+		return err
+	}
+	rb.report.NumDataTries++
+	rb.dataTriesStats.MergeTriesStatistics(trieStats)
+
+	dataTrie := &common.DataTrieStorageReport{
+		Address:   encodedAddress,
+		RootHash:  hex.EncodeToString(rootHash),
+		Size:      trieStats.GetTotalNodesSize(),
+		NumNodes:  trieStats.GetTotalNumNodes(),
+		NumLeaves: trieStats.GetNumLeafNodes(),
+		MaxDepth:  trieStats.GetMaxTrieDepth(),
+	}
+	rb.report.TopDataTriesBySize = insertDataTrieStorageReport(rb.report.TopDataTriesBySize, dataTrie, rb.numTop, isSmallerDataTrie)
+	rb.report.TopDataTriesByDepth = insertDataTrieStorageReport(rb.report.TopDataTriesByDepth, dataTrie, rb.numTop, isShallowerDataTrie)
+	contract.DataTrieSize = dataTrie.Size
+
+	leafParser, err := parsers.NewDataTrieLeafParser(address, rb.marshaller, rb.enableEpochsHandler)
+	if err != nil {
+		return err
+	}
+
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = rb.mainTrie.GetAllLeavesOnChannel(iteratorChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), leafParser)
+	if err != nil {
+		return err
+	}
+
+	accountTokens := make(map[string]struct{})
+	for leaf := range iteratorChannels.LeavesChan {
+		keySize := uint64(len(leaf.Key()) + len(leaf.Value()))
+		contract.NumKeys++
+		contract.KeysSize += keySize
+
+		if !bytes.HasPrefix(leaf.Key(), esdtKeyPrefix) {
+			continue
+		}
+
+		tokenID, _ := common.ExtractTokenIDAndNonceFromTokenStorageKey(leaf.Key()[len(esdtKeyPrefix):])
+		token, found := rb.tokens[string(tokenID)]
+		if !found {
+			token = &common.TokenStorageReport{
+				Token: string(tokenID),
+			}
+			rb.tokens[string(tokenID)] = token
+		}
+		token.NumKeys++
+		token.KeysSize += keySize
+
+		_, found = accountTokens[string(tokenID)]
+		if !found {
+			accountTokens[string(tokenID)] = struct{}{}
+			token.NumAccounts++
+		}
+	}
+
+	return iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+}
+
+// getCodeSize returns the size of the code with the given hash, each code being read only once from the trie
+func (rb *reportBuilder) getCodeSize(codeHash []byte) (uint64, error) {
+	codeSize, found := rb.codeSizes[string(codeHash)]
+	if found {
+		return codeSize, nil
+	}
+
+	codeEntryBytes, _, err := rb.mainTrie.Get(codeHash)
+	if err != nil {
+		return 0, err
+	}
+	if len(codeEntryBytes) == 0 {
+		return 0, fmt.Errorf("%w with hash %x", ErrMissingCode, codeHash)
+	}
+
+	codeEntry := &state.CodeEntry{}
+	err = rb.marshaller.Unmarshal(codeEntry, codeEntryBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	codeSize = uint64(len(codeEntry.Code))
+	rb.codeSizes[string(codeHash)] = codeSize
+	rb.report.NumCodes++
+	rb.report.CodeSize += codeSize
+
+	return codeSize, nil
+}
+
+func (rb *reportBuilder) addToCodeSizeHistogram(codeSize uint64) {
+	for _, bucket := range rb.codeSizeHistogram {
+		if bucket.MaxSize == 0 || codeSize < bucket.MaxSize {
+			bucket.NumContracts++
+			return
+		}
+	}
+}
+
+func (rb *reportBuilder) getTopTokens() []*common.TokenStorageReport {
+	tokens := make([]*common.TokenStorageReport, 0, len(rb.tokens))
+	for _, token := range rb.tokens {
+		tokens = append(tokens, token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].NumKeys != tokens[j].NumKeys {
+			return tokens[i].NumKeys > tokens[j].NumKeys
+		}
+
+		return tokens[i].Token < tokens[j].Token
+	})
+	if len(tokens) > rb.numTop {
+		tokens = tokens[:rb.numTop]
+	}
+
+	return tokens
+}
+
+func isSmallerDataTrie(a *common.DataTrieStorageReport, b *common.DataTrieStorageReport) bool {
+	return a.Size < b.Size
+}
+
+func isShallowerDataTrie(a *common.DataTrieStorageReport, b *common.DataTrieStorageReport) bool {
+	return a.MaxDepth < b.MaxDepth
+}
+
+// insertDataTrieStorageReport inserts the entry in the list sorted in descending order, keeping at most numTop entries.
+// An entry equal to an existing one is inserted after it, so the first found entries are kept
+func insertDataTrieStorageReport(
+	list []*common.DataTrieStorageReport,
+	entry *common.DataTrieStorageReport,
+	numTop int,
+	isLess func(a *common.DataTrieStorageReport, b *common.DataTrieStorageReport) bool,
+) []*common.DataTrieStorageReport {
+	index := sort.Search(len(list), func(i int) bool {
+		return isLess(list[i], entry)
+	})
+	if index >= numTop {
+		return list
+	}
+
+	list = append(list, nil)
+	copy(list[index+1:], list[index:])
+	list[index] = entry
+	if len(list) > numTop {
+		list = list[:numTop]
+	}
+
+	return list
+}
+
+// insertContractStorageReport inserts the entry in the list sorted by the storage size in descending order, keeping
+// at most numTop entries
+func insertContractStorageReport(
+	list []*common.ContractStorageReport,
+	entry *common.ContractStorageReport,
+	numTop int,
+) []*common.ContractStorageReport {
+	index := sort.Search(len(list), func(i int) bool {
+		return list[i].StorageSize < entry.StorageSize
+	})
+	if index >= numTop {
+		return list
+	}
+
+	list = append(list, nil)
+	copy(list[index+1:], list[index:])
+	list[index] = entry
+	if len(list) > numTop {
+		list = list[:numTop]
+	}
+
+	return list
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sr *storageReporter) IsInterfaceNil() bool {
+	return sr == nil
+}
@@ -0,0 +1,387 @@
+package storageReport
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userAddress       = "user address                    "
+	bigContract       = "big contract                    "
+	clonedContract    = "cloned contract                 "
+	largeCodeContract = "large code contract             "
+	fungibleToken     = "TKN-abcdef"
+	nftCollection     = "NFT-123456"
+)
+
+type testState struct {
+	t          *testing.T
+	mainTrie   common.Trie
+	marshaller marshal.Marshalizer
+	hasher     *hashingMocks.HasherMock
+}
+
+func newTestState(t *testing.T) *testState {
+	ts := &testState{
+		t:          t,
+		marshaller: &marshal.GogoProtoMarshalizer{},
+		hasher:     &hashingMocks.HasherMock{},
+	}
+
+	args := storageMock.GetStorageManagerArgs()
+	args.Marshalizer = ts.marshaller
+	args.Hasher = ts.hasher
+	storageManager, err := trie.NewTrieStorageManager(args)
+	require.Nil(t, err)
+
+	ts.mainTrie, err = trie.NewTrie(storageManager, ts.marshaller, ts.hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return ts
+}
+
+func (ts *testState) saveCode(code []byte) []byte {
+	codeHash := ts.hasher.Compute(string(code))
+	codeEntry, err := ts.marshaller.Marshal(&state.CodeEntry{
+		Code:          code,
+		NumReferences: 1,
+	})
+	require.Nil(ts.t, err)
+	require.Nil(ts.t, ts.mainTrie.Update(codeHash, codeEntry))
+
+	return codeHash
+}
+
+func (ts *testState) saveAccount(address string, codeHash []byte, keys map[string]string) {
+	dataTrieRootHash := []byte(nil)
+	if len(keys) > 0 {
+		dataTrie, err := ts.mainTrie.Recreate(common.EmptyTrieHash)
+		require.Nil(ts.t, err)
+		for key, value := range keys {
+			require.Nil(ts.t, dataTrie.Update([]byte(key), []byte(value+key+address)))
+		}
+		require.Nil(ts.t, dataTrie.Commit())
+		dataTrieRootHash, err = dataTrie.RootHash()
+		require.Nil(ts.t, err)
+	}
+
+	accountBytes, err := ts.marshaller.Marshal(&accounts.UserAccountData{
+		Balance:  big.NewInt(1),
+		CodeHash: codeHash,
+		RootHash: dataTrieRootHash,
+		Address:  []byte(address),
+	})
+	require.Nil(ts.t, err)
+	require.Nil(ts.t, ts.mainTrie.Update([]byte(address), accountBytes))
+}
+
+func (ts *testState) commit() common.Trie {
+	require.Nil(ts.t, ts.mainTrie.Commit())
+	rootHash, err := ts.mainTrie.RootHash()
+	require.Nil(ts.t, err)
+	tr, err := ts.mainTrie.Recreate(rootHash)
+	require.Nil(ts.t, err)
+
+	return tr
+}
+
+func esdtKey(token string) string {
+	return core.ProtectedKeyPrefix + core.ESDTKeyIdentifier + token
+}
+
+func createTestState(t *testing.T) *testState {
+	ts := newTestState(t)
+
+	smallCodeHash := ts.saveCode(bytes.Repeat([]byte("c"), 500))
+	largeCodeHash := ts.saveCode(bytes.Repeat([]byte("C"), 5000))
+
+	ts.saveAccount(userAddress, nil, map[string]string{
+		esdtKey(fungibleToken):          "balance",
+		esdtKey(nftCollection) + "\x01": "nft 1",
+		esdtKey(nftCollection) + "\x02": "nft 2",
+		esdtKey(nftCollection) + "\x03": "nft 3",
+		"not an esdt key":               "value",
+	})
+
+	bigContractKeys := make(map[string]string)
+	for i := 0; i < 50; i++ {
+		bigContractKeys[fmt.Sprintf("key%d", i)] = fmt.Sprintf("value%d", i)
+	}
+	ts.saveAccount(bigContract, smallCodeHash, bigContractKeys)
+	ts.saveAccount(clonedContract, smallCodeHash, nil)
+	ts.saveAccount(largeCodeContract, largeCodeHash, map[string]string{
+		esdtKey(fungibleToken): "balance",
+	})
+
+	return ts
+}
+
+func createMockArgsStorageReporter() ArgsStorageReporter {
+	return ArgsStorageReporter{
+		Marshaller:          &marshal.GogoProtoMarshalizer{},
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		AddressConverter: &testscommon.PubkeyConverterStub{
+			SilentEncodeCalled: func(pkBytes []byte, log core.Logger) string {
+				return string(pkBytes)
+			},
+		},
+	}
+}
+
+func TestNewStorageReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageReporter()
+		args.Marshaller = nil
+		sr, err := NewStorageReporter(args)
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+		assert.Nil(t, sr)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageReporter()
+		args.EnableEpochsHandler = nil
+		sr, err := NewStorageReporter(args)
+		assert.Equal(t, state.ErrNilEnableEpochsHandler, err)
+		assert.Nil(t, sr)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageReporter()
+		args.AddressConverter = nil
+		sr, err := NewStorageReporter(args)
+		assert.Equal(t, state.ErrNilAddressConverter, err)
+		assert.Nil(t, sr)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sr, err := NewStorageReporter(createMockArgsStorageReporter())
+		assert.Nil(t, err)
+		assert.False(t, sr.IsInterfaceNil())
+	})
+}
+
+func TestStorageReporter_Report(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+		report, err := sr.Report(context.Background(), nil, 10)
+		assert.Equal(t, state.ErrNilTrie, err)
+		assert.Nil(t, report)
+
+		report, err = sr.Report(context.Background(), newTestState(t).mainTrie, 0)
+		assert.True(t, errors.Is(err, ErrInvalidNumTop))
+		assert.Nil(t, report)
+	})
+	t.Run("empty trie should return an empty report", func(t *testing.T) {
+		t.Parallel()
+
+		sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+		report, err := sr.Report(context.Background(), newTestState(t).mainTrie, 10)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumAccounts)
+		assert.Empty(t, report.TopDataTriesBySize)
+		assert.Empty(t, report.TopTokens)
+		assert.Equal(t, len(codeSizeBucketsBounds)+1, len(report.CodeSizeHistogram))
+	})
+	t.Run("should report the data tries, the contracts, the codes and the tokens", func(t *testing.T) {
+		t.Parallel()
+
+		tr := createTestState(t).commit()
+		sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+		report, err := sr.Report(context.Background(), tr, 10)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(4), report.NumAccounts)
+		assert.Equal(t, uint64(3), report.NumContracts)
+		assert.Equal(t, uint64(3), report.NumDataTries)
+		assert.Equal(t, uint64(2), report.NumCodes)
+		assert.Equal(t, uint64(5500), report.CodeSize)
+		assert.NotZero(t, report.MainTrieSize)
+		assert.NotZero(t, report.MainTrieNumNodes)
+		assert.NotZero(t, report.DataTriesSize)
+
+		require.Equal(t, 3, len(report.TopDataTriesBySize))
+		assert.Equal(t, bigContract, report.TopDataTriesBySize[0].Address)
+		assert.Equal(t, uint64(50), report.TopDataTriesBySize[0].NumLeaves)
+		assert.Equal(t, largeCodeContract, report.TopDataTriesBySize[2].Address)
+		require.Equal(t, 3, len(report.TopDataTriesByDepth))
+		assert.Equal(t, bigContract, report.TopDataTriesByDepth[0].Address)
+		dataTriesSize := uint64(0)
+		for _, dataTrie := range report.TopDataTriesBySize {
+			dataTriesSize += dataTrie.Size
+		}
+		assert.Equal(t, report.DataTriesSize, dataTriesSize)
+
+		require.Equal(t, 3, len(report.TopContracts))
+		contracts := make(map[string]*common.ContractStorageReport)
+		for i, contract := range report.TopContracts {
+			if i > 0 {
+				assert.GreaterOrEqual(t, report.TopContracts[i-1].StorageSize, contract.StorageSize)
+			}
+			assert.Equal(t, contract.CodeSize+contract.DataTrieSize, contract.StorageSize)
+			contracts[contract.Address] = contract
+		}
+		assert.Equal(t, uint64(50), contracts[bigContract].NumKeys)
+		assert.Equal(t, report.TopDataTriesBySize[0].Size, contracts[bigContract].DataTrieSize)
+		assert.Equal(t, &common.ContractStorageReport{
+			Address:     clonedContract,
+			StorageSize: 500,
+			CodeSize:    500,
+		}, contracts[clonedContract])
+		assert.Equal(t, uint64(1), contracts[largeCodeContract].NumKeys)
+		assert.Equal(t, uint64(len(esdtKey(fungibleToken))+len("balance")), contracts[largeCodeContract].KeysSize)
+
+		assert.Equal(t, uint64(2), report.CodeSizeHistogram[0].NumContracts)
+		assert.Equal(t, uint64(0), report.CodeSizeHistogram[1].NumContracts)
+		assert.Equal(t, uint64(1), report.CodeSizeHistogram[2].NumContracts)
+		assert.Equal(t, uint64(4*kilobyte), report.CodeSizeHistogram[2].MinSize)
+		assert.Equal(t, uint64(16*kilobyte), report.CodeSizeHistogram[2].MaxSize)
+		assert.Equal(t, uint64(0), report.CodeSizeHistogram[len(codeSizeBucketsBounds)].MaxSize)
+
+		assert.Equal(t, []*common.TokenStorageReport{
+			{
+				Token:       nftCollection,
+				NumKeys:     3,
+				NumAccounts: 1,
+				KeysSize:    3 * uint64(len(esdtKey(nftCollection))+1+len("nft 1")),
+			},
+			{
+				Token:       fungibleToken,
+				NumKeys:     2,
+				NumAccounts: 2,
+				KeysSize:    2 * uint64(len(esdtKey(fungibleToken))+len("balance")),
+			},
+		}, report.TopTokens)
+	})
+	t.Run("should keep only the top entries", func(t *testing.T) {
+		t.Parallel()
+
+		tr := createTestState(t).commit()
+		sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+		report, err := sr.Report(context.Background(), tr, 1)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(3), report.NumContracts)
+		require.Equal(t, 1, len(report.TopDataTriesBySize))
+		assert.Equal(t, bigContract, report.TopDataTriesBySize[0].Address)
+		assert.Equal(t, 1, len(report.TopDataTriesByDepth))
+		assert.Equal(t, 1, len(report.TopContracts))
+		require.Equal(t, 1, len(report.TopTokens))
+		assert.Equal(t, nftCollection, report.TopTokens[0].Token)
+	})
+	t.Run("missing code should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := newTestState(t)
+		ts.saveAccount(bigContract, []byte("missing code hash"), nil)
+		sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+		report, err := sr.Report(context.Background(), ts.commit(), 10)
+		assert.True(t, errors.Is(err, ErrMissingCode))
+		assert.Nil(t, report)
+	})
+	t.Run("cancelled context should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := createTestState(t).commit()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+		report, err := sr.Report(ctx, tr, 10)
+		assert.NotNil(t, err)
+		assert.Nil(t, report)
+	})
+}
+
+func TestInsertDataTrieStorageReport(t *testing.T) {
+	t.Parallel()
+
+	list := make([]*common.DataTrieStorageReport, 0)
+	for _, size := range []uint64{5, 1, 7, 5, 3, 9} {
+		list = insertDataTrieStorageReport(list, &common.DataTrieStorageReport{Size: size, Address: fmt.Sprint(len(list))}, 4, isSmallerDataTrie)
+	}
+
+	sizes := make([]uint64, 0, len(list))
+	for _, entry := range list {
+		sizes = append(sizes, entry.Size)
+	}
+	assert.Equal(t, []uint64{9, 7, 5, 5}, sizes)
+	// the equal entries are kept in the order they were found
+	assert.Equal(t, "0", list[2].Address)
+}
+
+func TestWriters(t *testing.T) {
+	t.Parallel()
+
+	tr := createTestState(t).commit()
+	sr, _ := NewStorageReporter(createMockArgsStorageReporter())
+	report, err := sr.Report(context.Background(), tr, 10)
+	require.Nil(t, err)
+
+	t.Run("WriteJSON", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		require.Nil(t, WriteJSON(buff, report))
+
+		decodedReport := &common.StorageReport{}
+		require.Nil(t, json.Unmarshal(buff.Bytes(), decodedReport))
+		assert.Equal(t, report, decodedReport)
+	})
+	t.Run("WriteCSV", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		require.Nil(t, WriteCSV(buff, report))
+
+		tables := strings.Split(buff.String(), "\n\n")
+		require.Equal(t, 6, len(tables))
+
+		csvReader := csv.NewReader(strings.NewReader(tables[0]))
+		csvReader.FieldsPerRecord = -1
+		summary, err := csvReader.ReadAll()
+		require.Nil(t, err)
+		require.Equal(t, 3, len(summary))
+		assert.Equal(t, []string{"summary"}, summary[0])
+		assert.Equal(t, report.RootHash, summary[2][0])
+		assert.Equal(t, "4", summary[2][1])
+
+		csvReader = csv.NewReader(strings.NewReader(tables[5]))
+		csvReader.FieldsPerRecord = -1
+		tokens, err := csvReader.ReadAll()
+		require.Nil(t, err)
+		require.Equal(t, 4, len(tokens))
+		assert.Equal(t, []string{nftCollection, "3", "1", fmt.Sprint(report.TopTokens[0].KeysSize)}, tokens[2])
+		assert.Equal(t, []string{fungibleToken, "2", "2", fmt.Sprint(report.TopTokens[1].KeysSize)}, tokens[3])
+	})
+}
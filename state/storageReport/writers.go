@@ -0,0 +1,148 @@
+package storageReport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// WriteJSON writes the report in an indented JSON format
+func WriteJSON(writer io.Writer, report *common.StorageReport) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+// WriteCSV writes the report in a CSV format, as consecutive tables separated by an empty line. Each table starts
+// with a line holding its name, followed by a header line
+func WriteCSV(writer io.Writer, report *common.StorageReport) error {
+	csvWriter := csv.NewWriter(writer)
+	tables := [][][]string{
+		getSummaryRecords(report),
+		getDataTriesRecords("top data tries by size", report.TopDataTriesBySize),
+		getDataTriesRecords("top data tries by depth", report.TopDataTriesByDepth),
+		getContractsRecords(report.TopContracts),
+		getCodeSizeHistogramRecords(report.CodeSizeHistogram),
+		getTokensRecords(report.TopTokens),
+	}
+	for i, table := range tables {
+		if i > 0 {
+			err := csvWriter.Write([]string{})
+			if err != nil {
+				return err
+			}
+		}
+
+		err := csvWriter.WriteAll(table)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getSummaryRecords(report *common.StorageReport) [][]string {
+	return [][]string{
+		{"summary"},
+		{"rootHash", "numAccounts", "numContracts", "numDataTries", "mainTrieSize", "mainTrieNumNodes",
+			"mainTrieMaxDepth", "dataTriesSize", "dataTriesNumNodes", "codeSize", "numCodes"},
+		{
+			report.RootHash,
+			formatUint(report.NumAccounts),
+			formatUint(report.NumContracts),
+			formatUint(report.NumDataTries),
+			formatUint(report.MainTrieSize),
+			formatUint(report.MainTrieNumNodes),
+			formatUint(uint64(report.MainTrieMaxDepth)),
+			formatUint(report.DataTriesSize),
+			formatUint(report.DataTriesNumNodes),
+			formatUint(report.CodeSize),
+			formatUint(report.NumCodes),
+		},
+	}
+}
+
+func getDataTriesRecords(name string, dataTries []*common.DataTrieStorageReport) [][]string {
+	records := [][]string{
+		{name},
+		{"address", "rootHash", "size", "numNodes", "numLeaves", "maxDepth"},
+	}
+	for _, dataTrie := range dataTries {
+		records = append(records, []string{
+			dataTrie.Address,
+			dataTrie.RootHash,
+			formatUint(dataTrie.Size),
+			formatUint(dataTrie.NumNodes),
+			formatUint(dataTrie.NumLeaves),
+			formatUint(uint64(dataTrie.MaxDepth)),
+		})
+	}
+
+	return records
+}
+
+func getContractsRecords(contracts []*common.ContractStorageReport) [][]string {
+	records := [][]string{
+		{"top contracts by storage size"},
+		{"address", "storageSize", "codeSize", "dataTrieSize", "numKeys", "keysSize"},
+	}
+	for _, contract := range contracts {
+		records = append(records, []string{
+			contract.Address,
+			formatUint(contract.StorageSize),
+			formatUint(contract.CodeSize),
+			formatUint(contract.DataTrieSize),
+			formatUint(contract.NumKeys),
+			formatUint(contract.KeysSize),
+		})
+	}
+
+	return records
+}
+
+func getCodeSizeHistogramRecords(histogram []*common.CodeSizeBucket) [][]string {
+	records := [][]string{
+		{"code size histogram"},
+		{"minSize", "maxSize", "numContracts"},
+	}
+	for _, bucket := range histogram {
+		maxSize := ""
+		if bucket.MaxSize > 0 {
+			maxSize = formatUint(bucket.MaxSize)
+		}
+
+		records = append(records, []string{
+			formatUint(bucket.MinSize),
+			maxSize,
+			formatUint(bucket.NumContracts),
+		})
+	}
+
+	return records
+}
+
+func getTokensRecords(tokens []*common.TokenStorageReport) [][]string {
+	records := [][]string{
+		{"top tokens by number of keys"},
+		{"token", "numKeys", "numAccounts", "keysSize"},
+	}
+	for _, token := range tokens {
+		records = append(records, []string{
+			token.Token,
+			formatUint(token.NumKeys),
+			formatUint(token.NumAccounts),
+			formatUint(token.KeysSize),
+		})
+	}
+
+	return records
+}
+
+func formatUint(value uint64) string {
+	return strconv.FormatUint(value, 10)
+}
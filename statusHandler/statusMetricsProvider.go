@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/metricsRegistry"
 )
 
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
@@ -194,6 +195,36 @@ func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() (string, erro
 	return stringBuilder.String(), nil
 }
 
+// StatusMetricsWithoutP2POpenMetricsString returns the metrics as gauges, followed by the registered histograms and
+// counters, in the OpenMetrics text format
+func (sm *statusMetrics) StatusMetricsWithoutP2POpenMetricsString() (string, error) {
+	metrics, err := sm.getMetricsWithoutP2P()
+	if err != nil {
+		return "", err
+	}
+
+	sm.mutUint64Operations.RLock()
+	shardID := sm.uint64Metrics[common.MetricShardId]
+	sm.mutUint64Operations.RUnlock()
+
+	stringBuilder := strings.Builder{}
+	for key, value := range metrics {
+		switch value.(type) {
+		case int64, uint64:
+			stringBuilder.WriteString(fmt.Sprintf("# TYPE %s gauge\n", key))
+			sm.addPrometheusMetricToStringBuilder(&stringBuilder, shardID, key, value)
+		}
+	}
+
+	registryMetrics, err := metricsRegistry.OpenMetricsString()
+	if err != nil {
+		return "", err
+	}
+	stringBuilder.WriteString(registryMetrics)
+
+	return stringBuilder.String(), nil
+}
+
 func (sm *statusMetrics) addPrometheusMetricToStringBuilder(builder *strings.Builder, shardID uint64, key string, value interface{}) {
 	// only numeric values are accepted for prometheus. return if the value is not int64 or uint64
 	switch value.(type) {
@@ -166,6 +166,24 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldComputeRound
 	assert.Contains(t, strRes, `erd_nonces_passed_in_current_epoch{erd_shard_id="2"} 38`)
 }
 
+func TestStatusMetrics_StatusMetricsWithoutP2POpenMetricsString(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	key1, value1 := "test-key7", uint64(100)
+	key2, value2 := "test-key8", "value8"
+	sm.SetUInt64Value(key1, value1)
+	sm.SetStringValue(key2, value2)
+
+	strRes, err := sm.StatusMetricsWithoutP2POpenMetricsString()
+	require.Nil(t, err)
+
+	assert.Contains(t, strRes, fmt.Sprintf("# TYPE %s gauge\n", key1))
+	assert.Contains(t, strRes, fmt.Sprintf("%s{%s=\"%d\"} %v\n", key1, common.MetricShardId, 0, value1))
+	assert.NotContains(t, strRes, key2)
+	assert.True(t, strings.HasSuffix(strRes, "# EOF\n"))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
@@ -569,7 +587,7 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 
 	for i := 0; i < numIterations; i++ {
 		go func(idx int) {
-			switch idx % 14 {
+			switch idx % 15 {
 			case 0:
 				sm.AddUint64("test", uint64(idx))
 			case 1:
@@ -598,6 +616,8 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 				_, _ = sm.StatusP2pMetricsMap()
 			case 13:
 				_, _ = sm.BootstrapMetrics()
+			case 14:
+				_, _ = sm.StatusMetricsWithoutP2POpenMetricsString()
 			}
 			wg.Done()
 		}(i)
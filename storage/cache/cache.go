@@ -39,6 +39,7 @@ type TimeCacher interface {
 // PeerBlackListCacher can determine if a certain peer id is or not blacklisted
 type PeerBlackListCacher interface {
 	Upsert(pid core.PeerID, span time.Duration) error
+	UpsertWithReason(pid core.PeerID, span time.Duration, reason string) error
 	Has(pid core.PeerID) bool
 	Sweep()
 	IsInterfaceNil() bool
@@ -61,7 +62,14 @@ func NewLRUCache(size int) (storage.Cacher, error) {
 
 // NewPeerTimeCache returns an instance of a peer time cacher
 func NewPeerTimeCache(cache TimeCacher) (PeerBlackListCacher, error) {
-	return timecache.NewPeerTimeCache(cache)
+	ptc, err := timecache.NewPeerTimeCache(cache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &peerTimeCache{
+		peerTimeCacher: ptc,
+	}, nil
 }
 
 // NewCapacityLRU constructs an LRU cache of the given size with a byte size capacity
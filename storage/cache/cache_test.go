@@ -80,6 +80,21 @@ func TestNewPeerTimeCache(t *testing.T) {
 		assert.NotNil(t, instance)
 		assert.Nil(t, err)
 	})
+	t.Run("upsert with reason should upsert in the time cache", func(t *testing.T) {
+		t.Parallel()
+
+		upsertedKeys := make(map[string]time.Duration)
+		instance, _ := NewPeerTimeCache(&testscommon.TimeCacheStub{
+			UpsertCalled: func(key string, span time.Duration) error {
+				upsertedKeys[key] = span
+				return nil
+			},
+		})
+
+		err := instance.UpsertWithReason("pid", time.Minute, "reason")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]time.Duration{"pid": time.Minute}, upsertedKeys)
+	})
 }
 
 func TestNewCapacityLRU(t *testing.T) {
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+type peerTimeCacher interface {
+	Upsert(pid core.PeerID, span time.Duration) error
+	Has(pid core.PeerID) bool
+	Sweep()
+}
+
+// peerTimeCache is the peer IDs blacklist backed by a time cache, that does not record why a peer ID was blacklisted
+type peerTimeCache struct {
+	peerTimeCacher peerTimeCacher
+}
+
+// Upsert blacklists the provided peer ID for the provided span
+func (ptc *peerTimeCache) Upsert(pid core.PeerID, span time.Duration) error {
+	return ptc.peerTimeCacher.Upsert(pid, span)
+}
+
+// UpsertWithReason blacklists the provided peer ID for the provided span, ignoring the reason
+func (ptc *peerTimeCache) UpsertWithReason(pid core.PeerID, span time.Duration, _ string) error {
+	return ptc.peerTimeCacher.Upsert(pid, span)
+}
+
+// Has returns true if the provided peer ID is blacklisted
+func (ptc *peerTimeCache) Has(pid core.PeerID) bool {
+	return ptc.peerTimeCacher.Has(pid)
+}
+
+// Sweep clears the expired peer IDs
+func (ptc *peerTimeCache) Sweep() {
+	ptc.peerTimeCacher.Sweep()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ptc *peerTimeCache) IsInterfaceNil() bool {
+	return ptc == nil
+}
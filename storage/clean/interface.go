@@ -31,3 +31,11 @@ type NodeTypeProviderHandler interface {
 	GetType() core.NodeType
 	IsInterfaceNil() bool
 }
+
+// RetentionTracker defines what a component which tracks the retention windows of the storers should do
+type RetentionTracker interface {
+	EpochStarted(epoch uint32, timestamp uint64)
+	OldestRetainedEpoch(identifier string) uint32
+	GetOldestRetainedEpochs() map[string]uint32
+	IsInterfaceNil() bool
+}
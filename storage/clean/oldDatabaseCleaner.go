@@ -27,6 +27,7 @@ type ArgsOldDatabaseCleaner struct {
 	StorageListProvider    StorageListProviderHandler
 	EpochStartNotifier     EpochStartNotifier
 	OldDataCleanerProvider OldDataCleanerProvider
+	RetentionTracker       RetentionTracker
 }
 
 type oldDatabaseCleaner struct {
@@ -37,6 +38,7 @@ type oldDatabaseCleaner struct {
 	pathRemover            func(file string) error
 	directoryReader        storage.DirectoryReaderHandler
 	oldDataCleanerProvider OldDataCleanerProvider
+	retentionTracker       RetentionTracker
 	oldestEpochsToKeep     map[uint32]uint32
 }
 
@@ -51,6 +53,9 @@ func NewOldDatabaseCleaner(args ArgsOldDatabaseCleaner) (*oldDatabaseCleaner, er
 	if check.IfNil(args.OldDataCleanerProvider) {
 		return nil, storage.ErrNilOldDataCleanerProvider
 	}
+	if check.IfNil(args.RetentionTracker) {
+		return nil, storage.ErrNilRetentionTracker
+	}
 
 	pathRemoverFunc := func(file string) error {
 		return os.RemoveAll(file)
@@ -63,6 +68,7 @@ func NewOldDatabaseCleaner(args ArgsOldDatabaseCleaner) (*oldDatabaseCleaner, er
 		pathRemover:            pathRemoverFunc,
 		directoryReader:        directoryReader,
 		oldDataCleanerProvider: args.OldDataCleanerProvider,
+		retentionTracker:       args.RetentionTracker,
 		oldestEpochsToKeep:     make(map[uint32]uint32),
 	}
 
@@ -82,10 +88,17 @@ func (odc *oldDatabaseCleaner) registerHandler(handler EpochStartNotifier) {
 }
 
 func (odc *oldDatabaseCleaner) epochChangeActionHandler(hdr data.HeaderHandler) {
+	odc.retentionTracker.EpochStarted(hdr.GetEpoch(), hdr.GetTimeStamp())
+
 	err := odc.handleEpochChangeAction(hdr.GetEpoch())
 	if err != nil {
 		log.Debug("oldDatabaseCleaner: handleEpochChangeAction", "error", err)
 	}
+
+	err = odc.cleanStorersOutsideRetention()
+	if err != nil {
+		log.Debug("oldDatabaseCleaner: cleanStorersOutsideRetention", "error", err)
+	}
 }
 
 func (odc *oldDatabaseCleaner) epochChangePrepareHandler(_ data.HeaderHandler) {
@@ -211,6 +224,68 @@ func (odc *oldDatabaseCleaner) cleanOldEpochs(currentEpoch uint32) error {
 	return nil
 }
 
+// cleanStorersOutsideRetention removes, from the remaining epoch directories, the databases of the storers with a
+// retention policy which hold only epochs older than the storer's retention window. It is applied regardless of the
+// node's old data cleaning setting
+func (odc *oldDatabaseCleaner) cleanStorersOutsideRetention() error {
+	oldestRetainedEpochs := odc.retentionTracker.GetOldestRetainedEpochs()
+	if len(oldestRetainedEpochs) == 0 {
+		return nil
+	}
+
+	odc.Lock()
+	defer odc.Unlock()
+
+	epochDirectories, err := odc.directoryReader.ListDirectoriesAsString(odc.databasePath)
+	if err != nil {
+		return err
+	}
+
+	sortedEpochDirectories, sortedEpochs, found := getSortedEpochDirectories(epochDirectories)
+	if !found {
+		return nil
+	}
+
+	for idx, epoch := range sortedEpochs {
+		epochPath := path.Join(odc.databasePath, sortedEpochDirectories[idx])
+		shardDirectories, errList := odc.directoryReader.ListDirectoriesAsString(epochPath)
+		if errList != nil {
+			log.Debug("cannot list shard directories", "path", epochPath, "error", errList)
+			continue
+		}
+
+		for _, shardDirectory := range shardDirectories {
+			odc.removeStorersOutsideRetention(path.Join(epochPath, shardDirectory), epoch, oldestRetainedEpochs)
+		}
+	}
+
+	return nil
+}
+
+// removeStorersOutsideRetention should be called under mutex protection
+func (odc *oldDatabaseCleaner) removeStorersOutsideRetention(shardPath string, epoch uint32, oldestRetainedEpochs map[string]uint32) {
+	storerDirectories, err := odc.directoryReader.ListDirectoriesAsString(shardPath)
+	if err != nil {
+		log.Debug("cannot list storer directories", "path", shardPath, "error", err)
+		return
+	}
+
+	for _, storerDirectory := range storerDirectories {
+		oldestRetainedEpoch, hasPolicy := oldestRetainedEpochs[storerDirectory]
+		if !hasPolicy || epoch >= oldestRetainedEpoch {
+			continue
+		}
+
+		fullDirectoryPath := path.Join(shardPath, storerDirectory)
+		log.Debug("removing database outside the retention window", "db path", fullDirectoryPath,
+			"oldest retained epoch", oldestRetainedEpoch)
+		err = odc.pathRemover(fullDirectoryPath)
+		if err != nil {
+			log.Warn("cannot remove DB outside the retention window", "path", fullDirectoryPath, "error", err)
+		}
+	}
+}
+
 // cleanMap will remove all the entries from the map that aren't for current epoch.
 // should be called under mutex protection
 func (odc *oldDatabaseCleaner) cleanMap(currentEpoch uint32) {
@@ -53,6 +53,16 @@ func TestNewOldDatabaseCleaner(t *testing.T) {
 			},
 			expectedErr: storage.ErrNilOldDataCleanerProvider,
 		},
+		{
+			description: "nil retention tracker",
+			getArgs: func() ArgsOldDatabaseCleaner {
+				args := createMockArgs()
+				args.RetentionTracker = nil
+
+				return args
+			},
+			expectedErr: storage.ErrNilRetentionTracker,
+		},
 		{
 			description: "should work",
 			getArgs: func() ArgsOldDatabaseCleaner {
@@ -249,6 +259,66 @@ func TestOldDatabaseCleaner_EpochChange(t *testing.T) {
 	)
 }
 
+func TestOldDatabaseCleaner_EpochChangeShouldRemoveStorersOutsideRetention(t *testing.T) {
+	t.Parallel()
+
+	var handlerFunc epochStart.ActionHandler
+	args := createMockArgs()
+	args.EpochStartNotifier = &mock.EpochStartNotifierStub{
+		RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+			handlerFunc = handler
+		},
+	}
+	startedEpochs := make([]uint32, 0)
+	args.RetentionTracker = &testscommon.RetentionTrackerStub{
+		EpochStartedCalled: func(epoch uint32, timestamp uint64) {
+			startedEpochs = append(startedEpochs, epoch)
+			assert.Equal(t, uint64(1234), timestamp)
+		},
+		GetOldestRetainedEpochsCalled: func() map[string]uint32 {
+			return map[string]uint32{
+				"Transactions": 2,
+				"Receipts":     1,
+			}
+		},
+	}
+	directoryReader := &mock.DirectoryReaderStub{
+		ListDirectoriesAsStringCalled: func(directoryPath string) ([]string, error) {
+			switch directoryPath {
+			case "db/D":
+				return []string{"Epoch_2", "Epoch_0", "Static", "Epoch_1"}, nil
+			case "db/D/Epoch_0", "db/D/Epoch_1", "db/D/Epoch_2":
+				return []string{"Shard_0"}, nil
+			default:
+				return []string{"Transactions", "Receipts", "BlockHeaders"}, nil
+			}
+		},
+	}
+
+	removedFiles := make([]string, 0)
+	fileRemover := func(file string) error {
+		removedFiles = append(removedFiles, file)
+		return nil
+	}
+
+	// the old data cleaner provider does not allow removing whole epochs, but the retention policies are still applied
+	args.StorageListProvider = getStorageListProviderWithOldEpoch(2)
+	odc, _ := NewOldDatabaseCleaner(args)
+	odc.pathRemover = fileRemover
+	odc.directoryReader = directoryReader
+
+	handlerFunc.EpochStartAction(&block.Header{Epoch: 3, TimeStamp: 1234})
+	assert.Equal(t, []uint32{3}, startedEpochs)
+	assert.Equal(t,
+		[]string{
+			"db/D/Epoch_0/Shard_0/Transactions",
+			"db/D/Epoch_0/Shard_0/Receipts",
+			"db/D/Epoch_1/Shard_0/Transactions",
+		},
+		removedFiles,
+	)
+}
+
 func getStorageListProviderWithOldEpoch(epoch uint32) StorageListProviderHandler {
 	return &mock.StorageListProviderStub{
 		GetAllStorersCalled: func() map[dataRetriever.UnitType]storage.Storer {
@@ -276,6 +346,7 @@ func createMockArgs() ArgsOldDatabaseCleaner {
 			RegisterHandlerCalled: func(_ epochStart.ActionHandler) {},
 		},
 		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
+		RetentionTracker:       &testscommon.RetentionTrackerStub{},
 	}
 }
 
@@ -0,0 +1,262 @@
+package clean
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// RetentionStateFileName is the name of the file, placed in the database directory, in which the retention tracker
+// persists the epochs retained by each storer
+const RetentionStateFileName = "RetentionState.toml"
+
+const secondsInDay = 24 * 60 * 60
+
+// ArgsRetentionTracker holds the arguments needed for creating a retentionTracker
+type ArgsRetentionTracker struct {
+	DatabasePath string
+	Policies     []config.StorageRetentionPolicyConfig
+}
+
+type retentionPolicy struct {
+	numEpochs uint32
+	numDays   uint32
+}
+
+// EpochStartTime holds the timestamp of an epoch start block, as persisted by the retention tracker
+type EpochStartTime struct {
+	Epoch     uint32
+	Timestamp uint64
+}
+
+// StorerRetention holds the oldest epoch retained by a storer, as persisted by the retention tracker
+type StorerRetention struct {
+	Storer      string
+	OldestEpoch uint32
+}
+
+// RetentionState is the state persisted by the retention tracker
+type RetentionState struct {
+	EpochsStartTimes     []EpochStartTime
+	OldestRetainedEpochs []StorerRetention
+}
+
+type retentionTracker struct {
+	mut                  sync.RWMutex
+	filePath             string
+	policies             map[string]retentionPolicy
+	epochsStartTimes     map[uint32]uint64
+	oldestRetainedEpochs map[string]uint32
+	getTimeHandler       func() time.Time
+}
+
+// NewRetentionTracker returns a new instance of retentionTracker. The oldest epochs retained by the storers are loaded
+// from the state file found in the database directory, if any, so a restart does not lose track of the pruned data
+func NewRetentionTracker(args ArgsRetentionTracker) (*retentionTracker, error) {
+	policies, err := parseRetentionPolicies(args.Policies)
+	if err != nil {
+		return nil, err
+	}
+
+	rt := &retentionTracker{
+		filePath:             filepath.Join(args.DatabasePath, RetentionStateFileName),
+		policies:             policies,
+		epochsStartTimes:     make(map[uint32]uint64),
+		oldestRetainedEpochs: make(map[string]uint32),
+		getTimeHandler:       time.Now,
+	}
+
+	err = rt.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+func parseRetentionPolicies(policiesConfig []config.StorageRetentionPolicyConfig) (map[string]retentionPolicy, error) {
+	policies := make(map[string]retentionPolicy, len(policiesConfig))
+	for _, policyConfig := range policiesConfig {
+		if len(policyConfig.Storer) == 0 {
+			return nil, fmt.Errorf("%w: empty storer", storage.ErrInvalidRetentionPolicy)
+		}
+		_, exists := policies[policyConfig.Storer]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated policy for storer %s", storage.ErrInvalidRetentionPolicy, policyConfig.Storer)
+		}
+		isExpressedInEpochs := policyConfig.NumEpochs > 0
+		isExpressedInDays := policyConfig.NumDays > 0
+		if isExpressedInEpochs == isExpressedInDays {
+			return nil, fmt.Errorf("%w: exactly one of NumEpochs and NumDays should be set for storer %s",
+				storage.ErrInvalidRetentionPolicy, policyConfig.Storer)
+		}
+
+		policies[policyConfig.Storer] = retentionPolicy{
+			numEpochs: policyConfig.NumEpochs,
+			numDays:   policyConfig.NumDays,
+		}
+	}
+
+	return policies, nil
+}
+
+func (rt *retentionTracker) loadState() error {
+	state := &RetentionState{}
+	err := core.LoadTomlFile(state, rt.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w while loading the storage retention state from %s", err, rt.filePath)
+	}
+
+	for _, epochStartTime := range state.EpochsStartTimes {
+		rt.epochsStartTimes[epochStartTime.Epoch] = epochStartTime.Timestamp
+	}
+	for _, storerRetention := range state.OldestRetainedEpochs {
+		rt.oldestRetainedEpochs[storerRetention.Storer] = storerRetention.OldestEpoch
+	}
+
+	log.Debug("loaded the storage retention state", "file", rt.filePath, "oldest retained epochs", rt.oldestRetainedEpochs)
+
+	return nil
+}
+
+// EpochStarted records the start of the provided epoch and recomputes the oldest epoch retained by each storer with a
+// retention policy. It can be called by all the components notified of an epoch start, only the first call for an
+// epoch being taken into account
+func (rt *retentionTracker) EpochStarted(epoch uint32, timestamp uint64) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	if len(rt.policies) == 0 {
+		return
+	}
+	_, alreadyStarted := rt.epochsStartTimes[epoch]
+	if alreadyStarted {
+		return
+	}
+
+	rt.epochsStartTimes[epoch] = timestamp
+	for identifier, policy := range rt.policies {
+		oldestEpoch := rt.computeOldestRetainedEpoch(policy, epoch)
+		if oldestEpoch <= rt.oldestRetainedEpochs[identifier] {
+			continue
+		}
+
+		log.Debug("storage retention window moved", "storer", identifier, "epoch", epoch, "oldest retained epoch", oldestEpoch)
+		rt.oldestRetainedEpochs[identifier] = oldestEpoch
+	}
+	rt.removeUnneededEpochsStartTimes(epoch)
+
+	err := rt.saveState()
+	if err != nil {
+		log.Warn("cannot save the storage retention state", "file", rt.filePath, "error", err)
+	}
+}
+
+func (rt *retentionTracker) computeOldestRetainedEpoch(policy retentionPolicy, currentEpoch uint32) uint32 {
+	if policy.numEpochs > 0 {
+		if currentEpoch < policy.numEpochs {
+			return 0
+		}
+
+		return currentEpoch - policy.numEpochs + 1
+	}
+
+	// all the epochs started before the newest one which started before the cutoff time have ended before it
+	cutoffTime := rt.getTimeHandler().Unix() - int64(policy.numDays)*secondsInDay
+	oldestEpoch := uint32(0)
+	for epoch, startTime := range rt.epochsStartTimes {
+		if epoch <= currentEpoch && int64(startTime) <= cutoffTime && epoch > oldestEpoch {
+			oldestEpoch = epoch
+		}
+	}
+
+	return oldestEpoch
+}
+
+// removeUnneededEpochsStartTimes keeps only the start times of the epochs which can still move a retention window
+// expressed in days. Should be called under mutex protection
+func (rt *retentionTracker) removeUnneededEpochsStartTimes(currentEpoch uint32) {
+	oldestNeededEpoch := currentEpoch
+	for identifier, policy := range rt.policies {
+		if policy.numDays > 0 && rt.oldestRetainedEpochs[identifier] < oldestNeededEpoch {
+			oldestNeededEpoch = rt.oldestRetainedEpochs[identifier]
+		}
+	}
+
+	for epoch := range rt.epochsStartTimes {
+		if epoch < oldestNeededEpoch {
+			delete(rt.epochsStartTimes, epoch)
+		}
+	}
+}
+
+// saveState writes the state in a temporary file which then replaces the old one, so a crash while saving does not
+// corrupt the previous state. Should be called under mutex protection
+func (rt *retentionTracker) saveState() error {
+	state := &RetentionState{
+		EpochsStartTimes:     make([]EpochStartTime, 0, len(rt.epochsStartTimes)),
+		OldestRetainedEpochs: make([]StorerRetention, 0, len(rt.oldestRetainedEpochs)),
+	}
+	for epoch, timestamp := range rt.epochsStartTimes {
+		state.EpochsStartTimes = append(state.EpochsStartTimes, EpochStartTime{Epoch: epoch, Timestamp: timestamp})
+	}
+	for identifier, oldestEpoch := range rt.oldestRetainedEpochs {
+		state.OldestRetainedEpochs = append(state.OldestRetainedEpochs, StorerRetention{Storer: identifier, OldestEpoch: oldestEpoch})
+	}
+	sort.Slice(state.EpochsStartTimes, func(i, j int) bool {
+		return state.EpochsStartTimes[i].Epoch < state.EpochsStartTimes[j].Epoch
+	})
+	sort.Slice(state.OldestRetainedEpochs, func(i, j int) bool {
+		return state.OldestRetainedEpochs[i].Storer < state.OldestRetainedEpochs[j].Storer
+	})
+
+	err := os.MkdirAll(filepath.Dir(rt.filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := rt.filePath + ".tmp"
+	err = core.SaveTomlFile(state, tempFilePath)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, rt.filePath)
+}
+
+// OldestRetainedEpoch returns the oldest epoch retained by the storer with the provided identifier. The data of the
+// older epochs was pruned
+func (rt *retentionTracker) OldestRetainedEpoch(identifier string) uint32 {
+	rt.mut.RLock()
+	defer rt.mut.RUnlock()
+
+	return rt.oldestRetainedEpochs[identifier]
+}
+
+// GetOldestRetainedEpochs returns the oldest epoch retained by each storer which has, or had, a retention policy
+func (rt *retentionTracker) GetOldestRetainedEpochs() map[string]uint32 {
+	rt.mut.RLock()
+	defer rt.mut.RUnlock()
+
+	oldestRetainedEpochs := make(map[string]uint32, len(rt.oldestRetainedEpochs))
+	for identifier, oldestEpoch := range rt.oldestRetainedEpochs {
+		oldestRetainedEpochs[identifier] = oldestEpoch
+	}
+
+	return oldestRetainedEpochs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *retentionTracker) IsInterfaceNil() bool {
+	return rt == nil
+}
@@ -0,0 +1,172 @@
+package clean
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDay = uint64(secondsInDay)
+
+func createRetentionTrackerArgs(t *testing.T) ArgsRetentionTracker {
+	return ArgsRetentionTracker{
+		DatabasePath: t.TempDir(),
+		Policies: []config.StorageRetentionPolicyConfig{
+			{Storer: "Transactions", NumDays: 90},
+			{Storer: "Receipts", NumEpochs: 3},
+		},
+	}
+}
+
+func TestNewRetentionTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRetentionTrackerArgs(t)
+		args.Policies = append(args.Policies, config.StorageRetentionPolicyConfig{NumEpochs: 1})
+		rt, err := NewRetentionTracker(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy))
+		assert.Nil(t, rt)
+	})
+	t.Run("duplicated storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRetentionTrackerArgs(t)
+		args.Policies = append(args.Policies, config.StorageRetentionPolicyConfig{Storer: "Receipts", NumEpochs: 1})
+		rt, err := NewRetentionTracker(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy))
+		assert.Nil(t, rt)
+	})
+	t.Run("both epochs and days should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRetentionTrackerArgs(t)
+		args.Policies = append(args.Policies, config.StorageRetentionPolicyConfig{Storer: "MiniBlocks", NumEpochs: 1, NumDays: 1})
+		rt, err := NewRetentionTracker(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy))
+		assert.Nil(t, rt)
+	})
+	t.Run("neither epochs nor days should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRetentionTrackerArgs(t)
+		args.Policies = append(args.Policies, config.StorageRetentionPolicyConfig{Storer: "MiniBlocks"})
+		rt, err := NewRetentionTracker(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy))
+		assert.Nil(t, rt)
+	})
+	t.Run("corrupted state file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRetentionTrackerArgs(t)
+		err := os.WriteFile(filepath.Join(args.DatabasePath, RetentionStateFileName), []byte("not a toml ["), os.ModePerm)
+		require.Nil(t, err)
+
+		rt, err := NewRetentionTracker(args)
+		assert.NotNil(t, err)
+		assert.Nil(t, rt)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rt, err := NewRetentionTracker(createRetentionTrackerArgs(t))
+		assert.Nil(t, err)
+		assert.False(t, rt.IsInterfaceNil())
+		assert.Empty(t, rt.GetOldestRetainedEpochs())
+	})
+}
+
+func TestRetentionTracker_EpochStarted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("policy in epochs", func(t *testing.T) {
+		t.Parallel()
+
+		rt, _ := NewRetentionTracker(createRetentionTrackerArgs(t))
+		rt.EpochStarted(2, 0)
+		assert.Equal(t, uint32(0), rt.OldestRetainedEpoch("Receipts"))
+
+		rt.EpochStarted(3, 0)
+		assert.Equal(t, uint32(1), rt.OldestRetainedEpoch("Receipts"))
+
+		rt.EpochStarted(10, 0)
+		assert.Equal(t, uint32(8), rt.OldestRetainedEpoch("Receipts"))
+
+		// a storer without policy retains all the epochs
+		assert.Equal(t, uint32(0), rt.OldestRetainedEpoch("BlockHeaders"))
+	})
+	t.Run("policy in days", func(t *testing.T) {
+		t.Parallel()
+
+		now := 1000 * testDay
+		rt, _ := NewRetentionTracker(createRetentionTrackerArgs(t))
+		rt.getTimeHandler = func() time.Time {
+			return time.Unix(int64(now), 0)
+		}
+
+		// epoch 5 started before the 90 days window, so all the older epochs ended outside it
+		rt.EpochStarted(5, now-100*testDay)
+		rt.EpochStarted(6, now-80*testDay)
+		assert.Equal(t, uint32(5), rt.OldestRetainedEpoch("Transactions"))
+
+		now += 20 * testDay
+		rt.EpochStarted(7, now)
+		assert.Equal(t, uint32(6), rt.OldestRetainedEpoch("Transactions"))
+		assert.Equal(t, uint32(5), rt.OldestRetainedEpoch("Receipts"))
+	})
+	t.Run("should be called once per epoch and never move the window back", func(t *testing.T) {
+		t.Parallel()
+
+		rt, _ := NewRetentionTracker(createRetentionTrackerArgs(t))
+		rt.EpochStarted(10, 0)
+		rt.EpochStarted(10, 0)
+		rt.EpochStarted(4, 0)
+		assert.Equal(t, uint32(8), rt.OldestRetainedEpoch("Receipts"))
+	})
+	t.Run("no policies should not save the state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createRetentionTrackerArgs(t)
+		args.Policies = nil
+		rt, _ := NewRetentionTracker(args)
+		rt.EpochStarted(10, 0)
+
+		_, err := os.Stat(filepath.Join(args.DatabasePath, RetentionStateFileName))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("state should survive a restart", func(t *testing.T) {
+		t.Parallel()
+
+		now := 1000 * testDay
+		args := createRetentionTrackerArgs(t)
+		rt, _ := NewRetentionTracker(args)
+		rt.getTimeHandler = func() time.Time {
+			return time.Unix(int64(now), 0)
+		}
+		rt.EpochStarted(5, now-100*testDay)
+		rt.EpochStarted(10, now-80*testDay)
+
+		// the policies changed meanwhile, but the pruned epochs are still reported
+		args.Policies = []config.StorageRetentionPolicyConfig{{Storer: "Transactions", NumDays: 90}}
+		restarted, err := NewRetentionTracker(args)
+		require.Nil(t, err)
+		restarted.getTimeHandler = func() time.Time {
+			return time.Unix(int64(now+20*testDay), 0)
+		}
+		assert.Equal(t, map[string]uint32{"Transactions": 5, "Receipts": 8}, restarted.GetOldestRetainedEpochs())
+
+		// the start time of epoch 10 was persisted as well
+		restarted.EpochStarted(11, now+20*testDay)
+		assert.Equal(t, uint32(10), restarted.OldestRetainedEpoch("Transactions"))
+		assert.Equal(t, uint32(8), restarted.OldestRetainedEpoch("Receipts"))
+	})
+}
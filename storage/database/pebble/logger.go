@@ -0,0 +1,21 @@
+package pebble
+
+import "fmt"
+
+// pebbleLogger redirects the pebble internal messages to the node's logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs the message at trace level, pebble being verbose on the info level
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace(fmt.Sprintf(format, args...), "path", pl.path)
+}
+
+// Fatalf logs the message at error level and panics, pebble not expecting the call to return
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error(message, "path", pl.path)
+
+	panic(message)
+}
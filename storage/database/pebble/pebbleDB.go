@@ -0,0 +1,300 @@
+package pebble
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+var log = logger.GetOrCreate("storage/pebble")
+
+// DB is a persister backed by a Pebble LSM database. The writes are applied directly in the Pebble memtable and
+// write-ahead log without being synced, the write-ahead log being synced each time maxBatchSize writes were
+// done or batchDelaySeconds passed, whichever comes first
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *pebble.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	numUnsyncedWrites int64
+	mutSync           sync.Mutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*DB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+	if maxBatchSize < 1 {
+		return nil, fmt.Errorf("%w, maxBatchSize should be positive", storage.ErrInvalidConfig)
+	}
+	if batchDelaySeconds < 1 {
+		return nil, fmt.Errorf("%w, batchDelaySeconds should be positive", storage.ErrInvalidConfig)
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{path: path},
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		cancel:            cancel,
+	}
+
+	go dbStore.syncTimeoutHandle(ctx)
+
+	log.Debug("opened pebble db persister", "path", path, "created pointer", fmt.Sprintf("%p", db))
+
+	return dbStore, nil
+}
+
+func (s *DB) syncTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			err := s.syncWAL()
+			if err != nil {
+				log.Warn("pebble syncWAL", "path", s.path, "error", err.Error())
+			}
+		case <-ctx.Done():
+			log.Debug("closing the timed sync handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) syncWAL() error {
+	s.mutSync.Lock()
+	defer s.mutSync.Unlock()
+
+	if atomic.LoadInt64(&s.numUnsyncedWrites) == 0 {
+		return nil
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	// an empty log data record forces the write-ahead log to be synced on disk
+	err := db.LogData(nil, pebble.Sync)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreInt64(&s.numUnsyncedWrites, 0)
+
+	return nil
+}
+
+func (s *DB) updateUnsyncedWrites() error {
+	numUnsyncedWrites := atomic.AddInt64(&s.numUnsyncedWrites, 1)
+	if numUnsyncedWrites < int64(s.maxBatchSize) {
+		return nil
+	}
+
+	return s.syncWAL()
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := db.Set(key, val, pebble.NoSync)
+	if err != nil {
+		return err
+	}
+
+	return s.updateUnsyncedWrites()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	db := s.getDbPointer()
+	if db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	value, closer, err := db.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	clonedValue := make([]byte, len(value))
+	copy(clonedValue, value)
+	_ = closer.Close()
+
+	return clonedValue, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	_, closer, err := db.Get(key)
+	if err == pebble.ErrNotFound {
+		return storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return closer.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	db := s.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := db.Delete(key, pebble.NoSync)
+	if err != nil {
+		return err
+	}
+
+	return s.updateUnsyncedWrites()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	db := s.getDbPointer()
+	if db == nil {
+		return
+	}
+
+	iterator, err := db.NewIter(nil)
+	if err != nil {
+		log.Warn("pebble RangeKeys: cannot create iterator", "path", s.path, "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	for iterator.First(); iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Close syncs the pending writes and closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	err := s.syncWAL()
+	if err != nil && err != storage.ErrDBIsClosed {
+		log.Warn("pebble Close: cannot sync the write-ahead log", "path", s.path, "error", err.Error())
+	}
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+func (s *DB) getDbPointer() *pebble.DB {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	return s.db
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *pebble.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db != nil {
+		log.Debug("pebble db closed", "path", s.path, "pointer", fmt.Sprintf("%p", s.db))
+	}
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
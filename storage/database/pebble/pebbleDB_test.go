@@ -0,0 +1,160 @@
+package pebble
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDB(t *testing.T, maxBatchSize int) *DB {
+	db, err := NewDB(t.TempDir(), 10, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 1, 1, 0)
+		require.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+		require.True(t, check.IfNil(db))
+	})
+	t.Run("invalid max batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 1, 0, 10)
+		require.True(t, errors.Is(err, storage.ErrInvalidConfig))
+		require.True(t, check.IfNil(db))
+	})
+	t.Run("invalid batch delay should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 0, 1, 10)
+		require.True(t, errors.Is(err, storage.ErrInvalidConfig))
+		require.True(t, check.IfNil(db))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewDB(t.TempDir(), 1, 1, 10)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(db))
+		require.Nil(t, db.Close())
+	})
+}
+
+func TestDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 2)
+	key, val := []byte("key"), []byte("value")
+
+	_, err := db.Get(key)
+	require.Equal(t, storage.ErrKeyNotFound, err)
+	require.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+
+	require.Nil(t, db.Put(key, val))
+	recovered, err := db.Get(key)
+	require.Nil(t, err)
+	require.Equal(t, val, recovered)
+	require.Nil(t, db.Has(key))
+
+	require.Nil(t, db.Remove(key))
+	_, err = db.Get(key)
+	require.Equal(t, storage.ErrKeyNotFound, err)
+
+	require.Nil(t, db.Close())
+	_, err = db.Get(key)
+	require.Equal(t, storage.ErrDBIsClosed, err)
+	require.Equal(t, storage.ErrDBIsClosed, db.Has(key))
+	require.Equal(t, storage.ErrDBIsClosed, db.Put(key, val))
+	require.Equal(t, storage.ErrDBIsClosed, db.Remove(key))
+}
+
+func TestDB_ShouldPersistAfterReopen(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	db, _ := NewDB(path, 10, 100, 10)
+	for i := 0; i < 10; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, db.Close())
+
+	reopened, err := NewDB(path, 10, 100, 10)
+	require.Nil(t, err)
+	recovered, err := reopened.Get([]byte("key7"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value7"), recovered)
+	require.Nil(t, reopened.Destroy())
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 1)
+	expected := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+	}
+	for key, val := range expected {
+		_ = db.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = value
+		return true
+	})
+	assert.Equal(t, expected, recovered)
+
+	numVisited := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numVisited++
+		return false
+	})
+	assert.Equal(t, 1, numVisited)
+
+	db.RangeKeys(nil)
+	_ = db.Close()
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 5)
+	numOperations := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key%d", idx%10))
+			switch idx % 4 {
+			case 0:
+				_ = db.Put(key, key)
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Has(key)
+			case 3:
+				_ = db.Remove(key)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Nil(t, db.Close())
+}
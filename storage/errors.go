@@ -64,6 +64,15 @@ var ErrNilNodeTypeProvider = errors.New("nil node type provider")
 // ErrNilOldDataCleanerProvider signals that a nil old data cleaner provider has been provided
 var ErrNilOldDataCleanerProvider = errors.New("nil old data cleaner provider")
 
+// ErrNilRetentionTracker signals that a nil retention tracker has been provided
+var ErrNilRetentionTracker = errors.New("nil retention tracker")
+
+// ErrInvalidRetentionPolicy signals that an invalid storage retention policy has been provided
+var ErrInvalidRetentionPolicy = errors.New("invalid storage retention policy")
+
+// ErrDataPruned signals that the requested data belongs to an epoch outside the retention window of its storer
+var ErrDataPruned = errors.New("data was pruned as it is outside the retention window")
+
 // ErrKeyNotFound is raised when a key is not found
 var ErrKeyNotFound = storageErrors.ErrKeyNotFound
 
@@ -82,6 +91,9 @@ var ErrNotSupportedCacheType = storageErrors.ErrNotSupportedCacheType
 // ErrDBIsClosed is raised when the DB is closed
 var ErrDBIsClosed = storageErrors.ErrDBIsClosed
 
+// ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
+var ErrInvalidNumOpenFiles = storageErrors.ErrInvalidNumOpenFiles
+
 // ErrEpochKeepIsLowerThanNumActive signals that num epochs to keep is lower than num active epochs
 var ErrEpochKeepIsLowerThanNumActive = errors.New("num epochs to keep is lower than num active epochs")
 
@@ -58,6 +58,8 @@ func (pc *persisterCreator) CreateBasePersister(path string) (storage.Persister,
 		return database.NewSerialDB(path, pc.batchDelaySeconds, pc.maxBatchSize, pc.maxOpenFiles)
 	case storageunit.MemoryDB:
 		return database.NewMemDB(), nil
+	case storageunit.Pebble:
+		return database.NewPebbleDB(path, pc.batchDelaySeconds, pc.maxBatchSize, pc.maxOpenFiles)
 	default:
 		return nil, storage.ErrNotSupportedDBType
 	}
@@ -47,6 +47,7 @@ type StorageServiceFactory struct {
 	pathManager                   storage.PathManagerHandler
 	epochStartNotifier            epochStart.EpochStartNotifier
 	oldDataCleanerProvider        clean.OldDataCleanerProvider
+	retentionTracker              clean.RetentionTracker
 	createTrieEpochRootHashStorer bool
 	currentEpoch                  uint32
 	storageType                   StorageServiceType
@@ -91,6 +92,14 @@ func NewStorageServiceFactory(args StorageServiceFactoryArgs) (*StorageServiceFa
 		return nil, storage.ErrInvalidNumberOfEpochsToSave
 	}
 
+	retentionTracker, err := clean.NewRetentionTracker(clean.ArgsRetentionTracker{
+		DatabasePath: args.PathManager.DatabasePath(),
+		Policies:     args.Config.StoragePruning.RetentionPolicies,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &StorageServiceFactory{
 		generalConfig:                 args.Config,
 		prefsConfig:                   args.PrefsConfig,
@@ -100,6 +109,7 @@ func NewStorageServiceFactory(args StorageServiceFactoryArgs) (*StorageServiceFa
 		currentEpoch:                  args.CurrentEpoch,
 		createTrieEpochRootHashStorer: args.CreateTrieEpochRootHashStorer,
 		oldDataCleanerProvider:        oldDataCleanProvider,
+		retentionTracker:              retentionTracker,
 		storageType:                   args.StorageType,
 		nodeProcessingMode:            args.NodeProcessingMode,
 		snapshotsEnabled:              args.Config.StateTriesConfig.SnapshotsEnabled,
@@ -121,9 +131,52 @@ func checkArgs(args StorageServiceFactoryArgs) error {
 		return storage.ErrNilEpochStartNotifier
 	}
 
+	return checkRetentionPolicies(args.Config)
+}
+
+// checkRetentionPolicies rejects the retention policies if the storers do not divide the epochs into separate
+// databases, or if they target a protected storer: the state of an epoch references trie nodes saved in older epochs,
+// while the bootstrap and the epoch start read the headers, the bodies and the bootstrap data of older epochs, so a
+// pruned epoch would prevent the node from restarting
+func checkRetentionPolicies(cfg config.Config) error {
+	if len(cfg.StoragePruning.RetentionPolicies) > 0 && !cfg.StoragePruning.Enabled {
+		return fmt.Errorf("%w: storage pruning should be enabled", storage.ErrInvalidRetentionPolicy)
+	}
+
+	protectedStorers := make(map[string]struct{})
+	for _, storageConfig := range getStoragesWithoutRetention(cfg) {
+		protectedStorers[storageConfig.DB.FilePath] = struct{}{}
+	}
+	for _, policy := range cfg.StoragePruning.RetentionPolicies {
+		_, isProtected := protectedStorers[policy.Storer]
+		if isProtected {
+			return fmt.Errorf("%w: storer %s is needed by the state, the bootstrap or the epoch start and can not have a retention policy",
+				storage.ErrInvalidRetentionPolicy, policy.Storer)
+		}
+	}
+
 	return nil
 }
 
+func getStoragesWithoutRetention(cfg config.Config) []config.StorageConfig {
+	return []config.StorageConfig{
+		cfg.AccountsTrieStorage,
+		cfg.PeerAccountsTrieStorage,
+		cfg.AccountsTrieCheckpointsStorage,
+		cfg.PeerAccountsTrieCheckpointsStorage,
+		cfg.TrieEpochRootHashStorage,
+		cfg.BootstrapStorage,
+		cfg.MetaBlockStorage,
+		cfg.BlockHeaderStorage,
+		cfg.MetaHdrNonceHashStorage,
+		cfg.ShardHdrNonceHashStorage,
+		cfg.MiniBlocksStorage,
+		cfg.PeerBlockBodyStorage,
+		cfg.ScheduledSCRsStorage,
+		cfg.StatusMetricsStorage,
+	}
+}
+
 // TODO: refactor this function, split it into multiple ones
 func (psf *StorageServiceFactory) createAndAddBaseStorageUnits(
 	store dataRetriever.StorageService,
@@ -602,9 +655,45 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	err = psf.setUpAddressTransactionsStorerIfNeeded(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
+func (psf *StorageServiceFactory) setUpAddressTransactionsStorerIfNeeded(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.AddressTransactionsIndexEnabled {
+		return nil
+	}
+
+	// Create the addressTransactions (STATIC) storer
+	addressTransactionsConfig := psf.generalConfig.DbLookupExtensions.AddressTransactionsStorageConfig
+	addressTransactionsDbConfig := GetDBFromConfig(addressTransactionsConfig.DB)
+	addressTransactionsDbConfig.FilePath = psf.pathManager.PathForStatic(shardIDStr, addressTransactionsConfig.DB.FilePath)
+	addressTransactionsCacherConfig := GetCacherFromConfig(addressTransactionsConfig.Cache)
+
+	dbConfigHandler := NewDBConfigHandler(addressTransactionsConfig.DB)
+	addressTransactionsPersisterCreator, err := NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return err
+	}
+
+	addressTransactionsUnit, err := storageunit.NewStorageUnitFromConf(
+		addressTransactionsCacherConfig,
+		addressTransactionsDbConfig,
+		addressTransactionsPersisterCreator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AddressTransactionsStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AddressTransactionsUnit, addressTransactionsUnit)
+
+	return nil
+}
+
 func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
 	esdtSuppliesUnit, err := psf.createEsdtSuppliesUnit(shardIDStr)
 	if err != nil {
@@ -671,6 +760,7 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(
 		Identifier:                storageConfig.DB.FilePath,
 		PruningEnabled:            pruningEnabled,
 		OldDataCleanerProvider:    psf.oldDataCleanerProvider,
+		RetentionTracker:          psf.retentionTracker,
 		CustomDatabaseRemover:     customDatabaseRemover,
 		ShardCoordinator:          psf.shardCoordinator,
 		CacheConf:                 GetCacherFromConfig(storageConfig.Cache),
@@ -790,8 +880,10 @@ func (psf *StorageServiceFactory) getNumActivePersistersForFullHistoryStorer(isF
 }
 
 func (psf *StorageServiceFactory) initOldDatabasesCleaningIfNeeded(store dataRetriever.StorageService) error {
+	// a full archive node keeps all the old epochs, so the cleaner is needed only to apply the retention policies
 	isFullArchive := psf.prefsConfig.FullArchive
-	if isFullArchive {
+	hasRetentionPolicies := len(psf.generalConfig.StoragePruning.RetentionPolicies) > 0
+	if isFullArchive && !hasRetentionPolicies {
 		return nil
 	}
 	_, err := clean.NewOldDatabaseCleaner(clean.ArgsOldDatabaseCleaner{
@@ -799,6 +891,7 @@ func (psf *StorageServiceFactory) initOldDatabasesCleaningIfNeeded(store dataRet
 		StorageListProvider:    store,
 		EpochStartNotifier:     psf.epochStartNotifier,
 		OldDataCleanerProvider: psf.oldDataCleanerProvider,
+		RetentionTracker:       psf.retentionTracker,
 	})
 
 	return err
@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -54,6 +55,7 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 				ResultsHashesByTxHashStorageConfig: createMockStorageConfig("ResultsHashesByTxHashStorage"),
 				ESDTSuppliesStorageConfig:          createMockStorageConfig("ESDTSuppliesStorage"),
 				RoundHashStorageConfig:             createMockStorageConfig("RoundHashStorage"),
+				AddressTransactionsStorageConfig:   createMockStorageConfig("AddressTransactionsStorage"),
 			},
 			LogsAndEvents: config.LogsAndEventsConfig{
 				SaveInStorageEnabled: true,
@@ -142,6 +144,67 @@ func TestNewStorageServiceFactory(t *testing.T) {
 		assert.Equal(t, storage.ErrInvalidNumberOfEpochsToSave, err)
 		assert.Nil(t, storageServiceFactory)
 	})
+	t.Run("invalid retention policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.StoragePruning.RetentionPolicies = []config.StorageRetentionPolicyConfig{
+			{Storer: "TxStorage", NumEpochs: 1, NumDays: 1},
+		}
+		storageServiceFactory, err := NewStorageServiceFactory(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy))
+		assert.Nil(t, storageServiceFactory)
+	})
+	t.Run("retention policy without storage pruning should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.StoragePruning.Enabled = false
+		args.Config.StoragePruning.RetentionPolicies = []config.StorageRetentionPolicyConfig{
+			{Storer: "TxStorage", NumEpochs: 10},
+		}
+		storageServiceFactory, err := NewStorageServiceFactory(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy))
+		assert.Nil(t, storageServiceFactory)
+	})
+	t.Run("retention policy for a protected storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		protectedStorers := []string{
+			"AccountsTrieStorage",
+			"PeerAccountsTrieStorage",
+			"TrieEpochRootHashStorage",
+			"BootstrapStorage",
+			"MetaBlockStorage",
+			"BlockHeaderStorage",
+			"MetaHdrNonceHashStorage",
+			"ShardHdrNonceHashStorage",
+			"MiniBlocksStorage",
+			"PeerBlockBodyStorage",
+			"ScheduledSCRsStorage",
+		}
+		for _, storer := range protectedStorers {
+			args := createMockArgument(t)
+			args.Config.StoragePruning.RetentionPolicies = []config.StorageRetentionPolicyConfig{
+				{Storer: storer, NumEpochs: 10},
+			}
+			storageServiceFactory, err := NewStorageServiceFactory(args)
+			assert.True(t, errors.Is(err, storage.ErrInvalidRetentionPolicy), storer)
+			assert.Nil(t, storageServiceFactory)
+		}
+	})
+	t.Run("retention policy for a storer not read at bootstrap should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.StoragePruning.RetentionPolicies = []config.StorageRetentionPolicyConfig{
+			{Storer: "TxStorage", NumEpochs: 10},
+			{Storer: "ReceiptsStorage", NumDays: 30},
+		}
+		storageServiceFactory, err := NewStorageServiceFactory(args)
+		assert.Nil(t, err)
+		assert.NotNil(t, storageServiceFactory)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -435,6 +498,31 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Equal(t, expectedStorers, len(allStorers))
 		_ = storageService.CloseAll()
 	})
+	t.Run("wrong config for DbLookupExtensions.AddressTransactionsStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AddressTransactionsIndexEnabled = true
+		args.Config.DbLookupExtensions.AddressTransactionsStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.AddressTransactionsStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
+	t.Run("should work with the address transactions index", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AddressTransactionsIndexEnabled = true
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(storageService))
+		allStorers := storageService.GetAllStorers()
+		expectedStorers := 26
+		assert.Equal(t, expectedStorers, len(allStorers))
+		_ = storageService.CloseAll()
+	})
 	t.Run("should work without TrieEpochRootHashStorage", func(t *testing.T) {
 		t.Parallel()
 
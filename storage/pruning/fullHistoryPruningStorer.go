@@ -2,6 +2,7 @@ package pruning
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 
@@ -75,7 +76,12 @@ func (fhps *FullHistoryPruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]
 		return data, nil
 	}
 
-	return fhps.searchInEpoch(key, epoch+1)
+	data, errNextEpoch := fhps.searchInEpoch(key, epoch+1)
+	if errNextEpoch != nil && errors.Is(err, storage.ErrDataPruned) {
+		return nil, err
+	}
+
+	return data, errNextEpoch
 }
 
 // GetBulkFromEpoch will search a bulk of keys in the persister for the given epoch
@@ -106,6 +112,10 @@ func (fhps *FullHistoryPruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint
 
 // PutInEpoch will set the key-value pair in the given epoch
 func (fhps *FullHistoryPruningStorer) PutInEpoch(key []byte, data []byte, epoch uint32) error {
+	if fhps.isEpochPruned(epoch) {
+		return fhps.newDataPrunedError(epoch)
+	}
+
 	fhps.cacher.Put(key, data, len(data))
 
 	persister, err := fhps.getOrOpenPersister(epoch)
@@ -160,6 +170,11 @@ func (fhps *FullHistoryPruningStorer) getFromOldEpoch(key []byte, epoch uint32)
 }
 
 func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.Persister, error) {
+	// the databases outside the retention window are removed, so they should not be created again
+	if fhps.isEpochPruned(epoch) {
+		return nil, fhps.newDataPrunedError(epoch)
+	}
+
 	epochString := fmt.Sprintf("%d", epoch)
 
 	fhps.lock.RLock()
@@ -3,6 +3,7 @@ package pruning_test
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -18,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/pathmanager"
 	"github.com/multiversx/mx-chain-go/storage/pruning"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -177,6 +179,43 @@ func TestNewFullHistoryPruningStorer_GetFromEpochShouldSearchAlsoInNext(t *testi
 	assert.Equal(t, testVal, res2)
 }
 
+func TestNewFullHistoryPruningStorer_EpochsOutsideRetentionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.RetentionTracker = &testscommon.RetentionTrackerStub{
+		OldestRetainedEpochCalled: func(_ string) uint32 {
+			return 5
+		},
+	}
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 5,
+	}
+	fhps, _ := pruning.NewFullHistoryPruningStorer(fhArgs)
+	testVal := []byte("value")
+	testKey := []byte("key")
+
+	err := fhps.PutInEpoch(testKey, testVal, 3)
+	assert.True(t, errors.Is(err, storage.ErrDataPruned))
+
+	res, err := fhps.GetFromEpoch(testKey, 3)
+	assert.True(t, errors.Is(err, storage.ErrDataPruned))
+	assert.Nil(t, res)
+
+	bulkRes, err := fhps.GetBulkFromEpoch([][]byte{testKey}, 3)
+	assert.True(t, errors.Is(err, storage.ErrDataPruned))
+	assert.Nil(t, bulkRes)
+
+	// the data saved in the first retained epoch is still found when searching in the previous epoch
+	err = fhps.PutInEpoch(testKey, testVal, 5)
+	assert.Nil(t, err)
+	fhps.ClearCache()
+	res, err = fhps.GetFromEpoch(testKey, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+}
+
 func TestNewFullHistoryPruningStorer_GetBulkFromEpoch(t *testing.T) {
 	t.Parallel()
 
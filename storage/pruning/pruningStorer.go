@@ -93,6 +93,7 @@ type PruningStorer struct {
 	mutEpochPrepareHdr     sync.RWMutex
 	epochPrepareHdr        data.HeaderHandler
 	oldDataCleanerProvider clean.OldDataCleanerProvider
+	retentionTracker       clean.RetentionTracker
 	customDatabaseRemover  storage.CustomDatabaseRemoverHandler
 	identifier             string
 	numOfEpochsToKeep      uint32
@@ -154,6 +155,7 @@ func initPruningStorer(
 	pdb.numOfEpochsToKeep = args.EpochsData.NumOfEpochsToKeep
 	pdb.numOfActivePersisters = args.EpochsData.NumOfActivePersisters
 	pdb.oldDataCleanerProvider = args.OldDataCleanerProvider
+	pdb.retentionTracker = args.RetentionTracker
 	pdb.customDatabaseRemover = args.CustomDatabaseRemover
 	pdb.persistersMapByEpoch = persistersMapByEpoch
 	pdb.activePersisters = activePersisters
@@ -184,6 +186,9 @@ func checkArgs(args StorerArgs) error {
 	if check.IfNil(args.OldDataCleanerProvider) {
 		return storage.ErrNilOldDataCleanerProvider
 	}
+	if check.IfNil(args.RetentionTracker) {
+		return storage.ErrNilRetentionTracker
+	}
 	if args.MaxBatchSize > int(args.CacheConf.Capacity) {
 		return storage.ErrCacheSizeIsLowerThanBatchSize
 	}
@@ -220,10 +225,15 @@ func initPersistersInEpoch(
 	var persisters []*persisterData
 	persistersMapByEpoch := make(map[uint32]*persisterData)
 
+	oldestRetainedEpoch := int64(args.RetentionTracker.OldestRetainedEpoch(args.Identifier))
 	for epoch := int64(args.EpochsData.StartingEpoch); epoch >= 0; epoch-- {
 		if args.PersistersTracker.HasInitializedEnoughPersisters(epoch) {
 			break
 		}
+		// the starting epoch is always opened, the older epochs outside the retention window were already pruned
+		if epoch < oldestRetainedEpoch && epoch < int64(args.EpochsData.StartingEpoch) {
+			break
+		}
 
 		log.Debug("initPersistersInEpoch(): createPersisterDataForEpoch", "identifier", args.Identifier, "epoch", epoch, "shardID", shardIDStr)
 		p, err := createPersisterDataForEpoch(args, uint32(epoch), shardIDStr)
@@ -363,6 +373,10 @@ func (ps *PruningStorer) doPutInPersister(key, data []byte, persister storage.Pe
 
 // PutInEpoch adds data to specified epoch
 func (ps *PruningStorer) PutInEpoch(key, data []byte, epoch uint32) error {
+	if ps.isEpochPruned(epoch) {
+		return ps.newDataPrunedError(epoch)
+	}
+
 	ps.cacher.Put(key, data, len(data))
 
 	ps.lock.RLock()
@@ -490,6 +504,9 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 	if ok {
 		return v.([]byte), nil
 	}
+	if ps.isEpochPruned(epoch) {
+		return nil, ps.newDataPrunedError(epoch)
+	}
 
 	ps.lock.RLock()
 	pd, exists := ps.persistersMapByEpoch[epoch]
@@ -522,6 +539,10 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 
 // GetBulkFromEpoch will return a slice of keys only in the persister for the given epoch
 func (ps *PruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) ([]storageCore.KeyValuePair, error) {
+	if ps.isEpochPruned(epoch) {
+		return nil, ps.newDataPrunedError(epoch)
+	}
+
 	ps.lock.RLock()
 	pd, exists := ps.persistersMapByEpoch[epoch]
 	ps.lock.RUnlock()
@@ -721,6 +742,7 @@ func (ps *PruningStorer) registerHandler(handler EpochStartNotifier) {
 			if err != nil {
 				log.Warn("change epoch in storer", "error", err.Error())
 			}
+			ps.removePersistersOutsideRetention(hdr)
 		},
 		func(metaHdr data.HeaderHandler) {
 			err := ps.saveHeaderForEpochStartPrepare(metaHdr)
@@ -811,6 +833,52 @@ func (ps *PruningStorer) changeEpoch(header data.HeaderHandler) error {
 	return nil
 }
 
+// removePersistersOutsideRetention closes and forgets the persisters of the epochs outside the storer's retention
+// window. The databases are removed afterward by the old database cleaner
+func (ps *PruningStorer) removePersistersOutsideRetention(header data.HeaderHandler) {
+	ps.retentionTracker.EpochStarted(header.GetEpoch(), header.GetTimeStamp())
+
+	oldestRetainedEpoch := ps.retentionTracker.OldestRetainedEpoch(ps.identifier)
+	if oldestRetainedEpoch == 0 || !ps.pruningEnabled {
+		return
+	}
+
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	// the newest persister is always kept, so the storer can still save data
+	activePersisters := make([]*persisterData, 0, len(ps.activePersisters))
+	for idx, pd := range ps.activePersisters {
+		if idx == 0 || pd.epoch >= oldestRetainedEpoch {
+			activePersisters = append(activePersisters, pd)
+		}
+	}
+	ps.activePersisters = activePersisters
+
+	for epoch, pd := range ps.persistersMapByEpoch {
+		if epoch >= oldestRetainedEpoch || pd == ps.activePersisters[0] {
+			continue
+		}
+
+		if !pd.getIsClosed() {
+			err := pd.Close()
+			if err != nil {
+				log.Warn("error closing persister outside the retention window", "error", err.Error(), "id", ps.identifier)
+			}
+		}
+		delete(ps.persistersMapByEpoch, epoch)
+		log.Debug("PruningStorer - removed persister outside the retention window", "id", ps.identifier, "epoch", epoch)
+	}
+}
+
+func (ps *PruningStorer) isEpochPruned(epoch uint32) bool {
+	return epoch < ps.retentionTracker.OldestRetainedEpoch(ps.identifier)
+}
+
+func (ps *PruningStorer) newDataPrunedError(epoch uint32) error {
+	return fmt.Errorf("%w: epoch %d in %s", storage.ErrDataPruned, epoch, ps.identifier)
+}
+
 // should be called under mutex protection
 func (ps *PruningStorer) extendSavedEpochsIfNeeded(header data.HeaderHandler) bool {
 	epoch := header.GetEpoch()
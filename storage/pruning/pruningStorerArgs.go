@@ -16,6 +16,7 @@ type StorerArgs struct {
 	PersisterFactory          DbFactoryHandler
 	Notifier                  EpochStartNotifier
 	OldDataCleanerProvider    clean.OldDataCleanerProvider
+	RetentionTracker          clean.RetentionTracker
 	CustomDatabaseRemover     storage.CustomDatabaseRemoverHandler
 	MaxBatchSize              int
 	EpochsData                EpochArgs
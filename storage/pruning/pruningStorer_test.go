@@ -18,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/random"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/database"
 	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
@@ -85,6 +86,7 @@ func getDefaultArgs() pruning.StorerArgs {
 		EpochsData:             epochsData,
 		Notifier:               &mock.EpochStartNotifierStub{},
 		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
+		RetentionTracker:       &testscommon.RetentionTrackerStub{},
 		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
 		MaxBatchSize:           10,
 		PersistersTracker:      pruning.NewPersistersTracker(epochsData),
@@ -117,6 +119,7 @@ func getDefaultArgsSerialDB() pruning.StorerArgs {
 		EpochsData:             epochData,
 		Notifier:               &mock.EpochStartNotifierStub{},
 		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
+		RetentionTracker:       &testscommon.RetentionTrackerStub{},
 		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
 		MaxBatchSize:           20,
 		PersistersTracker:      pruning.NewPersistersTracker(epochData),
@@ -204,6 +207,17 @@ func TestNewPruningStorer_NilOldDataCleanerProviderShouldErr(t *testing.T) {
 	assert.Equal(t, storage.ErrNilOldDataCleanerProvider, err)
 }
 
+func TestNewPruningStorer_NilRetentionTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.RetentionTracker = nil
+	ps, err := pruning.NewPruningStorer(args)
+
+	assert.Nil(t, ps)
+	assert.Equal(t, storage.ErrNilRetentionTracker, err)
+}
+
 func TestNewPruningStorer_NilCustomDatabaseRemoverProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1278,6 +1292,61 @@ func TestPruningStorer_PutInEpoch(t *testing.T) {
 	})
 }
 
+func TestPruningStorer_EpochsOutsideRetentionShouldBePruned(t *testing.T) {
+	t.Parallel()
+
+	var handler epochStart.ActionHandler
+	args := getDefaultArgs()
+	args.Notifier = &mock.EpochStartNotifierStub{
+		RegisterHandlerCalled: func(h epochStart.ActionHandler) {
+			handler = h
+		},
+	}
+	// the retention window holds the current and the previous epoch
+	oldestRetainedEpoch := uint32(0)
+	args.RetentionTracker = &testscommon.RetentionTrackerStub{
+		EpochStartedCalled: func(epoch uint32, _ uint64) {
+			if epoch > 0 {
+				oldestRetainedEpoch = epoch - 1
+			}
+		},
+		OldestRetainedEpochCalled: func(identifier string) uint32 {
+			assert.Equal(t, "id", identifier)
+			return oldestRetainedEpoch
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+
+	key, value := []byte("key"), []byte("value")
+	err := ps.PutInEpoch(key, value, 0)
+	require.Nil(t, err)
+
+	handler.EpochStartAction(&block.Header{Epoch: 1})
+	ps.ClearCache()
+	recovered, err := ps.GetFromEpoch(key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+
+	handler.EpochStartAction(&block.Header{Epoch: 2})
+	handler.EpochStartAction(&block.Header{Epoch: 3})
+	assert.Equal(t, []uint32{2, 3}, ps.PersistersMapByEpochToSlice())
+
+	ps.ClearCache()
+	recovered, err = ps.GetFromEpoch(key, 0)
+	assert.True(t, errors.Is(err, storage.ErrDataPruned))
+	assert.Nil(t, recovered)
+
+	results, err := ps.GetBulkFromEpoch([][]byte{key}, 1)
+	assert.True(t, errors.Is(err, storage.ErrDataPruned))
+	assert.Nil(t, results)
+
+	err = ps.PutInEpoch(key, value, 1)
+	assert.True(t, errors.Is(err, storage.ErrDataPruned))
+
+	err = ps.PutInEpoch(key, value, 2)
+	assert.Nil(t, err)
+}
+
 func TestPruningStorer_RemoveFromCurrentEpoch(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,8 @@ const (
 	LvlDBSerial = storageUnit.LvlDBSerial
 	// MemoryDB represents an in memory storage identifier
 	MemoryDB = storageUnit.MemoryDB
+	// Pebble represents a Pebble LSM storage identifier
+	Pebble DBType = "Pebble"
 )
 
 // Shard id provider types that are currently supported
@@ -0,0 +1,13 @@
+package txcache
+
+import "errors"
+
+// ErrNilAdmissionPolicy signals that a nil admission policy has been provided
+var ErrNilAdmissionPolicy = errors.New("nil admission policy")
+
+// ErrInvalidGasPriceBump signals that an invalid gas price bump percentage was provided for the transactions replacement
+var ErrInvalidGasPriceBump = errors.New("invalid gas price bump percentage for the transactions replacement")
+
+// ErrTxReplacementUnderpriced signals that a transaction has the same sender and nonce as a pending transaction, but its gas
+// price is not high enough to replace it
+var ErrTxReplacementUnderpriced = errors.New("transaction replacement underpriced")
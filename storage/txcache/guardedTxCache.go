@@ -0,0 +1,643 @@
+package txcache
+
+import (
+	"bytes"
+	"hash/fnv"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-storage-go/txcache"
+)
+
+const numSendersLocks = 256
+
+// minNumTxsToTrackSender is the number of pending transactions from which the usage of a sender is tracked
+const minNumTxsToTrackSender = 32
+
+// AdmissionPolicy defines the checks applied to the transactions received from the network, before they are added in the
+// cache. The getSenderTxs function returns the pending transactions of the same sender, without the ones to be replaced.
+// For SelectTxsToEvict, it also contains the provided transaction, at the position it would have in the sender list.
+// OnTxsEvicted is called with the number of selected transactions actually removed, once the insertion is done
+type AdmissionPolicy interface {
+	CheckAdmission(tx *WrappedTransaction, getSenderTxs func() []*WrappedTransaction) error
+	SelectTxsToEvict(tx *WrappedTransaction, getSenderTxs func() []*WrappedTransaction) [][]byte
+	OnTxsEvicted(numTxs int)
+	IsInterfaceNil() bool
+}
+
+// ArgsGuardedTxCache holds the arguments needed to create a new guarded transaction cache
+type ArgsGuardedTxCache struct {
+	Config                    ConfigSourceMe
+	TxGasHandler              TxGasHandler
+	ReplacementEnabled        bool
+	MinGasPriceBumpPercentage uint32
+	EvictionHandler           func(tx *WrappedTransaction)
+	IsPinnedSender            func(sender []byte) bool
+}
+
+// GuardedTxCache is a TxCache adding the transactions under the lock of their sender, so the checks done on the pending
+// transactions of a sender, the insertion of a new transaction and the replacement of the pending transactions having
+// the same nonce are atomic. The transactions evicted by the admission policy, replaced, or evicted because of the sender
+// limits or of the cache capacity are passed to the eviction handler. The senders swept by the cache after a selection
+// are not passed to the eviction handler. The transactions of the pinned senders evicted because of the cache capacity
+// are added back in the room freed by the eviction, but the pinned senders are still subject to the sender limits,
+// which are applied by the cache itself
+type GuardedTxCache struct {
+	*TxCache
+	config                    ConfigSourceMe
+	sendersLocks              [numSendersLocks]sync.Mutex
+	mutEviction               sync.RWMutex
+	mutSendersUsage           sync.Mutex
+	sendersUsage              map[string]*senderUsage
+	sendersIndex              *sendersIndex
+	numAddsInProgress         int64
+	numBytesInProgress        int64
+	replacementEnabled        bool
+	minGasPriceBumpPercentage uint32
+	evictionHandler           func(tx *WrappedTransaction)
+	isPinnedSender            func(sender []byte) bool
+}
+
+type senderUsage struct {
+	numTxs   uint32
+	numBytes int64
+}
+
+// NewGuardedTxCache creates a new guarded transaction cache
+func NewGuardedTxCache(args ArgsGuardedTxCache) (*GuardedTxCache, error) {
+	if args.ReplacementEnabled && args.MinGasPriceBumpPercentage == 0 {
+		return nil, ErrInvalidGasPriceBump
+	}
+
+	cache, err := txcache.NewTxCache(args.Config, args.TxGasHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	evictionHandler := args.EvictionHandler
+	if evictionHandler == nil {
+		evictionHandler = func(_ *WrappedTransaction) {}
+	}
+	isPinnedSender := args.IsPinnedSender
+	if isPinnedSender == nil {
+		isPinnedSender = func(_ []byte) bool { return false }
+	}
+
+	return &GuardedTxCache{
+		TxCache:                   cache,
+		config:                    args.Config,
+		sendersUsage:              make(map[string]*senderUsage),
+		replacementEnabled:        args.ReplacementEnabled,
+		minGasPriceBumpPercentage: args.MinGasPriceBumpPercentage,
+		evictionHandler:           evictionHandler,
+		isPinnedSender:            isPinnedSender,
+	}, nil
+}
+
+// AddTx adds a transaction in the cache, without applying any admission policy or replacement. It returns false as
+// second value if the transaction is already in the cache or if it is evicted right away, because of the sender limits
+func (cache *GuardedTxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
+	if tx == nil || check.IfNil(tx.Tx) {
+		return false, false
+	}
+
+	mutSender := cache.getSenderLock(tx.Tx.GetSndAddr())
+	mutSender.Lock()
+	defer mutSender.Unlock()
+
+	ok, added, _ = cache.addTx(tx, nil, nil)
+
+	return ok, added
+}
+
+// AddTxWithPolicy adds the transaction if the provided policy accepts it, then removes the pending transactions of the
+// sender selected by the policy for eviction. If the replacement is enabled, the pending transactions having the same
+// sender and nonce are replaced by the added transaction, which is rejected if its gas price is not high enough.
+// The transactions to be replaced are not seen by the policy and are not accounted against the sender limits or the
+// capacity of the cache. It returns true if the transaction was added and kept in the cache
+func (cache *GuardedTxCache) AddTxWithPolicy(tx *WrappedTransaction, policy AdmissionPolicy) (bool, error) {
+	if tx == nil || check.IfNil(tx.Tx) {
+		return false, nil
+	}
+	if check.IfNil(policy) {
+		return false, ErrNilAdmissionPolicy
+	}
+
+	sender := tx.Tx.GetSndAddr()
+	mutSender := cache.getSenderLock(sender)
+	mutSender.Lock()
+	defer mutSender.Unlock()
+
+	_, exists := cache.GetByTxHash(tx.TxHash)
+	if exists {
+		return false, nil
+	}
+
+	txsToReplace, err := cache.selectTxsToReplace(tx)
+	if err != nil {
+		return false, err
+	}
+
+	var senderTxs []*WrappedTransaction
+	getSenderTxs := func() []*WrappedTransaction {
+		if senderTxs == nil {
+			senderTxs = excludeTxs(cache.GetTransactionsPoolForSender(string(sender)), txsToReplace)
+		}
+		return senderTxs
+	}
+
+	err = policy.CheckAdmission(tx, getSenderTxs)
+	if err != nil {
+		return false, err
+	}
+
+	var senderTxsAfterAdd []*WrappedTransaction
+	getSenderTxsAfterAdd := func() []*WrappedTransaction {
+		if senderTxsAfterAdd == nil {
+			senderTxsAfterAdd = insertInSenderList(getSenderTxs(), tx)
+		}
+		return senderTxsAfterAdd
+	}
+
+	txsToEvict := make([]*WrappedTransaction, 0)
+	for _, txHash := range policy.SelectTxsToEvict(tx, getSenderTxsAfterAdd) {
+		if bytes.Equal(txHash, tx.TxHash) {
+			return false, nil
+		}
+
+		txToEvict := findTx(getSenderTxsAfterAdd(), txHash)
+		if txToEvict != nil {
+			txsToEvict = append(txsToEvict, txToEvict)
+		}
+	}
+
+	_, added, numEvicted := cache.addTx(tx, txsToReplace, txsToEvict)
+	policy.OnTxsEvicted(numEvicted)
+
+	return added, nil
+}
+
+// addTx adds the transaction while the lock of its sender is held. The provided transactions of the same sender, replaced
+// or selected by the admission policy, and the pending transactions which would exceed the sender limits are evicted
+// before the insertion, so the cache does not evict other transactions of the sender on its own. Nothing is evicted if
+// the transaction itself would exceed the sender limits. The insertion is done exclusively if it would exceed the
+// capacity of the cache. It also returns the number of transactions selected by the admission policy which were evicted
+func (cache *GuardedTxCache) addTx(tx *WrappedTransaction, txsToReplace []*WrappedTransaction, txsToEvict []*WrappedTransaction) (bool, bool, int) {
+	_, exists := cache.GetByTxHash(tx.TxHash)
+	if exists {
+		return true, false, 0
+	}
+
+	txsToRemove := make([]*WrappedTransaction, 0, len(txsToReplace)+len(txsToEvict))
+	txsToRemove = append(txsToRemove, txsToReplace...)
+	txsToRemove = append(txsToRemove, txsToEvict...)
+	txsExceedingLimits, isKept := cache.selectTxsExceedingSenderLimits(tx, txsToRemove)
+	if !isKept {
+		return true, false, 0
+	}
+
+	for _, txToReplace := range txsToReplace {
+		cache.evictTx(txToReplace)
+	}
+	numEvicted := 0
+	for _, txToEvict := range txsToEvict {
+		if cache.evictTx(txToEvict) {
+			numEvicted++
+		}
+	}
+	for _, txToEvict := range txsExceedingLimits {
+		cache.evictTx(txToEvict)
+	}
+
+	ok, added := cache.addTxWithinCapacity(tx)
+	if added {
+		cache.updateSenderUsage(tx, 1)
+	}
+
+	return ok, added, numEvicted
+}
+
+// selectTxsExceedingSenderLimits returns the pending transactions which the cache would evict, after the removal of the
+// provided transactions and the insertion of the new one, because of the sender limits. The last transactions of the
+// sender list are selected first. It returns false if the new transaction itself would be evicted.
+// The sender list is fetched only if the tracked usage of the sender is not known to be within the limits
+func (cache *GuardedTxCache) selectTxsExceedingSenderLimits(tx *WrappedTransaction, txsToRemove []*WrappedTransaction) ([]*WrappedTransaction, bool) {
+	sender := tx.Tx.GetSndAddr()
+	numTxs, numBytes, isTracked := cache.getSenderUsage(sender)
+	if isTracked {
+		numTxs += 1 - int64(len(txsToRemove))
+		numBytes += tx.Size - sumSizes(txsToRemove)
+		if cache.isWithinSenderLimits(numTxs, numBytes) {
+			return nil, true
+		}
+	}
+
+	senderTxs := cache.GetTransactionsPoolForSender(string(sender))
+	cache.setSenderUsage(sender, senderTxs)
+
+	numTxs = int64(len(senderTxs) + 1 - len(txsToRemove))
+	numBytes = sumSizes(senderTxs) + tx.Size - sumSizes(txsToRemove)
+	if cache.isWithinSenderLimits(numTxs, numBytes) {
+		return nil, true
+	}
+
+	senderTxs = insertInSenderList(excludeTxs(senderTxs, txsToRemove), tx)
+
+	isKept := true
+	txsExceedingLimits := make([]*WrappedTransaction, 0)
+	for i := len(senderTxs) - 1; i >= 0; i-- {
+		if cache.isWithinSenderLimits(numTxs, numBytes) {
+			break
+		}
+
+		numTxs--
+		numBytes -= senderTxs[i].Size
+		if senderTxs[i] == tx {
+			isKept = false
+			continue
+		}
+
+		txsExceedingLimits = append(txsExceedingLimits, senderTxs[i])
+	}
+
+	return txsExceedingLimits, isKept
+}
+
+func (cache *GuardedTxCache) isWithinSenderLimits(numTxs int64, numBytes int64) bool {
+	return numTxs <= int64(cache.config.CountPerSenderThreshold) &&
+		numBytes <= int64(cache.config.NumBytesPerSenderThreshold)
+}
+
+// getSenderUsage returns the number of transactions and of bytes tracked for the sender. The tracked usage is never
+// lower than the real one, as the transactions removed by the cache on its own are not accounted
+func (cache *GuardedTxCache) getSenderUsage(sender []byte) (int64, int64, bool) {
+	cache.mutSendersUsage.Lock()
+	defer cache.mutSendersUsage.Unlock()
+
+	usage, isTracked := cache.sendersUsage[string(sender)]
+	if !isTracked {
+		return 0, 0, false
+	}
+
+	return int64(usage.numTxs), usage.numBytes, true
+}
+
+// setSenderUsage tracks the usage of the sender from its pending transactions. Only the senders having many pending
+// transactions are tracked, the list of the other ones being cheap enough to be fetched on each insertion
+func (cache *GuardedTxCache) setSenderUsage(sender []byte, senderTxs []*WrappedTransaction) {
+	cache.mutSendersUsage.Lock()
+	defer cache.mutSendersUsage.Unlock()
+
+	_, isTracked := cache.sendersUsage[string(sender)]
+	if len(senderTxs) < minNumTxsToTrackSender {
+		if isTracked {
+			delete(cache.sendersUsage, string(sender))
+		}
+		return
+	}
+
+	if !isTracked && len(cache.sendersUsage) >= cache.maxNumTrackedSenders() {
+		// the senders swept by the cache are never untracked, so the tracked usage is dropped once in a while
+		cache.sendersUsage = make(map[string]*senderUsage)
+	}
+
+	cache.sendersUsage[string(sender)] = &senderUsage{
+		numTxs:   uint32(len(senderTxs)),
+		numBytes: sumSizes(senderTxs),
+	}
+}
+
+func (cache *GuardedTxCache) maxNumTrackedSenders() int {
+	return 2*int(cache.CountTx())/minNumTxsToTrackSender + minNumTxsToTrackSender
+}
+
+// updateSenderUsage accounts a transaction added or evicted while the lock of its sender is held
+func (cache *GuardedTxCache) updateSenderUsage(tx *WrappedTransaction, sign int64) {
+	cache.mutSendersUsage.Lock()
+	defer cache.mutSendersUsage.Unlock()
+
+	usage, isTracked := cache.sendersUsage[string(tx.Tx.GetSndAddr())]
+	if !isTracked {
+		return
+	}
+
+	usage.numTxs = uint32(int64(usage.numTxs) + sign)
+	usage.numBytes += sign * tx.Size
+}
+
+// untrackSender drops the tracked usage of the sender, so its list is fetched on the next insertion. Used for the
+// transactions removed without the lock of their sender, which is always safe, as opposed to decreasing the usage
+func (cache *GuardedTxCache) untrackSender(sender []byte) {
+	cache.mutSendersUsage.Lock()
+	_, isTracked := cache.sendersUsage[string(sender)]
+	if isTracked {
+		delete(cache.sendersUsage, string(sender))
+	}
+	cache.mutSendersUsage.Unlock()
+}
+
+// insertInSenderList returns a new list with the transaction inserted in the sorted list of its sender, in the order
+// kept by the cache: ascending by nonce, then descending by gas price, then ascending by hash
+func insertInSenderList(senderTxs []*WrappedTransaction, tx *WrappedTransaction) []*WrappedTransaction {
+	index := sort.Search(len(senderTxs), func(i int) bool {
+		return !isBeforeInSenderList(senderTxs[i], tx)
+	})
+
+	txs := make([]*WrappedTransaction, 0, len(senderTxs)+1)
+	txs = append(txs, senderTxs[:index]...)
+	txs = append(txs, tx)
+
+	return append(txs, senderTxs[index:]...)
+}
+
+// isBeforeInSenderList returns true if the first transaction is placed before the second one in the list of their sender:
+// ascending by nonce, then descending by gas price, then ascending by hash
+func isBeforeInSenderList(first *WrappedTransaction, second *WrappedTransaction) bool {
+	if first.Tx.GetNonce() != second.Tx.GetNonce() {
+		return first.Tx.GetNonce() < second.Tx.GetNonce()
+	}
+	if first.Tx.GetGasPrice() != second.Tx.GetGasPrice() {
+		return first.Tx.GetGasPrice() > second.Tx.GetGasPrice()
+	}
+
+	return bytes.Compare(first.TxHash, second.TxHash) < 0
+}
+
+// addTxWithinCapacity adds the transaction under the eviction read lock if all the transactions being added fit in the
+// cache, otherwise exclusively, as the cache might evict senders in order to make room for it
+func (cache *GuardedTxCache) addTxWithinCapacity(tx *WrappedTransaction) (bool, bool) {
+	cache.mutEviction.RLock()
+	isReserved := cache.reserveCapacity(tx)
+	if isReserved {
+		ok, added := cache.TxCache.AddTx(tx)
+		if added {
+			cache.indexTx(tx)
+		}
+		cache.releaseCapacity(tx)
+		cache.mutEviction.RUnlock()
+
+		return ok, added
+	}
+	cache.releaseCapacity(tx)
+	cache.mutEviction.RUnlock()
+
+	return cache.addTxEvictingSenders(tx)
+}
+
+// reserveCapacity accounts the provided transaction as being added and returns true if all the transactions being added
+// fit in the cache, so the cache will not evict senders while adding them. Should be called under the eviction read lock
+func (cache *GuardedTxCache) reserveCapacity(tx *WrappedTransaction) bool {
+	numAdds := atomic.AddInt64(&cache.numAddsInProgress, 1)
+	numBytes := atomic.AddInt64(&cache.numBytesInProgress, tx.Size)
+	if !cache.config.EvictionEnabled {
+		return true
+	}
+
+	tooManyTxs := int64(cache.CountTx())+numAdds > int64(cache.config.CountThreshold)
+	tooManySenders := int64(cache.CountSenders())+numAdds > int64(cache.config.CountThreshold)
+	tooManyBytes := int64(cache.NumBytes())+numBytes > int64(cache.config.NumBytesThreshold)
+
+	return !tooManyTxs && !tooManySenders && !tooManyBytes
+}
+
+func (cache *GuardedTxCache) releaseCapacity(tx *WrappedTransaction) {
+	atomic.AddInt64(&cache.numAddsInProgress, -1)
+	atomic.AddInt64(&cache.numBytesInProgress, -tx.Size)
+}
+
+// isCapacityExceeded mirrors the check done by the cache before an insertion: senders are evicted only if it holds
+func (cache *GuardedTxCache) isCapacityExceeded() bool {
+	return cache.CountTx() > uint64(cache.config.CountThreshold) ||
+		cache.CountSenders() > uint64(cache.config.CountThreshold) ||
+		cache.NumBytes() > int(cache.config.NumBytesThreshold)
+}
+
+// addTxEvictingSenders adds the transaction exclusively. The cache does not notify the senders it evicts, so, only if
+// the capacity is already exceeded and the cache is about to evict, the indexed senders missing afterwards are looked
+// up and their transactions are passed to the eviction handler. The index is built on the first eviction and kept
+// afterwards. The indexed senders already missing before the insertion were swept by the cache after a selection and
+// are only dropped from the index. The evicted transactions of the pinned senders are added back only while the
+// capacity is not exceeded, so the cache does not evict again
+func (cache *GuardedTxCache) addTxEvictingSenders(tx *WrappedTransaction) (bool, bool) {
+	cache.mutEviction.Lock()
+	defer cache.mutEviction.Unlock()
+
+	if !cache.config.EvictionEnabled || !cache.isCapacityExceeded() {
+		ok, added := cache.TxCache.AddTx(tx)
+		if added {
+			cache.indexTx(tx)
+		}
+
+		return ok, added
+	}
+
+	if cache.sendersIndex == nil {
+		cache.sendersIndex = cache.createSendersIndex()
+	} else {
+		_ = cache.sendersIndex.popMissingSendersTxs(cache.isInCache)
+	}
+
+	ok, added := cache.TxCache.AddTx(tx)
+
+	for _, evictedTx := range cache.sendersIndex.popMissingSendersTxs(cache.isInCache) {
+		sender := evictedTx.Tx.GetSndAddr()
+		cache.untrackSender(sender)
+
+		if cache.isPinnedSender(sender) && !cache.isCapacityExceeded() {
+			_, readded := cache.TxCache.AddTx(evictedTx)
+			if readded {
+				cache.indexTx(evictedTx)
+				continue
+			}
+		}
+
+		cache.evictionHandler(evictedTx)
+	}
+
+	if added {
+		cache.indexTx(tx)
+	}
+
+	return ok, added
+}
+
+// createSendersIndex indexes all the transactions of the cache. Should be called under the eviction lock
+func (cache *GuardedTxCache) createSendersIndex() *sendersIndex {
+	index := newSendersIndex()
+	cache.ForEachTransaction(func(_ []byte, cachedTx *WrappedTransaction) {
+		_ = index.add(cachedTx)
+	})
+
+	return index
+}
+
+// indexTx adds the transaction to the index of its sender, if the index was built. The senders swept by the cache are
+// dropped from the index once in a while. Should be called under the eviction lock
+func (cache *GuardedTxCache) indexTx(tx *WrappedTransaction) {
+	if cache.sendersIndex == nil {
+		return
+	}
+
+	numSenders := cache.sendersIndex.add(tx)
+	if numSenders > 2*int(cache.CountSenders())+minNumTxsToTrackSender {
+		_ = cache.sendersIndex.popMissingSendersTxs(cache.isInCache)
+	}
+}
+
+func (cache *GuardedTxCache) isInCache(tx *WrappedTransaction) bool {
+	_, found := cache.GetByTxHash(tx.TxHash)
+	return found
+}
+
+// RemoveTxByHash removes a transaction by hash
+func (cache *GuardedTxCache) RemoveTxByHash(txHash []byte) bool {
+	tx, found := cache.GetByTxHash(txHash)
+	if !found {
+		return false
+	}
+
+	removed := cache.removeTx(tx)
+	if removed {
+		cache.untrackSender(tx.Tx.GetSndAddr())
+	}
+
+	return removed
+}
+
+func (cache *GuardedTxCache) removeTx(tx *WrappedTransaction) bool {
+	cache.mutEviction.RLock()
+	defer cache.mutEviction.RUnlock()
+
+	removed := cache.TxCache.RemoveTxByHash(tx.TxHash)
+	if removed && cache.sendersIndex != nil {
+		cache.sendersIndex.remove(tx)
+	}
+
+	return removed
+}
+
+// Clear clears the cache
+func (cache *GuardedTxCache) Clear() {
+	cache.mutEviction.Lock()
+	defer cache.mutEviction.Unlock()
+
+	cache.TxCache.Clear()
+
+	cache.mutSendersUsage.Lock()
+	cache.sendersUsage = make(map[string]*senderUsage)
+	cache.mutSendersUsage.Unlock()
+
+	cache.sendersIndex = nil
+}
+
+// evictTx removes a transaction of the sender whose lock is held and passes it to the eviction handler. It returns true
+// if the transaction was removed
+func (cache *GuardedTxCache) evictTx(tx *WrappedTransaction) bool {
+	if tx == nil {
+		return false
+	}
+
+	if !cache.removeTx(tx) {
+		return false
+	}
+
+	cache.updateSenderUsage(tx, -1)
+	cache.evictionHandler(tx)
+
+	return true
+}
+
+// GetTxsReplacedBy returns the pending transactions which would be replaced if the provided transaction was added in the
+// cache. The result is only a prediction, as the pending transactions can change until the transaction is received
+func (cache *GuardedTxCache) GetTxsReplacedBy(tx *WrappedTransaction) ([]*WrappedTransaction, error) {
+	if tx == nil || check.IfNil(tx.Tx) {
+		return nil, nil
+	}
+
+	return cache.selectTxsToReplace(tx)
+}
+
+// selectTxsToReplace returns the pending transactions of the sender having the same nonce as the provided transaction.
+// An error is returned if the gas price of the provided transaction is not high enough to replace all of them
+func (cache *GuardedTxCache) selectTxsToReplace(tx *WrappedTransaction) ([]*WrappedTransaction, error) {
+	if !cache.replacementEnabled {
+		return nil, nil
+	}
+
+	txsToReplace := make([]*WrappedTransaction, 0)
+	senderTxs := cache.GetTransactionsPoolForSender(string(tx.Tx.GetSndAddr()))
+	for _, senderTx := range senderTxs {
+		if senderTx.Tx.GetNonce() != tx.Tx.GetNonce() || bytes.Equal(senderTx.TxHash, tx.TxHash) {
+			continue
+		}
+		if !cache.isGasPriceBumpEnough(senderTx.Tx.GetGasPrice(), tx.Tx.GetGasPrice()) {
+			return nil, ErrTxReplacementUnderpriced
+		}
+
+		txsToReplace = append(txsToReplace, senderTx)
+	}
+
+	return txsToReplace, nil
+}
+
+// isGasPriceBumpEnough returns true if the new gas price is higher than the old one by at least the configured percentage
+func (cache *GuardedTxCache) isGasPriceBumpEnough(oldGasPrice uint64, newGasPrice uint64) bool {
+	minGasPrice := big.NewInt(0).SetUint64(oldGasPrice)
+	minGasPrice.Mul(minGasPrice, big.NewInt(100+int64(cache.minGasPriceBumpPercentage)))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+
+	return big.NewInt(0).SetUint64(newGasPrice).Cmp(minGasPrice) >= 0
+}
+
+func (cache *GuardedTxCache) getSenderLock(sender []byte) *sync.Mutex {
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(sender)
+
+	return &cache.sendersLocks[hasher.Sum32()%numSendersLocks]
+}
+
+func findTx(txs []*WrappedTransaction, txHash []byte) *WrappedTransaction {
+	for _, tx := range txs {
+		if bytes.Equal(tx.TxHash, txHash) {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+func sumSizes(txs []*WrappedTransaction) int64 {
+	numBytes := int64(0)
+	for _, tx := range txs {
+		numBytes += tx.Size
+	}
+
+	return numBytes
+}
+
+func containsTx(txs []*WrappedTransaction, txHash []byte) bool {
+	return findTx(txs, txHash) != nil
+}
+
+func excludeTxs(txs []*WrappedTransaction, txsToExclude []*WrappedTransaction) []*WrappedTransaction {
+	if len(txsToExclude) == 0 {
+		return txs
+	}
+
+	filteredTxs := make([]*WrappedTransaction, 0, len(txs))
+	for _, tx := range txs {
+		if !containsTx(txsToExclude, tx.TxHash) {
+			filteredTxs = append(filteredTxs, tx)
+		}
+	}
+
+	return filteredTxs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *GuardedTxCache) IsInterfaceNil() bool {
+	return cache == nil
+}
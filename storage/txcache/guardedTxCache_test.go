@@ -0,0 +1,560 @@
+package txcache
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type evictedTxsRecorder struct {
+	mut        sync.Mutex
+	evictedTxs []*WrappedTransaction
+}
+
+func (recorder *evictedTxsRecorder) onEvicted(tx *WrappedTransaction) {
+	recorder.mut.Lock()
+	recorder.evictedTxs = append(recorder.evictedTxs, tx)
+	recorder.mut.Unlock()
+}
+
+func (recorder *evictedTxsRecorder) popEvictedHashes() []string {
+	recorder.mut.Lock()
+	defer recorder.mut.Unlock()
+
+	hashes := make([]string, 0, len(recorder.evictedTxs))
+	for _, tx := range recorder.evictedTxs {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+	recorder.evictedTxs = nil
+
+	return hashes
+}
+
+func createTestConfig() ConfigSourceMe {
+	return ConfigSourceMe{
+		Name:                          "test",
+		NumChunks:                     1,
+		NumBytesThreshold:             1000000,
+		NumBytesPerSenderThreshold:    100000,
+		CountThreshold:                100,
+		CountPerSenderThreshold:       100,
+		NumSendersToPreemptivelyEvict: 1,
+	}
+}
+
+func createGuardedTxCacheToTest(t *testing.T) (*GuardedTxCache, *evictedTxsRecorder) {
+	return createGuardedTxCacheWithConfigToTest(t, createTestConfig(), false, 0)
+}
+
+func createGuardedTxCacheWithReplacementToTest(t *testing.T, replacementEnabled bool, minGasPriceBumpPercentage uint32) (*GuardedTxCache, *evictedTxsRecorder) {
+	return createGuardedTxCacheWithConfigToTest(t, createTestConfig(), replacementEnabled, minGasPriceBumpPercentage)
+}
+
+func createGuardedTxCacheWithConfigToTest(
+	t *testing.T,
+	cfg ConfigSourceMe,
+	replacementEnabled bool,
+	minGasPriceBumpPercentage uint32,
+) (*GuardedTxCache, *evictedTxsRecorder) {
+	recorder := &evictedTxsRecorder{}
+	cache, err := NewGuardedTxCache(ArgsGuardedTxCache{
+		Config: cfg,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			GasProcessingDivisor: 1,
+			MinimumGasPrice:      1,
+			MinimumGasMove:       1,
+		},
+		ReplacementEnabled:        replacementEnabled,
+		MinGasPriceBumpPercentage: minGasPriceBumpPercentage,
+		EvictionHandler:           recorder.onEvicted,
+	})
+	require.Nil(t, err)
+
+	return cache, recorder
+}
+
+func createWrappedTx(hash string, sender string, nonce uint64) *WrappedTransaction {
+	return createWrappedTxWithGasPrice(hash, sender, nonce, 1)
+}
+
+func createWrappedTxWithGasPrice(hash string, sender string, nonce uint64, gasPrice uint64) *WrappedTransaction {
+	return &WrappedTransaction{
+		Tx: &transaction.Transaction{
+			SndAddr:  []byte(sender),
+			Nonce:    nonce,
+			GasLimit: 50000,
+			GasPrice: gasPrice,
+		},
+		TxHash: []byte(hash),
+	}
+}
+
+func TestNewGuardedTxCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := NewGuardedTxCache(ArgsGuardedTxCache{
+			Config:       ConfigSourceMe{},
+			TxGasHandler: &txcachemocks.TxGasHandlerMock{},
+		})
+		assert.Nil(t, cache)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid gas price bump should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := NewGuardedTxCache(ArgsGuardedTxCache{
+			ReplacementEnabled:        true,
+			MinGasPriceBumpPercentage: 0,
+		})
+		assert.Nil(t, cache)
+		assert.Equal(t, ErrInvalidGasPriceBump, err)
+	})
+	t.Run("nil eviction handler should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.CountPerSenderThreshold = 1
+		cache, err := NewGuardedTxCache(ArgsGuardedTxCache{
+			Config:       cfg,
+			TxGasHandler: &txcachemocks.TxGasHandlerMock{GasProcessingDivisor: 1, MinimumGasPrice: 1, MinimumGasMove: 1},
+		})
+		require.Nil(t, err)
+
+		_, _ = cache.AddTx(createWrappedTx("hash-2", "alice", 2))
+		_, added := cache.AddTx(createWrappedTx("hash-1", "alice", 1))
+		assert.True(t, added)
+		assert.Equal(t, 1, cache.Len())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := createGuardedTxCacheToTest(t)
+		assert.False(t, cache.IsInterfaceNil())
+	})
+}
+
+func TestGuardedTxCache_AddTxWithPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, recorder := createGuardedTxCacheToTest(t)
+		added, err := cache.AddTxWithPolicy(createWrappedTx("hash", "alice", 1), nil)
+		assert.False(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Equal(t, ErrNilAdmissionPolicy, err)
+	})
+	t.Run("rejected transaction should not be added", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("rejected")
+		cache, recorder := createGuardedTxCacheToTest(t)
+		added, err := cache.AddTxWithPolicy(createWrappedTx("hash", "alice", 1), &txcachemocks.TxPoolPolicyStub{
+			CheckAdmissionCalled: func(tx *WrappedTransaction, getSenderTxs func() []*WrappedTransaction) error {
+				return expectedErr
+			},
+		})
+		assert.False(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, cache.Len())
+	})
+	t.Run("selected transactions should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		cache, recorder := createGuardedTxCacheToTest(t)
+		numEvicted := 0
+		keepLowestNonce := &txcachemocks.TxPoolPolicyStub{
+			SelectTxsToEvictCalled: func(tx *WrappedTransaction, getSenderTxs func() []*WrappedTransaction) [][]byte {
+				txsToEvict := make([][]byte, 0)
+				for _, senderTx := range getSenderTxs()[1:] {
+					txsToEvict = append(txsToEvict, senderTx.TxHash)
+				}
+
+				return txsToEvict
+			},
+			OnTxsEvictedCalled: func(numTxs int) {
+				numEvicted += numTxs
+			},
+		}
+
+		added, err := cache.AddTxWithPolicy(createWrappedTx("hash-2", "alice", 2), keepLowestNonce)
+		assert.True(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Nil(t, err)
+
+		added, err = cache.AddTxWithPolicy(createWrappedTx("hash-3", "alice", 3), keepLowestNonce)
+		assert.False(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, cache.Len())
+
+		assert.Equal(t, 0, numEvicted)
+
+		added, err = cache.AddTxWithPolicy(createWrappedTx("hash-1", "alice", 1), keepLowestNonce)
+		assert.True(t, added)
+		assert.Equal(t, []string{"hash-2"}, recorder.popEvictedHashes())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, cache.Len())
+		assert.Equal(t, 1, numEvicted)
+	})
+}
+
+func TestGuardedTxCache_AddTxWithPolicyReplacement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replacement disabled should keep both transactions", func(t *testing.T) {
+		t.Parallel()
+
+		cache, recorder := createGuardedTxCacheToTest(t)
+		_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-low", "alice", 1, 100), &txcachemocks.TxPoolPolicyStub{})
+		added, err := cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-high", "alice", 1, 200), &txcachemocks.TxPoolPolicyStub{})
+		assert.True(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, cache.Len())
+	})
+	t.Run("underpriced replacement should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, recorder := createGuardedTxCacheWithReplacementToTest(t, true, 10)
+		_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-low", "alice", 1, 100), &txcachemocks.TxPoolPolicyStub{})
+		added, err := cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-high", "alice", 1, 109), &txcachemocks.TxPoolPolicyStub{})
+		assert.False(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Equal(t, ErrTxReplacementUnderpriced, err)
+		assert.Equal(t, 1, cache.Len())
+	})
+	t.Run("replaced transaction should be removed and not seen by the policy", func(t *testing.T) {
+		t.Parallel()
+
+		cache, recorder := createGuardedTxCacheWithReplacementToTest(t, true, 10)
+		onePendingTxPerSender := &txcachemocks.TxPoolPolicyStub{
+			CheckAdmissionCalled: func(tx *WrappedTransaction, getSenderTxs func() []*WrappedTransaction) error {
+				if len(getSenderTxs()) > 0 {
+					return errors.New("too many txs for sender")
+				}
+
+				return nil
+			},
+		}
+		_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-low", "alice", 1, 100), onePendingTxPerSender)
+		added, err := cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-high", "alice", 1, 110), onePendingTxPerSender)
+		assert.True(t, added)
+		assert.Equal(t, []string{"hash-low"}, recorder.popEvictedHashes())
+		assert.Nil(t, err)
+		assert.Equal(t, 1, cache.Len())
+	})
+}
+
+func TestGuardedTxCache_AddTxWithPolicyReplacementAtSenderLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replacement at the count per sender should only evict the replaced transaction", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.CountPerSenderThreshold = 2
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, true, 10)
+
+		_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-1", "alice", 1, 100), &txcachemocks.TxPoolPolicyStub{})
+		_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-2", "alice", 2, 100), &txcachemocks.TxPoolPolicyStub{})
+		added, err := cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-1-bis", "alice", 1, 200), &txcachemocks.TxPoolPolicyStub{})
+		assert.True(t, added)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash-1"}, recorder.popEvictedHashes())
+		assert.Equal(t, 2, cache.Len())
+		_, found := cache.GetByTxHash([]byte("hash-2"))
+		assert.True(t, found)
+	})
+	t.Run("replacement at the bytes per sender should only evict the replaced transaction", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.NumBytesPerSenderThreshold = 200
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, true, 10)
+
+		txs := []*WrappedTransaction{
+			createWrappedTxWithGasPrice("hash-1", "alice", 1, 100),
+			createWrappedTxWithGasPrice("hash-2", "alice", 2, 100),
+			createWrappedTxWithGasPrice("hash-1-bis", "alice", 1, 200),
+		}
+		for _, tx := range txs {
+			tx.Size = 100
+			_, _ = cache.AddTxWithPolicy(tx, &txcachemocks.TxPoolPolicyStub{})
+		}
+
+		assert.Equal(t, []string{"hash-1"}, recorder.popEvictedHashes())
+		assert.Equal(t, 2, cache.Len())
+		_, found := cache.GetByTxHash([]byte("hash-2"))
+		assert.True(t, found)
+	})
+	t.Run("larger replacement exceeding the bytes per sender should evict the last transactions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.NumBytesPerSenderThreshold = 200
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, true, 10)
+
+		for _, tx := range []*WrappedTransaction{
+			createWrappedTxWithGasPrice("hash-1", "alice", 1, 100),
+			createWrappedTxWithGasPrice("hash-2", "alice", 2, 100),
+		} {
+			tx.Size = 100
+			_, _ = cache.AddTxWithPolicy(tx, &txcachemocks.TxPoolPolicyStub{})
+		}
+		replacement := createWrappedTxWithGasPrice("hash-1-bis", "alice", 1, 200)
+		replacement.Size = 150
+		added, err := cache.AddTxWithPolicy(replacement, &txcachemocks.TxPoolPolicyStub{})
+		assert.True(t, added)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash-1", "hash-2"}, recorder.popEvictedHashes())
+		assert.Equal(t, 1, cache.Len())
+	})
+	t.Run("replacement exceeding the bytes per sender by itself should keep the replaced transaction", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.NumBytesPerSenderThreshold = 200
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, true, 10)
+
+		_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-1", "alice", 1, 100), &txcachemocks.TxPoolPolicyStub{})
+		replacement := createWrappedTxWithGasPrice("hash-1-bis", "alice", 1, 200)
+		replacement.Size = 300
+		added, err := cache.AddTxWithPolicy(replacement, &txcachemocks.TxPoolPolicyStub{})
+		assert.False(t, added)
+		assert.Nil(t, err)
+		assert.Empty(t, recorder.popEvictedHashes())
+		_, found := cache.GetByTxHash([]byte("hash-1"))
+		assert.True(t, found)
+	})
+}
+
+func TestGuardedTxCache_AddTxSenderLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("transactions exceeding the count per sender should be evicted and notified", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.CountPerSenderThreshold = 2
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+		_, _ = cache.AddTx(createWrappedTx("hash-2", "alice", 2))
+		_, _ = cache.AddTx(createWrappedTx("hash-3", "alice", 3))
+		assert.Empty(t, recorder.popEvictedHashes())
+
+		_, added := cache.AddTx(createWrappedTx("hash-1", "alice", 1))
+		assert.True(t, added)
+		assert.Equal(t, []string{"hash-3"}, recorder.popEvictedHashes())
+		assert.Equal(t, 2, cache.Len())
+
+		_, added = cache.AddTx(createWrappedTx("hash-4", "alice", 4))
+		assert.False(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Equal(t, 2, cache.Len())
+
+		_, added = cache.AddTx(createWrappedTx("hash-bob", "bob", 4))
+		assert.True(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+	})
+	t.Run("transactions exceeding the bytes per sender should be evicted and notified", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.NumBytesPerSenderThreshold = 250
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+		txs := []*WrappedTransaction{
+			createWrappedTx("hash-2", "alice", 2),
+			createWrappedTx("hash-3", "alice", 3),
+			createWrappedTx("hash-1", "alice", 1),
+		}
+		for _, tx := range txs {
+			tx.Size = 100
+			_, _ = cache.AddTx(tx)
+		}
+
+		assert.Equal(t, []string{"hash-3"}, recorder.popEvictedHashes())
+		assert.Equal(t, 2, cache.Len())
+	})
+	t.Run("tracked sender usage should follow the evicted and removed transactions", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.CountPerSenderThreshold = minNumTxsToTrackSender + 2
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+		for nonce := 1; nonce <= int(cfg.CountPerSenderThreshold); nonce++ {
+			_, _ = cache.AddTx(createWrappedTx(fmt.Sprintf("hash-%d", nonce), "alice", uint64(nonce)))
+		}
+		assert.Empty(t, recorder.popEvictedHashes())
+
+		_, added := cache.AddTx(createWrappedTx("hash-0", "alice", 0))
+		assert.True(t, added)
+		assert.Equal(t, []string{fmt.Sprintf("hash-%d", cfg.CountPerSenderThreshold)}, recorder.popEvictedHashes())
+
+		assert.True(t, cache.RemoveTxByHash([]byte("hash-1")))
+		_, added = cache.AddTx(createWrappedTx("hash-100", "alice", 100))
+		assert.True(t, added)
+		assert.Empty(t, recorder.popEvictedHashes())
+		assert.Equal(t, int(cfg.CountPerSenderThreshold), cache.Len())
+
+		cache.Clear()
+		_, added = cache.AddTx(createWrappedTx("hash-1", "alice", 1))
+		assert.True(t, added)
+		assert.Equal(t, 1, cache.Len())
+	})
+	t.Run("transactions evicted by the policy path should be notified", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.CountPerSenderThreshold = 1
+		cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+		_, _ = cache.AddTxWithPolicy(createWrappedTx("hash-2", "alice", 2), &txcachemocks.TxPoolPolicyStub{})
+		added, err := cache.AddTxWithPolicy(createWrappedTx("hash-1", "alice", 1), &txcachemocks.TxPoolPolicyStub{})
+		assert.True(t, added)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"hash-2"}, recorder.popEvictedHashes())
+	})
+}
+
+func TestGuardedTxCache_AddTxCapacity(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestConfig()
+	cfg.EvictionEnabled = true
+	cfg.CountThreshold = 4
+	cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+	senders := []string{"alice", "bob", "carol", "dave", "eve", "frank"}
+	for i, sender := range senders {
+		_, _ = cache.AddTx(createWrappedTx("hash-"+sender, sender, uint64(i)))
+	}
+
+	evictedHashes := recorder.popEvictedHashes()
+	assert.NotEmpty(t, evictedHashes)
+	assert.Equal(t, len(senders), cache.Len()+len(evictedHashes))
+	for _, hash := range evictedHashes {
+		_, found := cache.GetByTxHash([]byte(hash))
+		assert.False(t, found)
+	}
+}
+
+func TestGuardedTxCache_AddTxCapacityPinnedSender(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestConfig()
+	cfg.EvictionEnabled = true
+	cfg.CountThreshold = 4
+	cfg.NumSendersToPreemptivelyEvict = 3
+	recorder := &evictedTxsRecorder{}
+	cache, err := NewGuardedTxCache(ArgsGuardedTxCache{
+		Config: cfg,
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			GasProcessingDivisor: 1,
+			MinimumGasPrice:      1,
+			MinimumGasMove:       1,
+		},
+		EvictionHandler: recorder.onEvicted,
+		IsPinnedSender: func(sender []byte) bool {
+			return string(sender) == "alice"
+		},
+	})
+	require.Nil(t, err)
+
+	_, _ = cache.AddTx(createWrappedTxWithGasPrice("hash-alice", "alice", 1, 1))
+	senders := []string{"bob", "carol", "dave", "eve", "frank", "grace"}
+	numAdded := 1
+	for _, sender := range senders {
+		_, added := cache.AddTx(createWrappedTxWithGasPrice("hash-"+sender, sender, 1, 1000))
+		if added {
+			numAdded++
+		}
+	}
+
+	evictedHashes := recorder.popEvictedHashes()
+	assert.NotEmpty(t, evictedHashes)
+	assert.NotContains(t, evictedHashes, "hash-alice")
+	assert.Equal(t, numAdded, cache.Len()+len(evictedHashes))
+	_, found := cache.GetByTxHash([]byte("hash-alice"))
+	assert.True(t, found)
+}
+
+func TestGuardedTxCache_AddTxCapacityRemovedSender(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestConfig()
+	cfg.EvictionEnabled = true
+	cfg.CountThreshold = 4
+	cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+	senders := []string{"alice", "bob", "carol", "dave", "eve"}
+	for _, sender := range senders {
+		_, _ = cache.AddTx(createWrappedTx("hash-"+sender, sender, 1))
+	}
+	assert.True(t, cache.RemoveTxByHash([]byte("hash-alice")))
+	assert.Empty(t, recorder.popEvictedHashes())
+
+	_, _ = cache.AddTx(createWrappedTx("hash-frank", "frank", 1))
+	_, _ = cache.AddTx(createWrappedTx("hash-grace", "grace", 1))
+
+	evictedHashes := recorder.popEvictedHashes()
+	assert.NotEmpty(t, evictedHashes)
+	assert.NotContains(t, evictedHashes, "hash-alice")
+	assert.Equal(t, len(senders)+1, cache.Len()+len(evictedHashes))
+
+	assert.Equal(t, cache.Len(), cache.sendersIndex.numSenders())
+}
+
+func TestGuardedTxCache_AddTxConcurrently(t *testing.T) {
+	t.Parallel()
+
+	cfg := createTestConfig()
+	cfg.EvictionEnabled = true
+	cfg.CountThreshold = 20
+	cache, recorder := createGuardedTxCacheWithConfigToTest(t, cfg, false, 0)
+
+	numTxs := 200
+	wg := sync.WaitGroup{}
+	wg.Add(numTxs)
+	for i := 0; i < numTxs; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			sender := string(rune('a' + idx%50))
+			_, _ = cache.AddTx(createWrappedTx(string(rune(idx))+"-hash", sender, uint64(idx)))
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, numTxs, cache.Len()+len(recorder.popEvictedHashes()))
+}
+
+func TestGuardedTxCache_GetTxsReplacedBy(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := createGuardedTxCacheWithReplacementToTest(t, true, 10)
+	_, _ = cache.AddTxWithPolicy(createWrappedTxWithGasPrice("hash-low", "alice", 1, 100), &txcachemocks.TxPoolPolicyStub{})
+
+	txsToReplace, err := cache.GetTxsReplacedBy(createWrappedTxWithGasPrice("hash-high", "alice", 1, 200))
+	assert.Nil(t, err)
+	require.Len(t, txsToReplace, 1)
+	assert.Equal(t, []byte("hash-low"), txsToReplace[0].TxHash)
+
+	txsToReplace, err = cache.GetTxsReplacedBy(createWrappedTxWithGasPrice("hash-same", "alice", 1, 100))
+	assert.Nil(t, txsToReplace)
+	assert.Equal(t, ErrTxReplacementUnderpriced, err)
+	assert.Equal(t, 1, cache.Len())
+}
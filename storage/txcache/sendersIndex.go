@@ -0,0 +1,122 @@
+package txcache
+
+import "sync"
+
+// sendersIndex keeps the transactions added in the cache, by sender, so the transactions of the senders evicted by the
+// cache can be found without walking the whole cache. The removed transactions are only marked, the list of a sender
+// being compacted once half of it is removed
+type sendersIndex struct {
+	mut     sync.Mutex
+	senders map[string]*indexedSenderTxs
+}
+
+type indexedSenderTxs struct {
+	txs     []*WrappedTransaction
+	removed map[*WrappedTransaction]struct{}
+}
+
+func newSendersIndex() *sendersIndex {
+	return &sendersIndex{
+		senders: make(map[string]*indexedSenderTxs),
+	}
+}
+
+// add indexes the transaction and returns the number of indexed senders
+func (index *sendersIndex) add(tx *WrappedTransaction) int {
+	sender := string(tx.Tx.GetSndAddr())
+
+	index.mut.Lock()
+	defer index.mut.Unlock()
+
+	senderTxs, isIndexed := index.senders[sender]
+	if !isIndexed {
+		senderTxs = &indexedSenderTxs{}
+		index.senders[sender] = senderTxs
+	}
+
+	senderTxs.txs = append(senderTxs.txs, tx)
+
+	return len(index.senders)
+}
+
+// remove marks the transaction as removed
+func (index *sendersIndex) remove(tx *WrappedTransaction) {
+	sender := string(tx.Tx.GetSndAddr())
+
+	index.mut.Lock()
+	defer index.mut.Unlock()
+
+	senderTxs, isIndexed := index.senders[sender]
+	if !isIndexed {
+		return
+	}
+
+	if senderTxs.removed == nil {
+		senderTxs.removed = make(map[*WrappedTransaction]struct{})
+	}
+	senderTxs.removed[tx] = struct{}{}
+	if 2*len(senderTxs.removed) < len(senderTxs.txs) {
+		return
+	}
+
+	senderTxs.txs = senderTxs.getTxs()
+	senderTxs.removed = nil
+	if len(senderTxs.txs) == 0 {
+		delete(index.senders, sender)
+	}
+}
+
+// popMissingSendersTxs removes the senders having none of their indexed transactions in the cache anymore and returns
+// their transactions. A sender is looked up by its transactions until one of them is found, so only the missing senders
+// are walked entirely
+func (index *sendersIndex) popMissingSendersTxs(isInCache func(tx *WrappedTransaction) bool) []*WrappedTransaction {
+	index.mut.Lock()
+	defer index.mut.Unlock()
+
+	missingTxs := make([]*WrappedTransaction, 0)
+	for sender, senderTxs := range index.senders {
+		txs := senderTxs.getTxs()
+		if containsAny(txs, isInCache) {
+			continue
+		}
+
+		missingTxs = append(missingTxs, txs...)
+		delete(index.senders, sender)
+	}
+
+	return missingTxs
+}
+
+func (index *sendersIndex) numSenders() int {
+	index.mut.Lock()
+	defer index.mut.Unlock()
+
+	return len(index.senders)
+}
+
+// getTxs returns the indexed transactions not marked as removed
+func (senderTxs *indexedSenderTxs) getTxs() []*WrappedTransaction {
+	if len(senderTxs.removed) == 0 {
+		return senderTxs.txs
+	}
+
+	txs := make([]*WrappedTransaction, 0, len(senderTxs.txs)-len(senderTxs.removed))
+	for _, tx := range senderTxs.txs {
+		_, isRemoved := senderTxs.removed[tx]
+		if !isRemoved {
+			txs = append(txs, tx)
+		}
+	}
+
+	return txs
+}
+
+func containsAny(txs []*WrappedTransaction, predicate func(tx *WrappedTransaction) bool) bool {
+	for _, tx := range txs {
+		if predicate(tx) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendersIndex_Remove(t *testing.T) {
+	t.Parallel()
+
+	index := newSendersIndex()
+	tx1 := createWrappedTx("hash-1", "alice", 1)
+	tx2 := createWrappedTx("hash-2", "alice", 2)
+	tx3 := createWrappedTx("hash-3", "alice", 3)
+	_ = index.add(tx1)
+	_ = index.add(tx2)
+	assert.Equal(t, 2, index.add(createWrappedTx("hash-bob", "bob", 1)))
+	_ = index.add(tx3)
+
+	index.remove(tx2)
+	assert.Equal(t, []*WrappedTransaction{tx1, tx3}, index.senders["alice"].getTxs())
+	assert.Len(t, index.senders["alice"].txs, 3)
+
+	index.remove(tx1)
+	assert.Equal(t, []*WrappedTransaction{tx3}, index.senders["alice"].txs)
+	assert.Nil(t, index.senders["alice"].removed)
+
+	index.remove(tx3)
+	assert.Equal(t, 1, index.numSenders())
+
+	index.remove(createWrappedTx("hash-carol", "carol", 1))
+	assert.Equal(t, 1, index.numSenders())
+}
+
+func TestSendersIndex_PopMissingSendersTxs(t *testing.T) {
+	t.Parallel()
+
+	index := newSendersIndex()
+	aliceTx1 := createWrappedTx("hash-alice-1", "alice", 1)
+	aliceTx2 := createWrappedTx("hash-alice-2", "alice", 2)
+	bobTx1 := createWrappedTx("hash-bob-1", "bob", 1)
+	bobTx2 := createWrappedTx("hash-bob-2", "bob", 2)
+	for _, tx := range []*WrappedTransaction{aliceTx1, aliceTx2, bobTx1, bobTx2} {
+		_ = index.add(tx)
+	}
+
+	inCache := map[*WrappedTransaction]bool{bobTx2: true}
+	isInCache := func(tx *WrappedTransaction) bool {
+		return inCache[tx]
+	}
+
+	assert.ElementsMatch(t, []*WrappedTransaction{aliceTx1, aliceTx2}, index.popMissingSendersTxs(isInCache))
+	assert.Equal(t, 1, index.numSenders())
+	assert.Empty(t, index.popMissingSendersTxs(isInCache))
+}
@@ -4,8 +4,9 @@ import "context"
 
 // ServerStub -
 type ServerStub struct {
-	ListenAndServeCalled func() error
-	ShutdownCalled       func(ctx context.Context) error
+	ListenAndServeCalled    func() error
+	ListenAndServeTLSCalled func(certFile string, keyFile string) error
+	ShutdownCalled          func(ctx context.Context) error
 }
 
 // ListenAndServe -
@@ -16,6 +17,14 @@ func (stub *ServerStub) ListenAndServe() error {
 	return nil
 }
 
+// ListenAndServeTLS -
+func (stub *ServerStub) ListenAndServeTLS(certFile string, keyFile string) error {
+	if stub.ListenAndServeTLSCalled != nil {
+		return stub.ListenAndServeTLSCalled(certFile, keyFile)
+	}
+	return nil
+}
+
 // Shutdown -
 func (stub *ServerStub) Shutdown(ctx context.Context) error {
 	if stub.ShutdownCalled != nil {
@@ -1,11 +1,16 @@
 package api
 
-import "github.com/multiversx/mx-chain-go/api/shared"
+import (
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/config"
+)
 
 // UpgradeableHttpServerHandlerStub -
 type UpgradeableHttpServerHandlerStub struct {
 	StartHttpServerCalled func() error
 	UpdateFacadeCalled    func(facade shared.FacadeHandler) error
+	ValidateConfigCalled  func(configs *config.Configs) error
+	ApplyConfigCalled     func(configs *config.Configs) error
 	CloseCalled           func() error
 }
 
@@ -27,6 +32,24 @@ func (stub *UpgradeableHttpServerHandlerStub) UpdateFacade(facade shared.FacadeH
 	return nil
 }
 
+// ValidateConfig -
+func (stub *UpgradeableHttpServerHandlerStub) ValidateConfig(configs *config.Configs) error {
+	if stub.ValidateConfigCalled != nil {
+		return stub.ValidateConfigCalled(configs)
+	}
+
+	return nil
+}
+
+// ApplyConfig -
+func (stub *UpgradeableHttpServerHandlerStub) ApplyConfig(configs *config.Configs) error {
+	if stub.ApplyConfigCalled != nil {
+		return stub.ApplyConfigCalled(configs)
+	}
+
+	return nil
+}
+
 // Close -
 func (stub *UpgradeableHttpServerHandlerStub) Close() error {
 	if stub.CloseCalled != nil {
@@ -321,6 +321,7 @@ func GetNetworkFactoryArgs() networkComp.NetworkComponentsFactoryArgs {
 		},
 		Syncer:           &p2pFactory.LocalSyncTimer{},
 		CryptoComponents: cryptoCompMock,
+		PathHandler:      &testscommon.PathManagerStub{},
 	}
 }
 
@@ -0,0 +1,32 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/config"
+
+// ConfigReloadHandlerStub -
+type ConfigReloadHandlerStub struct {
+	ValidateConfigCalled func(configs *config.Configs) error
+	ApplyConfigCalled    func(configs *config.Configs) error
+}
+
+// ValidateConfig -
+func (stub *ConfigReloadHandlerStub) ValidateConfig(configs *config.Configs) error {
+	if stub.ValidateConfigCalled != nil {
+		return stub.ValidateConfigCalled(configs)
+	}
+
+	return nil
+}
+
+// ApplyConfig -
+func (stub *ConfigReloadHandlerStub) ApplyConfig(configs *config.Configs) error {
+	if stub.ApplyConfigCalled != nil {
+		return stub.ApplyConfigCalled(configs)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ConfigReloadHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// ConfigReloaderStub -
+type ConfigReloaderStub struct {
+	ReloadCalled func(source string) ([]common.ConfigChange, error)
+}
+
+// Reload -
+func (stub *ConfigReloaderStub) Reload(source string) ([]common.ConfigChange, error) {
+	if stub.ReloadCalled != nil {
+		return stub.ReloadCalled(source)
+	}
+
+	return make([]common.ConfigChange, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *ConfigReloaderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
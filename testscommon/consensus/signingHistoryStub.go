@@ -0,0 +1,30 @@
+package consensus
+
+// SigningHistoryStub -
+type SigningHistoryStub struct {
+	CheckAndRecordSignatureCalled func(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error
+	CloseCalled                   func() error
+}
+
+// CheckAndRecordSignature -
+func (stub *SigningHistoryStub) CheckAndRecordSignature(publicKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+	if stub.CheckAndRecordSignatureCalled != nil {
+		return stub.CheckAndRecordSignatureCalled(publicKey, epoch, round, headerHash)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *SigningHistoryStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SigningHistoryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
 	"github.com/multiversx/mx-chain-go/dataRetriever/shardedData"
 	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool/policy/disabled"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -53,6 +54,7 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 				MinimumGasPrice:      200000000000,
 				GasProcessingDivisor: 100,
 			},
+			Policy:         disabled.NewDisabledTxPoolPolicy(),
 			NumberOfShards: 1,
 		},
 	)
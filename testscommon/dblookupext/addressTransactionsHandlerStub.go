@@ -0,0 +1,46 @@
+package dblookupext
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
+)
+
+// AddressTransactionsHandlerStub -
+type AddressTransactionsHandlerStub struct {
+	RecordBlockCalled              func(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error
+	RevertBlockCalled              func(blockHeader data.HeaderHandler) error
+	GetTransactionsByAddressCalled func(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error)
+}
+
+// RecordBlock -
+func (stub *AddressTransactionsHandlerStub) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error {
+	if stub.RecordBlockCalled != nil {
+		return stub.RecordBlockCalled(blockHeaderHash, blockHeader, miniBlocks)
+	}
+
+	return nil
+}
+
+// RevertBlock -
+func (stub *AddressTransactionsHandlerStub) RevertBlock(blockHeader data.HeaderHandler) error {
+	if stub.RevertBlockCalled != nil {
+		return stub.RevertBlockCalled(blockHeader)
+	}
+
+	return nil
+}
+
+// GetTransactionsByAddress -
+func (stub *AddressTransactionsHandlerStub) GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+	if stub.GetTransactionsByAddressCalled != nil {
+		return stub.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *AddressTransactionsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/addressTransactions"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -17,6 +18,7 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetTransactionsByAddressCalled     func(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -89,6 +91,15 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (hp *HistoryRepositoryStub) GetTransactionsByAddress(address []byte, from uint64, size uint64) ([]*addressTransactions.AddressTransaction, uint64, error) {
+	if hp.GetTransactionsByAddressCalled != nil {
+		return hp.GetTransactionsByAddressCalled(address, from, size)
+	}
+
+	return nil, 0, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
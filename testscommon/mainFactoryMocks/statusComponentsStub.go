@@ -5,11 +5,13 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/statistics"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/process"
 )
 
 // StatusComponentsStub -
 type StatusComponentsStub struct {
 	Outport                  outport.OutportHandler
+	EventsSubscriptions      outport.EventsSubscriptionHandler
 	SoftwareVersionCheck     statistics.SoftwareVersionChecker
 	AppStatusHandler         core.AppStatusHandler
 	ManagedPeersMonitorField common.ManagedPeersMonitor
@@ -35,6 +37,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// EventsSubscriptionHandler -
+func (scs *StatusComponentsStub) EventsSubscriptionHandler() outport.EventsSubscriptionHandler {
+	return scs.EventsSubscriptions
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
@@ -45,6 +52,21 @@ func (scs *StatusComponentsStub) ManagedPeersMonitor() common.ManagedPeersMonito
 	return scs.ManagedPeersMonitorField
 }
 
+// SetForkDetector -
+func (scs *StatusComponentsStub) SetForkDetector(_ process.ForkDetector) error {
+	return nil
+}
+
+// StartPolling -
+func (scs *StatusComponentsStub) StartPolling() error {
+	return nil
+}
+
+// String -
+func (scs *StatusComponentsStub) String() string {
+	return "StatusComponentsStub"
+}
+
 // IsInterfaceNil -
 func (scs *StatusComponentsStub) IsInterfaceNil() bool {
 	return scs == nil
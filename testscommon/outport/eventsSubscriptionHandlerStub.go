@@ -0,0 +1,30 @@
+package outport
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// EventsSubscriptionHandlerStub -
+type EventsSubscriptionHandlerStub struct {
+	SubscribeCalled   func(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error)
+	UnsubscribeCalled func(subscriptionID uint64)
+}
+
+// Subscribe -
+func (stub *EventsSubscriptionHandlerStub) Subscribe(filter common.EventsSubscriptionFilter) (common.EventsSubscription, error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// Unsubscribe -
+func (stub *EventsSubscriptionHandlerStub) Unsubscribe(subscriptionID uint64) {
+	if stub.UnsubscribeCalled != nil {
+		stub.UnsubscribeCalled(subscriptionID)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *EventsSubscriptionHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
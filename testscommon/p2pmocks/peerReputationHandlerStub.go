@@ -0,0 +1,99 @@
+package p2pmocks
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	PeersBlackListCacherCalled      func() process.PeerBlackListCacher
+	PublicKeysBlackListCacherCalled func() process.TimeCacher
+	IsIPDeniedCalled                func(ip string) bool
+	GetPeersReputationCalled        func() []*common.PeerReputation
+	BanPeerCalled                   func(pid core.PeerID, duration time.Duration, reason string) error
+	UnbanPeerCalled                 func(pid core.PeerID) error
+	BanIPCalled                     func(ip string, duration time.Duration, reason string) error
+	UnbanIPCalled                   func(ip string) error
+	CloseCalled                     func() error
+}
+
+// PeersBlackListCacher -
+func (stub *PeerReputationHandlerStub) PeersBlackListCacher() process.PeerBlackListCacher {
+	if stub.PeersBlackListCacherCalled != nil {
+		return stub.PeersBlackListCacherCalled()
+	}
+	return nil
+}
+
+// PublicKeysBlackListCacher -
+func (stub *PeerReputationHandlerStub) PublicKeysBlackListCacher() process.TimeCacher {
+	if stub.PublicKeysBlackListCacherCalled != nil {
+		return stub.PublicKeysBlackListCacherCalled()
+	}
+	return nil
+}
+
+// IsIPDenied -
+func (stub *PeerReputationHandlerStub) IsIPDenied(ip string) bool {
+	if stub.IsIPDeniedCalled != nil {
+		return stub.IsIPDeniedCalled(ip)
+	}
+	return false
+}
+
+// GetPeersReputation -
+func (stub *PeerReputationHandlerStub) GetPeersReputation() []*common.PeerReputation {
+	if stub.GetPeersReputationCalled != nil {
+		return stub.GetPeersReputationCalled()
+	}
+	return make([]*common.PeerReputation, 0)
+}
+
+// BanPeer -
+func (stub *PeerReputationHandlerStub) BanPeer(pid core.PeerID, duration time.Duration, reason string) error {
+	if stub.BanPeerCalled != nil {
+		return stub.BanPeerCalled(pid, duration, reason)
+	}
+	return nil
+}
+
+// UnbanPeer -
+func (stub *PeerReputationHandlerStub) UnbanPeer(pid core.PeerID) error {
+	if stub.UnbanPeerCalled != nil {
+		return stub.UnbanPeerCalled(pid)
+	}
+	return nil
+}
+
+// BanIP -
+func (stub *PeerReputationHandlerStub) BanIP(ip string, duration time.Duration, reason string) error {
+	if stub.BanIPCalled != nil {
+		return stub.BanIPCalled(ip, duration, reason)
+	}
+	return nil
+}
+
+// UnbanIP -
+func (stub *PeerReputationHandlerStub) UnbanIP(ip string) error {
+	if stub.UnbanIPCalled != nil {
+		return stub.UnbanIPCalled(ip)
+	}
+	return nil
+}
+
+// Close -
+func (stub *PeerReputationHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
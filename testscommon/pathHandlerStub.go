@@ -22,7 +22,7 @@ func (p *PathManagerStub) PathForEpoch(shardId string, epoch uint32, identifier
 
 // PathForStatic -
 func (p *PathManagerStub) PathForStatic(shardId string, identifier string) string {
-	if p.PathForEpochCalled != nil {
+	if p.PathForStaticCalled != nil {
 		return p.PathForStaticCalled(shardId, identifier)
 	}
 
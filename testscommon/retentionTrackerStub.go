@@ -0,0 +1,38 @@
+package testscommon
+
+// RetentionTrackerStub -
+type RetentionTrackerStub struct {
+	EpochStartedCalled            func(epoch uint32, timestamp uint64)
+	OldestRetainedEpochCalled     func(identifier string) uint32
+	GetOldestRetainedEpochsCalled func() map[string]uint32
+}
+
+// EpochStarted -
+func (stub *RetentionTrackerStub) EpochStarted(epoch uint32, timestamp uint64) {
+	if stub.EpochStartedCalled != nil {
+		stub.EpochStartedCalled(epoch, timestamp)
+	}
+}
+
+// OldestRetainedEpoch -
+func (stub *RetentionTrackerStub) OldestRetainedEpoch(identifier string) uint32 {
+	if stub.OldestRetainedEpochCalled != nil {
+		return stub.OldestRetainedEpochCalled(identifier)
+	}
+
+	return 0
+}
+
+// GetOldestRetainedEpochs -
+func (stub *RetentionTrackerStub) GetOldestRetainedEpochs() map[string]uint32 {
+	if stub.GetOldestRetainedEpochsCalled != nil {
+		return stub.GetOldestRetainedEpochsCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RetentionTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
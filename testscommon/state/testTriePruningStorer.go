@@ -48,6 +48,7 @@ func CreateTestingTriePruningStorer(coordinator sharding.Coordinator, notifier p
 		EpochsData:             epochsData,
 		Notifier:               notifier,
 		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{},
+		RetentionTracker:       &testscommon.RetentionTrackerStub{},
 		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
 		MaxBatchSize:           10,
 		PersistersTracker:      pruning.NewPersistersTracker(epochsData),
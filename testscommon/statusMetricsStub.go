@@ -2,15 +2,16 @@ package testscommon
 
 // StatusMetricsStub -
 type StatusMetricsStub struct {
-	StatusMetricsMapWithoutP2PCalled              func() (map[string]interface{}, error)
-	StatusP2pMetricsMapCalled                     func() (map[string]interface{}, error)
-	ConfigMetricsCalled                           func() (map[string]interface{}, error)
-	NetworkMetricsCalled                          func() (map[string]interface{}, error)
-	EconomicsMetricsCalled                        func() (map[string]interface{}, error)
-	EnableEpochsMetricsCalled                     func() (map[string]interface{}, error)
-	RatingsMetricsCalled                          func() (map[string]interface{}, error)
-	StatusMetricsWithoutP2PPrometheusStringCalled func() (string, error)
-	BootstrapMetricsCalled                        func() (map[string]interface{}, error)
+	StatusMetricsMapWithoutP2PCalled               func() (map[string]interface{}, error)
+	StatusP2pMetricsMapCalled                      func() (map[string]interface{}, error)
+	ConfigMetricsCalled                            func() (map[string]interface{}, error)
+	NetworkMetricsCalled                           func() (map[string]interface{}, error)
+	EconomicsMetricsCalled                         func() (map[string]interface{}, error)
+	EnableEpochsMetricsCalled                      func() (map[string]interface{}, error)
+	RatingsMetricsCalled                           func() (map[string]interface{}, error)
+	StatusMetricsWithoutP2PPrometheusStringCalled  func() (string, error)
+	StatusMetricsWithoutP2POpenMetricsStringCalled func() (string, error)
+	BootstrapMetricsCalled                         func() (map[string]interface{}, error)
 }
 
 // StatusMetricsWithoutP2PPrometheusString -
@@ -22,6 +23,15 @@ func (sms *StatusMetricsStub) StatusMetricsWithoutP2PPrometheusString() (string,
 	return "metric 10", nil
 }
 
+// StatusMetricsWithoutP2POpenMetricsString -
+func (sms *StatusMetricsStub) StatusMetricsWithoutP2POpenMetricsString() (string, error) {
+	if sms.StatusMetricsWithoutP2POpenMetricsStringCalled != nil {
+		return sms.StatusMetricsWithoutP2POpenMetricsStringCalled()
+	}
+
+	return "# TYPE metric gauge\nmetric 10\n# EOF\n", nil
+}
+
 // ConfigMetrics -
 func (sms *StatusMetricsStub) ConfigMetrics() (map[string]interface{}, error) {
 	if sms.ConfigMetricsCalled != nil {
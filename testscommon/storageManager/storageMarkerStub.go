@@ -0,0 +1,22 @@
+package storageManager
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// StorageMarkerStub -
+type StorageMarkerStub struct {
+	MarkStorerAsSyncedAndActiveCalled func(storer common.StorageManager)
+}
+
+// MarkStorerAsSyncedAndActive -
+func (sms *StorageMarkerStub) MarkStorerAsSyncedAndActive(storer common.StorageManager) {
+	if sms.MarkStorerAsSyncedAndActiveCalled != nil {
+		sms.MarkStorerAsSyncedAndActiveCalled(storer)
+	}
+}
+
+// IsInterfaceNil -
+func (sms *StorageMarkerStub) IsInterfaceNil() bool {
+	return sms == nil
+}
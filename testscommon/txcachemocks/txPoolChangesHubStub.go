@@ -0,0 +1,30 @@
+package txcachemocks
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// TxPoolChangesHubStub -
+type TxPoolChangesHubStub struct {
+	SubscribeCalled   func(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error)
+	UnsubscribeCalled func(subscriptionID uint64)
+}
+
+// Subscribe -
+func (stub *TxPoolChangesHubStub) Subscribe(filter common.TransactionsPoolFilter) (common.TransactionsPoolSubscription, error) {
+	if stub.SubscribeCalled != nil {
+		return stub.SubscribeCalled(filter)
+	}
+
+	return nil, nil
+}
+
+// Unsubscribe -
+func (stub *TxPoolChangesHubStub) Unsubscribe(subscriptionID uint64) {
+	if stub.UnsubscribeCalled != nil {
+		stub.UnsubscribeCalled(subscriptionID)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TxPoolChangesHubStub) IsInterfaceNil() bool {
+	return stub == nil
+}
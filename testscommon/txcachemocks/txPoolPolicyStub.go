@@ -0,0 +1,60 @@
+package txcachemocks
+
+import "github.com/multiversx/mx-chain-storage-go/txcache"
+
+// TxPoolPolicyStub -
+type TxPoolPolicyStub struct {
+	CheckAdmissionCalled   func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error
+	SelectTxsToEvictCalled func(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) [][]byte
+	OnTxsEvictedCalled     func(numTxs int)
+	IsPinnedCalled         func(sender []byte) bool
+	GetCountersCalled      func() map[string]uint64
+}
+
+// CheckAdmission -
+func (stub *TxPoolPolicyStub) CheckAdmission(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) error {
+	if stub.CheckAdmissionCalled != nil {
+		return stub.CheckAdmissionCalled(tx, getSenderTxs)
+	}
+
+	return nil
+}
+
+// SelectTxsToEvict -
+func (stub *TxPoolPolicyStub) SelectTxsToEvict(tx *txcache.WrappedTransaction, getSenderTxs func() []*txcache.WrappedTransaction) [][]byte {
+	if stub.SelectTxsToEvictCalled != nil {
+		return stub.SelectTxsToEvictCalled(tx, getSenderTxs)
+	}
+
+	return nil
+}
+
+// OnTxsEvicted -
+func (stub *TxPoolPolicyStub) OnTxsEvicted(numTxs int) {
+	if stub.OnTxsEvictedCalled != nil {
+		stub.OnTxsEvictedCalled(numTxs)
+	}
+}
+
+// IsPinned -
+func (stub *TxPoolPolicyStub) IsPinned(sender []byte) bool {
+	if stub.IsPinnedCalled != nil {
+		return stub.IsPinnedCalled(sender)
+	}
+
+	return false
+}
+
+// GetCounters -
+func (stub *TxPoolPolicyStub) GetCounters() map[string]uint64 {
+	if stub.GetCountersCalled != nil {
+		return stub.GetCountersCalled()
+	}
+
+	return make(map[string]uint64)
+}
+
+// IsInterfaceNil -
+func (stub *TxPoolPolicyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
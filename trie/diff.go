@@ -0,0 +1,398 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// LeafDiff holds a leaf that was added, removed or modified between two versions of a trie.
+// The old fields are empty for an added leaf and the new fields are empty for a removed leaf
+type LeafDiff struct {
+	Key        []byte
+	OldValue   []byte
+	NewValue   []byte
+	OldVersion core.TrieNodeVersion
+	NewVersion core.TrieNodeVersion
+	HasOld     bool
+	HasNew     bool
+}
+
+// LeafDiffHandler is called by the trie differ for each different leaf. Returning false stops the diff
+type LeafDiffHandler func(diff *LeafDiff) bool
+
+// ArgsTrieDiffer holds the arguments needed to create a trie differ
+type ArgsTrieDiffer struct {
+	Storer     common.BaseStorer
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+}
+
+// diffCursor points to a position in a trie. An extension node is seen as a chain of branch nodes with a single
+// child each, so consumed holds the number of the extension key nibbles already walked
+type diffCursor struct {
+	hash     []byte
+	n        node
+	consumed int
+}
+
+type diffLeaf struct {
+	path    []byte
+	value   []byte
+	version core.TrieNodeVersion
+}
+
+type trieDiffer struct {
+	storer     common.BaseStorer
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewTrieDiffer creates a new trie differ, which walks two versions of a trie in parallel and skips the subtrees
+// that have the same hash in both of them
+func NewTrieDiffer(args ArgsTrieDiffer) (*trieDiffer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &trieDiffer{
+		storer:     args.Storer,
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+	}, nil
+}
+
+// Diff calls the handler for each leaf that differs between the tries with the given root hashes, in the trie
+// traversal order. An empty root hash stands for an empty trie
+func (td *trieDiffer) Diff(oldRootHash []byte, newRootHash []byte, handler LeafDiffHandler) error {
+	return td.DiffAfterKey(oldRootHash, newRootHash, nil, handler)
+}
+
+// DiffAfterKey calls the handler for each leaf that differs between the tries with the given root hashes and is placed
+// after the provided key in the trie traversal order, so a diff can be resumed from the last reported leaf. The
+// subtrees placed entirely before the key are not loaded. An empty key starts the diff from the beginning
+func (td *trieDiffer) DiffAfterKey(oldRootHash []byte, newRootHash []byte, startAfterKey []byte, handler LeafDiffHandler) error {
+	if handler == nil {
+		return ErrNilLeafDiffHandler
+	}
+
+	var startAfterPath []byte
+	if len(startAfterKey) > 0 {
+		startAfterPath = keyBytesToHex(startAfterKey)
+	}
+
+	_, err := td.diffCursors(newDiffCursor(oldRootHash), newDiffCursor(newRootHash), make([]byte, 0), startAfterPath, handler)
+
+	return err
+}
+
+func newDiffCursor(hash []byte) *diffCursor {
+	if common.IsEmptyTrie(hash) {
+		return nil
+	}
+
+	return &diffCursor{
+		hash: hash,
+	}
+}
+
+func (td *trieDiffer) diffCursors(
+	oldCursor *diffCursor,
+	newCursor *diffCursor,
+	path []byte,
+	startAfterPath []byte,
+	handler LeafDiffHandler,
+) (bool, error) {
+	if isSameSubtree(oldCursor, newCursor) || isSubtreeBeforePath(path, startAfterPath) {
+		return true, nil
+	}
+
+	err := td.loadCursor(oldCursor)
+	if err != nil {
+		return false, err
+	}
+	err = td.loadCursor(newCursor)
+	if err != nil {
+		return false, err
+	}
+
+	if !isInnerNode(oldCursor) || !isInnerNode(newCursor) {
+		return td.diffLeaves(oldCursor, newCursor, path, startAfterPath, handler)
+	}
+
+	oldChildren, err := getCursorChildren(oldCursor)
+	if err != nil {
+		return false, err
+	}
+	newChildren, err := getCursorChildren(newCursor)
+	if err != nil {
+		return false, err
+	}
+
+	for i := 0; i < nrOfChildren; i++ {
+		shouldContinue, errDiff := td.diffCursors(oldChildren[i], newChildren[i], concat(path, byte(i)), startAfterPath, handler)
+		if errDiff != nil || !shouldContinue {
+			return shouldContinue, errDiff
+		}
+	}
+
+	return true, nil
+}
+
+func isSameSubtree(oldCursor *diffCursor, newCursor *diffCursor) bool {
+	if oldCursor == nil || newCursor == nil {
+		return oldCursor == newCursor
+	}
+
+	return oldCursor.consumed == newCursor.consumed && bytes.Equal(oldCursor.hash, newCursor.hash)
+}
+
+// isSubtreeBeforePath returns true if all the leaves of the subtree placed at the given path are placed before the
+// provided leaf path in the trie traversal order
+func isSubtreeBeforePath(path []byte, startAfterPath []byte) bool {
+	if len(startAfterPath) == 0 || len(path) > len(startAfterPath) {
+		return false
+	}
+
+	return bytes.Compare(path, startAfterPath[:len(path)]) < 0
+}
+
+func isInnerNode(cursor *diffCursor) bool {
+	if cursor == nil {
+		return false
+	}
+
+	_, isLeaf := cursor.n.(*leafNode)
+
+	return !isLeaf
+}
+
+func (td *trieDiffer) loadCursor(cursor *diffCursor) error {
+	if cursor == nil || !check.IfNil(cursor.n) {
+		return nil
+	}
+
+	encodedNode, err := td.storer.Get(cursor.hash)
+	if err != nil {
+		return fmt.Errorf("%w for trie node %x", err, cursor.hash)
+	}
+
+	cursor.n, err = decodeNode(encodedNode, td.marshaller, td.hasher)
+
+	return err
+}
+
+func getCursorChildren(cursor *diffCursor) ([]*diffCursor, error) {
+	children := make([]*diffCursor, nrOfChildren)
+	switch castedNode := cursor.n.(type) {
+	case *branchNode:
+		for i, childHash := range castedNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+			children[i] = &diffCursor{hash: childHash}
+		}
+	case *extensionNode:
+		remainingKey := castedNode.Key[cursor.consumed:]
+		if len(remainingKey) == 0 || int(remainingKey[0]) >= nrOfChildren {
+			return nil, ErrInvalidNode
+		}
+		if len(remainingKey) == 1 {
+			children[remainingKey[0]] = &diffCursor{hash: castedNode.EncodedChild}
+			break
+		}
+		children[remainingKey[0]] = &diffCursor{
+			hash:     cursor.hash,
+			n:        castedNode,
+			consumed: cursor.consumed + 1,
+		}
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrWrongTypeAssertion, cursor.n)
+	}
+
+	return children, nil
+}
+
+// diffLeaves handles the positions where at least one of the tries holds a leaf or nothing at all. The leaves of both
+// subtrees are collected in traversal order and merged by their path. The leaves placed up to the start path are skipped
+func (td *trieDiffer) diffLeaves(
+	oldCursor *diffCursor,
+	newCursor *diffCursor,
+	path []byte,
+	startAfterPath []byte,
+	handler LeafDiffHandler,
+) (bool, error) {
+	oldLeaves, err := td.collectLeaves(oldCursor, path)
+	if err != nil {
+		return false, err
+	}
+	newLeaves, err := td.collectLeaves(newCursor, path)
+	if err != nil {
+		return false, err
+	}
+
+	oldIndex, newIndex := 0, 0
+	for oldIndex < len(oldLeaves) || newIndex < len(newLeaves) {
+		comparison := compareLeafPaths(oldLeaves, oldIndex, newLeaves, newIndex)
+
+		var oldLeaf, newLeaf *diffLeaf
+		if comparison <= 0 {
+			oldLeaf = oldLeaves[oldIndex]
+			oldIndex++
+		}
+		if comparison >= 0 {
+			newLeaf = newLeaves[newIndex]
+			newIndex++
+		}
+
+		if isLeafUpToPath(oldLeaf, newLeaf, startAfterPath) {
+			continue
+		}
+
+		leafDiff, errCreate := createLeafDiff(oldLeaf, newLeaf)
+		if errCreate != nil {
+			return false, errCreate
+		}
+		if leafDiff == nil {
+			continue
+		}
+		if !handler(leafDiff) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func isLeafUpToPath(oldLeaf *diffLeaf, newLeaf *diffLeaf, startAfterPath []byte) bool {
+	if len(startAfterPath) == 0 {
+		return false
+	}
+
+	leaf := oldLeaf
+	if leaf == nil {
+		leaf = newLeaf
+	}
+
+	return bytes.Compare(leaf.path, startAfterPath) <= 0
+}
+
+// compareLeafPaths returns a negative value if only the old leaf should be consumed, a positive value if only the
+// new leaf should be consumed and 0 if both leaves have the same path
+func compareLeafPaths(oldLeaves []*diffLeaf, oldIndex int, newLeaves []*diffLeaf, newIndex int) int {
+	if oldIndex == len(oldLeaves) {
+		return 1
+	}
+	if newIndex == len(newLeaves) {
+		return -1
+	}
+
+	return bytes.Compare(oldLeaves[oldIndex].path, newLeaves[newIndex].path)
+}
+
+func createLeafDiff(oldLeaf *diffLeaf, newLeaf *diffLeaf) (*LeafDiff, error) {
+	if oldLeaf != nil && newLeaf != nil {
+		if bytes.Equal(oldLeaf.value, newLeaf.value) && oldLeaf.version == newLeaf.version {
+			return nil, nil
+		}
+	}
+
+	leafDiff := &LeafDiff{}
+	var err error
+	if oldLeaf != nil {
+		leafDiff.Key, err = getLeafKey(oldLeaf.path)
+		leafDiff.OldValue = oldLeaf.value
+		leafDiff.OldVersion = oldLeaf.version
+		leafDiff.HasOld = true
+	}
+	if newLeaf != nil {
+		leafDiff.Key, err = getLeafKey(newLeaf.path)
+		leafDiff.NewValue = newLeaf.value
+		leafDiff.NewVersion = newLeaf.version
+		leafDiff.HasNew = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return leafDiff, nil
+}
+
+func getLeafKey(path []byte) ([]byte, error) {
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(path)
+
+	return kb.GetKey()
+}
+
+func (td *trieDiffer) collectLeaves(cursor *diffCursor, path []byte) ([]*diffLeaf, error) {
+	leaves := make([]*diffLeaf, 0)
+	if cursor == nil {
+		return leaves, nil
+	}
+
+	type pendingCursor struct {
+		cursor *diffCursor
+		path   []byte
+	}
+
+	nextCursors := []pendingCursor{{cursor: cursor, path: path}}
+	for len(nextCursors) > 0 {
+		current := nextCursors[len(nextCursors)-1]
+		nextCursors = nextCursors[:len(nextCursors)-1]
+
+		err := td.loadCursor(current.cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		ln, isLeaf := current.cursor.n.(*leafNode)
+		if isLeaf {
+			version, errVersion := ln.getVersion()
+			if errVersion != nil {
+				return nil, errVersion
+			}
+
+			leaves = append(leaves, &diffLeaf{
+				path:    concat(current.path, ln.Key...),
+				value:   ln.Value,
+				version: version,
+			})
+			continue
+		}
+
+		children, err := getCursorChildren(current.cursor)
+		if err != nil {
+			return nil, err
+		}
+		// pushed in reverse order so the leaves are collected in the traversal order
+		for i := len(children) - 1; i >= 0; i-- {
+			if children[i] == nil {
+				continue
+			}
+			nextCursors = append(nextCursors, pendingCursor{
+				cursor: children[i],
+				path:   concat(current.path, byte(i)),
+			})
+		}
+	}
+
+	return leaves, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (td *trieDiffer) IsInterfaceNil() bool {
+	return td == nil
+}
@@ -0,0 +1,349 @@
+package trie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTrieDifferArgs(tr common.Trie) trie.ArgsTrieDiffer {
+	args := trie.GetDefaultTrieStorageManagerParameters()
+
+	return trie.ArgsTrieDiffer{
+		Storer:     tr.GetStorageManager(),
+		Marshaller: args.Marshalizer,
+		Hasher:     args.Hasher,
+	}
+}
+
+func commitAndGetRootHash(t *testing.T, tr common.Trie) []byte {
+	require.Nil(t, tr.Commit())
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func collectLeafDiffs(t *testing.T, tr common.Trie, oldRootHash []byte, newRootHash []byte) map[string]*trie.LeafDiff {
+	td, err := trie.NewTrieDiffer(createMockTrieDifferArgs(tr))
+	require.Nil(t, err)
+
+	diffs := make(map[string]*trie.LeafDiff)
+	err = td.Diff(oldRootHash, newRootHash, func(diff *trie.LeafDiff) bool {
+		_, found := diffs[string(diff.Key)]
+		require.False(t, found, "key %s reported twice", diff.Key)
+		diffs[string(diff.Key)] = diff
+
+		return true
+	})
+	require.Nil(t, err)
+
+	return diffs
+}
+
+func TestNewTrieDiffer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTrieDifferArgs(emptyTrie())
+		args.Storer = nil
+		td, err := trie.NewTrieDiffer(args)
+		assert.Equal(t, trie.ErrNilStorer, err)
+		assert.Nil(t, td)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTrieDifferArgs(emptyTrie())
+		args.Marshaller = nil
+		td, err := trie.NewTrieDiffer(args)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, td)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTrieDifferArgs(emptyTrie())
+		args.Hasher = nil
+		td, err := trie.NewTrieDiffer(args)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, td)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		td, err := trie.NewTrieDiffer(createMockTrieDifferArgs(emptyTrie()))
+		assert.Nil(t, err)
+		assert.False(t, td.IsInterfaceNil())
+	})
+}
+
+func TestTrieDiffer_Diff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		td, _ := trie.NewTrieDiffer(createMockTrieDifferArgs(emptyTrie()))
+		err := td.Diff(nil, nil, nil)
+		assert.Equal(t, trie.ErrNilLeafDiffHandler, err)
+	})
+	t.Run("same root hash should not report differences", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		rootHash := commitAndGetRootHash(t, tr)
+
+		assert.Empty(t, collectLeafDiffs(t, tr, rootHash, rootHash))
+		assert.Empty(t, collectLeafDiffs(t, tr, nil, common.EmptyTrieHash))
+	})
+	t.Run("empty old trie should report all leaves as added", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(50)
+		rootHash := commitAndGetRootHash(t, tr)
+
+		diffs := collectLeafDiffs(t, tr, common.EmptyTrieHash, rootHash)
+		require.Equal(t, len(values), len(diffs))
+		for _, value := range values {
+			diff := diffs[string(value)]
+			require.NotNil(t, diff)
+			assert.False(t, diff.HasOld)
+			assert.True(t, diff.HasNew)
+			assert.Equal(t, value, diff.NewValue)
+		}
+	})
+	t.Run("empty new trie should report all leaves as removed", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(50)
+		rootHash := commitAndGetRootHash(t, tr)
+
+		diffs := collectLeafDiffs(t, tr, rootHash, nil)
+		require.Equal(t, len(values), len(diffs))
+		for _, value := range values {
+			diff := diffs[string(value)]
+			require.NotNil(t, diff)
+			assert.True(t, diff.HasOld)
+			assert.False(t, diff.HasNew)
+			assert.Equal(t, value, diff.OldValue)
+		}
+	})
+	t.Run("should report the added, removed and modified leaves", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		oldLeaves := make(map[string]string)
+		for i := 0; i < 200; i++ {
+			// the key bytes are walked in reverse order, so keys sharing a suffix create extension nodes
+			key := fmt.Sprintf("%dkey", i)
+			oldLeaves[key] = fmt.Sprintf("value%d", i)
+			require.Nil(t, tr.Update([]byte(key), []byte(oldLeaves[key])))
+		}
+		oldRootHash := commitAndGetRootHash(t, tr)
+
+		newLeaves := make(map[string]string)
+		for key, value := range oldLeaves {
+			newLeaves[key] = value
+		}
+		for i := 0; i < 200; i += 7 {
+			key := fmt.Sprintf("%dkey", i)
+			delete(newLeaves, key)
+			require.Nil(t, tr.Delete([]byte(key)))
+		}
+		for i := 1; i < 200; i += 11 {
+			key := fmt.Sprintf("%dkey", i)
+			newLeaves[key] = "modified"
+			require.Nil(t, tr.Update([]byte(key), []byte("modified")))
+		}
+		for i := 0; i < 30; i++ {
+			key := fmt.Sprintf("%d new key", i)
+			newLeaves[key] = key
+			require.Nil(t, tr.Update([]byte(key), []byte(key)))
+		}
+		// the new root is a branch node, while the old root is an extension node
+		newLeaves["other"] = "other"
+		require.Nil(t, tr.Update([]byte("other"), []byte("other")))
+		newRootHash := commitAndGetRootHash(t, tr)
+
+		diffs := collectLeafDiffs(t, tr, oldRootHash, newRootHash)
+		numExpectedDiffs := 0
+		allKeys := make(map[string]struct{})
+		for key := range oldLeaves {
+			allKeys[key] = struct{}{}
+		}
+		for key := range newLeaves {
+			allKeys[key] = struct{}{}
+		}
+		for key := range allKeys {
+			oldValue, hasOld := oldLeaves[key]
+			newValue, hasNew := newLeaves[key]
+			if hasOld && hasNew && oldValue == newValue {
+				assert.Nil(t, diffs[key], "unchanged key %s reported", key)
+				continue
+			}
+
+			numExpectedDiffs++
+			diff := diffs[key]
+			require.NotNil(t, diff, "changed key %s not reported", key)
+			assert.Equal(t, hasOld, diff.HasOld)
+			assert.Equal(t, hasNew, diff.HasNew)
+			assert.Equal(t, oldValue, string(diff.OldValue))
+			assert.Equal(t, newValue, string(diff.NewValue))
+		}
+		assert.Equal(t, numExpectedDiffs, len(diffs))
+
+		reversedDiffs := collectLeafDiffs(t, tr, newRootHash, oldRootHash)
+		assert.Equal(t, numExpectedDiffs, len(reversedDiffs))
+	})
+	t.Run("should skip the subtrees that did not change", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(1000)
+		oldRootHash := commitAndGetRootHash(t, tr)
+		require.Nil(t, tr.Update(values[0], []byte("modified")))
+		newRootHash := commitAndGetRootHash(t, tr)
+
+		numReads := 0
+		args := createMockTrieDifferArgs(tr)
+		args.Storer = &storageMock.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				numReads++
+				return tr.GetStorageManager().Get(key)
+			},
+		}
+		td, _ := trie.NewTrieDiffer(args)
+
+		diffs := make([]*trie.LeafDiff, 0)
+		err := td.Diff(oldRootHash, newRootHash, func(diff *trie.LeafDiff) bool {
+			diffs = append(diffs, diff)
+			return true
+		})
+		require.Nil(t, err)
+
+		require.Equal(t, 1, len(diffs))
+		assert.Equal(t, values[0], diffs[0].Key)
+		assert.Equal(t, values[0], diffs[0].OldValue)
+		assert.Equal(t, []byte("modified"), diffs[0].NewValue)
+		allHashes, _ := tr.GetAllHashes()
+		assert.Less(t, numReads, len(allHashes)/10)
+	})
+	t.Run("handler returning false should stop the diff", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(50)
+		rootHash := commitAndGetRootHash(t, tr)
+		td, _ := trie.NewTrieDiffer(createMockTrieDifferArgs(tr))
+
+		numCalls := 0
+		err := td.Diff(nil, rootHash, func(diff *trie.LeafDiff) bool {
+			numCalls++
+			return numCalls < 3
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numCalls)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(50)
+		rootHash := commitAndGetRootHash(t, tr)
+		td, _ := trie.NewTrieDiffer(createMockTrieDifferArgs(tr))
+
+		err := td.Diff(nil, []byte("missing root hash"), func(diff *trie.LeafDiff) bool {
+			return true
+		})
+		assert.NotNil(t, err)
+
+		err = td.Diff(rootHash, []byte("missing root hash"), func(diff *trie.LeafDiff) bool {
+			return true
+		})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestTrieDiffer_DiffAfterKey(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	for i := 0; i < 200; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("%dkey", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	oldRootHash := commitAndGetRootHash(t, tr)
+	for i := 0; i < 200; i += 7 {
+		require.Nil(t, tr.Delete([]byte(fmt.Sprintf("%dkey", i))))
+	}
+	for i := 1; i < 200; i += 3 {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("%dkey", i)), []byte("modified")))
+	}
+	for i := 0; i < 30; i++ {
+		require.Nil(t, tr.Update([]byte(fmt.Sprintf("%d new key", i)), []byte("new")))
+	}
+	newRootHash := commitAndGetRootHash(t, tr)
+
+	numReads := 0
+	args := createMockTrieDifferArgs(tr)
+	args.Storer = &storageMock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			numReads++
+			return tr.GetStorageManager().Get(key)
+		},
+	}
+	td, _ := trie.NewTrieDiffer(args)
+
+	allKeys := make([]string, 0)
+	err := td.DiffAfterKey(oldRootHash, newRootHash, nil, func(diff *trie.LeafDiff) bool {
+		allKeys = append(allKeys, string(diff.Key))
+		return true
+	})
+	require.Nil(t, err)
+	numReadsForFullDiff := numReads
+
+	t.Run("resumed pages should cover the whole diff once", func(t *testing.T) {
+		pageSize := 7
+		resumedKeys := make([]string, 0)
+		var lastKey []byte
+		for {
+			page := make([]string, 0, pageSize)
+			err = td.DiffAfterKey(oldRootHash, newRootHash, lastKey, func(diff *trie.LeafDiff) bool {
+				page = append(page, string(diff.Key))
+				return len(page) < pageSize
+			})
+			require.Nil(t, err)
+			resumedKeys = append(resumedKeys, page...)
+			if len(page) < pageSize {
+				break
+			}
+			lastKey = []byte(page[len(page)-1])
+		}
+
+		assert.Equal(t, allKeys, resumedKeys)
+	})
+	t.Run("unchanged start key should resume after its position", func(t *testing.T) {
+		// the key with index 2 was not modified, so it is not part of the diff
+		keys := make([]string, 0)
+		err = td.DiffAfterKey(oldRootHash, newRootHash, []byte("2key"), func(diff *trie.LeafDiff) bool {
+			keys = append(keys, string(diff.Key))
+			return true
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, keys)
+		assert.Equal(t, allKeys[len(allKeys)-len(keys):], keys)
+	})
+	t.Run("subtrees before the start key should not be loaded", func(t *testing.T) {
+		numReads = 0
+		err = td.DiffAfterKey(oldRootHash, newRootHash, []byte(allKeys[len(allKeys)-2]), func(diff *trie.LeafDiff) bool {
+			assert.Equal(t, allKeys[len(allKeys)-1], string(diff.Key))
+			return true
+		})
+		require.Nil(t, err)
+		assert.Less(t, numReads, numReadsForFullDiff/4)
+	})
+}
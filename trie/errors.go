@@ -100,6 +100,9 @@ var ErrTrieSyncTimeout = errors.New("trie sync timeout")
 // ErrKeyNotFound is raised when a key is not found
 var ErrKeyNotFound = errors.New("key not found")
 
+// ErrKeyNotProven signals that a key is not proven by the provided Merkle proof
+var ErrKeyNotProven = errors.New("key not proven")
+
 // ErrNilIdleNodeProvider signals that a nil idle node provider was provided
 var ErrNilIdleNodeProvider = errors.New("nil idle node provider")
 
@@ -126,3 +129,12 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrEmptyRootHash signals that an empty root hash was provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrNodeHashMismatch signals that the content of a trie node does not match the hash it is stored with
+var ErrNodeHashMismatch = errors.New("trie node content does not match its hash")
+
+// ErrNilLeafDiffHandler signals that a nil leaf diff handler was provided
+var ErrNilLeafDiffHandler = errors.New("nil leaf diff handler")
@@ -962,6 +962,17 @@ func TestExtensionNode_getNextHashAndKey(t *testing.T) {
 	assert.Equal(t, []byte{}, nextKey)
 }
 
+func TestExtensionNode_getNextHashAndKeyDifferentPrefix(t *testing.T) {
+	t.Parallel()
+
+	_, collapsedEn := getEnAndCollapsedEn()
+	proofVerified, nextHash, nextKey := collapsedEn.getNextHashAndKey([]byte("a"))
+
+	assert.False(t, proofVerified)
+	assert.Nil(t, nextHash)
+	assert.Nil(t, nextKey)
+}
+
 func TestExtensionNode_getNextHashAndKeyNilKey(t *testing.T) {
 	t.Parallel()
 
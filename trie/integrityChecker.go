@@ -0,0 +1,241 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// LeafHandler is called by the integrity checker for each valid leaf, with the leaf key and value as stored in the trie
+type LeafHandler func(key []byte, value []byte)
+
+// ArgsIntegrityChecker holds the arguments needed to create a trie integrity checker
+type ArgsIntegrityChecker struct {
+	Storer     common.BaseStorer
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+	// RepairSource is optional. If set, the missing or corrupt nodes are fetched from it and written in RepairDestination
+	RepairSource      common.BaseStorer
+	RepairDestination common.BaseStorer
+}
+
+// IntegrityIssue holds the details of a trie node that is missing from the storer or does not match its hash
+type IntegrityIssue struct {
+	Hash []byte
+	// Path holds the key nibbles from the root to the faulty node
+	Path     []byte
+	Missing  bool
+	Repaired bool
+	Err      error
+}
+
+// IntegrityCheckResult holds the outcome of a trie integrity check
+type IntegrityCheckResult struct {
+	NumNodes  uint64
+	NumLeaves uint64
+	Issues    []*IntegrityIssue
+}
+
+type pendingNode struct {
+	hash []byte
+	path []byte
+}
+
+type integrityChecker struct {
+	storer            common.BaseStorer
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	repairSource      common.BaseStorer
+	repairDestination common.BaseStorer
+}
+
+// NewIntegrityChecker creates a new trie integrity checker. Unlike the dfsIterator, which stops at the first node
+// that can not be resolved, the checker reads each node exactly as it is stored, verifies it against its hash and
+// carries on with the rest of the trie, in the same depth first order
+func NewIntegrityChecker(args ArgsIntegrityChecker) (*integrityChecker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if !check.IfNil(args.RepairSource) && check.IfNil(args.RepairDestination) {
+		return nil, fmt.Errorf("%w for the repair destination", ErrNilStorer)
+	}
+
+	return &integrityChecker{
+		storer:            args.Storer,
+		marshaller:        args.Marshaller,
+		hasher:            args.Hasher,
+		repairSource:      args.RepairSource,
+		repairDestination: args.RepairDestination,
+	}, nil
+}
+
+// Check walks the trie with the given root hash and returns all the nodes that are missing or do not match their hash.
+// The handler is called for each leaf that could be read
+func (ic *integrityChecker) Check(rootHash []byte, handler LeafHandler) (*IntegrityCheckResult, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+	if handler == nil {
+		handler = func(_ []byte, _ []byte) {}
+	}
+
+	result := &IntegrityCheckResult{
+		Issues: make([]*IntegrityIssue, 0),
+	}
+	nextNodes := []pendingNode{{hash: rootHash, path: make([]byte, 0)}}
+	for len(nextNodes) > 0 {
+		current := nextNodes[len(nextNodes)-1]
+		nextNodes = nextNodes[:len(nextNodes)-1]
+
+		n, issue := ic.readNode(current)
+		if issue != nil {
+			result.Issues = append(result.Issues, issue)
+		}
+		if check.IfNil(n) {
+			continue
+		}
+		result.NumNodes++
+
+		switch castedNode := n.(type) {
+		case *branchNode:
+			// pushed in reverse order so the children are visited in the same order as with the dfsIterator
+			for i := len(castedNode.EncodedChildren) - 1; i >= 0; i-- {
+				if len(castedNode.EncodedChildren[i]) == 0 {
+					continue
+				}
+				nextNodes = append(nextNodes, pendingNode{
+					hash: castedNode.EncodedChildren[i],
+					path: concat(current.path, byte(i)),
+				})
+			}
+		case *extensionNode:
+			nextNodes = append(nextNodes, pendingNode{
+				hash: castedNode.EncodedChild,
+				path: concat(current.path, castedNode.Key...),
+			})
+		case *leafNode:
+			err := ic.handleLeaf(castedNode, current.path, handler)
+			if err != nil {
+				result.Issues = append(result.Issues, &IntegrityIssue{
+					Hash: current.hash,
+					Path: current.path,
+					Err:  err,
+				})
+				continue
+			}
+			result.NumLeaves++
+		default:
+			return nil, fmt.Errorf("%w: %T", ErrWrongTypeAssertion, n)
+		}
+	}
+
+	return result, nil
+}
+
+// readNode returns the decoded node and, if the node had to be repaired or could not be read, the issue found
+func (ic *integrityChecker) readNode(pn pendingNode) (node, *IntegrityIssue) {
+	encodedNode, err := ic.storer.Get(pn.hash)
+	if err != nil {
+		return ic.repairNode(&IntegrityIssue{
+			Hash:    pn.hash,
+			Path:    pn.path,
+			Missing: true,
+			Err:     err,
+		})
+	}
+
+	err = ic.checkHash(pn.hash, encodedNode)
+	if err != nil {
+		return ic.repairNode(&IntegrityIssue{
+			Hash: pn.hash,
+			Path: pn.path,
+			Err:  err,
+		})
+	}
+
+	n, err := decodeNode(encodedNode, ic.marshaller, ic.hasher)
+	if err != nil {
+		return nil, &IntegrityIssue{
+			Hash: pn.hash,
+			Path: pn.path,
+			Err:  err,
+		}
+	}
+
+	return n, nil
+}
+
+func (ic *integrityChecker) checkHash(hash []byte, encodedNode []byte) error {
+	computedHash := ic.hasher.Compute(string(encodedNode))
+	if !bytes.Equal(computedHash, hash) {
+		return fmt.Errorf("%w: content hash is %x", ErrNodeHashMismatch, computedHash)
+	}
+
+	return nil
+}
+
+func (ic *integrityChecker) repairNode(issue *IntegrityIssue) (node, *IntegrityIssue) {
+	if check.IfNil(ic.repairSource) {
+		return nil, issue
+	}
+
+	encodedNode, err := ic.repairSource.Get(issue.Hash)
+	if err != nil {
+		log.Debug("integrityChecker: node not found in the repair source", "hash", issue.Hash, "error", err)
+		return nil, issue
+	}
+	err = ic.checkHash(issue.Hash, encodedNode)
+	if err != nil {
+		log.Debug("integrityChecker: invalid node in the repair source", "hash", issue.Hash, "error", err)
+		return nil, issue
+	}
+	n, err := decodeNode(encodedNode, ic.marshaller, ic.hasher)
+	if err != nil {
+		log.Debug("integrityChecker: can not decode the node from the repair source", "hash", issue.Hash, "error", err)
+		return nil, issue
+	}
+
+	err = ic.repairDestination.Put(issue.Hash, encodedNode)
+	if err != nil {
+		log.Warn("integrityChecker: can not write the repaired node", "hash", issue.Hash, "error", err)
+		return nil, issue
+	}
+
+	issue.Repaired = true
+
+	return n, issue
+}
+
+func (ic *integrityChecker) handleLeaf(ln *leafNode, path []byte, handler LeafHandler) error {
+	if len(ln.Key) == 0 {
+		return ErrInvalidNode
+	}
+
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(path)
+	kb.BuildKey(ln.Key)
+	key, err := kb.GetKey()
+	if err != nil {
+		return err
+	}
+
+	handler(key, ln.Value)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ic *integrityChecker) IsInterfaceNil() bool {
+	return ic == nil
+}
@@ -0,0 +1,254 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type committedTestTrie struct {
+	rootHash   []byte
+	nodeHashes [][]byte
+	leaves     map[string][]byte
+	db         *testscommon.MemDbMock
+}
+
+func createCommittedTestTrie(t *testing.T, numLeaves int) *committedTestTrie {
+	tr, values := initTrieMultipleValues(numLeaves)
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	nodeHashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+
+	db := testscommon.NewMemDbMock()
+	for _, hash := range nodeHashes {
+		encodedNode, errGet := tr.GetStorageManager().Get(hash)
+		require.Nil(t, errGet)
+		require.Nil(t, db.Put(hash, encodedNode))
+	}
+
+	leaves := make(map[string][]byte, len(values))
+	for _, value := range values {
+		leaves[string(value)] = value
+	}
+
+	return &committedTestTrie{
+		rootHash:   rootHash,
+		nodeHashes: nodeHashes,
+		leaves:     leaves,
+		db:         db,
+	}
+}
+
+func createMockIntegrityCheckerArgs(db *testscommon.MemDbMock) trie.ArgsIntegrityChecker {
+	args := trie.GetDefaultTrieStorageManagerParameters()
+
+	return trie.ArgsIntegrityChecker{
+		Storer:     db,
+		Marshaller: args.Marshalizer,
+		Hasher:     args.Hasher,
+	}
+}
+
+func checkTrieAndCollectLeaves(t *testing.T, args trie.ArgsIntegrityChecker, rootHash []byte) (*trie.IntegrityCheckResult, map[string][]byte) {
+	checker, err := trie.NewIntegrityChecker(args)
+	require.Nil(t, err)
+
+	leaves := make(map[string][]byte)
+	result, err := checker.Check(rootHash, func(key []byte, value []byte) {
+		leaves[string(key)] = value
+	})
+	require.Nil(t, err)
+
+	return result, leaves
+}
+
+func TestNewIntegrityChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockIntegrityCheckerArgs(testscommon.NewMemDbMock())
+		args.Storer = nil
+		checker, err := trie.NewIntegrityChecker(args)
+		assert.Equal(t, trie.ErrNilStorer, err)
+		assert.Nil(t, checker)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockIntegrityCheckerArgs(testscommon.NewMemDbMock())
+		args.Marshaller = nil
+		checker, err := trie.NewIntegrityChecker(args)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, checker)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockIntegrityCheckerArgs(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		checker, err := trie.NewIntegrityChecker(args)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, checker)
+	})
+	t.Run("repair source without destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockIntegrityCheckerArgs(testscommon.NewMemDbMock())
+		args.RepairSource = testscommon.NewMemDbMock()
+		checker, err := trie.NewIntegrityChecker(args)
+		assert.True(t, errors.Is(err, trie.ErrNilStorer))
+		assert.Nil(t, checker)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := trie.NewIntegrityChecker(createMockIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, checker.IsInterfaceNil())
+	})
+}
+
+func TestIntegrityChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := trie.NewIntegrityChecker(createMockIntegrityCheckerArgs(testscommon.NewMemDbMock()))
+		result, err := checker.Check(nil, nil)
+		assert.Equal(t, trie.ErrEmptyRootHash, err)
+		assert.Nil(t, result)
+	})
+	t.Run("intact trie should not report issues", func(t *testing.T) {
+		t.Parallel()
+
+		testTrie := createCommittedTestTrie(t, 100)
+		result, leaves := checkTrieAndCollectLeaves(t, createMockIntegrityCheckerArgs(testTrie.db), testTrie.rootHash)
+
+		assert.Empty(t, result.Issues)
+		assert.Equal(t, uint64(len(testTrie.nodeHashes)), result.NumNodes)
+		assert.Equal(t, uint64(100), result.NumLeaves)
+		assert.Equal(t, testTrie.leaves, leaves)
+	})
+	t.Run("missing root should report it", func(t *testing.T) {
+		t.Parallel()
+
+		testTrie := createCommittedTestTrie(t, 10)
+		require.Nil(t, testTrie.db.Remove(testTrie.rootHash))
+		result, leaves := checkTrieAndCollectLeaves(t, createMockIntegrityCheckerArgs(testTrie.db), testTrie.rootHash)
+
+		require.Equal(t, 1, len(result.Issues))
+		assert.True(t, result.Issues[0].Missing)
+		assert.Equal(t, testTrie.rootHash, result.Issues[0].Hash)
+		assert.Empty(t, result.Issues[0].Path)
+		assert.Zero(t, result.NumNodes)
+		assert.Empty(t, leaves)
+	})
+	t.Run("missing node should be reported and the rest of the trie should be checked", func(t *testing.T) {
+		t.Parallel()
+
+		testTrie := createCommittedTestTrie(t, 100)
+		missingHash := testTrie.nodeHashes[1]
+		require.Nil(t, testTrie.db.Remove(missingHash))
+		result, leaves := checkTrieAndCollectLeaves(t, createMockIntegrityCheckerArgs(testTrie.db), testTrie.rootHash)
+
+		require.Equal(t, 1, len(result.Issues))
+		assert.True(t, result.Issues[0].Missing)
+		assert.False(t, result.Issues[0].Repaired)
+		assert.Equal(t, missingHash, result.Issues[0].Hash)
+		assert.NotEmpty(t, result.Issues[0].Path)
+		assert.True(t, len(leaves) > 0)
+		assert.True(t, len(leaves) < 100)
+		assert.Equal(t, uint64(len(leaves)), result.NumLeaves)
+	})
+	t.Run("corrupt node should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		testTrie := createCommittedTestTrie(t, 100)
+		corruptHash := testTrie.nodeHashes[len(testTrie.nodeHashes)-1]
+		encodedNode, _ := testTrie.db.Get(corruptHash)
+		corruptNode := bytes.Clone(encodedNode)
+		corruptNode[0]++
+		require.Nil(t, testTrie.db.Put(corruptHash, corruptNode))
+		result, leaves := checkTrieAndCollectLeaves(t, createMockIntegrityCheckerArgs(testTrie.db), testTrie.rootHash)
+
+		require.Equal(t, 1, len(result.Issues))
+		assert.False(t, result.Issues[0].Missing)
+		assert.True(t, errors.Is(result.Issues[0].Err, trie.ErrNodeHashMismatch))
+		assert.Equal(t, corruptHash, result.Issues[0].Hash)
+		assert.True(t, len(leaves) < 100)
+	})
+	t.Run("undecodable node with a valid hash should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		hasher := keccak.NewKeccak()
+		invalidNode := []byte("invalid node")
+		hash := hasher.Compute(string(invalidNode))
+		db := testscommon.NewMemDbMock()
+		_ = db.Put(hash, invalidNode)
+		args := createMockIntegrityCheckerArgs(db)
+		args.Marshaller = &marshallerMock.MarshalizerMock{}
+		args.Hasher = hasher
+		result, _ := checkTrieAndCollectLeaves(t, args, hash)
+
+		require.Equal(t, 1, len(result.Issues))
+		assert.False(t, result.Issues[0].Missing)
+		assert.NotNil(t, result.Issues[0].Err)
+	})
+	t.Run("should repair the missing and the corrupt nodes from the repair source", func(t *testing.T) {
+		t.Parallel()
+
+		testTrie := createCommittedTestTrie(t, 100)
+		repairSource := testscommon.NewMemDbMock()
+		testTrie.db.RangeKeys(func(key []byte, value []byte) bool {
+			_ = repairSource.Put(key, value)
+			return true
+		})
+		missingHash := testTrie.nodeHashes[1]
+		require.Nil(t, testTrie.db.Remove(missingHash))
+		corruptHash := testTrie.nodeHashes[len(testTrie.nodeHashes)-1]
+		require.Nil(t, testTrie.db.Put(corruptHash, []byte("corrupt")))
+
+		args := createMockIntegrityCheckerArgs(testTrie.db)
+		args.RepairSource = repairSource
+		args.RepairDestination = testTrie.db
+		result, leaves := checkTrieAndCollectLeaves(t, args, testTrie.rootHash)
+
+		require.Equal(t, 2, len(result.Issues))
+		for _, issue := range result.Issues {
+			assert.True(t, issue.Repaired)
+		}
+		assert.Equal(t, testTrie.leaves, leaves)
+
+		result, _ = checkTrieAndCollectLeaves(t, createMockIntegrityCheckerArgs(testTrie.db), testTrie.rootHash)
+		assert.Empty(t, result.Issues)
+	})
+	t.Run("node missing from the repair source should stay reported", func(t *testing.T) {
+		t.Parallel()
+
+		testTrie := createCommittedTestTrie(t, 100)
+		missingHash := testTrie.nodeHashes[1]
+		require.Nil(t, testTrie.db.Remove(missingHash))
+
+		args := createMockIntegrityCheckerArgs(testTrie.db)
+		args.RepairSource = testscommon.NewMemDbMock()
+		args.RepairDestination = testTrie.db
+		result, _ := checkTrieAndCollectLeaves(t, args, testTrie.rootHash)
+
+		require.Equal(t, 1, len(result.Issues))
+		assert.True(t, result.Issues[0].Missing)
+		assert.False(t, result.Issues[0].Repaired)
+	})
+}
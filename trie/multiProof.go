@@ -0,0 +1,51 @@
+package trie
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+// multiProofBuilder aggregates several Merkle proofs in a single set of encoded nodes. As the nodes are
+// identified by their hash, the nodes shared between proofs (even between different tries) are kept only once
+type multiProofBuilder struct {
+	hasher      hashing.Hasher
+	nodesHashes map[string]struct{}
+	nodes       [][]byte
+}
+
+// NewMultiProofBuilder creates a new instance of multiProofBuilder
+func NewMultiProofBuilder(hasher hashing.Hasher) (*multiProofBuilder, error) {
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &multiProofBuilder{
+		hasher:      hasher,
+		nodesHashes: make(map[string]struct{}),
+		nodes:       make([][]byte, 0),
+	}, nil
+}
+
+// AddProof adds the nodes of the given proof that are not already part of the multi-proof
+func (mpb *multiProofBuilder) AddProof(proof [][]byte) {
+	for _, encodedNode := range proof {
+		hash := string(mpb.hasher.Compute(string(encodedNode)))
+		_, exists := mpb.nodesHashes[hash]
+		if exists {
+			continue
+		}
+
+		mpb.nodesHashes[hash] = struct{}{}
+		mpb.nodes = append(mpb.nodes, encodedNode)
+	}
+}
+
+// Nodes returns the deduplicated set of encoded nodes, in the order they were first added
+func (mpb *multiProofBuilder) Nodes() [][]byte {
+	return mpb.nodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mpb *multiProofBuilder) IsInterfaceNil() bool {
+	return mpb == nil
+}
@@ -0,0 +1,91 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultiProofBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := trie.NewMultiProofBuilder(nil)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, trie.ErrNilHasher, err)
+
+	_, _, hasher, _, _ := getDefaultTrieParameters()
+	builder, err = trie.NewMultiProofBuilder(hasher)
+	assert.Nil(t, err)
+	assert.NotNil(t, builder)
+}
+
+func TestMultiProofBuilder_AddProofShouldDeduplicateSharedNodes(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_, _, hasher, _, _ := getDefaultTrieParameters()
+	builder, _ := trie.NewMultiProofBuilder(hasher)
+
+	proofDog, _, _ := tr.GetProof([]byte("dog"))
+	proofDoe, _, _ := tr.GetProof([]byte("doe"))
+	builder.AddProof(proofDog)
+	builder.AddProof(proofDoe)
+	builder.AddProof(proofDog)
+
+	// dog and doe share all the nodes except the last one
+	require.Equal(t, len(proofDog)+1, len(builder.Nodes()))
+	require.Equal(t, proofDog, builder.Nodes()[:len(proofDog)])
+	require.Equal(t, proofDoe[len(proofDoe)-1], builder.Nodes()[len(proofDog)])
+}
+
+func TestMerkleProofVerifier_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+	mpv, _ := trie.NewMerkleProofVerifier(marshaller, hasher)
+
+	builder, _ := trie.NewMultiProofBuilder(hasher)
+	for _, key := range []string{"dog", "doe", "ddog"} {
+		proof, _, _ := tr.GetProof([]byte(key))
+		builder.AddProof(proof)
+	}
+
+	t.Run("all keys should be proven", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := mpv.VerifyMultiProof(rootHash, [][]byte{[]byte("ddog"), []byte("dog"), []byte("doe")}, builder.Nodes())
+		require.Nil(t, err)
+		require.Equal(t, 3, len(values))
+		require.Contains(t, string(values[0]), "cat")
+		require.Contains(t, string(values[1]), "puppy")
+		require.Contains(t, string(values[2]), "reindeer")
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := mpv.VerifyMultiProof(rootHash, [][]byte{[]byte("dog"), []byte("cat")}, builder.Nodes())
+		require.True(t, errors.Is(err, trie.ErrKeyNotProven))
+		require.Contains(t, err.Error(), "index 1")
+		require.Nil(t, values)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := mpv.VerifyMultiProof(rootHash, [][]byte{[]byte("dog")}, builder.Nodes()[1:])
+		require.True(t, errors.Is(err, trie.ErrKeyNotProven))
+		require.Nil(t, values)
+	})
+	t.Run("different root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		values, err := mpv.VerifyMultiProof([]byte("another root hash"), [][]byte{[]byte("dog")}, builder.Nodes())
+		require.True(t, errors.Is(err, trie.ErrKeyNotProven))
+		require.Nil(t, values)
+	})
+}
@@ -1,6 +1,8 @@
 package trie
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -31,3 +33,62 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies that all the given keys are proven by the provided set of encoded nodes, starting
+// from the given root hash. The values of the keys are returned in the same order as the keys
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, nodes [][]byte) ([][]byte, error) {
+	nodesByHash := make(map[string][]byte, len(nodes))
+	for _, encodedNode := range nodes {
+		nodesByHash[string(mpv.trie.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	values := make([][]byte, 0, len(keys))
+	for i, key := range keys {
+		value, found, err := mpv.proveKey(rootHash, mpv.trie.hasher.Compute(string(key)), nodesByHash)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			value, found, err = mpv.proveKey(rootHash, key, nodesByHash)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w at index %d", ErrKeyNotProven, i)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (mpv *merkleProofVerifier) proveKey(rootHash []byte, key []byte, nodesByHash map[string][]byte) ([]byte, bool, error) {
+	wantHash := rootHash
+	hexKey := keyBytesToHex(key)
+	// each step consumes at least one nibble of the key, so the number of steps is bounded by the key length
+	maxSteps := len(hexKey) + 1
+	for i := 0; i < maxSteps; i++ {
+		encodedNode, ok := nodesByHash[string(wantHash)]
+		if !ok {
+			return nil, false, nil
+		}
+
+		n, err := decodeNode(encodedNode, mpv.trie.marshalizer, mpv.trie.hasher)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var proofVerified bool
+		proofVerified, wantHash, hexKey = n.getNextHashAndKey(hexKey)
+		if proofVerified {
+			return n.getValue(), true, nil
+		}
+		if len(wantHash) == 0 {
+			return nil, false, nil
+		}
+	}
+
+	return nil, false, nil
+}